@@ -7,8 +7,16 @@ Flow triggered by CMD_ANALYZE_JOB:
   3. LLM: generate Pros/Cons
   4. LLM: generate cover letter
   5. LLM: generate ATS CV suggestions
-  6. Write ai_analysis + generated_cover_letter to applications table
-  7. Publish EVENT_ANALYSIS_DONE to Redis → Gateway → SSE → client
+  6. Assemble ai_analysis, write the cover letter to the application
+  7. Publish EVENT_ANALYSIS_DONE (with ai_analysis) to Redis → the Tracker
+     Service stores it and notifies the client via Gateway SSE
+
+Events carry no user content (streams.publish redacts it), so the cover
+letter is written to the database rather than sent to the Tracker.
+
+CMD_GENERATE_COVER_LETTER re-runs step 4 alone (regenerate_cover_letter).
+CMD_GENERATE_FOLLOWUP drafts a follow-up message (draft_followup), written
+to the application the same way.
 """
 
 import json
@@ -18,6 +26,7 @@ from datetime import UTC, datetime
 import llm
 import match_score as ms
 import prompts
+import streams
 from database import get_pool
 
 logger = logging.getLogger(__name__)
@@ -35,6 +44,258 @@ async def analyze(application_id: str, user_id: str, rdb) -> None:
     pool = get_pool()
 
     # ── 1. Fetch all required data in one query ─────────────────
+    job = await _fetch_job_context(pool, application_id, user_id)
+    if job is None:
+        return
+    raw_data = job["raw_data"]
+    skills = job["skills"]
+    experience = job["experience"]
+    job_title = job["job_title"]
+    company = job["company"]
+    description = job["description"]
+    skills_flat = job["skills_flat"]
+
+    logger.info(
+        "Analyzing application %s — '%s' at '%s'", application_id, job_title, company
+    )
+
+    # ── 2. MatchScore (fast, synchronous) ──────────────────────
+    score = ms.compute(skills, experience, raw_data)
+    logger.info("MatchScore = %d/100", score)
+
+    # ── 3. LLM: Pros / Cons ────────────────────────────────────
+    sys_p, usr_p = prompts.pros_cons_prompt(
+        job_title, description, company, skills_flat, experience, score
+    )
+    pros_cons = await llm.chat_json(sys_p, usr_p, temperature=0.3)
+    pros: list[str] = (pros_cons or {}).get("pros", [])
+    cons: list[str] = (pros_cons or {}).get("cons", [])
+
+    # ── 4. LLM: Cover Letter ───────────────────────────────────
+    cover_letter = await _generate_cover_letter(job)
+
+    # ── 5. LLM: CV Suggestions ─────────────────────────────────
+    sys_cv, usr_cv = prompts.cv_suggestions_prompt(job_title, description, skills_flat)
+    cv_result = await llm.chat_json(sys_cv, usr_cv, temperature=0.3)
+    cv_suggestions: list[str] = (cv_result or {}).get("suggestions", [])
+
+    # ── 6. Assemble results ────────────────────────────────────
+    ai_analysis = {
+        "score": score,
+        "pros": pros,
+        "cons": cons,
+        "suggested_cv_content": "\n".join(f"• {s}" for s in cv_suggestions),
+        "analyzed_at": datetime.now(UTC).isoformat(),
+    }
+
+    if cover_letter is not None:
+        await _store_cover_letter(pool, application_id, user_id, cover_letter)
+
+    # ── 7. Publish EVENT_ANALYSIS_DONE ─────────────────────────
+    # The Tracker Service persists ai_analysis and then notifies the client
+    # (EVENT_APPLICATION_ANALYZED).
+    event_payload = json.dumps(
+        {
+            "type": "EVENT_ANALYSIS_DONE",
+            "applicationId": application_id,
+            "userId": user_id,
+            "matchScore": score,
+            "hasCoverLetter": cover_letter is not None,
+            "analyzedAt": ai_analysis["analyzed_at"],
+            "aiAnalysis": ai_analysis,
+        }
+    )
+    await streams.publish(rdb, "EVENT_ANALYSIS_DONE", event_payload)
+    logger.info("EVENT_ANALYSIS_DONE published for application %s", application_id)
+
+
+async def regenerate_cover_letter(application_id: str, user_id: str, rdb) -> None:
+    """
+    Cover-letter-only pipeline, triggered by CMD_GENERATE_COVER_LETTER.
+
+    Writes a fresh letter to applications.generated_cover_letter (the previous
+    one is kept by the cover_letter_versions trigger) and publishes
+    EVENT_COVER_LETTER_GENERATED.
+    """
+    pool = get_pool()
+    job = await _fetch_job_context(pool, application_id, user_id)
+    if job is None:
+        return
+
+    logger.info(
+        "Regenerating cover letter for application %s — '%s' at '%s'",
+        application_id,
+        job["job_title"],
+        job["company"],
+    )
+    cover_letter = await _generate_cover_letter(job)
+
+    if cover_letter is not None:
+        await _store_cover_letter(pool, application_id, user_id, cover_letter)
+        await _invalidate_tracker_cache(rdb, user_id)
+
+    await streams.publish(
+        rdb,
+        "EVENT_COVER_LETTER_GENERATED",
+        json.dumps(
+            {
+                "type": "EVENT_COVER_LETTER_GENERATED",
+                "applicationId": application_id,
+                "userId": user_id,
+                "status": "done" if cover_letter is not None else "error",
+            }
+        ),
+    )
+    logger.info(
+        "EVENT_COVER_LETTER_GENERATED published for application %s", application_id
+    )
+
+
+async def draft_followup(payload: dict, rdb) -> None:
+    """
+    Follow-up pipeline, triggered by CMD_GENERATE_FOLLOWUP, whose payload
+    carries the application's context (status, jobTitle, company,
+    daysSinceLastContact) and the tone wanted.
+
+    Writes the draft to applications.followup_draft (replacing the previous
+    one) and publishes EVENT_FOLLOWUP_DRAFTED.
+    """
+    application_id = payload["applicationId"]
+    user_id = payload["userId"]
+    pool = get_pool()
+    async with pool.acquire() as conn:
+        row = await conn.fetchrow(
+            """
+            SELECT p.full_name
+            FROM applications a
+            LEFT JOIN profiles p ON p.user_id = a.user_id
+            WHERE a.id = $1 AND a.user_id = $2
+            """,
+            application_id,
+            user_id,
+        )
+    if row is None:
+        logger.error(
+            "Application %s not found for user %s — aborting.", application_id, user_id
+        )
+        return
+
+    logger.info(
+        "Drafting follow-up for application %s (%s, %s days since last contact)",
+        application_id,
+        payload.get("status"),
+        payload.get("daysSinceLastContact"),
+    )
+    sys_fu, usr_fu = prompts.followup_prompt(
+        payload.get("jobTitle") or "",
+        payload.get("company") or "",
+        payload.get("status") or "",
+        int(payload.get("daysSinceLastContact") or 0),
+        payload.get("tone") or "",
+        row["full_name"] or "",
+    )
+    draft = await llm.chat_text(sys_fu, usr_fu, temperature=0.7)
+
+    if draft is not None:
+        await _store_followup_draft(pool, application_id, user_id, draft)
+        await _invalidate_tracker_cache(rdb, user_id)
+
+    await streams.publish(
+        rdb,
+        "EVENT_FOLLOWUP_DRAFTED",
+        json.dumps(
+            {
+                "type": "EVENT_FOLLOWUP_DRAFTED",
+                "applicationId": application_id,
+                "userId": user_id,
+                "status": "done" if draft is not None else "error",
+            }
+        ),
+    )
+    logger.info("EVENT_FOLLOWUP_DRAFTED published for application %s", application_id)
+
+
+# ── Helpers ────────────────────────────────────────────────────
+
+# Longest cover letter stored, in characters (the Tracker's limit for text
+# written to an application).
+MAX_COVER_LETTER_LEN = 20_000
+
+
+def _in_clear(text: str) -> str:
+    """
+    Escape text stored in clear in a sealed column the way the Tracker does
+    ("enc::" before a value starting with "enc:"), so that it is never taken
+    for a sealed value.
+    """
+    return "enc::" + text if text.startswith("enc:") else text
+
+
+async def _store_cover_letter(
+    pool, application_id: str, user_id: str, cover_letter: str
+) -> None:
+    """
+    Write a generated letter to applications.generated_cover_letter (the
+    previous one is kept by the cover_letter_versions trigger). The letter is
+    written in clear — this service holds no field encryption key — and
+    sealed by the Tracker's field-resealer job.
+    """
+    async with pool.acquire() as conn:
+        await conn.execute(
+            """
+            UPDATE applications
+            SET generated_cover_letter = $1, updated_at = NOW()
+            WHERE id = $2 AND user_id = $3
+            """,
+            _in_clear(cover_letter.strip()[:MAX_COVER_LETTER_LEN]),
+            application_id,
+            user_id,
+        )
+
+
+# Longest follow-up draft stored, in characters.
+MAX_FOLLOWUP_DRAFT_LEN = 5_000
+
+
+async def _store_followup_draft(
+    pool, application_id: str, user_id: str, draft: str
+) -> None:
+    """
+    Write a follow-up draft to applications.followup_draft, in clear like
+    cover letters (sealed by the Tracker's field-resealer job).
+    """
+    async with pool.acquire() as conn:
+        await conn.execute(
+            """
+            UPDATE applications
+            SET followup_draft = $1, followup_draft_at = NOW(), updated_at = NOW()
+            WHERE id = $2 AND user_id = $3
+            """,
+            _in_clear(draft.strip()[:MAX_FOLLOWUP_DRAFT_LEN]),
+            application_id,
+            user_id,
+        )
+
+
+async def _invalidate_tracker_cache(rdb, user_id: str) -> None:
+    """
+    Drop the Tracker Service's cached views of the user's applications after
+    writing one directly (bumps the user's cache generation). Best effort: the
+    tracker's cache TTL bounds staleness.
+    """
+    key = f"tracker:cache-gen:{user_id}"
+    try:
+        async with rdb.pipeline(transaction=True) as pipe:
+            await pipe.incr(key).expire(key, 24 * 60 * 60).execute()
+    except Exception as exc:
+        logger.warning("Tracker cache invalidation failed: %s", exc)
+
+
+async def _fetch_job_context(pool, application_id: str, user_id: str) -> dict | None:
+    """
+    Load the job offer and candidate profile behind an application, normalised
+    for the prompts. Returns None (and logs) when the application is missing.
+    """
     async with pool.acquire() as conn:
         row = await conn.fetchrow(
             """
@@ -61,7 +322,7 @@ async def analyze(application_id: str, user_id: str, rdb) -> None:
         logger.error(
             "Application %s not found for user %s — aborting.", application_id, user_id
         )
-        return
+        return None
 
     # Deserialise JSONB fields
     _raw = row["job_raw_data"]
@@ -94,81 +355,30 @@ async def analyze(application_id: str, user_id: str, rdb) -> None:
     skills_flat: list[str] = _flatten_skills(skills)
     cover_letter_template: str = row["cover_letter_template"] or ""
 
-    logger.info(
-        "Analyzing application %s — '%s' at '%s'", application_id, job_title, company
-    )
-
-    # ── 2. MatchScore (fast, synchronous) ──────────────────────
-    score = ms.compute(skills, experience, raw_data)
-    logger.info("MatchScore = %d/100", score)
-
-    # ── 3. LLM: Pros / Cons ────────────────────────────────────
-    sys_p, usr_p = prompts.pros_cons_prompt(
-        job_title, description, company, skills_flat, experience, score
-    )
-    pros_cons = await llm.chat_json(sys_p, usr_p, temperature=0.3)
-    pros: list[str] = (pros_cons or {}).get("pros", [])
-    cons: list[str] = (pros_cons or {}).get("cons", [])
-
-    # ── 4. LLM: Cover Letter ───────────────────────────────────
-    sys_cl, usr_cl = prompts.cover_letter_prompt(
-        job_title,
-        company,
-        description,
-        full_name,
-        skills_flat,
-        experience,
-        template=cover_letter_template,
-    )
-    cover_letter: str | None = await llm.chat_text(sys_cl, usr_cl, temperature=0.7)
-
-    # ── 5. LLM: CV Suggestions ─────────────────────────────────
-    sys_cv, usr_cv = prompts.cv_suggestions_prompt(job_title, description, skills_flat)
-    cv_result = await llm.chat_json(sys_cv, usr_cv, temperature=0.3)
-    cv_suggestions: list[str] = (cv_result or {}).get("suggestions", [])
-
-    # ── 6. Write results to DB ─────────────────────────────────
-    ai_analysis = {
-        "score": score,
-        "pros": pros,
-        "cons": cons,
-        "suggested_cv_content": "\n".join(f"• {s}" for s in cv_suggestions),
-        "analyzed_at": datetime.now(UTC).isoformat(),
+    return {
+        "raw_data": raw_data,
+        "skills": skills,
+        "experience": experience,
+        "job_title": job_title,
+        "company": company,
+        "description": description,
+        "full_name": full_name,
+        "skills_flat": skills_flat,
+        "cover_letter_template": cover_letter_template,
     }
 
-    async with pool.acquire() as conn:
-        await conn.execute(
-            """
-            UPDATE applications
-            SET
-                ai_analysis            = $1::jsonb,
-                generated_cover_letter = $2,
-                updated_at             = NOW()
-            WHERE id = $3
-            """,
-            json.dumps(ai_analysis),
-            cover_letter,
-            application_id,
-        )
-
-    logger.info("Analysis written to DB for application %s", application_id)
 
-    # ── 7. Publish EVENT_ANALYSIS_DONE ─────────────────────────
-    event_payload = json.dumps(
-        {
-            "type": "EVENT_ANALYSIS_DONE",
-            "applicationId": application_id,
-            "userId": user_id,
-            "matchScore": score,
-            "hasCoverLetter": cover_letter is not None,
-            "analyzedAt": ai_analysis["analyzed_at"],
-        }
+async def _generate_cover_letter(job: dict) -> str | None:
+    sys_cl, usr_cl = prompts.cover_letter_prompt(
+        job["job_title"],
+        job["company"],
+        job["description"],
+        job["full_name"],
+        job["skills_flat"],
+        job["experience"],
+        template=job["cover_letter_template"],
     )
-    await rdb.publish("EVENT_ANALYSIS_DONE", event_payload)
-    logger.info("EVENT_ANALYSIS_DONE published for application %s", application_id)
-
-
-# ── Helpers ────────────────────────────────────────────────────
+    return await llm.chat_text(sys_cl, usr_cl, temperature=0.7)
 
 
 def _load_json(value) -> list:
@@ -24,6 +24,7 @@ import redis.asyncio as aioredis
 from pdfminer.high_level import extract_text
 
 import llm
+import streams
 from database import get_pool
 
 logger = logging.getLogger(__name__)
@@ -129,7 +130,7 @@ async def parse(user_id: str, cv_url: str, rdb: aioredis.Redis) -> None:
             },
         }
     )
-    await rdb.publish("EVENT_CV_PARSED", event)
+    await streams.publish(rdb, "EVENT_CV_PARSED", event)
     logger.info("EVENT_CV_PARSED published for user %s", user_id)
 
 
@@ -170,6 +171,6 @@ async def _publish_error(rdb: aioredis.Redis, user_id: str, reason: str) -> None
         }
     )
     try:
-        await rdb.publish("EVENT_CV_PARSED", event)
+        await streams.publish(rdb, "EVENT_CV_PARSED", event)
     except Exception as exc:
         logger.warning("Failed to publish CV parse error event: %s", exc)
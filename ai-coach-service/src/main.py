@@ -5,15 +5,25 @@ Startup sequence:
   1. Validate required environment variables (config.py — fail-fast)
   2. Open asyncpg connection pool (database.py)
   3. Open Redis async connection (redis_consumer.py)
-  4. Spawn Redis subscriber as a background task (redis_consumer.py)
+  4. Spawn the Redis Streams consumer as a background task (redis_consumer.py)
   5. Expose /health endpoint
 
 On CMD_ANALYZE_JOB:
   - Fetch application + job_feed + profile from PostgreSQL
   - Run MatchScore (keyword matching, deterministic)
   - Call OpenRouter LLM for Pros/Cons, Cover Letter, CV suggestions
-  - Write ai_analysis + generated_cover_letter back to applications
-  - Publish EVENT_ANALYSIS_DONE for the Gateway SSE stream
+  - Write the cover letter to the application and publish
+    EVENT_ANALYSIS_DONE carrying ai_analysis; the Tracker Service persists
+    it and notifies the Gateway SSE stream
+
+On CMD_GENERATE_COVER_LETTER:
+  - Regenerate only the cover letter (earlier versions are kept in
+    cover_letter_versions) and publish EVENT_COVER_LETTER_GENERATED
+
+On CMD_GENERATE_FOLLOWUP:
+  - Draft a follow-up message from the application context the Tracker
+    sent, write it to applications.followup_draft and publish
+    EVENT_FOLLOWUP_DRAFTED
 
 On CMD_PARSE_CV:
   - Extract text from uploaded PDF using pdfminer.six
@@ -32,6 +42,7 @@ from pythonjsonlogger import jsonlogger
 
 import database
 import redis_consumer
+import request_context
 from config import AI_COACH_PORT, SERVICE_VERSION
 
 
@@ -45,6 +56,7 @@ def _configure_json_logging() -> None:
         static_fields={"service": "ai-coach-service", "version": SERVICE_VERSION},
     )
     handler.setFormatter(formatter)
+    handler.addFilter(request_context.LogFilter())
     root = logging.getLogger()
     root.handlers = [handler]
     root.setLevel(logging.INFO)
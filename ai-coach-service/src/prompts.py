@@ -100,6 +100,53 @@ Respond with JSON only.
     return system, user
 
 
+# How each tone accepted by the Tracker's RequestFollowUpDraft reads.
+FOLLOWUP_TONES = {
+    "PROFESSIONAL": "polite and professional",
+    "FRIENDLY": "warm and friendly, while staying professional",
+    "BRIEF": "very short and to the point (3 sentences at most)",
+}
+
+# What the candidate is waiting for, by application status.
+_FOLLOWUP_STAGES = {
+    "APPLIED": "news about their application",
+    "INTERVIEW": "news after their interview",
+    "OFFER": "the details or the next steps of the offer they received",
+    "ON_HOLD": "news about a process that was put on hold",
+    "REJECTED": "feedback on why their application was not retained",
+    "WITHDRAWN": "nothing — they withdrew and want to keep a good relationship",
+    "HIRED": "their start — they accepted the offer",
+}
+
+
+def followup_prompt(
+    job_title: str,
+    company: str,
+    status: str,
+    days_since_last_contact: int,
+    tone: str,
+    full_name: str,
+) -> tuple[str, str]:
+    system = (
+        "You are a career coach helping a candidate follow up with a company. "
+        "Write a short follow-up email body the candidate can send as is, "
+        "in the same language as the job title. Do not invent facts (names, "
+        "dates, interview details) that are not given. "
+        f"The tone is {FOLLOWUP_TONES.get(tone, FOLLOWUP_TONES['PROFESSIONAL'])}."
+    )
+    user = f"""
+Write a follow-up message for:
+
+Position: {job_title or 'the position'} at {company or 'the company'}
+Candidate name: {full_name or 'the candidate'}
+The candidate is waiting for: {_FOLLOWUP_STAGES.get(status, "news about their application")}
+Days since the last contact with the company: {days_since_last_contact}
+
+Output ONLY the message text (no subject line, no JSON wrapper), under 150 words.
+""".strip()
+    return system, user
+
+
 # ── Helpers ────────────────────────────────────────────────────
 
 
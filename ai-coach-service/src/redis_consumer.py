@@ -1,100 +1,213 @@
 """
-Redis Pub/Sub consumer.
+Redis Streams consumer.
 
-Subscribes to:
-  - CMD_ANALYZE_JOB  → analyzer.analyze(applicationId, userId)
-  - CMD_PARSE_CV     → cv_parser.parse(userId, cvUrl)
+Reads, as consumer group "ai-coach":
+  - CMD_ANALYZE_JOB            → analyzer.analyze(applicationId, userId)
+  - CMD_GENERATE_COVER_LETTER  → analyzer.regenerate_cover_letter(applicationId, userId)
+  - CMD_GENERATE_FOLLOWUP      → analyzer.draft_followup(payload)
+  - CMD_PARSE_CV               → cv_parser.parse(userId, cvUrl)
 
-Message payloads (JSON):
+Each command runs as its own task and is acknowledged (XACK) when that task
+ends, successfully or not — the safe wrappers already report failures to
+the user. Commands left unacknowledged by a crashed or restarted instance
+are claimed again once idle for longer than a run may take, so nothing is
+lost while the service is down.
+
+Message payloads (JSON, in the entry's "payload" field):
 
   CMD_ANALYZE_JOB:
     { "applicationId": "<uuid>", "userId": "<uuid>", "jobFeedId": "<uuid>" }
 
+  CMD_GENERATE_COVER_LETTER:
+    { "applicationId": "<uuid>", "userId": "<uuid>", "jobFeedId": "<uuid>" }
+
+  CMD_GENERATE_FOLLOWUP:
+    { "applicationId": "<uuid>", "userId": "<uuid>", "status": "APPLIED",
+      "jobTitle": "…", "company": "…", "daysSinceLastContact": 9,
+      "tone": "PROFESSIONAL" | "FRIENDLY" | "BRIEF" }
+
   CMD_PARSE_CV:
     { "userId": "<uuid>", "cvUrl": "<relative-path>" }
+
+Commands may also carry the "requestId" of the user action behind them; the
+command's task runs with it (see request_context).
 """
 
 import asyncio
 import json
 import logging
+import socket
+import time
+from collections.abc import Coroutine
 
 import redis.asyncio as aioredis
+from redis.exceptions import ResponseError
 
 import analyzer
 import cv_parser
+import request_context
+import streams
 from config import ANALYSIS_TIMEOUT_SECONDS, REDIS_URL
 
 logger = logging.getLogger(__name__)
 
-CHANNELS = ["CMD_ANALYZE_JOB", "CMD_PARSE_CV"]
+STREAMS = [
+    "CMD_ANALYZE_JOB",
+    "CMD_GENERATE_COVER_LETTER",
+    "CMD_GENERATE_FOLLOWUP",
+    "CMD_PARSE_CV",
+]
+GROUP = "ai-coach"
+CONSUMER = socket.gethostname()
+
+# A pending command idle for longer than this is assumed abandoned.
+CLAIM_IDLE_MS = (ANALYSIS_TIMEOUT_SECONDS + 60) * 1000
 
 
 async def start(rdb: aioredis.Redis) -> None:
     """
-    Long-running coroutine that listens on all command channels forever.
+    Long-running coroutine that consumes all command streams forever.
     Should be run as an asyncio task.
     """
-    pubsub = rdb.pubsub()
-    await pubsub.subscribe(*CHANNELS)
-    logger.info("Subscribed to Redis channels: %s", CHANNELS)
+    for stream in STREAMS:
+        try:
+            await rdb.xgroup_create(stream, GROUP, id="$", mkstream=True)
+        except ResponseError as exc:
+            if "BUSYGROUP" not in str(exc):
+                raise
+    logger.info("Consuming Redis streams %s as %s/%s", STREAMS, GROUP, CONSUMER)
+
+    last_claim = 0.0
+    while True:
+        if time.monotonic() - last_claim >= CLAIM_IDLE_MS / 1000:
+            await _claim_abandoned(rdb)
+            last_claim = time.monotonic()
 
-    async for message in pubsub.listen():
-        if message["type"] != "message":
+        try:
+            entries = await rdb.xreadgroup(
+                GROUP, CONSUMER, {s: ">" for s in STREAMS}, count=10, block=5000
+            )
+        except aioredis.ConnectionError as exc:
+            logger.error("Redis read failed: %s", exc)
+            await asyncio.sleep(2)
             continue
 
-        channel = message.get("channel", b"")
-        if isinstance(channel, bytes):
-            channel = channel.decode("utf-8")
+        for stream, messages in entries or []:
+            for msg_id, fields in messages:
+                await _handle(rdb, _str(stream), _str(msg_id), fields)
 
-        raw = message.get("data", b"")
-        if isinstance(raw, bytes):
-            raw = raw.decode("utf-8")
-
-        logger.info("Received [%s]: %s", channel, raw[:200])
 
+async def _claim_abandoned(rdb: aioredis.Redis) -> None:
+    """Take over commands another (dead) consumer never acknowledged."""
+    for stream in STREAMS:
         try:
-            payload = json.loads(raw)
-        except json.JSONDecodeError:
-            logger.error("Invalid JSON on channel %s: %s", channel, raw)
+            _, messages, *_ = await rdb.xautoclaim(
+                stream, GROUP, CONSUMER, min_idle_time=CLAIM_IDLE_MS, count=50
+            )
+        except aioredis.RedisError as exc:
+            logger.warning("XAUTOCLAIM failed on %s: %s", stream, exc)
             continue
+        for msg_id, fields in messages:
+            logger.info("Reclaimed abandoned %s entry %s", stream, _str(msg_id))
+            await _handle(rdb, stream, _str(msg_id), fields)
+
 
-        if channel == "CMD_ANALYZE_JOB":
-            _dispatch_analyze(payload, rdb)
-        elif channel == "CMD_PARSE_CV":
-            _dispatch_parse_cv(payload, rdb)
+async def _handle(rdb: aioredis.Redis, stream: str, msg_id: str, fields) -> None:
+    raw = _str((fields or {}).get(streams.PAYLOAD_FIELD.encode(), b""))
+    logger.info("Received [%s %s]: %s", stream, msg_id, raw[:200])
+
+    job = None
+    rid = ""
+    try:
+        payload = json.loads(raw)
+    except json.JSONDecodeError:
+        logger.error("Invalid JSON on stream %s: %s", stream, raw)
+    else:
+        if isinstance(payload, dict):
+            rid = request_context.from_payload(payload)
+        if stream == "CMD_ANALYZE_JOB":
+            job = _dispatch_analyze(payload, rdb)
+        elif stream == "CMD_GENERATE_COVER_LETTER":
+            job = _dispatch_cover_letter(payload, rdb)
+        elif stream == "CMD_GENERATE_FOLLOWUP":
+            job = _dispatch_followup(payload, rdb)
+        elif stream == "CMD_PARSE_CV":
+            job = _dispatch_parse_cv(payload, rdb)
         else:
-            logger.warning("Unhandled channel: %s", channel)
+            logger.warning("Unhandled stream: %s", stream)
+
+    if job is None:
+        await _ack(rdb, stream, msg_id)
+        return
+    asyncio.create_task(
+        _run_then_ack(job, rdb, stream, msg_id, rid), name=f"{stream}-{msg_id}"
+    )
+
+
+async def _run_then_ack(
+    job: Coroutine, rdb: aioredis.Redis, stream: str, msg_id: str, rid: str
+) -> None:
+    # The task has its own copy of the context: the ID stays with this command.
+    request_context.request_id.set(rid)
+    try:
+        await job
+    finally:
+        await _ack(rdb, stream, msg_id)
+
+
+async def _ack(rdb: aioredis.Redis, stream: str, msg_id: str) -> None:
+    try:
+        await rdb.xack(stream, GROUP, msg_id)
+    except aioredis.RedisError as exc:
+        logger.warning("XACK failed for %s %s: %s", stream, msg_id, exc)
+
+
+def _str(value) -> str:
+    return value.decode("utf-8") if isinstance(value, bytes) else value
 
 
 # ─── Dispatchers ─────────────────────────────────────────────────────────────
 
 
-def _dispatch_analyze(payload: dict, rdb: aioredis.Redis) -> None:
+def _dispatch_analyze(payload: dict, rdb: aioredis.Redis) -> Coroutine | None:
     application_id = payload.get("applicationId")
     user_id = payload.get("userId")
 
     if not application_id or not user_id:
         logger.error("CMD_ANALYZE_JOB missing required fields: %s", payload)
-        return
+        return None
 
-    asyncio.create_task(
-        _safe_analyze(application_id, user_id, rdb),
-        name=f"analyze-{application_id}",
-    )
+    return _safe_analyze(application_id, user_id, rdb)
+
+
+def _dispatch_cover_letter(payload: dict, rdb: aioredis.Redis) -> Coroutine | None:
+    application_id = payload.get("applicationId")
+    user_id = payload.get("userId")
+
+    if not application_id or not user_id:
+        logger.error("CMD_GENERATE_COVER_LETTER missing required fields: %s", payload)
+        return None
+
+    return _safe_cover_letter(application_id, user_id, rdb)
+
+
+def _dispatch_followup(payload: dict, rdb: aioredis.Redis) -> Coroutine | None:
+    if not payload.get("applicationId") or not payload.get("userId"):
+        logger.error("CMD_GENERATE_FOLLOWUP missing required fields: %s", payload)
+        return None
+
+    return _safe_followup(payload, rdb)
 
 
-def _dispatch_parse_cv(payload: dict, rdb: aioredis.Redis) -> None:
+def _dispatch_parse_cv(payload: dict, rdb: aioredis.Redis) -> Coroutine | None:
     user_id = payload.get("userId")
     cv_url = payload.get("cvUrl")
 
     if not user_id or not cv_url:
         logger.error("CMD_PARSE_CV missing required fields: %s", payload)
-        return
+        return None
 
-    asyncio.create_task(
-        _safe_parse_cv(user_id, cv_url, rdb),
-        name=f"parse-cv-{user_id}",
-    )
+    return _safe_parse_cv(user_id, cv_url, rdb)
 
 
 # ─── Safe wrappers ────────────────────────────────────────────────────────────
@@ -113,7 +226,8 @@ async def _safe_analyze(application_id: str, user_id: str, rdb: aioredis.Redis)
             ANALYSIS_TIMEOUT_SECONDS,
             application_id,
         )
-        await rdb.publish(
+        await streams.publish(
+            rdb,
             "EVENT_ANALYSIS_DONE",
             json.dumps(
                 {
@@ -131,7 +245,8 @@ async def _safe_analyze(application_id: str, user_id: str, rdb: aioredis.Redis)
         logger.exception(
             "Unhandled error analyzing application %s: %s", application_id, exc
         )
-        await rdb.publish(
+        await streams.publish(
+            rdb,
             "EVENT_ANALYSIS_DONE",
             json.dumps(
                 {
@@ -147,6 +262,61 @@ async def _safe_analyze(application_id: str, user_id: str, rdb: aioredis.Redis)
         )
 
 
+async def _safe_cover_letter(
+    application_id: str, user_id: str, rdb: aioredis.Redis
+) -> None:
+    """Wrapper that catches and logs any exception from cover letter generation."""
+    try:
+        await asyncio.wait_for(
+            analyzer.regenerate_cover_letter(application_id, user_id, rdb),
+            timeout=ANALYSIS_TIMEOUT_SECONDS,
+        )
+    except Exception as exc:
+        logger.exception(
+            "Cover letter generation failed for application %s: %s",
+            application_id,
+            exc,
+        )
+        await streams.publish(
+            rdb,
+            "EVENT_COVER_LETTER_GENERATED",
+            json.dumps(
+                {
+                    "type": "EVENT_COVER_LETTER_GENERATED",
+                    "applicationId": application_id,
+                    "userId": user_id,
+                    "status": "error",
+                }
+            ),
+        )
+
+
+async def _safe_followup(payload: dict, rdb: aioredis.Redis) -> None:
+    """Wrapper that catches and logs any exception from follow-up drafting."""
+    application_id = payload["applicationId"]
+    try:
+        await asyncio.wait_for(
+            analyzer.draft_followup(payload, rdb),
+            timeout=ANALYSIS_TIMEOUT_SECONDS,
+        )
+    except Exception as exc:
+        logger.exception(
+            "Follow-up drafting failed for application %s: %s", application_id, exc
+        )
+        await streams.publish(
+            rdb,
+            "EVENT_FOLLOWUP_DRAFTED",
+            json.dumps(
+                {
+                    "type": "EVENT_FOLLOWUP_DRAFTED",
+                    "applicationId": application_id,
+                    "userId": payload["userId"],
+                    "status": "error",
+                }
+            ),
+        )
+
+
 async def _safe_parse_cv(user_id: str, cv_url: str, rdb: aioredis.Redis) -> None:
     """Wrapper that catches and logs any exception from the CV parser."""
     try:
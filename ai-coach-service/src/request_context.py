@@ -0,0 +1,39 @@
+"""
+Request ID of the user action behind the command being handled, for
+correlating logs and events across services (the Gateway, the tracker, the
+AI coach).
+
+The tracker copies the ID of the request that queued a command into its
+payload ("requestId"); the consumer binds it for the command's task, so the
+task's log lines carry it (LogFilter) and the events it publishes include it
+(streams.publish) — the tracker picks it up again from EVENT_ANALYSIS_DONE.
+"""
+
+import contextvars
+import logging
+import re
+
+FIELD = "requestId"
+
+# Same rule as the tracker: printable ASCII, at most 128 characters.
+_VALID = re.compile(r"[\x21-\x7e]{1,128}")
+
+request_id: contextvars.ContextVar[str] = contextvars.ContextVar("request_id", default="")
+
+
+def from_payload(payload: dict) -> str:
+    """The request ID a command payload carries, "" if none or invalid."""
+    value = payload.get(FIELD)
+    if isinstance(value, str) and _VALID.fullmatch(value):
+        return value
+    return ""
+
+
+class LogFilter(logging.Filter):
+    """Adds the current request ID, when there is one, to log records."""
+
+    def filter(self, record: logging.LogRecord) -> bool:
+        rid = request_id.get()
+        if rid:
+            setattr(record, FIELD, rid)
+        return True
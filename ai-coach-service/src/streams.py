@@ -0,0 +1,34 @@
+"""
+Redis Streams helpers.
+
+Every EVENT_* / CMD_* message is appended to a Redis Stream named after its
+type, with the JSON document in a single "payload" field. Consumers read
+through consumer groups and acknowledge what they have handled, so messages
+survive a consumer restart (unlike Pub/Sub).
+"""
+
+import json
+
+import redis.asyncio as aioredis
+
+import request_context
+from jobmate_shared import redaction
+
+PAYLOAD_FIELD = "payload"
+STREAM_MAXLEN = 10_000
+
+
+async def publish(rdb: aioredis.Redis, stream: str, payload: str) -> None:
+    """
+    Append a JSON payload to a stream (trimmed to ~STREAM_MAXLEN entries),
+    with user content redacted (see redaction) and tagged with the request ID
+    of the command being handled, if any.
+    """
+    document = redaction.redact(json.loads(payload))
+    rid = request_context.request_id.get()
+    if rid and isinstance(document, dict) and request_context.FIELD not in document:
+        document[request_context.FIELD] = rid
+    payload = json.dumps(document)
+    await rdb.xadd(
+        stream, {PAYLOAD_FIELD: payload}, maxlen=STREAM_MAXLEN, approximate=True
+    )
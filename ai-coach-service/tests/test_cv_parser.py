@@ -7,7 +7,7 @@ All external I/O is mocked:
   - pdfminer.high_level.extract_text   → returns a fake CV text
   - llm.chat_json                      → returns a structured dict
   - database.get_pool                  → returns an async mock
-  - rdb.publish                        → async mock
+  - rdb.xadd                           → async mock (Redis Streams publish)
 """
 
 import json
@@ -99,6 +99,13 @@ class _AsyncCtxMgr:
         pass
 
 
+def _published(rdb_mock):
+    """Return (stream, event) of the single entry appended with XADD."""
+    rdb_mock.xadd.assert_awaited_once()
+    stream, fields = rdb_mock.xadd.call_args[0]
+    return stream, json.loads(fields["payload"])
+
+
 # ── Tests ─────────────────────────────────────────────────────────────────────
 
 
@@ -134,10 +141,8 @@ class TestParse:
         assert "user-uuid-1" in sql_call_args  # user_id passed as $6
 
         # EVENT_CV_PARSED should have been published
-        rdb_mock.publish.assert_awaited_once()
-        channel, raw = rdb_mock.publish.call_args[0]
-        assert channel == "EVENT_CV_PARSED"
-        event = json.loads(raw)
+        stream, event = _published(rdb_mock)
+        assert stream == "EVENT_CV_PARSED"
         assert event["type"] == "EVENT_CV_PARSED"
         assert event["userId"] == "user-uuid-1"
         assert "fieldsUpdated" in event
@@ -150,10 +155,8 @@ class TestParse:
         with patch("cv_parser.UPLOAD_BASE", str(tmp_path)):
             await cv_parser.parse("user-uuid-2", "/uploads/missing.pdf", rdb_mock)
 
-        rdb_mock.publish.assert_awaited_once()
-        channel, raw = rdb_mock.publish.call_args[0]
-        assert channel == "EVENT_CV_PARSED"
-        event = json.loads(raw)
+        stream, event = _published(rdb_mock)
+        assert stream == "EVENT_CV_PARSED"
         assert "error" in event
         assert event["userId"] == "user-uuid-2"
 
@@ -171,8 +174,7 @@ class TestParse:
         ):
             await cv_parser.parse("user-uuid-3", "/uploads/empty.pdf", rdb_mock)
 
-        rdb_mock.publish.assert_awaited_once()
-        event = json.loads(rdb_mock.publish.call_args[0][1])
+        _, event = _published(rdb_mock)
         assert "error" in event
 
     @pytest.mark.asyncio
@@ -190,8 +192,7 @@ class TestParse:
         ):
             await cv_parser.parse("user-uuid-4", "/uploads/cv2.pdf", rdb_mock)
 
-        rdb_mock.publish.assert_awaited_once()
-        event = json.loads(rdb_mock.publish.call_args[0][1])
+        _, event = _published(rdb_mock)
         assert "error" in event
 
     @pytest.mark.asyncio
@@ -218,8 +219,7 @@ class TestParse:
             await cv_parser.parse("user-uuid-5", "/uploads/partial.pdf", rdb_mock)
 
         conn_mock.execute.assert_awaited_once()
-        rdb_mock.publish.assert_awaited_once()
-        event = json.loads(rdb_mock.publish.call_args[0][1])
+        _, event = _published(rdb_mock)
         assert event["type"] == "EVENT_CV_PARSED"
         # fieldsUpdated.experience should be 0 (empty list from LLM)
         assert event["fieldsUpdated"]["experience"] == 0
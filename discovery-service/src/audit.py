@@ -0,0 +1,111 @@
+"""Records discovery operations in the shared, append-only audit_log table.
+
+The tracker service owns the query side (ListAuditLog); entries written here
+carry service = "discovery" and action = "discovery.<RPC>".
+"""
+
+from __future__ import annotations
+
+import functools
+import json
+import logging
+
+import grpc
+from google.protobuf.json_format import MessageToDict
+
+import database
+
+logger = logging.getLogger(__name__)
+
+SERVICE = "discovery"
+
+
+async def record(
+    *,
+    actor_id: str | None,
+    action: str,
+    resource_type: str | None = None,
+    resource_id: str | None = None,
+    old_values: dict | None = None,
+    new_values: dict | None = None,
+    request: dict | None = None,
+    outcome: str = "OK",
+) -> None:
+    """Append one entry. Best effort: failures are logged, never raised."""
+    try:
+        pool = await database.get_pool()
+        await pool.execute(
+            """
+            INSERT INTO audit_log (service, actor_id, action, resource_type, resource_id,
+                                   old_values, new_values, request, outcome)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+            """,
+            SERVICE,
+            actor_id,
+            f"{SERVICE}.{action}",
+            resource_type,
+            resource_id,
+            _json(old_values),
+            _json(new_values),
+            _json(request),
+            outcome,
+        )
+    except Exception as exc:
+        logger.warning("audit entry lost action=%s err=%s", action, exc)
+
+
+async def job_feed_snapshot(job_feed_id: str) -> dict | None:
+    """The job_feed row as recorded in new_values (raw scraper payload left out)."""
+    try:
+        pool = await database.get_pool()
+        row = await pool.fetchval(
+            "SELECT (to_jsonb(j) - 'raw_data')::text FROM job_feed j WHERE id = $1",
+            job_feed_id,
+        )
+    except Exception as exc:
+        logger.warning("audit snapshot failed job_feed=%s err=%s", job_feed_id, exc)
+        return None
+    return json.loads(row) if row else None
+
+
+def audited(resource_type: str | None = None):
+    """Decorate a servicer RPC so every call — failed ones included — is recorded.
+
+    When the response carries a job_feed_id, the created row is the resource.
+    The acting user is read from the x-user-id metadata.
+    """
+
+    def decorate(method):
+        @functools.wraps(method)
+        async def wrapper(self, request, context):
+            actor_id = next(
+                (v for k, v in context.invocation_metadata() if k == "x-user-id"), None
+            )
+            outcome = "OK"
+            response = None
+            try:
+                response = await method(self, request, context)
+                return response
+            except BaseException:
+                code = context.code() if hasattr(context, "code") else None
+                outcome = code.name if isinstance(code, grpc.StatusCode) else "UNKNOWN"
+                raise
+            finally:
+                resource_id = getattr(response, "job_feed_id", None) or None
+                await record(
+                    actor_id=actor_id,
+                    action=method.__name__,
+                    resource_type=resource_type if resource_id else None,
+                    resource_id=resource_id,
+                    new_values=await job_feed_snapshot(resource_id) if resource_id else None,
+                    request=MessageToDict(request),
+                    outcome=outcome,
+                )
+
+        return wrapper
+
+    return decorate
+
+
+def _json(value: dict | None) -> str | None:
+    return json.dumps(value) if value is not None else None
@@ -13,11 +13,41 @@ PROTO_FILE: str = os.path.join(PROTO_DIR, "discovery.proto")
 # Adzuna API credentials
 ADZUNA_APP_ID: str = os.getenv("ADZUNA_APP_ID", "")
 ADZUNA_APP_KEY: str = os.getenv("ADZUNA_APP_KEY", "")
+# More keys, as comma-separated "app_id:app_key" pairs (see key_pool).
+ADZUNA_KEYS: list[tuple[str, str]] = [
+    (pair.partition(":")[0].strip(), pair.partition(":")[2].strip())
+    for pair in os.getenv("ADZUNA_KEYS", "").split(",")
+    if pair.strip()
+]
+# JSON file with the keys, re-read when it changes (see credentials); takes
+# precedence over ADZUNA_APP_ID / ADZUNA_APP_KEY / ADZUNA_KEYS.
+ADZUNA_CREDENTIALS_FILE: str = os.getenv("ADZUNA_CREDENTIALS_FILE", "")
+# Requests each key may make per day (UTC), 0 = no limit (the free tier
+# allows 250).
+ADZUNA_DAILY_QUOTA_PER_KEY: int = int(os.getenv("ADZUNA_DAILY_QUOTA_PER_KEY", "0"))
 ADZUNA_COUNTRY: str = os.getenv("ADZUNA_COUNTRY", "fr")
 
+# Local development: serve canned offers (see mock_fetcher) instead of
+# calling Adzuna. The same seed gives the same offers.
+MOCK_FETCHER: bool = os.getenv("MOCK_FETCHER", "false").lower() in ("1", "true", "yes")
+MOCK_FETCHER_SEED: int = int(os.getenv("MOCK_FETCHER_SEED", "42"))
+
 # How often to run the automatic scrape (hours)
 SCRAPE_INTERVAL_HOURS: float = float(os.getenv("SCRAPE_INTERVAL_HOURS", "6"))
 
+# Scrape limits, so that one big config can't monopolize a cycle and the API
+# quota: provider requests in flight at once (all scrapes together), and per
+# config and scrape, offers inserted and job title × location pairs searched
+# (0 = no limit).
+SCRAPE_MAX_CONCURRENT_REQUESTS: int = max(1, int(os.getenv("SCRAPE_MAX_CONCURRENT_REQUESTS", "4")))
+SCRAPE_MAX_OFFERS_PER_CONFIG: int = int(os.getenv("SCRAPE_MAX_OFFERS_PER_CONFIG", "200"))
+SCRAPE_MAX_SEARCHES_PER_CONFIG: int = int(os.getenv("SCRAPE_MAX_SEARCHES_PER_CONFIG", "25"))
+
+# job_feed's monthly partitions older than this many months are dropped, but
+# for the offers applications were made from (see feed_partitions); 0 keeps
+# them all.
+JOB_FEED_RETENTION_MONTHS: int = int(os.getenv("JOB_FEED_RETENTION_MONTHS", "12"))
+
 # Red-flag keywords (comma-separated, override via env)
 RED_FLAG_KEYWORDS: list[str] = [
     kw.strip().lower()
@@ -0,0 +1,85 @@
+"""Job provider API credentials, rotatable without a restart.
+
+When ADZUNA_CREDENTIALS_FILE names a JSON file — typically a mounted
+Docker or Kubernetes secret — the credentials are read from it, and read
+again whenever it changes: one key, or a pool of them (see key_pool):
+
+    {"app_id": "…", "app_key": "…"}
+    {"keys": [{"app_id": "…", "app_key": "…"}, …]}
+
+Otherwise ADZUNA_APP_ID and ADZUNA_APP_KEY, then the "app_id:app_key"
+pairs of ADZUNA_KEYS, read at startup, are used. A file that can't be read
+or parsed (e.g. caught mid-write) is logged and the credentials read last
+are kept.
+"""
+
+from __future__ import annotations
+
+import json
+import logging
+import os
+from dataclasses import dataclass
+
+import config
+
+logger = logging.getLogger(__name__)
+
+
+@dataclass(frozen=True)
+class AdzunaCredentials:
+    app_id: str
+    app_key: str
+
+    def __bool__(self) -> bool:
+        return bool(self.app_id and self.app_key)
+
+
+class _File:
+    """A JSON file parsed again when its modification time or size changes."""
+
+    def __init__(self, path: str):
+        self.path = path
+        self._stamp: tuple[int, int] | None = None
+        self._value: dict = {}
+
+    def read(self) -> dict:
+        try:
+            st = os.stat(self.path)
+        except OSError as exc:
+            logger.warning("Credentials file unreadable, keeping the previous ones: %s", exc)
+            return self._value
+        stamp = (st.st_mtime_ns, st.st_size)
+        if stamp == self._stamp:
+            return self._value
+        self._stamp = stamp  # a bad version is reported once, not on every read
+        try:
+            with open(self.path, encoding="utf-8") as f:
+                value = json.load(f)
+            if not isinstance(value, dict):
+                raise ValueError("not a JSON object")
+        except (OSError, ValueError) as exc:
+            logger.warning("Credentials file %s unreadable, keeping the previous ones: %s", self.path, exc)
+            return self._value
+        if self._value:
+            logger.info("Credentials reloaded from %s", self.path)
+        self._value = value
+        return value
+
+
+_adzuna_file = _File(config.ADZUNA_CREDENTIALS_FILE) if config.ADZUNA_CREDENTIALS_FILE else None
+
+
+def adzuna() -> list[AdzunaCredentials]:
+    """The Adzuna keys to use now, without incomplete ones (empty if none are configured)."""
+    if _adzuna_file:
+        value = _adzuna_file.read()
+        entries = value.get("keys", [value])
+        keys = [
+            AdzunaCredentials(str(e.get("app_id", "")), str(e.get("app_key", "")))
+            for e in entries
+            if isinstance(e, dict)
+        ]
+    else:
+        keys = [AdzunaCredentials(config.ADZUNA_APP_ID, config.ADZUNA_APP_KEY)]
+        keys += [AdzunaCredentials(i, k) for i, k in config.ADZUNA_KEYS]
+    return [k for k in keys if k]
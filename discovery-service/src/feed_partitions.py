@@ -0,0 +1,40 @@
+"""Monthly partitions of job_feed (see migration 040).
+
+job_feed is partitioned by created_at, one partition per month. maintain()
+runs at startup and then daily: it creates the partitions of the coming
+PARTITIONS_AHEAD months, so that inserts never land in job_feed_default,
+and drops those older than JOB_FEED_RETENTION_MONTHS — their offers
+expired long ago. Offers an application was made from are kept, in
+job_feed_default. The work is done by SQL functions under an advisory
+lock, so replicas may run it at the same time.
+"""
+
+from __future__ import annotations
+
+import logging
+
+import config
+import database
+
+logger = logging.getLogger(__name__)
+
+PARTITIONS_AHEAD = 3
+
+
+async def maintain() -> None:
+    """Create the coming partitions and drop the expired ones. Failures are logged."""
+    try:
+        pool = await database.get_pool()
+        created = await pool.fetchval(
+            "SELECT job_feed_create_partitions(NOW(), $1)", PARTITIONS_AHEAD
+        )
+        dropped = 0
+        if config.JOB_FEED_RETENTION_MONTHS > 0:
+            dropped = await pool.fetchval(
+                "SELECT job_feed_drop_partitions($1)", config.JOB_FEED_RETENTION_MONTHS
+            )
+    except Exception as exc:
+        logger.error("job_feed partition maintenance failed: %s", exc)
+        return
+    if created or dropped:
+        logger.info("job_feed partitions: %d created, %d dropped", created, dropped)
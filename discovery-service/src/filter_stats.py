@@ -0,0 +1,113 @@
+"""Per-config statistics of what became of the scraped offers.
+
+Every scrape adds, per search config and day, how many fetched offers were
+inserted, skipped as already in the feed, or filtered out by a rule (see
+scraper.SearchFilters) — so a user can see why their feed is empty — and
+summary() adds how many of the inserted offers the user viewed. Rows
+older than RETENTION_DAYS are pruned after each scheduled cycle.
+"""
+
+from __future__ import annotations
+
+import logging
+from collections import Counter
+from dataclasses import dataclass, field
+
+import database
+
+logger = logging.getLogger(__name__)
+
+# Outcomes (the rule column). RED_FLAG's detail is the keyword that matched.
+INSERTED = "INSERTED"
+DUPLICATE = "DUPLICATE"
+RED_FLAG = "RED_FLAG"
+SALARY = "SALARY"
+KEYWORDS = "KEYWORDS"
+CAPPED = "CAPPED"  # left over once SCRAPE_MAX_OFFERS_PER_CONFIG were inserted
+
+RETENTION_DAYS = 90
+DEFAULT_DAYS = 30
+
+
+@dataclass
+class Summary:
+    days: int
+    fetched: int = 0
+    inserted: int = 0
+    duplicates: int = 0
+    # (rule, detail, offers) of the filtered offers, most offers first.
+    filtered: list[tuple[str, str, int]] = field(default_factory=list)
+    # Of the offers inserted in the window, those the user viewed (MarkShown).
+    shown: int = 0
+
+
+async def record(search_config_id: str, outcomes: Counter[tuple[str, str]]) -> None:
+    """Add a scrape's outcomes, keyed (rule, detail), to today's counts.
+    Best effort: failures are logged, never raised."""
+    if not outcomes:
+        return
+    try:
+        pool = await database.get_pool()
+        await pool.executemany(
+            """
+            INSERT INTO scrape_filter_stats (search_config_id, day, rule, detail, offers)
+            VALUES ($1, CURRENT_DATE, $2, $3, $4)
+            ON CONFLICT (search_config_id, day, rule, detail)
+            DO UPDATE SET offers = scrape_filter_stats.offers + EXCLUDED.offers
+            """,
+            [(search_config_id, rule, detail[:255], n) for (rule, detail), n in outcomes.items()],
+        )
+    except Exception as exc:
+        logger.warning("filter stats lost config=%s err=%s", search_config_id, exc)
+
+
+async def summary(search_config_id: str, days: int) -> Summary:
+    """The counts of the last `days` days (today included), at most RETENTION_DAYS."""
+    days = min(days or DEFAULT_DAYS, RETENTION_DAYS)
+    pool = await database.get_pool()
+    rows = await pool.fetch(
+        """
+        SELECT rule, detail, SUM(offers)::INT AS offers
+        FROM scrape_filter_stats
+        WHERE search_config_id = $1 AND day > CURRENT_DATE - $2::INT
+        GROUP BY rule, detail
+        ORDER BY offers DESC, rule, detail
+        """,
+        search_config_id,
+        days,
+    )
+    s = Summary(days=days)
+    for row in rows:
+        rule, detail, offers = row["rule"], row["detail"], row["offers"]
+        s.fetched += offers
+        if rule == INSERTED:
+            s.inserted += offers
+        elif rule == DUPLICATE:
+            s.duplicates += offers
+        else:
+            s.filtered.append((rule, detail, offers))
+    s.shown = await pool.fetchval(
+        """
+        SELECT (SELECT COUNT(*)::INT FROM job_feed
+                WHERE search_config_id = $1 AND shown_at IS NOT NULL
+                  AND created_at >= CURRENT_DATE - $2::INT + 1)  -- uncast: older partitions are skipped
+             + (SELECT COUNT(*)::INT FROM job_feed_matches
+                WHERE search_config_id = $1 AND shown_at IS NOT NULL
+                  AND matched_at >= CURRENT_DATE - $2::INT + 1)
+        """,
+        search_config_id,
+        days,
+    )
+    return s
+
+
+async def prune() -> None:
+    """Delete the counts older than RETENTION_DAYS."""
+    try:
+        pool = await database.get_pool()
+        await pool.execute(
+            "DELETE FROM scrape_filter_stats WHERE day <= CURRENT_DATE - $1::INT",
+            RETENTION_DAYS,
+        )
+    except Exception as exc:
+        logger.warning("filter stats prune failed: %s", exc)
@@ -5,19 +5,29 @@ from __future__ import annotations
 import json
 import logging
 import os
+import uuid
 
 import grpc
 from grpc import aio
 from grpc_reflection.v1alpha import reflection
 
+import audit
 import config
 import database
+import filter_stats
+import offer_stream
 import redis_client
+import request_context
+import scan_progress
+import scheduler
 import scraper
 import url_scraper
 
 logger = logging.getLogger(__name__)
 
+# Most job_feed ids one MarkShown call may carry.
+MAX_MARK_SHOWN = 500
+
 _pb2 = None
 _pb2_grpc = None
 
@@ -75,9 +85,29 @@ async def _verify_search_config_ownership(
     return row is not None
 
 
+def _search_config_status(row) -> object:
+    """SearchConfigStatus of a search_configs row (id, is_active, paused_at)."""
+    status = _pb2.SearchConfigStatus(search_config_id=str(row["id"]))
+    if not row["is_active"]:
+        status.state = "INACTIVE"
+    elif row["paused_at"]:
+        status.state = "PAUSED"
+        status.paused_at = row["paused_at"].isoformat()
+    else:
+        status.state = "ACTIVE"
+        next_run = scheduler.next_run_at()
+        if next_run:
+            status.next_scrape_at = next_run.isoformat()
+    return status
+
+
 class DiscoveryServicer:
+    # RPCs that mutate state (job_feed rows, scans) are audited — but
+    # MarkShown, a view receipt sent for every feed the user scrolls.
 
+    @audit.audited("job_feed")
     async def AddJobByUrl(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -162,7 +192,9 @@ class DiscoveryServicer:
             message="Job added to your inbox",
         )
 
+    @audit.audited("job_feed")
     async def AddJobManually(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -228,39 +260,223 @@ class DiscoveryServicer:
             message="Manual job added to your inbox",
         )
 
+    @audit.audited()
     async def TriggerScan(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
 
-        # Run in background — respond immediately
         import asyncio
 
+        # A user's scan reports its progress (see scan_progress).
         user_filter = request.user_id if request.user_id else None
+        progress = None
+        rows = []
+        if user_filter:
+            pool = await database.get_pool()
+            rows = await pool.fetch(
+                """SELECT id, user_id, job_titles, locations,
+                          keywords, red_flags, salary_min
+                   FROM search_configs
+                   WHERE user_id = $1 AND is_active = TRUE AND paused_at IS NULL""",
+                user_filter,
+            )
+            progress = scan_progress.Progress(user_id=user_filter, configs_total=len(rows))
 
+        # Run in background — respond immediately
         async def _bg():
             try:
                 if user_filter:
-                    pool = await database.get_pool()
-                    rows = await pool.fetch(
-                        """SELECT id, user_id, job_titles, locations
-                           FROM search_configs WHERE user_id = $1 AND is_active = TRUE""",
-                        user_filter,
-                    )
+                    await progress.report()
                     for row in rows:
                         await scraper.run_for_config(
                             str(row["id"]),
                             str(row["user_id"]),
                             list(row["job_titles"] or []),
                             list(row["locations"] or []),
+                            scraper.SearchFilters.from_row(row),
+                            progress,
                         )
+                        await progress.config_done()
                 else:
                     await scraper.run_all()
             except Exception as exc:
                 logger.error("TriggerScan background error: %s", exc)
+            finally:
+                if progress:
+                    await progress.finish()
 
         asyncio.create_task(_bg())
-        return _pb2.TriggerScanResponse(message="Scan triggered")
+        return _pb2.TriggerScanResponse(
+            message="Scan triggered", scan_id=progress.scan_id if progress else ""
+        )
+
+    @audit.audited()
+    async def PauseSearchConfig(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        if not request.search_config_id:
+            await context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT, "search_config_id is required"
+            )
+
+        pool = await database.get_pool()
+        row = await pool.fetchrow(
+            """UPDATE search_configs SET paused_at = COALESCE(paused_at, NOW())
+               WHERE id = $1 AND user_id = $2
+               RETURNING id, is_active, paused_at""",
+            request.search_config_id,
+            uid,
+        )
+        if row is None:
+            await context.abort(grpc.StatusCode.NOT_FOUND, "search config not found")
+        return _search_config_status(row)
+
+    @audit.audited()
+    async def ResumeSearchConfig(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        if not request.search_config_id:
+            await context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT, "search_config_id is required"
+            )
+
+        pool = await database.get_pool()
+        row = await pool.fetchrow(
+            """WITH old AS (
+                   SELECT id, paused_at FROM search_configs
+                   WHERE id = $1 AND user_id = $2
+                   FOR UPDATE
+               )
+               UPDATE search_configs sc SET paused_at = NULL
+               FROM old WHERE sc.id = old.id
+               RETURNING sc.id, sc.is_active, sc.paused_at, old.paused_at AS was_paused_at""",
+            request.search_config_id,
+            uid,
+        )
+        if row is None:
+            await context.abort(grpc.StatusCode.NOT_FOUND, "search config not found")
+
+        # Catch up on what was missed while paused, without waiting for the
+        # next scheduled scrape.
+        if row["was_paused_at"] and row["is_active"]:
+            import asyncio
+
+            async def _bg():
+                try:
+                    await scraper.run_one(request.search_config_id, uid)
+                except Exception as exc:
+                    logger.error("ResumeSearchConfig scan error: %s", exc)
+
+            asyncio.create_task(_bg())
+        return _search_config_status(row)
+
+    async def ListSearchConfigStatuses(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        pool = await database.get_pool()
+        rows = await pool.fetch(
+            """SELECT id, is_active, paused_at FROM search_configs
+               WHERE user_id = $1 ORDER BY created_at""",
+            uid,
+        )
+        return _pb2.ListSearchConfigStatusesResponse(
+            configs=[_search_config_status(row) for row in rows]
+        )
+
+    async def GetFilterStats(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        if not request.search_config_id:
+            await context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT, "search_config_id is required"
+            )
+        if request.days < 0:
+            await context.abort(grpc.StatusCode.INVALID_ARGUMENT, "days must not be negative")
+
+        pool = await database.get_pool()
+        if not await _verify_search_config_ownership(pool, request.search_config_id, uid):
+            await context.abort(grpc.StatusCode.NOT_FOUND, "search config not found")
+
+        s = await filter_stats.summary(request.search_config_id, request.days)
+        return _pb2.GetFilterStatsResponse(
+            days=s.days,
+            fetched=s.fetched,
+            inserted=s.inserted,
+            duplicates=s.duplicates,
+            filtered=[
+                _pb2.FilterRuleCount(rule=rule, detail=detail, offers=offers)
+                for rule, detail, offers in s.filtered
+            ],
+            shown=s.shown,
+        )
+
+    async def MarkShown(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        ids = list(dict.fromkeys(request.job_feed_ids))
+        if len(ids) > MAX_MARK_SHOWN:
+            await context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT,
+                f"at most {MAX_MARK_SHOWN} job_feed_ids per call",
+            )
+        try:
+            for job_feed_id in ids:
+                uuid.UUID(job_feed_id)
+        except ValueError:
+            await context.abort(grpc.StatusCode.INVALID_ARGUMENT, "job_feed_ids must be UUIDs")
+        if not ids:
+            return _pb2.MarkShownResponse()
+
+        pool = await database.get_pool()
+        # Same ownership rule as the feed: the user's search configs, or
+        # the user's own manual jobs — and offers linked to the user's
+        # configs (job_feed_matches), which have their own shown_at.
+        marked = await pool.fetchval(
+            """WITH own AS (
+                 UPDATE job_feed jf SET shown_at = NOW()
+                 WHERE jf.id = ANY($1::uuid[]) AND jf.shown_at IS NULL
+                   AND (jf.user_id = $2
+                        OR jf.search_config_id IN (SELECT id FROM search_configs WHERE user_id = $2))
+                 RETURNING jf.id
+               ), linked AS (
+                 UPDATE job_feed_matches m SET shown_at = NOW()
+                 WHERE m.job_feed_id = ANY($1::uuid[]) AND m.shown_at IS NULL
+                   AND m.search_config_id IN (SELECT id FROM search_configs WHERE user_id = $2)
+                 RETURNING m.job_feed_id AS id
+               )
+               SELECT COUNT(DISTINCT id)::INT
+               FROM (SELECT id FROM own UNION ALL SELECT id FROM linked) t""",
+            ids,
+            uid,
+        )
+        return _pb2.MarkShownResponse(marked=marked)
+
+    async def WatchNewOffers(self, request, context):
+        request_context.bind(context.invocation_metadata())
+        uid = _user_id_from_ctx(context)
+        if not uid:
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
+
+        # Runs until the client cancels the call.
+        async for offer in offer_stream.watch(uid):
+            yield _pb2.NewOffer(**offer)
 
 
 async def serve():
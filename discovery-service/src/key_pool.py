@@ -0,0 +1,114 @@
+"""Rotation among the configured Adzuna keys, with per-key daily quotas.
+
+Each request goes to the key that made the fewest requests today (UTC), as
+counted in Redis — so the counts are shared by every replica and survive
+restarts — and a key stops being used once it made
+ADZUNA_DAILY_QUOTA_PER_KEY requests, or Adzuna answered 429, until the next
+day. Keys are identified by their app_id: app_keys are never stored.
+"""
+
+from __future__ import annotations
+
+import logging
+from datetime import UTC, datetime
+
+import redis.asyncio as aioredis
+
+import config
+import credentials
+import redis_client
+from credentials import AdzunaCredentials
+
+logger = logging.getLogger(__name__)
+
+_PREFIX = "discovery:adzuna_quota"
+_TTL = 2 * 86400
+
+_exhausted_logged_on: str | None = None  # the day all keys ran out, logged once
+
+
+def _today() -> str:
+    return datetime.now(UTC).date().isoformat()
+
+
+def _counter(day: str, key: AdzunaCredentials) -> str:
+    return f"{_PREFIX}:{day}:{key.app_id}"
+
+
+def _rested(day: str, key: AdzunaCredentials) -> str:
+    return f"{_PREFIX}:{day}:{key.app_id}:rested"
+
+
+async def _usage(day: str, keys: list[AdzunaCredentials]) -> list[tuple[int, bool]]:
+    """(requests today, rate-limited today) of each key."""
+    values = await redis_client.get_client().mget(
+        [_counter(day, k) for k in keys] + [_rested(day, k) for k in keys]
+    )
+    return [(int(u or 0), r is not None) for u, r in zip(values[: len(keys)], values[len(keys) :], strict=True)]
+
+
+async def acquire() -> AdzunaCredentials | None:
+    """
+    Pick the key for one request and count the request on it. None when no
+    key is configured, or all of them are used up for today.
+    """
+    global _exhausted_logged_on
+    keys = credentials.adzuna()
+    if not keys:
+        return None
+    quota = config.ADZUNA_DAILY_QUOTA_PER_KEY
+    day = _today()
+    rdb = redis_client.get_client()
+    try:
+        usage = await _usage(day, keys)
+        candidates = sorted(
+            (
+                (used, key)
+                for (used, rested), key in zip(usage, keys, strict=True)
+                if not rested and (not quota or used < quota)
+            ),
+            key=lambda c: c[0],
+        )
+        # Least used first; a concurrent request may take the last request of
+        # a key between the read and the increment, hence the check after it.
+        for _, key in candidates:
+            n = await rdb.incr(_counter(day, key))
+            if n == 1:
+                await rdb.expire(_counter(day, key), _TTL)
+            if not quota or n <= quota:
+                return key
+            await rdb.decr(_counter(day, key))
+    except aioredis.RedisError as exc:
+        # Without the counters, keep scraping with the first key.
+        logger.warning("Adzuna quota tracking unavailable: %s", exc)
+        return keys[0]
+
+    if _exhausted_logged_on != day:
+        _exhausted_logged_on = day
+        logger.warning("All %d Adzuna keys are used up for today", len(keys))
+    return None
+
+
+async def exhaust(key: AdzunaCredentials) -> None:
+    """Stop using key until tomorrow: Adzuna rate-limited it."""
+    logger.warning("Adzuna rate-limited key %s, resting it until tomorrow", key.app_id)
+    try:
+        await redis_client.get_client().set(_rested(_today(), key), "1", ex=_TTL)
+    except aioredis.RedisError as exc:
+        logger.warning("Adzuna quota tracking unavailable: %s", exc)
+
+
+async def report() -> list[dict]:
+    """Today's usage of each key, for GET /status."""
+    keys = credentials.adzuna()
+    if not keys:
+        return []
+    try:
+        usage = await _usage(_today(), keys)
+    except aioredis.RedisError:
+        return [{"appId": k.app_id} for k in keys]
+    quota = config.ADZUNA_DAILY_QUOTA_PER_KEY or None
+    return [
+        {"appId": k.app_id, "requestsToday": used, "dailyQuota": quota, "rateLimited": rested}
+        for k, (used, rested) in zip(keys, usage, strict=True)
+    ]
@@ -1,4 +1,4 @@
-"""Entry-point for discovery-service: scheduler + gRPC + HTTP health."""
+"""Entry-point for discovery-service: scheduler + gRPC + Redis consumer + HTTP health."""
 
 from __future__ import annotations
 
@@ -7,11 +7,16 @@ import logging
 
 import uvicorn
 from fastapi import FastAPI
+from prometheus_client import make_asgi_app
 from pythonjsonlogger import jsonlogger
 
 import config
 import database
 import grpc_server
+import key_pool
+import provider_stats
+import redis_consumer
+import request_context
 import scheduler
 
 
@@ -21,6 +26,7 @@ def _configure_logging() -> None:
         "%(asctime)s %(name)s %(levelname)s %(message)s"
     )
     handler.setFormatter(formatter)
+    handler.addFilter(request_context.LogFilter())
     root = logging.getLogger()
     root.handlers.clear()
     root.addHandler(handler)
@@ -35,6 +41,24 @@ async def health():
     return {"status": "ok", "service": "discovery-service"}
 
 
+@app.get("/status")
+async def status():
+    """Per-provider request statistics since startup, today's use of the
+    Adzuna keys, and the next scrape."""
+    next_run = scheduler.next_run_at()
+    return {
+        "status": "ok",
+        "service": "discovery-service",
+        "nextScrapeAt": next_run.isoformat() if next_run else None,
+        "providers": provider_stats.report(),
+        "adzunaKeys": await key_pool.report(),
+    }
+
+
+# Prometheus metrics (provider_stats).
+app.mount("/metrics", make_asgi_app())
+
+
 async def _main() -> None:
     _configure_logging()
     logger = logging.getLogger(__name__)
@@ -52,6 +76,7 @@ async def _main() -> None:
 
     await asyncio.gather(
         grpc_server.serve(),
+        redis_consumer.start(),
         http_server.serve(),
     )
 
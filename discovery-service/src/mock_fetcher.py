@@ -0,0 +1,67 @@
+"""Canned job offers for local development (MOCK_FETCHER=true).
+
+Instead of calling Adzuna, a search is answered with offers shaped like
+Adzuna's results, assembled from the fixtures in fixtures/mock_offers.json:
+realistic companies, stacks and salaries, with the odd red flag and
+unknown salary so the filters have something to do. Offers are derived
+from MOCK_FETCHER_SEED and the search alone, so the same search returns
+the same offers (and source URLs): a second scrape only finds duplicates.
+"""
+
+from __future__ import annotations
+
+import hashlib
+import json
+import os
+import random
+from datetime import UTC, datetime, timedelta
+from functools import cache
+
+import config
+
+FIXTURES = os.path.join(os.path.dirname(os.path.abspath(__file__)), "fixtures", "mock_offers.json")
+
+MIN_OFFERS = 5
+MAX_OFFERS = 25
+RED_FLAG_RATE = 0.1
+
+
+@cache
+def _fixtures() -> dict:
+    with open(FIXTURES, encoding="utf-8") as f:
+        return json.load(f)
+
+
+def search(job_title: str, location: str) -> list[dict]:
+    """The offers of a search, as Adzuna search results."""
+    fx = _fixtures()
+    rng = random.Random(f"{config.MOCK_FETCHER_SEED}:{job_title.lower()}:{location.lower()}")
+    today = datetime.now(UTC).replace(hour=9, minute=0, second=0, microsecond=0)
+    results = []
+    for i in range(rng.randint(MIN_OFFERS, MAX_OFFERS)):
+        company = rng.choice(fx["companies"])
+        title = f"{rng.choice(fx['seniorities'])}{job_title}".strip()
+        salary_min, salary_max = rng.choice(fx["salary_ranges"])
+        paragraphs = [company["pitch"], rng.choice(fx["missions"]), rng.choice(fx["stacks"])]
+        if rng.random() < RED_FLAG_RATE:
+            paragraphs.append(rng.choice(fx["red_flags"]))
+        paragraphs.append(rng.choice(fx["perks"]))
+        offer_id = hashlib.sha1(
+            f"{config.MOCK_FETCHER_SEED}:{job_title}:{location}:{i}".encode()
+        ).hexdigest()[:12]
+        results.append(
+            {
+                "id": offer_id,
+                "title": title,
+                "description": " ".join(paragraphs),
+                "company": {"display_name": company["name"]},
+                "location": {"display_name": location, "area": ["France", location]},
+                "salary_min": salary_min or None,
+                "salary_max": salary_max or None,
+                "contract_type": rng.choice(fx["contract_types"]),
+                "created": (today - timedelta(days=rng.randint(0, 20))).isoformat(),
+                "redirect_url": f"https://mock-jobs.jobmate.local/offers/{offer_id}",
+                "mock": True,
+            }
+        )
+    return results
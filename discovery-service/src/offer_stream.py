@@ -0,0 +1,41 @@
+"""Live feed of the offers a scrape inserts, for WatchNewOffers.
+
+Every offer the scraper inserts is published on the user's Redis Pub/Sub
+channel; WatchNewOffers subscribes to it, so a watcher hears the offers of
+every replica's scrapes. Pub/Sub keeps nothing: offers inserted while
+nobody watches are only in the feed — unlike EVENT_JOB_DISCOVERED, this is
+a live view, not a delivery guarantee.
+"""
+
+from __future__ import annotations
+
+import json
+import logging
+from collections.abc import AsyncIterator
+
+import redis_client
+
+logger = logging.getLogger(__name__)
+
+_CHANNEL = "discovery:new_offers:{}"
+
+
+async def publish(user_id: str, offer: dict) -> None:
+    """Send an inserted offer to the user's watchers. Best effort."""
+    try:
+        await redis_client.get_client().publish(_CHANNEL.format(user_id), json.dumps(offer))
+    except Exception as exc:
+        logger.warning("new offer not streamed user=%s err=%s", user_id, exc)
+
+
+async def watch(user_id: str) -> AsyncIterator[dict]:
+    """The offers inserted for the user from now on, until the caller stops."""
+    pubsub = redis_client.get_client().pubsub()
+    await pubsub.subscribe(_CHANNEL.format(user_id))
+    try:
+        async for message in pubsub.listen():
+            if message["type"] == "message":
+                yield json.loads(message["data"])
+    finally:
+        await pubsub.unsubscribe()
+        await pubsub.aclose()
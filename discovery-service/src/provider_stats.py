@@ -0,0 +1,117 @@
+"""Per-provider request metrics, so a job board integration that silently
+degrades shows up.
+
+Every request to a provider (the Adzuna API, the pages fetched by
+AddJobByUrl) is counted with its outcome and latency, in memory for
+GET /status and as Prometheus metrics for GET /metrics. Counts start over
+when the service restarts.
+"""
+
+from __future__ import annotations
+
+import contextlib
+import time
+from dataclasses import dataclass
+from datetime import UTC, datetime
+
+from prometheus_client import Counter, Gauge, Histogram
+
+ADZUNA = "adzuna"
+URL = "url"  # pages fetched by AddJobByUrl
+
+# Consecutive failures after which a provider is reported "down" rather
+# than "degraded".
+DOWN_AFTER_FAILURES = 5
+
+_requests = Counter(
+    "discovery_provider_requests",
+    "Requests to job providers, by outcome (ok, error).",
+    ["provider", "outcome"],
+)
+_latency = Histogram(
+    "discovery_provider_request_duration_seconds",
+    "Latency of the requests to job providers.",
+    ["provider"],
+)
+_last_success = Gauge(
+    "discovery_provider_last_success_timestamp_seconds",
+    "Unix time of the last successful request to a job provider.",
+    ["provider"],
+)
+
+
+@dataclass
+class _Stats:
+    requests: int = 0
+    errors: int = 0
+    total_latency: float = 0.0
+    consecutive_failures: int = 0
+    last_success_at: datetime | None = None
+    last_error_at: datetime | None = None
+    last_error: str = ""
+
+
+_stats: dict[str, _Stats] = {}
+
+
+@contextlib.asynccontextmanager
+async def track(provider: str):
+    """Count the request made in the block: an error if it raises."""
+    start = time.monotonic()
+    try:
+        yield
+    except Exception as exc:
+        _record(provider, time.monotonic() - start, exc)
+        raise
+    _record(provider, time.monotonic() - start, None)
+
+
+def _record(provider: str, latency: float, exc: Exception | None) -> None:
+    s = _stats.setdefault(provider, _Stats())
+    now = datetime.now(UTC)
+    s.requests += 1
+    s.total_latency += latency
+    _latency.labels(provider).observe(latency)
+    if exc is None:
+        s.consecutive_failures = 0
+        s.last_success_at = now
+        _requests.labels(provider, "ok").inc()
+        _last_success.labels(provider).set(now.timestamp())
+    else:
+        s.errors += 1
+        s.consecutive_failures += 1
+        s.last_error_at = now
+        s.last_error = _describe(exc)
+        _requests.labels(provider, "error").inc()
+
+
+def _describe(exc: Exception) -> str:
+    """The error, without its message: request URLs carry API keys."""
+    response = getattr(exc, "response", None)
+    if response is not None:
+        return f"HTTP {response.status_code}"
+    return type(exc).__name__
+
+
+def report() -> dict:
+    """The providers' statistics since startup, for GET /status."""
+    return {provider: _report(s) for provider, s in sorted(_stats.items())}
+
+
+def _report(s: _Stats) -> dict:
+    if s.consecutive_failures == 0:
+        status = "up"
+    elif s.consecutive_failures < DOWN_AFTER_FAILURES:
+        status = "degraded"
+    else:
+        status = "down"
+    return {
+        "status": status,
+        "requests": s.requests,
+        "errors": s.errors,
+        "errorRate": round(s.errors / s.requests, 4),
+        "avgLatencyMs": round(s.total_latency / s.requests * 1000, 1),
+        "lastSuccessAt": s.last_success_at.isoformat() if s.last_success_at else None,
+        "lastErrorAt": s.last_error_at.isoformat() if s.last_error_at else None,
+        "lastError": s.last_error or None,
+    }
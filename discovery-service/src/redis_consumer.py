@@ -0,0 +1,103 @@
+"""
+Redis Streams consumer.
+
+Reads, as consumer group "discovery":
+  - CMD_SCRAPE_CONFIG → scraper.run_for_config for that search config, when
+    it is still active and not paused (the Tracker sends it when a search is
+    reactivated, so discovery resumes without waiting for the next scheduled
+    scrape)
+
+Message payload (JSON, in the entry's "payload" field):
+
+  CMD_SCRAPE_CONFIG:
+    { "searchConfigId": "<uuid>", "userId": "<uuid>" }
+
+Commands are acknowledged once handled, successfully or not; a scrape that
+fails is picked up by the next scheduled one anyway.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import json
+import logging
+import socket
+
+import redis.asyncio as aioredis
+from redis.exceptions import ResponseError
+
+import redis_client
+import request_context
+import scraper
+
+logger = logging.getLogger(__name__)
+
+STREAMS = ["CMD_SCRAPE_CONFIG"]
+GROUP = "discovery"
+CONSUMER = socket.gethostname()
+
+
+async def start() -> None:
+    """Consume the command streams forever; run as an asyncio task."""
+    rdb = redis_client.get_client()
+    for stream in STREAMS:
+        try:
+            await rdb.xgroup_create(stream, GROUP, id="$", mkstream=True)
+        except ResponseError as exc:
+            if "BUSYGROUP" not in str(exc):
+                raise
+    logger.info("Consuming Redis streams %s as %s/%s", STREAMS, GROUP, CONSUMER)
+
+    while True:
+        try:
+            entries = await rdb.xreadgroup(
+                GROUP, CONSUMER, {s: ">" for s in STREAMS}, count=10, block=5000
+            )
+        except aioredis.ConnectionError as exc:
+            logger.error("Redis read failed: %s", exc)
+            await asyncio.sleep(2)
+            continue
+
+        for stream, messages in entries or []:
+            for msg_id, fields in messages:
+                await _handle(rdb, stream, msg_id, fields)
+
+
+async def _handle(rdb: aioredis.Redis, stream: str, msg_id: str, fields) -> None:
+    raw = (fields or {}).get("payload", "")
+    try:
+        payload = json.loads(raw)
+    except json.JSONDecodeError:
+        logger.error("Invalid JSON on stream %s: %s", stream, raw)
+        payload = None
+
+    if isinstance(payload, dict):
+        request_context.request_id.set(request_context.from_payload(payload))
+        try:
+            if stream == "CMD_SCRAPE_CONFIG":
+                await _scrape_config(payload)
+            else:
+                logger.warning("Unhandled stream: %s", stream)
+        except Exception as exc:
+            logger.error("%s %s failed: %s", stream, msg_id, exc)
+        finally:
+            request_context.request_id.set("")
+
+    try:
+        await rdb.xack(stream, GROUP, msg_id)
+    except aioredis.RedisError as exc:
+        logger.warning("XACK failed for %s %s: %s", stream, msg_id, exc)
+
+
+async def _scrape_config(payload: dict) -> None:
+    config_id = payload.get("searchConfigId")
+    user_id = payload.get("userId")
+    if not config_id or not user_id:
+        logger.error("CMD_SCRAPE_CONFIG missing required fields: %s", payload)
+        return
+
+    inserted = await scraper.run_one(config_id, user_id)
+    if inserted is None:
+        logger.info("CMD_SCRAPE_CONFIG: search %s is gone, inactive or paused", config_id)
+        return
+    logger.info("Scanned reopened search %s: %d new jobs", config_id, inserted)
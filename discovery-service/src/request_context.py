@@ -0,0 +1,52 @@
+"""
+Request ID of the user action being served, for correlating logs and events
+across services (the Gateway, the tracker, the AI coach).
+
+The ID arrives in the "x-request-id" gRPC metadata (bind) or in the command
+being consumed (from_payload); while set, every log line carries it as
+"requestId" (LogFilter) and every published event includes it
+(redis_client.publish). Tasks started while serving a request
+inherit it, as asyncio copies context variables into new tasks.
+"""
+
+from __future__ import annotations
+
+import contextvars
+import logging
+import re
+
+METADATA_KEY = "x-request-id"
+FIELD = "requestId"
+
+# Same rule as the tracker: printable ASCII, at most 128 characters.
+_VALID = re.compile(r"[\x21-\x7e]{1,128}")
+
+request_id: contextvars.ContextVar[str] = contextvars.ContextVar("request_id", default="")
+
+
+def is_valid(value: object) -> bool:
+    return isinstance(value, str) and _VALID.fullmatch(value) is not None
+
+
+def bind(metadata) -> None:
+    """Set the request ID from gRPC invocation metadata, if it carries one."""
+    for key, val in metadata or ():
+        if key == METADATA_KEY and is_valid(val):
+            request_id.set(val)
+            return
+
+
+def from_payload(payload: dict) -> str:
+    """The request ID a command payload carries, "" if none or invalid."""
+    value = payload.get(FIELD)
+    return value if is_valid(value) else ""
+
+
+class LogFilter(logging.Filter):
+    """Adds the current request ID, when there is one, to log records."""
+
+    def filter(self, record: logging.LogRecord) -> bool:
+        rid = request_id.get()
+        if rid:
+            setattr(record, FIELD, rid)
+        return True
@@ -0,0 +1,68 @@
+"""Live progress of an on-demand scan (TriggerScan), for a progress bar.
+
+While the scan runs, EVENT_SCAN_PROGRESS is published as it starts, after
+every search (title × location) of every config — the Gateway forwards it
+to the user's SSE stream — and once more, with done set, when it is over:
+
+    { "scanId": "<uuid>", "userId": "<uuid>", "configsDone": 1,
+      "configsTotal": 3, "searchConfigId": "<uuid>", "provider": "adzuna",
+      "fetched": 120, "inserted": 14, "done": false }
+
+Counts are those of the whole scan so far. Scheduled scrapes report no
+progress: nobody is waiting on them.
+"""
+
+from __future__ import annotations
+
+import uuid
+from dataclasses import dataclass, field
+
+import config
+import provider_stats
+import redis_client
+
+STREAM = "EVENT_SCAN_PROGRESS"
+
+
+@dataclass
+class Progress:
+    user_id: str
+    configs_total: int
+    scan_id: str = field(default_factory=lambda: str(uuid.uuid4()))
+    configs_done: int = 0
+    search_config_id: str = ""
+    fetched: int = 0
+    inserted: int = 0
+    done: bool = False
+
+    async def searched(self, search_config_id: str, fetched: int, inserted: int) -> None:
+        """Count one search of a config: offers fetched, new ones inserted."""
+        self.search_config_id = search_config_id
+        self.fetched += fetched
+        self.inserted += inserted
+        await self.report()
+
+    async def config_done(self) -> None:
+        self.configs_done += 1
+        await self.report()
+
+    async def finish(self) -> None:
+        self.done = True
+        await self.report()
+
+    async def report(self) -> None:
+        """Publish the progress so far."""
+        await redis_client.publish(
+            STREAM,
+            {
+                "scanId": self.scan_id,
+                "userId": self.user_id,
+                "configsDone": self.configs_done,
+                "configsTotal": self.configs_total,
+                "searchConfigId": self.search_config_id,
+                "provider": "mock" if config.MOCK_FETCHER else provider_stats.ADZUNA,
+                "fetched": self.fetched,
+                "inserted": self.inserted,
+                "done": self.done,
+            },
+        )
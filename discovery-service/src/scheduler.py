@@ -1,12 +1,14 @@
-"""APScheduler setup for periodic Adzuna scraping."""
+"""APScheduler setup for periodic Adzuna scraping and job_feed partition maintenance."""
 
 from __future__ import annotations
 
 import logging
+from datetime import UTC, datetime
 
 from apscheduler.schedulers.asyncio import AsyncIOScheduler
 
 import config
+import feed_partitions
 import scraper
 
 logger = logging.getLogger(__name__)
@@ -32,11 +34,25 @@ def start() -> AsyncIOScheduler:
         id="adzuna_scrape",
         replace_existing=True,
     )
+    _scheduler.add_job(
+        feed_partitions.maintain,
+        trigger="interval",
+        days=1,
+        next_run_time=datetime.now(UTC),  # and right away
+        id="job_feed_partitions",
+        replace_existing=True,
+    )
     _scheduler.start()
     logger.info("Scheduler started (interval=%sh)", config.SCRAPE_INTERVAL_HOURS)
     return _scheduler
 
 
+def next_run_at() -> datetime | None:
+    """When the next scheduled scrape starts, or None if the scheduler is not running."""
+    job = _scheduler.get_job("adzuna_scrape") if _scheduler else None
+    return job.next_run_time if job else None
+
+
 def stop() -> None:
     if _scheduler and _scheduler.running:
         _scheduler.shutdown(wait=False)
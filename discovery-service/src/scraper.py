@@ -2,15 +2,24 @@
 
 from __future__ import annotations
 
+import asyncio
 import json
 import logging
+from collections import Counter
 from dataclasses import dataclass, field
+from datetime import UTC, datetime
 
 import httpx
 
 import config
 import database
+import filter_stats
+import key_pool
+import mock_fetcher
+import offer_stream
+import provider_stats
 import redis_client
+import scan_progress
 
 logger = logging.getLogger(__name__)
 
@@ -19,6 +28,9 @@ PAGE_SIZE = 50
 MAX_PAGES = 3
 HTTP_TIMEOUT = 15.0
 
+# Caps the provider requests in flight across all scrapes.
+_requests = asyncio.Semaphore(config.SCRAPE_MAX_CONCURRENT_REQUESTS)
+
 
 @dataclass
 class JobResult:
@@ -33,20 +45,65 @@ class JobResult:
     raw_data: dict = field(default_factory=dict)
 
 
-def _has_red_flag(text: str) -> bool:
+@dataclass
+class SearchFilters:
+    """The filter rules of a search config, applied to its scraped offers."""
+
+    keywords: list[str] = field(default_factory=list)  # at least one must appear
+    red_flags: list[str] = field(default_factory=list)  # on top of RED_FLAG_KEYWORDS
+    salary_min: int | None = None
+
+    @classmethod
+    def from_row(cls, row) -> SearchFilters:
+        """Read the keywords, red_flags and salary_min columns of a search_configs row."""
+        return cls(
+            keywords=[k.lower() for k in row["keywords"] or [] if k.strip()],
+            red_flags=[k.lower() for k in row["red_flags"] or [] if k.strip()],
+            salary_min=row["salary_min"],
+        )
+
+
+def _red_flag(text: str, extra: list[str] | None = None) -> str | None:
+    """The first red-flag keyword (RED_FLAG_KEYWORDS, then extra) found in text, or None."""
     lower = text.lower()
-    return any(kw in lower for kw in config.RED_FLAG_KEYWORDS)
+    keywords = [*config.RED_FLAG_KEYWORDS, *(extra or [])]
+    return next((kw for kw in keywords if kw in lower), None)
+
+
+def _has_red_flag(text: str) -> bool:
+    return _red_flag(text) is not None
+
+
+def _filter_reason(job: JobResult, filters: SearchFilters) -> tuple[str, str] | None:
+    """
+    The rule that filters job out of its config's feed, as (rule, detail) — see
+    filter_stats — or None if it passes them all. An offer without a salary
+    passes the salary rule.
+    """
+    text = f"{job.title} {job.description}"
+    kw = _red_flag(text, filters.red_flags)
+    if kw:
+        return filter_stats.RED_FLAG, kw
+    salary = max(job.salary_min, job.salary_max)
+    if filters.salary_min and 0 < salary < filters.salary_min:
+        return filter_stats.SALARY, ""
+    if filters.keywords:
+        lower = text.lower()
+        if not any(k in lower for k in filters.keywords):
+            return filter_stats.KEYWORDS, ""
+    return None
 
 
 async def _fetch_page(
     client: httpx.AsyncClient, job_title: str, location: str, page: int
 ) -> list[JobResult]:
-    if not config.ADZUNA_APP_ID or not config.ADZUNA_APP_KEY:
+    key = await key_pool.acquire()
+    if key is None:
         return []
 
     params = {
-        "app_id": config.ADZUNA_APP_ID,
-        "app_key": config.ADZUNA_APP_KEY,
+        "app_id": key.app_id,
+        "app_key": key.app_key,
         "results_per_page": PAGE_SIZE,
         "what": job_title,
         "where": location,
@@ -54,32 +111,40 @@ async def _fetch_page(
     }
     url = f"{ADZUNA_BASE}/{config.ADZUNA_COUNTRY}/search/{page}"
     try:
-        resp = await client.get(url, params=params, timeout=HTTP_TIMEOUT)
-        resp.raise_for_status()
-        data = resp.json()
+        async with _requests, provider_stats.track(provider_stats.ADZUNA):
+            resp = await client.get(url, params=params, timeout=HTTP_TIMEOUT)
+            resp.raise_for_status()
+            data = resp.json()
+    except httpx.HTTPStatusError as exc:
+        if exc.response.status_code == 429:
+            await key_pool.exhaust(key)
+        logger.warning("Adzuna fetch error page=%d key=%s: HTTP %d", page, key.app_id, exc.response.status_code)
+        return []
     except Exception as exc:
         logger.warning("Adzuna fetch error page=%d: %s", page, exc)
         return []
 
-    results: list[JobResult] = []
-    for r in data.get("results", []):
-        results.append(
-            JobResult(
-                external_id=str(r.get("id", "")),
-                title=r.get("title", ""),
-                description=r.get("description", ""),
-                company_name=(r.get("company") or {}).get("display_name", ""),
-                location=(r.get("location") or {}).get("display_name", ""),
-                salary_min=float(r.get("salary_min") or 0),
-                salary_max=float(r.get("salary_max") or 0),
-                source_url=r.get("redirect_url", ""),
-                raw_data=r,
-            )
-        )
-    return results
+    return [_job_result(r) for r in data.get("results", [])]
+
+
+def _job_result(r: dict) -> JobResult:
+    """JobResult of an Adzuna search result."""
+    return JobResult(
+        external_id=str(r.get("id", "")),
+        title=r.get("title", ""),
+        description=r.get("description", ""),
+        company_name=(r.get("company") or {}).get("display_name", ""),
+        location=(r.get("location") or {}).get("display_name", ""),
+        salary_min=float(r.get("salary_min") or 0),
+        salary_max=float(r.get("salary_max") or 0),
+        source_url=r.get("redirect_url", ""),
+        raw_data=r,
+    )
 
 
 async def _fetch_all(job_title: str, location: str) -> list[JobResult]:
+    if config.MOCK_FETCHER:
+        return [_job_result(r) for r in mock_fetcher.search(job_title, location)]
     async with httpx.AsyncClient() as client:
         results: list[JobResult] = []
         for page in range(1, MAX_PAGES + 1):
@@ -97,25 +162,44 @@ async def _upsert_job(
     user_id: str,
 ) -> str | None:
     """
-    Insert a job into job_feed, skipping if the source_url already exists.
-    Returns the new job_feed row id, or None if skipped.
+    Put a job in the config's feed and return its job_feed row id, or None
+    if it is already there. A live offer (same source_url) another config
+    found first is not copied: the config is linked to that row
+    (job_feed_matches) and the row's id is returned.
     """
-    row = await pool.fetchrow(
+    existing = await pool.fetchrow(
         """
-        WITH existing AS (
-            SELECT id
-            FROM job_feed
-            WHERE search_config_id = $1
-              AND source_url = $4
-            LIMIT 1
+        SELECT id, COALESCE(search_config_id = $1, FALSE) AS own, expires_at > NOW() AS live
+        FROM job_feed
+        WHERE source_url = $2 AND NOT is_manual
+        ORDER BY 2 DESC, 3 DESC, created_at DESC
+        LIMIT 1
+        """,
+        search_config_id,
+        job.source_url,
+    )
+    if existing and existing["own"]:
+        return None
+    if existing and existing["live"]:
+        linked = await pool.fetchval(
+            """
+            INSERT INTO job_feed_matches (job_feed_id, search_config_id)
+            VALUES ($1, $2)
+            ON CONFLICT DO NOTHING
+            RETURNING job_feed_id
+            """,
+            existing["id"],
+            search_config_id,
         )
-                INSERT INTO job_feed
-                    (user_id, search_config_id, title, description, source_url,
-                     status, raw_data, company_name, is_manual)
-                SELECT $2, $1, $3, $5, $4,
-                             'PENDING', $6, $7, FALSE
-                WHERE NOT EXISTS (SELECT 1 FROM existing)
-                RETURNING id
+        return str(linked) if linked else None
+
+    row = await pool.fetchrow(
+        """
+        INSERT INTO job_feed
+            (user_id, search_config_id, title, description, source_url,
+             status, raw_data, company_name, is_manual)
+        VALUES ($2, $1, $3, $5, $4, 'PENDING', $6, $7, FALSE)
+        RETURNING id
         """,
         search_config_id,
         user_id,
@@ -125,59 +209,150 @@ async def _upsert_job(
         json.dumps(job.raw_data),
         job.company_name or None,
     )
-    return str(row["id"]) if row else None
+    return str(row["id"])
+
+
+def _new_offer(job_feed_id: str, search_config_id: str, job: JobResult) -> dict:
+    """The NewOffer (discovery.proto) of an inserted job, as sent to watchers."""
+    return {
+        "job_feed_id": job_feed_id,
+        "search_config_id": search_config_id,
+        "title": (job.title or "").strip() or "Untitled job",
+        "company_name": job.company_name or "",
+        "location": job.location or "",
+        "source_url": job.source_url,
+        "salary_min": job.salary_min,
+        "salary_max": job.salary_max,
+        "inserted_at": datetime.now(UTC).isoformat(),
+    }
 
 
 async def run_for_config(
-    search_config_id: str, user_id: str, job_titles: list[str], locations: list[str]
+    search_config_id: str,
+    user_id: str,
+    job_titles: list[str],
+    locations: list[str],
+    filters: SearchFilters | None = None,
+    progress: scan_progress.Progress | None = None,
 ) -> int:
     """
     Scrape Adzuna for a specific search config and insert results.
-    Returns the number of new jobs inserted.
+    Returns the number of new jobs inserted — offers another config found
+    first and linked to this one included. What became of every offer
+    fetched is added to the config's filter statistics, and each search is
+    reported to progress, if given.
+
+    At most SCRAPE_MAX_SEARCHES_PER_CONFIG title × location pairs are
+    searched, and the scrape stops once SCRAPE_MAX_OFFERS_PER_CONFIG offers
+    were inserted: the rest of that search's offers count as CAPPED and are
+    picked up by a later scrape.
     """
     pool = await database.get_pool()
+    filters = filters or SearchFilters()
     inserted = 0
+    outcomes: Counter[tuple[str, str]] = Counter()
+
+    searches = [(t, loc) for t in job_titles for loc in locations]
+    max_searches = config.SCRAPE_MAX_SEARCHES_PER_CONFIG
+    if max_searches and len(searches) > max_searches:
+        logger.info(
+            "Config %s: searching %d of its %d title × location pairs",
+            search_config_id, max_searches, len(searches),
+        )
+        searches = searches[:max_searches]
+    max_offers = config.SCRAPE_MAX_OFFERS_PER_CONFIG
 
-    for title in job_titles:
-        for location in locations:
-            jobs = await _fetch_all(title, location)
-            for job in jobs:
-                combined = f"{job.title} {job.description}"
-                if _has_red_flag(combined):
-                    logger.debug("Red flag filtered: %s", job.title)
-                    continue
-                jid = await _upsert_job(pool, job, search_config_id, user_id)
-                if jid:
-                    inserted += 1
-                    await redis_client.publish(
-                        "EVENT_JOB_DISCOVERED",
-                        {
-                            "jobFeedId": jid,
-                            "userId": user_id,
-                            "searchConfigId": search_config_id,
-                        },
-                    )
+    for title, location in searches:
+        jobs = await _fetch_all(title, location)
+        inserted_before = inserted
+        for i, job in enumerate(jobs):
+            if max_offers and inserted >= max_offers:
+                outcomes[filter_stats.CAPPED, ""] += len(jobs) - i
+                break
+            reason = _filter_reason(job, filters)
+            if reason:
+                logger.debug("Filtered (%s %s): %s", *reason, job.title)
+                outcomes[reason] += 1
+                continue
+            jid = await _upsert_job(pool, job, search_config_id, user_id)
+            if not jid:
+                outcomes[filter_stats.DUPLICATE, ""] += 1
+                continue
+            inserted += 1
+            outcomes[filter_stats.INSERTED, ""] += 1
+            await redis_client.publish(
+                "EVENT_JOB_DISCOVERED",
+                {
+                    "jobFeedId": jid,
+                    "userId": user_id,
+                    "searchConfigId": search_config_id,
+                },
+            )
+            await offer_stream.publish(user_id, _new_offer(jid, search_config_id, job))
+        if progress:
+            await progress.searched(search_config_id, len(jobs), inserted - inserted_before)
+        if max_offers and inserted >= max_offers:
+            logger.info("Config %s: reached %d offers this scrape", search_config_id, max_offers)
+            break
 
+    await filter_stats.record(search_config_id, outcomes)
     logger.info("Scrape done config=%s inserted=%d", search_config_id, inserted)
     return inserted
 
 
+async def run_one(search_config_id: str, user_id: str) -> int | None:
+    """
+    Scrape one of user_id's search configs now. Returns the number of new
+    jobs inserted, or None if the config is gone, inactive or paused.
+    """
+    pool = await database.get_pool()
+    row = await pool.fetchrow(
+        """SELECT id, user_id, job_titles, locations, keywords, red_flags, salary_min
+           FROM search_configs
+           WHERE id = $1 AND user_id = $2 AND is_active = TRUE AND paused_at IS NULL""",
+        search_config_id,
+        user_id,
+    )
+    if row is None:
+        return None
+    return await run_for_config(
+        str(row["id"]),
+        str(row["user_id"]),
+        list(row["job_titles"] or []),
+        list(row["locations"] or []),
+        SearchFilters.from_row(row),
+    )
+
+
 async def run_all() -> None:
-    """Automatic scheduled scrape: iterate all active search configs."""
+    """Automatic scheduled scrape: iterate all active search configs not paused."""
     pool = await database.get_pool()
     rows = await pool.fetch(
         """
-        SELECT sc.id, p.user_id, sc.job_titles, sc.locations
+        SELECT sc.id, p.user_id, sc.job_titles, sc.locations,
+               sc.keywords, sc.red_flags, sc.salary_min
         FROM search_configs sc
         JOIN profiles p ON p.user_id = sc.user_id
-        WHERE sc.is_active = TRUE
+        WHERE sc.is_active = TRUE AND sc.paused_at IS NULL
         """,
     )
     logger.info("Scheduled scrape: %d active configs", len(rows))
-    for row in rows:
-        await run_for_config(
-            search_config_id=str(row["id"]),
-            user_id=str(row["user_id"]),
-            job_titles=list(row["job_titles"] or []),
-            locations=list(row["locations"] or []),
-        )
+    # Configs are scraped side by side, SCRAPE_MAX_CONCURRENT_REQUESTS
+    # provider requests at a time; one failing doesn't stop the others.
+    results = await asyncio.gather(
+        *(
+            run_for_config(
+                search_config_id=str(row["id"]),
+                user_id=str(row["user_id"]),
+                job_titles=list(row["job_titles"] or []),
+                locations=list(row["locations"] or []),
+                filters=SearchFilters.from_row(row),
+            )
+            for row in rows
+        ),
+        return_exceptions=True,
+    )
+    for row, result in zip(rows, results, strict=True):
+        if isinstance(result, Exception):
+            logger.error("Scrape failed config=%s: %s", row["id"], result)
+    await filter_stats.prune()
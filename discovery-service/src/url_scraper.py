@@ -8,6 +8,8 @@ import re
 import httpx
 from bs4 import BeautifulSoup
 
+import provider_stats
+
 logger = logging.getLogger(__name__)
 
 HTTP_TIMEOUT = 20.0
@@ -23,7 +25,7 @@ async def extract_job_from_url(url: str) -> dict:
     try:
         async with httpx.AsyncClient(
             follow_redirects=True, timeout=HTTP_TIMEOUT
-        ) as client:
+        ) as client, provider_stats.track(provider_stats.URL):
             resp = await client.get(url, headers={"User-Agent": "JobmateBot/1.0"})
             resp.raise_for_status()
             html = resp.text
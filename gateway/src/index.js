@@ -5,7 +5,10 @@
  *  - Express (HTTP server + middleware)
  *  - Apollo Server v4 (GraphQL at POST /graphql)
  *  - SSE (GET /events) — authenticated via ?token=<jwt>
- *  - Redis subscriber — pushes AI events to SSE clients
+ *  - iCalendar feeds (GET /calendar/<token>.ics) — secret per-user URL
+ *  - Google Calendar OAuth callback (GET /integrations/google-calendar/callback)
+ *  - Redis Streams consumer — pushes AI events to SSE clients
+ *  - Discovery WatchNewOffers stream per SSE client — pushes new offers live
  */
 
 import express from 'express';
@@ -21,9 +24,13 @@ import { typeDefs } from './schema/typeDefs.js';
 import { resolvers } from './schema/resolvers.js';
 import { buildContext } from './middleware/auth.js';
 import { sseManager } from './sse/manager.js';
-import { subscriber } from './lib/redis.js';
+import { subscribe, startConsuming } from './lib/redis.js';
 import { query } from './lib/db.js';
+import { renderCalendarFeed, completeGoogleCalendarAuth } from './lib/trackerGrpc.js';
+import { watchNewOffers } from './lib/discoveryGrpc.js';
 import { logger } from './lib/logger.js';
+import { requestContextMiddleware, currentRequestId } from './lib/requestContext.js';
+import { sendError } from './lib/errors.js';
 
 // ─────────────────────────────────────────────────────────────
 // Expo Push Notification helper (no API key required)
@@ -78,7 +85,7 @@ const apollo = new ApolloServer({
   typeDefs,
   resolvers,
   formatError: (formattedError, error) => {
-    console.error('[graphql] Error:', formattedError.message);
+    logger.error({ requestId: currentRequestId() }, `[graphql] Error: ${formattedError.message}`);
     return formattedError;
   },
 });
@@ -97,9 +104,13 @@ app.set('trust proxy', 1);
 app.use(cors({
   origin: process.env.CORS_ORIGIN || '*',
   methods: ['GET', 'POST', 'OPTIONS'],
-  allowedHeaders: ['Content-Type', 'Authorization'],
+  allowedHeaders: ['Content-Type', 'Authorization', 'X-Request-Id'],
+  exposedHeaders: ['X-Request-Id'],
 }));
 
+// Every request gets an ID (see requestContext.js), echoed in X-Request-Id.
+app.use(requestContextMiddleware);
+
 // ── Health check (public) ──────────────────────────
 app.get('/health', (_req, res) => {
   res.json({ status: 'ok', service: 'gateway', version: '1.0.0' });
@@ -125,14 +136,14 @@ app.get('/events', (req, res) => {
   const token = req.query.token;
 
   if (!token) {
-    return res.status(401).json({ error: 'Missing token query parameter.' });
+    return sendError(res, 401, 'UNAUTHENTICATED', 'Missing token query parameter.');
   }
 
   let decoded;
   try {
     decoded = jwt.verify(token, process.env.JWT_SECRET);
   } catch {
-    return res.status(401).json({ error: 'Invalid or expired token.' });
+    return sendError(res, 401, 'UNAUTHENTICATED', 'Invalid or expired token.');
   }
 
   const userId = decoded.userId;
@@ -147,6 +158,11 @@ app.get('/events', (req, res) => {
   // Register connection
   sseManager.add(userId, res);
 
+  // New offers of the user's scrapes, live from discovery
+  const stopWatchingOffers = watchNewOffers(userId, (offer) => {
+    sseManager.send(userId, { type: 'NEW_OFFER', ...offer });
+  });
+
   // Keepalive ping every 25s to prevent proxy timeouts
   const keepalive = setInterval(() => {
     res.write(': ping\n\n');
@@ -155,12 +171,57 @@ app.get('/events', (req, res) => {
   // Cleanup on client disconnect
   req.on('close', () => {
     clearInterval(keepalive);
+    stopWatchingOffers();
     sseManager.remove(userId);
   });
 });
 
+// ── iCalendar feed ─────────────────────────────────────────
+// Calendar apps poll the URL without any header, so the secret token in the
+// path is the only credential (rotated through the tracker if leaked).
+const calendarLimiter = rateLimit({
+  windowMs: 60 * 1000,
+  max: 30,
+  standardHeaders: 'draft-8',
+  legacyHeaders: false,
+});
+
+app.get('/calendar/:token.ics', calendarLimiter, async (req, res) => {
+  try {
+    const ics = await renderCalendarFeed(req.params.token);
+    res.setHeader('Content-Type', 'text/calendar; charset=utf-8');
+    res.setHeader('Cache-Control', 'private, max-age=300');
+    res.send(ics);
+  } catch (err) {
+    if (err.grpcCode === 5) { // NOT_FOUND
+      return sendError(res, 404, 'CALENDAR_FEED_NOT_FOUND', 'Unknown calendar feed.');
+    }
+    console.error('[calendar] Failed to render feed:', err.message);
+    sendError(res, 502, 'UNAVAILABLE', 'Calendar feed unavailable.');
+  }
+});
+
+// ── Google Calendar OAuth callback ─────────────────────────
+// Google redirects the user's browser here after the consent page; the
+// tracker exchanges the code, then the user is sent back to the app.
+app.get('/integrations/google-calendar/callback', calendarLimiter, async (req, res) => {
+  const back = new URL(process.env.FRONTEND_URL || 'http://localhost:3000');
+  const { state, code, error } = req.query;
+  try {
+    if (error || typeof state !== 'string' || typeof code !== 'string') {
+      throw new Error(`consent not granted (${error || 'missing code'})`);
+    }
+    await completeGoogleCalendarAuth(state, code);
+    back.searchParams.set('googleCalendar', 'connected');
+  } catch (err) {
+    console.error('[google-calendar] OAuth callback failed:', err.message);
+    back.searchParams.set('googleCalendar', 'failed');
+  }
+  res.redirect(302, back.toString());
+});
+
 // ─────────────────────────────────────────────────────────────
-// Redis — Subscribe to internal events from other services
+// Redis — Consume internal events from other services (Redis Streams)
 // ─────────────────────────────────────────────────────────────
 
 /**
@@ -169,7 +230,7 @@ app.get('/events', (req, res) => {
  * the frontend without requiring a page reload.
  * Payload: { jobFeedId, userId, searchConfigId }
  */
-await subscriber.subscribe('EVENT_JOB_DISCOVERED', async (raw) => {
+await subscribe('EVENT_JOB_DISCOVERED', async (raw) => {
   try {
     const payload = JSON.parse(raw);
     console.log(
@@ -190,11 +251,36 @@ await subscriber.subscribe('EVENT_JOB_DISCOVERED', async (raw) => {
   }
 });
 
+/**
+ * EVENT_SCAN_PROGRESS — published by Discovery Service while a scan the user
+ * triggered runs (triggerScan returns its scanId), and once more with done.
+ * Payload: { scanId, userId, configsDone, configsTotal, searchConfigId,
+ *            provider, fetched, inserted, done }
+ */
+await subscribe('EVENT_SCAN_PROGRESS', async (raw) => {
+  try {
+    const payload = JSON.parse(raw);
+    sseManager.send(payload.userId, {
+      type: 'SCAN_PROGRESS',
+      scanId: payload.scanId,
+      configsDone: payload.configsDone ?? 0,
+      configsTotal: payload.configsTotal ?? 0,
+      searchConfigId: payload.searchConfigId || null,
+      provider: payload.provider ?? null,
+      fetched: payload.fetched ?? 0,
+      inserted: payload.inserted ?? 0,
+      done: payload.done ?? false,
+    });
+  } catch (err) {
+    console.error('[redis] Failed to parse EVENT_SCAN_PROGRESS:', err.message);
+  }
+});
+
 /**
  * EVENT_CV_PARSED — published by AI Coach after enriching a profile from a CV.
  * Payload: { type, userId, fieldsUpdated } or { type, userId, error }
  */
-await subscriber.subscribe('EVENT_CV_PARSED', async (raw) => {
+await subscribe('EVENT_CV_PARSED', async (raw) => {
   try {
     const payload = JSON.parse(raw);
     console.log(`[redis] EVENT_CV_PARSED — user ${payload.userId}`);
@@ -213,22 +299,25 @@ await subscriber.subscribe('EVENT_CV_PARSED', async (raw) => {
 });
 
 /**
- * EVENT_ANALYSIS_DONE — published by AI Coach after processing an application.
- * Payload: { type, applicationId, userId, matchScore, hasCoverLetter, analyzedAt }
+ * EVENT_APPLICATION_ANALYZED — published by Tracker Service once it has stored
+ * the AI Coach's results (EVENT_ANALYSIS_DONE), so clients can refetch them.
+ * Payload: { type, applicationId, userId, status, matchScore, hasCoverLetter, analyzedAt, error }
  */
-await subscriber.subscribe('EVENT_ANALYSIS_DONE', async (raw) => {
+await subscribe('EVENT_APPLICATION_ANALYZED', async (raw) => {
   try {
     const payload = JSON.parse(raw);
     console.log(
-      `[redis] EVENT_ANALYSIS_DONE — user ${payload.userId}, application ${payload.applicationId}, score ${payload.matchScore}`
+      `[redis] EVENT_APPLICATION_ANALYZED — user ${payload.userId}, application ${payload.applicationId}, score ${payload.matchScore}`
     );
     sseManager.send(payload.userId, {
       type: 'ANALYSIS_DONE',
       applicationId: payload.applicationId,
+      status: payload.status ?? 'done',
       matchScore: payload.matchScore ?? null,
       hasCoverLetter: payload.hasCoverLetter ?? false,
       analyzedAt: payload.analyzedAt ?? null,
     });
+    if (payload.status && payload.status !== 'done') return;
     const pushToken = await getPushToken(payload.userId);
     await sendExpoPush(
       pushToken,
@@ -237,7 +326,47 @@ await subscriber.subscribe('EVENT_ANALYSIS_DONE', async (raw) => {
       { type: 'ANALYSIS_DONE', applicationId: payload.applicationId }
     );
   } catch (err) {
-    console.error('[redis] Failed to parse EVENT_ANALYSIS_DONE:', err.message);
+    console.error('[redis] Failed to parse EVENT_APPLICATION_ANALYZED:', err.message);
+  }
+});
+
+/**
+ * EVENT_COVER_LETTER_GENERATED — published by AI Coach after a RegenerateCoverLetter request.
+ * Payload: { type, applicationId, userId, status: 'done' | 'error' }
+ */
+await subscribe('EVENT_COVER_LETTER_GENERATED', async (raw) => {
+  try {
+    const payload = JSON.parse(raw);
+    console.log(
+      `[redis] EVENT_COVER_LETTER_GENERATED — user ${payload.userId}, application ${payload.applicationId}, ${payload.status}`
+    );
+    sseManager.send(payload.userId, {
+      type: 'COVER_LETTER_GENERATED',
+      applicationId: payload.applicationId,
+      status: payload.status ?? 'done',
+    });
+  } catch (err) {
+    console.error('[redis] Failed to parse EVENT_COVER_LETTER_GENERATED:', err.message);
+  }
+});
+
+/**
+ * EVENT_FOLLOWUP_DRAFTED — published by AI Coach after a RequestFollowUpDraft request.
+ * Payload: { type, applicationId, userId, status: 'done' | 'error' }
+ */
+await subscribe('EVENT_FOLLOWUP_DRAFTED', async (raw) => {
+  try {
+    const payload = JSON.parse(raw);
+    console.log(
+      `[redis] EVENT_FOLLOWUP_DRAFTED — user ${payload.userId}, application ${payload.applicationId}, ${payload.status}`
+    );
+    sseManager.send(payload.userId, {
+      type: 'FOLLOWUP_DRAFTED',
+      applicationId: payload.applicationId,
+      status: payload.status ?? 'done',
+    });
+  } catch (err) {
+    console.error('[redis] Failed to parse EVENT_FOLLOWUP_DRAFTED:', err.message);
   }
 });
 
@@ -245,7 +374,7 @@ await subscriber.subscribe('EVENT_ANALYSIS_DONE', async (raw) => {
  * EVENT_CARD_MOVED — published by Tracker Service after a Kanban card transition.
  * Payload: { type, applicationId, userId, from, to }
  */
-await subscriber.subscribe('EVENT_CARD_MOVED', async (raw) => {
+await subscribe('EVENT_CARD_MOVED', async (raw) => {
   try {
     const payload = JSON.parse(raw);
     console.log(
@@ -269,7 +398,95 @@ await subscriber.subscribe('EVENT_CARD_MOVED', async (raw) => {
   }
 });
 
-console.log('[redis] Subscribed to: EVENT_JOB_DISCOVERED, EVENT_CV_PARSED, EVENT_ANALYSIS_DONE, EVENT_CARD_MOVED');
+/**
+ * EVENT_APPLICATION_CREATED / EVENT_APPLICATION_UPDATED — published by Tracker
+ * Service when an application is added or one of its fields changes (status
+ * moves go through EVENT_CARD_MOVED instead). Forwarded as-is so other open
+ * clients can refresh the card; no push notification.
+ * Payload: { type, applicationId, userId, status, fields?, at }
+ */
+for (const stream of ['EVENT_APPLICATION_CREATED', 'EVENT_APPLICATION_UPDATED']) {
+  await subscribe(stream, async (raw) => {
+    try {
+      const payload = JSON.parse(raw);
+      console.log(
+        `[redis] ${stream} — user ${payload.userId}, application ${payload.applicationId}`
+      );
+      sseManager.send(payload.userId, {
+        type: stream.replace(/^EVENT_/, ''),
+        applicationId: payload.applicationId,
+        status: payload.status,
+        fields: payload.fields ?? [],
+        at: payload.at,
+      });
+    } catch (err) {
+      console.error(`[redis] Failed to parse ${stream}:`, err.message);
+    }
+  });
+}
+
+/**
+ * EVENT_APPLICATION_MERGED — published by Tracker Service when a duplicate
+ * card is folded into another. Clients drop mergedApplicationId and refresh
+ * applicationId.
+ * Payload: { type, applicationId, mergedApplicationId, userId }
+ */
+await subscribe('EVENT_APPLICATION_MERGED', async (raw) => {
+  try {
+    const payload = JSON.parse(raw);
+    console.log(
+      `[redis] EVENT_APPLICATION_MERGED — user ${payload.userId}, application ${payload.mergedApplicationId} → ${payload.applicationId}`
+    );
+    sseManager.send(payload.userId, {
+      type: 'APPLICATION_MERGED',
+      applicationId: payload.applicationId,
+      mergedApplicationId: payload.mergedApplicationId,
+    });
+  } catch (err) {
+    console.error('[redis] Failed to parse EVENT_APPLICATION_MERGED:', err.message);
+  }
+});
+
+/**
+ * EVENT_RELANCE_DUE — published by Tracker Service when a follow-up reminder
+ * comes due. Delivered on the channels the user picked (IN_APP = SSE, PUSH).
+ * fromRule is set for reminders fired by a reminder rule rather than a fixed time.
+ * Payload: { type, applicationId, userId, status, jobTitle, company, remindAt, fromRule, channels }
+ */
+await subscribe('EVENT_RELANCE_DUE', async (raw) => {
+  try {
+    const payload = JSON.parse(raw);
+    const channels = payload.channels ?? ['IN_APP'];
+    console.log(
+      `[redis] EVENT_RELANCE_DUE — user ${payload.userId}, application ${payload.applicationId}, ${channels.join('+')}`
+    );
+    if (channels.includes('IN_APP')) {
+      sseManager.send(payload.userId, {
+        type: 'RELANCE_DUE',
+        applicationId: payload.applicationId,
+        jobTitle: payload.jobTitle ?? '',
+        company: payload.company ?? '',
+        remindAt: payload.remindAt,
+        fromRule: payload.fromRule ?? false,
+      });
+    }
+    if (channels.includes('PUSH')) {
+      const pushToken = await getPushToken(payload.userId);
+      const job = [payload.jobTitle, payload.company].filter(Boolean).join(' — ');
+      await sendExpoPush(pushToken, 'Relance ⏰', job ? `Pensez à relancer : ${job}` : 'Une candidature attend votre relance', {
+        type: 'RELANCE_DUE',
+        applicationId: payload.applicationId,
+      });
+    }
+  } catch (err) {
+    console.error('[redis] Failed to parse EVENT_RELANCE_DUE:', err.message);
+  }
+});
+
+startConsuming();
+console.log(
+  '[redis] Consuming streams: EVENT_JOB_DISCOVERED, EVENT_SCAN_PROGRESS, EVENT_CV_PARSED, EVENT_APPLICATION_ANALYZED, EVENT_COVER_LETTER_GENERATED, EVENT_FOLLOWUP_DRAFTED, EVENT_CARD_MOVED, EVENT_APPLICATION_CREATED, EVENT_APPLICATION_UPDATED, EVENT_APPLICATION_MERGED, EVENT_RELANCE_DUE'
+);
 
 // ─────────────────────────────────────────────────────────────
 // Start HTTP Server
@@ -10,6 +10,9 @@ import path from 'path';
 import grpc from '@grpc/grpc-js';
 import protoLoader from '@grpc/proto-loader';
 
+import { fromGrpcError } from './errors.js';
+import { currentRequestId } from './requestContext.js';
+
 const __dirname = path.dirname(fileURLToPath(import.meta.url));
 
 const PROTO_PATH = path.resolve(__dirname, '../../../../proto/discovery.proto');
@@ -35,6 +38,10 @@ const client = new DiscoveryService(addr, grpc.credentials.createInsecure());
 function userMeta(userId) {
   const meta = new grpc.Metadata();
   meta.set('x-user-id', userId);
+  const requestId = currentRequestId();
+  if (requestId) {
+    meta.set('x-request-id', requestId);
+  }
   return meta;
 }
 
@@ -42,9 +49,7 @@ function call(method, request, meta) {
   return new Promise((resolve, reject) => {
     client[method](request, meta, (err, response) => {
       if (err) {
-        const mapped = new Error(err.details || err.message);
-        mapped.grpcCode = err.code;
-        reject(mapped);
+        reject(fromGrpcError(err));
       } else {
         resolve(response);
       }
@@ -85,10 +90,97 @@ export async function addJobManually(userId, input) {
 }
 
 /**
- * Trigger an on-demand scrape for the user's search configs.
+ * Trigger an on-demand scrape for the user's search configs; its progress
+ * is published as EVENT_SCAN_PROGRESS.
  * @param {string} userId
- * @returns {Promise<{ message: string }>}
+ * @returns {Promise<{ message: string, scanId: string }>}
  */
 export async function triggerScan(userId) {
   return call('triggerScan', { userId }, userMeta(userId));
 }
+
+/**
+ * Pause a search config's scraping (it stays active).
+ * @param {string} userId
+ * @param {string} searchConfigId
+ * @returns {Promise<{ searchConfigId: string, state: string, pausedAt: string, nextScrapeAt: string }>}
+ */
+export async function pauseSearchConfig(userId, searchConfigId) {
+  return call('pauseSearchConfig', { searchConfigId }, userMeta(userId));
+}
+
+/**
+ * Resume a paused search config; an active one is scraped right away.
+ * @param {string} userId
+ * @param {string} searchConfigId
+ * @returns {Promise<{ searchConfigId: string, state: string, pausedAt: string, nextScrapeAt: string }>}
+ */
+export async function resumeSearchConfig(userId, searchConfigId) {
+  return call('resumeSearchConfig', { searchConfigId }, userMeta(userId));
+}
+
+/**
+ * The scraping status (ACTIVE, PAUSED, INACTIVE) of each of the user's search configs.
+ * @param {string} userId
+ * @returns {Promise<Array<{ searchConfigId: string, state: string, pausedAt: string, nextScrapeAt: string }>>}
+ */
+export async function listSearchConfigStatuses(userId) {
+  const res = await call('listSearchConfigStatuses', {}, userMeta(userId));
+  return res.configs;
+}
+
+/**
+ * What became of the offers scraped for a search config over the last days.
+ * @param {string} userId
+ * @param {string} searchConfigId
+ * @param {number} [days] — 0 = 30, at most 90
+ * @returns {Promise<{ days: number, fetched: number, inserted: number, duplicates: number, filtered: Array<{ rule: string, detail: string, offers: number }> }>}
+ */
+export async function getFilterStats(userId, searchConfigId, days = 0) {
+  return call('getFilterStats', { searchConfigId, days }, userMeta(userId));
+}
+
+/**
+ * Record that the user viewed these feed entries (see shownBatcher.js).
+ * @param {string} userId
+ * @param {string[]} jobFeedIds — at most 500
+ * @returns {Promise<{ marked: number }>}
+ */
+export async function markShown(userId, jobFeedIds) {
+  return call('markShown', { jobFeedIds }, userMeta(userId));
+}
+
+// Delay before a dropped WatchNewOffers stream is reopened.
+const WATCH_RETRY_MS = 5000;
+
+/**
+ * Receive the offers scrapes insert into the user's feed, as they are
+ * inserted (server stream), until stopped. The stream is reopened when it
+ * drops (e.g. discovery restarts); offers inserted meanwhile are not
+ * replayed.
+ * @param {string} userId
+ * @param {(offer: { jobFeedId: string, searchConfigId: string, title: string, companyName: string, location: string, sourceUrl: string, salaryMin: number, salaryMax: number, insertedAt: string }) => void} onOffer
+ * @returns {() => void} stops watching
+ */
+export function watchNewOffers(userId, onOffer) {
+  let stopped = false;
+  let stream = null;
+  let retry = null;
+
+  const open = () => {
+    stream = client.watchNewOffers({}, userMeta(userId));
+    stream.on('data', onOffer);
+    stream.on('error', (err) => {
+      if (stopped || err.code === grpc.status.CANCELLED) return;
+      console.warn(`[discovery] WatchNewOffers dropped for user ${userId}:`, err.details || err.message);
+      retry = setTimeout(open, WATCH_RETRY_MS);
+    });
+  };
+  open();
+
+  return () => {
+    stopped = true;
+    clearTimeout(retry);
+    stream.cancel();
+  };
+}
@@ -0,0 +1,62 @@
+/**
+ * errors.js — the error model shared with the services
+ *
+ * Errors carry a machine-readable code clients branch on, never the English
+ * message. The tracker gives each gRPC error a reason (ErrorInfo detail, and
+ * the "x-error-reason" trailer read here): APPLICATION_NOT_FOUND,
+ * VALIDATION_FAILED, DUPLICATE_APPLICATION… or the status code's name.
+ *
+ *  - GraphQL: extensions.code is the GraphQL-style code (NOT_FOUND,
+ *    BAD_USER_INPUT…) and extensions.reason the service's reason.
+ *  - HTTP: { "error": { "code": "<REASON>", "message": "…" } }
+ */
+
+import grpc from '@grpc/grpc-js';
+import { GraphQLError } from 'graphql';
+
+const GRAPHQL_CODES = {
+  [grpc.status.INVALID_ARGUMENT]: 'BAD_USER_INPUT',
+  [grpc.status.NOT_FOUND]: 'NOT_FOUND',
+  [grpc.status.ALREADY_EXISTS]: 'CONFLICT',
+  [grpc.status.ABORTED]: 'CONFLICT',
+  [grpc.status.FAILED_PRECONDITION]: 'FAILED_PRECONDITION',
+  [grpc.status.RESOURCE_EXHAUSTED]: 'RATE_LIMITED',
+  [grpc.status.UNAUTHENTICATED]: 'UNAUTHENTICATED',
+  [grpc.status.PERMISSION_DENIED]: 'FORBIDDEN',
+  [grpc.status.UNAVAILABLE]: 'SERVICE_UNAVAILABLE',
+};
+
+/**
+ * Name of a gRPC status code as spelled in the spec (NOT_FOUND…).
+ * @param {number} code
+ * @returns {string}
+ */
+function statusName(code) {
+  return Object.keys(grpc.status).find((name) => grpc.status[name] === code) ?? 'UNKNOWN';
+}
+
+/**
+ * Map a failed gRPC call to a GraphQLError. The gRPC code stays readable as
+ * error.grpcCode for callers that branch on it.
+ * @param {import('@grpc/grpc-js').ServiceError} err
+ * @returns {GraphQLError}
+ */
+export function fromGrpcError(err) {
+  const reason = err.metadata?.get('x-error-reason')?.[0]?.toString() || statusName(err.code);
+  const code = GRAPHQL_CODES[err.code] ?? 'INTERNAL_SERVER_ERROR';
+  const message = code === 'INTERNAL_SERVER_ERROR' ? 'Internal server error.' : err.details || err.message;
+  const mapped = new GraphQLError(message, { extensions: { code, reason } });
+  mapped.grpcCode = err.code;
+  return mapped;
+}
+
+/**
+ * Send the shared JSON error body.
+ * @param {import('express').Response} res
+ * @param {number} status HTTP status
+ * @param {string} code machine-readable code (UNAUTHENTICATED, NOT_FOUND…)
+ * @param {string} message
+ */
+export function sendError(res, status, code, message) {
+  return res.status(status).json({ error: { code, message } });
+}
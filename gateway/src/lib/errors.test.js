@@ -0,0 +1,54 @@
+/**
+ * Unit tests — error model (fromGrpcError, sendError)
+ */
+
+import { describe, it, expect, vi } from 'vitest';
+import grpc from '@grpc/grpc-js';
+
+import { fromGrpcError, sendError } from './errors.js';
+
+function grpcError(code, details, reason) {
+  const metadata = new grpc.Metadata();
+  if (reason) metadata.set('x-error-reason', reason);
+  return Object.assign(new Error(details), { code, details, metadata });
+}
+
+// ── fromGrpcError ──────────────────────────────────────────────────────────
+
+describe('fromGrpcError', () => {
+  it('keeps the tracker reason and maps the code', () => {
+    const err = fromGrpcError(grpcError(grpc.status.NOT_FOUND, 'application not found', 'APPLICATION_NOT_FOUND'));
+    expect(err.message).toBe('application not found');
+    expect(err.extensions).toMatchObject({ code: 'NOT_FOUND', reason: 'APPLICATION_NOT_FOUND' });
+    expect(err.grpcCode).toBe(grpc.status.NOT_FOUND);
+  });
+
+  it('falls back to the status name without a reason', () => {
+    const err = fromGrpcError(grpcError(grpc.status.INVALID_ARGUMENT, 'bad id'));
+    expect(err.extensions).toMatchObject({ code: 'BAD_USER_INPUT', reason: 'INVALID_ARGUMENT' });
+  });
+
+  it('maps an idempotent retry still in progress to a conflict', () => {
+    const err = fromGrpcError(grpcError(grpc.status.ABORTED, 'still in progress', 'IDEMPOTENT_REQUEST_PENDING'));
+    expect(err.extensions).toMatchObject({ code: 'CONFLICT', reason: 'IDEMPOTENT_REQUEST_PENDING' });
+  });
+
+  it('hides the message of internal errors', () => {
+    const err = fromGrpcError(grpcError(grpc.status.INTERNAL, 'pq: connection refused', 'INTERNAL'));
+    expect(err.message).toBe('Internal server error.');
+    expect(err.extensions.code).toBe('INTERNAL_SERVER_ERROR');
+  });
+});
+
+// ── sendError ──────────────────────────────────────────────────────────────
+
+describe('sendError', () => {
+  it('sends the shared JSON body', () => {
+    const res = { status: vi.fn().mockReturnThis(), json: vi.fn().mockReturnThis() };
+    sendError(res, 404, 'CALENDAR_FEED_NOT_FOUND', 'Unknown calendar feed.');
+    expect(res.status).toHaveBeenCalledWith(404);
+    expect(res.json).toHaveBeenCalledWith({
+      error: { code: 'CALENDAR_FEED_NOT_FOUND', message: 'Unknown calendar feed.' },
+    });
+  });
+});
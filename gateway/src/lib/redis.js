@@ -1,18 +1,30 @@
 /**
- * Redis clients — Publisher + Subscriber
+ * Redis clients — Publisher + Stream consumer
  *
- * Redis requires two separate client instances for Pub/Sub:
- *  - `publisher`  : used by resolvers to emit commands (CMD_ANALYZE_JOB, etc.)
- *  - `subscriber` : long-lived connection listening for events (EVENT_ANALYSIS_DONE, etc.)
+ * Services exchange messages over Redis Streams: every CMD_* / EVENT_* message
+ * is appended to a stream named after its type, with the JSON document in a
+ * single `payload` field. Unlike Pub/Sub, entries wait in the stream, so
+ * events published while the gateway is down are delivered once it is back.
  *
- * Channels:
- *   CMD_ANALYZE_JOB   → payload: { applicationId, userId }
- *   EVENT_ANALYSIS_DONE → payload: { applicationId, userId }
+ *  - `publisher` : used by resolvers to emit commands (CMD_ANALYZE_JOB, etc.)
+ *  - `consumer`  : dedicated connection blocking on XREADGROUP for events
+ *                  (EVENT_APPLICATION_ANALYZED, etc.), as consumer group "gateway"
+ *
+ * Streams:
+ *   CMD_ANALYZE_JOB            → payload: { applicationId, userId }
+ *   EVENT_OFFER_ACCEPTED       → payload: { userId, jobFeedId }
+ *   EVENT_APPLICATION_ANALYZED → payload: { applicationId, userId, status, matchScore }
  */
 
+import os from 'node:os';
 import { createClient } from 'redis';
 
 const REDIS_URL = process.env.REDIS_URL || 'redis://redis:6379';
+const STREAM_MAXLEN = 10000;
+const GROUP = 'gateway';
+const CONSUMER = os.hostname();
+// Entries left unacknowledged this long (consumer crashed mid-handler) are claimed again.
+const CLAIM_IDLE_MS = 60_000;
 
 // ── Publisher ─────────────────────────────────────────────────
 export const publisher = createClient({ url: REDIS_URL });
@@ -22,18 +34,106 @@ publisher.on('error', (err) => console.error('[redis:publisher] Error:', err.mes
 await publisher.connect();
 console.log('[redis:publisher] Connected.');
 
-// ── Subscriber ────────────────────────────────────────────────
-export const subscriber = createClient({ url: REDIS_URL });
+// ── Consumer ──────────────────────────────────────────────────
+export const consumer = createClient({ url: REDIS_URL });
 
-subscriber.on('error', (err) => console.error('[redis:subscriber] Error:', err.message));
+consumer.on('error', (err) => console.error('[redis:consumer] Error:', err.message));
 
-await subscriber.connect();
-console.log('[redis:subscriber] Connected.');
+await consumer.connect();
+console.log('[redis:consumer] Connected.');
 
 /**
- * Publish a command to a Redis channel.
- * @param {string} channel - e.g. 'CMD_ANALYZE_JOB'
+ * Publish a message to a Redis stream.
+ * @param {string} stream - e.g. 'CMD_ANALYZE_JOB'
  * @param {object} payload - will be JSON-serialized
  */
-export const publish = (channel, payload) =>
-  publisher.publish(channel, JSON.stringify(payload));
+export const publish = (stream, payload) =>
+  publisher.xAdd(
+    stream,
+    '*',
+    { payload: JSON.stringify(payload) },
+    { TRIM: { strategy: 'MAXLEN', strategyModifier: '~', threshold: STREAM_MAXLEN } }
+  );
+
+/**
+ * Drop the tracker's cached views of a user's applications (board counts,
+ * listings) after writing applications directly: bumps the user's cache
+ * generation, as the tracker does after its own mutations. Best effort — the
+ * tracker's cache TTL bounds staleness if this fails.
+ * @param {string} userId
+ */
+export const invalidateTrackerCache = async (userId) => {
+  const key = `tracker:cache-gen:${userId}`;
+  try {
+    await publisher.multi().incr(key).expire(key, 24 * 60 * 60).exec();
+  } catch (err) {
+    console.warn('[redis] tracker cache invalidation failed:', err?.message || err);
+  }
+};
+
+/** @type {Map<string, (raw: string) => Promise<void>>} */
+const handlers = new Map();
+
+/**
+ * Register the handler for a stream. Call startConsuming() once all are registered.
+ * An entry is acknowledged after its handler settles, whether or not it threw.
+ * @param {string} stream - e.g. 'EVENT_CARD_MOVED'
+ * @param {(raw: string) => Promise<void>} handler - receives the JSON payload
+ */
+export const subscribe = async (stream, handler) => {
+  try {
+    await consumer.xGroupCreate(stream, GROUP, '$', { MKSTREAM: true });
+  } catch (err) {
+    if (!err.message.startsWith('BUSYGROUP')) throw err;
+  }
+  handlers.set(stream, handler);
+};
+
+const handle = async (stream, entry) => {
+  if (!entry) return; // trimmed before it could be claimed
+  try {
+    await handlers.get(stream)(entry.message.payload ?? '');
+  } catch (err) {
+    console.error(`[redis:consumer] Handler failed on ${stream}:`, err.message);
+  } finally {
+    await consumer.xAck(stream, GROUP, entry.id);
+  }
+};
+
+const claimAbandoned = async () => {
+  for (const stream of handlers.keys()) {
+    const { messages } = await consumer.xAutoClaim(stream, GROUP, CONSUMER, CLAIM_IDLE_MS, '0-0', {
+      COUNT: 50,
+    });
+    for (const entry of messages) await handle(stream, entry);
+  }
+};
+
+/**
+ * Consume all subscribed streams forever (runs in the background).
+ */
+export const startConsuming = () => {
+  (async () => {
+    let lastClaim = 0;
+    for (;;) {
+      try {
+        if (Date.now() - lastClaim >= CLAIM_IDLE_MS) {
+          await claimAbandoned();
+          lastClaim = Date.now();
+        }
+        const res = await consumer.xReadGroup(
+          GROUP,
+          CONSUMER,
+          [...handlers.keys()].map((key) => ({ key, id: '>' })),
+          { COUNT: 10, BLOCK: 5000 }
+        );
+        for (const { name, messages } of res ?? []) {
+          for (const entry of messages) await handle(name, entry);
+        }
+      } catch (err) {
+        console.error('[redis:consumer] Read failed:', err.message);
+        await new Promise((resolve) => setTimeout(resolve, 2000));
+      }
+    }
+  })();
+};
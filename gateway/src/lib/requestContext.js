@@ -0,0 +1,58 @@
+/**
+ * requestContext.js — per-request values reachable from the gRPC clients
+ *
+ * The clients only receive the userId from resolvers; values they forward
+ * on behalf of the caller (its JWT, for the tracker's USER_AUTH_MODE=jwt,
+ * and the request ID) travel through an AsyncLocalStorage scope opened for
+ * each HTTP request.
+ *
+ * The request ID correlates one user action across services: taken from the
+ * caller's X-Request-Id header (Traefik, a client) or generated, echoed in
+ * the response, sent to every service as "x-request-id" gRPC metadata and
+ * copied by the tracker into the events the action causes.
+ */
+
+import { AsyncLocalStorage } from 'node:async_hooks';
+import { randomBytes } from 'node:crypto';
+
+const storage = new AsyncLocalStorage();
+
+// Longer or non-printable caller IDs are replaced (same rule as the tracker).
+const MAX_REQUEST_ID_LENGTH = 128;
+const VALID_REQUEST_ID = /^[\x21-\x7e]+$/;
+
+/**
+ * Whether a request ID received from a caller can be used as is.
+ * @param {string | undefined} id
+ * @returns {boolean}
+ */
+export function isValidRequestId(id) {
+  return typeof id === 'string' && id.length <= MAX_REQUEST_ID_LENGTH && VALID_REQUEST_ID.test(id);
+}
+
+/**
+ * Express middleware: runs the rest of the request with its Authorization
+ * header and request ID in scope, and returns the ID in X-Request-Id.
+ */
+export function requestContextMiddleware(req, res, next) {
+  const incoming = req.get('x-request-id');
+  const requestId = isValidRequestId(incoming) ? incoming : randomBytes(16).toString('hex');
+  res.set('X-Request-Id', requestId);
+  storage.run({ authorization: req.headers.authorization || '', requestId }, next);
+}
+
+/**
+ * Authorization header of the request being served, '' outside a request.
+ * @returns {string}
+ */
+export function currentAuthorization() {
+  return storage.getStore()?.authorization ?? '';
+}
+
+/**
+ * ID of the request being served, '' outside a request.
+ * @returns {string}
+ */
+export function currentRequestId() {
+  return storage.getStore()?.requestId ?? '';
+}
@@ -0,0 +1,52 @@
+/**
+ * shownBatcher.js — batches feed view receipts into discovery's MarkShown
+ *
+ * Clients report each feed entry as it scrolls into view; the receipts of a
+ * user are collected and sent in one MarkShown call every SHOWN_FLUSH_MS
+ * (or as soon as MAX_BATCH accumulate), rather than one call per entry.
+ * Receipts are best effort: a failed flush is logged and dropped — the
+ * entries are simply reported again the next time they are viewed.
+ *
+ * Environment variables:
+ *   SHOWN_FLUSH_MS — delay before a user's receipts are sent (default: 2000)
+ */
+
+import { markShown } from './discoveryGrpc.js';
+import { logger } from './logger.js';
+
+const FLUSH_MS = Number(process.env.SHOWN_FLUSH_MS) || 2000;
+// discovery's MAX_MARK_SHOWN.
+const MAX_BATCH = 500;
+
+/** userId → { ids: Set<string>, timer } */
+const pending = new Map();
+
+/**
+ * Queue view receipts of a user's feed entries.
+ * @param {string} userId
+ * @param {string[]} jobFeedIds
+ */
+export function queueShown(userId, jobFeedIds) {
+  for (const id of jobFeedIds) {
+    let batch = pending.get(userId);
+    if (!batch) {
+      batch = { ids: new Set(), timer: setTimeout(() => flush(userId), FLUSH_MS) };
+      batch.timer.unref();
+      pending.set(userId, batch);
+    }
+    batch.ids.add(id);
+    if (batch.ids.size >= MAX_BATCH) flush(userId);
+  }
+}
+
+/** Send a user's queued receipts now. */
+function flush(userId) {
+  const batch = pending.get(userId);
+  if (!batch) return;
+  pending.delete(userId);
+  clearTimeout(batch.timer);
+  if (batch.ids.size === 0) return;
+  markShown(userId, [...batch.ids]).catch((err) => {
+    logger.warn({ err, userId, count: batch.ids.size }, 'MarkShown failed, view receipts dropped');
+  });
+}
@@ -6,18 +6,30 @@
  * proto/ directory at the monorepo root.
  *
  * The userId is forwarded via gRPC metadata key "x-user-id", mirroring
- * the previous HTTP x-user-id header approach.
+ * the previous HTTP x-user-id header approach. The tracker only trusts it
+ * from authenticated services: every call carries INTERNAL_SERVICE_TOKEN
+ * ("x-internal-token"), or the channel presents a client certificate (mTLS).
  *
  * Environment variables:
  *   TRACKER_GRPC_ADDR — host:port for the tracker gRPC server
  *                       (default: tracker-service:9082)
+ *   INTERNAL_SERVICE_TOKEN — shared token (tracker INTERNAL_AUTH_MODE=token)
+ *
+ * The user's JWT is forwarded too ("authorization"), for trackers running
+ * with USER_AUTH_MODE=jwt that verify it instead of trusting x-user-id.
+ *   TRACKER_TLS_CA, TRACKER_TLS_CLIENT_CERT, TRACKER_TLS_CLIENT_KEY — PEM
+ *                       files for mTLS (tracker INTERNAL_AUTH_MODE=mtls)
  */
 
+import fs from 'fs';
 import { fileURLToPath } from 'url';
 import path from 'path';
 import grpc from '@grpc/grpc-js';
 import protoLoader from '@grpc/proto-loader';
 
+import { fromGrpcError } from './errors.js';
+import { currentAuthorization, currentRequestId } from './requestContext.js';
+
 const __dirname = path.dirname(fileURLToPath(import.meta.url));
 
 // Resolve the shared proto file from the monorepo root.
@@ -38,30 +50,63 @@ const TrackerService = grpcObj.tracker.TrackerService;
 
 const addr = process.env.TRACKER_GRPC_ADDR || 'tracker-service:9082';
 
-const client = new TrackerService(addr, grpc.credentials.createInsecure());
+/** TLS client credentials when the mTLS files are configured, plaintext otherwise. */
+function channelCredentials() {
+  const { TRACKER_TLS_CA, TRACKER_TLS_CLIENT_CERT, TRACKER_TLS_CLIENT_KEY } = process.env;
+  if (!TRACKER_TLS_CA) {
+    return grpc.credentials.createInsecure();
+  }
+  return grpc.credentials.createSsl(
+    fs.readFileSync(TRACKER_TLS_CA),
+    fs.readFileSync(TRACKER_TLS_CLIENT_KEY),
+    fs.readFileSync(TRACKER_TLS_CLIENT_CERT),
+  );
+}
+
+const client = new TrackerService(addr, channelCredentials());
 
 // ─── Helpers ─────────────────────────────────────────────────────────────────
 
+/**
+ * Build gRPC metadata authenticating the Gateway to the tracker, with the
+ * ID of the request being served.
+ */
+function serviceMeta() {
+  const meta = new grpc.Metadata();
+  const requestId = currentRequestId();
+  if (requestId) {
+    meta.set('x-request-id', requestId);
+  }
+  if (process.env.INTERNAL_SERVICE_TOKEN) {
+    meta.set('x-internal-token', process.env.INTERNAL_SERVICE_TOKEN);
+  }
+  return meta;
+}
+
 /**
  * Build gRPC metadata carrying the userId so the server can identify the caller
- * without needing a JWT (the Gateway already validated it).
+ * without needing a JWT (the Gateway already validated it), and the JWT itself
+ * for trackers that verify it.
  */
 function userMeta(userId) {
-  const meta = new grpc.Metadata();
+  const meta = serviceMeta();
   meta.set('x-user-id', userId);
+  const authorization = currentAuthorization();
+  if (authorization) {
+    meta.set('authorization', authorization);
+  }
   return meta;
 }
 
 /**
- * Wrap a gRPC unary call in a Promise, mapping gRPC errors to JS Error objects.
+ * Wrap a gRPC unary call in a Promise, mapping gRPC errors to GraphQL errors
+ * (see errors.js).
  */
 function call(method, request, meta) {
   return new Promise((resolve, reject) => {
     client[method](request, meta, (err, response) => {
       if (err) {
-        const mapped = new Error(err.details || err.message);
-        mapped.grpcCode = err.code;
-        reject(mapped);
+        reject(fromGrpcError(err));
       } else {
         resolve(response);
       }
@@ -100,8 +145,8 @@ export async function createApplication(userId, jobFeedId) {
  * @param {string} newStatus
  * @returns {Promise<object>} updated ApplicationProto
  */
-export async function moveCard(userId, applicationId, newStatus) {
-  return call('moveCard', { applicationId, newStatus }, userMeta(userId));
+export async function moveCard(userId, applicationId, newStatus, reason = '') {
+  return call('moveCard', { applicationId, newStatus, reason }, userMeta(userId));
 }
 
 /**
@@ -116,23 +161,59 @@ export async function addNote(userId, applicationId, note) {
 }
 
 /**
- * Set a numeric rating (1–5) on an application.
+ * Rate an application: 1–5 stars, or per criterion (the stars are then the
+ * criteria's rounded mean), with an optional review.
  * @param {string} userId
  * @param {string} applicationId
- * @param {number} rating
+ * @param {number} rating — ignored when criteria are given
+ * @param {{roleFit?: number, compensation?: number, culture?: number, commute?: number}} [criteria]
+ * @param {string} [review]
  * @returns {Promise<object>} updated ApplicationProto
  */
-export async function rateApplication(userId, applicationId, rating) {
-  return call('rateApplication', { applicationId, rating }, userMeta(userId));
+export async function rateApplication(userId, applicationId, rating, criteria = null, review = '') {
+  const req = { applicationId, rating, review };
+  if (criteria) req.criteria = criteria;
+  return call('rateApplication', req, userMeta(userId));
 }
 
 /**
  * Set a follow-up reminder date/time on an application.
  * @param {string} userId
  * @param {string} applicationId
- * @param {string} remindAt — ISO 8601 timestamp string
+ * @param {string} remindAt — ISO 8601 timestamp string ('' clears the reminder)
+ * @param {string} [localRemindAt] — wall-clock time without offset, e.g.
+ *   "2026-10-20T09:00", read in the user's timezone; wins over remindAt
  * @returns {Promise<object>} updated ApplicationProto
  */
-export async function setRelanceReminder(userId, applicationId, remindAt) {
-  return call('setRelanceReminder', { applicationId, remindAt }, userMeta(userId));
+export async function setRelanceReminder(userId, applicationId, remindAt, localRemindAt = '') {
+  const req = { applicationId, localRemindAt };
+  if (remindAt) {
+    const ms = Date.parse(remindAt);
+    if (Number.isNaN(ms)) {
+      throw new Error(`remindAt: invalid ISO 8601 timestamp "${remindAt}"`);
+    }
+    req.remindAt = { seconds: Math.floor(ms / 1000), nanos: (ms % 1000) * 1e6 };
+  }
+  return call('setRelanceReminder', req, userMeta(userId));
+}
+
+/**
+ * Render the iCalendar feed identified by its secret token. The token is the
+ * only credential, so no userId is forwarded.
+ * @param {string} token
+ * @returns {Promise<string>} text/calendar document
+ */
+export async function renderCalendarFeed(token) {
+  const res = await call('renderCalendarFeed', { token }, serviceMeta());
+  return res.ics;
+}
+
+/**
+ * Complete the Google Calendar OAuth flow with the parameters Google sent to
+ * the callback URL. The single-use state identifies the user.
+ * @param {string} state
+ * @param {string} code
+ */
+export async function completeGoogleCalendarAuth(state, code) {
+  return call('completeGoogleCalendarAuth', { state, code }, serviceMeta());
 }
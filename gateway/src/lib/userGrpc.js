@@ -18,6 +18,9 @@ import path from 'path';
 import grpc from '@grpc/grpc-js';
 import protoLoader from '@grpc/proto-loader';
 
+import { fromGrpcError } from './errors.js';
+import { currentRequestId } from './requestContext.js';
+
 const __dirname = path.dirname(fileURLToPath(import.meta.url));
 
 const PROTO_PATH = path.resolve(__dirname, '../../../../proto/user.proto');
@@ -47,11 +50,16 @@ const client = new ProfileService(addr, grpc.credentials.createInsecure());
 function userMeta(userId) {
   const meta = new grpc.Metadata();
   meta.set('x-user-id', userId);
+  const requestId = currentRequestId();
+  if (requestId) {
+    meta.set('x-request-id', requestId);
+  }
   return meta;
 }
 
 /**
- * Wrap a gRPC unary call in a Promise, mapping gRPC errors to JS Error objects.
+ * Wrap a gRPC unary call in a Promise, mapping gRPC errors to GraphQL errors
+ * (see errors.js).
  */
 function call(method, request, meta) {
   return new Promise((resolve, reject) => {
@@ -74,9 +82,7 @@ function call(method, request, meta) {
 
     rpc(request, meta, (err, response) => {
       if (err) {
-        const mapped = new Error(err.details || err.message);
-        mapped.grpcCode = err.code;
-        reject(mapped);
+        reject(fromGrpcError(err));
       } else {
         resolve(response);
       }
@@ -10,13 +10,15 @@ import { GraphQLError } from 'graphql';
 import { GraphQLJSON } from 'graphql-scalars';
 import GraphQLUpload from 'graphql-upload/GraphQLUpload.mjs';
 import { query } from '../lib/db.js';
-import { publish } from '../lib/redis.js';
+import { publish, invalidateTrackerCache } from '../lib/redis.js';
 import { signToken, requireAuth } from '../middleware/auth.js';
 import * as trackerClient from '../lib/trackerGrpc.js';
 import * as userClient from '../lib/userGrpc.js';
 import * as discoveryClient from '../lib/discoveryGrpc.js';
+import { queueShown } from '../lib/shownBatcher.js';
 
 const BCRYPT_ROUNDS = 12;
+const UUID_RE = /^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$/i;
 
 // All service communication is now via gRPC.
 // tracker-service → ../lib/trackerGrpc.js (port 9082)
@@ -96,6 +98,13 @@ export const resolvers = {
       if (v instanceof Date) return v.toISOString();
       return protoTsToISO(v) ?? '';
     },
+    relanceReminderAt: (parent) => {
+      const v = parent.relanceReminderAt;
+      if (!v) return null;
+      if (typeof v === 'string') return v;
+      if (v instanceof Date) return v.toISOString();
+      return protoTsToISO(v);
+    },
   },  // ── Queries ─────────────────────────────────────────────
   Query: {
     health: () => 'OK',
@@ -113,6 +122,16 @@ export const resolvers = {
       return userClient.getSearchConfigs(context.user.userId);
     },
 
+    searchConfigFilterStats: async (_parent, { searchConfigId, days }, context) => {
+      requireAuth(context);
+      return discoveryClient.getFilterStats(context.user.userId, searchConfigId, days ?? 0);
+    },
+
+    searchConfigStatuses: async (_parent, _args, context) => {
+      requireAuth(context);
+      return discoveryClient.listSearchConfigStatuses(context.user.userId);
+    },
+
     // Profile (via profile-service gRPC)
     myProfile: async (_parent, _args, context) => {
       requireAuth(context);
@@ -120,22 +139,43 @@ export const resolvers = {
     },
 
     // Phase 2 — JobFeed (implemented)
-    jobFeed: async (_parent, { status }, context) => {
+    jobFeed: async (_parent, { status, unseen }, context) => {
       requireAuth(context);
       const { userId } = context.user;
 
       // Include both search-config jobs and manual jobs.
       // Manual jobs can have search_config_id NULL and are owned by jf.user_id.
+      // Entries expire 30 days after they are created (expires_at's default):
+      // the created_at bound lets PostgreSQL skip older job_feed partitions.
+      // Offers another user's config found first are linked to the user's
+      // configs (job_feed_matches): listed from when they were linked, with
+      // the user's own status and shown_at.
       const { rows } = await query(
-        `SELECT jf.id, jf.raw_data, jf.source_url, jf.status, jf.created_at
-         FROM job_feed jf
-         LEFT JOIN search_configs sc ON sc.id = jf.search_config_id
-         WHERE (sc.user_id = $1 OR jf.user_id = $1)
-           AND ($2::job_status IS NULL OR jf.status = $2::job_status)
-           AND jf.expires_at > NOW()
-         ORDER BY jf.created_at DESC
+        `SELECT f.*
+         FROM (
+           SELECT jf.id, jf.raw_data, jf.source_url, jf.status, jf.shown_at, jf.created_at
+           FROM job_feed jf
+           LEFT JOIN search_configs sc ON sc.id = jf.search_config_id
+           WHERE (sc.user_id = $1 OR jf.user_id = $1)
+             AND jf.expires_at > NOW()
+             AND jf.created_at > NOW() - INTERVAL '30 days'
+           UNION ALL
+           (SELECT DISTINCT ON (jf.id)
+                   jf.id, jf.raw_data, jf.source_url, m.status, m.shown_at, m.matched_at
+            FROM job_feed_matches m
+            JOIN search_configs sc ON sc.id = m.search_config_id AND sc.user_id = $1
+            JOIN job_feed jf ON jf.id = m.job_feed_id
+            LEFT JOIN search_configs owner ON owner.id = jf.search_config_id
+            WHERE m.matched_at > NOW() - INTERVAL '30 days'
+              AND jf.user_id IS DISTINCT FROM $1
+              AND owner.user_id IS DISTINCT FROM $1
+            ORDER BY jf.id, m.matched_at)
+         ) f
+         WHERE ($2::job_status IS NULL OR f.status = $2::job_status)
+           AND (NOT $3 OR f.shown_at IS NULL)
+         ORDER BY f.created_at DESC
          LIMIT 100`,
-        [userId, status ?? null]
+        [userId, status ?? null, unseen ?? false]
       );
 
       return rows.map((r) => ({
@@ -143,6 +183,7 @@ export const resolvers = {
         rawData: r.raw_data,
         sourceUrl: r.source_url,
         status: r.status,
+        shownAt: r.shown_at,
         createdAt: r.created_at,
       }));
     },
@@ -203,7 +244,7 @@ export const resolvers = {
       const user = rowToUser(rows[0]);
       const token = signToken({ id: rows[0].id, email: rows[0].email });
 
-      console.log(`[auth] New user registered: ${normalizedEmail}`);
+      console.log(`[auth] New user registered: ${rows[0].id}`);
       return { token, user };
     },
 
@@ -235,7 +276,7 @@ export const resolvers = {
       const user = rowToUser(fullRow);
       const token = signToken({ id: dbUser.id, email: dbUser.email });
 
-      console.log(`[auth] User logged in: ${normalizedEmail}`);
+      console.log(`[auth] User logged in: ${dbUser.id}`);
       return { token, user };
     },
 
@@ -306,6 +347,16 @@ export const resolvers = {
       return result.success ?? true;
     },
 
+    pauseSearchConfig: async (_parent, { id }, context) => {
+      requireAuth(context);
+      return discoveryClient.pauseSearchConfig(context.user.userId, id);
+    },
+
+    resumeSearchConfig: async (_parent, { id }, context) => {
+      requireAuth(context);
+      return discoveryClient.resumeSearchConfig(context.user.userId, id);
+    },
+
     // ── uploadCV ──────────────────────────────────────────
     uploadCV: async (_parent, { file }, context) => {
       requireAuth(context);
@@ -353,17 +404,19 @@ export const resolvers = {
 
       // Insert a bare application; NULL job_feed_id = manual entry.
       // ON CONFLICT: a user can only have one manual (null job_feed_id) application
-      // — just bump updated_at so the row is returned.
+      // — just bump updated_at so the row is returned. The cover letter and
+      // notes are stored sealed by the Tracker: they are read through it.
       const { rows } = await query(
         `INSERT INTO applications (user_id, job_feed_id, current_status)
          VALUES ($1, $2, 'TO_APPLY')
          ON CONFLICT (user_id, job_feed_id) DO UPDATE
            SET updated_at = NOW()
-         RETURNING id, job_feed_id, current_status, ai_analysis, generated_cover_letter,
-                   user_notes, user_rating, relance_reminder_at, history_log,
+         RETURNING id, job_feed_id, current_status, ai_analysis,
+                   user_rating, relance_reminder_at, history_log,
                    created_at, updated_at`,
         [userId, jobFeedId ?? null],
       );
+      await invalidateTrackerCache(userId);
 
       const app = rows[0];
       return {
@@ -371,8 +424,8 @@ export const resolvers = {
         jobFeedId: app.job_feed_id,
         currentStatus: app.current_status,
         aiAnalysis: app.ai_analysis,
-        generatedCoverLetter: app.generated_cover_letter,
-        userNotes: app.user_notes,
+        generatedCoverLetter: null,
+        userNotes: null,
         userRating: app.user_rating,
         relanceReminderAt: app.relance_reminder_at,
         historyLog: app.history_log,
@@ -396,23 +449,36 @@ export const resolvers = {
           extensions: { code: 'NOT_FOUND' },
         });
       }
+      await invalidateTrackerCache(userId);
 
       return true;
     },
 
     // ── approveJob (Phase 3) ───────────────────────────────
+    // The card itself is created by the Tracker, which consumes
+    // EVENT_OFFER_ACCEPTED, queues CMD_ANALYZE_JOB and announces the card
+    // with EVENT_APPLICATION_CREATED (relayed to clients over SSE).
     approveJob: async (_parent, { jobFeedId }, context) => {
       requireAuth(context);
       const { userId } = context.user;
 
       // 1. Verify ownership — job must belong to the authenticated user either via
-      //    search_configs (scraped jobs) OR via job_feed.user_id (manually added jobs).
+      //    search_configs (scraped jobs) OR via job_feed.user_id (manually added jobs),
+      //    or be linked to one of the user's search configs (job_feed_matches),
+      //    which then holds the user's status.
       const { rows: feedRows } = await query(
-        `SELECT jf.id, jf.status, jf.raw_data, jf.source_url, jf.created_at
+        `SELECT jf.id, jf.status, FALSE AS linked
          FROM job_feed jf
          LEFT JOIN search_configs sc ON sc.id = jf.search_config_id
          WHERE jf.id = $1
-           AND (sc.user_id = $2 OR jf.user_id = $2)`,
+           AND (sc.user_id = $2 OR jf.user_id = $2)
+         UNION ALL
+         SELECT m.job_feed_id, m.status, TRUE
+         FROM job_feed_matches m
+         JOIN search_configs sc ON sc.id = m.search_config_id AND sc.user_id = $2
+         WHERE m.job_feed_id = $1
+         ORDER BY linked
+         LIMIT 1`,
         [jobFeedId, userId]
       );
 
@@ -428,45 +494,53 @@ export const resolvers = {
         });
       }
 
-      // 2. Update job_feed status to APPROVED
-      await query(
-        `UPDATE job_feed SET status = 'APPROVED' WHERE id = $1`,
-        [jobFeedId]
-      );
-
-      // 3. Insert application — ON CONFLICT keeps idempotent if called twice
-      const { rows: appRows } = await query(
-        `INSERT INTO applications (user_id, job_feed_id, current_status)
-         VALUES ($1, $2, 'TO_APPLY')
-         ON CONFLICT (user_id, job_feed_id) DO UPDATE
-           SET updated_at = NOW()
-         RETURNING id, current_status, ai_analysis, generated_cover_letter,
-                   user_notes, user_rating, history_log, created_at, updated_at`,
-        [userId, jobFeedId]
-      );
-
-      const app = appRows[0];
+      // 2. Update job_feed (or the user's match) status
+      const setStatus = async (status) => {
+        if (feedRows[0].linked) {
+          const { rows } = await query(
+            `WITH linked AS (
+               UPDATE job_feed_matches m SET status = $3
+               FROM search_configs sc
+               WHERE m.job_feed_id = $1 AND sc.id = m.search_config_id AND sc.user_id = $2
+               RETURNING m.job_feed_id, m.status, m.shown_at, m.matched_at
+             )
+             SELECT DISTINCT ON (jf.id)
+                    jf.id, jf.raw_data, jf.source_url, l.status, l.shown_at, l.matched_at AS created_at
+             FROM linked l
+             JOIN job_feed jf ON jf.id = l.job_feed_id
+             ORDER BY jf.id, l.matched_at`,
+            [jobFeedId, userId, status]
+          );
+          return rows[0];
+        }
+        const { rows } = await query(
+          `UPDATE job_feed SET status = $2 WHERE id = $1
+           RETURNING id, raw_data, source_url, status, shown_at, created_at`,
+          [jobFeedId, status]
+        );
+        return rows[0];
+      };
+      const r = await setStatus('APPROVED');
 
-      // 4. Publish CMD_ANALYZE_JOB → ai-coach-service via Redis
+      // 3. Hand the job to the Tracker. Without the event no card would ever
+      //    be created, so the approval is undone and the user may retry.
       try {
-        await publish('CMD_ANALYZE_JOB', { applicationId: app.id, userId });
-        console.log(`[approveJob] Published CMD_ANALYZE_JOB for application ${app.id}`);
+        await publish('EVENT_OFFER_ACCEPTED', { userId, jobFeedId });
       } catch (err) {
-        // Non-fatal: analysis will be triggered on next retry mechanism
-        console.error('[approveJob] Failed to publish CMD_ANALYZE_JOB:', err.message);
+        console.error('[approveJob] Failed to publish EVENT_OFFER_ACCEPTED:', err.message);
+        await setStatus(feedRows[0].status);
+        throw new GraphQLError('Job could not be approved, please retry.', {
+          extensions: { code: 'SERVICE_UNAVAILABLE' },
+        });
       }
 
-      // 5. Return Application shape
       return {
-        id: app.id,
-        currentStatus: app.current_status,
-        aiAnalysis: app.ai_analysis,
-        generatedCoverLetter: app.generated_cover_letter,
-        userNotes: app.user_notes,
-        userRating: app.user_rating,
-        historyLog: app.history_log,
-        createdAt: app.created_at,
-        updatedAt: app.updated_at,
+        id: r.id,
+        rawData: r.raw_data,
+        sourceUrl: r.source_url,
+        status: r.status,
+        shownAt: r.shown_at,
+        createdAt: r.created_at,
       };
     },
 
@@ -477,8 +551,9 @@ export const resolvers = {
 
       // Verify ownership + update in one round-trip.
       // A job belongs to the user either via search_configs (scraped jobs)
-      // OR directly via job_feed.user_id (manual additions).
-      const { rows } = await query(
+      // OR directly via job_feed.user_id (manual additions). Otherwise it may
+      // be linked to one of the user's configs: the match is rejected.
+      let { rows } = await query(
         `UPDATE job_feed jf
          SET status = 'REJECTED'
          WHERE jf.id = $1
@@ -491,9 +566,26 @@ export const resolvers = {
                  AND sc.user_id = $2
              )
            )
-         RETURNING jf.id, jf.raw_data, jf.source_url, jf.status, jf.created_at`,
+         RETURNING jf.id, jf.raw_data, jf.source_url, jf.status, jf.shown_at, jf.created_at`,
         [jobFeedId, userId]
       );
+      if (rows.length === 0) {
+        ({ rows } = await query(
+          `WITH linked AS (
+             UPDATE job_feed_matches m
+             SET status = 'REJECTED'
+             FROM search_configs sc
+             WHERE m.job_feed_id = $1 AND sc.id = m.search_config_id AND sc.user_id = $2
+             RETURNING m.job_feed_id, m.status, m.shown_at, m.matched_at
+           )
+           SELECT DISTINCT ON (jf.id)
+                  jf.id, jf.raw_data, jf.source_url, l.status, l.shown_at, l.matched_at AS created_at
+           FROM linked l
+           JOIN job_feed jf ON jf.id = l.job_feed_id
+           ORDER BY jf.id, l.matched_at`,
+          [jobFeedId, userId]
+        ));
+      }
 
       if (rows.length === 0) {
         throw new GraphQLError('Job not found or does not belong to you.', {
@@ -507,14 +599,32 @@ export const resolvers = {
         rawData: r.raw_data,
         sourceUrl: r.source_url,
         status: r.status,
+        shownAt: r.shown_at,
         createdAt: r.created_at,
       };
     },
 
+    markJobsShown: async (_parent, { jobFeedIds }, context) => {
+      requireAuth(context);
+      if (jobFeedIds.length > 500) {
+        throw new GraphQLError('At most 500 job feed IDs per call.', {
+          extensions: { code: 'BAD_USER_INPUT' },
+        });
+      }
+      // One malformed ID would make discovery refuse the whole batch.
+      if (!jobFeedIds.every((id) => UUID_RE.test(id))) {
+        throw new GraphQLError('Job feed IDs must be UUIDs.', {
+          extensions: { code: 'BAD_USER_INPUT' },
+        });
+      }
+      queueShown(context.user.userId, jobFeedIds);
+      return true;
+    },
+
     // ── Phase 4 ────────────────────────────────────────────
-    moveCard: async (_parent, { applicationId, newStatus }, context) => {
+    moveCard: async (_parent, { applicationId, newStatus, reason }, context) => {
       requireAuth(context);
-      return trackerClient.moveCard(context.user.userId, applicationId, newStatus);
+      return trackerClient.moveCard(context.user.userId, applicationId, newStatus, reason ?? '');
     },
 
     addNote: async (_parent, { applicationId, note }, context) => {
@@ -522,13 +632,13 @@ export const resolvers = {
       return trackerClient.addNote(context.user.userId, applicationId, note);
     },
 
-    rateApplication: async (_parent, { applicationId, rating }, context) => {
+    rateApplication: async (_parent, { applicationId, rating, criteria, review }, context) => {
       requireAuth(context);
-      return trackerClient.rateApplication(context.user.userId, applicationId, rating);
+      return trackerClient.rateApplication(context.user.userId, applicationId, rating ?? 0, criteria, review ?? '');
     },
-    setRelanceReminder: async (_parent, { applicationId, remindAt }, context) => {
+    setRelanceReminder: async (_parent, { applicationId, remindAt, localRemindAt }, context) => {
       requireAuth(context);
-      return trackerClient.setRelanceReminder(context.user.userId, applicationId, remindAt);
+      return trackerClient.setRelanceReminder(context.user.userId, applicationId, remindAt, localRemindAt ?? '');
     },
 
     // ── Discovery ────────────────────────────────────────
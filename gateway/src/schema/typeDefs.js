@@ -80,6 +80,8 @@ export const typeDefs = `#graphql
     rawData: JSON!
     sourceUrl: String
     status: JobStatus!
+    # First time the user viewed it (markJobsShown); null = never shown.
+    shownAt: String
     createdAt: String!
   }
 
@@ -87,6 +89,14 @@ export const typeDefs = `#graphql
   # Applications / Kanban (Phase 4 — stubs)
   # ────────────────────────────────────────────────
 
+  # Sub-scores of a rating, 1–5 each (0 / null = not rated).
+  type RatingCriteria {
+    roleFit: Int
+    compensation: Int
+    culture: Int
+    commute: Int
+  }
+
   type Application {
     id: ID!
     currentStatus: ApplicationStatus!
@@ -94,6 +104,8 @@ export const typeDefs = `#graphql
     generatedCoverLetter: String
     userNotes: String
     userRating: Int
+    ratingCriteria: RatingCriteria
+    ratingReview: String
     historyLog: JSON
     jobFeedId: ID
     searchConfigId: ID
@@ -105,6 +117,8 @@ export const typeDefs = `#graphql
   type ManualJobResult {
     jobFeedId: ID!
     message: String!
+    # triggerScan only: the scan whose SCAN_PROGRESS events are sent on /events.
+    scanId: ID
   }
 
   # ────────────────────────────────────────────────
@@ -132,6 +146,8 @@ export const typeDefs = `#graphql
     OFFER
     REJECTED
     HIRED
+    WITHDRAWN
+    ON_HOLD
   }
 
   enum RemotePolicy {
@@ -180,6 +196,13 @@ export const typeDefs = `#graphql
     coverLetterTemplate: String
   }
 
+  input RatingCriteriaInput {
+    roleFit: Int
+    compensation: Int
+    culture: Int
+    commute: Int
+  }
+
   input ManualJobInput {
     searchConfigId: ID
     companyName: String!
@@ -191,6 +214,34 @@ export const typeDefs = `#graphql
     whyUs: String
   }
 
+  # Scraping status of a search config: ACTIVE, PAUSED (skipped until
+  # resumed) or INACTIVE (deleted or archived on HIRED).
+  type SearchConfigStatus {
+    searchConfigId: ID!
+    state: String!
+    pausedAt: String
+    nextScrapeAt: String
+  }
+
+  # Offers filtered out by one rule: RED_FLAG (detail: the keyword), SALARY
+  # (below salaryMin), KEYWORDS (none of the config's keywords) or CAPPED
+  # (left for a later scrape: the per-scrape offer cap was reached).
+  type FilterRuleCount {
+    rule: String!
+    detail: String!
+    offers: Int!
+  }
+
+  type FilterStats {
+    days: Int!
+    fetched: Int!
+    inserted: Int!
+    duplicates: Int!
+    filtered: [FilterRuleCount!]!
+    # Of the inserted offers, those the user viewed.
+    shown: Int!
+  }
+
   # ────────────────────────────────────────────────
   # Queries
   # ────────────────────────────────────────────────
@@ -203,8 +254,13 @@ export const typeDefs = `#graphql
     me: User!
     myProfile: Profile!
     mySearchConfigs: [SearchConfig!]!
+    # What became of a search config's scraped offers over the last days
+    # (default 30, at most 90) — why its feed is empty.
+    searchConfigFilterStats(searchConfigId: ID!, days: Int): FilterStats!
+    searchConfigStatuses: [SearchConfigStatus!]!
     myApplications(status: ApplicationStatus): [Application!]!
-    jobFeed(status: JobStatus): [JobFeedItem!]!
+    # unseen: only the entries never shown to the user ("new since you last looked").
+    jobFeed(status: JobStatus, unseen: Boolean): [JobFeedItem!]!
   }
 
   # ────────────────────────────────────────────────
@@ -223,21 +279,35 @@ export const typeDefs = `#graphql
     createSearchConfig(input: CreateSearchConfigInput!): SearchConfig!
     updateSearchConfig(id: ID!, input: UpdateSearchConfigInput!): SearchConfig!
     deleteSearchConfig(id: ID!): Boolean!
+    # Pausing stops scraping without deactivating the config; resuming
+    # scrapes it right away.
+    pauseSearchConfig(id: ID!): SearchConfigStatus!
+    resumeSearchConfig(id: ID!): SearchConfigStatus!
 
     # ── CV Upload ─────────────────────────────
     uploadCV(file: Upload!): CVUploadResult!
 
     # ── Job Feed (Phase 2) ─────────────────────
-    approveJob(jobFeedId: ID!): Application!
+    # Approving hands the job to the tracker, which puts it on the board at
+    # TO_APPLY: the card arrives over SSE (EVENT_APPLICATION_CREATED).
+    approveJob(jobFeedId: ID!): JobFeedItem!
     rejectJob(jobFeedId: ID!): JobFeedItem!
+    # Report feed entries the user viewed (at most 500 per call). Receipts
+    # are batched and recorded asynchronously: the result is always true.
+    markJobsShown(jobFeedIds: [ID!]!): Boolean!
 
     # ── Kanban (Phase 4) ──────────────────────
     createApplication(jobFeedId: ID): Application!
     deleteApplication(applicationId: ID!): Boolean!
-    moveCard(applicationId: ID!, newStatus: ApplicationStatus!): Application!
+    # reason: optional comment kept in the move's history entry.
+    moveCard(applicationId: ID!, newStatus: ApplicationStatus!, reason: String): Application!
     addNote(applicationId: ID!, note: String!): Application!
-    rateApplication(applicationId: ID!, rating: Int!): Application!
-    setRelanceReminder(applicationId: ID!, remindAt: String!): Application!
+    # rating: 1–5 stars, ignored when criteria are given (the stars are then
+    # their rounded mean). review: optional, at most 500 characters.
+    rateApplication(applicationId: ID!, rating: Int, criteria: RatingCriteriaInput, review: String): Application!
+    # remindAt: ISO 8601 ("" clears). localRemindAt: "YYYY-MM-DDTHH:MM" in the
+    # user's timezone (tracker settings), takes precedence over remindAt.
+    setRelanceReminder(applicationId: ID!, remindAt: String!, localRemindAt: String): Application!
 
     # ── Discovery (manual job add) ────────────
     addJobByUrl(searchConfigId: ID, url: String!): ManualJobResult!
@@ -2,8 +2,8 @@
  * SSE Connection Manager
  *
  * Keeps an in-memory registry of active Server-Sent Events connections,
- * keyed by userId. When the AI Coach publishes EVENT_ANALYSIS_DONE on Redis,
- * the gateway uses this manager to push the event to the right client.
+ * keyed by userId. When the Tracker publishes EVENT_APPLICATION_ANALYZED on
+ * Redis, the gateway uses this manager to push the event to the right client.
  *
  * Usage:
  *   sseManager.add(userId, res)         — called when client connects to /events
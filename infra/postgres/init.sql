@@ -23,7 +23,16 @@ CREATE TYPE application_status AS ENUM (
   'INTERVIEW',  -- Interview scheduled
   'OFFER',      -- Offer received
   'REJECTED',   -- Application rejected
-  'HIRED'       -- Accepted offer — triggers search archival
+  'HIRED',      -- Accepted offer — triggers search archival
+  'WITHDRAWN',  -- Candidate left the process themselves
+  'ON_HOLD'     -- Process paused (hiring freeze) — resumes to hold_origin
+);
+
+-- Declared in ascending order so ORDER BY priority DESC puts HIGH first
+CREATE TYPE application_priority AS ENUM (
+  'LOW',
+  'MEDIUM',
+  'HIGH'
 );
 
 CREATE TYPE remote_policy AS ENUM (
@@ -90,6 +99,8 @@ CREATE TABLE IF NOT EXISTS search_configs (
   cover_letter_template   TEXT,                         -- User's base template for LLM cover letter generation
   is_active               BOOLEAN NOT NULL DEFAULT TRUE,
   completed_at            TIMESTAMPTZ,                  -- Set when a HIRED outcome archives this search (distinct from is_active soft-delete)
+  archive_on_hired        BOOLEAN NOT NULL DEFAULT TRUE, -- FALSE = stays active when one of its jobs is HIRED
+  paused_at               TIMESTAMPTZ,                  -- Set while the user pauses scraping (independent of is_active)
   created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
   updated_at              TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
@@ -97,10 +108,13 @@ CREATE TABLE IF NOT EXISTS search_configs (
 -- ─────────────────────────────────────────────────────────────
 -- job_feed
 -- The "Inbox" / triage queue populated by the Discovery Service.
--- TTL enforced via expires_at — a cleanup cron job DELETEs expired rows.
+-- Partitioned by month of created_at (job_feed_YYYY_MM, plus
+-- job_feed_default): the Discovery Service creates the coming months'
+-- partitions and drops those older than JOB_FEED_RETENTION_MONTHS, keeping
+-- the offers applications were made from (see job_feed_drop_partitions).
 -- ─────────────────────────────────────────────────────────────
 CREATE TABLE IF NOT EXISTS job_feed (
-  id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  id               UUID NOT NULL DEFAULT uuid_generate_v4(),
   -- Direct owner reference — set for manually-added jobs (addJobByUrl / addJobManually).
   -- Allows approveJob to verify ownership even when search_config_id is NULL.
   user_id          UUID REFERENCES users(id) ON DELETE CASCADE,
@@ -117,8 +131,43 @@ CREATE TABLE IF NOT EXISTS job_feed (
   company_name        VARCHAR(255),
   company_description TEXT,
   why_us              TEXT,
+  shown_at         TIMESTAMPTZ,                 -- First time the user viewed it (NULL = never shown)
   expires_at       TIMESTAMPTZ NOT NULL DEFAULT (NOW() + INTERVAL '30 days'),
-  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- The partition key must be part of it
+  PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE IF NOT EXISTS job_feed_default PARTITION OF job_feed DEFAULT;
+
+-- ─────────────────────────────────────────────────────────────
+-- job_feed_matches
+-- Other search configs a live scraped offer was found for: the offer is
+-- stored once, under the config that found it first, and shows in the
+-- linked configs' feeds too. Each match has its own triage state.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS job_feed_matches (
+  job_feed_id       UUID NOT NULL,               -- job_feed(id); no FOREIGN KEY, job_feed is partitioned
+  search_config_id  UUID NOT NULL REFERENCES search_configs(id) ON DELETE CASCADE,
+  status            job_status NOT NULL DEFAULT 'PENDING', -- the linked config's user's triage
+  shown_at          TIMESTAMPTZ,                 -- First time that user viewed it
+  matched_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- When it entered their feed
+  PRIMARY KEY (job_feed_id, search_config_id)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- board_columns
+-- User-defined Kanban columns. Each one subdivides a canonical status.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS board_columns (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        VARCHAR(64) NOT NULL,             -- "Technical Test", "Reference Check"
+  status      application_status NOT NULL,      -- canonical status the column belongs to
+  position    INT NOT NULL DEFAULT 0,           -- ordering within the status
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, name)
 );
 
 -- ─────────────────────────────────────────────────────────────
@@ -129,20 +178,444 @@ CREATE TABLE IF NOT EXISTS job_feed (
 CREATE TABLE IF NOT EXISTS applications (
   id                      UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
   user_id                 UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-  job_feed_id             UUID REFERENCES job_feed(id) ON DELETE SET NULL,
+  job_feed_id             UUID,                -- job_feed(id); no FOREIGN KEY, job_feed is partitioned (see release_job_feed)
   current_status          application_status NOT NULL DEFAULT 'TO_APPLY',
   ai_analysis             JSONB NOT NULL DEFAULT '{}',
   -- Structure: { "score": 85, "pros": [...], "cons": [...], "suggested_cv_content": "..." }
-  generated_cover_letter  TEXT,
-  user_notes              TEXT,
+  generated_cover_letter  TEXT,                -- Sealed by the tracker (FIELD_ENCRYPTION_KEYS)
+  user_notes              TEXT,                -- Copy of the latest application_notes row (sealed)
+  followup_draft          TEXT,                -- Latest follow-up message drafted by the AI Coach (sealed)
+  followup_draft_at       TIMESTAMPTZ,         -- When it was drafted
   user_rating             SMALLINT CHECK (user_rating BETWEEN 1 AND 5),
+  rating_criteria         JSONB                -- Optional sub-scores, 1–5 each; user_rating is their rounded mean
+    CHECK (jsonb_typeof(rating_criteria) = 'object'
+           AND rating_criteria - ARRAY['roleFit', 'compensation', 'culture', 'commute'] = '{}'::jsonb
+           AND NOT jsonb_path_exists(rating_criteria, '$.* ? (@.type() != "number" || @ < 1 || @ > 5)')),
+  -- Structure: { "roleFit": 4, "compensation": 3, "culture": 5, "commute": 2 }
+  rating_review           TEXT,                -- Short review with the rating (sealed)
   relance_reminder_at     TIMESTAMPTZ,         -- Optional: when to remind user to follow up
+  relance_reminder_fired_at TIMESTAMPTZ,       -- Set once EVENT_RELANCE_DUE went out (reset on change)
+  relance_rule_status     application_status,  -- Reminder rule: fires while the card is in this status
+  relance_rule_after_days INT CHECK (relance_rule_after_days BETWEEN 1 AND 365), -- first, days after entering it
+  relance_rule_every_days INT CHECK (relance_rule_every_days BETWEEN 0 AND 365), -- then every N days (0 = once)
+  relance_rule_fired_at   TIMESTAMPTZ,         -- Last time the rule fired
+  archived_at             TIMESTAMPTZ,         -- Set when hidden from the board (NULL = active)
+  hold_origin             application_status,  -- While ON_HOLD: stage the card was paused from
+  ghosted_at              TIMESTAMPTZ,         -- Flagged by the ghost detector (no news for too long)
+  compacted_at            TIMESTAMPTZ,         -- Closed card reduced to a summary by the retention job
+  column_id               UUID REFERENCES board_columns(id) ON DELETE SET NULL, -- NULL = status' default lane
+  priority                application_priority NOT NULL DEFAULT 'MEDIUM',
+  next_step_due_at        TIMESTAMPTZ,         -- Deadline of the next step (take-home test, offer response)
+  next_step_label         VARCHAR(200),        -- What is due, e.g. "Take-home test"
+  rejection_reason        VARCHAR(30)          -- While REJECTED: why (NULL = not given)
+    CHECK (rejection_reason IN ('NO_RESPONSE', 'AFTER_SCREENING', 'AFTER_INTERVIEW', 'SALARY_MISMATCH', 'WITHDREW')),
+  rejection_stage         application_status,  -- While REJECTED: status it happened at
   history_log             JSONB NOT NULL DEFAULT '[]',
   -- Structure: [{ "from": "TO_APPLY", "to": "APPLIED", "at": "2026-01-01T10:00:00Z" }]
   created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
   updated_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-  -- One application per user per job feed item (NULL job_feed_id allowed for edge cases)
-  UNIQUE NULLS NOT DISTINCT (user_id, job_feed_id)
+  -- One application per user per job feed item (NULL once the offer is deleted)
+  UNIQUE (user_id, job_feed_id)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- application_notes
+-- Append-only, timestamped notes (interview feedback, call summaries…).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS application_notes (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  body            TEXT NOT NULL,               -- Sealed by the tracker
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  edited_at       TIMESTAMPTZ                  -- NULL = never edited
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- application_last_actions
+-- The last move, rating change or note edit of each application, with the
+-- values it replaced (sealed ones as stored), for UndoLastAction.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS application_last_actions (
+  application_id  UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  action          VARCHAR(20) NOT NULL CHECK (action IN ('MOVE', 'RATING', 'NOTE_EDIT')),
+  note_id         UUID,                        -- NOTE_EDIT: the note edited
+  previous        JSONB NOT NULL DEFAULT '{}', -- the values the action replaced
+  at              TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- application_search
+-- SearchApplications' index: a tsvector per application over its job
+-- title, company, notes and cover letter, lexemes stored as keyed hashes
+-- (they come from sealed text). Rebuilt by the tracker when stale.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS application_search (
+  application_id  UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  document        TSVECTOR NOT NULL,
+  version         TEXT NOT NULL,               -- "<text search config>:<key ID>"
+  indexed_at      TIMESTAMPTZ NOT NULL         -- applications.updated_at indexed
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- attachments
+-- Files attached to an application (CV version sent, portfolio…).
+-- Bytes live in S3/MinIO under object_key; sizes feed the per-user quota.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS attachments (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  kind            VARCHAR(16) NOT NULL DEFAULT 'OTHER' CHECK (kind IN ('CV', 'COVER_LETTER', 'OTHER')),
+  file_name       VARCHAR(255) NOT NULL,
+  content_type    VARCHAR(127) NOT NULL,
+  size_bytes      BIGINT NOT NULL CHECK (size_bytes > 0),
+  object_key      TEXT NOT NULL UNIQUE,        -- <user_id>/<application_id>/<id>
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  -- Malware scan: PENDING until scanned CLEAN or QUARANTINED (object
+  -- deleted); UNSCANNED when uploaded without a scanner configured.
+  scan_status     VARCHAR(16) NOT NULL DEFAULT 'PENDING'
+                    CHECK (scan_status IN ('PENDING', 'CLEAN', 'QUARANTINED', 'UNSCANNED')),
+  scan_signature  TEXT,                        -- what a QUARANTINED file contains
+  scanned_at      TIMESTAMPTZ,
+  scan_next_at    TIMESTAMPTZ NOT NULL DEFAULT NOW() -- when a PENDING scan is due, or its lease
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- cover_letter_versions
+-- Every cover letter ever written to an application (AI generations and
+-- restores). applications.generated_cover_letter holds the current one;
+-- rows are added by the record_cover_letter_version trigger below.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS cover_letter_versions (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  version         INT NOT NULL,                -- 1, 2, … per application
+  body            TEXT NOT NULL,               -- Sealed by the tracker
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (application_id, version)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- tracker_settings
+-- Per-user Tracker preferences. Missing row = deployment defaults.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS tracker_settings (
+  user_id           UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  ghosting_enabled  BOOLEAN NOT NULL DEFAULT TRUE,
+  ghost_after_days  INT CHECK (ghost_after_days BETWEEN 1 AND 365), -- NULL = GHOST_AFTER_DAYS
+  extra_transitions JSONB NOT NULL DEFAULT '[]', -- [{ "from": "TO_APPLY", "to": "INTERVIEW" }]
+  share_benchmarks  BOOLEAN NOT NULL DEFAULT FALSE, -- opt-in to the anonymous benchmarks
+  reminder_channels TEXT[] NOT NULL DEFAULT '{IN_APP,PUSH}', -- relance reminder delivery ({} = muted)
+  timezone          VARCHAR(64) NOT NULL DEFAULT 'UTC', -- IANA zone wall-clock reminder times are read in
+  archive_search_on_hired BOOLEAN NOT NULL DEFAULT TRUE, -- HIRED deactivates the card's search_config
+  closed_retention  VARCHAR(10) NOT NULL DEFAULT 'COMPACT' -- Old REJECTED/WITHDRAWN cards: compacted or deleted
+                    CHECK (closed_retention IN ('COMPACT', 'DELETE')),
+  created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- interviews
+-- Interview rounds of an application (phone screen, technical, onsite…).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS interviews (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  round           INT NOT NULL CHECK (round BETWEEN 1 AND 50), -- 1, 2, … per application
+  type            VARCHAR(16) NOT NULL DEFAULT 'OTHER'
+                  CHECK (type IN ('PHONE_SCREEN', 'TECHNICAL', 'BEHAVIORAL', 'ONSITE', 'HR', 'FINAL', 'OTHER')),
+  scheduled_at    TIMESTAMPTZ,                 -- NULL = not scheduled yet
+  interviewer     VARCHAR(200),
+  outcome         VARCHAR(16) NOT NULL DEFAULT 'PENDING'
+                  CHECK (outcome IN ('PENDING', 'PASSED', 'FAILED', 'CANCELLED')),
+  notes           TEXT,
+  went_well       TEXT,                        -- Post-interview feedback…
+  red_flags       TEXT,
+  questions_asked TEXT[] NOT NULL DEFAULT '{}',
+  feedback_at     TIMESTAMPTZ,                 -- …recorded at (NULL = none yet)
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- contacts
+-- Recruiters / hiring managers met along the way. Linked to applications
+-- through application_contacts; company is free text, matched
+-- case-insensitively against the job's company.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS contacts (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id            UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name               VARCHAR(200) NOT NULL,
+  role               VARCHAR(200),                -- e.g. "Tech recruiter"
+  email              VARCHAR(320),
+  linkedin_url       VARCHAR(2048),
+  company            VARCHAR(255),
+  last_contacted_at  TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS application_contacts (
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  contact_id      UUID NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (application_id, contact_id)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- offers
+-- Terms of a job offer, one per application that reached OFFER. Amounts are
+-- yearly, gross, in currency, stored as JSON ({"baseSalary": …, "bonus": …,
+-- "equityValue": …}) in compensation, sealed by the tracker like the equity
+-- details. offer_negotiations logs the counter-offers exchanged on the way.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS offers (
+  application_id     UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id            UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  currency           CHAR(3) NOT NULL DEFAULT 'EUR',  -- ISO 4217
+  compensation       TEXT,                             -- sealed amounts, equity value per year
+  equity_details     TEXT,                             -- sealed, e.g. "0.1% over 4 years, 1y cliff"
+  start_date         DATE,
+  response_deadline  TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS offer_negotiations (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  kind            VARCHAR(16) NOT NULL DEFAULT 'NOTE'
+                  CHECK (kind IN ('COUNTER_OFFER', 'REVISED_OFFER', 'NOTE')),
+  compensation    TEXT,                              -- sealed amounts proposed at this step
+  note            TEXT,                              -- sealed
+  occurred_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- benchmark_stats
+-- Anonymous aggregates over users who opted in (share_benchmarks), recomputed
+-- nightly, one row per job title cohort ('' = all titles). Cohorts smaller
+-- than BENCHMARK_MIN_USERS are left out.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS benchmark_stats (
+  title_key              VARCHAR(500) PRIMARY KEY,  -- normalized job title, '' = all titles
+  user_count             INT NOT NULL,
+  median_applications    DOUBLE PRECISION NOT NULL,
+  median_interview_rate  DOUBLE PRECISION NOT NULL, -- interviews / applications sent
+  computed_at            TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- calendar_feeds
+-- Secret iCalendar feed URL of a user (/calendar/<token>.ics): relance
+-- reminders, interviews and offer deadlines. The rendered feed is cached
+-- until the user's applications change (fingerprint).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+  user_id       UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  token_hash    BYTEA NOT NULL UNIQUE, -- SHA-256 of the token
+  ics           TEXT,         -- cached rendering, NULL = not rendered yet
+  fingerprint   VARCHAR(100), -- state of the applications ics was rendered from
+  generated_at  TIMESTAMPTZ,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- google_calendar_links
+-- Optional Google Calendar connection of a user. OAuth tokens are stored
+-- sealed; events are pushed to a secondary "JobMate" calendar.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS google_calendar_links (
+  user_id                  UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  calendar_id              VARCHAR(255) NOT NULL,  -- the "JobMate" secondary calendar
+  refresh_token            BYTEA NOT NULL,         -- sealed (AES-GCM, TOKEN_ENCRYPTION_KEY)
+  access_token             BYTEA,                  -- sealed
+  access_token_expires_at  TIMESTAMPTZ,
+  sync_token               TEXT,                   -- Google incremental sync position
+  needs_reconnect          BOOLEAN NOT NULL DEFAULT FALSE, -- grant revoked, sync paused
+  sync_started_at          TIMESTAMPTZ,            -- lease of the replica syncing it
+  last_synced_at           TIMESTAMPTZ,
+  last_error               TEXT,
+  connected_at             TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- google_calendar_auth_states
+-- Pending OAuth consent requests (single-use state, 15 min).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS google_calendar_auth_states (
+  state       VARCHAR(64) PRIMARY KEY,
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  expires_at  TIMESTAMPTZ NOT NULL
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- google_calendar_events
+-- Feed events pushed to Google, to detect changes on either side.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS google_calendar_events (
+  user_id          UUID NOT NULL REFERENCES google_calendar_links(user_id) ON DELETE CASCADE,
+  uid              VARCHAR(100) NOT NULL,   -- calendar feed UID, e.g. interview-<id>@jobmate
+  source_kind      VARCHAR(16) NOT NULL CHECK (source_kind IN ('relance', 'interview', 'offer')),
+  source_id        UUID NOT NULL,           -- application, or interview for interviews
+  google_event_id  VARCHAR(1024) NOT NULL,
+  start_at         TIMESTAMPTZ NOT NULL,    -- as last pushed or pulled
+  fingerprint      VARCHAR(64) NOT NULL,    -- content last pushed
+  detached         BOOLEAN NOT NULL DEFAULT FALSE, -- deleted in Google by the user
+  PRIMARY KEY (user_id, uid)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- audit_log
+-- Append-only record of every mutating operation (who, what, when, target
+-- row before → after), written by the Tracker's gRPC interceptor and the
+-- Discovery service. See the audit_log_append_only trigger.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS audit_log (
+  id             BIGSERIAL PRIMARY KEY,
+  occurred_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  service        VARCHAR(30) NOT NULL,     -- tracker, discovery
+  actor_id       UUID,                     -- no FK: entries outlive their users; NULL = unauthenticated/job
+  action         VARCHAR(100) NOT NULL,    -- <service>.<RPC>, e.g. tracker.MoveCard
+  resource_type  VARCHAR(30),              -- application, contact, settings, job_feed, …
+  resource_id    TEXT,
+  old_values     JSONB,                    -- target row before the operation
+  new_values     JSONB,                    -- and after
+  request        JSONB,                    -- secrets redacted
+  outcome        VARCHAR(30) NOT NULL      -- OK or the gRPC status code of the failure
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- board_shares
+-- Read-only access to a user's board granted to a coach or mentor: a
+-- JobMate user (grantee_id) or whoever holds a token (SHA-256 stored).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS board_shares (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  owner_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  grantee_id  UUID REFERENCES users(id) ON DELETE CASCADE, -- NULL = token share
+  token_hash  BYTEA UNIQUE,                  -- SHA-256 of the token, token shares only
+  statuses    application_status[] NOT NULL DEFAULT '{}', -- visible columns ({} = all)
+  expires_at  TIMESTAMPTZ NOT NULL,
+  revoked_at  TIMESTAMPTZ,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK ((grantee_id IS NULL) <> (token_hash IS NULL))
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- webhooks
+-- Outgoing webhooks registered by users for some of their tracker events,
+-- signed with a per-webhook secret (sealed, TOKEN_ENCRYPTION_KEY).
+-- webhook_deliveries queues each event to send, retried with backoff until
+-- delivered or DEAD.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS webhooks (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  url         VARCHAR(2048) NOT NULL,
+  secret      BYTEA NOT NULL,               -- sealed HMAC-SHA256 signing key
+  events      TEXT[] NOT NULL,              -- subscribed event types, e.g. {EVENT_CARD_MOVED}
+  is_active   BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  webhook_id       UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+  event_type       VARCHAR(100) NOT NULL,
+  payload          JSONB NOT NULL,
+  status           VARCHAR(16) NOT NULL DEFAULT 'PENDING'
+                   CHECK (status IN ('PENDING', 'DELIVERED', 'DEAD')),
+  attempts         INT NOT NULL DEFAULT 0,
+  next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_status_code INT,                     -- HTTP status of the last attempt, NULL = no response
+  last_error       TEXT,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  delivered_at     TIMESTAMPTZ
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- outbox_events
+-- Tracker domain events (EVENT_* / CMD_*) written in the same transaction
+-- as the change they describe. The Tracker's outbox relay publishes them to
+-- the Redis stream named `stream`, in id order, then stamps published_at;
+-- published events are kept OUTBOX_RETENTION for replays (trackerctl replay).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS outbox_events (
+  id          BIGSERIAL PRIMARY KEY,
+  stream      VARCHAR(100) NOT NULL,
+  payload     JSONB NOT NULL,
+  attempts    INT NOT NULL DEFAULT 0,              -- failed publish attempts
+  last_error  TEXT,
+  published_at TIMESTAMPTZ,                        -- NULL = pending
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- board_snapshots
+-- Every card of a user's board (status, archived, company, title) as it
+-- was at taken_at, stored by the Tracker's board-snapshots job once per
+-- BOARD_SNAPSHOT_INTERVAL or on demand, for CompareSnapshots. Kept
+-- BOARD_SNAPSHOT_RETENTION.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS board_snapshots (
+  id        UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id   UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  cards     JSONB NOT NULL DEFAULT '[]',   -- [{id, status, archived, company, title}]
+  taken_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- application_templates
+-- User-defined setups for common kinds of applications, which the
+-- Tracker creates cards from: priority, next step label, reminder rule and
+-- scaffold notes (application_template_notes, sealed like notes).
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS application_templates (
+  id                      UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id                 UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name                    VARCHAR(100) NOT NULL,
+  priority                application_priority NOT NULL DEFAULT 'MEDIUM',
+  next_step_label         VARCHAR(200),
+  relance_rule_status     application_status,  -- NULL = the card's status
+  relance_rule_after_days INT CHECK (relance_rule_after_days BETWEEN 1 AND 365),
+  relance_rule_every_days INT CHECK (relance_rule_every_days BETWEEN 0 AND 365),
+  created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS application_template_notes (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  template_id  UUID NOT NULL REFERENCES application_templates(id) ON DELETE CASCADE,
+  user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  position     INT NOT NULL,
+  body         TEXT NOT NULL,               -- Sealed by the tracker
+  UNIQUE (template_id, position)
+);
+
+-- ─────────────────────────────────────────────────────────────
+-- scrape_filter_stats
+-- What became of the offers the Discovery Service scraped, per search
+-- config and day: inserted, already in the feed, or filtered out by a rule.
+-- Kept 90 days.
+-- ─────────────────────────────────────────────────────────────
+CREATE TABLE IF NOT EXISTS scrape_filter_stats (
+  search_config_id  UUID NOT NULL REFERENCES search_configs(id) ON DELETE CASCADE,
+  day               DATE NOT NULL,
+  rule              VARCHAR(20) NOT NULL,          -- INSERTED, DUPLICATE, RED_FLAG, SALARY, KEYWORDS, CAPPED
+  detail            VARCHAR(255) NOT NULL DEFAULT '', -- RED_FLAG: the keyword that matched
+  offers            INT NOT NULL,
+  PRIMARY KEY (search_config_id, day, rule, detail)
 );
 
 -- ─────────────────────────────────────────────────────────────
@@ -171,6 +644,17 @@ CREATE INDEX IF NOT EXISTS idx_job_feed_status
 CREATE INDEX IF NOT EXISTS idx_job_feed_expires_at
   ON job_feed (expires_at);
 
+-- Offer deduplication: scrapes across configs, AddJobByUrl per user
+CREATE INDEX IF NOT EXISTS idx_job_feed_source_url
+  ON job_feed (source_url);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_user_source_url
+  ON job_feed (user_id, source_url);
+
+-- job_feed_matches
+CREATE INDEX IF NOT EXISTS idx_job_feed_matches_search_config_id
+  ON job_feed_matches (search_config_id, matched_at);
+
 -- applications
 CREATE INDEX IF NOT EXISTS idx_applications_user_id
   ON applications (user_id);
@@ -181,6 +665,131 @@ CREATE INDEX IF NOT EXISTS idx_applications_current_status
 CREATE INDEX IF NOT EXISTS idx_applications_job_feed_id
   ON applications (job_feed_id);
 
+CREATE INDEX IF NOT EXISTS idx_applications_user_active
+  ON applications (user_id, updated_at DESC)
+  WHERE archived_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_column_id
+  ON applications (column_id)
+  WHERE column_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_next_step_due
+  ON applications (user_id, next_step_due_at)
+  WHERE next_step_due_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_rejections
+  ON applications (user_id, rejection_reason, rejection_stage)
+  WHERE current_status = 'REJECTED';
+
+-- Reminder worker scan: armed reminders
+CREATE INDEX IF NOT EXISTS idx_applications_relance_due
+  ON applications (relance_reminder_at)
+  WHERE relance_reminder_at IS NOT NULL AND relance_reminder_fired_at IS NULL;
+
+-- Reminder worker scan: cards sitting in the status their rule targets
+CREATE INDEX IF NOT EXISTS idx_applications_relance_rules
+  ON applications (relance_rule_status)
+  WHERE relance_rule_status IS NOT NULL;
+
+-- Ghost detector scan: active, not-yet-flagged cards awaiting an answer
+CREATE INDEX IF NOT EXISTS idx_applications_ghost_candidates
+  ON applications (updated_at)
+  WHERE current_status IN ('APPLIED', 'INTERVIEW') AND ghosted_at IS NULL AND archived_at IS NULL;
+
+-- Retention job scan: closed cards by age
+CREATE INDEX IF NOT EXISTS idx_applications_closed
+  ON applications (updated_at)
+  WHERE current_status IN ('REJECTED', 'WITHDRAWN');
+
+-- application_notes
+CREATE INDEX IF NOT EXISTS idx_application_notes_application_id
+  ON application_notes (application_id, created_at);
+
+-- application_last_actions
+CREATE INDEX IF NOT EXISTS idx_application_last_actions_user_id
+  ON application_last_actions (user_id);
+
+-- application_search
+CREATE INDEX IF NOT EXISTS idx_application_search_document
+  ON application_search USING GIN (document);
+
+CREATE INDEX IF NOT EXISTS idx_application_search_user_id
+  ON application_search (user_id);
+
+-- attachments
+CREATE INDEX IF NOT EXISTS idx_attachments_application_id
+  ON attachments (application_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_user_id
+  ON attachments (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_scan_pending
+  ON attachments (scan_next_at) WHERE scan_status = 'PENDING';
+
+-- interviews
+CREATE INDEX IF NOT EXISTS idx_interviews_application_id
+  ON interviews (application_id, round);
+
+-- contacts
+CREATE INDEX IF NOT EXISTS idx_contacts_user_company
+  ON contacts (user_id, lower(company));
+
+CREATE INDEX IF NOT EXISTS idx_application_contacts_contact_id
+  ON application_contacts (contact_id);
+
+-- offers
+CREATE INDEX IF NOT EXISTS idx_offers_user_id
+  ON offers (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_offer_negotiations_application_id
+  ON offer_negotiations (application_id, occurred_at);
+
+-- audit_log
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor
+  ON audit_log (actor_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_resource
+  ON audit_log (resource_id, id DESC)
+  WHERE resource_id IS NOT NULL;
+
+-- board_shares
+CREATE INDEX IF NOT EXISTS idx_board_shares_owner
+  ON board_shares (owner_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_board_shares_grantee
+  ON board_shares (grantee_id)
+  WHERE grantee_id IS NOT NULL;
+
+-- webhooks
+CREATE INDEX IF NOT EXISTS idx_webhooks_user_id
+  ON webhooks (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+  ON webhook_deliveries (next_attempt_at)
+  WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook
+  ON webhook_deliveries (webhook_id, created_at DESC);
+
+-- outbox_events
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+  ON outbox_events (id)
+  WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_created_at
+  ON outbox_events (created_at);
+
+-- board_snapshots
+CREATE INDEX IF NOT EXISTS idx_board_snapshots_user_taken_at
+  ON board_snapshots (user_id, taken_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_board_snapshots_taken_at
+  ON board_snapshots (taken_at);
+
+-- application_template_notes
+CREATE INDEX IF NOT EXISTS idx_application_template_notes_user_id
+  ON application_template_notes (user_id);
+
 -- ─────────────────────────────────────────────────────────────
 -- update_updated_at trigger helper
 -- Automatically refreshes updated_at on row modification
@@ -188,7 +797,12 @@ CREATE INDEX IF NOT EXISTS idx_applications_job_feed_id
 CREATE OR REPLACE FUNCTION trigger_set_updated_at()
 RETURNS TRIGGER AS $$
 BEGIN
-  NEW.updated_at = NOW();
+  -- Resealing sensitive fields (jobmate.reseal) and compacting closed
+  -- applications (jobmate.retention) are not updates.
+  IF current_setting('jobmate.reseal', true) IS DISTINCT FROM 'on'
+     AND current_setting('jobmate.retention', true) IS DISTINCT FROM 'on' THEN
+    NEW.updated_at = NOW();
+  END IF;
   RETURN NEW;
 END;
 $$ LANGUAGE plpgsql;
@@ -208,3 +822,160 @@ CREATE TRIGGER set_updated_at_search_configs
 CREATE TRIGGER set_updated_at_applications
   BEFORE UPDATE ON applications
   FOR EACH ROW EXECUTE FUNCTION trigger_set_updated_at();
+
+-- ─────────────────────────────────────────────────────────────
+-- Cover letter versioning
+-- Records each new applications.generated_cover_letter value, whoever writes it
+-- — except the tracker resealing it (jobmate.reseal = 'on').
+-- ─────────────────────────────────────────────────────────────
+CREATE OR REPLACE FUNCTION trigger_record_cover_letter_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.generated_cover_letter IS NOT NULL
+     AND current_setting('jobmate.reseal', true) IS DISTINCT FROM 'on'
+     AND (TG_OP = 'INSERT' OR NEW.generated_cover_letter IS DISTINCT FROM OLD.generated_cover_letter) THEN
+    INSERT INTO cover_letter_versions (application_id, user_id, version, body)
+    SELECT NEW.id, NEW.user_id, COALESCE(MAX(version), 0) + 1, NEW.generated_cover_letter
+    FROM cover_letter_versions
+    WHERE application_id = NEW.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_cover_letter_version
+  AFTER INSERT OR UPDATE OF generated_cover_letter ON applications
+  FOR EACH ROW EXECUTE FUNCTION trigger_record_cover_letter_version();
+
+-- ─────────────────────────────────────────────────────────────
+-- Relance reminder re-arming
+-- A new relance_reminder_at must fire again, whoever writes it.
+-- ─────────────────────────────────────────────────────────────
+CREATE OR REPLACE FUNCTION trigger_rearm_relance_reminder()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.relance_reminder_at IS DISTINCT FROM OLD.relance_reminder_at THEN
+    NEW.relance_reminder_fired_at := NULL;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER rearm_relance_reminder
+  BEFORE UPDATE OF relance_reminder_at ON applications
+  FOR EACH ROW EXECUTE FUNCTION trigger_rearm_relance_reminder();
+
+-- ─────────────────────────────────────────────────────────────
+-- Append-only audit log
+-- Rows can only be removed by a retention/erasure job that sets
+-- jobmate.audit_purge = 'on' in its transaction.
+-- ─────────────────────────────────────────────────────────────
+CREATE OR REPLACE FUNCTION trigger_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF current_setting('jobmate.audit_purge', true) IS DISTINCT FROM 'on' THEN
+    RAISE EXCEPTION 'audit_log is append-only';
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+  BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+  FOR EACH STATEMENT EXECUTE FUNCTION trigger_audit_log_append_only();
+
+-- ─────────────────────────────────────────────────────────────
+-- job_feed partitions
+-- job_feed_create_partitions(since, months_ahead) creates the monthly
+-- partitions from since's month to months_ahead months from now;
+-- job_feed_drop_partitions(retention_months) drops those older than that,
+-- with their matches, but for the offers applications were made from,
+-- moved to job_feed_default. The Discovery Service runs both daily
+-- (feed_partitions).
+-- ─────────────────────────────────────────────────────────────
+CREATE OR REPLACE FUNCTION job_feed_create_partitions(since TIMESTAMPTZ, months_ahead INT)
+RETURNS INT AS $$
+DECLARE
+  m       TIMESTAMP := date_trunc('month', since AT TIME ZONE 'UTC');
+  horizon TIMESTAMP := date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => months_ahead);
+  lo      TIMESTAMPTZ;
+  hi      TIMESTAMPTZ;
+  part    TEXT;
+  created INT := 0;
+BEGIN
+  -- Replicas may run this side by side.
+  PERFORM pg_advisory_xact_lock(hashtext('job_feed_partitions'));
+  WHILE m <= horizon LOOP
+    part := 'job_feed_' || to_char(m, 'YYYY_MM');
+    IF to_regclass(part) IS NULL THEN
+      lo := m AT TIME ZONE 'UTC';
+      hi := (m + INTERVAL '1 month') AT TIME ZONE 'UTC';
+      -- Rows inserted while the month had no partition went to
+      -- job_feed_default: move them before attaching it.
+      EXECUTE format('CREATE TABLE %I (LIKE job_feed INCLUDING DEFAULTS)', part);
+      EXECUTE format('WITH moved AS (DELETE FROM job_feed_default WHERE created_at >= %L AND created_at < %L RETURNING *)
+                      INSERT INTO %I SELECT * FROM moved', lo, hi, part);
+      EXECUTE format('ALTER TABLE job_feed ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)', part, lo, hi);
+      created := created + 1;
+    END IF;
+    m := m + INTERVAL '1 month';
+  END LOOP;
+  RETURN created;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION job_feed_drop_partitions(retention_months INT)
+RETURNS INT AS $$
+DECLARE
+  cutoff  TIMESTAMP := date_trunc('month', NOW() AT TIME ZONE 'UTC') - make_interval(months => retention_months);
+  p       RECORD;
+  dropped INT := 0;
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('job_feed_partitions'));
+  FOR p IN
+    SELECT c.relname
+    FROM pg_inherits i
+    JOIN pg_class c ON c.oid = i.inhrelid
+    WHERE i.inhparent = 'job_feed'::regclass
+      AND c.relname ~ '^job_feed_[0-9]{4}_[0-9]{2}$'
+      AND to_date(right(c.relname, 7), 'YYYY_MM') + INTERVAL '1 month' <= cutoff
+    ORDER BY c.relname
+  LOOP
+    -- Once detached, the month is no partition's: the offers applications
+    -- were made from, copied back, land in job_feed_default.
+    EXECUTE format('ALTER TABLE job_feed DETACH PARTITION %I', p.relname);
+    EXECUTE format('INSERT INTO job_feed SELECT * FROM %I j
+                    WHERE EXISTS (SELECT 1 FROM applications a WHERE a.job_feed_id = j.id)', p.relname);
+    EXECUTE format('DELETE FROM job_feed_matches m USING %I j
+                    WHERE m.job_feed_id = j.id
+                      AND NOT EXISTS (SELECT 1 FROM applications a WHERE a.job_feed_id = j.id)', p.relname);
+    EXECUTE format('DROP TABLE %I', p.relname);
+    dropped := dropped + 1;
+  END LOOP;
+  RETURN dropped;
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT job_feed_create_partitions(NOW(), 3);
+
+-- ─────────────────────────────────────────────────────────────
+-- Applications of removed offers
+-- applications.job_feed_id cannot reference the partitioned job_feed: it is
+-- cleared when the offer is deleted. Statement-level, so rows
+-- job_feed_create_partitions moves out of job_feed_default (deleted from
+-- the partition itself) are not taken for removed.
+-- ─────────────────────────────────────────────────────────────
+CREATE OR REPLACE FUNCTION trigger_release_job_feed()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE applications a SET job_feed_id = NULL
+  FROM removed r
+  WHERE a.job_feed_id = r.id;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER release_job_feed
+  AFTER DELETE ON job_feed
+  REFERENCING OLD TABLE AS removed
+  FOR EACH STATEMENT EXECUTE FUNCTION trigger_release_job_feed();
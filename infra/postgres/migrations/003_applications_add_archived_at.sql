@@ -0,0 +1,12 @@
+-- Migration 003 — Archive/restore for applications
+-- Archived applications are hidden from the board but keep their status,
+-- notes and history. NULL = on the board.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+
+-- Board queries only ever scan non-archived rows
+CREATE INDEX IF NOT EXISTS idx_applications_user_active
+  ON applications (user_id, updated_at DESC)
+  WHERE archived_at IS NULL;
@@ -0,0 +1,6 @@
+-- Migration 004 — WITHDRAWN terminal application status
+-- Candidates who leave a process themselves were forced into REJECTED,
+-- which skews rejection statistics.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TYPE application_status ADD VALUE IF NOT EXISTS 'WITHDRAWN';
@@ -0,0 +1,9 @@
+-- Migration 005 — ON_HOLD intermediate application status
+-- A held card remembers the stage it was paused from (hold_origin) so it can
+-- only resume there. hold_origin is NULL whenever the card is not ON_HOLD.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TYPE application_status ADD VALUE IF NOT EXISTS 'ON_HOLD';
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS hold_origin application_status;
@@ -0,0 +1,19 @@
+-- Migration 006 — Automatic GHOSTED detection
+-- ghosted_at flags APPLIED/INTERVIEW cards with no update for too long.
+-- tracker_settings stores per-user Tracker preferences (missing row = defaults).
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS ghosted_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS tracker_settings (
+  user_id           UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  ghosting_enabled  BOOLEAN NOT NULL DEFAULT TRUE,
+  ghost_after_days  INT CHECK (ghost_after_days BETWEEN 1 AND 365), -- NULL = GHOST_AFTER_DAYS
+  created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_applications_ghost_candidates
+  ON applications (updated_at)
+  WHERE current_status IN ('APPLIED', 'INTERVIEW') AND ghosted_at IS NULL AND archived_at IS NULL;
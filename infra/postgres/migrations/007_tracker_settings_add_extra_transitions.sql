@@ -0,0 +1,7 @@
+-- Migration 007 — Per-user customizable transition rules
+-- Edges the user allows on top of the default Kanban state machine.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS extra_transitions JSONB NOT NULL DEFAULT '[]';
+  -- [{ "from": "TO_APPLY", "to": "INTERVIEW" }]
@@ -0,0 +1,22 @@
+-- Migration 008 — User-defined custom Kanban columns
+-- Each column subdivides a canonical application_status; applications may
+-- reference one (NULL = the status' default lane).
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS board_columns (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        VARCHAR(64) NOT NULL,
+  status      application_status NOT NULL,
+  position    INT NOT NULL DEFAULT 0,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, name)
+);
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS column_id UUID REFERENCES board_columns(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_column_id
+  ON applications (column_id)
+  WHERE column_id IS NOT NULL;
@@ -0,0 +1,14 @@
+-- Migration 009 — Priority field on applications
+-- The enum is declared in ascending order so ORDER BY priority DESC puts HIGH first.
+-- Safe to run multiple times (idempotent).
+
+DO $$
+BEGIN
+  CREATE TYPE application_priority AS ENUM ('LOW', 'MEDIUM', 'HIGH');
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END
+$$;
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS priority application_priority NOT NULL DEFAULT 'MEDIUM';
@@ -0,0 +1,11 @@
+-- Migration 010 — Next-step due date on applications
+-- Take-home tests and offer deadlines as first-class data rather than notes.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS next_step_due_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS next_step_label  VARCHAR(200);
+
+CREATE INDEX IF NOT EXISTS idx_applications_next_step_due
+  ON applications (user_id, next_step_due_at)
+  WHERE next_step_due_at IS NOT NULL;
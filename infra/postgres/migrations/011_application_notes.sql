@@ -0,0 +1,23 @@
+-- Migration 011 — Append-only notes per application
+-- applications.user_notes is kept as a denormalized copy of the latest note
+-- so board cards can show it without a join.
+-- Existing single notes are carried over as each application's first note.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS application_notes (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  body            TEXT NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  edited_at       TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_notes_application_id
+  ON application_notes (application_id, created_at);
+
+INSERT INTO application_notes (application_id, user_id, body, created_at)
+SELECT a.id, a.user_id, a.user_notes, a.updated_at
+FROM applications a
+WHERE a.user_notes IS NOT NULL AND a.user_notes <> ''
+  AND NOT EXISTS (SELECT 1 FROM application_notes n WHERE n.application_id = a.id);
@@ -0,0 +1,22 @@
+-- Migration 012 — Attachments (CVs, documents) per application
+-- File bytes live in S3/MinIO under object_key; this table holds metadata
+-- and is what per-user storage quotas are computed from.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS attachments (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  kind            VARCHAR(16) NOT NULL DEFAULT 'OTHER' CHECK (kind IN ('CV', 'COVER_LETTER', 'OTHER')),
+  file_name       VARCHAR(255) NOT NULL,
+  content_type    VARCHAR(127) NOT NULL,
+  size_bytes      BIGINT NOT NULL CHECK (size_bytes > 0),
+  object_key      TEXT NOT NULL UNIQUE,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_application_id
+  ON attachments (application_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_user_id
+  ON attachments (user_id);
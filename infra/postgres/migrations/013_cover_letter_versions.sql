@@ -0,0 +1,41 @@
+-- Migration 013 — Cover letter version history
+-- Every value written to applications.generated_cover_letter (by the AI Coach
+-- or a restore) is kept as a numbered version, via a trigger so that every
+-- writer is covered. The column keeps holding the current version.
+-- Existing letters become version 1.
+-- Safe to run multiple times (IF NOT EXISTS / OR REPLACE / idempotent).
+
+CREATE TABLE IF NOT EXISTS cover_letter_versions (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  version         INT NOT NULL,
+  body            TEXT NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (application_id, version)
+);
+
+CREATE OR REPLACE FUNCTION trigger_record_cover_letter_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.generated_cover_letter IS NOT NULL
+     AND (TG_OP = 'INSERT' OR NEW.generated_cover_letter IS DISTINCT FROM OLD.generated_cover_letter) THEN
+    INSERT INTO cover_letter_versions (application_id, user_id, version, body)
+    SELECT NEW.id, NEW.user_id, COALESCE(MAX(version), 0) + 1, NEW.generated_cover_letter
+    FROM cover_letter_versions
+    WHERE application_id = NEW.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS record_cover_letter_version ON applications;
+CREATE TRIGGER record_cover_letter_version
+  AFTER INSERT OR UPDATE OF generated_cover_letter ON applications
+  FOR EACH ROW EXECUTE FUNCTION trigger_record_cover_letter_version();
+
+INSERT INTO cover_letter_versions (application_id, user_id, version, body, created_at)
+SELECT a.id, a.user_id, 1, a.generated_cover_letter, a.updated_at
+FROM applications a
+WHERE a.generated_cover_letter IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM cover_letter_versions v WHERE v.application_id = a.id);
@@ -0,0 +1,14 @@
+-- Migration 014 — Transactional outbox for Tracker domain events
+-- Events are written here in the same transaction as the change they
+-- describe; the Tracker's outbox relay publishes them to Redis Streams and
+-- deletes them, so an event can no longer be lost between COMMIT and publish.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+  id          BIGSERIAL PRIMARY KEY,
+  stream      VARCHAR(100) NOT NULL,
+  payload     JSONB NOT NULL,
+  attempts    INT NOT NULL DEFAULT 0,
+  last_error  TEXT,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
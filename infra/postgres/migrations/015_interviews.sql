@@ -0,0 +1,23 @@
+-- Migration 015 — Interview rounds per application
+-- One row per scheduled (or past) interview: phone screen, technical round,
+-- onsite… with who ran it and how it went.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS interviews (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  round           INT NOT NULL CHECK (round BETWEEN 1 AND 50),
+  type            VARCHAR(16) NOT NULL DEFAULT 'OTHER'
+                  CHECK (type IN ('PHONE_SCREEN', 'TECHNICAL', 'BEHAVIORAL', 'ONSITE', 'HR', 'FINAL', 'OTHER')),
+  scheduled_at    TIMESTAMPTZ,
+  interviewer     VARCHAR(200),
+  outcome         VARCHAR(16) NOT NULL DEFAULT 'PENDING'
+                  CHECK (outcome IN ('PENDING', 'PASSED', 'FAILED', 'CANCELLED')),
+  notes           TEXT,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_interviews_application_id
+  ON interviews (application_id, round);
@@ -0,0 +1,10 @@
+-- Migration 016 — Structured post-interview feedback
+-- What went well, red flags and the questions asked, recorded with the
+-- interview's outcome. feedback_at stays NULL until feedback is recorded.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE interviews
+  ADD COLUMN IF NOT EXISTS went_well       TEXT,
+  ADD COLUMN IF NOT EXISTS red_flags       TEXT,
+  ADD COLUMN IF NOT EXISTS questions_asked TEXT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS feedback_at     TIMESTAMPTZ;
@@ -0,0 +1,30 @@
+-- Migration 017 — Contacts (recruiters, hiring managers)
+-- A contact belongs to a user, optionally names the company it works for and
+-- can be linked to any number of that user's applications.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS contacts (
+  id                 UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id            UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name               VARCHAR(200) NOT NULL,
+  role               VARCHAR(200),
+  email              VARCHAR(320),
+  linkedin_url       VARCHAR(2048),
+  company            VARCHAR(255),
+  last_contacted_at  TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS application_contacts (
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  contact_id      UUID NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (application_id, contact_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contacts_user_company
+  ON contacts (user_id, lower(company));
+
+CREATE INDEX IF NOT EXISTS idx_application_contacts_contact_id
+  ON application_contacts (contact_id);
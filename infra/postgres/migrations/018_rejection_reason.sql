@@ -0,0 +1,24 @@
+-- Migration 018 — Structured rejection reason and stage
+-- Why an application was rejected and the status it happened at, set when
+-- moving to REJECTED and cleared when leaving it. Existing rejected cards get
+-- their stage back from history_log.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS rejection_reason VARCHAR(30)
+    CHECK (rejection_reason IN ('NO_RESPONSE', 'AFTER_SCREENING', 'AFTER_INTERVIEW', 'SALARY_MISMATCH', 'WITHDREW')),
+  ADD COLUMN IF NOT EXISTS rejection_stage  application_status;
+
+UPDATE applications a
+SET rejection_stage = (
+  SELECT (h.e->>'from')::application_status
+  FROM jsonb_array_elements(a.history_log) WITH ORDINALITY AS h(e, i)
+  WHERE h.e->>'to' = 'REJECTED' AND COALESCE(h.e->>'from', '') <> ''
+  ORDER BY h.i DESC
+  LIMIT 1
+)
+WHERE a.current_status = 'REJECTED' AND a.rejection_stage IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_rejections
+  ON applications (user_id, rejection_reason, rejection_stage)
+  WHERE current_status = 'REJECTED';
@@ -0,0 +1,21 @@
+-- Migration 019 — Offer details
+-- The terms of a job offer, one row per application that reached OFFER, so
+-- competing offers can be compared. Amounts are yearly, gross, in currency.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS offers (
+  application_id     UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id            UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  currency           CHAR(3) NOT NULL DEFAULT 'EUR',  -- ISO 4217
+  base_salary        BIGINT CHECK (base_salary >= 0),
+  bonus              BIGINT CHECK (bonus >= 0),
+  equity_value       BIGINT CHECK (equity_value >= 0), -- estimated value per year
+  equity_details     VARCHAR(500),                     -- e.g. "0.1% over 4 years, 1y cliff"
+  start_date         DATE,
+  response_deadline  TIMESTAMPTZ,
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_offers_user_id
+  ON offers (user_id);
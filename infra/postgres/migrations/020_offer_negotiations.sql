@@ -0,0 +1,21 @@
+-- Migration 020 — Offer negotiation log
+-- Counter-offers, revised offers and notes exchanged while negotiating an
+-- offer, with the amounts proposed at each step.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS offer_negotiations (
+  id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  application_id  UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  kind            VARCHAR(16) NOT NULL DEFAULT 'NOTE'
+                  CHECK (kind IN ('COUNTER_OFFER', 'REVISED_OFFER', 'NOTE')),
+  base_salary     BIGINT CHECK (base_salary >= 0),
+  bonus           BIGINT CHECK (bonus >= 0),
+  equity_value    BIGINT CHECK (equity_value >= 0),
+  note            TEXT,
+  occurred_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_offer_negotiations_application_id
+  ON offer_negotiations (application_id, occurred_at);
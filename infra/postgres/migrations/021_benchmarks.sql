@@ -0,0 +1,16 @@
+-- Migration 021 — Anonymous benchmark statistics
+-- share_benchmarks: opt-in to contribute to (and see) the benchmarks.
+-- benchmark_stats holds the aggregates computed nightly from opted-in users,
+-- one row per job title cohort ('' = all titles); no per-user data is kept.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS share_benchmarks BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS benchmark_stats (
+  title_key              VARCHAR(500) PRIMARY KEY,  -- normalized job title, '' = all titles
+  user_count             INT NOT NULL,
+  median_applications    DOUBLE PRECISION NOT NULL,
+  median_interview_rate  DOUBLE PRECISION NOT NULL, -- interviews / applications sent
+  computed_at            TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,14 @@
+-- Migration 022 — iCalendar feeds
+-- One secret feed URL per user (/calendar/<token>.ics) listing relance
+-- reminders, interviews and offer deadlines. The rendered feed is cached
+-- until the user's applications change (fingerprint).
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+  user_id       UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  token         VARCHAR(64) NOT NULL UNIQUE,
+  ics           TEXT,         -- cached rendering, NULL = not rendered yet
+  fingerprint   VARCHAR(100), -- state of the applications ics was rendered from
+  generated_at  TIMESTAMPTZ,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,37 @@
+-- Migration 023 — Google Calendar integration
+-- OAuth connection of a user's Google account (tokens sealed with
+-- TOKEN_ENCRYPTION_KEY), pending consent requests, and the events pushed to
+-- the "JobMate" calendar, so reschedules made in Google can be applied back.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS google_calendar_links (
+  user_id                  UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  calendar_id              VARCHAR(255) NOT NULL,  -- the "JobMate" secondary calendar
+  refresh_token            BYTEA NOT NULL,         -- sealed (AES-GCM, TOKEN_ENCRYPTION_KEY)
+  access_token             BYTEA,                  -- sealed
+  access_token_expires_at  TIMESTAMPTZ,
+  sync_token               TEXT,                   -- Google incremental sync position
+  needs_reconnect          BOOLEAN NOT NULL DEFAULT FALSE, -- grant revoked, sync paused
+  sync_started_at          TIMESTAMPTZ,            -- lease of the replica syncing it
+  last_synced_at           TIMESTAMPTZ,
+  last_error               TEXT,
+  connected_at             TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS google_calendar_auth_states (
+  state       VARCHAR(64) PRIMARY KEY,
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  expires_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS google_calendar_events (
+  user_id          UUID NOT NULL REFERENCES google_calendar_links(user_id) ON DELETE CASCADE,
+  uid              VARCHAR(100) NOT NULL,   -- calendar feed UID, e.g. interview-<id>@jobmate
+  source_kind      VARCHAR(16) NOT NULL CHECK (source_kind IN ('relance', 'interview', 'offer')),
+  source_id        UUID NOT NULL,           -- application, or interview for interviews
+  google_event_id  VARCHAR(1024) NOT NULL,
+  start_at         TIMESTAMPTZ NOT NULL,    -- as last pushed or pulled
+  fingerprint      VARCHAR(64) NOT NULL,    -- content last pushed
+  detached         BOOLEAN NOT NULL DEFAULT FALSE, -- deleted in Google by the user
+  PRIMARY KEY (user_id, uid)
+);
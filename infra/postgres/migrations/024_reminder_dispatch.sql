@@ -0,0 +1,38 @@
+-- Migration 024 — Relance reminder dispatch
+-- The reminder worker publishes EVENT_RELANCE_DUE once relance_reminder_at
+-- is reached and stamps relance_reminder_fired_at. Changing the reminder
+-- re-arms it (trigger), whoever writes it. Users pick the delivery channels.
+-- Reminders already more than a day overdue are marked fired so deploying
+-- does not flood users with stale nudges.
+-- Safe to run multiple times (IF NOT EXISTS / OR REPLACE / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS relance_reminder_fired_at TIMESTAMPTZ;
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS reminder_channels TEXT[] NOT NULL DEFAULT '{IN_APP,PUSH}';
+
+CREATE OR REPLACE FUNCTION trigger_rearm_relance_reminder()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.relance_reminder_at IS DISTINCT FROM OLD.relance_reminder_at THEN
+    NEW.relance_reminder_fired_at := NULL;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS rearm_relance_reminder ON applications;
+CREATE TRIGGER rearm_relance_reminder
+  BEFORE UPDATE OF relance_reminder_at ON applications
+  FOR EACH ROW EXECUTE FUNCTION trigger_rearm_relance_reminder();
+
+UPDATE applications
+SET relance_reminder_fired_at = NOW()
+WHERE relance_reminder_at < NOW() - INTERVAL '1 day'
+  AND relance_reminder_fired_at IS NULL;
+
+-- Reminder worker scan: armed reminders
+CREATE INDEX IF NOT EXISTS idx_applications_relance_due
+  ON applications (relance_reminder_at)
+  WHERE relance_reminder_at IS NOT NULL AND relance_reminder_fired_at IS NULL;
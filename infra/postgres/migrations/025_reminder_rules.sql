@@ -0,0 +1,17 @@
+-- Migration 025 — Reminder rules
+-- Relance reminders relative to a card's status ("10 days after applying",
+-- "every 7 days until the status changes"), evaluated by the reminder worker
+-- alongside the fixed relance_reminder_at. relance_rule_fired_at is the last
+-- time the rule fired; the rule starts over when the card re-enters its status.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS relance_rule_status     application_status,
+  ADD COLUMN IF NOT EXISTS relance_rule_after_days INT CHECK (relance_rule_after_days BETWEEN 1 AND 365),
+  ADD COLUMN IF NOT EXISTS relance_rule_every_days INT CHECK (relance_rule_every_days BETWEEN 0 AND 365),
+  ADD COLUMN IF NOT EXISTS relance_rule_fired_at   TIMESTAMPTZ;
+
+-- Reminder worker scan: cards sitting in the status their rule targets
+CREATE INDEX IF NOT EXISTS idx_applications_relance_rules
+  ON applications (relance_rule_status)
+  WHERE relance_rule_status IS NOT NULL;
@@ -0,0 +1,7 @@
+-- Migration 026 — User timezone
+-- IANA time zone of each user, so wall-clock reminder times ("remind me at
+-- 9am") are read in their timezone. Reminders themselves stay in UTC.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
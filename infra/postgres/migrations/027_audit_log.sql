@@ -0,0 +1,44 @@
+-- Migration 027 — Audit log
+-- Append-only record of every mutating operation (who, what, when, target
+-- row before → after) written by the tracker's gRPC interceptor and the
+-- discovery service, queried through the tracker's ListAuditLog. Rows
+-- cannot be updated or deleted, except by a retention/erasure job that sets
+-- jobmate.audit_purge = 'on' in its transaction.
+-- Safe to run multiple times (IF NOT EXISTS / OR REPLACE / idempotent).
+
+CREATE TABLE IF NOT EXISTS audit_log (
+  id             BIGSERIAL PRIMARY KEY,
+  occurred_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  service        VARCHAR(30) NOT NULL,     -- tracker, discovery
+  actor_id       UUID,                     -- no FK: entries outlive their users; NULL = unauthenticated/job
+  action         VARCHAR(100) NOT NULL,    -- <service>.<RPC>, e.g. tracker.MoveCard
+  resource_type  VARCHAR(30),              -- application, contact, settings, job_feed, …
+  resource_id    TEXT,
+  old_values     JSONB,                    -- target row before the operation
+  new_values     JSONB,                    -- and after
+  request        JSONB,                    -- secrets redacted
+  outcome        VARCHAR(30) NOT NULL      -- OK or the gRPC status code of the failure
+);
+
+CREATE OR REPLACE FUNCTION trigger_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF current_setting('jobmate.audit_purge', true) IS DISTINCT FROM 'on' THEN
+    RAISE EXCEPTION 'audit_log is append-only';
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+  BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+  FOR EACH STATEMENT EXECUTE FUNCTION trigger_audit_log_append_only();
+
+-- ListAuditLog: by actor (users, support) and by resource (debugging)
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor
+  ON audit_log (actor_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_resource
+  ON audit_log (resource_id, id DESC)
+  WHERE resource_id IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Migration 028 — Search archival on HIRED preferences
+-- Moving a card to HIRED deactivates the search_config it came from. Users
+-- running several searches in parallel can turn that off for all of them
+-- (tracker_settings) or for a single search (search_configs).
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS archive_search_on_hired BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE search_configs
+  ADD COLUMN IF NOT EXISTS archive_on_hired BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,25 @@
+-- Migration 029 — Board shares
+-- Read-only access to a user's board granted to another identity (a career
+-- coach, a mentor): either a JobMate user (grantee_id) or whoever holds a
+-- token, of which only the SHA-256 is stored. Shares expire and can be
+-- restricted to some statuses.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS board_shares (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  owner_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  grantee_id  UUID REFERENCES users(id) ON DELETE CASCADE, -- NULL = token share
+  token_hash  BYTEA UNIQUE,                  -- SHA-256 of the token, token shares only
+  statuses    application_status[] NOT NULL DEFAULT '{}', -- visible columns ({} = all)
+  expires_at  TIMESTAMPTZ NOT NULL,
+  revoked_at  TIMESTAMPTZ,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK ((grantee_id IS NULL) <> (token_hash IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_shares_owner
+  ON board_shares (owner_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_board_shares_grantee
+  ON board_shares (grantee_id)
+  WHERE grantee_id IS NOT NULL;
@@ -0,0 +1,42 @@
+-- Migration 030 — Outgoing webhooks
+-- URLs users register to receive some of their tracker events (card moved,
+-- application created, reminder due), signed with a per-webhook secret
+-- (stored sealed with TOKEN_ENCRYPTION_KEY). Each event to deliver is a
+-- webhook_deliveries row, retried with backoff until delivered or DEAD.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS webhooks (
+  id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  url         VARCHAR(2048) NOT NULL,
+  secret      BYTEA NOT NULL,               -- sealed HMAC-SHA256 signing key
+  events      TEXT[] NOT NULL,              -- subscribed event types, e.g. {EVENT_CARD_MOVED}
+  is_active   BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  webhook_id       UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+  event_type       VARCHAR(100) NOT NULL,
+  payload          JSONB NOT NULL,
+  status           VARCHAR(16) NOT NULL DEFAULT 'PENDING'
+                   CHECK (status IN ('PENDING', 'DELIVERED', 'DEAD')),
+  attempts         INT NOT NULL DEFAULT 0,
+  next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_status_code INT,                     -- HTTP status of the last attempt, NULL = no response
+  last_error       TEXT,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  delivered_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_user_id
+  ON webhooks (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+  ON webhook_deliveries (next_attempt_at)
+  WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook
+  ON webhook_deliveries (webhook_id, created_at DESC);
@@ -0,0 +1,63 @@
+-- Migration 031 — Field encryption
+-- Notes, cover letters and offer terms are sealed by the tracker
+-- (FIELD_ENCRYPTION_KEYS) before they are stored: "enc:<key id>:<base64>"
+-- text. Offer and negotiation amounts, BIGINT columns until now, move to a
+-- compensation column holding their JSON ({"baseSalary": …}), sealed by the
+-- tracker's field-resealer job along with the other values still in clear.
+-- Resealing sets jobmate.reseal = 'on' in its transaction so updated_at and
+-- the cover letter history are left alone.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE offers ALTER COLUMN equity_details TYPE TEXT;
+ALTER TABLE offers ADD COLUMN IF NOT EXISTS compensation TEXT;
+ALTER TABLE offer_negotiations ADD COLUMN IF NOT EXISTS compensation TEXT;
+
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM information_schema.columns
+             WHERE table_name = 'offers' AND column_name = 'base_salary') THEN
+    UPDATE offers
+    SET compensation = jsonb_strip_nulls(jsonb_build_object(
+          'baseSalary', NULLIF(base_salary, 0), 'bonus', NULLIF(bonus, 0),
+          'equityValue', NULLIF(equity_value, 0)))::text
+    WHERE compensation IS NULL
+      AND COALESCE(base_salary, 0) + COALESCE(bonus, 0) + COALESCE(equity_value, 0) > 0;
+    ALTER TABLE offers DROP COLUMN base_salary, DROP COLUMN bonus, DROP COLUMN equity_value;
+  END IF;
+
+  IF EXISTS (SELECT 1 FROM information_schema.columns
+             WHERE table_name = 'offer_negotiations' AND column_name = 'base_salary') THEN
+    UPDATE offer_negotiations
+    SET compensation = jsonb_strip_nulls(jsonb_build_object(
+          'baseSalary', NULLIF(base_salary, 0), 'bonus', NULLIF(bonus, 0),
+          'equityValue', NULLIF(equity_value, 0)))::text
+    WHERE compensation IS NULL
+      AND COALESCE(base_salary, 0) + COALESCE(bonus, 0) + COALESCE(equity_value, 0) > 0;
+    ALTER TABLE offer_negotiations DROP COLUMN base_salary, DROP COLUMN bonus, DROP COLUMN equity_value;
+  END IF;
+END $$;
+
+CREATE OR REPLACE FUNCTION trigger_set_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF current_setting('jobmate.reseal', true) IS DISTINCT FROM 'on' THEN
+    NEW.updated_at = NOW();
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION trigger_record_cover_letter_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.generated_cover_letter IS NOT NULL
+     AND current_setting('jobmate.reseal', true) IS DISTINCT FROM 'on'
+     AND (TG_OP = 'INSERT' OR NEW.generated_cover_letter IS DISTINCT FROM OLD.generated_cover_letter) THEN
+    INSERT INTO cover_letter_versions (application_id, user_id, version, body)
+    SELECT NEW.id, NEW.user_id, COALESCE(MAX(version), 0) + 1, NEW.generated_cover_letter
+    FROM cover_letter_versions
+    WHERE application_id = NEW.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
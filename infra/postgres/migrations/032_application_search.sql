@@ -0,0 +1,22 @@
+-- Migration 032 — Application search index
+-- One row per application for SearchApplications: a tsvector over its job
+-- title, company, notes and cover letter. Those are sealed, so the lexemes
+-- are stored as keyed hashes (the active FIELD_ENCRYPTION_KEYS key) rather
+-- than in clear. Rows are rebuilt by the tracker when the application
+-- changed since indexed_at or version (text search configuration and key)
+-- is not the current one.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS application_search (
+  application_id  UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  document        TSVECTOR NOT NULL,
+  version         TEXT NOT NULL,               -- "<text search config>:<key ID>"
+  indexed_at      TIMESTAMPTZ NOT NULL         -- applications.updated_at indexed
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_search_document
+  ON application_search USING GIN (document);
+
+CREATE INDEX IF NOT EXISTS idx_application_search_user_id
+  ON application_search (user_id);
@@ -0,0 +1,9 @@
+-- Migration 033 — Follow-up drafts
+-- RequestFollowUpDraft asks the AI Coach (CMD_GENERATE_FOLLOWUP) for a
+-- follow-up message to send about an application; the AI Coach writes it to
+-- the application, in clear, and the tracker's field-resealer job seals it
+-- like the cover letter. Each draft replaces the previous one.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS followup_draft TEXT;
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS followup_draft_at TIMESTAMPTZ;
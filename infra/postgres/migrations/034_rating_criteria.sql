@@ -0,0 +1,14 @@
+-- Migration 034 — Structured ratings
+-- RateApplication may score an application per criterion (role fit,
+-- compensation, company culture, commute: 1–5 each, any subset) with a short
+-- review. user_rating stays the star rating every client reads: the rounded
+-- mean of the criteria when they are given. The review is sealed by the
+-- tracker like notes.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications
+  ADD COLUMN IF NOT EXISTS rating_criteria JSONB
+    CHECK (jsonb_typeof(rating_criteria) = 'object'
+           AND rating_criteria - ARRAY['roleFit', 'compensation', 'culture', 'commute'] = '{}'::jsonb
+           AND NOT jsonb_path_exists(rating_criteria, '$.* ? (@.type() != "number" || @ < 1 || @ > 5)'));
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS rating_review TEXT;
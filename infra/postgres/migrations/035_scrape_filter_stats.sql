@@ -0,0 +1,16 @@
+-- Migration 035 — Scrape filter statistics
+-- The discovery service counts, per search config and day, what became of
+-- the offers it scraped: inserted, already in the feed, or filtered out by a
+-- rule (a red flag, salary_min, keywords). GetFilterStats sums the recent
+-- days so users can see why their feed is empty; rows older than 90 days
+-- are pruned.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS scrape_filter_stats (
+  search_config_id  UUID NOT NULL REFERENCES search_configs(id) ON DELETE CASCADE,
+  day               DATE NOT NULL,
+  rule              VARCHAR(20) NOT NULL,          -- INSERTED, DUPLICATE, RED_FLAG, SALARY, KEYWORDS
+  detail            VARCHAR(255) NOT NULL DEFAULT '', -- RED_FLAG: the keyword that matched
+  offers            INT NOT NULL,
+  PRIMARY KEY (search_config_id, day, rule, detail)
+);
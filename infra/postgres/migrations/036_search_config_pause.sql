@@ -0,0 +1,7 @@
+-- Migration 036 — Pausing a search
+-- A paused search config is skipped by the Discovery Service's scrapes but
+-- stays active: pausing is the user's "not now", independent of is_active
+-- (deleted, or archived on HIRED) and of completed_at.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE search_configs ADD COLUMN IF NOT EXISTS paused_at TIMESTAMPTZ;
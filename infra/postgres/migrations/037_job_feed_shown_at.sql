@@ -0,0 +1,7 @@
+-- Migration 037 — Feed items seen by the user
+-- shown_at is when the user first actually viewed a job_feed entry (the
+-- Gateway batches these receipts into the Discovery Service's MarkShown),
+-- so "new since you last looked" is told apart from "recently inserted".
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE job_feed ADD COLUMN IF NOT EXISTS shown_at TIMESTAMPTZ;
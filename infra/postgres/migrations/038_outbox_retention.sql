@@ -0,0 +1,15 @@
+-- Migration 038 — Replayable outbox
+-- The outbox relay no longer deletes the events it published: it stamps
+-- published_at, and they are kept for OUTBOX_RETENTION so support staff can
+-- replay them (trackerctl replay) to consumers that missed them. The relay
+-- only reads pending rows, hence the partial index.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+  ON outbox_events (id)
+  WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_created_at
+  ON outbox_events (created_at);
@@ -0,0 +1,33 @@
+-- Migration 039 — Retention of closed applications
+-- REJECTED and WITHDRAWN cards left untouched for CLOSED_RETENTION_MONTHS
+-- are compacted by the tracker's closed-retention job — AI analysis details,
+-- cover letters, follow-up drafts, non-move history entries and the job's
+-- scraped payload are dropped, a summary row is kept (compacted_at) — or
+-- deleted, for users who asked for it (tracker_settings.closed_retention).
+-- The job sets jobmate.retention = 'on' in its transaction so compacting a
+-- card does not bump its updated_at.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS compacted_at TIMESTAMPTZ;
+
+ALTER TABLE tracker_settings
+  ADD COLUMN IF NOT EXISTS closed_retention VARCHAR(10) NOT NULL DEFAULT 'COMPACT'
+    CHECK (closed_retention IN ('COMPACT', 'DELETE'));
+
+CREATE OR REPLACE FUNCTION trigger_set_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+  -- Resealing sensitive fields (jobmate.reseal) and compacting closed
+  -- applications (jobmate.retention) are not updates.
+  IF current_setting('jobmate.reseal', true) IS DISTINCT FROM 'on'
+     AND current_setting('jobmate.retention', true) IS DISTINCT FROM 'on' THEN
+    NEW.updated_at = NOW();
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Retention job scan: closed cards by age
+CREATE INDEX IF NOT EXISTS idx_applications_closed
+  ON applications (updated_at)
+  WHERE current_status IN ('REJECTED', 'WITHDRAWN');
@@ -0,0 +1,135 @@
+-- Migration 040 — Monthly partitions of job_feed
+-- job_feed is append-heavy and scanned by status and date: it becomes a
+-- table partitioned by created_at, one partition per month
+-- (job_feed_YYYY_MM) plus job_feed_default. The Discovery Service creates
+-- the coming months' partitions (job_feed_create_partitions) and drops
+-- those older than JOB_FEED_RETENTION_MONTHS (job_feed_drop_partitions),
+-- keeping the offers applications were made from in job_feed_default.
+--
+-- A partitioned table's primary key must include the partition key, so it
+-- becomes (id, created_at) and applications.job_feed_id can no longer be a
+-- foreign key: it stays a plain reference (the tracker LEFT JOINs it).
+-- Offer deduplication is indexed per partition: (search_config_id,
+-- source_url) for scrapes, (user_id, source_url) for AddJobByUrl.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE OR REPLACE FUNCTION job_feed_create_partitions(since TIMESTAMPTZ, months_ahead INT)
+RETURNS INT AS $$
+DECLARE
+  m       TIMESTAMP := date_trunc('month', since AT TIME ZONE 'UTC');
+  horizon TIMESTAMP := date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => months_ahead);
+  lo      TIMESTAMPTZ;
+  hi      TIMESTAMPTZ;
+  part    TEXT;
+  created INT := 0;
+BEGIN
+  -- Replicas may run this side by side.
+  PERFORM pg_advisory_xact_lock(hashtext('job_feed_partitions'));
+  WHILE m <= horizon LOOP
+    part := 'job_feed_' || to_char(m, 'YYYY_MM');
+    IF to_regclass(part) IS NULL THEN
+      lo := m AT TIME ZONE 'UTC';
+      hi := (m + INTERVAL '1 month') AT TIME ZONE 'UTC';
+      -- Rows inserted while the month had no partition went to
+      -- job_feed_default: move them before attaching it.
+      EXECUTE format('CREATE TABLE %I (LIKE job_feed INCLUDING DEFAULTS)', part);
+      EXECUTE format('WITH moved AS (DELETE FROM job_feed_default WHERE created_at >= %L AND created_at < %L RETURNING *)
+                      INSERT INTO %I SELECT * FROM moved', lo, hi, part);
+      EXECUTE format('ALTER TABLE job_feed ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)', part, lo, hi);
+      created := created + 1;
+    END IF;
+    m := m + INTERVAL '1 month';
+  END LOOP;
+  RETURN created;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION job_feed_drop_partitions(retention_months INT)
+RETURNS INT AS $$
+DECLARE
+  cutoff  TIMESTAMP := date_trunc('month', NOW() AT TIME ZONE 'UTC') - make_interval(months => retention_months);
+  p       RECORD;
+  dropped INT := 0;
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('job_feed_partitions'));
+  FOR p IN
+    SELECT c.relname
+    FROM pg_inherits i
+    JOIN pg_class c ON c.oid = i.inhrelid
+    WHERE i.inhparent = 'job_feed'::regclass
+      AND c.relname ~ '^job_feed_[0-9]{4}_[0-9]{2}$'
+      AND to_date(right(c.relname, 7), 'YYYY_MM') + INTERVAL '1 month' <= cutoff
+    ORDER BY c.relname
+  LOOP
+    -- Once detached, the month is no partition's: the offers applications
+    -- were made from, copied back, land in job_feed_default.
+    EXECUTE format('ALTER TABLE job_feed DETACH PARTITION %I', p.relname);
+    EXECUTE format('INSERT INTO job_feed SELECT * FROM %I j
+                    WHERE EXISTS (SELECT 1 FROM applications a WHERE a.job_feed_id = j.id)', p.relname);
+    EXECUTE format('DROP TABLE %I', p.relname);
+    dropped := dropped + 1;
+  END LOOP;
+  RETURN dropped;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+BEGIN
+  IF (SELECT relkind FROM pg_class WHERE oid = 'job_feed'::regclass) = 'p' THEN
+    RETURN;
+  END IF;
+
+  ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_job_feed_id_fkey;
+
+  ALTER TABLE job_feed RENAME TO job_feed_legacy;
+  ALTER TABLE job_feed_legacy RENAME CONSTRAINT job_feed_pkey TO job_feed_legacy_pkey;
+  DROP INDEX IF EXISTS idx_job_feed_search_config_id;
+  DROP INDEX IF EXISTS idx_job_feed_status;
+  DROP INDEX IF EXISTS idx_job_feed_expires_at;
+
+  CREATE TABLE job_feed (
+    id                  UUID NOT NULL DEFAULT uuid_generate_v4(),
+    user_id             UUID REFERENCES users(id) ON DELETE CASCADE,
+    search_config_id    UUID REFERENCES search_configs(id) ON DELETE SET NULL,
+    raw_data            JSONB NOT NULL DEFAULT '{}',
+    source_url          TEXT,
+    status              job_status NOT NULL DEFAULT 'PENDING',
+    is_manual           BOOLEAN NOT NULL DEFAULT FALSE,
+    title               VARCHAR(512),
+    description         TEXT,
+    company_name        VARCHAR(255),
+    company_description TEXT,
+    why_us              TEXT,
+    shown_at            TIMESTAMPTZ,
+    expires_at          TIMESTAMPTZ NOT NULL DEFAULT (NOW() + INTERVAL '30 days'),
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at)
+  ) PARTITION BY RANGE (created_at);
+
+  CREATE TABLE job_feed_default PARTITION OF job_feed DEFAULT;
+  PERFORM job_feed_create_partitions(COALESCE((SELECT MIN(created_at) FROM job_feed_legacy), NOW()), 3);
+
+  INSERT INTO job_feed
+    (id, user_id, search_config_id, raw_data, source_url, status, is_manual, title, description,
+     company_name, company_description, why_us, shown_at, expires_at, created_at)
+  SELECT id, user_id, search_config_id, raw_data, source_url, status, is_manual, title, description,
+         company_name, company_description, why_us, shown_at, expires_at, created_at
+  FROM job_feed_legacy;
+
+  DROP TABLE job_feed_legacy;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_search_config_id
+  ON job_feed (search_config_id);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_status
+  ON job_feed (status);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_expires_at
+  ON job_feed (expires_at);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_config_source_url
+  ON job_feed (search_config_id, source_url);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_user_source_url
+  ON job_feed (user_id, source_url);
@@ -0,0 +1,58 @@
+-- Migration 041 — Offers shared between search configs
+-- A scraped offer is stored once: when another config's scrape finds it
+-- again (same source_url) while it is still live, the config is linked to
+-- the existing job_feed row through job_feed_matches instead of getting a
+-- copy. Each match keeps its own triage state (status, shown_at), so the
+-- linked config's user triages the offer independently of its owner.
+-- Deduplication now looks source_url up across configs, hence the index.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS job_feed_matches (
+  job_feed_id       UUID NOT NULL,               -- job_feed(id); no FOREIGN KEY, job_feed is partitioned
+  search_config_id  UUID NOT NULL REFERENCES search_configs(id) ON DELETE CASCADE,
+  status            job_status NOT NULL DEFAULT 'PENDING',
+  shown_at          TIMESTAMPTZ,
+  matched_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (job_feed_id, search_config_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_matches_search_config_id
+  ON job_feed_matches (search_config_id, matched_at);
+
+DROP INDEX IF EXISTS idx_job_feed_config_source_url;
+
+CREATE INDEX IF NOT EXISTS idx_job_feed_source_url
+  ON job_feed (source_url);
+
+-- Matches go with the partitions of their offers.
+CREATE OR REPLACE FUNCTION job_feed_drop_partitions(retention_months INT)
+RETURNS INT AS $$
+DECLARE
+  cutoff  TIMESTAMP := date_trunc('month', NOW() AT TIME ZONE 'UTC') - make_interval(months => retention_months);
+  p       RECORD;
+  dropped INT := 0;
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('job_feed_partitions'));
+  FOR p IN
+    SELECT c.relname
+    FROM pg_inherits i
+    JOIN pg_class c ON c.oid = i.inhrelid
+    WHERE i.inhparent = 'job_feed'::regclass
+      AND c.relname ~ '^job_feed_[0-9]{4}_[0-9]{2}$'
+      AND to_date(right(c.relname, 7), 'YYYY_MM') + INTERVAL '1 month' <= cutoff
+    ORDER BY c.relname
+  LOOP
+    -- Once detached, the month is no partition's: the offers applications
+    -- were made from, copied back, land in job_feed_default.
+    EXECUTE format('ALTER TABLE job_feed DETACH PARTITION %I', p.relname);
+    EXECUTE format('INSERT INTO job_feed SELECT * FROM %I j
+                    WHERE EXISTS (SELECT 1 FROM applications a WHERE a.job_feed_id = j.id)', p.relname);
+    EXECUTE format('DELETE FROM job_feed_matches m USING %I j
+                    WHERE m.job_feed_id = j.id
+                      AND NOT EXISTS (SELECT 1 FROM applications a WHERE a.job_feed_id = j.id)', p.relname);
+    EXECUTE format('DROP TABLE %I', p.relname);
+    dropped := dropped + 1;
+  END LOOP;
+  RETURN dropped;
+END;
+$$ LANGUAGE plpgsql;
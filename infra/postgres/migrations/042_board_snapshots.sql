@@ -0,0 +1,20 @@
+-- Migration 042 — Board snapshots
+-- The tracker's board-snapshots job stores, once per BOARD_SNAPSHOT_INTERVAL
+-- (or on demand, TakeBoardSnapshot), every card of a user's board — its
+-- status, whether it is archived, its company and title — so
+-- CompareSnapshots can tell what changed between two dates without
+-- replaying history logs. Snapshots are kept BOARD_SNAPSHOT_RETENTION.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS board_snapshots (
+  id        UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id   UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  cards     JSONB NOT NULL DEFAULT '[]',   -- [{id, status, archived, company, title}]
+  taken_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_snapshots_user_taken_at
+  ON board_snapshots (user_id, taken_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_board_snapshots_taken_at
+  ON board_snapshots (taken_at);
@@ -0,0 +1,18 @@
+-- Migration 043 — Undo of the last tracker action
+-- The last move, rating change or note edit of each application, with the
+-- values it replaced, so the tracker's UndoLastAction can restore them
+-- within UNDO_GRACE_PERIOD. Replaced values of sealed columns (notes,
+-- reviews) are copied as they are stored, sealed.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS application_last_actions (
+  application_id  UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  user_id         UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  action          VARCHAR(20) NOT NULL CHECK (action IN ('MOVE', 'RATING', 'NOTE_EDIT')),
+  note_id         UUID,                        -- NOTE_EDIT: the note edited
+  previous        JSONB NOT NULL DEFAULT '{}', -- the values the action replaced
+  at              TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_last_actions_user_id
+  ON application_last_actions (user_id);
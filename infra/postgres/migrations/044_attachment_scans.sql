@@ -0,0 +1,18 @@
+-- Migration 044 — Malware scanning of attachments
+-- Uploaded attachments are PENDING until the tracker's scanner (ClamAV or an
+-- external API) finds them CLEAN or QUARANTINED (infected: the object is
+-- deleted, the record kept); only CLEAN and UNSCANNED ones (uploaded while
+-- no scanner was configured, as every existing one) can be downloaded.
+-- scan_next_at leases pending attachments to a scanner run.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE attachments
+  ADD COLUMN IF NOT EXISTS scan_status VARCHAR(16) NOT NULL DEFAULT 'UNSCANNED'
+    CHECK (scan_status IN ('PENDING', 'CLEAN', 'QUARANTINED', 'UNSCANNED'));
+ALTER TABLE attachments ALTER COLUMN scan_status SET DEFAULT 'PENDING';
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS scan_signature TEXT;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS scanned_at TIMESTAMPTZ;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS scan_next_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_attachments_scan_pending
+  ON attachments (scan_next_at) WHERE scan_status = 'PENDING';
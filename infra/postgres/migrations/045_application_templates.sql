@@ -0,0 +1,31 @@
+-- Migration 045 — Application templates
+-- User-defined setups for common kinds of applications (priority, next step
+-- label, reminder rule, scaffold notes) the tracker creates cards from.
+-- Template notes are sealed by the tracker, like application notes.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+CREATE TABLE IF NOT EXISTS application_templates (
+  id                      UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id                 UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name                    VARCHAR(100) NOT NULL,
+  priority                application_priority NOT NULL DEFAULT 'MEDIUM',
+  next_step_label         VARCHAR(200),
+  relance_rule_status     application_status,  -- NULL = the card's status
+  relance_rule_after_days INT CHECK (relance_rule_after_days BETWEEN 1 AND 365),
+  relance_rule_every_days INT CHECK (relance_rule_every_days BETWEEN 0 AND 365),
+  created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS application_template_notes (
+  id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  template_id  UUID NOT NULL REFERENCES application_templates(id) ON DELETE CASCADE,
+  user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  position     INT NOT NULL,
+  body         TEXT NOT NULL,               -- Sealed by the tracker
+  UNIQUE (template_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_template_notes_user_id
+  ON application_template_notes (user_id);
@@ -0,0 +1,35 @@
+-- Migration 046 — Applications of removed offers
+-- applications.job_feed_id lost its foreign key (and its ON DELETE SET NULL)
+-- when job_feed was partitioned (migration 040): a trigger on job_feed now
+-- clears it when the offer is deleted, and the references left dangling
+-- since then are cleared here. Several of a user's applications may then
+-- have no offer, so (user_id, job_feed_id) is only unique when job_feed_id
+-- is set.
+--
+-- The trigger is statement-level on job_feed: rows job_feed_create_partitions
+-- moves out of job_feed_default are deleted from the partition itself and
+-- are not taken for removed.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_user_id_job_feed_id_key;
+ALTER TABLE applications ADD CONSTRAINT applications_user_id_job_feed_id_key UNIQUE (user_id, job_feed_id);
+
+CREATE OR REPLACE FUNCTION trigger_release_job_feed()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE applications a SET job_feed_id = NULL
+  FROM removed r
+  WHERE a.job_feed_id = r.id;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS release_job_feed ON job_feed;
+CREATE TRIGGER release_job_feed
+  AFTER DELETE ON job_feed
+  REFERENCING OLD TABLE AS removed
+  FOR EACH STATEMENT EXECUTE FUNCTION trigger_release_job_feed();
+
+UPDATE applications a SET job_feed_id = NULL
+WHERE a.job_feed_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM job_feed f WHERE f.id = a.job_feed_id);
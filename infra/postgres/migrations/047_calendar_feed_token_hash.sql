@@ -0,0 +1,24 @@
+-- Migration 047 — Hashed calendar feed tokens
+-- calendar_feeds stored the secret of each feed URL in plain text: only its
+-- SHA-256 is kept now, as for board share tokens. Existing URLs keep
+-- working; the tracker only returns a feed's token when it creates or
+-- rotates it.
+-- Safe to run multiple times (IF NOT EXISTS / idempotent).
+
+ALTER TABLE calendar_feeds ADD COLUMN IF NOT EXISTS token_hash BYTEA;
+
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM information_schema.columns
+             WHERE table_name = 'calendar_feeds' AND column_name = 'token') THEN
+    UPDATE calendar_feeds
+    SET token_hash = sha256(convert_to(token, 'UTF8'))
+    WHERE token_hash IS NULL;
+    ALTER TABLE calendar_feeds DROP COLUMN token;
+  END IF;
+END $$;
+
+ALTER TABLE calendar_feeds ALTER COLUMN token_hash SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS calendar_feeds_token_hash_key
+  ON calendar_feeds (token_hash);
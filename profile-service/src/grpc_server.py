@@ -16,6 +16,7 @@ from grpc_reflection.v1alpha import reflection
 import config
 import database
 import redis_client
+import request_context
 
 logger = logging.getLogger(__name__)
 
@@ -118,6 +119,7 @@ class ProfileServicer:
     # ── Profile ────────────────────────────────────────────────────────────────
 
     async def GetProfile(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -150,6 +152,7 @@ class ProfileServicer:
     # ── SearchConfig CRUD ──────────────────────────────────────────────────────
 
     async def GetSearchConfigs(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -166,6 +169,7 @@ class ProfileServicer:
         )
 
     async def CreateSearchConfig(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -198,6 +202,7 @@ class ProfileServicer:
         return _row_to_search_config_proto(dict(row))
 
     async def UpdateSearchConfig(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -237,6 +242,7 @@ class ProfileServicer:
         return _row_to_search_config_proto(dict(row))
 
     async def DeleteSearchConfig(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -252,6 +258,7 @@ class ProfileServicer:
     # ── CV ─────────────────────────────────────────────────────────────────────
 
     async def UploadCV(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -284,6 +291,7 @@ class ProfileServicer:
         return _pb2.UploadCVResponse(cv_url=cv_url, message="CV uploaded successfully")
 
     async def ParseCV(self, request, context):
+        request_context.bind(context.invocation_metadata())
         uid = _user_id_from_ctx(context)
         if not uid:
             await context.abort(grpc.StatusCode.UNAUTHENTICATED, "missing x-user-id")
@@ -10,6 +10,7 @@ from pythonjsonlogger import jsonlogger
 import config
 import database
 import grpc_server
+import request_context
 
 # ─── Logging setup ────────────────────────────────────────────────────────────
 
@@ -20,6 +21,7 @@ def _configure_logging() -> None:
         "%(asctime)s %(name)s %(levelname)s %(message)s"
     )
     handler.setFormatter(formatter)
+    handler.addFilter(request_context.LogFilter())
     root = logging.getLogger()
     root.handlers.clear()
     root.addHandler(handler)
@@ -1,4 +1,4 @@
-"""Async Redis publisher for profile-service."""
+"""Async Redis Streams publisher for profile-service."""
 
 from __future__ import annotations
 
@@ -8,6 +8,8 @@ import logging
 import redis.asyncio as aioredis
 
 import config
+import request_context
+from jobmate_shared import redaction
 
 logger = logging.getLogger(__name__)
 
@@ -21,8 +23,23 @@ def get_client() -> aioredis.Redis:
     return _client
 
 
-async def publish(channel: str, payload: dict) -> None:
+# Messages are appended to a Redis Stream named after the message type, with
+# the JSON document in a single "payload" field; consumers read them through
+# consumer groups, so nothing is lost while they are down.
+STREAM_MAXLEN = 10_000
+
+
+async def publish(stream: str, payload: dict) -> None:
+    payload = redaction.redact(payload)
+    rid = request_context.request_id.get()
+    if rid:
+        payload = {**payload, request_context.FIELD: rid}
     try:
-        await get_client().publish(channel, json.dumps(payload))
+        await get_client().xadd(
+            stream,
+            {"payload": json.dumps(payload)},
+            maxlen=STREAM_MAXLEN,
+            approximate=True,
+        )
     except Exception as exc:
-        logger.warning("Redis publish failed channel=%s err=%s", channel, exc)
+        logger.warning("Redis publish failed stream=%s err=%s", stream, exc)
@@ -0,0 +1,6 @@
+"""
+Code shared by the Python services (profile, discovery, AI coach).
+
+Baked into each image under /opt/jobmate/python-shared, which is on their
+PYTHONPATH; import as `from jobmate_shared import redaction`.
+"""
@@ -0,0 +1,69 @@
+"""
+Redaction of user content — notes, cover letters, email addresses, salary
+figures — from published events, with the same rules as the tracker's
+redact package: values under a sensitive key are replaced by their length
+and email addresses in other strings are masked. Applied by publish, so
+events carry IDs, statuses and sizes only. Shared by every Python service
+that publishes events.
+"""
+
+from __future__ import annotations
+
+import re
+
+# Keys, lowercased without "_" or "-", whose values are user content. Keys
+# containing "email" or "salary" are sensitive too.
+_SENSITIVE_KEYS = frozenset(
+    {
+        "note",
+        "notes",
+        "usernotes",
+        "text",
+        "body",
+        "coverletter",
+        "generatedcoverletter",
+        "followupdraft",
+        "draft",
+        "compensation",
+        "totalcompensation",
+        "basesalary",
+        "bonus",
+        "equityvalue",
+        "equitydetails",
+    }
+)
+
+_EMAIL = re.compile(r"[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}")
+
+
+def is_sensitive(key: str) -> bool:
+    k = key.lower().replace("_", "").replace("-", "")
+    return k in _SENSITIVE_KEYS or "email" in k or "salary" in k
+
+
+def mask(text: str) -> str:
+    """Return text with the email addresses it contains masked."""
+    return _EMAIL.sub("[email]", text) if "@" in text else text
+
+
+def redact(value):
+    """Return a JSON-like value with user content redacted, at any depth."""
+    if isinstance(value, dict):
+        return {
+            k: _sensitive(v) if is_sensitive(k) else redact(v)
+            for k, v in value.items()
+        }
+    if isinstance(value, list):
+        return [redact(v) for v in value]
+    if isinstance(value, str):
+        return mask(value)
+    return value
+
+
+def _sensitive(value):
+    # That there is nothing (null, "") is not sensitive.
+    if value is None or value == "":
+        return value
+    if isinstance(value, str):
+        return f"[redacted {len(value)} chars]"
+    return "[redacted]"
@@ -0,0 +1,45 @@
+"""
+Unit tests for redaction.redact().
+
+Run with:  pytest tests/test_redaction.py -v
+"""
+
+import os
+import sys
+
+# Allow importing jobmate_shared from python-shared/
+sys.path.insert(0, os.path.dirname(os.path.dirname(os.path.abspath(__file__))))
+
+from jobmate_shared import redaction  # noqa: E402
+
+
+class TestRedact:
+    def test_sensitive_values_replaced_by_length(self):
+        out = redaction.redact(
+            {"applicationId": "a1", "coverLetter": "Dear team", "user_notes": "x"}
+        )
+        assert out == {
+            "applicationId": "a1",
+            "coverLetter": "[redacted 9 chars]",
+            "user_notes": "[redacted 1 chars]",
+        }
+
+    def test_nested_amounts_redacted(self):
+        out = redaction.redact({"offer": {"baseSalary": 65000, "currency": "EUR"}})
+        assert out == {"offer": {"baseSalary": "[redacted]", "currency": "EUR"}}
+
+    def test_emails_masked_in_other_strings(self):
+        out = redaction.redact(
+            {"error": "no profile for jane.doe@acme.io", "ids": ["a@b.co"]}
+        )
+        assert out == {"error": "no profile for [email]", "ids": ["[email]"]}
+
+    def test_empty_values_kept(self):
+        assert redaction.redact({"coverLetter": None, "note": ""}) == {
+            "coverLetter": None,
+            "note": "",
+        }
+
+    def test_ids_and_scores_kept(self):
+        event = {"applicationId": "a1", "matchScore": 87, "hasCoverLetter": True}
+        assert redaction.redact(event) == event
@@ -3,20 +3,149 @@
 // Kanban state machine for job applications.
 // Exposes a gRPC API on port 9082 (internal Docker network) used by the
 // Gateway, implementing TrackerService:
-//   - ListApplications — list user's kanban cards
-//   - MoveCard         — state machine transitions
-//   - AddNote          — free-text note update
+//   - ListApplications — list user's kanban cards (cached in Redis per filter)
+//   - BatchGetApplications — up to 200 cards by ID in one call
+//   - GetApplicationByJobFeedId — the card of a feed entry, if tracked
+//   - GetHistory       — paginated, typed history log of a card
+//   - SearchApplications — full-text search of titles, companies, notes and cover letters
+//   - GetTimeline      — one feed of a card's moves, notes, reminders, analyses and interviews
+//   - CreateManualApplication — card for a job found outside JobMate
+//   - ImportApplicationEmail — card from a forwarded confirmation email
+//   - CloneApplication — card for another job with an existing card's setup
+//   - List/Create/Update/DeleteApplicationTemplate /
+//     CreateApplicationFromTemplate — named card setups to create cards from
+//   - MoveCard         — state machine transitions (with rejection reason/stage)
+//   - BulkMove         — same transition for many cards, one transaction
+//   - UndoLastMove     — revert a recent move (within UNDO_GRACE_PERIOD)
+//   - UndoLastAction   — revert a card's recent move, rating change or note
+//     edit (within UNDO_GRACE_PERIOD)
+//   - AddNote / ListNotes / EditNote / DeleteNote — timestamped notes
 //   - RateApplication  — 1-5 star rating
+//   - SetPriority      — LOW/MEDIUM/HIGH priority
+//   - SetNextStep      — next-step due date (take-home test, offer deadline)
+//   - SnoozeReminder / ClearReminder — act on a relance reminder (logged in history)
+//   - SetReminderRule  — status-relative, optionally recurring relance reminders
+//   - UpdateApplication — field-mask patch of several fields at once
+//   - ArchiveApplication / RestoreApplication — hide/unhide a card
+//   - MergeApplications — fold a duplicate card into another
+//   - List/Create/Update/DeleteColumn, MoveToColumn — custom board columns
+//   - ReanalyzeApplication — re-run AI scoring (REANALYZE_COOLDOWN per card)
+//   - RequestFollowUpDraft — AI-drafted follow-up message (FOLLOWUP_COOLDOWN per card)
+//   - ListCoverLetterVersions / RegenerateCoverLetter /
+//     RestoreCoverLetterVersion — versioned AI cover letters
+//   - CreateAttachment / ListAttachments / GetAttachmentDownloadUrl /
+//     DeleteAttachment — files stored in S3/MinIO via presigned URLs,
+//     downloadable once scanned for malware
+//   - Create/List/Update/DeleteInterview — interview rounds (also on GetApplication)
+//   - RecordInterviewFeedback — post-interview debrief + outcome (logged in history)
+//   - SetOfferDetails — salary, bonus, equity, dates of an offer
+//   - CompareOffers — current offers side by side, ranked by total compensation
+//   - Add/List/DeleteNegotiationEntry — counter-offer log per offer
+//   - Create/List/Update/DeleteContact, Link/UnlinkContact — recruiters and
+//     hiring managers, linked to applications
+//   - ListCompanies / GetCompanyOverview — applications grouped by company
+//   - GetRejectionStats — rejections by reason and stage
+//   - GetSearchConfigStats — offers found → applications → interviews → hires per search config
+//   - CountApplicationsByStatus — column header counts (cached in Redis)
+//   - TakeBoardSnapshot / CompareSnapshots — board snapshots, and what
+//     changed between two dates
+//   - GetCalendarFeed / RotateCalendarFeedToken / RenderCalendarFeed — iCal
+//     feed of reminders, interviews and offer deadlines (served by the Gateway)
+//   - Start/CompleteGoogleCalendarAuth, GetGoogleCalendarStatus,
+//     DisconnectGoogleCalendar — optional two-way Google Calendar sync
+//   - GetBenchmark — anonymous median funnel for a job title (opt-in)
+//   - GetSettings / UpdateSettings — per-user tracker preferences
+//   - SetSearchConfigArchival, ReactivateSearchConfig, ReopenSearch — whether
+//     a move to HIRED deactivates the card's search, and reactivating it
+//     (with an immediate CMD_SCRAPE_CONFIG scan)
+//   - ListAuditLog     — query the audit log (own entries; AUDIT_ADMIN_USER_IDS: all)
+//   - ExportUserData / EraseUserData — GDPR data-subject export and erasure
+//   - Create/List/RevokeBoardShare, ListSharedApplications,
+//     GetSharedApplication — read-only board sharing with a coach or mentor
+//   - Create/List/DeleteWebhook, ListWebhookDeliveries,
+//     RetryWebhookDelivery — signed outgoing webhooks on tracker events
+//
+// Background jobs (internal/worker):
+//   - outbox-relay — publishes the domain events queued in outbox_events
+//     (every OUTBOX_RELAY_INTERVAL)
+//   - outbox-pruner — deletes the published events older than
+//     OUTBOX_RETENTION, which can no longer be replayed (hourly)
+//   - closed-retention — compacts, or deletes if their owner asked for it,
+//     the REJECTED/WITHDRAWN cards untouched for CLOSED_RETENTION_MONTHS
+//     (daily, when it is set)
+//   - attachment-scan — scans the uploaded attachments for malware with
+//     ClamAV or an external API, quarantining infected ones (every
+//     ATTACHMENT_SCAN_INTERVAL, when CLAMAV_ADDR or SCAN_API_URL is set)
+//   - board-snapshots — snapshots the users' boards for CompareSnapshots
+//     and deletes those older than BOARD_SNAPSHOT_RETENTION (every
+//     BOARD_SNAPSHOT_INTERVAL)
+//   - ghost-detector — flags cards silent for too long, publishes
+//     EVENT_APPLICATION_GHOSTED
+//   - reminder-dispatcher — fires due relance reminders and reminder rules
+//     as EVENT_RELANCE_DUE (every REMINDER_CHECK_INTERVAL)
+//   - benchmarks — recomputes the anonymous benchmark_stats (every
+//     BENCHMARK_INTERVAL, nightly by default)
+//   - google-calendar-sync — pushes feed events to the connected Google
+//     calendars and applies reschedules made there (every
+//     GOOGLE_CALENDAR_SYNC_INTERVAL, when GOOGLE_CLIENT_ID is set)
+//   - webhook-dispatcher — sends the due webhook deliveries, retrying failed
+//     ones with backoff (every WEBHOOK_DISPATCH_INTERVAL, when
+//     TOKEN_ENCRYPTION_KEY is set)
+//   - field-resealer — seals the notes, cover letters and offer terms
+//     stored in clear or under an older key (every FIELD_RESEAL_INTERVAL,
+//     when FIELD_ENCRYPTION_KEYS is set)
+//   - analysis-results — consumes the AI Coach's EVENT_ANALYSIS_DONE (consumer
+//     group "tracker"), stores ai_analysis + cover letter, publishes
+//     EVENT_APPLICATION_ANALYZED
+//   - user-deletions — consumes the auth service's EVENT_USER_DELETED
+//     (consumer group "tracker") and erases the user's tracker data
+//
+// Every RPC runs through interceptors (internal/grpcserver) that take or
+// assign its request ID (x-request-id metadata, added to its log lines and
+// the events it publishes), log it with its latency and status code, and
+// turn handler panics into Internal errors, refuse malformed (non-UUID)
+// IDs with InvalidArgument, and give every error a machine-readable reason
+// (ErrorInfo detail and x-error-reason trailer). Callers must authenticate as a
+// JobMate service (INTERNAL_AUTH_MODE): with the shared INTERNAL_SERVICE_TOKEN
+// in x-internal-token metadata, or with a client certificate (mTLS); the
+// x-user-id they send is only trusted after that — or, with
+// USER_AUTH_MODE=jwt, not at all: the user ID comes from the user's JWT,
+// forwarded by the Gateway and verified here (JWT_SECRET or JWT_JWKS_URL).
+//
+// Every mutating RPC is recorded in the append-only audit_log (who, what,
+// when, target row before → after) by the internal/audit interceptor.
+//
+// With OTEL_EXPORTER_OTLP_ENDPOINT set, RPCs are traced with OpenTelemetry
+// (internal/telemetry), continuing the caller's trace (traceparent metadata),
+// down to each SQL statement and Redis command; log lines carry the traceId.
 //
 // A minimal HTTP server is kept on port 8082 for the /health endpoint
-// required by Traefik. All application logic is accessed only via gRPC.
+// required by Traefik. All application logic is accessed via gRPC — or, with
+// REST_ADDR set, through a REST gateway exposing every RPC as JSON over HTTP
+// (POST /v1/<Method>), for scripts and debugging, authenticated the same way.
+// With ADMIN_ADDR set, a separate listener serves pprof profiles and expvar
+// runtime statistics (internal/admin) to holders of ADMIN_TOKEN, who can
+// also switch the log level (INFO/DEBUG) and log SQL statements with their
+// arguments for a while, without a restart.
+// Support staff repair data (force a move, replay reminders, re-emit events)
+// with the cmd/trackerctl operator CLI, shipped in the same image.
 //
 // On HIRED transition: deactivates the linked search_config (archival).
-// Publishes EVENT_CARD_MOVED to Redis for Gateway SSE forward.
+// Publishes EVENT_CARD_MOVED, EVENT_APPLICATION_CREATED,
+// EVENT_APPLICATION_UPDATED, EVENT_APPLICATION_MERGED and EVENT_RELANCE_DUE
+// to Redis Streams (internal/streams) for Gateway SSE forward, through a
+// transactional outbox: events are written with the change they describe and
+// relayed by outbox-relay (at-least-once).
+//
+// Redis is optional at boot: without it the tracker serves degraded —
+// uncached reads, no idempotency replay or reanalysis cooldown, events held
+// in the outbox — reports "degraded" on /health, and recovers on its own
+// once Redis answers again (internal/db RedisMonitor).
 package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -25,25 +154,46 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
+	_ "time/tzdata" // the production image is FROM scratch: embed the zone database for user timezones
 
 	pb "jobmate/tracker-service/internal/pb"
 
+	"jobmate/tracker-service/internal/admin"
+	"jobmate/tracker-service/internal/audit"
 	"jobmate/tracker-service/internal/config"
 	"jobmate/tracker-service/internal/db"
+	"jobmate/tracker-service/internal/gcal"
 	"jobmate/tracker-service/internal/grpcserver"
+	"jobmate/tracker-service/internal/jwtauth"
 	"jobmate/tracker-service/internal/kanban"
+	"jobmate/tracker-service/internal/redact"
+	"jobmate/tracker-service/internal/requestid"
+	"jobmate/tracker-service/internal/scan"
+	"jobmate/tracker-service/internal/secretbox"
+	"jobmate/tracker-service/internal/storage"
+	"jobmate/tracker-service/internal/telemetry"
+	"jobmate/tracker-service/internal/webhook"
+	"jobmate/tracker-service/internal/worker"
 
+	"github.com/jackc/pgx/v5/multitracer"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const version = "1.0.0"
 
 func main() {
 	// ── Structured JSON logging ──────────────────────────────────
-	jsonHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
-	slog.SetDefault(slog.New(jsonHandler))
+	// The level, and logging SQL statements, can be changed at runtime on the
+	// admin server (/debug/logging).
+	logging := admin.NewLogging(slog.LevelInfo)
+	jsonHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logging.Level})
+	// redact keeps notes, cover letters, emails and salaries out of the logs.
+	slog.SetDefault(slog.New(telemetry.LogHandler(requestid.LogHandler(redact.Handler(jsonHandler)))))
 	log.SetFlags(0) // log.Printf calls will still work but output raw lines
 
 	// ── Config ────────────────────────────────────────────
@@ -56,30 +206,194 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// ── Tracing ──────────────────────────────────────────────────────────────
+	shutdownTracing := func(context.Context) error { return nil }
+	if cfg.OTLPEndpoint != "" {
+		shutdownTracing, err = telemetry.Setup(ctx, cfg.OTLPEndpoint, "tracker-service", version)
+		if err != nil {
+			slog.Error("Tracing setup failed", "err", err)
+			os.Exit(1)
+		}
+		slog.Info("Tracing enabled ✓", "endpoint", cfg.OTLPEndpoint)
+	} else {
+		slog.Warn("OTEL_EXPORTER_OTLP_ENDPOINT not set — tracing disabled")
+	}
+
 	// ── PostgreSQL ───────────────────────────────────────────────────────────
 	slog.Info("Connecting to PostgreSQL…")
-	pool, err := db.NewPostgresPool(ctx, cfg.DatabaseURL)
+	pool, err := db.NewPostgresPool(ctx, cfg.DatabaseURL, cfg.DBPool, multitracer.New(telemetry.QueryTracer{}, logging))
 	if err != nil {
 		slog.Error("PostgreSQL connection failed", "err", err)
 		os.Exit(1)
 	}
 	defer pool.Close()
-	slog.Info("PostgreSQL connected ✓")
+	poolCfg := pool.Config()
+	slog.Info("PostgreSQL connected ✓",
+		"maxConns", poolCfg.MaxConns,
+		"minConns", poolCfg.MinConns,
+		"statementCacheMode", poolCfg.ConnConfig.DefaultQueryExecMode.String(),
+	)
 
 	// ── Redis ────────────────────────────────────────────
 	slog.Info("Connecting to Redis…")
-	rdb, err := db.NewRedisClient(ctx, cfg.RedisURL)
+	rdb, redisMon, err := db.NewRedisClient(ctx, cfg.RedisURL)
 	if err != nil {
-		slog.Error("Redis connection failed", "err", err)
+		slog.Error("Config error", "err", err)
 		os.Exit(1)
 	}
 	defer rdb.Close()
-	slog.Info("Redis connected ✓")
+	rdb.AddHook(telemetry.RedisHook{})
+	go redisMon.Run(ctx)
 
 	// ── Business logic + gRPC server ────────────────────────────────────────
-	svc := kanban.NewService(pool, rdb)
-	grpcSrv := grpc.NewServer()
-	pb.RegisterTrackerServiceServer(grpcSrv, grpcserver.NewServer(svc))
+	policy, err := kanban.ParseTransitionPolicy(cfg.ExtraTransitions)
+	if err != nil {
+		slog.Error("Config error", "err", fmt.Errorf("EXTRA_TRANSITIONS: %w", err))
+		os.Exit(1)
+	}
+	var store *storage.Client
+	if cfg.S3Endpoint != "" {
+		store, err = storage.New(storage.Config{
+			Endpoint:  cfg.S3Endpoint,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		})
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+	} else {
+		slog.Warn("S3_ENDPOINT not set — attachments disabled")
+	}
+	var scanner scan.Scanner
+	switch {
+	case cfg.ClamAVAddr != "":
+		scanner, err = scan.NewClamAV(cfg.ClamAVAddr)
+	case cfg.ScanAPIURL != "":
+		scanner, err = scan.NewHTTP(cfg.ScanAPIURL, cfg.ScanAPIToken)
+	default:
+		if store != nil {
+			slog.Warn("CLAMAV_ADDR and SCAN_API_URL not set — attachments not scanned for malware")
+		}
+	}
+	if err != nil {
+		slog.Error("Config error", "err", err)
+		os.Exit(1)
+	}
+	var (
+		googleCal *gcal.Client
+		secrets   *secretbox.Box
+	)
+	if cfg.GoogleClientID != "" || cfg.TokenEncryptionKey != "" {
+		secrets, err = secretbox.NewFromBase64(cfg.TokenEncryptionKey)
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+	}
+	if cfg.GoogleClientID != "" {
+		googleCal, err = gcal.New(gcal.Config{
+			ClientID:     cfg.GoogleClientID,
+			ClientSecret: cfg.GoogleClientSecret,
+			RedirectURL:  cfg.GoogleRedirectURL,
+		})
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+	} else {
+		slog.Warn("GOOGLE_CLIENT_ID not set — Google Calendar sync disabled")
+	}
+	var fieldKeys *secretbox.Keyring
+	if cfg.FieldEncryptionKeys != "" {
+		fieldKeys, err = secretbox.ParseKeyring(cfg.FieldEncryptionKeys)
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+		slog.Info("Field encryption enabled", "activeKeyId", fieldKeys.ActiveKeyID())
+	} else {
+		slog.Warn("FIELD_ENCRYPTION_KEYS not set — notes, cover letters and offers stored in clear")
+	}
+	var webhooks *webhook.Client
+	if secrets != nil {
+		webhooks = webhook.New(cfg.WebhookAllowInsecure)
+	} else {
+		slog.Warn("TOKEN_ENCRYPTION_KEY not set — webhooks disabled")
+	}
+	svc := kanban.NewService(pool, rdb, kanban.Options{
+		GhostAfterDays:         cfg.GhostAfterDays,
+		UndoGracePeriod:        cfg.UndoGracePeriod,
+		TransitionPolicy:       policy,
+		Storage:                store,
+		AttachmentQuota:        int64(cfg.AttachmentQuotaMB) << 20,
+		Scanner:                scanner,
+		ReanalyzeCooldown:      cfg.ReanalyzeCooldown,
+		FollowUpCooldown:       cfg.FollowUpCooldown,
+		DuplicateRejectionDays: cfg.DuplicateRejectionDays,
+		BenchmarkMinUsers:      cfg.BenchmarkMinUsers,
+		ClosedRetentionMonths:  cfg.ClosedRetentionMonths,
+		GoogleCalendar:         googleCal,
+		Secrets:                secrets,
+		Webhooks:               webhooks,
+		FieldKeys:              fieldKeys,
+		SearchTextConfig:       cfg.SearchTextConfig,
+	})
+	auditLog := audit.New(pool)
+	interceptors := []grpc.UnaryServerInterceptor{grpcserver.RequestIDInterceptor(), grpcserver.ErrorInfoInterceptor()}
+	// The caller service is authenticated before anything else looks at the
+	// request: user tokens, logs and handlers only see trusted calls.
+	var mtls *tls.Config
+	switch cfg.InternalAuth {
+	case config.InternalAuthToken:
+		interceptors = append(interceptors, grpcserver.InternalTokenInterceptor(cfg.InternalServiceToken))
+	case config.InternalAuthMTLS:
+		mtls, err = grpcserver.MTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+	}
+	if cfg.UserAuth == config.UserAuthJWT {
+		verifier, err := jwtauth.New(jwtauth.Config{
+			Secret:       []byte(cfg.JWTSecret),
+			JWKSURL:      cfg.JWKSURL,
+			JWKSCacheTTL: cfg.JWKSCacheTTL,
+		})
+		if err != nil {
+			slog.Error("Config error", "err", err)
+			os.Exit(1)
+		}
+		interceptors = append(interceptors, grpcserver.UserJWTInterceptor(verifier))
+	}
+	interceptors = append(interceptors, grpcserver.LoggingInterceptor(), grpcserver.RecoveryInterceptor())
+	grpcOpts := grpcserver.Tuning{
+		MaxRecvMsgSize:               cfg.GRPCMaxRecvMsgSize,
+		MaxSendMsgSize:               cfg.GRPCMaxSendMsgSize,
+		MaxConcurrentStreams:         uint32(cfg.GRPCMaxConcurrentStreams),
+		KeepaliveMinTime:             cfg.GRPCKeepaliveMinTime,
+		KeepalivePermitWithoutStream: cfg.GRPCKeepalivePermitWithoutStream,
+		KeepaliveTime:                cfg.GRPCKeepaliveTime,
+		KeepaliveTimeout:             cfg.GRPCKeepaliveTimeout,
+	}.ServerOptions()
+	if cfg.OTLPEndpoint != "" {
+		grpcOpts = append(grpcOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	}
+	interceptors = append(interceptors,
+		grpcserver.UUIDValidationInterceptor(),
+		grpcserver.CacheInvalidationInterceptor(svc),
+		auditLog.UnaryServerInterceptor(grpcserver.AuditOptions()),
+	)
+	grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(interceptors...))
+	trackerSrv := grpcserver.NewServer(svc, auditLog, cfg.AuditAdminUserIDs)
+	netOpts := slices.Clip(grpcOpts)
+	if mtls != nil {
+		netOpts = append(netOpts, grpc.Creds(credentials.NewTLS(mtls)))
+	}
+	grpcSrv := grpc.NewServer(netOpts...)
+	pb.RegisterTrackerServiceServer(grpcSrv, trackerSrv)
 
 	grpcPort := os.Getenv("TRACKER_GRPC_PORT")
 	if grpcPort == "" {
@@ -92,16 +406,75 @@ func main() {
 	}
 
 	go func() {
-		slog.Info("tracker-service gRPC listening", "port", grpcPort, "version", version)
+		slog.Info("tracker-service gRPC listening", "port", grpcPort, "version", version, "auth", cfg.InternalAuth, "userAuth", cfg.UserAuth)
 		if err := grpcSrv.Serve(lis); err != nil {
 			slog.Error("gRPC server error", "err", err)
 			os.Exit(1)
 		}
 	}()
 
+	// ── Background jobs ─────────────────────────────────────────────────────
+	go worker.Every(ctx, "ghost-detector", cfg.GhostCheckInterval, func(ctx context.Context) error {
+		_, err := svc.DetectGhosted(ctx)
+		return err
+	})
+	go worker.Every(ctx, "outbox-relay", cfg.OutboxRelayInterval, func(ctx context.Context) error {
+		_, err := svc.RelayOutbox(ctx)
+		return err
+	})
+	go worker.Every(ctx, "outbox-pruner", time.Hour, func(ctx context.Context) error {
+		_, err := svc.PruneOutbox(ctx, cfg.OutboxRetention)
+		return err
+	})
+	if cfg.ClosedRetentionMonths > 0 {
+		go worker.Every(ctx, "closed-retention", 24*time.Hour, func(ctx context.Context) error {
+			_, err := svc.ApplyClosedRetention(ctx)
+			return err
+		})
+	}
+	if scanner != nil && store != nil {
+		go worker.Every(ctx, "attachment-scan", cfg.AttachmentScanInterval, func(ctx context.Context) error {
+			_, err := svc.ScanAttachments(ctx)
+			return err
+		})
+	}
+	go worker.Every(ctx, "board-snapshots", cfg.BoardSnapshotInterval, func(ctx context.Context) error {
+		_, err := svc.SnapshotBoards(ctx, cfg.BoardSnapshotInterval, cfg.BoardSnapshotRetention)
+		return err
+	})
+	go worker.Every(ctx, "reminder-dispatcher", cfg.ReminderCheckInterval, func(ctx context.Context) error {
+		_, err := svc.DispatchDueReminders(ctx)
+		return err
+	})
+	go worker.Every(ctx, "benchmarks", cfg.BenchmarkInterval, func(ctx context.Context) error {
+		_, err := svc.ComputeBenchmarks(ctx)
+		return err
+	})
+	if googleCal != nil {
+		go worker.Every(ctx, "google-calendar-sync", cfg.GoogleCalendarSyncInterval, func(ctx context.Context) error {
+			_, err := svc.SyncGoogleCalendars(ctx)
+			return err
+		})
+	}
+	if webhooks != nil {
+		go worker.Every(ctx, "webhook-dispatcher", cfg.WebhookDispatchInterval, func(ctx context.Context) error {
+			_, err := svc.DispatchWebhooks(ctx)
+			return err
+		})
+	}
+	if fieldKeys != nil {
+		go worker.Every(ctx, "field-resealer", cfg.FieldResealInterval, func(ctx context.Context) error {
+			_, err := svc.ResealFields(ctx)
+			return err
+		})
+	}
+	go worker.Consume(ctx, rdb, "analysis-results", "EVENT_ANALYSIS_DONE", "tracker", svc.HandleAnalysisDone)
+	go worker.Consume(ctx, rdb, "user-deletions", "EVENT_USER_DELETED", "tracker", svc.HandleUserDeleted)
+	go worker.Consume(ctx, rdb, "accepted-offers", "EVENT_OFFER_ACCEPTED", "tracker", svc.HandleOfferAccepted)
+
 	// ── HTTP server (/health only — required by Traefik) ─────────────────────
 	mux := http.NewServeMux()
-	mux.HandleFunc("/health", healthHandler)
+	mux.HandleFunc("/health", healthHandler(redisMon))
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Port),
@@ -118,12 +491,70 @@ func main() {
 		}
 	}()
 
+	// ── REST gateway (optional — JSON over HTTP, never needed by the Gateway) ─
+	// It calls an in-process gRPC server with the same interceptors; with
+	// mTLS, its listener requires the same client certificates instead.
+	var restSrv *http.Server
+	var restGRPC *grpc.Server
+	if cfg.RESTAddr != "" {
+		var restConn *grpc.ClientConn
+		restGRPC, restConn, err = grpcserver.NewInProcess(trackerSrv, grpcOpts...)
+		if err != nil {
+			slog.Error("REST gateway error", "err", err)
+			os.Exit(1)
+		}
+		defer restConn.Close()
+		maxBody := int64(cfg.GRPCMaxRecvMsgSize)
+		if maxBody == 0 {
+			maxBody = 4 << 20 // the gRPC default
+		}
+		restSrv = &http.Server{
+			Addr:              cfg.RESTAddr,
+			Handler:           grpcserver.RESTHandler(restConn, maxBody),
+			TLSConfig:         mtls,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			slog.Info("tracker-service REST gateway listening", "addr", cfg.RESTAddr, "auth", cfg.InternalAuth)
+			var err error
+			if mtls != nil {
+				err = restSrv.ListenAndServeTLS("", "")
+			} else {
+				err = restSrv.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				slog.Error("REST gateway error", "err", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	// ── Admin server (pprof, expvar — never routed by Traefik) ─────────────────
+	var adminSrv *http.Server
+	if cfg.AdminAddr != "" {
+		admin.PublishStats(pool, rdb)
+		adminSrv = &http.Server{
+			Addr:              cfg.AdminAddr,
+			Handler:           admin.Handler(cfg.AdminToken, logging),
+			ReadHeaderTimeout: 10 * time.Second,
+			// No WriteTimeout: CPU profiles and traces stream for ?seconds=.
+		}
+		go func() {
+			slog.Info("tracker-service admin listening", "addr", cfg.AdminAddr)
+			if err := adminSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("Admin server error", "err", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// ── Graceful shutdown ────────────────────────────────────────────────────
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	slog.Info("tracker-service shutting down…")
+	cancel() // stop background jobs
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
 
@@ -132,18 +563,42 @@ func main() {
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		slog.Error("HTTP shutdown error", "err", err)
 	}
+	if restSrv != nil {
+		if err := restSrv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("REST gateway shutdown error", "err", err)
+		}
+		restGRPC.GracefulStop()
+	}
+	if adminSrv != nil {
+		if err := adminSrv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Admin shutdown error", "err", err)
+		}
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Tracing shutdown error", "err", err)
+	}
 	slog.Info("tracker-service stopped.")
 }
 
-func healthHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(map[string]string{
-		"status":  "ok",
-		"service": "tracker-service",
-		"version": version,
-	}); err != nil {
-		slog.Error("health encode error", "err", err)
+// healthHandler reports the service as up — Traefik keeps routing to it —
+// and "degraded" while Redis is unavailable.
+func healthHandler(redisMon *db.RedisMonitor) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{
+			"status":  "ok",
+			"service": "tracker-service",
+			"version": version,
+			"redis":   "up",
+		}
+		if !redisMon.Up() {
+			body["status"] = "degraded"
+			body["redis"] = "down"
+			body["redisDownSince"] = redisMon.DownSince().UTC().Format(time.RFC3339)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			slog.Error("health encode error", "err", err)
+		}
 	}
 }
-
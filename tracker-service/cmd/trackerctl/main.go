@@ -0,0 +1,382 @@
+// trackerctl is the tracker's operator CLI: what support staff used to do
+// with hand-written SQL in production, with the service's own rules and an
+// audit trail. It connects to the tracker's PostgreSQL and Redis
+// (DATABASE_URL, REDIS_URL) directly, so run it next to the service, e.g.
+//
+//	docker compose exec tracker-service /trackerctl apps -user <uuid>
+//
+// Subcommands:
+//
+//	apps      -user U [-status S] [-archived] [-json]
+//	          list a user's applications, most recently updated first
+//	move      -user U -app A -to STATUS -reason TEXT
+//	          force a card into a status, bypassing the transition rules
+//	          (kanban.ForceMove); the history entry is marked OPERATOR
+//	reminders [-user U] [-refire-since DURATION]
+//	          fire the reminders that are due now, after re-arming those
+//	          fired within DURATION (their notification was lost)
+//	reemit    -user U [-app A] [-since DURATION]
+//	          queue EVENT_APPLICATION_UPDATED again for one card, or for
+//	          the cards updated within DURATION (default 24h)
+//	replay    [-user U] [-app A] [-since DURATION | -from TIME [-to TIME]]
+//	          [-stream S,…]
+//	          publish again, unchanged and in order, the events still kept
+//	          in the outbox (OUTBOX_RETENTION) of a card, a user or a time
+//	          range (kanban.ReplayEvents) — for consumers that missed them
+//	seed      -user U
+//	          fill the user's empty board with sample applications in
+//	          every status (kanban.SeedDemoBoard), for demos and end-to-end
+//	          tests; refused unless DEMO_SEED=true
+//
+// Every subcommand but apps is recorded in the audit log as
+// "trackerctl.<Subcommand>", with the operator (-operator, default $USER)
+// and the arguments in the request.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"jobmate/tracker-service/internal/audit"
+	"jobmate/tracker-service/internal/db"
+	"jobmate/tracker-service/internal/kanban"
+
+	"google.golang.org/grpc/codes"
+)
+
+const usage = `usage: trackerctl <command> [flags]
+
+commands:
+  apps       list a user's applications
+  move       force a card into a status
+  reminders  fire due reminders, optionally re-arming recently fired ones
+  reemit     queue EVENT_APPLICATION_UPDATED again for a user's cards
+  replay     publish again the kept outbox events of a card, user or time range
+  seed       fill an empty board with demo applications (DEMO_SEED=true)
+
+Run "trackerctl <command> -h" for the flags of a command.
+`
+
+// env is what the subcommands work with.
+type env struct {
+	svc      *kanban.Service
+	audit    *audit.Log
+	operator string
+	out      io.Writer
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	commands := map[string]func(context.Context, *env, []string) error{
+		"apps":      runApps,
+		"move":      runMove,
+		"reminders": runReminders,
+		"reemit":    runReemit,
+		"replay":    runReplay,
+		"seed":      runSeed,
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "trackerctl: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	e, closeEnv, err := connect(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "trackerctl:", err)
+		os.Exit(1)
+	}
+	defer closeEnv()
+
+	if err := run(ctx, e, os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2) // the flag set printed the usage
+		}
+		fmt.Fprintln(os.Stderr, "trackerctl:", err)
+		os.Exit(1)
+	}
+}
+
+// connect opens the tracker's database and Redis.
+func connect(ctx context.Context) (*env, func(), error) {
+	dbURL, redisURL := os.Getenv("DATABASE_URL"), os.Getenv("REDIS_URL")
+	if dbURL == "" || redisURL == "" {
+		return nil, nil, errors.New("DATABASE_URL and REDIS_URL are required")
+	}
+	pool, err := db.NewPostgresPool(ctx, dbURL, db.PoolConfig{}, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("postgres: %w", err)
+	}
+	rdb, _, err := db.NewRedisClient(ctx, redisURL)
+	if err != nil {
+		pool.Close()
+		return nil, nil, fmt.Errorf("redis: %w", err)
+	}
+	operator := os.Getenv("USER")
+	if operator == "" {
+		operator = "unknown"
+	}
+	e := &env{
+		svc:      kanban.NewService(pool, rdb, kanban.Options{}),
+		audit:    audit.New(pool),
+		operator: operator,
+		out:      os.Stdout,
+	}
+	return e, func() { rdb.Close(); pool.Close() }, nil
+}
+
+// flags returns the flag set of a subcommand, with the common -operator.
+func (e *env) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("trackerctl "+name, flag.ContinueOnError)
+	fs.StringVar(&e.operator, "operator", e.operator, "who runs the command, for the audit log")
+	return fs
+}
+
+// record writes an operator action to the audit log. Failing to record it
+// is reported but does not undo the action.
+func (e *env) record(ctx context.Context, action, userID, appID string, request map[string]any, before json.RawMessage, runErr error) {
+	request["operator"] = e.operator
+	raw, _ := json.Marshal(request)
+	entry := audit.Entry{
+		Service:   "tracker",
+		Action:    "trackerctl." + action,
+		OldValues: before,
+		Request:   raw,
+		Outcome:   audit.OutcomeOK,
+	}
+	if appID != "" {
+		entry.ResourceType, entry.ResourceID = "application", appID
+		entry.NewValues = e.audit.Snapshot(ctx, audit.Target{Type: "application", ID: appID}, userID)
+	}
+	if runErr != nil {
+		entry.Outcome = outcomeOf(runErr)
+	}
+	if err := e.audit.Record(ctx, entry); err != nil {
+		fmt.Fprintln(os.Stderr, "trackerctl: warning:", err)
+	}
+}
+
+// outcomeOf names the failure of an action as the audit log does: by the
+// gRPC code the same error gets from the service.
+func outcomeOf(err error) string {
+	var invalid *kanban.ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		return codes.InvalidArgument.String()
+	case errors.Is(err, kanban.ErrNotFound):
+		return codes.NotFound.String()
+	case errors.Is(err, kanban.ErrBoardNotEmpty):
+		return codes.FailedPrecondition.String()
+	}
+	return codes.Internal.String()
+}
+
+func runApps(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("apps")
+	userID := fs.String("user", "", "user ID (required)")
+	status := fs.String("status", "", "only this status")
+	archived := fs.Bool("archived", false, "include archived applications")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return errors.New("apps: -user is required")
+	}
+
+	apps, err := e.svc.ListApplications(ctx, *userID, kanban.ListFilter{
+		Status:          strings.ToUpper(*status),
+		IncludeArchived: *archived,
+		View:            kanban.ViewSummary,
+	})
+	if err != nil {
+		return fmt.Errorf("apps: %w", err)
+	}
+	if *asJSON {
+		enc := json.NewEncoder(e.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(apps)
+	}
+
+	tw := tabwriter.NewWriter(e.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATUS\tTITLE\tCOMPANY\tUPDATED\tARCHIVED")
+	for _, a := range apps {
+		archivedAt := ""
+		if a.ArchivedAt != nil {
+			archivedAt = a.ArchivedAt.UTC().Format(time.DateTime)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", a.ID, a.CurrentStatus, a.JobTitle, a.Company,
+			a.UpdatedAt.UTC().Format(time.DateTime), archivedAt)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(e.out, "%d application(s)\n", len(apps))
+	return nil
+}
+
+func runMove(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("move")
+	userID := fs.String("user", "", "owner's user ID (required)")
+	appID := fs.String("app", "", "application ID (required)")
+	to := fs.String("to", "", "target status, e.g. APPLIED (required)")
+	reason := fs.String("reason", "", "why, shown in the card's history (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" || *appID == "" || *to == "" || *reason == "" {
+		return errors.New("move: -user, -app, -to and -reason are required")
+	}
+
+	before := e.audit.Snapshot(ctx, audit.Target{Type: "application", ID: *appID}, *userID)
+	app, from, err := e.svc.ForceMove(ctx, *userID, *appID, strings.ToUpper(*to), *reason)
+	e.record(ctx, "ForceMove", *userID, *appID, map[string]any{
+		"userId": *userID, "applicationId": *appID, "status": strings.ToUpper(*to), "reason": *reason,
+	}, before, err)
+	if err != nil {
+		return fmt.Errorf("move: %w", err)
+	}
+	fmt.Fprintf(e.out, "%s: %s → %s\n", app.ID, from, app.CurrentStatus)
+	return e.relay(ctx)
+}
+
+func runReminders(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("reminders")
+	userID := fs.String("user", "", "only re-arm this user's reminders (default: everyone's)")
+	refireSince := fs.Duration("refire-since", 0, "re-arm the reminders fired within this duration first, e.g. 6h")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rearmed := 0
+	if *refireSince > 0 {
+		var err error
+		rearmed, err = e.svc.RearmFiredReminders(ctx, *userID, time.Now().Add(-*refireSince))
+		if err != nil {
+			return fmt.Errorf("reminders: %w", err)
+		}
+	}
+	fired, err := e.svc.DispatchAllDueReminders(ctx)
+	e.record(ctx, "ReplayReminders", *userID, "", map[string]any{
+		"userId": *userID, "refireSince": refireSince.String(), "rearmed": rearmed, "fired": fired,
+	}, nil, err)
+	if err != nil {
+		return fmt.Errorf("reminders: %w", err)
+	}
+	fmt.Fprintf(e.out, "%d reminder(s) re-armed, %d fired\n", rearmed, fired)
+	return e.relay(ctx)
+}
+
+func runReemit(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("reemit")
+	userID := fs.String("user", "", "user ID (required)")
+	appID := fs.String("app", "", "only this application")
+	since := fs.Duration("since", 24*time.Hour, "cards updated within this duration (ignored with -app)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return errors.New("reemit: -user is required")
+	}
+
+	n, err := e.svc.ReemitApplicationEvents(ctx, *userID, *appID, time.Now().Add(-*since))
+	e.record(ctx, "ReemitEvents", *userID, *appID, map[string]any{
+		"userId": *userID, "applicationId": *appID, "since": since.String(), "queued": n,
+	}, nil, err)
+	if err != nil {
+		return fmt.Errorf("reemit: %w", err)
+	}
+	fmt.Fprintf(e.out, "%d event(s) queued\n", n)
+	return e.relay(ctx)
+}
+
+func runReplay(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("replay")
+	var f kanban.ReplayFilter
+	fs.StringVar(&f.UserID, "user", "", "only this user's events")
+	fs.StringVar(&f.ApplicationID, "app", "", "only this application's events")
+	since := fs.Duration("since", 0, "events created within this duration, e.g. 2h")
+	fs.Func("from", "events created at or after this RFC 3339 time", func(v string) (err error) {
+		f.Since, err = time.Parse(time.RFC3339, v)
+		return err
+	})
+	fs.Func("to", "events created before this RFC 3339 time (default: now)", func(v string) (err error) {
+		f.Until, err = time.Parse(time.RFC3339, v)
+		return err
+	})
+	streams := fs.String("stream", "", "only these streams, comma-separated (e.g. EVENT_CARD_MOVED)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *since > 0 {
+		if !f.Since.IsZero() {
+			return errors.New("replay: -since and -from are exclusive")
+		}
+		f.Since = time.Now().Add(-*since)
+	}
+	if *streams != "" {
+		f.Streams = strings.Split(*streams, ",")
+	}
+
+	n, err := e.svc.ReplayEvents(ctx, f)
+	e.record(ctx, "ReplayEvents", f.UserID, f.ApplicationID, map[string]any{
+		"userId": f.UserID, "applicationId": f.ApplicationID, "since": f.Since, "until": f.Until,
+		"streams": f.Streams, "queued": n,
+	}, nil, err)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	fmt.Fprintf(e.out, "%d event(s) queued\n", n)
+	return e.relay(ctx)
+}
+
+func runSeed(ctx context.Context, e *env, args []string) error {
+	fs := e.flags("seed")
+	userID := fs.String("user", "", "user ID (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return errors.New("seed: -user is required")
+	}
+	// Demo data must never land on a production board.
+	if os.Getenv("DEMO_SEED") != "true" {
+		return errors.New("seed: refused, DEMO_SEED=true is not set (development and demo environments only)")
+	}
+
+	n, err := e.svc.SeedDemoBoard(ctx, *userID)
+	e.record(ctx, "SeedDemoBoard", *userID, "", map[string]any{
+		"userId": *userID, "created": n,
+	}, nil, err)
+	if err != nil {
+		return fmt.Errorf("seed: %w", err)
+	}
+	fmt.Fprintf(e.out, "%d demo application(s) created\n", n)
+	return e.relay(ctx)
+}
+
+// relay publishes the events the command queued rather than waiting for the
+// service's outbox-relay.
+func (e *env) relay(ctx context.Context) error {
+	n, err := e.svc.RelayOutbox(ctx)
+	if err != nil {
+		return fmt.Errorf("relay outbox (the service's relay will retry): %w", err)
+	}
+	fmt.Fprintf(e.out, "%d event(s) published\n", n)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Package admin serves the tracker's runtime diagnostics, for operators
+// chasing goroutine leaks or memory growth in production:
+//
+//   - /debug/pprof/… — net/http/pprof profiles (heap, goroutine, profile…)
+//   - /debug/vars    — expvar: memstats, goroutine count, uptime, PostgreSQL
+//     and Redis pool statistics (PublishStats) and the background jobs'
+//     counters (package worker)
+//   - /debug/logging — the log level and SQL logging, changed at runtime
+//     (see Logging)
+//
+// It runs on its own listener (ADMIN_ADDR), never routed by Traefik, and
+// every request must carry "Authorization: Bearer <ADMIN_TOKEN>", e.g.
+//
+//	curl -H "Authorization: Bearer $ADMIN_TOKEN" http://tracker:6060/debug/pprof/heap > heap.pprof
+//	go tool pprof heap.pprof
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// Handler returns the diagnostics endpoints, guarded by token.
+func Handler(token string, logging *Logging) http.Handler {
+	mux := http.NewServeMux()
+	// Registered explicitly: the packages' init only fills http.DefaultServeMux.
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.Handle("/debug/logging", logging)
+	return requireToken(token, mux)
+}
+
+// requireToken refuses requests without the bearer token.
+func requireToken(token string, next http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="tracker-admin"`)
+			writeError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "missing or invalid admin token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeError answers with the JSON error body the services share:
+// {"error": {"code": "<REASON>", "message": "…"}}, code being one of the
+// gRPC status names or a more precise reason.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	body := map[string]map[string]string{"error": {"code": code, "message": message}}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// started is when the process started, near enough.
+var started = time.Now()
+
+// PublishStats adds the runtime and connection pool statistics to expvar.
+// Call it once.
+func PublishStats(pool *pgxpool.Pool, rdb *redis.Client) {
+	expvar.Publish("goroutines", expvar.Func(func() any { return runtime.NumGoroutine() }))
+	expvar.Publish("uptimeSeconds", expvar.Func(func() any { return int64(time.Since(started).Seconds()) }))
+	expvar.Publish("postgresPool", expvar.Func(func() any {
+		s := pool.Stat()
+		return map[string]any{
+			"totalConns":        s.TotalConns(),
+			"idleConns":         s.IdleConns(),
+			"acquiredConns":     s.AcquiredConns(),
+			"maxConns":          s.MaxConns(),
+			"acquireCount":      s.AcquireCount(),
+			"emptyAcquireCount": s.EmptyAcquireCount(),
+			"acquireDurationMs": s.AcquireDuration().Milliseconds(),
+		}
+	}))
+	expvar.Publish("redisPool", expvar.Func(func() any {
+		s := rdb.PoolStats()
+		return map[string]any{
+			"totalConns": s.TotalConns,
+			"idleConns":  s.IdleConns,
+			"staleConns": s.StaleConns,
+			"hits":       s.Hits,
+			"misses":     s.Misses,
+			"timeouts":   s.Timeouts,
+		}
+	}))
+}
@@ -0,0 +1,45 @@
+package admin_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/admin"
+)
+
+const token = "0123456789abcdef0123456789abcdef"
+
+func TestHandler_RequiresToken(t *testing.T) {
+	h := admin.Handler(token, admin.NewLogging(slog.LevelInfo))
+	for _, auth := range []string{"", token, "Bearer wrong", "Basic " + token} {
+		req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized || !strings.Contains(rec.Body.String(), `"code":"UNAUTHENTICATED"`) {
+			t.Errorf("Authorization %q: status %d, body %s, want 401 UNAUTHENTICATED", auth, rec.Code, rec.Body)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := admin.Handler(token, admin.NewLogging(slog.LevelInfo))
+	for path, want := range map[string]string{
+		"/debug/pprof/":                  "goroutine",
+		"/debug/pprof/goroutine?debug=1": "goroutine profile",
+		"/debug/vars":                    `"memstats"`,
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("GET %s: status %d, body without %q", path, rec.Code, want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"jobmate/tracker-service/internal/redact"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// maxSQLLogging bounds how long SQL statements are logged with their
+// arguments, which hold user data (strings are logged as their length, see
+// redact.Args).
+const maxSQLLogging = time.Hour
+
+// Logging is the logging configuration operators can change at runtime,
+// without a restart, through /debug/logging:
+//
+//	GET                      → {"level":"INFO","sqlUntil":null}
+//	PUT ?level=DEBUG         → sets the level of the process' logger
+//	PUT ?sql=10m             → logs every SQL statement with its arguments
+//	                           for 10 minutes (at most maxSQLLogging; 0 stops)
+//
+// SQL lines are logged at INFO ("sql query"): they are what was asked for,
+// whatever the level. As a pgx.QueryTracer, Logging sees the statements.
+type Logging struct {
+	Level    *slog.LevelVar
+	sqlUntil atomic.Int64 // Unix nanoseconds; 0 = off
+}
+
+// NewLogging returns a Logging at level, without SQL logging.
+func NewLogging(level slog.Level) *Logging {
+	l := &Logging{Level: new(slog.LevelVar)}
+	l.Level.Set(level)
+	return l
+}
+
+// LogSQLUntil logs the SQL statements with their arguments until t (zero
+// time: stop).
+func (l *Logging) LogSQLUntil(t time.Time) {
+	if t.IsZero() {
+		l.sqlUntil.Store(0)
+		return
+	}
+	l.sqlUntil.Store(t.UnixNano())
+}
+
+// SQLUntil returns when SQL logging stops, zero if it is off.
+func (l *Logging) SQLUntil() time.Time {
+	until := l.sqlUntil.Load()
+	if until == 0 || time.Now().UnixNano() >= until {
+		return time.Time{}
+	}
+	return time.Unix(0, until)
+}
+
+// queryKey carries a loggedQuery from TraceQueryStart to TraceQueryEnd.
+type queryKey struct{}
+
+type loggedQuery struct {
+	start time.Time
+	data  pgx.TraceQueryStartData
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (l *Logging) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if l.SQLUntil().IsZero() {
+		return ctx
+	}
+	return context.WithValue(ctx, queryKey{}, loggedQuery{start: time.Now(), data: data})
+}
+
+// TraceQueryEnd implements pgx.QueryTracer: it logs the statements started
+// while SQL logging was on.
+func (l *Logging) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	q, ok := ctx.Value(queryKey{}).(loggedQuery)
+	if !ok {
+		return
+	}
+	attrs := []any{
+		"sql", q.data.SQL,
+		"args", redact.Args(q.data.Args),
+		"durationMs", time.Since(q.start).Milliseconds(),
+		"rows", data.CommandTag.RowsAffected(),
+	}
+	if data.Err != nil {
+		attrs = append(attrs, "err", data.Err)
+	}
+	slog.InfoContext(ctx, "sql query", attrs...)
+}
+
+// ServeHTTP serves /debug/logging.
+func (l *Logging) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		if err := l.update(r); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		writeError(w, http.StatusMethodNotAllowed, "UNIMPLEMENTED", "method not allowed")
+		return
+	}
+
+	state := struct {
+		Level    string     `json:"level"`
+		SQLUntil *time.Time `json:"sqlUntil"`
+	}{Level: l.Level.Level().String()}
+	if until := l.SQLUntil(); !until.IsZero() {
+		state.SQLUntil = &until
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(state)
+}
+
+// update applies the level and sql query parameters of r.
+func (l *Logging) update(r *http.Request) error {
+	q := r.URL.Query()
+	var (
+		level    slog.Level
+		setLevel = q.Has("level")
+	)
+	if setLevel {
+		if err := level.UnmarshalText([]byte(q.Get("level"))); err != nil {
+			return fmt.Errorf("level must be DEBUG, INFO, WARN or ERROR, got %q", q.Get("level"))
+		}
+	}
+	var sqlFor time.Duration
+	if q.Has("sql") {
+		d, err := time.ParseDuration(q.Get("sql"))
+		if err != nil || d < 0 || d > maxSQLLogging {
+			return fmt.Errorf("sql must be a duration between 0 and %s, got %q", maxSQLLogging, q.Get("sql"))
+		}
+		sqlFor = d
+	}
+	if !setLevel && !q.Has("sql") {
+		return fmt.Errorf("nothing to change: set level and/or sql")
+	}
+
+	if setLevel {
+		l.Level.Set(level)
+		slog.Warn("log level changed", "level", level.String())
+	}
+	if q.Has("sql") {
+		if sqlFor == 0 {
+			l.LogSQLUntil(time.Time{})
+			slog.Warn("SQL logging stopped")
+		} else {
+			until := time.Now().Add(sqlFor)
+			l.LogSQLUntil(until)
+			slog.Warn("SQL logging with arguments enabled", "until", until.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"jobmate/tracker-service/internal/admin"
+)
+
+func putLogging(t *testing.T, h http.Handler, query string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPut, "/debug/logging?"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLoggingEndpoint(t *testing.T) {
+	logging := admin.NewLogging(slog.LevelInfo)
+	h := admin.Handler(token, logging)
+
+	rec := putLogging(t, h, "level=debug&sql=5m")
+	var state struct {
+		Level    string     `json:"level"`
+		SQLUntil *time.Time `json:"sqlUntil"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &state); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("PUT: status %d, body %s", rec.Code, rec.Body)
+	}
+	if logging.Level.Level() != slog.LevelDebug || state.Level != "DEBUG" {
+		t.Errorf("level = %s (reported %s), want DEBUG", logging.Level.Level(), state.Level)
+	}
+	if state.SQLUntil == nil || time.Until(*state.SQLUntil) > 5*time.Minute || time.Until(*state.SQLUntil) < 4*time.Minute {
+		t.Errorf("sqlUntil = %v, want in 5 minutes", state.SQLUntil)
+	}
+
+	if rec := putLogging(t, h, "sql=0"); rec.Code != http.StatusOK || !logging.SQLUntil().IsZero() {
+		t.Errorf("sql=0: status %d, SQL logging until %v", rec.Code, logging.SQLUntil())
+	}
+	for _, bad := range []string{"", "level=LOUD", "sql=2h", "sql=-1m", "sql=soon"} {
+		if rec := putLogging(t, h, bad); rec.Code != http.StatusBadRequest {
+			t.Errorf("PUT ?%s: status %d, want 400", bad, rec.Code)
+		}
+	}
+}
+
+func TestLogging_SQL(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	logging := admin.NewLogging(slog.LevelInfo)
+	query := func(sql string) {
+		ctx := logging.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql, Args: []any{"u1"}})
+		logging.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+	}
+
+	query("SELECT 1")
+	logging.LogSQLUntil(time.Now().Add(time.Minute))
+	query("SELECT 2")
+	logging.LogSQLUntil(time.Now().Add(-time.Second)) // expired
+	query("SELECT 3")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"sql":"SELECT 2"`) || !strings.Contains(lines[0], `"args":["[2 chars]"]`) {
+		t.Errorf("logged %q, want only SELECT 2 with its redacted arguments", lines)
+	}
+}
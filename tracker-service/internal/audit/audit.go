@@ -0,0 +1,160 @@
+// Package audit records mutating operations in audit_log, the append-only
+// table shared by the JobMate services (the discovery service writes to it
+// too), and reads them back for debugging and compliance.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Entry is one recorded operation.
+type Entry struct {
+	ID           int64           `json:"id"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+	Service      string          `json:"service"`      // "tracker", "discovery"
+	ActorID      string          `json:"actorId"`      // "" = unauthenticated or background job
+	Action       string          `json:"action"`       // e.g. "tracker.MoveCard"
+	ResourceType string          `json:"resourceType"` // see resources; "" when unknown
+	ResourceID   string          `json:"resourceId"`
+	OldValues    json.RawMessage `json:"oldValues"` // resource before the operation, nil when unknown
+	NewValues    json.RawMessage `json:"newValues"` // and after
+	Request      json.RawMessage `json:"request"`   // secrets redacted
+	Outcome      string          `json:"outcome"`   // OutcomeOK or the gRPC code of the failure
+}
+
+// OutcomeOK is the Outcome of operations that succeeded.
+const OutcomeOK = "OK"
+
+// Filter selects entries for List. Zero fields match everything.
+type Filter struct {
+	ActorID    string
+	Action     string
+	ResourceID string
+	Since      time.Time
+	Until      time.Time
+	PageSize   int    // 0 = defaultPageSize
+	PageToken  string // NextPageToken of the previous page
+}
+
+// List page sizes.
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// ErrInvalidFilter is returned by List for out-of-range paging arguments.
+var ErrInvalidFilter = errors.New("invalid audit filter")
+
+// Log reads and writes audit_log.
+type Log struct {
+	pool *pgxpool.Pool
+}
+
+// New returns a Log backed by pool.
+func New(pool *pgxpool.Pool) *Log {
+	return &Log{pool: pool}
+}
+
+// Record appends e. ID and OccurredAt are set by the database.
+func (l *Log) Record(ctx context.Context, e Entry) error {
+	_, err := l.pool.Exec(ctx,
+		`INSERT INTO audit_log (service, actor_id, action, resource_type, resource_id,
+		                        old_values, new_values, request, outcome)
+		 VALUES ($1, NULLIF($2, '')::uuid, $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8, $9)`,
+		e.Service, e.ActorID, e.Action, e.ResourceType, e.ResourceID,
+		nullableJSON(e.OldValues), nullableJSON(e.NewValues), nullableJSON(e.Request), e.Outcome,
+	)
+	if err != nil {
+		return fmt.Errorf("audit record: %w", err)
+	}
+	return nil
+}
+
+// List returns the entries matching f, newest first, and the token of the
+// next page ("" on the last one).
+func (l *Log) List(ctx context.Context, f Filter) ([]Entry, string, error) {
+	size, before, err := parsePage(f.PageSize, f.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, err := l.pool.Query(ctx,
+		`SELECT id, occurred_at, service, COALESCE(actor_id::text, ''), action,
+		        COALESCE(resource_type, ''), COALESCE(resource_id, ''),
+		        old_values, new_values, request, outcome
+		 FROM audit_log
+		 WHERE ($1 = '' OR actor_id = NULLIF($1, '')::uuid)
+		   AND ($2 = '' OR action = $2)
+		   AND ($3 = '' OR resource_id = $3)
+		   AND ($4::timestamptz IS NULL OR occurred_at >= $4)
+		   AND ($5::timestamptz IS NULL OR occurred_at < $5)
+		   AND ($6::bigint IS NULL OR id < $6)
+		 ORDER BY id DESC
+		 LIMIT $7`,
+		f.ActorID, f.Action, f.ResourceID, nullableTime(f.Since), nullableTime(f.Until), before, size+1,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("audit list: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]Entry, 0, size)
+	for rows.Next() {
+		var e Entry
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.Service, &e.ActorID, &e.Action,
+			&e.ResourceType, &e.ResourceID, &e.OldValues, &e.NewValues, &e.Request, &e.Outcome); err != nil {
+			return nil, "", fmt.Errorf("audit list scan: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("audit list: %w", err)
+	}
+
+	var next string
+	if len(entries) > size {
+		entries = entries[:size]
+		next = strconv.FormatInt(entries[size-1].ID, 10)
+	}
+	return entries, next, nil
+}
+
+// parsePage validates List's paging arguments. before is the entry ID the
+// page starts below; nil for the first page.
+func parsePage(pageSize int, pageToken string) (size int, before *int64, err error) {
+	switch {
+	case pageSize < 0 || pageSize > maxPageSize:
+		return 0, nil, fmt.Errorf("%w: page_size must be between 0 and %d", ErrInvalidFilter, maxPageSize)
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	}
+	if pageToken == "" {
+		return pageSize, nil, nil
+	}
+	id, err := strconv.ParseInt(pageToken, 10, 64)
+	if err != nil || id < 1 {
+		return 0, nil, fmt.Errorf("%w: invalid page_token", ErrInvalidFilter)
+	}
+	return pageSize, &id, nil
+}
+
+func nullableJSON(b json.RawMessage) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
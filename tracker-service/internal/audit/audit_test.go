@@ -0,0 +1,53 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"jobmate/tracker-service/internal/audit"
+	pb "jobmate/tracker-service/internal/pb"
+)
+
+func TestIsMutating(t *testing.T) {
+	for method, want := range map[string]bool{
+		"/tracker.TrackerService/MoveCard":                   true,
+		"/tracker.TrackerService/UpdateSettings":             true,
+		"/tracker.TrackerService/CompleteGoogleCalendarAuth": true,
+		"/tracker.TrackerService/GetCalendarFeed":            true, // creates the feed token on first call
+		"/tracker.TrackerService/Unknown":                    true,
+		"/other.Service/GetThing":                            true,
+		"/tracker.TrackerService/GetApplication":             false,
+		"/tracker.TrackerService/BatchGetApplications":       false,
+		"/tracker.TrackerService/ListAuditLog":               false,
+		"/tracker.TrackerService/CountApplicationsByStatus":  false,
+		"/tracker.TrackerService/CompareOffers":              false,
+		"/tracker.TrackerService/RenderCalendarFeed":         false,
+		"/tracker.TrackerService/SearchApplications":         false,
+		"/tracker.TrackerService/ExportUserData":             false,
+	} {
+		if got := audit.IsMutating(method); got != want {
+			t.Errorf("IsMutating(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	raw := audit.Redact(&pb.CompleteGoogleCalendarAuthRequest{State: "s3cret", Code: "c0de"})
+	var got map[string]string
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("Redact: %v (%s)", err, raw)
+	}
+	if got["state"] != "[redacted]" || got["code"] != "[redacted]" {
+		t.Errorf("Redact = %s, want state and code redacted", raw)
+	}
+
+	raw = audit.Redact(&pb.MoveCardRequest{ApplicationId: "app-1", NewStatus: "APPLIED"})
+	if err := json.Unmarshal(raw, &got); err != nil || got["applicationId"] != "app-1" || got["newStatus"] != "APPLIED" {
+		t.Errorf("Redact(MoveCardRequest) = %s, %v; want the fields kept", raw, err)
+	}
+
+	raw = audit.Redact(&pb.AddNoteRequest{ApplicationId: "app-1", Note: "Recruiter: jane@acme.io"})
+	if err := json.Unmarshal(raw, &got); err != nil || got["applicationId"] != "app-1" || got["note"] != "[redacted 23 chars]" {
+		t.Errorf("Redact(AddNoteRequest) = %s, %v; want the note redacted", raw, err)
+	}
+}
@@ -0,0 +1,5 @@
+package audit
+
+// Exported aliases of unexported helpers, for the audit_test package only.
+
+var Redact = redactRequest
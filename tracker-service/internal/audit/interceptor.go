@@ -0,0 +1,164 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"jobmate/tracker-service/internal/redact"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Target identifies the resource an operation changes: a key of resources
+// and the ID of its row.
+type Target struct {
+	Type string
+	ID   string
+}
+
+// Options configure UnaryServerInterceptor.
+type Options struct {
+	Service string // recorded as Entry.Service and the prefix of Entry.Action
+	// Actor returns the caller's user ID, "" if unauthenticated.
+	Actor func(ctx context.Context) string
+	// Target locates the resource a request refers to or, for creations, the
+	// one returned in the response.
+	Target func(msg any, actorID string) (Target, bool)
+}
+
+// IsMutating reports whether the RPC fullMethod (e.g.
+// "/tracker.TrackerService/MoveCard") changes state: every method but those
+// declared idempotency_level = NO_SIDE_EFFECTS in their .proto. Methods not
+// in the registry count as mutating.
+func IsMutating(fullMethod string) bool {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return true
+	}
+	m, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return true
+	}
+	opts, _ := m.Options().(*descriptorpb.MethodOptions)
+	return opts.GetIdempotencyLevel() != descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// UnaryServerInterceptor records every mutating RPC — failed ones included —
+// with snapshots of its target before and after the call. Recording is best
+// effort: a failure is logged and never fails the RPC.
+func (l *Log) UnaryServerInterceptor(opts Options) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !IsMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+
+		actor := opts.Actor(ctx)
+		target, found := opts.Target(req, actor)
+		var old json.RawMessage
+		if found {
+			old = l.Snapshot(ctx, target, actor)
+		}
+
+		resp, err := handler(ctx, req)
+
+		e := Entry{
+			Service:   opts.Service,
+			ActorID:   actor,
+			Action:    opts.Service + "." + method,
+			OldValues: old,
+			Request:   redactRequest(req),
+			Outcome:   OutcomeOK,
+		}
+		if err != nil {
+			e.Outcome = status.Code(err).String()
+		} else {
+			if !found {
+				target, found = opts.Target(resp, actor)
+			}
+			if found {
+				e.NewValues = l.Snapshot(ctx, target, actor)
+			}
+		}
+		if found {
+			e.ResourceType, e.ResourceID = target.Type, target.ID
+		}
+		if rerr := l.Record(context.WithoutCancel(ctx), e); rerr != nil {
+			slog.Warn("audit entry lost", "action", e.Action, "actorId", actor, "err", rerr)
+		}
+		return resp, err
+	}
+}
+
+// resources are the tables snapshotted around an operation, by Target.Type.
+// Large or derived columns are left out, and so are free-text and personal
+// ones (notes, feedback, amounts, contact details): the log is kept long after
+// the user deletes them.
+var resources = map[string]struct {
+	table, key string
+	omit       []string
+}{
+	"application": {"applications", "id", []string{"ai_analysis", "generated_cover_letter", "followup_draft", "history_log", "user_notes", "rating_review"}},
+	"note":        {"application_notes", "id", []string{"body"}},
+	"interview":   {"interviews", "id", []string{"interviewer", "notes", "went_well", "red_flags", "questions_asked"}},
+	"attachment":  {"attachments", "id", nil},
+	"contact":     {"contacts", "id", []string{"name", "email", "linkedin_url"}},
+	"negotiation": {"offer_negotiations", "id", []string{"compensation", "note"}},
+	"column":      {"board_columns", "id", nil},
+	"settings":    {"tracker_settings", "user_id", nil},
+}
+
+// Snapshot returns the row of t owned by userID as JSON, or nil if it does
+// not exist (deleted, someone else's, malformed ID) or t.Type is unknown.
+func (l *Log) Snapshot(ctx context.Context, t Target, userID string) json.RawMessage {
+	r, ok := resources[t.Type]
+	if !ok || userID == "" {
+		return nil
+	}
+	var row []byte
+	err := l.pool.QueryRow(ctx,
+		`SELECT to_jsonb(t) - $3::text[] FROM `+r.table+` t
+		 WHERE t.`+r.key+`::text = $1 AND t.user_id::text = $2`,
+		t.ID, userID, r.omit,
+	).Scan(&row)
+	if err != nil {
+		return nil
+	}
+	return row
+}
+
+// secretFields are request fields never written to the log (JSON names).
+var secretFields = map[string]bool{"code": true, "state": true, "token": true}
+
+// redactRequest returns req as JSON without its secret fields, and with user
+// content (notes, amounts, emails) redacted as in logs.
+func redactRequest(req any) json.RawMessage {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	raw, err := protojson.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	for k := range fields {
+		if secretFields[k] {
+			fields[k] = json.RawMessage(`"[redacted]"`)
+		}
+	}
+	out, _ := json.Marshal(fields)
+	return redact.JSON(out)
+}
@@ -5,6 +5,11 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"jobmate/tracker-service/internal/db"
 )
 
 // Config holds all runtime configuration for the tracker service.
@@ -12,8 +17,168 @@ type Config struct {
 	Port        string
 	DatabaseURL string
 	RedisURL    string
+
+	// DBPool tunes the PostgreSQL pool (DB_MAX_CONNS, DB_MIN_CONNS,
+	// DB_MAX_CONN_LIFETIME, DB_HEALTH_CHECK_PERIOD, DB_STATEMENT_CACHE_MODE);
+	// unset variables keep the pgxpool defaults.
+	DBPool db.PoolConfig
+
+	// Ghost detection: cards silent for GhostAfterDays (users may override)
+	// are flagged by a job running every GhostCheckInterval.
+	GhostAfterDays     int
+	GhostCheckInterval time.Duration
+
+	// UndoGracePeriod is how long a card move can still be undone.
+	UndoGracePeriod time.Duration
+
+	// ReanalyzeCooldown is the minimum delay between two re-analyses of the
+	// same application.
+	ReanalyzeCooldown time.Duration
+
+	// FollowUpCooldown is the minimum delay between two follow-up drafts for
+	// the same application.
+	FollowUpCooldown time.Duration
+
+	// OutboxRelayInterval is how often queued domain events are published.
+	// Published events are kept OutboxRetention for replays (trackerctl
+	// replay), then pruned.
+	OutboxRelayInterval time.Duration
+	OutboxRetention     time.Duration
+
+	// ClosedRetentionMonths is how long REJECTED and WITHDRAWN cards stay
+	// untouched before they are compacted, or deleted when their owner asked
+	// for it; 0 keeps them as they are.
+	ClosedRetentionMonths int
+
+	// Users' boards are snapshotted every BoardSnapshotInterval for
+	// CompareSnapshots; snapshots are kept BoardSnapshotRetention.
+	BoardSnapshotInterval  time.Duration
+	BoardSnapshotRetention time.Duration
+
+	// DuplicateRejectionDays is how long after a rejection a new application
+	// to the same company and job title is flagged as a duplicate.
+	DuplicateRejectionDays int
+
+	// Anonymous benchmarks are recomputed every BenchmarkInterval from the
+	// users who opted in; cohorts under BenchmarkMinUsers are not published.
+	BenchmarkInterval time.Duration
+	BenchmarkMinUsers int
+
+	// Google Calendar sync, disabled when GoogleClientID is empty. OAuth
+	// tokens are stored sealed with TokenEncryptionKey (base64, 32 bytes).
+	GoogleClientID             string
+	GoogleClientSecret         string
+	GoogleRedirectURL          string
+	TokenEncryptionKey         string
+	GoogleCalendarSyncInterval time.Duration
+
+	// User webhooks, disabled when TokenEncryptionKey is empty (their
+	// secrets are sealed with it). Deliveries are sent every
+	// WebhookDispatchInterval; WebhookAllowInsecure also accepts http URLs
+	// and private addresses, for local development only.
+	WebhookDispatchInterval time.Duration
+	WebhookAllowInsecure    bool
+
+	// FieldEncryptionKeys seals notes, cover letters and offer terms at rest
+	// (see secretbox.ParseKeyring: "<id>:<base64 key>,…", the first one
+	// active); empty stores them in clear. Values in clear or under an older
+	// key are resealed every FieldResealInterval.
+	FieldEncryptionKeys string
+	FieldResealInterval time.Duration
+
+	// SearchTextConfig is the PostgreSQL text search configuration
+	// SearchApplications stems and drops stop words with, e.g. "english",
+	// "french" or "simple" (no stemming).
+	SearchTextConfig string
+
+	// AdminAddr is where the diagnostics endpoints (pprof, expvar — package
+	// admin) listen, e.g. ":6060"; empty disables them. Requests must carry
+	// AdminToken.
+	AdminAddr  string
+	AdminToken string
+
+	// RESTAddr is where the REST gateway (every RPC as JSON over HTTP —
+	// grpcserver.RESTHandler) listens, e.g. ":8083"; empty disables it.
+	// Callers authenticate as on the gRPC port.
+	RESTAddr string
+
+	// gRPC server limits (see grpcserver.Tuning); 0 keeps the grpc-go
+	// default. Message sizes are in bytes.
+	GRPCMaxRecvMsgSize               int
+	GRPCMaxSendMsgSize               int
+	GRPCMaxConcurrentStreams         int
+	GRPCKeepaliveMinTime             time.Duration
+	GRPCKeepalivePermitWithoutStream bool
+	GRPCKeepaliveTime                time.Duration
+	GRPCKeepaliveTimeout             time.Duration
+
+	// OTLPEndpoint is the OpenTelemetry collector (OTLP/gRPC) traces are
+	// exported to; empty disables tracing.
+	OTLPEndpoint string
+
+	// ReminderCheckInterval is how often due relance reminders are fired.
+	ReminderCheckInterval time.Duration
+
+	// InternalAuth is how gRPC callers prove they are a JobMate service:
+	// InternalAuthToken ("token", with InternalServiceToken) or
+	// InternalAuthMTLS (a client certificate signed by TLSClientCAFile; the
+	// server presents TLSCertFile/TLSKeyFile).
+	InternalAuth         string
+	InternalServiceToken string
+	TLSCertFile          string
+	TLSKeyFile           string
+	TLSClientCAFile      string
+
+	// UserAuth is how the caller's user ID is determined: UserAuthHeader
+	// trusts the x-user-id metadata of authenticated services, UserAuthJWT
+	// verifies the user's JWT forwarded by the Gateway — signed with
+	// JWTSecret (HS256, the Gateway's JWT_SECRET) or a key of JWKSURL,
+	// cached for JWKSCacheTTL.
+	UserAuth     string
+	JWTSecret    string
+	JWKSURL      string
+	JWKSCacheTTL time.Duration
+
+	// AuditAdminUserIDs may read every user's audit log entries (support
+	// staff); others only see their own.
+	AuditAdminUserIDs []string
+
+	// ExtraTransitions relaxes the state machine for every user, as a
+	// comma-separated list of FROM>TO edges (e.g. "TO_APPLY>INTERVIEW").
+	ExtraTransitions string
+
+	// Attachment storage (S3 or MinIO). Attachments are disabled when
+	// S3Endpoint is empty.
+	S3Endpoint        string
+	S3Bucket          string
+	S3Region          string
+	S3AccessKey       string
+	S3SecretKey       string
+	AttachmentQuotaMB int
+
+	// Malware scanning of attachments, through a clamd daemon (ClamAVAddr)
+	// or an external API (ScanAPIURL); neither set leaves them unscanned.
+	ClamAVAddr             string
+	ScanAPIURL             string
+	ScanAPIToken           string
+	AttachmentScanInterval time.Duration
 }
 
+// InternalAuth modes.
+const (
+	InternalAuthToken = "token"
+	InternalAuthMTLS  = "mtls"
+)
+
+// UserAuth modes.
+const (
+	UserAuthHeader = "header"
+	UserAuthJWT    = "jwt"
+)
+
+// minInternalTokenLen guards against guessable internal service tokens.
+const minInternalTokenLen = 32
+
 // Load reads environment variables and returns a validated Config.
 func Load() (*Config, error) {
 	dbURL := os.Getenv("DATABASE_URL")
@@ -31,9 +196,333 @@ func Load() (*Config, error) {
 		port = "8082"
 	}
 
+	ghostAfterDays, err := envInt("GHOST_AFTER_DAYS", 21)
+	if err != nil {
+		return nil, err
+	}
+	ghostCheckInterval, err := envDuration("GHOST_CHECK_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	undoGracePeriod, err := envDuration("UNDO_GRACE_PERIOD", 15*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	reanalyzeCooldown, err := envDuration("REANALYZE_COOLDOWN", 10*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	followUpCooldown, err := envDuration("FOLLOWUP_COOLDOWN", 2*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	outboxRelayInterval, err := envDuration("OUTBOX_RELAY_INTERVAL", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	outboxRetention, err := envDuration("OUTBOX_RETENTION", 72*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	closedRetentionMonths, err := envInt("CLOSED_RETENTION_MONTHS", 0)
+	if err != nil {
+		return nil, err
+	}
+	if closedRetentionMonths < 0 {
+		return nil, fmt.Errorf("CLOSED_RETENTION_MONTHS must not be negative")
+	}
+
+	boardSnapshotInterval, err := envDuration("BOARD_SNAPSHOT_INTERVAL", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	boardSnapshotRetention, err := envDuration("BOARD_SNAPSHOT_RETENTION", 90*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicateRejectionDays, err := envInt("DUPLICATE_REJECTION_DAYS", 90)
+	if err != nil {
+		return nil, err
+	}
+
+	benchmarkInterval, err := envDuration("BENCHMARK_INTERVAL", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	benchmarkMinUsers, err := envInt("BENCHMARK_MIN_USERS", 10)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderCheckInterval, err := envDuration("REMINDER_CHECK_INTERVAL", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	googleCalendarSyncInterval, err := envDuration("GOOGLE_CALENDAR_SYNC_INTERVAL", 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookDispatchInterval, err := envDuration("WEBHOOK_DISPATCH_INTERVAL", 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	webhookAllowInsecure, err := envBool("WEBHOOK_ALLOW_INSECURE")
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentQuotaMB, err := envInt("ATTACHMENT_QUOTA_MB", 100)
+	if err != nil {
+		return nil, err
+	}
+	attachmentScanInterval, err := envDuration("ATTACHMENT_SCAN_INTERVAL", 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("CLAMAV_ADDR") != "" && os.Getenv("SCAN_API_URL") != "" {
+		return nil, fmt.Errorf("CLAMAV_ADDR and SCAN_API_URL are exclusive: set one scanner")
+	}
+
+	dbPool, err := loadPoolConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	grpcMaxRecvMsgMB, err := envInt("GRPC_MAX_RECV_MSG_MB", 4)
+	if err != nil {
+		return nil, err
+	}
+	grpcMaxSendMsgMB, err := envInt("GRPC_MAX_SEND_MSG_MB", 0)
+	if err != nil {
+		return nil, err
+	}
+	grpcMaxConcurrentStreams, err := envInt("GRPC_MAX_CONCURRENT_STREAMS", 0)
+	if err != nil {
+		return nil, err
+	}
+	grpcKeepaliveMinTime, err := envDuration("GRPC_KEEPALIVE_MIN_TIME", 0)
+	if err != nil {
+		return nil, err
+	}
+	grpcKeepalivePermitWithoutStream, err := envBool("GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM")
+	if err != nil {
+		return nil, err
+	}
+	grpcKeepaliveTime, err := envDuration("GRPC_KEEPALIVE_TIME", 0)
+	if err != nil {
+		return nil, err
+	}
+	grpcKeepaliveTimeout, err := envDuration("GRPC_KEEPALIVE_TIMEOUT", 0)
+	if err != nil {
+		return nil, err
+	}
+	fieldResealInterval, err := envDuration("FIELD_RESEAL_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	searchTextConfig := os.Getenv("SEARCH_TEXT_CONFIG")
+	if searchTextConfig == "" {
+		searchTextConfig = "english"
+	}
+	if strings.Trim(searchTextConfig, "abcdefghijklmnopqrstuvwxyz_.") != "" {
+		return nil, fmt.Errorf("SEARCH_TEXT_CONFIG %q is not a text search configuration name", searchTextConfig)
+	}
+
+	internalAuth := os.Getenv("INTERNAL_AUTH_MODE")
+	if internalAuth == "" {
+		internalAuth = InternalAuthToken
+	}
+	internalToken := os.Getenv("INTERNAL_SERVICE_TOKEN")
+	tlsCert, tlsKey, tlsClientCA := os.Getenv("TRACKER_TLS_CERT"), os.Getenv("TRACKER_TLS_KEY"), os.Getenv("TRACKER_TLS_CLIENT_CA")
+	switch internalAuth {
+	case InternalAuthToken:
+		if len(internalToken) < minInternalTokenLen {
+			return nil, fmt.Errorf("INTERNAL_SERVICE_TOKEN must be at least %d characters (INTERNAL_AUTH_MODE=token)", minInternalTokenLen)
+		}
+	case InternalAuthMTLS:
+		if tlsCert == "" || tlsKey == "" || tlsClientCA == "" {
+			return nil, fmt.Errorf("TRACKER_TLS_CERT, TRACKER_TLS_KEY and TRACKER_TLS_CLIENT_CA are required (INTERNAL_AUTH_MODE=mtls)")
+		}
+	default:
+		return nil, fmt.Errorf("INTERNAL_AUTH_MODE must be %q or %q, got %q", InternalAuthToken, InternalAuthMTLS, internalAuth)
+	}
+
+	userAuth := os.Getenv("USER_AUTH_MODE")
+	if userAuth == "" {
+		userAuth = UserAuthHeader
+	}
+	jwtSecret, jwksURL := os.Getenv("JWT_SECRET"), os.Getenv("JWT_JWKS_URL")
+	switch userAuth {
+	case UserAuthHeader:
+	case UserAuthJWT:
+		if jwtSecret == "" && jwksURL == "" {
+			return nil, fmt.Errorf("JWT_SECRET or JWT_JWKS_URL is required (USER_AUTH_MODE=jwt)")
+		}
+	default:
+		return nil, fmt.Errorf("USER_AUTH_MODE must be %q or %q, got %q", UserAuthHeader, UserAuthJWT, userAuth)
+	}
+	jwksCacheTTL, err := envDuration("JWT_JWKS_CACHE_TTL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	adminAddr, adminToken := os.Getenv("ADMIN_ADDR"), os.Getenv("ADMIN_TOKEN")
+	if adminAddr != "" && len(adminToken) < minInternalTokenLen {
+		return nil, fmt.Errorf("ADMIN_TOKEN must be at least %d characters (ADMIN_ADDR is set)", minInternalTokenLen)
+	}
+
 	return &Config{
-		Port:        port,
-		DatabaseURL: dbURL,
-		RedisURL:    redisURL,
+		Port:                             port,
+		DatabaseURL:                      dbURL,
+		RedisURL:                         redisURL,
+		DBPool:                           dbPool,
+		GhostAfterDays:                   ghostAfterDays,
+		GhostCheckInterval:               ghostCheckInterval,
+		UndoGracePeriod:                  undoGracePeriod,
+		ReanalyzeCooldown:                reanalyzeCooldown,
+		FollowUpCooldown:                 followUpCooldown,
+		OutboxRelayInterval:              outboxRelayInterval,
+		OutboxRetention:                  outboxRetention,
+		ClosedRetentionMonths:            closedRetentionMonths,
+		BoardSnapshotInterval:            boardSnapshotInterval,
+		BoardSnapshotRetention:           boardSnapshotRetention,
+		DuplicateRejectionDays:           duplicateRejectionDays,
+		BenchmarkInterval:                benchmarkInterval,
+		BenchmarkMinUsers:                benchmarkMinUsers,
+		ReminderCheckInterval:            reminderCheckInterval,
+		GoogleClientID:                   os.Getenv("GOOGLE_CLIENT_ID"),
+		GoogleClientSecret:               os.Getenv("GOOGLE_CLIENT_SECRET"),
+		GoogleRedirectURL:                os.Getenv("GOOGLE_REDIRECT_URL"),
+		TokenEncryptionKey:               os.Getenv("TOKEN_ENCRYPTION_KEY"),
+		GoogleCalendarSyncInterval:       googleCalendarSyncInterval,
+		WebhookDispatchInterval:          webhookDispatchInterval,
+		WebhookAllowInsecure:             webhookAllowInsecure,
+		FieldEncryptionKeys:              os.Getenv("FIELD_ENCRYPTION_KEYS"),
+		FieldResealInterval:              fieldResealInterval,
+		SearchTextConfig:                 searchTextConfig,
+		GRPCMaxRecvMsgSize:               grpcMaxRecvMsgMB << 20,
+		GRPCMaxSendMsgSize:               grpcMaxSendMsgMB << 20,
+		GRPCMaxConcurrentStreams:         grpcMaxConcurrentStreams,
+		GRPCKeepaliveMinTime:             grpcKeepaliveMinTime,
+		GRPCKeepalivePermitWithoutStream: grpcKeepalivePermitWithoutStream,
+		GRPCKeepaliveTime:                grpcKeepaliveTime,
+		GRPCKeepaliveTimeout:             grpcKeepaliveTimeout,
+		OTLPEndpoint:                     os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		AdminAddr:                        adminAddr,
+		AdminToken:                       adminToken,
+		RESTAddr:                         os.Getenv("REST_ADDR"),
+		InternalAuth:                     internalAuth,
+		InternalServiceToken:             internalToken,
+		TLSCertFile:                      tlsCert,
+		TLSKeyFile:                       tlsKey,
+		TLSClientCAFile:                  tlsClientCA,
+		UserAuth:                         userAuth,
+		JWTSecret:                        jwtSecret,
+		JWKSURL:                          jwksURL,
+		JWKSCacheTTL:                     jwksCacheTTL,
+		AuditAdminUserIDs:                envList("AUDIT_ADMIN_USER_IDS"),
+		ExtraTransitions:                 os.Getenv("EXTRA_TRANSITIONS"),
+		S3Endpoint:                       os.Getenv("S3_ENDPOINT"),
+		S3Bucket:                         os.Getenv("S3_BUCKET"),
+		S3Region:                         os.Getenv("S3_REGION"),
+		S3AccessKey:                      os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:                      os.Getenv("S3_SECRET_KEY"),
+		AttachmentQuotaMB:                attachmentQuotaMB,
+		ClamAVAddr:                       os.Getenv("CLAMAV_ADDR"),
+		ScanAPIURL:                       os.Getenv("SCAN_API_URL"),
+		ScanAPIToken:                     os.Getenv("SCAN_API_TOKEN"),
+		AttachmentScanInterval:           attachmentScanInterval,
+	}, nil
+}
+
+// loadPoolConfig reads the DB_* pool settings; 0 means the pgxpool default.
+func loadPoolConfig() (db.PoolConfig, error) {
+	maxConns, err := envInt("DB_MAX_CONNS", 0)
+	if err != nil {
+		return db.PoolConfig{}, err
+	}
+	minConns, err := envInt("DB_MIN_CONNS", 0)
+	if err != nil {
+		return db.PoolConfig{}, err
+	}
+	if maxConns > 0 && minConns > maxConns {
+		return db.PoolConfig{}, fmt.Errorf("DB_MIN_CONNS (%d) must not exceed DB_MAX_CONNS (%d)", minConns, maxConns)
+	}
+	maxConnLifetime, err := envDuration("DB_MAX_CONN_LIFETIME", 0)
+	if err != nil {
+		return db.PoolConfig{}, err
+	}
+	healthCheckPeriod, err := envDuration("DB_HEALTH_CHECK_PERIOD", 0)
+	if err != nil {
+		return db.PoolConfig{}, err
+	}
+	cacheMode := os.Getenv("DB_STATEMENT_CACHE_MODE")
+	if cacheMode != "" && !db.ValidStatementCacheMode(cacheMode) {
+		return db.PoolConfig{}, fmt.Errorf("DB_STATEMENT_CACHE_MODE must be cache_statement, cache_describe, describe_exec, exec or simple_protocol, got %q", cacheMode)
+	}
+	return db.PoolConfig{
+		MaxConns:           int32(maxConns),
+		MinConns:           int32(minConns),
+		MaxConnLifetime:    maxConnLifetime,
+		HealthCheckPeriod:  healthCheckPeriod,
+		StatementCacheMode: cacheMode,
 	}, nil
 }
+
+// envInt reads a positive integer variable, falling back to def when unset.
+func envInt(key string, def int) (int, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", key, raw)
+	}
+	return v, nil
+}
+
+// envBool reads a boolean variable ("true", "1", …), false when unset.
+func envBool(key string) (bool, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", key, raw)
+	}
+	return v, nil
+}
+
+// envList reads a comma-separated variable, dropping blank items.
+func envList(key string) []string {
+	var out []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// envDuration reads a positive Go duration ("90s", "1h"), falling back to def.
+func envDuration(key string, def time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def, nil
+	}
+	v, err := time.ParseDuration(raw)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration (e.g. 30m), got %q", key, raw)
+	}
+	return v, nil
+}
@@ -0,0 +1,6 @@
+package db
+
+import "github.com/jackc/pgx/v5/pgxpool"
+
+// ApplyPoolConfig exposes PoolConfig.apply to the external tests.
+func ApplyPoolConfig(pc PoolConfig, cfg *pgxpool.Config) error { return pc.apply(cfg) }
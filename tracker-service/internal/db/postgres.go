@@ -4,15 +4,51 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// NewPostgresPool creates and verifies a pgxpool connection pool.
-func NewPostgresPool(ctx context.Context, databaseURL string) (*pgxpool.Pool, error) {
-	pool, err := pgxpool.New(ctx, databaseURL)
+// PoolConfig tunes the PostgreSQL pool; zero fields keep the pgxpool
+// defaults (or the values set in the DATABASE_URL).
+type PoolConfig struct {
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	HealthCheckPeriod time.Duration
+	// StatementCacheMode is how statements are prepared and cached on each
+	// connection, one of the pgx default_query_exec_mode names:
+	// cache_statement (pgx's default), cache_describe, describe_exec, exec
+	// or simple_protocol. The last three suit a PgBouncer in transaction
+	// mode, where a connection's prepared statements cannot be reused.
+	StatementCacheMode string
+}
+
+// queryExecModes maps the StatementCacheMode names to pgx modes.
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
+
+// NewPostgresPool creates and verifies a pgxpool connection pool. tracer,
+// when not nil, is called around every query (see package telemetry).
+func NewPostgresPool(ctx context.Context, databaseURL string, pc PoolConfig, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(databaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("pgxpool.New: %w", err)
+		return nil, fmt.Errorf("pgxpool.ParseConfig: %w", err)
+	}
+	cfg.ConnConfig.Tracer = tracer
+	if err := pc.apply(cfg); err != nil {
+		return nil, err
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.NewWithConfig: %w", err)
 	}
 
 	if err := pool.Ping(ctx); err != nil {
@@ -22,3 +58,36 @@ func NewPostgresPool(ctx context.Context, databaseURL string) (*pgxpool.Pool, er
 
 	return pool, nil
 }
+
+// apply sets the non-zero fields of pc on cfg.
+func (pc PoolConfig) apply(cfg *pgxpool.Config) error {
+	if pc.MaxConns > 0 {
+		cfg.MaxConns = pc.MaxConns
+	}
+	if pc.MinConns > 0 {
+		cfg.MinConns = pc.MinConns
+	}
+	if cfg.MinConns > cfg.MaxConns {
+		return fmt.Errorf("pool min conns (%d) exceeds max conns (%d)", cfg.MinConns, cfg.MaxConns)
+	}
+	if pc.MaxConnLifetime > 0 {
+		cfg.MaxConnLifetime = pc.MaxConnLifetime
+	}
+	if pc.HealthCheckPeriod > 0 {
+		cfg.HealthCheckPeriod = pc.HealthCheckPeriod
+	}
+	if pc.StatementCacheMode != "" {
+		mode, ok := queryExecModes[pc.StatementCacheMode]
+		if !ok {
+			return fmt.Errorf("unknown statement cache mode %q", pc.StatementCacheMode)
+		}
+		cfg.ConnConfig.DefaultQueryExecMode = mode
+	}
+	return nil
+}
+
+// ValidStatementCacheMode reports whether mode is a PoolConfig.StatementCacheMode.
+func ValidStatementCacheMode(mode string) bool {
+	_, ok := queryExecModes[mode]
+	return ok
+}
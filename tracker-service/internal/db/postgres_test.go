@@ -0,0 +1,62 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"jobmate/tracker-service/internal/db"
+)
+
+func parse(t *testing.T, url string) *pgxpool.Config {
+	t.Helper()
+	cfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestPoolConfig_Apply(t *testing.T) {
+	cfg := parse(t, "postgres://u:p@localhost/db")
+	pc := db.PoolConfig{
+		MaxConns:           6,
+		MinConns:           2,
+		MaxConnLifetime:    30 * time.Minute,
+		HealthCheckPeriod:  15 * time.Second,
+		StatementCacheMode: "exec",
+	}
+	if err := db.ApplyPoolConfig(pc, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MaxConns != 6 || cfg.MinConns != 2 || cfg.MaxConnLifetime != 30*time.Minute || cfg.HealthCheckPeriod != 15*time.Second {
+		t.Errorf("pool = max %d min %d lifetime %s health %s", cfg.MaxConns, cfg.MinConns, cfg.MaxConnLifetime, cfg.HealthCheckPeriod)
+	}
+	if cfg.ConnConfig.DefaultQueryExecMode != pgx.QueryExecModeExec {
+		t.Errorf("exec mode = %s, want exec", cfg.ConnConfig.DefaultQueryExecMode)
+	}
+}
+
+// Zero fields keep what the URL (or pgxpool) set.
+func TestPoolConfig_ApplyZeroKeepsURL(t *testing.T) {
+	cfg := parse(t, "postgres://u:p@localhost/db?pool_max_conns=3&default_query_exec_mode=simple_protocol")
+	if err := db.ApplyPoolConfig(db.PoolConfig{}, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MaxConns != 3 || cfg.ConnConfig.DefaultQueryExecMode != pgx.QueryExecModeSimpleProtocol {
+		t.Errorf("pool = max %d mode %s, want the URL's", cfg.MaxConns, cfg.ConnConfig.DefaultQueryExecMode)
+	}
+}
+
+func TestPoolConfig_ApplyInvalid(t *testing.T) {
+	for name, pc := range map[string]db.PoolConfig{
+		"min over max": {MaxConns: 2, MinConns: 5},
+		"unknown mode": {StatementCacheMode: "prepare"},
+	} {
+		if err := db.ApplyPoolConfig(pc, parse(t, "postgres://u:p@localhost/db")); err == nil {
+			t.Errorf("%s: apply = nil error", name)
+		}
+	}
+}
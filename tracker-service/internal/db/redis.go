@@ -2,22 +2,131 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
-// NewRedisClient creates and verifies a Redis client connection.
-func NewRedisClient(ctx context.Context, redisURL string) (*redis.Client, error) {
+// Redis only holds caches, cooldowns and the streams events are published
+// to — events wait in the PostgreSQL outbox until they are — so the tracker
+// keeps serving without it. The RedisMonitor pings it in the background;
+// while it is down, commands fail at once with ErrRedisUnavailable instead
+// of each waiting for a connection timeout, and callers fall back as they do
+// on any Redis error.
+
+// ErrRedisUnavailable is returned by every command while Redis is down.
+var ErrRedisUnavailable = errors.New("redis unavailable")
+
+const (
+	// redisProbeInterval is how often the monitor pings Redis.
+	redisProbeInterval = 2 * time.Second
+	redisProbeTimeout  = time.Second
+)
+
+// NewRedisClient creates a Redis client and its monitor, which must be run
+// (RedisMonitor.Run). Redis being down is not an error: the client starts
+// degraded and recovers once the monitor reaches it.
+func NewRedisClient(ctx context.Context, redisURL string) (*redis.Client, *RedisMonitor, error) {
 	opts, err := redis.ParseURL(redisURL)
 	if err != nil {
-		return nil, fmt.Errorf("redis.ParseURL: %w", err)
+		return nil, nil, fmt.Errorf("redis.ParseURL: %w", err)
 	}
 
 	rdb := redis.NewClient(opts)
-	if err := rdb.Ping(ctx).Err(); err != nil {
-		return nil, fmt.Errorf("redis ping failed: %w", err)
+	mon := &RedisMonitor{rdb: rdb}
+	mon.probe(ctx)
+	rdb.AddHook(mon)
+	return rdb, mon, nil
+}
+
+// RedisMonitor tracks whether Redis is reachable.
+type RedisMonitor struct {
+	rdb  *redis.Client
+	up   atomic.Bool
+	down atomic.Int64 // Unix seconds when it went down, 0 = up
+}
+
+// Up reports whether Redis answered the last ping.
+func (m *RedisMonitor) Up() bool {
+	return m.up.Load()
+}
+
+// DownSince returns when Redis stopped answering, zero when it is up.
+func (m *RedisMonitor) DownSince() time.Time {
+	if d := m.down.Load(); d != 0 {
+		return time.Unix(d, 0)
 	}
+	return time.Time{}
+}
 
-	return rdb, nil
+// Run pings Redis every redisProbeInterval until ctx is cancelled, logging
+// when it goes down and comes back. It blocks — start it with `go`.
+func (m *RedisMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(redisProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.probe(ctx)
+		}
+	}
+}
+
+type probeKey struct{}
+
+// probe pings Redis, bypassing the fail-fast hook, and records the result.
+func (m *RedisMonitor) probe(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, probeKey{}, true), redisProbeTimeout)
+	defer cancel()
+	err := m.rdb.Ping(ctx).Err()
+	if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+		return // shutting down
+	}
+	switch wasUp := m.up.Swap(err == nil); {
+	case err == nil && !wasUp:
+		if since := m.DownSince(); !since.IsZero() {
+			slog.Info("Redis reachable again", "downFor", time.Since(since).Round(time.Second).String())
+		} else {
+			slog.Info("Redis connected ✓")
+		}
+		m.down.Store(0)
+	case err != nil && (wasUp || m.down.Load() == 0):
+		m.down.Store(time.Now().Unix())
+		slog.Warn("Redis unavailable — running degraded (no caching, events held in the outbox)", "err", err)
+	}
+}
+
+// DialHook implements redis.Hook.
+func (m *RedisMonitor) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook implements redis.Hook: commands fail fast while Redis is down.
+func (m *RedisMonitor) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !m.Up() && ctx.Value(probeKey{}) == nil {
+			cmd.SetErr(ErrRedisUnavailable)
+			return ErrRedisUnavailable
+		}
+		return next(ctx, cmd)
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook.
+func (m *RedisMonitor) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !m.Up() {
+			for _, cmd := range cmds {
+				cmd.SetErr(ErrRedisUnavailable)
+			}
+			return ErrRedisUnavailable
+		}
+		return next(ctx, cmds)
+	}
 }
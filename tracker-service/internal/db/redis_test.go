@@ -0,0 +1,41 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/db"
+)
+
+// Without Redis the client starts degraded and its commands fail at once.
+func TestNewRedisClient_Unreachable(t *testing.T) {
+	rdb, mon, err := db.NewRedisClient(context.Background(), "redis://127.0.0.1:1/0")
+	if err != nil {
+		t.Fatalf("NewRedisClient = %v, want a degraded client", err)
+	}
+	defer rdb.Close()
+	if mon.Up() || mon.DownSince().IsZero() {
+		t.Fatalf("monitor up = %t (down since %v), want down", mon.Up(), mon.DownSince())
+	}
+
+	start := time.Now()
+	if err := rdb.Get(context.Background(), "k").Err(); !errors.Is(err, db.ErrRedisUnavailable) {
+		t.Errorf("Get = %v, want ErrRedisUnavailable", err)
+	}
+	pipe := rdb.TxPipeline()
+	incr := pipe.Incr(context.Background(), "k")
+	if _, err := pipe.Exec(context.Background()); !errors.Is(err, db.ErrRedisUnavailable) || !errors.Is(incr.Err(), db.ErrRedisUnavailable) {
+		t.Errorf("pipeline = %v / %v, want ErrRedisUnavailable", err, incr.Err())
+	}
+	if d := time.Since(start); d > 100*time.Millisecond {
+		t.Errorf("failing commands took %s, want immediate", d)
+	}
+}
+
+func TestNewRedisClient_BadURL(t *testing.T) {
+	if _, _, err := db.NewRedisClient(context.Background(), "http://nope"); err == nil {
+		t.Error("NewRedisClient(bad URL) = nil error")
+	}
+}
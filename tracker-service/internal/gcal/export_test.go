@@ -0,0 +1,11 @@
+package gcal
+
+// Exported helpers for the gcal_test package only.
+
+// PointTo sends every request of c to base (an httptest server) instead of Google.
+func PointTo(c *Client, base string) {
+	c.authURL = base + "/auth"
+	c.tokenURL = base + "/token"
+	c.revokeURL = base + "/revoke"
+	c.apiURL = base + "/calendar/v3"
+}
@@ -0,0 +1,383 @@
+// Package gcal is a minimal Google Calendar client: the OAuth 2.0
+// authorization-code flow (offline access, so the tracker can sync without
+// the user) and the calendars/events endpoints of the Calendar API v3.
+//
+// The tracker only asks for the calendar.app.created scope: events are kept
+// in a secondary "JobMate" calendar it creates, never in the user's own
+// calendars, and it cannot read anything else.
+package gcal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Scope is the only OAuth scope requested.
+const Scope = "https://www.googleapis.com/auth/calendar.app.created"
+
+// uidProperty is the private extended property carrying the tracker's event
+// UID, so events can be matched back whatever Google calls them.
+const uidProperty = "jobmateUid"
+
+var (
+	// ErrUnauthorized is returned when Google refuses the user's grant
+	// (revoked, expired refresh token): the user must connect again.
+	ErrUnauthorized = errors.New("google calendar authorization revoked")
+	// ErrSyncTokenExpired asks for a full resynchronization (HTTP 410).
+	ErrSyncTokenExpired = errors.New("google calendar sync token expired")
+	// ErrNotFound is returned for missing events and calendars.
+	ErrNotFound = errors.New("google calendar resource not found")
+)
+
+// Config holds the OAuth client registered in the Google Cloud console.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string       // the Gateway's callback, e.g. https://api.example.com/integrations/google-calendar/callback
+	HTTPClient   *http.Client // nil = a client with a 15s timeout
+}
+
+// Client talks to the Google OAuth and Calendar endpoints.
+type Client struct {
+	cfg       Config
+	http      *http.Client
+	authURL   string
+	tokenURL  string
+	revokeURL string
+	apiURL    string
+}
+
+// New validates cfg and returns a Client.
+func New(cfg Config) (*Client, error) {
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, fmt.Errorf("google client ID and secret are required")
+	}
+	u, err := url.Parse(cfg.RedirectURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("google redirect URL must be an http(s) URL, got %q", cfg.RedirectURL)
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &Client{
+		cfg:       cfg,
+		http:      client,
+		authURL:   "https://accounts.google.com/o/oauth2/v2/auth",
+		tokenURL:  "https://oauth2.googleapis.com/token",
+		revokeURL: "https://oauth2.googleapis.com/revoke",
+		apiURL:    "https://www.googleapis.com/calendar/v3",
+	}, nil
+}
+
+// ─── OAuth ────────────────────────────────────────────────────────────────────
+
+// Token is an OAuth grant. RefreshToken is only returned by Exchange.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	Expiry       time.Time
+}
+
+// AuthCodeURL returns the consent page URL. state is echoed back to the
+// redirect URL and identifies the user completing the flow.
+func (c *Client) AuthCodeURL(state string) string {
+	q := url.Values{
+		"client_id":     {c.cfg.ClientID},
+		"redirect_uri":  {c.cfg.RedirectURL},
+		"response_type": {"code"},
+		"scope":         {Scope},
+		"access_type":   {"offline"},
+		"prompt":        {"consent"}, // always hand out a refresh token
+		"state":         {state},
+	}
+	return c.authURL + "?" + q.Encode()
+}
+
+// Exchange trades the authorization code received on the redirect URL for a
+// grant, which must include a refresh token.
+func (c *Client) Exchange(ctx context.Context, code string) (*Token, error) {
+	tok, err := c.token(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.cfg.RedirectURL},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tok.RefreshToken == "" {
+		return nil, fmt.Errorf("google token exchange: no refresh token returned")
+	}
+	return tok, nil
+}
+
+// Refresh returns a new access token for a refresh token.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	tok, err := c.token(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tok.RefreshToken = refreshToken
+	return tok, nil
+}
+
+// Revoke invalidates a grant. Revoking an already invalid token is not an error.
+func (c *Client) Revoke(ctx context.Context, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.revokeURL,
+		strings.NewReader(url.Values{"token": {token}}.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("google revoke: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("google revoke: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (c *Client) token(ctx context.Context, form url.Values) (*Token, error) {
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("client_secret", c.cfg.ClientSecret)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("google token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+		Error        string `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("google token: %s: %w", resp.Status, err)
+	}
+	if body.Error == "invalid_grant" {
+		return nil, ErrUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return nil, fmt.Errorf("google token: unexpected status %s (%s)", resp.Status, body.Error)
+	}
+	return &Token{
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		Expiry:       time.Now().Add(time.Duration(body.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// ─── Calendar API ─────────────────────────────────────────────────────────────
+
+// Event is the part of a Google Calendar event the tracker manages.
+type Event struct {
+	ID          string
+	UID         string // the tracker's UID, from the private extended properties
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Cancelled   bool // deleted in Google ("cancelled" status)
+}
+
+type apiEvent struct {
+	ID                 string         `json:"id,omitempty"`
+	Status             string         `json:"status,omitempty"`
+	Summary            string         `json:"summary,omitempty"`
+	Description        string         `json:"description,omitempty"`
+	Start              *apiDateTime   `json:"start,omitempty"`
+	End                *apiDateTime   `json:"end,omitempty"`
+	ExtendedProperties *apiProperties `json:"extendedProperties,omitempty"`
+}
+
+type apiProperties struct {
+	Private map[string]string `json:"private,omitempty"`
+}
+
+type apiDateTime struct {
+	DateTime string `json:"dateTime,omitempty"`
+	Date     string `json:"date,omitempty"` // all-day events moved in Google
+}
+
+func (d *apiDateTime) time() time.Time {
+	if d == nil {
+		return time.Time{}
+	}
+	if t, err := time.Parse(time.RFC3339, d.DateTime); err == nil {
+		return t
+	}
+	t, _ := time.Parse(time.DateOnly, d.Date)
+	return t
+}
+
+func toAPIEvent(e Event) apiEvent {
+	return apiEvent{
+		Summary:            e.Summary,
+		Description:        e.Description,
+		Start:              &apiDateTime{DateTime: e.Start.UTC().Format(time.RFC3339)},
+		End:                &apiDateTime{DateTime: e.End.UTC().Format(time.RFC3339)},
+		ExtendedProperties: &apiProperties{Private: map[string]string{uidProperty: e.UID}},
+	}
+}
+
+func fromAPIEvent(ae apiEvent) Event {
+	e := Event{
+		ID:          ae.ID,
+		Summary:     ae.Summary,
+		Description: ae.Description,
+		Start:       ae.Start.time(),
+		End:         ae.End.time(),
+		Cancelled:   ae.Status == "cancelled",
+	}
+	if ae.ExtendedProperties != nil {
+		e.UID = ae.ExtendedProperties.Private[uidProperty]
+	}
+	return e
+}
+
+// CreateCalendar creates a secondary calendar and returns its ID.
+func (c *Client) CreateCalendar(ctx context.Context, accessToken, summary string) (string, error) {
+	var out struct {
+		ID string `json:"id"`
+	}
+	err := c.do(ctx, accessToken, http.MethodPost, "/calendars", nil, map[string]string{"summary": summary}, &out)
+	if err != nil {
+		return "", fmt.Errorf("google create calendar: %w", err)
+	}
+	return out.ID, nil
+}
+
+// InsertEvent creates e and returns it with its Google ID.
+func (c *Client) InsertEvent(ctx context.Context, accessToken, calendarID string, e Event) (*Event, error) {
+	var out apiEvent
+	err := c.do(ctx, accessToken, http.MethodPost, "/calendars/"+url.PathEscape(calendarID)+"/events", nil, toAPIEvent(e), &out)
+	if err != nil {
+		return nil, fmt.Errorf("google insert event: %w", err)
+	}
+	created := fromAPIEvent(out)
+	return &created, nil
+}
+
+// UpdateEvent replaces the event e.ID with e.
+func (c *Client) UpdateEvent(ctx context.Context, accessToken, calendarID string, e Event) error {
+	err := c.do(ctx, accessToken, http.MethodPut,
+		"/calendars/"+url.PathEscape(calendarID)+"/events/"+url.PathEscape(e.ID), nil, toAPIEvent(e), nil)
+	if err != nil {
+		return fmt.Errorf("google update event: %w", err)
+	}
+	return nil
+}
+
+// DeleteEvent removes an event. Deleting a missing event is not an error.
+func (c *Client) DeleteEvent(ctx context.Context, accessToken, calendarID, eventID string) error {
+	err := c.do(ctx, accessToken, http.MethodDelete,
+		"/calendars/"+url.PathEscape(calendarID)+"/events/"+url.PathEscape(eventID), nil, nil, nil)
+	if err != nil && !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrSyncTokenExpired) { // 410: already deleted
+		return fmt.Errorf("google delete event: %w", err)
+	}
+	return nil
+}
+
+// ListChanges returns the events changed since syncToken — every event,
+// deleted ones included, when syncToken is empty — and the token to pass
+// next time. ErrSyncTokenExpired means a full listing is needed.
+func (c *Client) ListChanges(ctx context.Context, accessToken, calendarID, syncToken string) ([]Event, string, error) {
+	var (
+		events    []Event
+		pageToken string
+	)
+	for {
+		q := url.Values{"showDeleted": {"true"}, "maxResults": {"250"}}
+		if syncToken != "" {
+			q.Set("syncToken", syncToken)
+		}
+		if pageToken != "" {
+			q.Set("pageToken", pageToken)
+		}
+		var page struct {
+			Items         []apiEvent `json:"items"`
+			NextPageToken string     `json:"nextPageToken"`
+			NextSyncToken string     `json:"nextSyncToken"`
+		}
+		err := c.do(ctx, accessToken, http.MethodGet, "/calendars/"+url.PathEscape(calendarID)+"/events", q, nil, &page)
+		if err != nil {
+			return nil, "", fmt.Errorf("google list events: %w", err)
+		}
+		for _, ae := range page.Items {
+			events = append(events, fromAPIEvent(ae))
+		}
+		if page.NextPageToken == "" {
+			return events, page.NextSyncToken, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// do sends a Calendar API request with a JSON body (if in is not nil) and
+// decodes the JSON response into out (if not nil).
+func (c *Client) do(ctx context.Context, accessToken, method, path string, query url.Values, in, out any) error {
+	u := c.apiURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusGone:
+		return ErrSyncTokenExpired
+	case resp.StatusCode >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 10<<20)).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
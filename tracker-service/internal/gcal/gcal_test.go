@@ -0,0 +1,131 @@
+package gcal_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/gcal"
+)
+
+func newClient(t *testing.T, h http.Handler) *gcal.Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	c, err := gcal.New(gcal.Config{ClientID: "id", ClientSecret: "secret", RedirectURL: "https://api.example.com/cb"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcal.PointTo(c, srv.URL)
+	return c
+}
+
+func TestNew_Validation(t *testing.T) {
+	for name, cfg := range map[string]gcal.Config{
+		"no client id":    {ClientSecret: "s", RedirectURL: "https://x/cb"},
+		"no secret":       {ClientID: "i", RedirectURL: "https://x/cb"},
+		"relative url":    {ClientID: "i", ClientSecret: "s", RedirectURL: "/cb"},
+		"no redirect url": {ClientID: "i", ClientSecret: "s"},
+	} {
+		if _, err := gcal.New(cfg); err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	c, _ := gcal.New(gcal.Config{ClientID: "id", ClientSecret: "secret", RedirectURL: "https://api.example.com/cb"})
+	u, err := url.Parse(c.AuthCodeURL("st4te"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("state") != "st4te" || q.Get("access_type") != "offline" || q.Get("scope") != gcal.Scope ||
+		q.Get("redirect_uri") != "https://api.example.com/cb" {
+		t.Errorf("unexpected consent URL %s", u)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/token" || r.Form.Get("code") != "c0de" || r.Form.Get("client_secret") != "secret" {
+			t.Errorf("unexpected token request %s %v", r.URL.Path, r.Form)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "at", "refresh_token": "rt", "expires_in": 3600})
+	}))
+	tok, err := c.Exchange(context.Background(), "c0de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.AccessToken != "at" || tok.RefreshToken != "rt" || time.Until(tok.Expiry) < 59*time.Minute {
+		t.Errorf("token = %+v", tok)
+	}
+}
+
+func TestRefresh_InvalidGrant(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"})
+	}))
+	if _, err := c.Refresh(context.Background(), "rt"); !errors.Is(err, gcal.ErrUnauthorized) {
+		t.Errorf("Refresh error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestListChanges_Pages(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		q := r.URL.Query()
+		switch {
+		case q.Get("syncToken") == "old":
+			w.WriteHeader(http.StatusGone)
+		case q.Get("pageToken") == "":
+			json.NewEncoder(w).Encode(map[string]any{
+				"items": []any{map[string]any{
+					"id": "e1", "status": "confirmed", "summary": "Interview",
+					"start":              map[string]string{"dateTime": "2026-05-04T14:30:00+02:00"},
+					"end":                map[string]string{"dateTime": "2026-05-04T15:30:00+02:00"},
+					"extendedProperties": map[string]any{"private": map[string]string{"jobmateUid": "interview-1@jobmate"}},
+				}},
+				"nextPageToken": "p2",
+			})
+		default:
+			json.NewEncoder(w).Encode(map[string]any{
+				"items":         []any{map[string]any{"id": "e2", "status": "cancelled"}},
+				"nextSyncToken": "next",
+			})
+		}
+	}))
+	ctx := context.Background()
+
+	events, next, err := c.ListChanges(ctx, "at", "cal@group.calendar.google.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != "next" || len(events) != 2 {
+		t.Fatalf("got %d events, sync token %q; want 2, %q", len(events), next, "next")
+	}
+	want := time.Date(2026, 5, 4, 12, 30, 0, 0, time.UTC)
+	if e := events[0]; e.ID != "e1" || e.UID != "interview-1@jobmate" || !e.Start.Equal(want) || e.Cancelled {
+		t.Errorf("events[0] = %+v", e)
+	}
+	if !events[1].Cancelled {
+		t.Errorf("events[1] = %+v, want cancelled", events[1])
+	}
+
+	if _, _, err := c.ListChanges(ctx, "at", "cal", "old"); !errors.Is(err, gcal.ErrSyncTokenExpired) {
+		t.Errorf("expired sync token error = %v, want ErrSyncTokenExpired", err)
+	}
+	if _, _, err := c.ListChanges(ctx, "revoked", "cal", ""); !errors.Is(err, gcal.ErrUnauthorized) {
+		t.Errorf("revoked access token error = %v, want ErrUnauthorized", err)
+	}
+}
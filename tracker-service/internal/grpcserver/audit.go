@@ -0,0 +1,150 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	pb "jobmate/tracker-service/internal/pb"
+
+	"jobmate/tracker-service/internal/audit"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditOptions configures the audit interceptor for tracker RPCs.
+func AuditOptions() audit.Options {
+	return audit.Options{
+		Service: "tracker",
+		Actor: func(ctx context.Context) string {
+			userID, _ := userIDFromCtx(ctx)
+			return userID
+		},
+		Target: auditTarget,
+	}
+}
+
+// auditTarget locates the resource a tracker request refers to, or the one
+// a creation returned. IDs are tried from the most specific resource to the
+// application they belong to.
+func auditTarget(msg any, userID string) (audit.Target, bool) {
+	switch m := msg.(type) {
+	case *pb.UpdateSettingsRequest:
+		return audit.Target{Type: "settings", ID: userID}, true
+	case *pb.ApplicationProto:
+		return audit.Target{Type: "application", ID: m.Id}, true
+	case *pb.Note:
+		return audit.Target{Type: "note", ID: m.Id}, true
+	case *pb.Interview:
+		return audit.Target{Type: "interview", ID: m.Id}, true
+	case *pb.Contact:
+		return audit.Target{Type: "contact", ID: m.Id}, true
+	case *pb.BoardColumn:
+		return audit.Target{Type: "column", ID: m.Id}, true
+	case *pb.NegotiationEntry:
+		return audit.Target{Type: "negotiation", ID: m.Id}, true
+	case *pb.CreateAttachmentResponse:
+		return audit.Target{Type: "attachment", ID: m.GetAttachment().GetId()}, true
+	}
+
+	var id string
+	if m, ok := msg.(interface{ GetNoteId() string }); ok {
+		if id = m.GetNoteId(); id != "" {
+			return audit.Target{Type: "note", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetInterviewId() string }); ok {
+		if id = m.GetInterviewId(); id != "" {
+			return audit.Target{Type: "interview", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetAttachmentId() string }); ok {
+		if id = m.GetAttachmentId(); id != "" {
+			return audit.Target{Type: "attachment", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetContactId() string }); ok {
+		if id = m.GetContactId(); id != "" {
+			return audit.Target{Type: "contact", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetEntryId() string }); ok {
+		if id = m.GetEntryId(); id != "" {
+			return audit.Target{Type: "negotiation", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetApplicationId() string }); ok {
+		if id = m.GetApplicationId(); id != "" {
+			return audit.Target{Type: "application", ID: id}, true
+		}
+	}
+	if m, ok := msg.(interface{ GetColumnId() string }); ok {
+		if id = m.GetColumnId(); id != "" {
+			return audit.Target{Type: "column", ID: id}, true
+		}
+	}
+	return audit.Target{}, false
+}
+
+// ListAuditLog returns audit entries, newest first. Callers see their own
+// entries; those listed in AUDIT_ADMIN_USER_IDS may query anyone's.
+func (s *Server) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.audit == nil {
+		return nil, status.Error(codes.FailedPrecondition, "audit log disabled")
+	}
+
+	f := audit.Filter{
+		ActorID:    req.ActorId,
+		Action:     req.Action,
+		ResourceID: req.ResourceId,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	}
+	if req.Since != nil {
+		f.Since = req.Since.AsTime()
+	}
+	if req.Until != nil {
+		f.Until = req.Until.AsTime()
+	}
+	if !slices.Contains(s.auditAdmins, userID) {
+		if f.ActorID != "" && f.ActorID != userID {
+			return nil, status.Error(codes.PermissionDenied, "only audit administrators may read other users' entries")
+		}
+		f.ActorID = userID
+	}
+
+	entries, next, err := s.audit.List(ctx, f)
+	if errors.Is(err, audit.ErrInvalidFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.ListAuditLogResponse{
+		Entries:       make([]*pb.AuditEntry, 0, len(entries)),
+		NextPageToken: next,
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &pb.AuditEntry{
+			Id:           e.ID,
+			OccurredAt:   timestamppb.New(e.OccurredAt),
+			Service:      e.Service,
+			ActorId:      e.ActorID,
+			Action:       e.Action,
+			ResourceType: e.ResourceType,
+			ResourceId:   e.ResourceID,
+			OldValues:    e.OldValues,
+			NewValues:    e.NewValues,
+			Request:      e.Request,
+			Outcome:      e.Outcome,
+		})
+	}
+	return resp, nil
+}
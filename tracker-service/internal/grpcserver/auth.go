@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"jobmate/tracker-service/internal/jwtauth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// InternalTokenMetadataKey is the metadata key callers put the shared
+// internal service token in (see InternalTokenInterceptor).
+const InternalTokenMetadataKey = "x-internal-token"
+
+// InternalTokenInterceptor refuses calls that do not carry token in their
+// x-internal-token metadata. The tracker trusts the x-user-id its callers
+// send; the token keeps that trust to the Gateway rather than to anything
+// that can reach the Docker network. Install it before the interceptors that
+// act on the request (audit).
+func InternalTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	want := []byte(token)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		vals := md.Get(InternalTokenMetadataKey)
+		if len(vals) == 0 || subtle.ConstantTimeCompare([]byte(vals[0]), want) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid internal service token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// MTLSCredentials returns server credentials presenting the certificate in
+// certFile/keyFile and requiring clients to present one signed by a CA of
+// clientCAFile (PEM): callers without such a certificate fail the handshake.
+func MTLSCredentials(certFile, keyFile, clientCAFile string) (credentials.TransportCredentials, error) {
+	cfg, err := MTLSConfig(certFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// MTLSConfig is the TLS configuration of MTLSCredentials, for the tracker's
+// other listeners (the REST gateway).
+func MTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client CA: %w", err)
+	}
+	cas := x509.NewCertPool()
+	if !cas.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("client CA %s: no PEM certificate found", clientCAFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    cas,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// UserJWTInterceptor derives the caller's user ID from the JWT forwarded in
+// the "authorization" metadata ("Bearer <token>") instead of trusting the
+// x-user-id sent alongside: the verified ID replaces it. Calls without a
+// token proceed without a user (only the token-authenticated RPCs, such as
+// RenderCalendarFeed, accept them); calls with an invalid one are refused.
+// Install it before every interceptor that reads the user ID.
+func UserJWTInterceptor(v *jwtauth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		md = md.Copy()
+		md.Delete("x-user-id")
+
+		if vals := md.Get("authorization"); len(vals) > 0 {
+			token, ok := strings.CutPrefix(vals[0], "Bearer ")
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "authorization metadata must be a Bearer token")
+			}
+			userID, err := v.UserID(ctx, token)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			md.Set("x-user-id", userID)
+		}
+		return handler(metadata.NewIncomingContext(ctx, md), req)
+	}
+}
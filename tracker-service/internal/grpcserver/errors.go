@@ -0,0 +1,173 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"jobmate/tracker-service/internal/kanban"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Every error the tracker returns carries a google.rpc.ErrorInfo detail in
+// ErrorDomain whose Reason is a stable, machine-readable code clients branch
+// on instead of the English message: APPLICATION_NOT_FOUND,
+// VALIDATION_FAILED, … (see errorReasons), or the name of the status code
+// (UNAUTHENTICATED, INTERNAL, …) for errors without a more precise one. The
+// reason is also sent in the ErrorReasonKey trailer, for clients that do
+// not decode status details (the Gateway).
+
+const (
+	// ErrorDomain is the ErrorInfo domain of the tracker's errors.
+	ErrorDomain = "tracker.jobmate"
+	// ErrorReasonKey is the trailer metadata key holding the error reason.
+	ErrorReasonKey = "x-error-reason"
+)
+
+// Reasons of the errors that carry details besides ErrorInfo.
+const (
+	ReasonValidationFailed     = "VALIDATION_FAILED"
+	ReasonDuplicateApplication = "DUPLICATE_APPLICATION"
+	ReasonReanalyzeCooldown    = "REANALYZE_COOLDOWN"
+)
+
+// errorReasons maps the domain's sentinel errors to their status code and
+// reason.
+var errorReasons = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{kanban.ErrNotFound, codes.NotFound, "APPLICATION_NOT_FOUND"},
+	{kanban.ErrJobNotFound, codes.NotFound, "JOB_NOT_FOUND"},
+	{kanban.ErrColumnNotFound, codes.NotFound, "COLUMN_NOT_FOUND"},
+	{kanban.ErrNoteNotFound, codes.NotFound, "NOTE_NOT_FOUND"},
+	{kanban.ErrAttachmentNotFound, codes.NotFound, "ATTACHMENT_NOT_FOUND"},
+	{kanban.ErrTemplateNotFound, codes.NotFound, "TEMPLATE_NOT_FOUND"},
+	{kanban.ErrCoverLetterVersionNotFound, codes.NotFound, "COVER_LETTER_VERSION_NOT_FOUND"},
+	{kanban.ErrInterviewNotFound, codes.NotFound, "INTERVIEW_NOT_FOUND"},
+	{kanban.ErrContactNotFound, codes.NotFound, "CONTACT_NOT_FOUND"},
+	{kanban.ErrCompanyNotFound, codes.NotFound, "COMPANY_NOT_FOUND"},
+	{kanban.ErrNegotiationEntryNotFound, codes.NotFound, "NEGOTIATION_ENTRY_NOT_FOUND"},
+	{kanban.ErrBenchmarkNotFound, codes.NotFound, "BENCHMARK_NOT_FOUND"},
+	{kanban.ErrCalendarFeedNotFound, codes.NotFound, "CALENDAR_FEED_NOT_FOUND"},
+	{kanban.ErrSearchConfigNotFound, codes.NotFound, "SEARCH_CONFIG_NOT_FOUND"},
+	{kanban.ErrShareNotFound, codes.NotFound, "SHARE_NOT_FOUND"},
+	{kanban.ErrWebhookNotFound, codes.NotFound, "WEBHOOK_NOT_FOUND"},
+	{kanban.ErrSnapshotNotFound, codes.NotFound, "SNAPSHOT_NOT_FOUND"},
+	{kanban.ErrAttachmentsDisabled, codes.FailedPrecondition, "ATTACHMENTS_DISABLED"},
+	{kanban.ErrAttachmentScanPending, codes.FailedPrecondition, "ATTACHMENT_SCAN_PENDING"},
+	{kanban.ErrAttachmentQuarantined, codes.FailedPrecondition, "ATTACHMENT_QUARANTINED"},
+	{kanban.ErrBenchmarksNotShared, codes.FailedPrecondition, "BENCHMARKS_NOT_SHARED"},
+	{kanban.ErrGoogleCalendarDisabled, codes.FailedPrecondition, "GOOGLE_CALENDAR_DISABLED"},
+	{kanban.ErrWebhooksDisabled, codes.FailedPrecondition, "WEBHOOKS_DISABLED"},
+	{kanban.ErrIdempotentRequestPending, codes.Aborted, "IDEMPOTENT_REQUEST_PENDING"},
+	{kanban.ErrIdempotencyKeyReused, codes.InvalidArgument, "IDEMPOTENCY_KEY_REUSED"},
+}
+
+// toGRPCError maps domain errors to gRPC status errors.
+func toGRPCError(err error) error {
+	for _, r := range errorReasons {
+		if errors.Is(err, r.err) {
+			return withDetails(status.New(r.code, err.Error()), errorInfo(r.reason, nil))
+		}
+	}
+	var ce *kanban.CooldownError
+	if errors.As(err, &ce) {
+		return withDetails(status.New(codes.ResourceExhausted, ce.Error()),
+			errorInfo(ReasonReanalyzeCooldown, nil),
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(ce.RetryAfter)},
+		)
+	}
+	var de *kanban.DuplicateError
+	if errors.As(err, &de) {
+		return withDetails(status.New(codes.AlreadyExists, de.Error()),
+			errorInfo(ReasonDuplicateApplication, map[string]string{"application_ids": strings.Join(de.ApplicationIDs, ",")}),
+		)
+	}
+	var ve *kanban.ValidationError
+	if errors.As(err, &ve) {
+		st := status.New(codes.InvalidArgument, ve.Msg)
+		if ve.Field == "" {
+			return withDetails(st, errorInfo(ReasonValidationFailed, nil))
+		}
+		// Structured detail so clients can point at the offending field.
+		return withDetails(st,
+			errorInfo(ReasonValidationFailed, map[string]string{"field": ve.Field}),
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: ve.Field, Description: ve.Msg}},
+			},
+		)
+	}
+	return withDetails(status.New(codes.Internal, "internal server error"), errorInfo(grpcCodeName(codes.Internal), nil))
+}
+
+func errorInfo(reason string, md map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: md}
+}
+
+// withDetails returns st with details attached, or st alone if they cannot
+// be marshalled.
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// errorReason returns the ErrorInfo reason of st, "" if it has none.
+func errorReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+// ErrorInfoInterceptor gives every error an ErrorInfo reason — the code's
+// name when the handler or an interceptor did not set one — and sends it in
+// the ErrorReasonKey trailer. Install it right after RequestIDInterceptor so
+// it sees the errors of all the others.
+func ErrorInfoInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		st := status.Convert(err)
+		reason := errorReason(st)
+		if reason == "" {
+			reason = grpcCodeName(st.Code())
+			err = withDetails(st, errorInfo(reason, nil))
+		}
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(ErrorReasonKey, reason)) // fails only outside a real stream (tests)
+		return resp, err
+	}
+}
+
+// grpcCodeName renders a status code the way it is spelled in the gRPC spec
+// (NOT_FOUND, INVALID_ARGUMENT, …), for per-item error fields and default
+// error reasons.
+func grpcCodeName(c codes.Code) string {
+	name := c.String()
+	if strings.HasPrefix(name, "Code(") {
+		return "CODE_" + strconv.Itoa(int(c))
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
@@ -0,0 +1,58 @@
+package grpcserver_test
+
+import (
+	"context"
+	"testing"
+
+	"jobmate/tracker-service/internal/grpcserver"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func reasonOf(t *testing.T, err error) string {
+	t.Helper()
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.Domain != grpcserver.ErrorDomain {
+				t.Errorf("ErrorInfo domain = %q", info.Domain)
+			}
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestErrorInfoInterceptor(t *testing.T) {
+	intercept := grpcserver.ErrorInfoInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/tracker.TrackerService/Test"}
+	run := func(err error) error {
+		_, got := intercept(context.Background(), nil, info, func(context.Context, any) (any, error) { return nil, err })
+		return got
+	}
+
+	if err := run(nil); err != nil {
+		t.Fatalf("success = %v", err)
+	}
+
+	// Errors without a reason get their code's name.
+	err := run(status.Error(codes.Unauthenticated, "missing token"))
+	if status.Code(err) != codes.Unauthenticated || reasonOf(t, err) != "UNAUTHENTICATED" {
+		t.Errorf("plain status = %v (reason %q), want UNAUTHENTICATED", err, reasonOf(t, err))
+	}
+	err = run(status.Error(codes.FailedPrecondition, "nope"))
+	if reasonOf(t, err) != "FAILED_PRECONDITION" {
+		t.Errorf("reason = %q, want FAILED_PRECONDITION", reasonOf(t, err))
+	}
+
+	// A reason set by the handler is kept.
+	st, _ := status.New(codes.NotFound, "application not found").WithDetails(&errdetails.ErrorInfo{
+		Reason: "APPLICATION_NOT_FOUND", Domain: grpcserver.ErrorDomain,
+	})
+	err = run(st.Err())
+	if reasonOf(t, err) != "APPLICATION_NOT_FOUND" || len(status.Convert(err).Details()) != 1 {
+		t.Errorf("handler reason = %q (%d details), want APPLICATION_NOT_FOUND alone", reasonOf(t, err), len(status.Convert(err).Details()))
+	}
+}
@@ -0,0 +1,100 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"jobmate/tracker-service/internal/audit"
+	"jobmate/tracker-service/internal/kanban"
+	"jobmate/tracker-service/internal/requestid"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDInterceptor puts the caller's x-request-id metadata — or a new ID
+// when it is missing or malformed — in the context of the call and echoes it
+// in the response header. Install it first so later interceptors see it.
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if vals := md.Get(requestid.MetadataKey); len(vals) > 0 && requestid.Valid(vals[0]) {
+				id = vals[0]
+			}
+		}
+		if id == "" {
+			id = requestid.New()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, id)) // fails only outside a real stream (tests)
+		return handler(requestid.NewContext(ctx, id), req)
+	}
+}
+
+// LoggingInterceptor logs every RPC once it returns, with its latency and
+// status code: Info for successes and client errors, Error for server errors.
+func LoggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		attrs := []any{
+			"method", info.FullMethod,
+			"code", code.String(),
+			"durationMs", time.Since(start).Milliseconds(),
+		}
+		if userID, uerr := userIDFromCtx(ctx); uerr == nil {
+			attrs = append(attrs, "userId", userID)
+		}
+		if isServerError(code) {
+			slog.ErrorContext(ctx, "grpc request failed", append(attrs, "err", err)...)
+		} else {
+			slog.InfoContext(ctx, "grpc request", attrs...)
+		}
+		return resp, err
+	}
+}
+
+// isServerError reports whether code points at a fault of the tracker rather
+// than of the request.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.DeadlineExceeded, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+// RecoveryInterceptor turns a panic in a handler into an Internal error,
+// logged with its stack, instead of crashing the process.
+func RecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.ErrorContext(ctx, "grpc handler panic", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+				resp, err = nil, status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// CacheInvalidationInterceptor drops the caller's cached views (see
+// kanban.Service.InvalidateUserCache) once a mutating RPC returns — failed
+// ones included, which may have changed something before failing.
+func CacheInvalidationInterceptor(svc *kanban.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if audit.IsMutating(info.FullMethod) {
+			if userID, uerr := userIDFromCtx(ctx); uerr == nil {
+				svc.InvalidateUserCache(context.WithoutCancel(ctx), userID)
+			}
+		}
+		return resp, err
+	}
+}
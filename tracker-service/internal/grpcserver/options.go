@@ -0,0 +1,55 @@
+package grpcserver
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Tuning holds the grpc.Server limits operators may change; zero fields
+// keep the grpc-go defaults.
+type Tuning struct {
+	// MaxRecvMsgSize and MaxSendMsgSize bound a message, in bytes (grpc-go:
+	// 4 MiB received, unlimited sent). AI analyses make the largest ones.
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+	// MaxConcurrentStreams bounds the calls in flight on one connection
+	// (grpc-go: unlimited).
+	MaxConcurrentStreams uint32
+
+	// KeepaliveMinTime is how often clients may ping at most; faster
+	// clients are disconnected (grpc-go: 5m). KeepalivePermitWithoutStream
+	// also lets them ping between calls.
+	KeepaliveMinTime             time.Duration
+	KeepalivePermitWithoutStream bool
+	// KeepaliveTime is how long a connection may stay silent before the
+	// server pings it, and KeepaliveTimeout how long it then waits for the
+	// answer before closing it (grpc-go: 2h, 20s).
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+}
+
+// ServerOptions returns the grpc.ServerOptions applying t.
+func (t Tuning) ServerOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             t.KeepaliveMinTime,
+			PermitWithoutStream: t.KeepalivePermitWithoutStream,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    t.KeepaliveTime,
+			Timeout: t.KeepaliveTimeout,
+		}),
+	}
+	if t.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(t.MaxRecvMsgSize))
+	}
+	if t.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(t.MaxSendMsgSize))
+	}
+	if t.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(t.MaxConcurrentStreams))
+	}
+	return opts
+}
@@ -0,0 +1,245 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	pb "jobmate/tracker-service/internal/pb"
+	"jobmate/tracker-service/internal/requestid"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// The REST gateway exposes every TrackerService RPC as JSON over HTTP, for
+// scripts, debugging sessions and webhooks that have no gRPC stubs:
+//
+//	POST /v1/<Method>   body: the request message, protojson
+//	                    → 200 and the response message, or an error
+//	GET  /v1/           the methods, with their request and response types
+//
+// e.g.
+//
+//	curl -X POST -H "x-internal-token: $TOKEN" -H "x-user-id: $USER" \
+//	     -d '{"applicationId": "…"}' http://tracker:8083/v1/GetApplication
+//
+// Calls go through a gRPC connection to the tracker itself, so they run the
+// same interceptors as any other: authentication (the x-internal-token,
+// x-user-id and authorization headers are forwarded as metadata), request
+// IDs, UUID validation, audit. Errors come back in the JSON error body the
+// services share, {"error": {"code": "<reason>", "message": "…"}}, with the
+// HTTP status matching the gRPC code.
+
+// restForwardedHeaders are the HTTP headers passed on to the RPC as metadata.
+var restForwardedHeaders = []string{
+	InternalTokenMetadataKey,
+	"x-user-id",
+	"authorization",
+	requestid.MetadataKey,
+	"traceparent",
+	"tracestate",
+}
+
+// restMethod is an RPC the REST gateway serves.
+type restMethod struct {
+	fullName string // /tracker.TrackerService/<Method>
+	input    protoreflect.MessageType
+	output   protoreflect.MessageType
+}
+
+// RESTHandler returns the REST gateway, calling the tracker through conn.
+// Request bodies larger than maxBody bytes are refused (0 = no limit).
+func RESTHandler(conn grpc.ClientConnInterface, maxBody int64) http.Handler {
+	methods := restMethods()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/{$}", func(w http.ResponseWriter, r *http.Request) {
+		list := make(map[string]map[string]string, len(methods))
+		for name, m := range methods {
+			list[name] = map[string]string{
+				"request":  string(m.input.Descriptor().FullName()),
+				"response": string(m.output.Descriptor().FullName()),
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"methods": list})
+	})
+	mux.HandleFunc("POST /v1/{method}", func(w http.ResponseWriter, r *http.Request) {
+		m, ok := methods[r.PathValue("method")]
+		if !ok {
+			writeRESTError(w, http.StatusNotFound, "NOT_FOUND", "unknown method "+r.PathValue("method"))
+			return
+		}
+		serveREST(w, r, conn, m, maxBody)
+	})
+	return mux
+}
+
+// restInProcessBufSize is the buffer of the in-process connection behind the
+// REST gateway.
+const restInProcessBufSize = 1 << 20
+
+// NewInProcess serves impl on an in-memory listener with opts and returns
+// that server along with a connection to it — the REST gateway's way into
+// the tracker, through the same interceptors as the network listener.
+// Stop the server and close the connection at shutdown.
+func NewInProcess(impl pb.TrackerServiceServer, opts ...grpc.ServerOption) (*grpc.Server, *grpc.ClientConn, error) {
+	lis := bufconn.Listen(restInProcessBufSize)
+	srv := grpc.NewServer(opts...)
+	pb.RegisterTrackerServiceServer(srv, impl)
+	go func() { _ = srv.Serve(lis) }() // returns once stopped
+
+	conn, err := grpc.NewClient("passthrough:///tracker",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		return nil, nil, fmt.Errorf("in-process connection: %w", err)
+	}
+	return srv, conn, nil
+}
+
+// restMethods indexes the TrackerService RPCs by name.
+func restMethods() map[string]restMethod {
+	sd := pb.File_tracker_proto.Services().ByName("TrackerService")
+	methods := make(map[string]restMethod, sd.Methods().Len())
+	for i := range sd.Methods().Len() {
+		md := sd.Methods().Get(i)
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			continue
+		}
+		in, errIn := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		out, errOut := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+		if errIn != nil || errOut != nil {
+			continue
+		}
+		methods[string(md.Name())] = restMethod{
+			fullName: "/" + string(sd.FullName()) + "/" + string(md.Name()),
+			input:    in,
+			output:   out,
+		}
+	}
+	return methods
+}
+
+// serveREST decodes the request message, calls the RPC and encodes its
+// response.
+func serveREST(w http.ResponseWriter, r *http.Request, conn grpc.ClientConnInterface, m restMethod, maxBody int64) {
+	body := io.Reader(r.Body)
+	if maxBody > 0 {
+		body = http.MaxBytesReader(w, r.Body, maxBody)
+	}
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeRESTError(w, http.StatusRequestEntityTooLarge, "RESOURCE_EXHAUSTED", "request body too large")
+			return
+		}
+		writeRESTError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "cannot read request body")
+		return
+	}
+	in := m.input.New().Interface()
+	if len(strings.TrimSpace(string(raw))) > 0 {
+		if err := protojson.Unmarshal(raw, in); err != nil {
+			writeRESTError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid request body: "+err.Error())
+			return
+		}
+	}
+
+	md := metadata.MD{}
+	for _, h := range restForwardedHeaders {
+		if v := r.Header.Get(h); v != "" {
+			md.Set(h, v)
+		}
+	}
+	ctx := metadata.NewOutgoingContext(r.Context(), md)
+
+	out := m.output.New().Interface()
+	var header metadata.MD
+	err = conn.Invoke(ctx, m.fullName, in, out, grpc.Header(&header))
+	if ids := header.Get(requestid.MetadataKey); len(ids) > 0 {
+		w.Header().Set("X-Request-Id", ids[0])
+	}
+	if err != nil {
+		writeRPCError(w, err)
+		return
+	}
+	writeRESTMessage(w, out)
+}
+
+// writeRESTMessage answers 200 with msg as JSON, unset fields included so
+// scripts need not know the proto defaults.
+func writeRESTMessage(w http.ResponseWriter, msg proto.Message) {
+	raw, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, "INTERNAL", "cannot encode response")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(raw)
+}
+
+// writeRPCError answers with the error of an RPC: its ErrorInfo reason (the
+// code's name if it has none) and message, with the matching HTTP status.
+func writeRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	reason := errorReason(st)
+	if reason == "" {
+		reason = grpcCodeName(st.Code())
+	}
+	writeRESTError(w, httpStatusFromCode(st.Code()), reason, st.Message())
+}
+
+// writeRESTError answers with the JSON error body the services share.
+func writeRESTError(w http.ResponseWriter, httpStatus int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(httpStatus)
+	body := map[string]map[string]string{"error": {"code": code, "message": message}}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// httpStatusFromCode maps a gRPC status code to its HTTP status, as
+// grpc-gateway does.
+func httpStatusFromCode(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // client closed request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,130 @@
+package grpcserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/grpcserver"
+	pb "jobmate/tracker-service/internal/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// restFake answers GetApplication with the caller's x-user-id as the card's
+// title; every other RPC is unimplemented.
+type restFake struct {
+	pb.UnimplementedTrackerServiceServer
+}
+
+func (restFake) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.ApplicationProto, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("x-user-id")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing x-user-id metadata")
+	}
+	return &pb.ApplicationProto{Id: req.ApplicationId, JobTitle: md.Get("x-user-id")[0]}, nil
+}
+
+func newRESTServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv, conn, err := grpcserver.NewInProcess(restFake{},
+		grpc.ChainUnaryInterceptor(grpcserver.RequestIDInterceptor(), grpcserver.ErrorInfoInterceptor()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(grpcserver.RESTHandler(conn, 1024))
+	t.Cleanup(func() {
+		ts.Close()
+		conn.Close()
+		srv.Stop()
+	})
+	return ts
+}
+
+func postREST(t *testing.T, ts *httptest.Server, method, body string, header map[string]string) (*http.Response, map[string]any) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodPost, ts.URL+"/v1/"+method, strings.NewReader(body))
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var decoded map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("%s: decode: %v", method, err)
+	}
+	return resp, decoded
+}
+
+func TestRESTHandlerCall(t *testing.T) {
+	ts := newRESTServer(t)
+	resp, body := postREST(t, ts, "GetApplication", `{"applicationId": "app-1"}`,
+		map[string]string{"x-user-id": "user-1", "x-request-id": "req-1"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, body %v", resp.StatusCode, body)
+	}
+	if body["id"] != "app-1" || body["jobTitle"] != "user-1" {
+		t.Errorf("body = %v, want the application with the forwarded user", body)
+	}
+	if _, ok := body["userRating"]; !ok {
+		t.Error("unset fields should be included")
+	}
+	if got := resp.Header.Get("X-Request-Id"); got != "req-1" {
+		t.Errorf("X-Request-Id = %q, want req-1", got)
+	}
+}
+
+func TestRESTHandlerErrors(t *testing.T) {
+	ts := newRESTServer(t)
+	for _, tc := range []struct {
+		name, method, body string
+		header             map[string]string
+		status             int
+		code               string
+	}{
+		{"rpc error", "GetApplication", `{}`, nil, http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{"unimplemented", "ListApplications", ``, nil, http.StatusNotImplemented, "UNIMPLEMENTED"},
+		{"unknown method", "DropTables", `{}`, nil, http.StatusNotFound, "NOT_FOUND"},
+		{"invalid body", "GetApplication", `{"nope": 1}`, nil, http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"body too large", "GetApplication", `{"applicationId": "` + strings.Repeat("a", 2048) + `"}`, nil,
+			http.StatusRequestEntityTooLarge, "RESOURCE_EXHAUSTED"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, body := postREST(t, ts, tc.method, tc.body, tc.header)
+			if resp.StatusCode != tc.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.status)
+			}
+			errBody, _ := body["error"].(map[string]any)
+			if errBody["code"] != tc.code {
+				t.Errorf("error = %v, want code %s", body["error"], tc.code)
+			}
+		})
+	}
+}
+
+func TestRESTHandlerListsMethods(t *testing.T) {
+	ts := newRESTServer(t)
+	resp, err := http.Get(ts.URL + "/v1/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		Methods map[string]map[string]string `json:"methods"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if got := body.Methods["GetApplication"]["request"]; got != "tracker.GetApplicationRequest" {
+		t.Errorf("GetApplication request = %q", got)
+	}
+}
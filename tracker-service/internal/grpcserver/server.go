@@ -8,9 +8,12 @@ package grpcserver
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	pb "jobmate/tracker-service/internal/pb"
 
+	"jobmate/tracker-service/internal/audit"
 	"jobmate/tracker-service/internal/kanban"
 
 	"google.golang.org/grpc/codes"
@@ -23,23 +26,1699 @@ import (
 type Server struct {
 	pb.UnimplementedTrackerServiceServer
 	svc *kanban.Service
+
+	audit       *audit.Log
+	auditAdmins []string // user IDs allowed to read every user's audit entries
+}
+
+// NewServer constructs a gRPC Server backed by the given kanban.Service.
+// auditLog serves ListAuditLog (nil disables it).
+func NewServer(svc *kanban.Service, auditLog *audit.Log, auditAdmins []string) *Server {
+	return &Server{svc: svc, audit: auditLog, auditAdmins: auditAdmins}
+}
+
+// ─── RPC implementations ──────────────────────────────────────────────────────
+
+// ListApplications returns all applications belonging to the caller.
+func (s *Server) ListApplications(ctx context.Context, req *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := s.svc.ListApplications(ctx, userID, kanban.ListFilter{
+		Status:          req.StatusFilter,
+		IncludeArchived: req.IncludeArchived,
+		Priority:        req.PriorityFilter,
+		Sort:            req.SortBy,
+		View:            req.View,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.ApplicationProto, 0, len(apps))
+	for i := range apps {
+		protos = append(protos, appToProto(&apps[i]))
+	}
+
+	return &pb.ListApplicationsResponse{Applications: protos}, nil
+}
+
+// MoveCard transitions an application to a new Kanban status.
+func (s *Server) MoveCard(ctx context.Context, req *pb.MoveCardRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "MoveCard", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.MoveCard(ctx, userID, req.ApplicationId, req.NewStatus, req.RejectionReason, req.RejectionStage, req.Reason)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// UndoLastMove reverts the most recent status change of an application.
+func (s *Server) UndoLastMove(ctx context.Context, req *pb.UndoLastMoveRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.UndoLastMove(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// UndoLastAction reverts the last move, rating change or note edit of an application.
+func (s *Server) UndoLastAction(ctx context.Context, req *pb.UndoLastActionRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.UndoLastAction(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// BulkMove transitions several applications to the same status at once.
+func (s *Server) BulkMove(ctx context.Context, req *pb.BulkMoveRequest) (*pb.BulkMoveResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.svc.BulkMove(ctx, userID, req.ApplicationIds, req.NewStatus, req.RejectionReason, req.RejectionStage, req.Reason)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.BulkMoveResponse{Results: make([]*pb.BulkMoveResult, 0, len(results))}
+	for _, r := range results {
+		item := &pb.BulkMoveResult{ApplicationId: r.ApplicationID, Ok: r.Err == nil}
+		if r.Err != nil {
+			st := status.Convert(toGRPCError(r.Err))
+			item.ErrorCode = grpcCodeName(st.Code())
+			item.ErrorReason = errorReason(st)
+			item.Error = st.Message()
+		} else {
+			item.Application = appToProto(r.App)
+			resp.MovedCount++
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp, nil
+}
+
+// AddNote appends a note to an application.
+func (s *Server) AddNote(ctx context.Context, req *pb.AddNoteRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "AddNote", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.AddNote(ctx, userID, req.ApplicationId, req.Note)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ListNotes returns an application's notes, oldest first.
+func (s *Server) ListNotes(ctx context.Context, req *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.svc.ListNotes(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.Note, 0, len(notes))
+	for i := range notes {
+		protos = append(protos, noteToProto(&notes[i]))
+	}
+
+	return &pb.ListNotesResponse{Notes: protos}, nil
+}
+
+// EditNote replaces the text of a note.
+func (s *Server) EditNote(ctx context.Context, req *pb.EditNoteRequest) (*pb.Note, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.svc.EditNote(ctx, userID, req.NoteId, req.Text)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return noteToProto(n), nil
+}
+
+// DeleteNote removes a note.
+func (s *Server) DeleteNote(ctx context.Context, req *pb.DeleteNoteRequest) (*pb.DeleteNoteResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteNote(ctx, userID, req.NoteId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteNoteResponse{}, nil
+}
+
+// RateApplication rates an application, by stars or per criterion.
+func (s *Server) RateApplication(ctx context.Context, req *pb.RateApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rating := kanban.Rating{Stars: req.Rating, Review: req.Review}
+	if c := req.Criteria; c != nil {
+		rating.Criteria = kanban.RatingCriteria{
+			RoleFit:      c.RoleFit,
+			Compensation: c.Compensation,
+			Culture:      c.Culture,
+			Commute:      c.Commute,
+		}
+	}
+	app, err := s.svc.RateApplication(ctx, userID, req.ApplicationId, rating)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// GetApplication returns a single application by ID.
+func (s *Server) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.GetApplication(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// GetApplicationByJobFeedId returns the caller's application for a job_feed
+// entry, or an empty response if they do not track it.
+func (s *Server) GetApplicationByJobFeedId(ctx context.Context, req *pb.GetApplicationByJobFeedIdRequest) (*pb.GetApplicationByJobFeedIdResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.GetApplicationByJobFeedID(ctx, userID, req.JobFeedId)
+	if errors.Is(err, kanban.ErrNotFound) {
+		return &pb.GetApplicationByJobFeedIdResponse{}, nil
+	}
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.GetApplicationByJobFeedIdResponse{Application: appToProto(app)}, nil
+}
+
+// BatchGetApplications returns the caller's applications among the requested IDs.
+func (s *Server) BatchGetApplications(ctx context.Context, req *pb.BatchGetApplicationsRequest) (*pb.BatchGetApplicationsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := s.svc.BatchGetApplications(ctx, userID, req.ApplicationIds)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.ApplicationProto, 0, len(apps))
+	for i := range apps {
+		protos = append(protos, appToProto(&apps[i]))
+	}
+	return &pb.BatchGetApplicationsResponse{Applications: protos}, nil
+}
+
+// GetHistory returns a page of an application's history, newest first.
+func (s *Server) GetHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.svc.GetHistory(ctx, userID, req.ApplicationId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.GetHistoryResponse{
+		Entries:       make([]*pb.HistoryEntry, 0, len(page.Entries)),
+		NextPageToken: page.NextPageToken,
+		TotalCount:    int32(page.TotalCount),
+	}
+	for _, e := range page.Entries {
+		resp.Entries = append(resp.Entries, historyEntryToProto(e))
+	}
+	return resp, nil
+}
+
+// GetTimeline returns a page of an application's activity, newest first.
+func (s *Server) GetTimeline(ctx context.Context, req *pb.GetTimelineRequest) (*pb.GetTimelineResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.svc.GetTimeline(ctx, userID, req.ApplicationId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.GetTimelineResponse{
+		Entries:       make([]*pb.TimelineEntry, 0, len(page.Entries)),
+		NextPageToken: page.NextPageToken,
+		TotalCount:    int32(page.TotalCount),
+	}
+	for i := range page.Entries {
+		resp.Entries = append(resp.Entries, timelineEntryToProto(&page.Entries[i]))
+	}
+	return resp, nil
+}
+
+// SearchApplications returns a page of the caller's applications matching a
+// full-text query, best matches first.
+func (s *Server) SearchApplications(ctx context.Context, req *pb.SearchApplicationsRequest) (*pb.SearchApplicationsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.svc.SearchApplications(ctx, userID, req.Query, req.IncludeArchived, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.SearchApplicationsResponse{
+		Hits:          make([]*pb.SearchHit, 0, len(page.Hits)),
+		NextPageToken: page.NextPageToken,
+	}
+	for i := range page.Hits {
+		resp.Hits = append(resp.Hits, searchHitToProto(&page.Hits[i]))
+	}
+	return resp, nil
+}
+
+// CreateApplication creates a new application for the given job feed entry.
+func (s *Server) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "CreateApplication", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.CreateApplication(ctx, userID, req.JobFeedId, req.ConfirmDuplicate)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// CreateManualApplication creates an application for a job found outside JobMate.
+func (s *Server) CreateManualApplication(ctx context.Context, req *pb.CreateManualApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "CreateManualApplication", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.CreateManualApplication(ctx, userID, kanban.ManualJob{
+			Title:       req.Title,
+			Company:     req.Company,
+			URL:         req.Url,
+			Location:    req.Location,
+			Description: req.Description,
+		}, req.ConfirmDuplicate)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ImportApplicationEmail creates an application from a forwarded
+// confirmation email.
+func (s *Server) ImportApplicationEmail(ctx context.Context, req *pb.ImportApplicationEmailRequest) (*pb.ImportApplicationEmailResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := kanban.ParseConfirmationEmail(req.Email)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	app, err := s.svc.Idempotent(ctx, userID, "ImportApplicationEmail", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.ImportConfirmationEmail(ctx, userID, email, kanban.Status(req.Status), req.ConfirmDuplicate)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.ImportApplicationEmailResponse{
+		Application: appToProto(app),
+		Company:     email.Company,
+		JobTitle:    email.JobTitle,
+	}
+	if !email.AppliedAt.IsZero() {
+		resp.AppliedAt = timestamppb.New(email.AppliedAt)
+	}
+	return resp, nil
+}
+
+// CloneApplication creates a card for another job with the setup of an
+// existing one.
+func (s *Server) CloneApplication(ctx context.Context, req *pb.CloneApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "CloneApplication", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.CloneApplication(ctx, userID, req.ApplicationId, newCardJobFromProto(req.Job), req.ConfirmDuplicate)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ListApplicationTemplates returns the user's application templates.
+func (s *Server) ListApplicationTemplates(ctx context.Context, _ *pb.ListApplicationTemplatesRequest) (*pb.ListApplicationTemplatesResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.svc.ListTemplates(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.ListApplicationTemplatesResponse{Templates: make([]*pb.ApplicationTemplate, 0, len(templates))}
+	for i := range templates {
+		resp.Templates = append(resp.Templates, templateToProto(&templates[i]))
+	}
+	return resp, nil
+}
+
+// CreateApplicationTemplate saves a new application template.
+func (s *Server) CreateApplicationTemplate(ctx context.Context, req *pb.CreateApplicationTemplateRequest) (*pb.ApplicationTemplate, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.svc.CreateTemplate(ctx, userID, req.Template.GetName(), cardSetupFromProto(req.Template))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return templateToProto(t), nil
+}
+
+// UpdateApplicationTemplate replaces an application template.
+func (s *Server) UpdateApplicationTemplate(ctx context.Context, req *pb.UpdateApplicationTemplateRequest) (*pb.ApplicationTemplate, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.svc.UpdateTemplate(ctx, userID, req.Template.GetId(), req.Template.GetName(), cardSetupFromProto(req.Template))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return templateToProto(t), nil
+}
+
+// DeleteApplicationTemplate removes an application template.
+func (s *Server) DeleteApplicationTemplate(ctx context.Context, req *pb.DeleteApplicationTemplateRequest) (*pb.DeleteApplicationTemplateResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteTemplate(ctx, userID, req.TemplateId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteApplicationTemplateResponse{}, nil
+}
+
+// CreateApplicationFromTemplate creates a card with a template's setup.
+func (s *Server) CreateApplicationFromTemplate(ctx context.Context, req *pb.CreateApplicationFromTemplateRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.Idempotent(ctx, userID, "CreateApplicationFromTemplate", req.IdempotencyKey, req, func(ctx context.Context) (*kanban.Application, error) {
+		return s.svc.CreateApplicationFromTemplate(ctx, userID, req.TemplateId, newCardJobFromProto(req.Job), req.ConfirmDuplicate)
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// SetRelanceReminder sets the follow-up reminder timestamp on an application.
+func (s *Server) SetRelanceReminder(ctx context.Context, req *pb.SetRelanceReminderRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var app *kanban.Application
+	if req.LocalRemindAt != "" {
+		app, err = s.svc.SetRelanceReminderLocal(ctx, userID, req.ApplicationId, req.LocalRemindAt)
+	} else {
+		var remindAt time.Time
+		if req.RemindAt != nil {
+			remindAt = req.RemindAt.AsTime()
+		}
+		app, err = s.svc.SetRelanceReminder(ctx, userID, req.ApplicationId, remindAt)
+	}
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// SnoozeReminder pushes the application's relance reminder back.
+func (s *Server) SnoozeReminder(ctx context.Context, req *pb.SnoozeReminderRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Hours < 0 || req.Days < 0 {
+		return nil, status.Error(codes.InvalidArgument, "hours and days must not be negative")
+	}
+	by := time.Duration(req.Hours)*time.Hour + time.Duration(req.Days)*24*time.Hour
+	app, err := s.svc.SnoozeReminder(ctx, userID, req.ApplicationId, by)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ClearReminder dismisses the application's relance reminder.
+func (s *Server) ClearReminder(ctx context.Context, req *pb.ClearReminderRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.ClearReminder(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// SetReminderRule sets or clears the application's status-relative reminder rule.
+func (s *Server) SetReminderRule(ctx context.Context, req *pb.SetReminderRuleRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := kanban.ReminderRule{
+		Status:    kanban.Status(req.Rule.GetStatus()),
+		AfterDays: int(req.Rule.GetAfterDays()),
+		EveryDays: int(req.Rule.GetEveryDays()),
+	}
+	app, err := s.svc.SetReminderRule(ctx, userID, req.ApplicationId, rule)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// UpdateApplication patches the fields named in the request's update mask.
+func (s *Server) UpdateApplication(ctx context.Context, req *pb.UpdateApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd, err := applicationUpdateFromMask(req.Application, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	app, err := s.svc.UpdateApplication(ctx, userID, req.ApplicationId, upd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// SetPriority sets the LOW/MEDIUM/HIGH priority of an application.
+func (s *Server) SetPriority(ctx context.Context, req *pb.SetPriorityRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.SetPriority(ctx, userID, req.ApplicationId, req.Priority)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// SetNextStep sets or clears the next-step due date of an application.
+func (s *Server) SetNextStep(ctx context.Context, req *pb.SetNextStepRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dueAt *time.Time
+	if req.DueAt != nil {
+		t := req.DueAt.AsTime()
+		dueAt = &t
+	}
+
+	app, err := s.svc.SetNextStep(ctx, userID, req.ApplicationId, dueAt, req.Label)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ArchiveApplication hides an application from the board, keeping its history.
+func (s *Server) ArchiveApplication(ctx context.Context, req *pb.ArchiveApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.ArchiveApplication(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// MergeApplications folds a duplicate application into another one.
+func (s *Server) MergeApplications(ctx context.Context, req *pb.MergeApplicationsRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.MergeApplications(ctx, userID, req.ApplicationId, req.MergedApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// RestoreApplication puts an archived application back on the board.
+func (s *Server) RestoreApplication(ctx context.Context, req *pb.RestoreApplicationRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.RestoreApplication(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ListColumns returns the caller's custom board columns.
+func (s *Server) ListColumns(ctx context.Context, _ *pb.ListColumnsRequest) (*pb.ListColumnsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := s.svc.ListColumns(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.ListColumnsResponse{Columns: make([]*pb.BoardColumn, 0, len(cols))}
+	for i := range cols {
+		resp.Columns = append(resp.Columns, columnToProto(&cols[i]))
+	}
+	return resp, nil
+}
+
+// CreateColumn adds a custom board column.
+func (s *Server) CreateColumn(ctx context.Context, req *pb.CreateColumnRequest) (*pb.BoardColumn, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := s.svc.CreateColumn(ctx, userID, req.Name, req.Status, int(req.Position))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return columnToProto(col), nil
+}
+
+// UpdateColumn renames and/or repositions a custom board column.
+func (s *Server) UpdateColumn(ctx context.Context, req *pb.UpdateColumnRequest) (*pb.BoardColumn, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd := kanban.ColumnUpdate{Name: req.Name}
+	if req.Position != nil {
+		pos := int(*req.Position)
+		upd.Position = &pos
+	}
+
+	col, err := s.svc.UpdateColumn(ctx, userID, req.ColumnId, upd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return columnToProto(col), nil
+}
+
+// DeleteColumn removes a custom board column.
+func (s *Server) DeleteColumn(ctx context.Context, req *pb.DeleteColumnRequest) (*pb.DeleteColumnResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteColumn(ctx, userID, req.ColumnId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteColumnResponse{}, nil
+}
+
+// MoveToColumn places a card in a custom column (or its default lane).
+func (s *Server) MoveToColumn(ctx context.Context, req *pb.MoveToColumnRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.MoveToColumn(ctx, userID, req.ApplicationId, req.ColumnId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// ReanalyzeApplication re-triggers the AI analysis of an application.
+func (s *Server) ReanalyzeApplication(ctx context.Context, req *pb.ReanalyzeApplicationRequest) (*pb.ReanalyzeApplicationResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.ReanalyzeApplication(ctx, userID, req.ApplicationId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.ReanalyzeApplicationResponse{}, nil
+}
+
+// ListCoverLetterVersions returns an application's cover letters, newest first.
+func (s *Server) ListCoverLetterVersions(ctx context.Context, req *pb.ListCoverLetterVersionsRequest) (*pb.ListCoverLetterVersionsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.svc.ListCoverLetterVersions(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.CoverLetterVersion, 0, len(versions))
+	for _, v := range versions {
+		protos = append(protos, &pb.CoverLetterVersion{
+			Id:            v.ID,
+			ApplicationId: v.ApplicationID,
+			Version:       int32(v.Version),
+			Text:          v.Text,
+			CreatedAt:     timestamppb.New(v.CreatedAt),
+		})
+	}
+
+	return &pb.ListCoverLetterVersionsResponse{Versions: protos}, nil
+}
+
+// RegenerateCoverLetter asks the AI Coach for a new cover letter.
+func (s *Server) RegenerateCoverLetter(ctx context.Context, req *pb.RegenerateCoverLetterRequest) (*pb.RegenerateCoverLetterResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.RegenerateCoverLetter(ctx, userID, req.ApplicationId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.RegenerateCoverLetterResponse{}, nil
+}
+
+// RequestFollowUpDraft asks the AI Coach to draft a follow-up message.
+func (s *Server) RequestFollowUpDraft(ctx context.Context, req *pb.RequestFollowUpDraftRequest) (*pb.RequestFollowUpDraftResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.RequestFollowUpDraft(ctx, userID, req.ApplicationId, req.Tone); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.RequestFollowUpDraftResponse{}, nil
+}
+
+// RestoreCoverLetterVersion makes an earlier cover letter current again.
+func (s *Server) RestoreCoverLetterVersion(ctx context.Context, req *pb.RestoreCoverLetterVersionRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.RestoreCoverLetterVersion(ctx, userID, req.VersionId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
+}
+
+// CreateAttachment registers a file and returns its presigned upload URL.
+func (s *Server) CreateAttachment(ctx context.Context, req *pb.CreateAttachmentRequest) (*pb.CreateAttachmentResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	att, upload, err := s.svc.CreateAttachment(ctx, userID, req.ApplicationId, kanban.AttachmentUpload{
+		Kind:        req.Kind,
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		SizeBytes:   req.SizeBytes,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.CreateAttachmentResponse{
+		Attachment: attachmentToProto(att),
+		Upload:     presignedURLToProto(upload),
+	}, nil
+}
+
+// ListAttachments returns an application's attachments, oldest first.
+func (s *Server) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	atts, err := s.svc.ListAttachments(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.Attachment, 0, len(atts))
+	for i := range atts {
+		protos = append(protos, attachmentToProto(&atts[i]))
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: protos}, nil
+}
+
+// GetAttachmentDownloadUrl returns a short-lived download URL.
+func (s *Server) GetAttachmentDownloadUrl(ctx context.Context, req *pb.GetAttachmentDownloadUrlRequest) (*pb.AttachmentUrl, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, download, err := s.svc.AttachmentDownloadURL(ctx, userID, req.AttachmentId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return presignedURLToProto(download), nil
+}
+
+// DeleteAttachment removes an attachment and its stored file.
+func (s *Server) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteAttachment(ctx, userID, req.AttachmentId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteAttachmentResponse{}, nil
+}
+
+// CreateInterview adds an interview round to an application.
+func (s *Server) CreateInterview(ctx context.Context, req *pb.CreateInterviewRequest) (*pb.Interview, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := s.svc.CreateInterview(ctx, userID, req.ApplicationId, interviewFromProto(req.Interview))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return interviewToProto(iv), nil
+}
+
+// SetOfferDetails records the terms of an application's offer.
+func (s *Server) SetOfferDetails(ctx context.Context, req *pb.SetOfferDetailsRequest) (*pb.Offer, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := offerFromProto(req.Offer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	offer, err := s.svc.SetOfferDetails(ctx, userID, req.ApplicationId, o)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return offerToProto(offer), nil
+}
+
+// CompareOffers lays the caller's current offers side by side.
+func (s *Server) CompareOffers(ctx context.Context, _ *pb.CompareOffersRequest) (*pb.CompareOffersResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmp, err := s.svc.CompareOffers(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.CompareOffersResponse{Offers: make([]*pb.OfferComparison, 0, len(cmp))}
+	for i := range cmp {
+		c := &cmp[i]
+		p := &pb.OfferComparison{
+			ApplicationId:     c.ApplicationID,
+			JobTitle:          c.JobTitle,
+			Company:           c.Company,
+			TotalCompensation: c.TotalCompensation,
+			Rank:              int32(c.Rank),
+			RelativeToBest:    c.RelativeToBest,
+			Negotiation:       negotiationEntriesToProto(c.Negotiation),
+		}
+		if c.Offer != nil {
+			p.Offer = offerToProto(c.Offer)
+		}
+		resp.Offers = append(resp.Offers, p)
+	}
+	return resp, nil
+}
+
+// AddNegotiationEntry logs a step of an offer negotiation.
+func (s *Server) AddNegotiationEntry(ctx context.Context, req *pb.AddNegotiationEntryRequest) (*pb.NegotiationEntry, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := s.svc.AddNegotiationEntry(ctx, userID, req.ApplicationId, negotiationEntryFromProto(req.Entry))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return negotiationEntryToProto(e), nil
+}
+
+// ListNegotiationEntries returns an application's negotiation log.
+func (s *Server) ListNegotiationEntries(ctx context.Context, req *pb.ListNegotiationEntriesRequest) (*pb.ListNegotiationEntriesResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.svc.ListNegotiationEntries(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.ListNegotiationEntriesResponse{Entries: negotiationEntriesToProto(entries)}, nil
+}
+
+// DeleteNegotiationEntry removes a negotiation step.
+func (s *Server) DeleteNegotiationEntry(ctx context.Context, req *pb.DeleteNegotiationEntryRequest) (*pb.DeleteNegotiationEntryResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteNegotiationEntry(ctx, userID, req.EntryId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteNegotiationEntryResponse{}, nil
+}
+
+// ListInterviews returns an application's interviews, by round.
+func (s *Server) ListInterviews(ctx context.Context, req *pb.ListInterviewsRequest) (*pb.ListInterviewsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ivs, err := s.svc.ListInterviews(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.ListInterviewsResponse{Interviews: interviewsToProto(ivs)}, nil
+}
+
+// UpdateInterview patches the fields named in the request's update mask.
+func (s *Server) UpdateInterview(ctx context.Context, req *pb.UpdateInterviewRequest) (*pb.Interview, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd, err := interviewUpdateFromMask(req.Interview, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	iv, err := s.svc.UpdateInterview(ctx, userID, req.InterviewId, upd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return interviewToProto(iv), nil
+}
+
+// RecordInterviewFeedback stores the debrief and outcome of an interview.
+func (s *Server) RecordInterviewFeedback(ctx context.Context, req *pb.RecordInterviewFeedbackRequest) (*pb.Interview, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := s.svc.RecordInterviewFeedback(ctx, userID, req.InterviewId, kanban.InterviewFeedback{
+		Outcome:        req.Outcome,
+		WentWell:       req.WentWell,
+		RedFlags:       req.RedFlags,
+		QuestionsAsked: req.QuestionsAsked,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return interviewToProto(iv), nil
+}
+
+// DeleteInterview removes an interview.
+func (s *Server) DeleteInterview(ctx context.Context, req *pb.DeleteInterviewRequest) (*pb.DeleteInterviewResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteInterview(ctx, userID, req.InterviewId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteInterviewResponse{}, nil
+}
+
+// CreateContact adds a contact, optionally linked to an application.
+func (s *Server) CreateContact(ctx context.Context, req *pb.CreateContactRequest) (*pb.Contact, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.svc.CreateContact(ctx, userID, contactFromProto(req.Contact), req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return contactToProto(c), nil
+}
+
+// ListContacts returns the caller's contacts, by name.
+func (s *Server) ListContacts(ctx context.Context, req *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.svc.ListContacts(ctx, userID, kanban.ContactFilter{
+		ApplicationID: req.ApplicationId,
+		Company:       req.Company,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.ListContactsResponse{Contacts: contactsToProto(contacts)}, nil
+}
+
+// UpdateContact patches the fields named in the request's update mask.
+func (s *Server) UpdateContact(ctx context.Context, req *pb.UpdateContactRequest) (*pb.Contact, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd, err := contactUpdateFromMask(req.Contact, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c, err := s.svc.UpdateContact(ctx, userID, req.ContactId, upd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return contactToProto(c), nil
+}
+
+// DeleteContact removes a contact and its application links.
+func (s *Server) DeleteContact(ctx context.Context, req *pb.DeleteContactRequest) (*pb.DeleteContactResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DeleteContact(ctx, userID, req.ContactId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.DeleteContactResponse{}, nil
+}
+
+// LinkContact links a contact to an application.
+func (s *Server) LinkContact(ctx context.Context, req *pb.LinkContactRequest) (*pb.Contact, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.svc.LinkContact(ctx, userID, req.ContactId, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return contactToProto(c), nil
+}
+
+// UnlinkContact removes the link between a contact and an application.
+func (s *Server) UnlinkContact(ctx context.Context, req *pb.UnlinkContactRequest) (*pb.Contact, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.svc.UnlinkContact(ctx, userID, req.ContactId, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return contactToProto(c), nil
+}
+
+// ListCompanies returns the companies the caller applied to.
+func (s *Server) ListCompanies(ctx context.Context, _ *pb.ListCompaniesRequest) (*pb.ListCompaniesResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	companies, err := s.svc.ListCompanies(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	protos := make([]*pb.CompanySummary, 0, len(companies))
+	for i := range companies {
+		protos = append(protos, companySummaryToProto(&companies[i]))
+	}
+
+	return &pb.ListCompaniesResponse{Companies: protos}, nil
+}
+
+// GetCompanyOverview returns the caller's full history with one company.
+func (s *Server) GetCompanyOverview(ctx context.Context, req *pb.GetCompanyOverviewRequest) (*pb.CompanyOverview, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ov, err := s.svc.GetCompanyOverview(ctx, userID, req.Company)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	p := &pb.CompanyOverview{
+		Summary:      companySummaryToProto(&ov.CompanySummary),
+		Applications: make([]*pb.ApplicationProto, 0, len(ov.Applications)),
+		Contacts:     contactsToProto(ov.Contacts),
+		Notes:        make([]*pb.Note, 0, len(ov.Notes)),
+	}
+	for i := range ov.Applications {
+		p.Applications = append(p.Applications, appToProto(&ov.Applications[i]))
+	}
+	for i := range ov.Notes {
+		p.Notes = append(p.Notes, noteToProto(&ov.Notes[i]))
+	}
+
+	return p, nil
+}
+
+// GetRejectionStats counts the caller's rejected applications by reason and stage.
+func (s *Server) GetRejectionStats(ctx context.Context, _ *pb.GetRejectionStatsRequest) (*pb.GetRejectionStatsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.svc.GetRejectionStats(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.GetRejectionStatsResponse{Stats: make([]*pb.RejectionStat, 0, len(stats))}
+	for _, st := range stats {
+		resp.Stats = append(resp.Stats, &pb.RejectionStat{
+			Reason: st.Reason,
+			Stage:  st.Stage,
+			Count:  int32(st.Count),
+		})
+	}
+	return resp, nil
+}
+
+// GetSearchConfigStats returns the funnel of each of the caller's search configs.
+func (s *Server) GetSearchConfigStats(ctx context.Context, _ *pb.GetSearchConfigStatsRequest) (*pb.GetSearchConfigStatsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.svc.GetSearchConfigStats(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.GetSearchConfigStatsResponse{Configs: make([]*pb.SearchConfigStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Configs = append(resp.Configs, &pb.SearchConfigStats{
+			SearchConfigId: st.SearchConfigID,
+			JobTitles:      st.JobTitles,
+			Locations:      st.Locations,
+			IsActive:       st.IsActive,
+			CreatedAt:      timestamppb.New(st.CreatedAt),
+			Discovered:     int32(st.Discovered),
+			Applications:   int32(st.Applications),
+			Applied:        int32(st.Applied),
+			Interviewed:    int32(st.Interviewed),
+			Hired:          int32(st.Hired),
+		})
+	}
+	return resp, nil
+}
+
+// TakeBoardSnapshot stores a snapshot of the caller's board.
+func (s *Server) TakeBoardSnapshot(ctx context.Context, _ *pb.TakeBoardSnapshotRequest) (*pb.BoardSnapshot, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.svc.TakeBoardSnapshot(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.BoardSnapshot{Id: b.ID, TakenAt: timestamppb.New(b.TakenAt), Cards: int32(b.Cards)}, nil
+}
+
+// CompareSnapshots returns what changed on the caller's board between two dates.
+func (s *Server) CompareSnapshots(ctx context.Context, req *pb.CompareSnapshotsRequest) (*pb.CompareSnapshotsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Since == nil {
+		return nil, status.Error(codes.InvalidArgument, "since is required")
+	}
+	var until time.Time
+	if req.Until != nil {
+		until = req.Until.AsTime()
+	}
+
+	d, err := s.svc.CompareSnapshots(ctx, userID, req.Since.AsTime(), until)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.CompareSnapshotsResponse{
+		FromTakenAt: timestamppb.New(d.FromTakenAt),
+		ToTakenAt:   timestamppb.New(d.ToTakenAt),
+		Added:       snapshotCardsToProto(d.Added),
+		Removed:     snapshotCardsToProto(d.Removed),
+		Moved:       make([]*pb.SnapshotMove, 0, len(d.Moved)),
+		Archived:    snapshotCardsToProto(d.Archived),
+		Restored:    snapshotCardsToProto(d.Restored),
+	}
+	for i := range d.Moved {
+		resp.Moved = append(resp.Moved, &pb.SnapshotMove{
+			Card:       snapshotCardToProto(&d.Moved[i].Card),
+			FromStatus: string(d.Moved[i].From),
+		})
+	}
+	return resp, nil
+}
+
+func snapshotCardsToProto(cards []kanban.SnapshotCard) []*pb.SnapshotCard {
+	out := make([]*pb.SnapshotCard, 0, len(cards))
+	for i := range cards {
+		out = append(out, snapshotCardToProto(&cards[i]))
+	}
+	return out
+}
+
+func snapshotCardToProto(c *kanban.SnapshotCard) *pb.SnapshotCard {
+	return &pb.SnapshotCard{
+		ApplicationId: c.ApplicationID,
+		Status:        string(c.Status),
+		Archived:      c.Archived,
+		Company:       c.Company,
+		JobTitle:      c.JobTitle,
+	}
+}
+
+// CountApplicationsByStatus counts the caller's active applications per status.
+func (s *Server) CountApplicationsByStatus(ctx context.Context, _ *pb.CountApplicationsByStatusRequest) (*pb.CountApplicationsByStatusResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.svc.CountApplicationsByStatus(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.CountApplicationsByStatusResponse{Counts: make(map[string]int32, len(counts))}
+	for st, n := range counts {
+		resp.Counts[string(st)] = int32(n)
+		resp.Total += int32(n)
+	}
+	return resp, nil
+}
+
+// GetCalendarFeed returns the caller's iCalendar feed, creating it if needed.
+func (s *Server) GetCalendarFeed(ctx context.Context, _ *pb.GetCalendarFeedRequest) (*pb.CalendarFeed, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.svc.GetCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return calendarFeedToProto(f), nil
+}
+
+// RotateCalendarFeedToken gives the caller's feed a new secret URL.
+func (s *Server) RotateCalendarFeedToken(ctx context.Context, _ *pb.RotateCalendarFeedTokenRequest) (*pb.CalendarFeed, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.svc.RotateCalendarFeedToken(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return calendarFeedToProto(f), nil
+}
+
+// RenderCalendarFeed returns a feed's iCalendar document. The token is the
+// only credential: no x-user-id is expected.
+func (s *Server) RenderCalendarFeed(ctx context.Context, req *pb.RenderCalendarFeedRequest) (*pb.CalendarFeedContent, error) {
+	ics, err := s.svc.RenderCalendarFeed(ctx, req.Token)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.CalendarFeedContent{Ics: ics}, nil
+}
+
+// StartGoogleCalendarAuth returns the Google consent page URL for the caller.
+func (s *Server) StartGoogleCalendarAuth(ctx context.Context, _ *pb.StartGoogleCalendarAuthRequest) (*pb.GoogleCalendarAuthUrl, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := s.svc.StartGoogleCalendarAuth(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.GoogleCalendarAuthUrl{Url: authURL}, nil
+}
+
+// CompleteGoogleCalendarAuth finishes the OAuth flow. Called by the Gateway's
+// OAuth callback: the state is the only credential, no x-user-id is expected.
+func (s *Server) CompleteGoogleCalendarAuth(ctx context.Context, req *pb.CompleteGoogleCalendarAuthRequest) (*pb.GoogleCalendarStatus, error) {
+	st, err := s.svc.CompleteGoogleCalendarAuth(ctx, req.State, req.Code)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return googleCalendarStatusToProto(st), nil
+}
+
+// GetGoogleCalendarStatus reports the caller's Google Calendar connection.
+func (s *Server) GetGoogleCalendarStatus(ctx context.Context, _ *pb.GetGoogleCalendarStatusRequest) (*pb.GoogleCalendarStatus, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.svc.GetGoogleCalendarStatus(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return googleCalendarStatusToProto(st), nil
+}
+
+// DisconnectGoogleCalendar revokes the caller's Google grant.
+func (s *Server) DisconnectGoogleCalendar(ctx context.Context, _ *pb.DisconnectGoogleCalendarRequest) (*pb.GoogleCalendarStatus, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.DisconnectGoogleCalendar(ctx, userID); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.GoogleCalendarStatus{}, nil
+}
+
+// GetBenchmark compares the caller's funnel with the anonymous median.
+func (s *Server) GetBenchmark(ctx context.Context, req *pb.GetBenchmarkRequest) (*pb.Benchmark, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.svc.GetBenchmark(ctx, userID, req.JobTitle)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.Benchmark{
+		JobTitle:            b.JobTitle,
+		UserCount:           int32(b.UserCount),
+		MedianApplications:  b.MedianApplications,
+		MedianInterviewRate: b.MedianInterviewRate,
+		ComputedAt:          timestamppb.New(b.ComputedAt),
+		Applications:        int32(b.Applications),
+		Interviews:          int32(b.Interviews),
+		InterviewRate:       b.InterviewRate,
+	}, nil
+}
+
+// GetSettings returns the caller's tracker preferences.
+func (s *Server) GetSettings(ctx context.Context, _ *pb.GetSettingsRequest) (*pb.TrackerSettings, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.svc.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return settingsToProto(st), nil
+}
+
+// UpdateSettings partially updates the caller's tracker preferences.
+func (s *Server) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.TrackerSettings, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd := kanban.SettingsUpdate{GhostingEnabled: req.GhostingEnabled, ShareBenchmarks: req.ShareBenchmarks}
+	if req.GhostAfterDays != nil {
+		days := int(*req.GhostAfterDays)
+		upd.GhostAfterDays = &days
+	}
+	if req.ExtraTransitions != nil {
+		edges := make([]kanban.Transition, 0, len(req.ExtraTransitions.Items))
+		for _, t := range req.ExtraTransitions.Items {
+			edges = append(edges, kanban.Transition{From: kanban.Status(t.From), To: kanban.Status(t.To)})
+		}
+		upd.ExtraTransitions = &edges
+	}
+	if req.ReminderChannels != nil {
+		channels := append([]string{}, req.ReminderChannels.Items...)
+		upd.ReminderChannels = &channels
+	}
+	upd.Timezone = req.Timezone
+	upd.ArchiveSearchOnHired = req.ArchiveSearchOnHired
+	if req.ClosedRetention != nil {
+		mode := kanban.RetentionMode(*req.ClosedRetention)
+		upd.ClosedRetention = &mode
+	}
+
+	st, err := s.svc.UpdateSettings(ctx, userID, upd)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return settingsToProto(st), nil
+}
+
+// SetSearchConfigArchival sets whether HIRED deactivates one of the caller's searches.
+func (s *Server) SetSearchConfigArchival(ctx context.Context, req *pb.SetSearchConfigArchivalRequest) (*pb.SearchConfigArchival, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.SetSearchConfigArchival(ctx, userID, req.SearchConfigId, req.ArchiveOnHired); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.SearchConfigArchival{SearchConfigId: req.SearchConfigId, ArchiveOnHired: req.ArchiveOnHired}, nil
 }
 
-// NewServer constructs a gRPC Server backed by the given kanban.Service.
-func NewServer(svc *kanban.Service) *Server {
-	return &Server{svc: svc}
+// ReactivateSearchConfig reactivates the search a card came from.
+func (s *Server) ReactivateSearchConfig(ctx context.Context, req *pb.ReactivateSearchConfigRequest) (*pb.ApplicationProto, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.svc.ReactivateSearchConfig(ctx, userID, req.ApplicationId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return appToProto(app), nil
 }
 
-// ─── RPC implementations ──────────────────────────────────────────────────────
+// ReopenSearch reactivates one of the caller's searches and queues a scan of it.
+func (s *Server) ReopenSearch(ctx context.Context, req *pb.ReopenSearchRequest) (*pb.ReopenSearchResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-// ListApplications returns all applications belonging to the caller.
-func (s *Server) ListApplications(ctx context.Context, req *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
+	if err := s.svc.ReopenSearch(ctx, userID, req.SearchConfigId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.ReopenSearchResponse{}, nil
+}
+
+// ExportUserData returns everything the tracker stores about the caller.
+func (s *Server) ExportUserData(ctx context.Context, _ *pb.ExportUserDataRequest) (*pb.UserDataExport, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.svc.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.UserDataExport{Data: data}, nil
+}
+
+// EraseUserData deletes or anonymizes all of the caller's tracker data.
+func (s *Server) EraseUserData(ctx context.Context, req *pb.EraseUserDataRequest) (*pb.EraseUserDataResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := kanban.ParseErasureMode(req.Mode)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	rows, err := s.svc.EraseUserData(ctx, userID, mode)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.EraseUserDataResponse{Rows: rows}, nil
+}
+
+// CreateBoardShare grants read-only access to the caller's board.
+func (s *Server) CreateBoardShare(ctx context.Context, req *pb.CreateBoardShareRequest) (*pb.BoardShare, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.svc.CreateBoardShare(ctx, userID, kanban.BoardShareInput{
+		GranteeID: req.GranteeUserId,
+		Statuses:  req.Statuses,
+		ExpiresAt: req.ExpiresAt.AsTime(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return boardShareToProto(share), nil
+}
+
+// ListBoardShares returns the caller's live shares, or those granted to them.
+func (s *Server) ListBoardShares(ctx context.Context, req *pb.ListBoardSharesRequest) (*pb.ListBoardSharesResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.svc.ListBoardShares(ctx, userID, req.Received)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &pb.ListBoardSharesResponse{Shares: make([]*pb.BoardShare, 0, len(shares))}
+	for i := range shares {
+		resp.Shares = append(resp.Shares, boardShareToProto(&shares[i]))
+	}
+	return resp, nil
+}
+
+// RevokeBoardShare ends one of the caller's shares.
+func (s *Server) RevokeBoardShare(ctx context.Context, req *pb.RevokeBoardShareRequest) (*pb.RevokeBoardShareResponse, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	apps, err := s.svc.ListApplications(ctx, userID, req.StatusFilter)
+	if err := s.svc.RevokeBoardShare(ctx, userID, req.ShareId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &pb.RevokeBoardShareResponse{}, nil
+}
+
+// ListSharedApplications lists the applications of a board shared with the caller.
+func (s *Server) ListSharedApplications(ctx context.Context, req *pb.ListSharedApplicationsRequest) (*pb.ListApplicationsResponse, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := s.svc.ListSharedApplications(ctx, userID, req.ShareId, req.ShareToken, kanban.ListFilter{
+		Status:   req.StatusFilter,
+		Priority: req.PriorityFilter,
+		Sort:     req.SortBy,
+		View:     req.View,
+	})
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -52,14 +1731,14 @@ func (s *Server) ListApplications(ctx context.Context, req *pb.ListApplicationsR
 	return &pb.ListApplicationsResponse{Applications: protos}, nil
 }
 
-// MoveCard transitions an application to a new Kanban status.
-func (s *Server) MoveCard(ctx context.Context, req *pb.MoveCardRequest) (*pb.ApplicationProto, error) {
+// GetSharedApplication returns one application of a board shared with the caller.
+func (s *Server) GetSharedApplication(ctx context.Context, req *pb.GetSharedApplicationRequest) (*pb.ApplicationProto, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.MoveCard(ctx, userID, req.ApplicationId, req.NewStatus)
+	app, err := s.svc.GetSharedApplication(ctx, userID, req.ShareId, req.ShareToken, req.ApplicationId)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -67,79 +1746,85 @@ func (s *Server) MoveCard(ctx context.Context, req *pb.MoveCardRequest) (*pb.App
 	return appToProto(app), nil
 }
 
-// AddNote updates the free-text note on an application.
-func (s *Server) AddNote(ctx context.Context, req *pb.AddNoteRequest) (*pb.ApplicationProto, error) {
+// CreateWebhook registers a webhook for the caller's events.
+func (s *Server) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.AddNote(ctx, userID, req.ApplicationId, req.Note)
+	hook, err := s.svc.CreateWebhook(ctx, userID, req.Url, req.Events)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	return appToProto(app), nil
+	return webhookToProto(hook), nil
 }
 
-// RateApplication sets a numeric rating (1-5) on an application.
-func (s *Server) RateApplication(ctx context.Context, req *pb.RateApplicationRequest) (*pb.ApplicationProto, error) {
+// ListWebhooks returns the caller's webhooks.
+func (s *Server) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.RateApplication(ctx, userID, req.ApplicationId, req.Rating)
+	hooks, err := s.svc.ListWebhooks(ctx, userID)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	return appToProto(app), nil
+	resp := &pb.ListWebhooksResponse{Webhooks: make([]*pb.Webhook, 0, len(hooks))}
+	for i := range hooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(&hooks[i]))
+	}
+	return resp, nil
 }
 
-// GetApplication returns a single application by ID.
-func (s *Server) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.ApplicationProto, error) {
+// DeleteWebhook removes one of the caller's webhooks.
+func (s *Server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.GetApplication(ctx, userID, req.ApplicationId)
-	if err != nil {
+	if err := s.svc.DeleteWebhook(ctx, userID, req.WebhookId); err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	return appToProto(app), nil
+	return &pb.DeleteWebhookResponse{}, nil
 }
 
-// CreateApplication creates a new application for the given job feed entry.
-func (s *Server) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest) (*pb.ApplicationProto, error) {
+// ListWebhookDeliveries returns the latest deliveries of one of the caller's webhooks.
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.CreateApplication(ctx, userID, req.JobFeedId)
+	deliveries, err := s.svc.ListWebhookDeliveries(ctx, userID, req.WebhookId, req.Status)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	return appToProto(app), nil
+	resp := &pb.ListWebhookDeliveriesResponse{Deliveries: make([]*pb.WebhookDelivery, 0, len(deliveries))}
+	for i := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToProto(&deliveries[i]))
+	}
+	return resp, nil
 }
 
-// SetRelanceReminder sets the follow-up reminder timestamp on an application.
-func (s *Server) SetRelanceReminder(ctx context.Context, req *pb.SetRelanceReminderRequest) (*pb.ApplicationProto, error) {
+// RetryWebhookDelivery queues a dead delivery again.
+func (s *Server) RetryWebhookDelivery(ctx context.Context, req *pb.RetryWebhookDeliveryRequest) (*pb.RetryWebhookDeliveryResponse, error) {
 	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := s.svc.SetRelanceReminder(ctx, userID, req.ApplicationId, req.RemindAt)
-	if err != nil {
+	if err := s.svc.RetryWebhookDelivery(ctx, userID, req.DeliveryId); err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	return appToProto(app), nil
+	return &pb.RetryWebhookDeliveryResponse{}, nil
 }
 
 // ─── Helpers ─────────────────────────────────────────────────────────────────
@@ -158,29 +1843,579 @@ func userIDFromCtx(ctx context.Context) (string, error) {
 	return vals[0], nil
 }
 
-// toGRPCError maps domain errors to gRPC status errors.
-func toGRPCError(err error) error {
-	if errors.Is(err, kanban.ErrNotFound) {
-		return status.Error(codes.NotFound, err.Error())
+// applicationUpdateFromMask reads the masked fields of p into a
+// kanban.ApplicationUpdate. Unknown or read-only paths are rejected.
+func applicationUpdateFromMask(p *pb.ApplicationProto, paths []string) (kanban.ApplicationUpdate, error) {
+	var upd kanban.ApplicationUpdate
+	if len(paths) == 0 {
+		return upd, errors.New("update_mask must list at least one field")
+	}
+	if p == nil {
+		p = &pb.ApplicationProto{}
+	}
+	for _, path := range paths {
+		switch path {
+		case "user_rating":
+			upd.UserRating = &p.UserRating
+		case "priority":
+			upd.Priority = &p.Priority
+		case "relance_reminder_at":
+			var t time.Time
+			if p.RelanceReminderAt != nil {
+				t = p.RelanceReminderAt.AsTime()
+			}
+			upd.RelanceReminderAt = &t
+		case "next_step_due_at":
+			var t time.Time
+			if p.NextStepDueAt != nil {
+				t = p.NextStepDueAt.AsTime()
+			}
+			upd.NextStepDueAt = &t
+		case "next_step_label":
+			upd.NextStepLabel = &p.NextStepLabel
+		default:
+			return upd, fmt.Errorf("update_mask: unsupported field %q", path)
+		}
+	}
+	return upd, nil
+}
+
+// interviewUpdateFromMask reads the masked fields of p into a
+// kanban.InterviewUpdate. Unknown or read-only paths are rejected.
+func interviewUpdateFromMask(p *pb.Interview, paths []string) (kanban.InterviewUpdate, error) {
+	var upd kanban.InterviewUpdate
+	if len(paths) == 0 {
+		return upd, errors.New("update_mask must list at least one field")
+	}
+	if p == nil {
+		p = &pb.Interview{}
+	}
+	for _, path := range paths {
+		switch path {
+		case "round":
+			upd.Round = &p.Round
+		case "type":
+			upd.Type = &p.Type
+		case "scheduled_at":
+			var t time.Time
+			if p.ScheduledAt != nil {
+				t = p.ScheduledAt.AsTime()
+			}
+			upd.ScheduledAt = &t
+		case "interviewer":
+			upd.Interviewer = &p.Interviewer
+		case "outcome":
+			upd.Outcome = &p.Outcome
+		case "notes":
+			upd.Notes = &p.Notes
+		default:
+			return upd, fmt.Errorf("update_mask: unsupported field %q", path)
+		}
+	}
+	return upd, nil
+}
+
+// interviewFromProto reads the user-supplied fields of an Interview message.
+func interviewFromProto(p *pb.Interview) kanban.Interview {
+	if p == nil {
+		return kanban.Interview{}
+	}
+	iv := kanban.Interview{
+		Round:       p.Round,
+		Type:        p.Type,
+		Interviewer: p.Interviewer,
+		Outcome:     p.Outcome,
+		Notes:       p.Notes,
+	}
+	if p.ScheduledAt != nil {
+		t := p.ScheduledAt.AsTime()
+		iv.ScheduledAt = &t
+	}
+	return iv
+}
+
+func boardShareToProto(b *kanban.BoardShare) *pb.BoardShare {
+	p := &pb.BoardShare{
+		Id:            b.ID,
+		OwnerId:       b.OwnerID,
+		GranteeUserId: b.GranteeID,
+		Token:         b.Token,
+		Statuses:      make([]string, len(b.Statuses)),
+		ExpiresAt:     timestamppb.New(b.ExpiresAt),
+		CreatedAt:     timestamppb.New(b.CreatedAt),
+	}
+	for i, st := range b.Statuses {
+		p.Statuses[i] = string(st)
+	}
+	return p
+}
+
+func webhookToProto(w *kanban.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Secret:    w.Secret,
+		Events:    w.Events,
+		Active:    w.Active,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+	}
+}
+
+func webhookDeliveryToProto(d *kanban.WebhookDelivery) *pb.WebhookDelivery {
+	p := &pb.WebhookDelivery{
+		Id:             d.ID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == kanban.DeliveryPending {
+		p.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		p.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return p
+}
+
+func calendarFeedToProto(f *kanban.CalendarFeed) *pb.CalendarFeed {
+	return &pb.CalendarFeed{
+		Token:     f.Token,
+		Path:      f.Path(),
+		CreatedAt: timestamppb.New(f.CreatedAt),
+	}
+}
+
+func googleCalendarStatusToProto(st *kanban.GoogleCalendarStatus) *pb.GoogleCalendarStatus {
+	p := &pb.GoogleCalendarStatus{
+		Connected:      st.Connected,
+		NeedsReconnect: st.NeedsReconnect,
+		CalendarId:     st.CalendarID,
+		LastError:      st.LastError,
+	}
+	if st.ConnectedAt != nil {
+		p.ConnectedAt = timestamppb.New(*st.ConnectedAt)
+	}
+	if st.LastSyncedAt != nil {
+		p.LastSyncedAt = timestamppb.New(*st.LastSyncedAt)
+	}
+	return p
+}
+
+// offerFromProto reads the user-supplied fields of an offer.
+func offerFromProto(p *pb.Offer) (kanban.Offer, error) {
+	if p == nil {
+		return kanban.Offer{}, nil
+	}
+	o := kanban.Offer{
+		Currency:      p.Currency,
+		BaseSalary:    p.BaseSalary,
+		Bonus:         p.Bonus,
+		EquityValue:   p.EquityValue,
+		EquityDetails: p.EquityDetails,
+	}
+	if p.StartDate != "" {
+		d, err := time.Parse(time.DateOnly, p.StartDate)
+		if err != nil {
+			return o, errors.New("start_date must be YYYY-MM-DD")
+		}
+		o.StartDate = &d
+	}
+	if p.ResponseDeadline != nil {
+		t := p.ResponseDeadline.AsTime()
+		o.ResponseDeadline = &t
+	}
+	return o, nil
+}
+
+// offerToProto converts a kanban.Offer to its proto representation.
+func offerToProto(o *kanban.Offer) *pb.Offer {
+	p := &pb.Offer{
+		ApplicationId: o.ApplicationID,
+		Currency:      o.Currency,
+		BaseSalary:    o.BaseSalary,
+		Bonus:         o.Bonus,
+		EquityValue:   o.EquityValue,
+		EquityDetails: o.EquityDetails,
+		UpdatedAt:     timestamppb.New(o.UpdatedAt),
+	}
+	if o.StartDate != nil {
+		p.StartDate = o.StartDate.Format(time.DateOnly)
+	}
+	if o.ResponseDeadline != nil {
+		p.ResponseDeadline = timestamppb.New(*o.ResponseDeadline)
+	}
+	return p
+}
+
+// negotiationEntryFromProto reads the user-supplied fields of a negotiation step.
+func negotiationEntryFromProto(p *pb.NegotiationEntry) kanban.NegotiationEntry {
+	if p == nil {
+		return kanban.NegotiationEntry{}
+	}
+	e := kanban.NegotiationEntry{
+		Kind:        p.Kind,
+		BaseSalary:  p.BaseSalary,
+		Bonus:       p.Bonus,
+		EquityValue: p.EquityValue,
+		Note:        p.Note,
+	}
+	if p.OccurredAt != nil {
+		e.OccurredAt = p.OccurredAt.AsTime()
+	}
+	return e
+}
+
+// negotiationEntryToProto converts a kanban.NegotiationEntry to its proto representation.
+func negotiationEntryToProto(e *kanban.NegotiationEntry) *pb.NegotiationEntry {
+	return &pb.NegotiationEntry{
+		Id:            e.ID,
+		ApplicationId: e.ApplicationID,
+		Kind:          e.Kind,
+		BaseSalary:    e.BaseSalary,
+		Bonus:         e.Bonus,
+		EquityValue:   e.EquityValue,
+		Note:          e.Note,
+		OccurredAt:    timestamppb.New(e.OccurredAt),
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+}
+
+func negotiationEntriesToProto(entries []kanban.NegotiationEntry) []*pb.NegotiationEntry {
+	protos := make([]*pb.NegotiationEntry, 0, len(entries))
+	for i := range entries {
+		protos = append(protos, negotiationEntryToProto(&entries[i]))
+	}
+	return protos
+}
+
+// interviewToProto converts a kanban.Interview to its proto representation.
+func interviewToProto(iv *kanban.Interview) *pb.Interview {
+	p := &pb.Interview{
+		Id:            iv.ID,
+		ApplicationId: iv.ApplicationID,
+		Round:         iv.Round,
+		Type:          iv.Type,
+		Interviewer:   iv.Interviewer,
+		Outcome:       iv.Outcome,
+		Notes:         iv.Notes,
+		CreatedAt:     timestamppb.New(iv.CreatedAt),
+		UpdatedAt:     timestamppb.New(iv.UpdatedAt),
+	}
+	if iv.ScheduledAt != nil {
+		p.ScheduledAt = timestamppb.New(*iv.ScheduledAt)
+	}
+	if fb := iv.Feedback; fb != nil {
+		p.Feedback = &pb.InterviewFeedback{
+			WentWell:       fb.WentWell,
+			RedFlags:       fb.RedFlags,
+			QuestionsAsked: fb.QuestionsAsked,
+			RecordedAt:     timestamppb.New(fb.RecordedAt),
+		}
+	}
+	return p
+}
+
+func interviewsToProto(ivs []kanban.Interview) []*pb.Interview {
+	protos := make([]*pb.Interview, 0, len(ivs))
+	for i := range ivs {
+		protos = append(protos, interviewToProto(&ivs[i]))
+	}
+	return protos
+}
+
+// contactUpdateFromMask reads the masked fields of p into a
+// kanban.ContactUpdate. Unknown or read-only paths are rejected.
+func contactUpdateFromMask(p *pb.Contact, paths []string) (kanban.ContactUpdate, error) {
+	var upd kanban.ContactUpdate
+	if len(paths) == 0 {
+		return upd, errors.New("update_mask must list at least one field")
+	}
+	if p == nil {
+		p = &pb.Contact{}
+	}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			upd.Name = &p.Name
+		case "role":
+			upd.Role = &p.Role
+		case "email":
+			upd.Email = &p.Email
+		case "linkedin_url":
+			upd.LinkedInURL = &p.LinkedinUrl
+		case "company":
+			upd.Company = &p.Company
+		case "last_contacted_at":
+			var t time.Time
+			if p.LastContactedAt != nil {
+				t = p.LastContactedAt.AsTime()
+			}
+			upd.LastContactedAt = &t
+		default:
+			return upd, fmt.Errorf("update_mask: unsupported field %q", path)
+		}
+	}
+	return upd, nil
+}
+
+// contactFromProto reads the user-supplied fields of a Contact message.
+func contactFromProto(p *pb.Contact) kanban.Contact {
+	if p == nil {
+		return kanban.Contact{}
+	}
+	c := kanban.Contact{
+		Name:        p.Name,
+		Role:        p.Role,
+		Email:       p.Email,
+		LinkedInURL: p.LinkedinUrl,
+		Company:     p.Company,
+	}
+	if p.LastContactedAt != nil {
+		t := p.LastContactedAt.AsTime()
+		c.LastContactedAt = &t
+	}
+	return c
+}
+
+// contactToProto converts a kanban.Contact to its proto representation.
+func contactToProto(c *kanban.Contact) *pb.Contact {
+	p := &pb.Contact{
+		Id:             c.ID,
+		Name:           c.Name,
+		Role:           c.Role,
+		Email:          c.Email,
+		LinkedinUrl:    c.LinkedInURL,
+		Company:        c.Company,
+		ApplicationIds: c.ApplicationIDs,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+	if c.LastContactedAt != nil {
+		p.LastContactedAt = timestamppb.New(*c.LastContactedAt)
 	}
-	var ve *kanban.ValidationError
-	if errors.As(err, &ve) {
-		return status.Error(codes.InvalidArgument, ve.Msg)
+	return p
+}
+
+func contactsToProto(contacts []kanban.Contact) []*pb.Contact {
+	protos := make([]*pb.Contact, 0, len(contacts))
+	for i := range contacts {
+		protos = append(protos, contactToProto(&contacts[i]))
+	}
+	return protos
+}
+
+// companySummaryToProto converts a kanban.CompanySummary to its proto representation.
+func companySummaryToProto(cs *kanban.CompanySummary) *pb.CompanySummary {
+	return &pb.CompanySummary{
+		Name:             cs.Name,
+		ApplicationCount: int32(cs.ApplicationCount),
+		ActiveCount:      int32(cs.ActiveCount),
+		RejectedCount:    int32(cs.RejectedCount),
+		StatusCounts:     cs.StatusCounts,
+		ContactCount:     int32(cs.ContactCount),
+		LastActivityAt:   timestamppb.New(cs.LastActivityAt),
+	}
+}
+
+// noteToProto converts a kanban.Note to its proto representation.
+func noteToProto(n *kanban.Note) *pb.Note {
+	p := &pb.Note{
+		Id:            n.ID,
+		ApplicationId: n.ApplicationID,
+		Text:          n.Text,
+		CreatedAt:     timestamppb.New(n.CreatedAt),
+	}
+	if n.EditedAt != nil {
+		p.EditedAt = timestamppb.New(*n.EditedAt)
+	}
+	return p
+}
+
+// attachmentToProto converts a kanban.Attachment to its proto representation.
+func attachmentToProto(a *kanban.Attachment) *pb.Attachment {
+	p := &pb.Attachment{
+		Id:            a.ID,
+		ApplicationId: a.ApplicationID,
+		Kind:          a.Kind,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		SizeBytes:     a.SizeBytes,
+		CreatedAt:     timestamppb.New(a.CreatedAt),
+		ScanStatus:    a.ScanStatus,
+		ScanSignature: a.ScanSignature,
+	}
+	if a.ScannedAt != nil {
+		p.ScannedAt = timestamppb.New(*a.ScannedAt)
+	}
+	return p
+}
+
+func presignedURLToProto(u *kanban.PresignedURL) *pb.AttachmentUrl {
+	return &pb.AttachmentUrl{Url: u.URL, ExpiresAt: timestamppb.New(u.ExpiresAt)}
+}
+
+// columnToProto converts a kanban.Column to its proto representation.
+func newCardJobFromProto(j *pb.NewCardJob) kanban.NewCardJob {
+	return kanban.NewCardJob{
+		JobFeedID: j.GetJobFeedId(),
+		Manual: kanban.ManualJob{
+			Title:       j.GetTitle(),
+			Company:     j.GetCompany(),
+			URL:         j.GetUrl(),
+			Location:    j.GetLocation(),
+			Description: j.GetDescription(),
+		},
+	}
+}
+
+func cardSetupFromProto(t *pb.ApplicationTemplate) kanban.CardSetup {
+	return kanban.CardSetup{
+		Priority:      kanban.Priority(t.GetPriority()),
+		NextStepLabel: t.GetNextStepLabel(),
+		ReminderRule: kanban.ReminderRule{
+			Status:    kanban.Status(t.GetReminderRule().GetStatus()),
+			AfterDays: int(t.GetReminderRule().GetAfterDays()),
+			EveryDays: int(t.GetReminderRule().GetEveryDays()),
+		},
+		Notes: t.GetNotes(),
+	}
+}
+
+func templateToProto(t *kanban.ApplicationTemplate) *pb.ApplicationTemplate {
+	p := &pb.ApplicationTemplate{
+		Id:            t.ID,
+		Name:          t.Name,
+		Priority:      string(t.Priority),
+		NextStepLabel: t.NextStepLabel,
+		Notes:         t.Notes,
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		UpdatedAt:     timestamppb.New(t.UpdatedAt),
+	}
+	if !t.ReminderRule.IsZero() {
+		p.ReminderRule = &pb.ReminderRule{
+			Status:    string(t.ReminderRule.Status),
+			AfterDays: int32(t.ReminderRule.AfterDays),
+			EveryDays: int32(t.ReminderRule.EveryDays),
+		}
+	}
+	return p
+}
+
+func columnToProto(c *kanban.Column) *pb.BoardColumn {
+	return &pb.BoardColumn{
+		Id:        c.ID,
+		Name:      c.Name,
+		Status:    string(c.Status),
+		Position:  int32(c.Position),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
 	}
-	return status.Error(codes.Internal, "internal server error")
+}
+
+// settingsToProto converts kanban.Settings to its proto representation.
+func settingsToProto(st *kanban.Settings) *pb.TrackerSettings {
+	p := &pb.TrackerSettings{
+		GhostingEnabled:  st.GhostingEnabled,
+		GhostAfterDays:   int32(st.GhostAfterDays),
+		ExtraTransitions: make([]*pb.Transition, 0, len(st.ExtraTransitions)),
+		ShareBenchmarks:  st.ShareBenchmarks,
+		ReminderChannels: st.ReminderChannels,
+		Timezone:         st.Timezone,
+
+		ArchiveSearchOnHired: st.ArchiveSearchOnHired,
+		ClosedRetention:      string(st.ClosedRetention),
+	}
+	for _, t := range st.ExtraTransitions {
+		p.ExtraTransitions = append(p.ExtraTransitions, &pb.Transition{From: string(t.From), To: string(t.To)})
+	}
+	return p
+}
+
+func historyEntryToProto(e kanban.HistoryEntry) *pb.HistoryEntry {
+	p := &pb.HistoryEntry{
+		Kind:            e.Kind,
+		At:              timestamppb.New(e.At),
+		Actor:           e.Actor,
+		FromStatus:      e.From,
+		ToStatus:        e.To,
+		Undo:            e.Undo,
+		Reason:          e.Reason,
+		RejectionReason: e.RejectionReason,
+		RejectionStage:  e.RejectionStage,
+		InterviewId:     e.InterviewID,
+		Round:           e.Round,
+		Outcome:         e.Outcome,
+		MergedFrom:      e.MergedFrom,
+		SearchConfigId:  e.SearchConfigID,
+		Action:          e.Action,
+	}
+	if e.RemindAt != nil {
+		p.RemindAt = timestamppb.New(*e.RemindAt)
+	}
+	return p
+}
+
+func timelineEntryToProto(e *kanban.TimelineEntry) *pb.TimelineEntry {
+	p := &pb.TimelineEntry{
+		Kind:  e.Kind,
+		At:    timestamppb.New(e.At),
+		Actor: e.Actor,
+	}
+	switch {
+	case e.History != nil:
+		p.History = historyEntryToProto(*e.History)
+	case e.Note != nil:
+		p.Note = noteToProto(e.Note)
+	case e.Interview != nil:
+		p.Interview = interviewToProto(e.Interview)
+	}
+	return p
+}
+
+func searchHitToProto(h *kanban.SearchHit) *pb.SearchHit {
+	p := &pb.SearchHit{
+		Application: appToProto(&h.Application),
+		Rank:        h.Rank,
+		Highlights:  make([]*pb.SearchHighlight, 0, len(h.Highlights)),
+	}
+	for _, hl := range h.Highlights {
+		matches := make([]*pb.TextRange, 0, len(hl.Matches))
+		for _, m := range hl.Matches {
+			matches = append(matches, &pb.TextRange{Start: int32(m.Start), Length: int32(m.Length)})
+		}
+		p.Highlights = append(p.Highlights, &pb.SearchHighlight{
+			Field:   hl.Field,
+			NoteId:  hl.NoteID,
+			Text:    hl.Text,
+			Matches: matches,
+		})
+	}
+	return p
 }
 
 // appToProto converts a kanban.Application to its proto representation.
 func appToProto(a *kanban.Application) *pb.ApplicationProto {
 	p := &pb.ApplicationProto{
-		Id:             a.ID,
-		CurrentStatus:  a.CurrentStatus,
-		AiAnalysis:     []byte(a.AIAnalysis),
-		HistoryLog:     []byte(a.HistoryLog),
-		JobFeedId:      a.JobFeedID,
-		SearchConfigId: a.SearchConfigID,
-		CreatedAt:      timestamppb.New(a.CreatedAt),
-		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+		Id:              a.ID,
+		CurrentStatus:   a.CurrentStatus,
+		AiAnalysis:      []byte(a.AIAnalysis),
+		HistoryLog:      []byte(a.HistoryLog),
+		JobFeedId:       a.JobFeedID,
+		SearchConfigId:  a.SearchConfigID,
+		OnHoldFrom:      a.HoldOrigin,
+		ColumnId:        a.ColumnID,
+		Priority:        a.Priority,
+		RejectionReason: a.RejectionReason,
+		RejectionStage:  a.RejectionStage,
+		JobTitle:        a.JobTitle,
+		Company:         a.Company,
+		Location:        a.Location,
+		SourceUrl:       a.SourceURL,
+		CreatedAt:       timestamppb.New(a.CreatedAt),
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
 	}
 
 	if a.GeneratedCoverLetter != nil {
@@ -192,8 +2427,50 @@ func appToProto(a *kanban.Application) *pb.ApplicationProto {
 	if a.UserRating != nil {
 		p.UserRating = *a.UserRating
 	}
+	if c := a.RatingCriteria; c != nil {
+		p.RatingCriteria = &pb.RatingCriteria{
+			RoleFit:      c.RoleFit,
+			Compensation: c.Compensation,
+			Culture:      c.Culture,
+			Commute:      c.Commute,
+		}
+	}
+	if a.RatingReview != nil {
+		p.RatingReview = *a.RatingReview
+	}
 	if a.RelanceReminderAt != nil {
-		p.RelanceReminderAt = a.RelanceReminderAt.UTC().Format("2006-01-02T15:04:05Z")
+		p.RelanceReminderAt = timestamppb.New(*a.RelanceReminderAt)
+	}
+	if a.ArchivedAt != nil {
+		p.ArchivedAt = timestamppb.New(*a.ArchivedAt)
+	}
+	if a.GhostedAt != nil {
+		p.GhostedAt = timestamppb.New(*a.GhostedAt)
+	}
+	if a.NextStepDueAt != nil {
+		p.NextStepDueAt = timestamppb.New(*a.NextStepDueAt)
+	}
+	if a.NextStepLabel != nil {
+		p.NextStepLabel = *a.NextStepLabel
+	}
+	if a.FollowUpDraft != nil {
+		p.FollowupDraft = *a.FollowUpDraft
+	}
+	if a.FollowUpDraftAt != nil {
+		p.FollowupDraftAt = timestamppb.New(*a.FollowUpDraftAt)
+	}
+	if a.Interviews != nil {
+		p.Interviews = interviewsToProto(a.Interviews)
+	}
+	if a.Offer != nil {
+		p.Offer = offerToProto(a.Offer)
+	}
+	if !a.ReminderRule.IsZero() {
+		p.ReminderRule = &pb.ReminderRule{
+			Status:    string(a.ReminderRule.Status),
+			AfterDays: int32(a.ReminderRule.AfterDays),
+			EveryDays: int32(a.ReminderRule.EveryDays),
+		}
 	}
 
 	return p
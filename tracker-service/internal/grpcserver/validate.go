@@ -0,0 +1,95 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"jobmate/tracker-service/internal/kanban"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// uuidExempt are the *_id request fields that do not hold UUIDs.
+var uuidExempt = map[protoreflect.Name]bool{
+	"resource_id": true, // audit log filter: any service's resource key
+}
+
+// UUIDValidationInterceptor refuses requests with a malformed ID — a
+// non-empty string *_id or *_ids field, nested ones included, that is not a
+// UUID — with InvalidArgument naming the field, rather than letting it reach
+// the database and come back as NotFound or an SQL error. Empty IDs are left
+// to the handlers.
+func UUIDValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if m, ok := req.(proto.Message); ok {
+			if field := malformedID(m.ProtoReflect(), ""); field != "" {
+				return nil, toGRPCError(&kanban.ValidationError{Field: field, Msg: field + " must be a UUID"})
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// malformedID returns the path of a malformed ID field of m ("" if none).
+func malformedID(m protoreflect.Message, prefix string) string {
+	var bad string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.MessageKind:
+			if !fd.IsList() {
+				bad = malformedID(v.Message(), name+".")
+				break
+			}
+			for i, list := 0, v.List(); i < list.Len() && bad == ""; i++ {
+				bad = malformedID(list.Get(i).Message(), fmt.Sprintf("%s[%d].", name, i))
+			}
+		case fd.Kind() == protoreflect.StringKind && isIDField(fd.Name()):
+			if !fd.IsList() {
+				if s := v.String(); s != "" && !validUUID(s) {
+					bad = name
+				}
+				break
+			}
+			for i, list := 0, v.List(); i < list.Len(); i++ {
+				if !validUUID(list.Get(i).String()) {
+					bad = fmt.Sprintf("%s[%d]", name, i)
+					break
+				}
+			}
+		}
+		return bad == ""
+	})
+	return bad
+}
+
+func isIDField(name protoreflect.Name) bool {
+	s := string(name)
+	return (strings.HasSuffix(s, "_id") || strings.HasSuffix(s, "_ids")) && !uuidExempt[name]
+}
+
+// validUUID reports whether s is a UUID in its canonical textual form
+// (8-4-4-4-12 hex digits, any case).
+func validUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
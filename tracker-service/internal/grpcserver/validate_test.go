@@ -0,0 +1,60 @@
+package grpcserver_test
+
+import (
+	"context"
+	"testing"
+
+	"jobmate/tracker-service/internal/grpcserver"
+	pb "jobmate/tracker-service/internal/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const validID = "0b7c1f0e-3d4a-4c8e-9f1a-2b3c4d5e6f70"
+
+func TestUUIDValidationInterceptor(t *testing.T) {
+	intercept := grpcserver.UUIDValidationInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/tracker.TrackerService/Test"}
+
+	cases := []struct {
+		name  string
+		req   proto.Message
+		field string // "" = accepted
+	}{
+		{"valid", &pb.MoveCardRequest{ApplicationId: validID}, ""},
+		{"empty left to the handler", &pb.MoveCardRequest{}, ""},
+		{"uppercase", &pb.MoveCardRequest{ApplicationId: "0B7C1F0E-3D4A-4C8E-9F1A-2B3C4D5E6F70"}, ""},
+		{"malformed", &pb.MoveCardRequest{ApplicationId: "42"}, "application_id"},
+		{"no hyphens", &pb.MoveCardRequest{ApplicationId: "0b7c1f0e3d4a4c8e9f1a2b3c4d5e6f70abcd"}, "application_id"},
+		{"repeated", &pb.BulkMoveRequest{ApplicationIds: []string{validID, "nope"}}, "application_ids[1]"},
+		{"exempt", &pb.ListAuditLogRequest{ResourceId: "job-17"}, ""},
+	}
+	for _, c := range cases {
+		_, err := intercept(context.Background(), c.req, info, handler)
+		if c.field == "" {
+			if err != nil {
+				t.Errorf("%s: err = %v, want accepted", c.name, err)
+			}
+			continue
+		}
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Errorf("%s: code = %v, want InvalidArgument", c.name, st.Code())
+			continue
+		}
+		var field string
+		for _, d := range st.Details() {
+			if br, ok := d.(*errdetails.BadRequest); ok && len(br.FieldViolations) > 0 {
+				field = br.FieldViolations[0].Field
+			}
+		}
+		if field != c.field {
+			t.Errorf("%s: field = %q, want %q", c.name, field, c.field)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJWKSCacheTTL = time.Hour
+	// minJWKSRefresh rate-limits the refetches triggered by unknown key IDs,
+	// so tokens with made-up kids cannot hammer the JWKS endpoint.
+	minJWKSRefresh = time.Minute
+	maxJWKSSize    = 1 << 20
+)
+
+// jwks caches the keys published at a JWKS URL, by key ID.
+type jwks struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newJWKS(url string, ttl time.Duration, client *http.Client) *jwks {
+	if ttl <= 0 {
+		ttl = defaultJWKSCacheTTL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &jwks{url: url, ttl: ttl, client: client}
+}
+
+// key returns the key kid designates, fetching the set when the cache is
+// stale or does not know kid. An empty kid matches a set of a single key.
+func (j *jwks) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	age := time.Since(j.fetchedAt)
+	k, ok := j.lookup(kid)
+	if j.keys == nil || age > j.ttl || (!ok && age > minJWKSRefresh) {
+		keys, err := j.fetch(ctx)
+		if err != nil {
+			if ok { // serve the stale key rather than fail every request
+				return k, nil
+			}
+			return nil, err
+		}
+		j.keys, j.fetchedAt = keys, time.Now()
+		k, ok = j.lookup(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, kid)
+	}
+	return k, nil
+}
+
+func (j *jwks) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(j.keys) == 1 {
+		for _, k := range j.keys {
+			return k, true
+		}
+	}
+	k, ok := j.keys[kid]
+	return k, ok
+}
+
+// jwk holds the members of a JSON Web Key used for RSA and P-256 keys.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// fetch downloads the key set. Keys of other types or uses are skipped.
+func (j *jwks) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("jwks request: %w", err)
+	}
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jwks fetch: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks fetch: status %d", resp.StatusCode)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxJWKSSize)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("jwks decode: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if pub, err := k.publicKey(); err == nil {
+			keys[k.Kid] = pub
+		}
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("jwk %s: bad exponent", k.Kid)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("jwk %s: unsupported curve %q", k.Kid, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !pub.Curve.IsOnCurve(x, y) { //nolint:staticcheck // validating an untrusted point
+			return nil, fmt.Errorf("jwk %s: point not on curve", k.Kid)
+		}
+		return pub, nil
+	}
+	return nil, fmt.Errorf("jwk %s: unsupported key type %q", k.Kid, k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("jwk: bad base64url integer")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,119 @@
+package jwtauth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/jwtauth"
+)
+
+var b64 = base64.RawURLEncoding
+
+func segments(t *testing.T, header, claims map[string]any) string {
+	t.Helper()
+	h, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+}
+
+func hs256(t *testing.T, secret string, claims map[string]any) string {
+	signed := segments(t, map[string]any{"alg": "HS256", "typ": "JWT"}, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + b64.EncodeToString(mac.Sum(nil))
+}
+
+func TestUserID_HS256(t *testing.T) {
+	v, err := jwtauth.New(jwtauth.Config{Secret: []byte("s3cret")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	got, err := v.UserID(context.Background(), hs256(t, "s3cret", map[string]any{"userId": "u1", "exp": exp}))
+	if err != nil || got != "u1" {
+		t.Fatalf("UserID = %q, %v; want u1", got, err)
+	}
+
+	bad := map[string]string{
+		"wrong secret": hs256(t, "other", map[string]any{"userId": "u1", "exp": exp}),
+		"expired":      hs256(t, "s3cret", map[string]any{"userId": "u1", "exp": time.Now().Add(-time.Hour).Unix()}),
+		"no expiry":    hs256(t, "s3cret", map[string]any{"userId": "u1"}),
+		"no user":      hs256(t, "s3cret", map[string]any{"exp": exp}),
+		"not yet":      hs256(t, "s3cret", map[string]any{"userId": "u1", "exp": exp, "nbf": time.Now().Add(time.Hour).Unix()}),
+		"alg none":     segments(t, map[string]any{"alg": "none"}, map[string]any{"userId": "u1", "exp": exp}) + ".",
+		"garbage":      "not-a-token",
+	}
+	for name, token := range bad {
+		if _, err := v.UserID(context.Background(), token); !errors.Is(err, jwtauth.ErrInvalidToken) {
+			t.Errorf("%s: err = %v, want ErrInvalidToken", name, err)
+		}
+	}
+}
+
+func TestUserID_JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA", "kid": "k1", "use": "sig",
+			"n": b64.EncodeToString(key.N.Bytes()),
+			"e": b64.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer srv.Close()
+
+	v, err := jwtauth.New(jwtauth.Config{JWKSURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs256 := func(kid string) string {
+		signed := segments(t, map[string]any{"alg": "RS256", "kid": kid},
+			map[string]any{"sub": "u2", "exp": time.Now().Add(time.Hour).Unix()})
+		digest := sha256.Sum256([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed + "." + b64.EncodeToString(sig)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got, err := v.UserID(context.Background(), rs256("k1")); err != nil || got != "u2" {
+			t.Fatalf("UserID = %q, %v; want u2", got, err)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("JWKS fetched %d times, want 1 (cached)", fetches)
+	}
+	if _, err := v.UserID(context.Background(), rs256("unknown")); !errors.Is(err, jwtauth.ErrInvalidToken) {
+		t.Errorf("unknown kid: err = %v, want ErrInvalidToken", err)
+	}
+	// An HS256 token is refused when no secret is configured, whatever it
+	// was signed with.
+	if _, err := v.UserID(context.Background(), hs256(t, "x", map[string]any{"sub": "u2", "exp": time.Now().Add(time.Hour).Unix()})); !errors.Is(err, jwtauth.ErrInvalidToken) {
+		t.Errorf("HS256 without secret: err = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,49 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// OfferAccepted is the EVENT_OFFER_ACCEPTED payload published when a user
+// saves or approves an offer of their feed.
+type OfferAccepted struct {
+	UserID    string `json:"userId"`
+	JobFeedID string `json:"jobFeedId"`
+}
+
+// HandleOfferAccepted puts the offer an EVENT_OFFER_ACCEPTED payload names
+// on the user's board at TO_APPLY (see CreateApplication), so the handoff
+// from discovery needs no second call. With nobody to confirm it, an offer
+// the user already has an application for — this one, on a redelivery, or
+// the same job at the same company (see DuplicateError) — is logged and
+// skipped, as is one deleted in the meantime.
+func (s *Service) HandleOfferAccepted(ctx context.Context, payload string) error {
+	var ev OfferAccepted
+	if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+		return fmt.Errorf("offerAccepted: bad payload: %w", err)
+	}
+	if ev.UserID == "" || ev.JobFeedID == "" {
+		return errors.New("offerAccepted: missing userId or jobFeedId")
+	}
+
+	app, err := s.CreateApplication(ctx, ev.UserID, ev.JobFeedID, false)
+	var dup *DuplicateError
+	switch {
+	case errors.As(err, &dup):
+		slog.InfoContext(ctx, "accepted offer not tracked: already applied to this job",
+			"userId", ev.UserID, "jobFeedId", ev.JobFeedID, "existing", dup.ApplicationIDs)
+		return nil
+	case errors.Is(err, ErrJobNotFound):
+		slog.WarnContext(ctx, "accepted offer not tracked: job not found", "userId", ev.UserID, "jobFeedId", ev.JobFeedID)
+		return nil
+	case err != nil:
+		return fmt.Errorf("offerAccepted: %w", err)
+	}
+	s.InvalidateUserCache(ctx, ev.UserID)
+	slog.InfoContext(ctx, "accepted offer tracked", "userId", ev.UserID, "jobFeedId", ev.JobFeedID, "applicationId", app.ID)
+	return nil
+}
@@ -0,0 +1,74 @@
+package kanban_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// offlineRedis is a client whose commands fail at once: cache invalidations
+// are logged and skipped.
+func offlineRedis(t *testing.T) *redis.Client {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
+
+func TestHandleOfferAccepted(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "search-1")
+	svc := kanban.NewService(nil, offlineRedis(t), kanban.Options{Applications: repo})
+	payload := `{"userId":"user-1","jobFeedId":"job-1"}`
+
+	if err := svc.HandleOfferAccepted(context.Background(), payload); err != nil {
+		t.Fatalf("HandleOfferAccepted: %v", err)
+	}
+	if got := repo.app("app-1"); got.userID != "user-1" || got.jobFeedID != "job-1" || got.status != kanban.StatusToApply {
+		t.Errorf("card = %+v, want user-1's TO_APPLY card of job-1", got)
+	}
+	want := "[CMD_ANALYZE_JOB EVENT_APPLICATION_CREATED]"
+	if got := fmt.Sprint(repo.streams()); got != want {
+		t.Errorf("events = %s, want %s", got, want)
+	}
+
+	// Redelivered: nothing more.
+	if err := svc.HandleOfferAccepted(context.Background(), payload); err != nil {
+		t.Fatalf("HandleOfferAccepted(again): %v", err)
+	}
+	if got := fmt.Sprint(repo.streams()); got != want {
+		t.Errorf("events after a redelivery = %s, want %s", got, want)
+	}
+}
+
+func TestHandleOfferAccepted_Skipped(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addJob("job-2", "ACME", "Go developer", "")
+	repo.addApp("app-old", "user-1", "job-1", kanban.StatusApplied)
+	svc := kanban.NewService(nil, offlineRedis(t), kanban.Options{Applications: repo})
+
+	for _, payload := range []string{
+		`{"userId":"user-1","jobFeedId":"job-2"}`,    // duplicate of app-old
+		`{"userId":"user-1","jobFeedId":"job-gone"}`, // deleted
+	} {
+		if err := svc.HandleOfferAccepted(context.Background(), payload); err != nil {
+			t.Errorf("HandleOfferAccepted(%s) = %v, want it skipped", payload, err)
+		}
+	}
+	if got := repo.streams(); len(got) != 0 {
+		t.Errorf("events = %v, want none", got)
+	}
+}
+
+func TestHandleOfferAccepted_BadPayload(t *testing.T) {
+	svc := kanban.NewService(nil, nil, kanban.Options{Applications: newMemRepo()})
+	for _, payload := range []string{`not json`, `{"userId":"user-1"}`, `{"jobFeedId":"job-1"}`} {
+		if err := svc.HandleOfferAccepted(context.Background(), payload); err == nil {
+			t.Errorf("HandleOfferAccepted(%s) = nil, want an error", payload)
+		}
+	}
+}
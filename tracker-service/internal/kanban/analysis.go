@@ -0,0 +1,100 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// AnalysisDone is the EVENT_ANALYSIS_DONE payload published by the AI Coach
+// when it finishes (or gives up on) an application. The cover letter is not
+// in it — events carry no user content — but written by the AI Coach to the
+// application before it publishes the event.
+type AnalysisDone struct {
+	ApplicationID  string          `json:"applicationId"`
+	UserID         string          `json:"userId"`
+	MatchScore     *int            `json:"matchScore"`
+	AnalyzedAt     string          `json:"analyzedAt"`
+	Status         string          `json:"status"` // "", "timeout" or "error"
+	Error          string          `json:"error"`
+	AIAnalysis     json.RawMessage `json:"aiAnalysis"` // ai_analysis object
+	HasCoverLetter bool            `json:"hasCoverLetter"`
+}
+
+// HandleAnalysisDone persists the ai_analysis carried by an
+// EVENT_ANALYSIS_DONE payload onto the application and queues
+// EVENT_APPLICATION_ANALYZED for the Gateway SSE stream in the same
+// transaction, so clients are only notified once the results can be read.
+// Stored results are logged as an ANALYZED history entry.
+//
+// Failed analyses carry no results and are only forwarded.
+func (s *Service) HandleAnalysisDone(ctx context.Context, payload string) error {
+	var ev AnalysisDone
+	if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+		return fmt.Errorf("analysisDone: bad payload: %w", err)
+	}
+	if ev.ApplicationID == "" || ev.UserID == "" {
+		return errors.New("analysisDone: missing applicationId or userId")
+	}
+
+	if len(ev.AIAnalysis) > 0 {
+		var obj map[string]any
+		if err := json.Unmarshal(ev.AIAnalysis, &obj); err != nil {
+			return fmt.Errorf("analysisDone: aiAnalysis is not a JSON object: %w", err)
+		}
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("analysisDone: begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	status := ev.Status
+	if status == "" {
+		status = "done"
+	}
+	if len(ev.AIAnalysis) > 0 {
+		logged, _ := json.Marshal([]HistoryEntry{{
+			Kind: HistoryAnalyzed, At: time.Now().UTC().Truncate(time.Second), Outcome: status, Actor: ActorSystem,
+		}})
+		tag, err := tx.Exec(ctx,
+			`UPDATE applications
+			 SET ai_analysis = $1::jsonb, history_log = history_log || $4::jsonb, updated_at = NOW()
+			 WHERE id = $2 AND user_id = $3`,
+			string(ev.AIAnalysis), ev.ApplicationID, ev.UserID, string(logged),
+		)
+		if err != nil {
+			return fmt.Errorf("analysisDone: update: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			// Deleted while the AI Coach was busy — nothing to notify about.
+			slog.Warn("analysisDone: application not found", "applicationId", ev.ApplicationID)
+			return nil
+		}
+	}
+
+	err = enqueueEvent(ctx, tx, "EVENT_APPLICATION_ANALYZED", map[string]any{
+		"type":           "EVENT_APPLICATION_ANALYZED",
+		"applicationId":  ev.ApplicationID,
+		"userId":         ev.UserID,
+		"status":         status,
+		"matchScore":     ev.MatchScore,
+		"hasCoverLetter": ev.HasCoverLetter,
+		"analyzedAt":     ev.AnalyzedAt,
+		"error":          ev.Error,
+	})
+	if err != nil {
+		return fmt.Errorf("analysisDone: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("analysisDone: commit: %w", err)
+	}
+	if len(ev.AIAnalysis) > 0 {
+		s.InvalidateUserCache(ctx, ev.UserID)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Attachment is a file (the CV version sent, a portfolio, an offer letter…)
+// stored in the object store and linked to an application.
+type Attachment struct {
+	ID            string    `json:"id"`
+	ApplicationID string    `json:"applicationId"`
+	Kind          string    `json:"kind"`
+	FileName      string    `json:"fileName"`
+	ContentType   string    `json:"contentType"`
+	SizeBytes     int64     `json:"sizeBytes"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// ScanStatus is one of the Scan* statuses; ScanSignature names what a
+	// quarantined file contains.
+	ScanStatus    string     `json:"scanStatus"`
+	ScanSignature string     `json:"scanSignature,omitempty"`
+	ScannedAt     *time.Time `json:"scannedAt,omitempty"`
+	objectKey     string
+}
+
+// AttachmentUpload describes a file the client is about to upload.
+type AttachmentUpload struct {
+	Kind        string // CV, COVER_LETTER or OTHER (default)
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+}
+
+// PresignedURL is a time-limited object-store URL.
+type PresignedURL struct {
+	URL       string
+	ExpiresAt time.Time
+}
+
+// Attachment kinds.
+const (
+	AttachmentCV          = "CV"
+	AttachmentCoverLetter = "COVER_LETTER"
+	AttachmentOther       = "OTHER"
+)
+
+// Attachment scan statuses (see ScanAttachments). Only ScanClean and
+// ScanUnscanned attachments can be downloaded.
+const (
+	ScanPending     = "PENDING"
+	ScanClean       = "CLEAN"
+	ScanQuarantined = "QUARANTINED"
+	// ScanUnscanned attachments were uploaded while no scanner was
+	// configured.
+	ScanUnscanned = "UNSCANNED"
+)
+
+const (
+	maxAttachmentSize     = 10 << 20 // 10 MiB per file
+	maxAttachmentNameLen  = 255
+	attachmentURLLifetime = 15 * time.Minute
+	// attachmentScanDelay is how long after its upload URL expired an
+	// attachment is first scanned, in case the object store's clock is
+	// behind.
+	attachmentScanDelay = time.Minute
+)
+
+// allowedAttachmentTypes are the accepted MIME types: documents and images.
+var allowedAttachmentTypes = map[string]bool{
+	"application/pdf":    true,
+	"application/msword": true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+	"application/vnd.oasis.opendocument.text":                                 true,
+	"text/plain": true,
+	"image/png":  true,
+	"image/jpeg": true,
+}
+
+var (
+	// ErrAttachmentNotFound is returned when an attachment is missing or owned by someone else.
+	ErrAttachmentNotFound = fmt.Errorf("attachment not found")
+	// ErrAttachmentsDisabled is returned when no object store is configured.
+	ErrAttachmentsDisabled = fmt.Errorf("attachments are not configured on this deployment")
+	// ErrAttachmentScanPending is returned when an attachment is not
+	// scanned yet: it cannot be downloaded until found clean.
+	ErrAttachmentScanPending = fmt.Errorf("attachment is being scanned for malware")
+	// ErrAttachmentQuarantined is returned when malware was found in an
+	// attachment.
+	ErrAttachmentQuarantined = fmt.Errorf("attachment quarantined: malware found")
+)
+
+const attachmentColumns = `id::text, application_id::text, kind, file_name, content_type, size_bytes, created_at, object_key,
+	scan_status, COALESCE(scan_signature, ''), scanned_at`
+
+const attachmentSelect = `SELECT ` + attachmentColumns + ` FROM attachments`
+
+func scanAttachment(row pgx.Row) (*Attachment, error) {
+	var a Attachment
+	if err := row.Scan(&a.ID, &a.ApplicationID, &a.Kind, &a.FileName, &a.ContentType, &a.SizeBytes, &a.CreatedAt, &a.objectKey,
+		&a.ScanStatus, &a.ScanSignature, &a.ScannedAt); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// CreateAttachment registers a file on an application and returns a
+// presigned URL the client PUTs the bytes to, with exactly the declared
+// Content-Type and Content-Length. Fails when the upload would push the
+// user's total past Options.AttachmentQuota. With Options.Scanner set, the
+// attachment is ScanPending until ScanAttachments scanned it, which it does
+// once the upload URL has expired: the file cannot be replaced after its
+// scan.
+func (s *Service) CreateAttachment(ctx context.Context, userID, appID string, up AttachmentUpload) (*Attachment, *PresignedURL, error) {
+	up, err := normalizeAttachmentUpload(up)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.opts.Storage == nil {
+		return nil, nil, ErrAttachmentsDisabled
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("createAttachment begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	// Serialize the quota check per user.
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('attachments:' || $1))`, userID); err != nil {
+		return nil, nil, fmt.Errorf("createAttachment lock: %w", err)
+	}
+	var used int64
+	if err := tx.QueryRow(ctx,
+		`SELECT COALESCE(SUM(size_bytes), 0) FROM attachments WHERE user_id = $1 AND scan_status <> 'QUARANTINED'`, userID,
+	).Scan(&used); err != nil {
+		return nil, nil, fmt.Errorf("createAttachment usage: %w", err)
+	}
+	if quota := s.opts.AttachmentQuota; quota > 0 && used+up.SizeBytes > quota {
+		return nil, nil, &ValidationError{
+			Field: "size_bytes",
+			Msg:   fmt.Sprintf("storage quota exceeded: %d of %d MiB used", used>>20, quota>>20),
+		}
+	}
+
+	scanStatus := ScanUnscanned
+	if s.opts.Scanner != nil {
+		scanStatus = ScanPending
+	}
+	uploadExpiresAt := time.Now().Add(attachmentURLLifetime)
+	a, err := scanAttachment(tx.QueryRow(ctx,
+		`INSERT INTO attachments (id, user_id, application_id, kind, file_name, content_type, size_bytes, object_key,
+		                          scan_status, scan_next_at)
+		 SELECT g.id, a.user_id, a.id, $3, $4, $5, $6,
+		        a.user_id::text || '/' || a.id::text || '/' || g.id::text, $7, $8
+		 FROM applications a, (SELECT uuid_generate_v4() AS id) g
+		 WHERE a.id = $1 AND a.user_id = $2
+		 RETURNING `+attachmentColumns,
+		appID, userID, up.Kind, up.FileName, up.ContentType, up.SizeBytes,
+		scanStatus, uploadExpiresAt.Add(attachmentScanDelay),
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("createAttachment insert: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("createAttachment commit: %w", err)
+	}
+	return a, &PresignedURL{
+		URL:       s.opts.Storage.PresignPut(a.objectKey, a.ContentType, a.SizeBytes, time.Until(uploadExpiresAt)),
+		ExpiresAt: uploadExpiresAt,
+	}, nil
+}
+
+// ListAttachments returns an application's attachments, oldest first.
+func (s *Service) ListAttachments(ctx context.Context, userID, appID string) ([]Attachment, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM applications WHERE id = $1 AND user_id = $2)`,
+		appID, userID,
+	).Scan(&exists)
+	if err != nil || !exists {
+		return nil, ErrNotFound
+	}
+
+	rows, err := s.pool.Query(ctx,
+		attachmentSelect+` WHERE application_id = $1 AND user_id = $2 ORDER BY created_at, id`,
+		appID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listAttachments query: %w", err)
+	}
+	defer rows.Close()
+
+	atts := make([]Attachment, 0)
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listAttachments scan: %w", err)
+		}
+		atts = append(atts, *a)
+	}
+	return atts, rows.Err()
+}
+
+// AttachmentDownloadURL returns the attachment and a short-lived download
+// URL: ErrAttachmentScanPending until the attachment is scanned,
+// ErrAttachmentQuarantined when malware was found in it.
+func (s *Service) AttachmentDownloadURL(ctx context.Context, userID, attID string) (*Attachment, *PresignedURL, error) {
+	if s.opts.Storage == nil {
+		return nil, nil, ErrAttachmentsDisabled
+	}
+	a, err := scanAttachment(s.pool.QueryRow(ctx,
+		attachmentSelect+` WHERE id = $1 AND user_id = $2`, attID, userID))
+	if err != nil {
+		return nil, nil, ErrAttachmentNotFound
+	}
+	switch a.ScanStatus {
+	case ScanPending:
+		return nil, nil, ErrAttachmentScanPending
+	case ScanQuarantined:
+		return nil, nil, ErrAttachmentQuarantined
+	}
+	return a, &PresignedURL{
+		URL:       s.opts.Storage.PresignGet(a.objectKey, a.FileName, attachmentURLLifetime),
+		ExpiresAt: time.Now().Add(attachmentURLLifetime),
+	}, nil
+}
+
+// DeleteAttachment removes an attachment and, best-effort, its stored object.
+func (s *Service) DeleteAttachment(ctx context.Context, userID, attID string) error {
+	var key string
+	err := s.pool.QueryRow(ctx,
+		`DELETE FROM attachments WHERE id = $1 AND user_id = $2 RETURNING object_key`,
+		attID, userID,
+	).Scan(&key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAttachmentNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("deleteAttachment: %w", err)
+	}
+
+	if s.opts.Storage != nil {
+		if err := s.opts.Storage.Delete(ctx, key); err != nil {
+			slog.Warn("attachment object not deleted", "key", key, "err", err)
+		}
+	}
+	return nil
+}
+
+// normalizeAttachmentUpload validates an upload request: known kind,
+// allowed MIME type, size within bounds and a plain file name.
+func normalizeAttachmentUpload(up AttachmentUpload) (AttachmentUpload, error) {
+	switch up.Kind {
+	case "":
+		up.Kind = AttachmentOther
+	case AttachmentCV, AttachmentCoverLetter, AttachmentOther:
+	default:
+		return up, &ValidationError{Field: "kind", Msg: fmt.Sprintf("unknown attachment kind %q", up.Kind)}
+	}
+
+	up.ContentType = strings.ToLower(strings.TrimSpace(up.ContentType))
+	if !allowedAttachmentTypes[up.ContentType] {
+		return up, &ValidationError{
+			Field: "content_type",
+			Msg:   fmt.Sprintf("unsupported content type %q (PDF, Word, ODT, text, PNG or JPEG only)", up.ContentType),
+		}
+	}
+	if up.SizeBytes <= 0 || up.SizeBytes > maxAttachmentSize {
+		return up, &ValidationError{
+			Field: "size_bytes",
+			Msg:   fmt.Sprintf("size_bytes must be between 1 and %d", maxAttachmentSize),
+		}
+	}
+
+	name, err := cleanText("file_name", up.FileName, maxAttachmentNameLen)
+	if err != nil {
+		return up, err
+	}
+	// Keep only the base name: clients sometimes send a full local path.
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" || name == "." || name == ".." {
+		return up, &ValidationError{Field: "file_name", Msg: "file_name must not be empty"}
+	}
+	up.FileName = name
+	return up, nil
+}
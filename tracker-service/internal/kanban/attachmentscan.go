@@ -0,0 +1,128 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"jobmate/tracker-service/internal/storage"
+)
+
+const (
+	// attachmentScanBatch is how many attachments one claim leases.
+	attachmentScanBatch = 20
+	// attachmentScanLease is how long a claimed attachment is left to its
+	// scanner run; a run that fails retries it after that.
+	attachmentScanLease = 10 * time.Minute
+	// attachmentMissingRetry is how long an attachment whose file was not
+	// found waits for its next check. Its upload URL has expired, so the
+	// file is not expected any more.
+	attachmentMissingRetry = time.Hour
+)
+
+// pendingScan is an attachment leased by claimAttachmentScans.
+type pendingScan struct {
+	id, userID, objectKey string
+}
+
+// ScanAttachments runs Options.Scanner on the uploaded files of the
+// ScanPending attachments and marks them ScanClean, or ScanQuarantined —
+// deleting the file, best-effort — when malware is found. Attachments are
+// due once their upload URL expired (see CreateAttachment), so that a file
+// cannot be replaced after its scan; those whose file was never uploaded are
+// checked again hourly. Returns the number of attachments scanned. Does
+// nothing without a scanner or an object store.
+func (s *Service) ScanAttachments(ctx context.Context) (int, error) {
+	if s.opts.Scanner == nil || s.opts.Storage == nil {
+		return 0, nil
+	}
+	scanned := 0
+	for {
+		batch, err := s.claimAttachmentScans(ctx)
+		if err != nil {
+			return scanned, err
+		}
+		for _, p := range batch {
+			ok, err := s.checkAttachment(ctx, p)
+			if err != nil {
+				slog.Warn("attachment not scanned", "attachment", p.id, "err", err)
+				continue
+			}
+			if ok {
+				scanned++
+			}
+		}
+		if len(batch) < attachmentScanBatch || ctx.Err() != nil {
+			return scanned, nil
+		}
+	}
+}
+
+// claimAttachmentScans leases a batch of pending attachments due for a scan.
+func (s *Service) claimAttachmentScans(ctx context.Context) ([]pendingScan, error) {
+	rows, err := s.pool.Query(ctx,
+		`UPDATE attachments
+		 SET scan_next_at = NOW() + make_interval(secs => $2)
+		 WHERE id IN (SELECT id FROM attachments
+		              WHERE scan_status = 'PENDING' AND scan_next_at <= NOW()
+		              ORDER BY scan_next_at
+		              LIMIT $1
+		              FOR UPDATE SKIP LOCKED)
+		 RETURNING id::text, user_id::text, object_key`,
+		attachmentScanBatch, attachmentScanLease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("scanAttachments claim: %w", err)
+	}
+	defer rows.Close()
+
+	var batch []pendingScan
+	for rows.Next() {
+		var p pendingScan
+		if err := rows.Scan(&p.id, &p.userID, &p.objectKey); err != nil {
+			return nil, fmt.Errorf("scanAttachments scan: %w", err)
+		}
+		batch = append(batch, p)
+	}
+	return batch, rows.Err()
+}
+
+// checkAttachment scans one attachment and records the verdict; false when
+// its file was not uploaded.
+func (s *Service) checkAttachment(ctx context.Context, p pendingScan) (bool, error) {
+	file, err := s.opts.Storage.Get(ctx, p.objectKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		_, err := s.pool.Exec(ctx,
+			`UPDATE attachments SET scan_next_at = NOW() + make_interval(secs => $2) WHERE id = $1`,
+			p.id, attachmentMissingRetry.Seconds())
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+	result, err := s.opts.Scanner.Scan(ctx, file)
+	file.Close()
+	if err != nil {
+		return false, err
+	}
+
+	if !result.Infected {
+		_, err := s.pool.Exec(ctx,
+			`UPDATE attachments SET scan_status = 'CLEAN', scanned_at = NOW()
+			 WHERE id = $1 AND scan_status = 'PENDING'`,
+			p.id)
+		return err == nil, err
+	}
+	if _, err := s.pool.Exec(ctx,
+		`UPDATE attachments SET scan_status = 'QUARANTINED', scan_signature = $2, scanned_at = NOW()
+		 WHERE id = $1 AND scan_status = 'PENDING'`,
+		p.id, result.Signature); err != nil {
+		return false, err
+	}
+	slog.Warn("attachment quarantined", "attachment", p.id, "userId", p.userID, "signature", result.Signature)
+	if err := s.opts.Storage.Delete(ctx, p.objectKey); err != nil {
+		slog.Warn("quarantined attachment object not deleted", "key", p.objectKey, "err", err)
+	}
+	return true, nil
+}
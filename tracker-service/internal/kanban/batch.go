@@ -0,0 +1,62 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// maxBatchGet caps how many applications a single BatchGetApplications may
+// fetch.
+const maxBatchGet = 200
+
+// BatchGetApplications returns the user's applications among appIDs in one
+// query, in request order (duplicate IDs collapsed). IDs that do not exist
+// or belong to someone else are skipped, not reported: callers compare the
+// returned IDs with the requested ones. Unlike GetApplication, interviews are
+// not loaded.
+func (s *Service) BatchGetApplications(ctx context.Context, userID string, appIDs []string) ([]Application, error) {
+	lower := make([]string, len(appIDs))
+	for i, id := range appIDs {
+		lower[i] = strings.ToLower(id) // as returned by PostgreSQL
+	}
+	ids := dedupe(lower)
+	if len(ids) == 0 {
+		return nil, &ValidationError{Field: "application_ids", Msg: "application_ids must not be empty"}
+	}
+	if len(ids) > maxBatchGet {
+		return nil, &ValidationError{Field: "application_ids", Msg: fmt.Sprintf("at most %d applications can be fetched at once", maxBatchGet)}
+	}
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+appColumns("a")+`
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.id = ANY($1::uuid[]) AND a.user_id = $2`,
+		ids, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("batchGetApplications query: %w", err)
+	}
+	defer rows.Close()
+
+	byID := make(map[string]Application, len(ids))
+	for rows.Next() {
+		var a Application
+		if err := rows.Scan(s.appScanDest(&a)...); err != nil {
+			return nil, fmt.Errorf("batchGetApplications scan: %w", err)
+		}
+		byID[a.ID] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("batchGetApplications: %w", err)
+	}
+
+	apps := make([]Application, 0, len(byID))
+	for _, id := range ids {
+		if a, ok := byID[id]; ok {
+			apps = append(apps, a)
+		}
+	}
+	return apps, nil
+}
@@ -0,0 +1,150 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Benchmark compares a user's funnel for a job title with the anonymous
+// median of the users who share their statistics.
+type Benchmark struct {
+	// JobTitle is the cohort the figures are for; "" = all job titles, used
+	// when the requested title has too few users.
+	JobTitle            string    `json:"jobTitle"`
+	UserCount           int       `json:"userCount"`
+	MedianApplications  float64   `json:"medianApplications"`
+	MedianInterviewRate float64   `json:"medianInterviewRate"` // interviews / applications sent
+	ComputedAt          time.Time `json:"computedAt"`
+
+	// The caller's own figures for the same cohort, computed live.
+	Applications  int     `json:"applications"`
+	Interviews    int     `json:"interviews"`
+	InterviewRate float64 `json:"interviewRate"`
+}
+
+// benchmarkMinApplications is the number of applications sent a user needs
+// in a cohort for their interview rate to count towards it.
+const benchmarkMinApplications = 3
+
+var (
+	// ErrBenchmarksNotShared is returned to users who did not opt in to the
+	// benchmarks: only contributors may see them.
+	ErrBenchmarksNotShared = errors.New("benchmarks are only available to users sharing their statistics")
+	// ErrBenchmarkNotFound is returned until a cohort is large enough.
+	ErrBenchmarkNotFound = errors.New("no benchmark available yet")
+)
+
+// titleKeyExpr is the SQL counterpart of companyKey, applied to the job
+// title joined as jf.
+const titleKeyExpr = `lower(regexp_replace(btrim(` + jobTitleExpr + `), '\s+', ' ', 'g'))`
+
+// benchmarkFunnelColumns count, among the applications joined as a, those
+// sent (moved past TO_APPLY) and those of them that reached an interview.
+const benchmarkFunnelColumns = `
+	COUNT(*) FILTER (WHERE a.current_status <> 'TO_APPLY') AS applied,
+	COUNT(*) FILTER (WHERE a.current_status <> 'TO_APPLY'
+	                   AND (a.current_status IN ('INTERVIEW', 'OFFER', 'HIRED')
+	                        OR a.history_log @> '[{"to": "INTERVIEW"}]')) AS interviewed`
+
+// ComputeBenchmarks rebuilds benchmark_stats from the applications of the
+// users who opted in: per job title, and over all titles, the median number
+// of applications sent and the median interview rate. Cohorts of fewer than
+// Options.BenchmarkMinUsers users are dropped so no individual funnel can be
+// inferred. Returns the number of cohorts stored.
+func (s *Service) ComputeBenchmarks(ctx context.Context) (int, error) {
+	var n int
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM benchmark_stats`); err != nil {
+			return fmt.Errorf("computeBenchmarks clear: %w", err)
+		}
+		tag, err := tx.Exec(ctx,
+			`WITH apps AS (
+			   SELECT a.*, `+titleKeyExpr+` AS title_key
+			   FROM applications a
+			   JOIN tracker_settings ts ON ts.user_id = a.user_id AND ts.share_benchmarks
+			   LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+			 ), funnels AS (
+			   SELECT a.user_id,
+			          CASE WHEN GROUPING(a.title_key) = 1 THEN '' ELSE a.title_key END AS title_key,
+			          `+benchmarkFunnelColumns+`
+			   FROM apps a
+			   GROUP BY GROUPING SETS ((a.user_id, a.title_key), (a.user_id))
+			   HAVING GROUPING(a.title_key) = 1 OR a.title_key <> ''
+			 )
+			 INSERT INTO benchmark_stats (title_key, user_count, median_applications, median_interview_rate)
+			 SELECT title_key, COUNT(*),
+			        percentile_cont(0.5) WITHIN GROUP (ORDER BY applied),
+			        percentile_cont(0.5) WITHIN GROUP (ORDER BY interviewed::float8 / applied)
+			 FROM funnels
+			 WHERE applied >= $2
+			 GROUP BY title_key
+			 HAVING COUNT(*) >= $1`,
+			s.opts.BenchmarkMinUsers, benchmarkMinApplications)
+		if err != nil {
+			return fmt.Errorf("computeBenchmarks: %w", err)
+		}
+		n = int(tag.RowsAffected())
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("benchmarks computed", "cohorts", n)
+	return n, nil
+}
+
+// GetBenchmark returns the benchmark of jobTitle — or of all titles when its
+// cohort is too small or jobTitle is empty — with the caller's own figures.
+// The caller must share their statistics (ErrBenchmarksNotShared).
+func (s *Service) GetBenchmark(ctx context.Context, userID, jobTitle string) (*Benchmark, error) {
+	var shared bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT share_benchmarks FROM tracker_settings WHERE user_id = $1`, userID,
+	).Scan(&shared)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("getBenchmark settings: %w", err)
+	}
+	if !shared {
+		return nil, ErrBenchmarksNotShared
+	}
+
+	var b Benchmark
+	key := companyKey(jobTitle)
+	err = s.pool.QueryRow(ctx,
+		`SELECT title_key, user_count, median_applications, median_interview_rate, computed_at
+		 FROM benchmark_stats
+		 WHERE title_key IN ($1, '')
+		 ORDER BY title_key DESC
+		 LIMIT 1`,
+		key,
+	).Scan(&key, &b.UserCount, &b.MedianApplications, &b.MedianInterviewRate, &b.ComputedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBenchmarkNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getBenchmark: %w", err)
+	}
+	if key != "" {
+		b.JobTitle = jobTitle
+	}
+
+	err = s.pool.QueryRow(ctx,
+		`SELECT `+benchmarkFunnelColumns+`
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1 AND ($2 = '' OR `+titleKeyExpr+` = $2)`,
+		userID, key,
+	).Scan(&b.Applications, &b.Interviews)
+	if err != nil {
+		return nil, fmt.Errorf("getBenchmark own funnel: %w", err)
+	}
+	if b.Applications > 0 {
+		b.InterviewRate = float64(b.Interviews) / float64(b.Applications)
+	}
+	return &b, nil
+}
@@ -0,0 +1,125 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// maxBulkMove caps how many applications a single BulkMove may touch.
+const maxBulkMove = 200
+
+// BulkMoveResult is the per-application outcome of a BulkMove call.
+// Exactly one of App and Err is set.
+type BulkMoveResult struct {
+	ApplicationID string
+	App           *Application
+	Err           error
+}
+
+// BulkMove transitions several applications to the same status in one
+// transaction — e.g. "mark all remaining as REJECTED" when a search ends.
+//
+// Every transition is validated individually: applications that are missing,
+// archived or not allowed to move return a per-item error and are left
+// untouched, while the valid ones are applied and committed together.
+// Results are returned in request order (duplicate IDs are collapsed).
+// rejectionReason, rejectionStage and reason apply to every card as in
+// MoveCard; without rejectionStage each card's stage is the status it leaves.
+func (s *Service) BulkMove(ctx context.Context, userID string, appIDs []string, newStatusStr, rejectionReason, rejectionStage, reason string) ([]BulkMoveResult, error) {
+	newStatus, err := ParseStatus(newStatusStr)
+	if err != nil {
+		return nil, &ValidationError{Msg: err.Error()}
+	}
+	rej, err := parseRejection(newStatus, rejectionReason, rejectionStage)
+	if err != nil {
+		return nil, err
+	}
+	if reason, err = cleanText("reason", reason, maxMoveReasonLen); err != nil {
+		return nil, err
+	}
+	ids := dedupe(appIDs)
+	if len(ids) == 0 {
+		return nil, &ValidationError{Msg: "application_ids must not be empty"}
+	}
+	if len(ids) > maxBulkMove {
+		return nil, &ValidationError{Msg: fmt.Sprintf("at most %d applications can be moved at once", maxBulkMove)}
+	}
+
+	// Lock every targeted row up front, in a fixed order, so the validation
+	// below cannot race with a concurrent MoveCard or deadlock with another
+	// BulkMove.
+	locking := slices.Sorted(slices.Values(ids))
+	var results []BulkMoveResult
+	err = s.repo.InTx(ctx, func(tx ApplicationTx) error {
+		policy, err := s.transitionPolicy(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		found := make(map[string]CardState, len(ids))
+		for _, id := range locking {
+			cur, err := tx.LockCard(ctx, userID, id)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("bulkMove lock: %w", err)
+			}
+			found[id] = cur
+		}
+
+		results = make([]BulkMoveResult, 0, len(ids))
+		for _, id := range ids {
+			res := BulkMoveResult{ApplicationID: id}
+			cur, ok := found[id]
+			if !ok {
+				res.Err = ErrNotFound
+			} else if err := checkMove(cur, newStatus, policy); err != nil {
+				res.Err = err
+			} else {
+				app, err := s.applyMove(ctx, tx, userID, id, cur.Status, newStatus, rej, reason)
+				if err != nil {
+					return fmt.Errorf("bulkMove update: %w", err)
+				}
+				if err := enqueueCardMoved(ctx, tx, userID, id, cur.Status, newStatus, ""); err != nil {
+					return fmt.Errorf("bulkMove: %w", err)
+				}
+				res.App = app
+			}
+			results = append(results, res)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moved := 0
+	for _, r := range results {
+		if r.App != nil {
+			moved++
+		}
+	}
+	slog.Info("bulk move", "userId", userID, "to", newStatus, "requested", len(ids), "moved", moved)
+
+	return results, nil
+}
+
+// dedupe returns ids without empty strings or repeats, preserving order.
+func dedupe(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Cached views of a user's applications are stored in Redis under keys that
+// embed the user's cache generation, a counter bumped by every mutation
+// (InvalidateUserCache). Bumping it orphans every view at once, and a view
+// computed from data read before a mutation is stored under the old
+// generation, where nobody looks it up again. The Gateway, which still
+// writes some applications directly, bumps it too.
+const (
+	// cacheViewTTL bounds how long a view can be served should a generation
+	// bump be missed (Redis unavailable during the mutation).
+	cacheViewTTL = 5 * time.Minute
+	// cacheGenerationTTL only needs to outlive the views: a generation that
+	// expires restarts from 0 long after the views of that era did.
+	cacheGenerationTTL = 24 * time.Hour
+)
+
+func cacheGenerationKey(userID string) string {
+	return "tracker:cache-gen:" + userID
+}
+
+// readThrough returns the user's view named view from the cache, or computes
+// it with load and caches it. Redis errors are non-fatal: load's result is
+// returned uncached.
+func readThrough[T any](ctx context.Context, s *Service, userID, view string, load func(ctx context.Context) (T, error)) (T, error) {
+	gen, err := s.rdb.Get(ctx, cacheGenerationKey(userID)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		gen = "0"
+	case err != nil:
+		slog.Warn("cache: generation lookup failed", "view", view, "userId", userID, "err", err)
+		return load(ctx)
+	}
+	key := fmt.Sprintf("tracker:view:%s:%s:%s", userID, gen, view)
+
+	cached, err := s.rdb.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		var v T
+		if err := json.Unmarshal(cached, &v); err == nil {
+			return v, nil
+		}
+		slog.Warn("cache: corrupt entry, recomputing", "view", view, "userId", userID)
+	case !errors.Is(err, redis.Nil):
+		slog.Warn("cache: lookup failed", "view", view, "userId", userID, "err", err)
+	}
+
+	v, err := load(ctx)
+	if err != nil {
+		return v, err
+	}
+	if payload, mErr := json.Marshal(v); mErr == nil {
+		if err := s.rdb.Set(ctx, key, payload, cacheViewTTL).Err(); err != nil {
+			slog.Warn("cache: store failed", "view", view, "userId", userID, "err", err)
+		}
+	}
+	return v, nil
+}
+
+// InvalidateUserCache drops the cached views of the user's applications by
+// bumping their generation. Called after every mutation of them; errors are
+// only logged, cacheViewTTL bounding how long a stale view survives.
+func (s *Service) InvalidateUserCache(ctx context.Context, userID string) {
+	key := cacheGenerationKey(userID)
+	pipe := s.rdb.TxPipeline()
+	pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, cacheGenerationTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("cache: invalidation failed", "userId", userID, "err", err)
+	}
+}
+
+// allStatuses lists every Status in board order.
+var allStatuses = []Status{
+	StatusToApply, StatusApplied, StatusInterview, StatusOffer,
+	StatusOnHold, StatusHired, StatusRejected, StatusWithdrawn,
+}
+
+// CountApplicationsByStatus returns how many of the user's active (not
+// archived) applications are in each status — every status present, 0 when
+// empty — for the board's column headers. Counts are cached (see
+// readThrough).
+func (s *Service) CountApplicationsByStatus(ctx context.Context, userID string) (map[Status]int, error) {
+	return readThrough(ctx, s, userID, "counts", func(ctx context.Context) (map[Status]int, error) {
+		rows, err := s.pool.Query(ctx,
+			`SELECT current_status::text, COUNT(*)
+			 FROM applications
+			 WHERE user_id = $1 AND archived_at IS NULL
+			 GROUP BY 1`,
+			userID)
+		if err != nil {
+			return nil, fmt.Errorf("countApplicationsByStatus query: %w", err)
+		}
+		defer rows.Close()
+
+		counts := make(map[Status]int, len(allStatuses))
+		for _, st := range allStatuses {
+			counts[st] = 0
+		}
+		for rows.Next() {
+			var (
+				st Status
+				n  int
+			)
+			if err := rows.Scan(&st, &n); err != nil {
+				return nil, fmt.Errorf("countApplicationsByStatus scan: %w", err)
+			}
+			counts[st] = n
+		}
+		return counts, rows.Err()
+	})
+}
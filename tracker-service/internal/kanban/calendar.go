@@ -0,0 +1,218 @@
+package kanban
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CalendarFeed is a user's secret iCalendar feed. Anyone knowing Token can
+// read the feed, so it is only shown to its owner and can be rotated.
+type CalendarFeed struct {
+	// Token is only set on the feed GetCalendarFeed creates and the one
+	// RotateCalendarFeedToken returns: only its hash is stored.
+	Token     string    `json:"token,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Path is the feed's URL path on the public API host, "" without Token.
+func (f CalendarFeed) Path() string {
+	if f.Token == "" {
+		return ""
+	}
+	return "/calendar/" + f.Token + ".ics"
+}
+
+// calendarHistory is how far back the feed keeps past events.
+const calendarHistory = 90 * 24 * time.Hour
+
+// Event durations, as the tracker only stores start times.
+const (
+	relanceEventDuration   = 15 * time.Minute
+	interviewEventDuration = time.Hour
+	deadlineEventDuration  = 30 * time.Minute
+)
+
+// ErrCalendarFeedNotFound is returned for unknown (or rotated) feed tokens.
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// GetCalendarFeed returns the user's feed, creating it on first use. Only
+// the feed it creates carries its token: an existing feed's URL cannot be
+// shown again, RotateCalendarFeedToken gives it a new one.
+func (s *Service) GetCalendarFeed(ctx context.Context, userID string) (*CalendarFeed, error) {
+	token := newCalendarToken()
+	f := CalendarFeed{Token: token}
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO calendar_feeds (user_id, token_hash) VALUES ($1, $2)
+		 ON CONFLICT (user_id) DO NOTHING
+		 RETURNING created_at`,
+		userID, hashToken(token),
+	).Scan(&f.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		f.Token = ""
+		err = s.pool.QueryRow(ctx,
+			`SELECT created_at FROM calendar_feeds WHERE user_id = $1`, userID,
+		).Scan(&f.CreatedAt)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getCalendarFeed: %w", err)
+	}
+	return &f, nil
+}
+
+// RotateCalendarFeedToken replaces the user's feed token, e.g. after the URL
+// leaked or to show it again. Calendars subscribed to the old URL stop
+// receiving updates.
+func (s *Service) RotateCalendarFeedToken(ctx context.Context, userID string) (*CalendarFeed, error) {
+	token := newCalendarToken()
+	f := CalendarFeed{Token: token}
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO calendar_feeds (user_id, token_hash) VALUES ($1, $2)
+		 ON CONFLICT (user_id) DO UPDATE
+		 SET token_hash = EXCLUDED.token_hash, ics = NULL, fingerprint = NULL, generated_at = NULL, created_at = NOW()
+		 RETURNING created_at`,
+		userID, hashToken(token),
+	).Scan(&f.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("rotateCalendarFeedToken: %w", err)
+	}
+	return &f, nil
+}
+
+// RenderCalendarFeed returns the iCalendar document of the feed identified by
+// token: the owner's relance reminders, interviews and offer deadlines of
+// non-archived applications, from calendarHistory ago onwards. The rendering
+// is cached and regenerated once any of the owner's applications changed.
+func (s *Service) RenderCalendarFeed(ctx context.Context, token string) (string, error) {
+	if token == "" || len(token) > 64 {
+		return "", ErrCalendarFeedNotFound
+	}
+
+	var (
+		userID, cachedFingerprint string
+		cached                    *string
+		fingerprint               string
+	)
+	err := s.pool.QueryRow(ctx,
+		`SELECT f.user_id::text, f.ics, COALESCE(f.fingerprint, ''),
+		        (SELECT COUNT(*)::text || '/' || COALESCE(MAX(a.updated_at)::text, '')
+		         FROM applications a WHERE a.user_id = f.user_id)
+		 FROM calendar_feeds f WHERE f.token_hash = $1`,
+		hashToken(token),
+	).Scan(&userID, &cached, &cachedFingerprint, &fingerprint)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("renderCalendarFeed: %w", err)
+	}
+	if cached != nil && cachedFingerprint == fingerprint {
+		return *cached, nil
+	}
+
+	events, err := s.calendarEvents(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	ics := renderICS(events, time.Now())
+	if _, err := s.pool.Exec(ctx,
+		`UPDATE calendar_feeds SET ics = $2, fingerprint = $3, generated_at = NOW() WHERE user_id = $1`,
+		userID, ics, fingerprint,
+	); err != nil {
+		return "", fmt.Errorf("renderCalendarFeed cache: %w", err)
+	}
+	return ics, nil
+}
+
+// calendarEvents lists the events of the user's feed by start time.
+func (s *Service) calendarEvents(ctx context.Context, userID string) ([]calendarEvent, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT kind, id, at, title, company, round, type, interviewer, outcome FROM (
+		   SELECT 'relance' AS kind, a.id::text AS id, a.relance_reminder_at AS at,
+		          `+jobTitleExpr+` AS title, `+jobCompanyExpr+` AS company,
+		          0 AS round, '' AS type, '' AS interviewer, '' AS outcome
+		   FROM applications a LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		   WHERE a.user_id = $1 AND a.archived_at IS NULL AND a.relance_reminder_at >= $2
+		   UNION ALL
+		   SELECT 'interview', i.id::text, i.scheduled_at,
+		          `+jobTitleExpr+`, `+jobCompanyExpr+`,
+		          i.round, i.type, COALESCE(i.interviewer, ''), i.outcome
+		   FROM interviews i
+		   JOIN applications a ON a.id = i.application_id
+		   LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		   WHERE i.user_id = $1 AND a.archived_at IS NULL AND i.scheduled_at >= $2
+		   UNION ALL
+		   SELECT 'offer', a.id::text, o.response_deadline,
+		          `+jobTitleExpr+`, `+jobCompanyExpr+`,
+		          0, '', '', ''
+		   FROM offers o
+		   JOIN applications a ON a.id = o.application_id
+		   LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		   WHERE o.user_id = $1 AND a.archived_at IS NULL AND a.current_status = 'OFFER'
+		     AND o.response_deadline >= $2
+		 ) e
+		 ORDER BY at, kind, id`,
+		userID, time.Now().Add(-calendarHistory))
+	if err != nil {
+		return nil, fmt.Errorf("calendarEvents query: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]calendarEvent, 0)
+	for rows.Next() {
+		var (
+			kind, id, title, company, typ, interviewer, outcome string
+			at                                                  time.Time
+			round                                               int32
+		)
+		if err := rows.Scan(&kind, &id, &at, &title, &company, &round, &typ, &interviewer, &outcome); err != nil {
+			return nil, fmt.Errorf("calendarEvents scan: %w", err)
+		}
+		job := jobLabel(title, company)
+		e := calendarEvent{UID: kind + "-" + id + "@jobmate", Kind: kind, SourceID: id, Start: at}
+		switch kind {
+		case "relance":
+			e.Duration = relanceEventDuration
+			e.Summary = "Follow up: " + job
+		case "interview":
+			e.Duration = interviewEventDuration
+			e.Summary = fmt.Sprintf("Interview round %d (%s): %s", round, typ, job)
+			if interviewer != "" {
+				e.Description = "With " + interviewer
+			}
+			e.Cancelled = outcome == OutcomeCancelled
+		case "offer":
+			e.Duration = deadlineEventDuration
+			e.Summary = "Offer deadline: " + job
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// jobLabel names a job in calendar events: "Title — Company", whichever is known.
+func jobLabel(title, company string) string {
+	switch {
+	case title != "" && company != "":
+		return title + " — " + company
+	case title != "":
+		return title
+	case company != "":
+		return company
+	}
+	return "application"
+}
+
+// newCalendarToken returns an unguessable URL-safe feed token.
+func newCalendarToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("crypto/rand: %v", err)) // never fails on supported platforms
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
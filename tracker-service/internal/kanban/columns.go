@@ -0,0 +1,236 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Column is a user-defined board column ("Technical Test", "Reference
+// Check", …). Every column belongs to one canonical Status: the state machine
+// still works on statuses, columns only subdivide them on the board.
+// Cards without a column sit in their status' default lane.
+type Column struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Status    Status    `json:"status"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ColumnUpdate is a partial update: nil fields are left unchanged.
+type ColumnUpdate struct {
+	Name     *string
+	Position *int
+}
+
+const (
+	maxColumnsPerUser = 50
+	maxColumnNameLen  = 64
+)
+
+// ErrColumnNotFound is returned when a column is missing or owned by someone else.
+var ErrColumnNotFound = fmt.Errorf("column not found")
+
+const columnSelect = `SELECT id::text, name, status::text, position, created_at, updated_at FROM board_columns`
+
+func scanColumn(row pgx.Row) (*Column, error) {
+	var (
+		c      Column
+		status string
+	)
+	if err := row.Scan(&c.ID, &c.Name, &status, &c.Position, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	c.Status = Status(status)
+	return &c, nil
+}
+
+// ListColumns returns the user's custom columns ordered by status, then position.
+func (s *Service) ListColumns(ctx context.Context, userID string) ([]Column, error) {
+	rows, err := s.pool.Query(ctx,
+		columnSelect+` WHERE user_id = $1 ORDER BY status, position, created_at`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listColumns query: %w", err)
+	}
+	defer rows.Close()
+
+	cols := make([]Column, 0)
+	for rows.Next() {
+		c, err := scanColumn(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listColumns scan: %w", err)
+		}
+		cols = append(cols, *c)
+	}
+	return cols, rows.Err()
+}
+
+// CreateColumn adds a custom column under the given canonical status.
+func (s *Service) CreateColumn(ctx context.Context, userID, name, statusStr string, position int) (*Column, error) {
+	st, err := ParseStatus(statusStr)
+	if err != nil {
+		return nil, &ValidationError{Msg: err.Error()}
+	}
+	name, err = validateColumnName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	if err := s.pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM board_columns WHERE user_id = $1`, userID,
+	).Scan(&count); err != nil {
+		return nil, fmt.Errorf("createColumn count: %w", err)
+	}
+	if count >= maxColumnsPerUser {
+		return nil, &ValidationError{Msg: fmt.Sprintf("at most %d custom columns are allowed", maxColumnsPerUser)}
+	}
+
+	c, err := scanColumn(s.pool.QueryRow(ctx,
+		`INSERT INTO board_columns (user_id, name, status, position)
+		 VALUES ($1, $2, $3::application_status, $4)
+		 ON CONFLICT (user_id, name) DO NOTHING
+		 RETURNING id::text, name, status::text, position, created_at, updated_at`,
+		userID, name, string(st), position,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &ValidationError{Msg: fmt.Sprintf("a column named %q already exists", name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("createColumn: %w", err)
+	}
+	return c, nil
+}
+
+// UpdateColumn renames and/or repositions a column. Its status is fixed:
+// cards in it would otherwise change status without a transition.
+func (s *Service) UpdateColumn(ctx context.Context, userID, columnID string, upd ColumnUpdate) (*Column, error) {
+	if upd.Name != nil {
+		name, err := validateColumnName(*upd.Name)
+		if err != nil {
+			return nil, err
+		}
+		upd.Name = &name
+	}
+
+	c, err := scanColumn(s.pool.QueryRow(ctx,
+		`UPDATE board_columns
+		 SET name       = COALESCE($1, name),
+		     position   = COALESCE($2, position),
+		     updated_at = NOW()
+		 WHERE id = $3 AND user_id = $4
+		 RETURNING id::text, name, status::text, position, created_at, updated_at`,
+		upd.Name, upd.Position, columnID, userID,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrColumnNotFound
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, &ValidationError{Msg: fmt.Sprintf("a column named %q already exists", *upd.Name)}
+		}
+		return nil, fmt.Errorf("updateColumn: %w", err)
+	}
+	return c, nil
+}
+
+// DeleteColumn removes a column. Cards in it fall back to their status'
+// default lane (applications.column_id is ON DELETE SET NULL).
+func (s *Service) DeleteColumn(ctx context.Context, userID, columnID string) error {
+	tag, err := s.pool.Exec(ctx,
+		`DELETE FROM board_columns WHERE id = $1 AND user_id = $2`, columnID, userID)
+	if err != nil {
+		return fmt.Errorf("deleteColumn: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrColumnNotFound
+	}
+	return nil
+}
+
+// MoveToColumn places a card in a custom column, or back into its status'
+// default lane when columnID is empty. When the column belongs to another
+// status the card is transitioned first, under the usual state machine rules.
+func (s *Service) MoveToColumn(ctx context.Context, userID, appID, columnID string) (*Application, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("moveToColumn begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	cur, err := scanCardState(tx.QueryRow(ctx,
+		`SELECT `+cardStateColumns+` FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		appID, userID,
+	))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	target := cur.Status
+	if columnID != "" {
+		col, err := scanColumn(tx.QueryRow(ctx,
+			columnSelect+` WHERE id = $1 AND user_id = $2`, columnID, userID))
+		if err != nil {
+			return nil, ErrColumnNotFound
+		}
+		target = col.Status
+	}
+
+	moved := target != cur.Status
+	if moved {
+		policy, err := s.transitionPolicy(ctx, s.pgTx(tx), userID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkMove(cur, target, policy); err != nil {
+			return nil, err
+		}
+		if _, err := s.applyMove(ctx, s.pgTx(tx), userID, appID, cur.Status, target, Rejection{}, ""); err != nil {
+			return nil, fmt.Errorf("moveToColumn move: %w", err)
+		}
+	} else if cur.Archived {
+		return nil, &ValidationError{Msg: "application is archived — restore it before moving"}
+	}
+
+	var app Application
+	err = tx.QueryRow(ctx,
+		`WITH upd AS (
+		   UPDATE applications
+		   SET column_id = NULLIF($1, '')::uuid, updated_at = NOW()
+		   WHERE id = $2 AND user_id = $3
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		columnID, appID, userID,
+	).Scan(s.appScanDest(&app)...)
+	if err != nil {
+		return nil, fmt.Errorf("moveToColumn update: %w", err)
+	}
+	if err := enqueueCardMoved(ctx, s.pgTx(tx), userID, appID, cur.Status, target, columnID); err != nil {
+		return nil, fmt.Errorf("moveToColumn: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("moveToColumn commit: %w", err)
+	}
+	return &app, nil
+}
+
+func validateColumnName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", &ValidationError{Msg: "column name must not be empty"}
+	}
+	if utf8.RuneCountInString(name) > maxColumnNameLen {
+		return "", &ValidationError{Msg: fmt.Sprintf("column name must be at most %d characters", maxColumnNameLen)}
+	}
+	return name, nil
+}
@@ -0,0 +1,251 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CompanySummary aggregates a user's applications to one company. Companies
+// are matched on their name, case- and whitespace-insensitively.
+type CompanySummary struct {
+	Name             string           `json:"name"` // as spelled on the most recent application
+	ApplicationCount int              `json:"applicationCount"`
+	ActiveCount      int              `json:"activeCount"` // on the board and not closed
+	RejectedCount    int              `json:"rejectedCount"`
+	StatusCounts     map[string]int32 `json:"statusCounts"` // archived applications included
+	ContactCount     int              `json:"contactCount"`
+	LastActivityAt   time.Time        `json:"lastActivityAt"`
+}
+
+// CompanyOverview is everything a user has on record with one company.
+type CompanyOverview struct {
+	CompanySummary
+	Applications []Application `json:"applications"` // most recently updated first
+	Contacts     []Contact     `json:"contacts"`
+	Notes        []Note        `json:"notes"` // newest first
+}
+
+// maxCompanyNotes caps the notes returned by GetCompanyOverview.
+const maxCompanyNotes = 200
+
+// ErrCompanyNotFound is returned when the user has no application to a company.
+var ErrCompanyNotFound = fmt.Errorf("company not found")
+
+// companyApp is the light view of an application the company aggregation
+// works from.
+type companyApp struct {
+	ID        string
+	Company   string
+	Status    Status
+	Archived  bool
+	UpdatedAt time.Time
+}
+
+// ListCompanies returns one summary per company the user applied to, most
+// recently active first. Archived applications count as history.
+func (s *Service) ListCompanies(ctx context.Context, userID string) ([]CompanySummary, error) {
+	apps, err := s.companyApps(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	contacts, err := s.ListContacts(ctx, userID, ContactFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeCompanies(apps, contacts), nil
+}
+
+// GetCompanyOverview returns the user's full history with a company: every
+// application (archived and closed ones included), the contacts working
+// there or linked to those applications, and their notes.
+func (s *Service) GetCompanyOverview(ctx context.Context, userID, company string) (*CompanyOverview, error) {
+	key := companyKey(company)
+	if key == "" {
+		return nil, &ValidationError{Field: "company", Msg: "company must not be empty"}
+	}
+
+	all, err := s.companyApps(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		apps []companyApp
+		ids  []string
+	)
+	for _, a := range all {
+		if companyKey(a.Company) == key {
+			apps = append(apps, a)
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(apps) == 0 {
+		return nil, ErrCompanyNotFound
+	}
+
+	allContacts, err := s.ListContacts(ctx, userID, ContactFilter{})
+	if err != nil {
+		return nil, err
+	}
+	contacts := companyContacts(key, ids, allContacts)
+
+	ov := &CompanyOverview{
+		CompanySummary: summarizeCompanies(apps, contacts)[0],
+		Contacts:       contacts,
+	}
+	if ov.Applications, err = s.applicationsByID(ctx, userID, ids); err != nil {
+		return nil, err
+	}
+	if ov.Notes, err = s.notesOf(ctx, userID, ids); err != nil {
+		return nil, err
+	}
+	return ov, nil
+}
+
+// companyApps lists every application of the user with its company.
+func (s *Service) companyApps(ctx context.Context, userID string) ([]companyApp, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT a.id::text, `+jobCompanyExpr+`, a.current_status::text, a.archived_at IS NOT NULL, a.updated_at
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("companyApps query: %w", err)
+	}
+	defer rows.Close()
+
+	var apps []companyApp
+	for rows.Next() {
+		var (
+			a      companyApp
+			status string
+		)
+		if err := rows.Scan(&a.ID, &a.Company, &status, &a.Archived, &a.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("companyApps scan: %w", err)
+		}
+		a.Status = Status(status)
+		apps = append(apps, a)
+	}
+	return apps, rows.Err()
+}
+
+func (s *Service) applicationsByID(ctx context.Context, userID string, ids []string) ([]Application, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+appListColumns("a")+`
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1 AND a.id::text = ANY($2)
+		 ORDER BY a.updated_at DESC, a.id`,
+		userID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("applicationsByID query: %w", err)
+	}
+	defer rows.Close()
+
+	apps := make([]Application, 0, len(ids))
+	for rows.Next() {
+		var a Application
+		if err := rows.Scan(s.appScanDest(&a)...); err != nil {
+			return nil, fmt.Errorf("applicationsByID scan: %w", err)
+		}
+		apps = append(apps, a)
+	}
+	return apps, rows.Err()
+}
+
+func (s *Service) notesOf(ctx context.Context, userID string, appIDs []string) ([]Note, error) {
+	rows, err := s.pool.Query(ctx,
+		noteSelect+` WHERE user_id = $1 AND application_id::text = ANY($2)
+		 ORDER BY created_at DESC, id DESC LIMIT $3`,
+		userID, appIDs, maxCompanyNotes)
+	if err != nil {
+		return nil, fmt.Errorf("notesOf query: %w", err)
+	}
+	defer rows.Close()
+
+	notes := make([]Note, 0)
+	for rows.Next() {
+		n, err := s.scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("notesOf scan: %w", err)
+		}
+		notes = append(notes, *n)
+	}
+	return notes, rows.Err()
+}
+
+// companyKey is the matching key of a company name: lower-cased, with
+// whitespace trimmed and collapsed. "" for unknown companies.
+func companyKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// companyContacts keeps the contacts working at the company with key, or
+// linked to one of its applications.
+func companyContacts(key string, appIDs []string, contacts []Contact) []Contact {
+	linked := make(map[string]bool, len(appIDs))
+	for _, id := range appIDs {
+		linked[id] = true
+	}
+	out := make([]Contact, 0)
+	for _, c := range contacts {
+		match := companyKey(c.Company) == key
+		for _, id := range c.ApplicationIDs {
+			match = match || linked[id]
+		}
+		if match {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// summarizeCompanies groups apps by company, most recently active first.
+// Applications without a known company are left out.
+func summarizeCompanies(apps []companyApp, contacts []Contact) []CompanySummary {
+	byKey := make(map[string]*CompanySummary)
+	appIDs := make(map[string][]string)
+	for _, a := range apps {
+		key := companyKey(a.Company)
+		if key == "" {
+			continue
+		}
+		cs, ok := byKey[key]
+		if !ok {
+			cs = &CompanySummary{StatusCounts: make(map[string]int32)}
+			byKey[key] = cs
+		}
+		if cs.Name == "" || a.UpdatedAt.After(cs.LastActivityAt) {
+			cs.Name = strings.Join(strings.Fields(a.Company), " ")
+			cs.LastActivityAt = a.UpdatedAt
+		}
+		cs.ApplicationCount++
+		cs.StatusCounts[string(a.Status)]++
+		switch a.Status {
+		case StatusRejected:
+			cs.RejectedCount++
+		case StatusHired, StatusWithdrawn:
+		default:
+			if !a.Archived {
+				cs.ActiveCount++
+			}
+		}
+		appIDs[key] = append(appIDs[key], a.ID)
+	}
+
+	out := make([]CompanySummary, 0, len(byKey))
+	for key, cs := range byKey {
+		cs.ContactCount = len(companyContacts(key, appIDs[key], contacts))
+		out = append(out, *cs)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].LastActivityAt.Equal(out[j].LastActivityAt) {
+			return out[i].LastActivityAt.After(out[j].LastActivityAt)
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
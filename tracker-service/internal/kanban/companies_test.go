@@ -0,0 +1,50 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestSummarizeCompanies(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2026, 3, n, 0, 0, 0, 0, time.UTC) }
+	apps := []kanban.CompanyApp{
+		{ID: "a1", Company: "acme corp", Status: kanban.StatusRejected, UpdatedAt: day(1)},
+		{ID: "a2", Company: "  ACME   Corp ", Status: kanban.StatusApplied, UpdatedAt: day(5)},
+		{ID: "a3", Company: "Acme Corp", Status: kanban.StatusInterview, Archived: true, UpdatedAt: day(3)},
+		{ID: "b1", Company: "Globex", Status: kanban.StatusOffer, UpdatedAt: day(2)},
+		{ID: "x1", Company: "", Status: kanban.StatusToApply, UpdatedAt: day(9)},
+	}
+	contacts := []kanban.Contact{
+		{ID: "c1", Company: "ACME CORP"},
+		{ID: "c2", Company: "Recruiting Ltd", ApplicationIDs: []string{"a3"}},
+		{ID: "c3", Company: "Initech"},
+	}
+
+	got := kanban.SummarizeCompanies(apps, contacts)
+	if len(got) != 2 {
+		t.Fatalf("got %d companies, want 2 (unknown company skipped): %+v", len(got), got)
+	}
+
+	acme := got[0]
+	if acme.Name != "ACME Corp" {
+		t.Errorf("name = %q, want the most recent spelling %q", acme.Name, "ACME Corp")
+	}
+	if acme.ApplicationCount != 3 || acme.ActiveCount != 1 || acme.RejectedCount != 1 {
+		t.Errorf("counts = %d apps / %d active / %d rejected, want 3/1/1",
+			acme.ApplicationCount, acme.ActiveCount, acme.RejectedCount)
+	}
+	if acme.StatusCounts["INTERVIEW"] != 1 || acme.StatusCounts["REJECTED"] != 1 {
+		t.Errorf("status counts = %v", acme.StatusCounts)
+	}
+	if acme.ContactCount != 2 {
+		t.Errorf("contacts = %d, want 2 (by company + by link)", acme.ContactCount)
+	}
+	if !acme.LastActivityAt.Equal(day(5)) {
+		t.Errorf("last activity = %v, want %v", acme.LastActivityAt, day(5))
+	}
+	if got[1].Name != "Globex" || got[1].ActiveCount != 1 {
+		t.Errorf("second company = %+v, want active Globex", got[1])
+	}
+}
@@ -0,0 +1,72 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SearchConfigStats is the funnel of one search config: how many offers
+// Discovery found for it and how far the applications made from them went.
+type SearchConfigStats struct {
+	SearchConfigID string    `json:"searchConfigId"`
+	JobTitles      []string  `json:"jobTitles"`
+	Locations      []string  `json:"locations"`
+	IsActive       bool      `json:"isActive"`
+	CreatedAt      time.Time `json:"createdAt"`
+
+	Discovered   int `json:"discovered"`   // offers found, still in the feed
+	Applications int `json:"applications"` // cards created from them, archived ones included
+	Applied      int `json:"applied"`      // of which sent (moved past TO_APPLY, not rejected before applying)
+	Interviewed  int `json:"interviewed"`  // of which reached INTERVIEW
+	Hired        int `json:"hired"`        // of which reached HIRED
+}
+
+// GetSearchConfigStats returns the funnel of each of the user's search
+// configs, inactive ones included, those that produced the most hires, then
+// interviews, then applications first — so users see which titles and
+// locations yield results and can prune the rest. Offers another config
+// found first and linked to this one (job_feed_matches) count as its own.
+// Manually added jobs belong to no config and are not counted. Cards
+// rejected straight from TO_APPLY (rejection stage TO_APPLY) were never sent
+// and do not count as applied.
+func (s *Service) GetSearchConfigStats(ctx context.Context, userID string) ([]SearchConfigStats, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT sc.id::text, sc.job_titles, sc.locations, sc.is_active, sc.created_at,
+		        (SELECT COUNT(*) FROM job_feed f WHERE f.search_config_id = sc.id AND NOT f.is_manual)
+		          + (SELECT COUNT(*) FROM job_feed_matches m WHERE m.search_config_id = sc.id),
+		        COUNT(a.id),
+		        COUNT(a.id) FILTER (WHERE a.current_status <> 'TO_APPLY'
+		                              AND a.rejection_stage IS DISTINCT FROM 'TO_APPLY'),
+		        COUNT(a.id) FILTER (WHERE a.current_status <> 'TO_APPLY'
+		                              AND (a.current_status IN ('INTERVIEW', 'OFFER', 'HIRED')
+		                                   OR a.history_log @> '[{"to": "INTERVIEW"}]')),
+		        COUNT(a.id) FILTER (WHERE a.current_status = 'HIRED'
+		                              OR a.history_log @> '[{"to": "HIRED"}]')
+		 FROM search_configs sc
+		 LEFT JOIN LATERAL (
+		   SELECT f.id FROM job_feed f WHERE f.search_config_id = sc.id AND NOT f.is_manual
+		   UNION ALL
+		   SELECT m.job_feed_id FROM job_feed_matches m WHERE m.search_config_id = sc.id
+		 ) jf ON TRUE
+		 LEFT JOIN applications a ON a.job_feed_id = jf.id AND a.user_id = sc.user_id
+		 WHERE sc.user_id = $1
+		 GROUP BY sc.id
+		 ORDER BY 10 DESC, 9 DESC, 7 DESC, sc.created_at DESC`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("getSearchConfigStats query: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]SearchConfigStats, 0)
+	for rows.Next() {
+		var st SearchConfigStats
+		if err := rows.Scan(&st.SearchConfigID, &st.JobTitles, &st.Locations, &st.IsActive, &st.CreatedAt,
+			&st.Discovered, &st.Applications, &st.Applied, &st.Interviewed, &st.Hired); err != nil {
+			return nil, fmt.Errorf("getSearchConfigStats scan: %w", err)
+		}
+		stats = append(stats, st)
+	}
+	return stats, rows.Err()
+}
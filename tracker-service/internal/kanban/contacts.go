@@ -0,0 +1,406 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Contact is a recruiter, hiring manager or other person met during a job
+// search. It belongs to a user and may be linked to several applications,
+// e.g. a recruiter who sends more than one opening from the same company.
+type Contact struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Role            string     `json:"role"`
+	Email           string     `json:"email"`
+	LinkedInURL     string     `json:"linkedinUrl"`
+	Company         string     `json:"company"`
+	LastContactedAt *time.Time `json:"lastContactedAt"`
+	ApplicationIDs  []string   `json:"applicationIds"` // linked applications, oldest link first
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// ContactUpdate lists the contact fields to change; nil fields are left
+// untouched. A zero LastContactedAt clears the date.
+type ContactUpdate struct {
+	Name            *string
+	Role            *string
+	Email           *string
+	LinkedInURL     *string
+	Company         *string
+	LastContactedAt *time.Time
+}
+
+// ContactFilter narrows a ListContacts call. The zero value lists every
+// contact of the user.
+type ContactFilter struct {
+	// ApplicationID restricts results to contacts linked to that application.
+	ApplicationID string
+	// Company restricts results to one company, case-insensitively.
+	Company string
+}
+
+const (
+	maxContactNameLen    = 200
+	maxContactRoleLen    = 200
+	maxContactEmailLen   = 320
+	maxContactCompanyLen = 255
+	maxContactURLLen     = 2048
+)
+
+// ErrContactNotFound is returned when a contact is missing or owned by someone else.
+var ErrContactNotFound = fmt.Errorf("contact not found")
+
+const contactSelect = `SELECT c.id::text, c.name, COALESCE(c.role, ''), COALESCE(c.email, ''),
+	COALESCE(c.linkedin_url, ''), COALESCE(c.company, ''), c.last_contacted_at,
+	ARRAY(SELECT ac.application_id::text FROM application_contacts ac
+	      WHERE ac.contact_id = c.id ORDER BY ac.created_at, ac.application_id),
+	c.created_at, c.updated_at
+	FROM contacts c`
+
+func scanContact(row pgx.Row) (*Contact, error) {
+	var c Contact
+	if err := row.Scan(&c.ID, &c.Name, &c.Role, &c.Email, &c.LinkedInURL, &c.Company,
+		&c.LastContactedAt, &c.ApplicationIDs, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// CreateContact adds a contact and, when appID is set, links it to that
+// application. An empty Company then defaults to the application's company.
+func (s *Service) CreateContact(ctx context.Context, userID string, c Contact, appID string) (*Contact, error) {
+	c, err := normalizeContact(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *Contact
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		if appID != "" && c.Company == "" {
+			var app Application
+			err := tx.QueryRow(ctx,
+				`SELECT `+appColumns("a")+`
+				 FROM applications a
+				 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+				 WHERE a.id = $1 AND a.user_id = $2`,
+				appID, userID,
+			).Scan(s.appScanDest(&app)...)
+			if err != nil {
+				return ErrNotFound
+			}
+			c.Company = truncateRunes(app.Company, maxContactCompanyLen)
+		}
+
+		var id string
+		err := tx.QueryRow(ctx,
+			`INSERT INTO contacts (user_id, name, role, email, linkedin_url, company, last_contacted_at)
+			 VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), $7)
+			 RETURNING id::text`,
+			userID, c.Name, c.Role, c.Email, c.LinkedInURL, c.Company, c.LastContactedAt,
+		).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("createContact insert: %w", err)
+		}
+		if appID != "" {
+			if err := linkContact(ctx, tx, userID, id, appID); err != nil {
+				return err
+			}
+		}
+		created, err = scanContact(tx.QueryRow(ctx, contactSelect+` WHERE c.id = $1`, id))
+		if err != nil {
+			return fmt.Errorf("createContact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListContacts returns the user's contacts matching filter, by name.
+func (s *Service) ListContacts(ctx context.Context, userID string, filter ContactFilter) ([]Contact, error) {
+	if filter.ApplicationID != "" {
+		var exists bool
+		err := s.pool.QueryRow(ctx,
+			`SELECT EXISTS (SELECT 1 FROM applications WHERE id = $1 AND user_id = $2)`,
+			filter.ApplicationID, userID,
+		).Scan(&exists)
+		if err != nil || !exists {
+			return nil, ErrNotFound
+		}
+	}
+
+	rows, err := s.pool.Query(ctx,
+		contactSelect+`
+		 WHERE c.user_id = $1
+		   AND ($2 = '' OR EXISTS (SELECT 1 FROM application_contacts ac
+		                           WHERE ac.contact_id = c.id AND ac.application_id::text = $2))
+		   AND ($3 = '' OR lower(c.company) = lower($3))
+		 ORDER BY lower(c.name), c.id`,
+		userID, filter.ApplicationID, strings.TrimSpace(filter.Company))
+	if err != nil {
+		return nil, fmt.Errorf("listContacts query: %w", err)
+	}
+	defer rows.Close()
+
+	contacts := make([]Contact, 0)
+	for rows.Next() {
+		c, err := scanContact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listContacts scan: %w", err)
+		}
+		contacts = append(contacts, *c)
+	}
+	return contacts, rows.Err()
+}
+
+// UpdateContact applies the non-nil fields of upd to a contact.
+func (s *Service) UpdateContact(ctx context.Context, userID, contactID string, upd ContactUpdate) (*Contact, error) {
+	var c *Contact
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		cur, err := scanContact(tx.QueryRow(ctx,
+			contactSelect+` WHERE c.id = $1 AND c.user_id = $2 FOR UPDATE OF c`,
+			contactID, userID,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrContactNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("updateContact: %w", err)
+		}
+
+		next, err := normalizeContact(applyContactUpdate(*cur, upd))
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx,
+			`UPDATE contacts
+			 SET name = $2, role = NULLIF($3, ''), email = NULLIF($4, ''), linkedin_url = NULLIF($5, ''),
+			     company = NULLIF($6, ''), last_contacted_at = $7, updated_at = NOW()
+			 WHERE id = $1`,
+			contactID, next.Name, next.Role, next.Email, next.LinkedInURL, next.Company, next.LastContactedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("updateContact: %w", err)
+		}
+		c, err = scanContact(tx.QueryRow(ctx, contactSelect+` WHERE c.id = $1`, contactID))
+		if err != nil {
+			return fmt.Errorf("updateContact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteContact removes a contact and its links to applications.
+func (s *Service) DeleteContact(ctx context.Context, userID, contactID string) error {
+	return s.inTx(ctx, func(tx pgx.Tx) error {
+		c, err := scanContact(tx.QueryRow(ctx,
+			contactSelect+` WHERE c.id = $1 AND c.user_id = $2 FOR UPDATE OF c`,
+			contactID, userID,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrContactNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("deleteContact: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM contacts WHERE id = $1`, contactID); err != nil {
+			return fmt.Errorf("deleteContact: %w", err)
+		}
+		for _, appID := range c.ApplicationIDs {
+			if err := touchApplication(ctx, tx, userID, appID, []string{"contacts"}); err != nil {
+				return fmt.Errorf("deleteContact: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// LinkContact links a contact to an application. Linking twice is a no-op.
+func (s *Service) LinkContact(ctx context.Context, userID, contactID, appID string) (*Contact, error) {
+	var c *Contact
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		if err := ownContact(ctx, tx, userID, contactID); err != nil {
+			return err
+		}
+		if err := linkContact(ctx, tx, userID, contactID, appID); err != nil {
+			return err
+		}
+		var err error
+		c, err = scanContact(tx.QueryRow(ctx, contactSelect+` WHERE c.id = $1`, contactID))
+		if err != nil {
+			return fmt.Errorf("linkContact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnlinkContact removes the link between a contact and an application. The
+// contact itself is kept.
+func (s *Service) UnlinkContact(ctx context.Context, userID, contactID, appID string) (*Contact, error) {
+	var c *Contact
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		if err := ownContact(ctx, tx, userID, contactID); err != nil {
+			return err
+		}
+		tag, err := tx.Exec(ctx,
+			`DELETE FROM application_contacts ac
+			 USING applications a
+			 WHERE ac.contact_id = $1 AND ac.application_id = $2
+			   AND a.id = ac.application_id AND a.user_id = $3`,
+			contactID, appID, userID,
+		)
+		if err != nil {
+			return fmt.Errorf("unlinkContact: %w", err)
+		}
+		if tag.RowsAffected() > 0 {
+			if err := touchApplication(ctx, tx, userID, appID, []string{"contacts"}); err != nil {
+				return fmt.Errorf("unlinkContact: %w", err)
+			}
+		}
+		c, err = scanContact(tx.QueryRow(ctx, contactSelect+` WHERE c.id = $1`, contactID))
+		if err != nil {
+			return fmt.Errorf("unlinkContact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ownContact locks the contact row, or returns ErrContactNotFound.
+func ownContact(ctx context.Context, q querier, userID, contactID string) error {
+	var id string
+	err := q.QueryRow(ctx,
+		`SELECT id::text FROM contacts WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		contactID, userID,
+	).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrContactNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("contact lookup: %w", err)
+	}
+	return nil
+}
+
+// linkContact links a contact (already checked to belong to userID) to one
+// of the user's applications and reports the change on the application.
+func linkContact(ctx context.Context, q querier, userID, contactID, appID string) error {
+	var found, inserted bool
+	err := q.QueryRow(ctx,
+		`WITH app AS (
+		   SELECT id FROM applications WHERE id = $1 AND user_id = $2
+		 ), ins AS (
+		   INSERT INTO application_contacts (application_id, contact_id)
+		   SELECT id, $3 FROM app
+		   ON CONFLICT DO NOTHING
+		   RETURNING 1
+		 )
+		 SELECT EXISTS (SELECT 1 FROM app), EXISTS (SELECT 1 FROM ins)`,
+		appID, userID, contactID,
+	).Scan(&found, &inserted)
+	if err != nil || !found {
+		return ErrNotFound
+	}
+	if !inserted {
+		return nil
+	}
+	return touchApplication(ctx, q, userID, appID, []string{"contacts"})
+}
+
+func applyContactUpdate(c Contact, upd ContactUpdate) Contact {
+	if upd.Name != nil {
+		c.Name = *upd.Name
+	}
+	if upd.Role != nil {
+		c.Role = *upd.Role
+	}
+	if upd.Email != nil {
+		c.Email = *upd.Email
+	}
+	if upd.LinkedInURL != nil {
+		c.LinkedInURL = *upd.LinkedInURL
+	}
+	if upd.Company != nil {
+		c.Company = *upd.Company
+	}
+	if upd.LastContactedAt != nil {
+		c.LastContactedAt = upd.LastContactedAt
+	}
+	return c
+}
+
+// normalizeContact validates a contact's user-supplied fields: a name is
+// required, the email must be a bare address and the LinkedIn URL must point
+// to linkedin.com.
+func normalizeContact(c Contact) (Contact, error) {
+	var err error
+	if c.Name, err = cleanText("name", c.Name, maxContactNameLen); err != nil {
+		return c, err
+	}
+	if c.Name == "" {
+		return c, &ValidationError{Field: "name", Msg: "name must not be empty"}
+	}
+	if c.Role, err = cleanText("role", c.Role, maxContactRoleLen); err != nil {
+		return c, err
+	}
+	if c.Company, err = cleanText("company", c.Company, maxContactCompanyLen); err != nil {
+		return c, err
+	}
+
+	if c.Email, err = cleanText("email", c.Email, maxContactEmailLen); err != nil {
+		return c, err
+	}
+	if c.Email != "" {
+		addr, err := mail.ParseAddress(c.Email)
+		if err != nil || addr.Address != c.Email {
+			return c, &ValidationError{Field: "email", Msg: "email must be a plain address, e.g. jane@example.com"}
+		}
+	}
+
+	if c.LinkedInURL, err = cleanText("linkedin_url", c.LinkedInURL, maxContactURLLen); err != nil {
+		return c, err
+	}
+	if c.LinkedInURL != "" {
+		u, err := url.Parse(c.LinkedInURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+			(u.Hostname() != "linkedin.com" && !strings.HasSuffix(u.Hostname(), ".linkedin.com")) {
+			return c, &ValidationError{Field: "linkedin_url", Msg: "linkedin_url must be an http(s) linkedin.com URL"}
+		}
+	}
+
+	if c.LastContactedAt != nil && c.LastContactedAt.IsZero() {
+		c.LastContactedAt = nil
+	}
+	return c, nil
+}
+
+// truncateRunes cuts s to at most n characters.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,125 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CoverLetterVersion is one cover letter written to an application, by the
+// AI Coach or by restoring an earlier version. Versions are recorded by a
+// database trigger on applications.generated_cover_letter, so the column
+// always holds the latest one.
+type CoverLetterVersion struct {
+	ID            string    `json:"id"`
+	ApplicationID string    `json:"applicationId"`
+	Version       int       `json:"version"`
+	Text          string    `json:"text"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ErrCoverLetterVersionNotFound is returned when a version is missing or owned by someone else.
+var ErrCoverLetterVersionNotFound = fmt.Errorf("cover letter version not found")
+
+// ListCoverLetterVersions returns an application's cover letters, newest first.
+func (s *Service) ListCoverLetterVersions(ctx context.Context, userID, appID string) ([]CoverLetterVersion, error) {
+	if _, err := s.ownedJobFeedID(ctx, userID, appID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT id::text, application_id::text, version, body, created_at
+		 FROM cover_letter_versions
+		 WHERE application_id = $1 AND user_id = $2
+		 ORDER BY version DESC`,
+		appID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listCoverLetterVersions query: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]CoverLetterVersion, 0)
+	for rows.Next() {
+		var v CoverLetterVersion
+		if err := rows.Scan(&v.ID, &v.ApplicationID, &v.Version, s.sealed(&v.Text), &v.CreatedAt); err != nil {
+			return nil, fmt.Errorf("listCoverLetterVersions scan: %w", err)
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+// RegenerateCoverLetter asks the AI Coach for a new cover letter by
+// queuing CMD_GENERATE_COVER_LETTER. The result lands asynchronously as a
+// new version; previous versions are kept.
+func (s *Service) RegenerateCoverLetter(ctx context.Context, userID, appID string) error {
+	jobFeedID, err := s.ownedJobFeedID(ctx, userID, appID)
+	if err != nil {
+		return err
+	}
+	if jobFeedID == "" {
+		return &ValidationError{Msg: "application has no job offer to write a cover letter for"}
+	}
+
+	err = enqueueEvent(ctx, s.pool, "CMD_GENERATE_COVER_LETTER", map[string]string{
+		"type":          "CMD_GENERATE_COVER_LETTER",
+		"applicationId": appID,
+		"jobFeedId":     jobFeedID,
+		"userId":        userID,
+	})
+	if err != nil {
+		return fmt.Errorf("regenerateCoverLetter: %w", err)
+	}
+	return nil
+}
+
+// RestoreCoverLetterVersion makes an earlier version the current cover
+// letter again. The restore itself is recorded as a new version.
+func (s *Service) RestoreCoverLetterVersion(ctx context.Context, userID, versionID string) (*Application, error) {
+	var app Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx,
+			`WITH upd AS (
+			   UPDATE applications a
+			   SET generated_cover_letter = v.body, updated_at = NOW()
+			   FROM cover_letter_versions v
+			   WHERE v.id = $1 AND v.user_id = $2 AND a.id = v.application_id
+			   RETURNING a.*
+			 )
+			 SELECT `+appColumns("upd")+`
+			 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+			versionID, userID,
+		).Scan(s.appScanDest(&app)...)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCoverLetterVersionNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return enqueueApplicationUpdated(ctx, tx, userID, &app, "generated_cover_letter")
+	})
+	if errors.Is(err, ErrCoverLetterVersionNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("restoreCoverLetterVersion: %w", err)
+	}
+	return &app, nil
+}
+
+// ownedJobFeedID verifies that appID belongs to userID and returns its
+// job_feed_id ("" when the job was deleted).
+func (s *Service) ownedJobFeedID(ctx context.Context, userID, appID string) (string, error) {
+	var jobFeedID string
+	err := s.pool.QueryRow(ctx,
+		`SELECT COALESCE(job_feed_id::text, '') FROM applications WHERE id = $1 AND user_id = $2`,
+		appID, userID,
+	).Scan(&jobFeedID)
+	if err != nil {
+		return "", ErrNotFound
+	}
+	return jobFeedID, nil
+}
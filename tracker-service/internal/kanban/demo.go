@@ -0,0 +1,256 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrBoardNotEmpty is returned by SeedDemoBoard when the user already has
+// applications: the demo board is never mixed with real cards.
+var ErrBoardNotEmpty = errors.New("the user already has applications")
+
+// demoCard is one application of the demo board. The card was created
+// AgeDays ago and went through Path after TO_APPLY, its moves evenly spread
+// since then.
+type demoCard struct {
+	Title, Company, Location string
+	AgeDays                  int
+	Path                     []Status
+	Priority                 Priority
+	Score                    int
+	Rejection                string   // with a move to REJECTED
+	Notes                    []string // oldest first
+	RemindInDays             int      // relance reminder, 0 = none
+}
+
+// demoBoard is a job search a few weeks in, with cards in every status.
+// The companies are fictional.
+var demoBoard = []demoCard{
+	{
+		Title: "Backend Engineer (Go)", Company: "Northwind Logistics", Location: "Paris",
+		AgeDays: 1, Priority: PriorityHigh, Score: 88,
+		Notes: []string{"Stack matches: Go, PostgreSQL, Kafka. Apply before Friday."},
+	},
+	{
+		Title: "Platform Engineer", Company: "Bluepeak Energy", Location: "Lyon (hybrid)",
+		AgeDays: 3, Priority: PriorityMedium, Score: 74,
+	},
+	{
+		Title: "Software Engineer, Payments", Company: "Tallis Bank", Location: "Remote",
+		AgeDays: 12, Path: []Status{StatusApplied}, Priority: PriorityMedium, Score: 69,
+		Notes:        []string{"Applied through the careers page, referral from a former colleague."},
+		RemindInDays: 2,
+	},
+	{
+		Title: "Site Reliability Engineer", Company: "Orbital Games", Location: "Bordeaux",
+		AgeDays: 9, Path: []Status{StatusApplied}, Priority: PriorityLow, Score: 61,
+	},
+	{
+		Title: "Full-Stack Developer", Company: "Greenleaf Health", Location: "Nantes (hybrid)",
+		AgeDays: 20, Path: []Status{StatusApplied, StatusInterview}, Priority: PriorityHigh, Score: 82,
+		Notes: []string{
+			"Recruiter call: team of 6, React + Go, on-call one week in six.",
+			"Technical interview on Thursday — review system design (rate limiting, queues).",
+		},
+		RemindInDays: 4,
+	},
+	{
+		Title: "Data Engineer", Company: "Harbor Analytics", Location: "Lille",
+		AgeDays: 27, Path: []Status{StatusApplied, StatusInterview, StatusOffer}, Priority: PriorityHigh, Score: 79,
+		Notes: []string{
+			"Final round went well, they asked about the notice period.",
+			"Offer received: answer expected within a week. Negotiate remote days.",
+		},
+		RemindInDays: 1,
+	},
+	{
+		Title: "Backend Developer", Company: "Quillon Software", Location: "Toulouse",
+		AgeDays: 35, Path: []Status{StatusApplied, StatusOnHold}, Priority: PriorityMedium, Score: 66,
+		Notes: []string{"Hiring freeze until next quarter, the recruiter will reach out."},
+	},
+	{
+		Title: "Junior DevOps Engineer", Company: "Cobalt Mobility", Location: "Marseille",
+		AgeDays: 30, Path: []Status{StatusApplied, StatusRejected}, Priority: PriorityLow, Score: 52,
+		Rejection: RejectionNoResponse,
+	},
+	{
+		Title: "Software Engineer", Company: "Vantage Retail", Location: "Paris",
+		AgeDays: 40, Path: []Status{StatusApplied, StatusInterview, StatusRejected}, Priority: PriorityMedium, Score: 71,
+		Rejection: RejectionAfterInterview,
+		Notes:     []string{"Feedback: strong on backend, wanted more frontend experience."},
+	},
+	{
+		Title: "API Developer", Company: "Meridian Travel", Location: "Remote",
+		AgeDays: 25, Path: []Status{StatusApplied, StatusWithdrawn}, Priority: PriorityLow, Score: 58,
+		Notes: []string{"Withdrew: the role turned out to be mostly maintenance of a legacy PHP app."},
+	},
+	{
+		Title: "Go Developer", Company: "Lumen Robotics", Location: "Grenoble",
+		AgeDays: 60, Path: []Status{StatusApplied, StatusInterview, StatusOffer, StatusHired}, Priority: PriorityHigh, Score: 91,
+		Notes: []string{
+			"Two technical rounds and a meeting with the CTO.",
+			"Accepted the offer, starting on the 1st.",
+		},
+	},
+}
+
+// SeedDemoBoard fills an empty board with sample applications in every
+// status, with their history, notes and reminders, so that demos and
+// end-to-end tests don't start from an empty kanban. It is meant for
+// development and demo environments only (see trackerctl seed); no AI
+// analysis is requested, the cards carry a canned one. It returns the
+// number of cards created.
+func (s *Service) SeedDemoBoard(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, &ValidationError{Field: "user_id", Msg: "is required"}
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("seedDemoBoard begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	var userExists, hasApps bool
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM users WHERE id = $1),
+		        EXISTS (SELECT 1 FROM applications WHERE user_id = $1)`,
+		userID,
+	).Scan(&userExists, &hasApps)
+	if err != nil {
+		return 0, fmt.Errorf("seedDemoBoard: %w", err)
+	}
+	if !userExists {
+		return 0, ErrNotFound
+	}
+	if hasApps {
+		return 0, ErrBoardNotEmpty
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, c := range demoBoard {
+		if err := s.seedDemoCard(ctx, tx, userID, c, now); err != nil {
+			return 0, fmt.Errorf("seedDemoBoard %s: %w", c.Company, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("seedDemoBoard commit: %w", err)
+	}
+	return len(demoBoard), nil
+}
+
+// seedDemoCard inserts one card of the demo board, as a manual job, and
+// queues its EVENT_APPLICATION_CREATED.
+func (s *Service) seedDemoCard(ctx context.Context, tx querier, userID string, c demoCard, now time.Time) error {
+	created := now.AddDate(0, 0, -c.AgeDays)
+	history, status, holdOrigin := demoHistory(c, created, now)
+	var rejectionStage Status
+	if status == StatusRejected {
+		rejectionStage = Status(history[len(history)-1].RejectionStage)
+	}
+	updated := created
+	if len(history) > 0 {
+		updated = history[len(history)-1].At
+	}
+
+	var remindAt *time.Time
+	if c.RemindInDays > 0 {
+		at := now.AddDate(0, 0, c.RemindInDays)
+		remindAt = &at
+		history = append(history, HistoryEntry{Kind: HistoryReminderSet, At: updated, RemindAt: remindAt})
+	}
+	logged, _ := json.Marshal(history)
+	if history == nil {
+		logged = []byte("[]")
+	}
+
+	rawData, _ := json.Marshal(map[string]string{
+		"title":        c.Title,
+		"company":      c.Company,
+		"company_name": c.Company,
+		"location":     c.Location,
+	})
+	analysis, _ := json.Marshal(map[string]any{
+		"score": c.Score,
+		"pros":  []string{"Tech stack matches your profile"},
+		"cons":  []string{"Sample analysis of a demo card"},
+	})
+
+	var jobFeedID string
+	err := tx.QueryRow(ctx,
+		`INSERT INTO job_feed (user_id, status, raw_data, is_manual, title, company_name, created_at)
+		 VALUES ($1, 'APPROVED', $2, TRUE, $3, $4, $5)
+		 RETURNING id::text`,
+		userID, string(rawData), c.Title, c.Company, created,
+	).Scan(&jobFeedID)
+	if err != nil {
+		return fmt.Errorf("job_feed: %w", err)
+	}
+
+	app := Application{CurrentStatus: string(status), CreatedAt: created}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO applications
+		   (user_id, job_feed_id, current_status, ai_analysis, priority, hold_origin,
+		    rejection_reason, rejection_stage, relance_reminder_at, history_log, created_at, updated_at)
+		 VALUES ($1, $2, $3::application_status, $4, $5::application_priority,
+		         NULLIF($6, '')::application_status, NULLIF($7, ''), NULLIF($8, '')::application_status,
+		         $9, $10::jsonb, $11, $12)
+		 RETURNING id::text`,
+		userID, jobFeedID, string(status), string(analysis), string(c.Priority),
+		string(holdOrigin), c.Rejection, string(rejectionStage),
+		remindAt, string(logged), created, updated,
+	).Scan(&app.ID)
+	if err != nil {
+		return fmt.Errorf("application: %w", err)
+	}
+
+	for i, text := range c.Notes {
+		// Spread between the card's creation and its last update.
+		at := created.Add(updated.Sub(created) * time.Duration(i+1) / time.Duration(len(c.Notes)+1))
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO application_notes (application_id, user_id, body, created_at)
+			 VALUES ($1, $2, $3, $4)`,
+			app.ID, userID, s.seal(text), at,
+		); err != nil {
+			return fmt.Errorf("note: %w", err)
+		}
+	}
+	if len(c.Notes) > 0 {
+		if _, err := tx.Exec(ctx,
+			`UPDATE applications SET user_notes = $2 WHERE id = $1`,
+			app.ID, s.seal(c.Notes[len(c.Notes)-1]),
+		); err != nil {
+			return fmt.Errorf("note: %w", err)
+		}
+	}
+
+	return enqueueApplicationCreated(ctx, s.pgTx(tx), userID, &app)
+}
+
+// demoHistory returns the moves of a demo card from created to now, the
+// card's resulting status and, when it ends ON_HOLD, the status it was put
+// on hold from.
+func demoHistory(c demoCard, created, now time.Time) (history []HistoryEntry, status, holdOrigin Status) {
+	status = StatusToApply
+	step := now.Sub(created) / time.Duration(len(c.Path)+1)
+	for i, to := range c.Path {
+		e := HistoryEntry{
+			From: string(status),
+			To:   string(to),
+			At:   created.Add(step * time.Duration(i+1)).Truncate(time.Second),
+		}
+		if to == StatusRejected {
+			e.RejectionReason, e.RejectionStage = c.Rejection, string(status)
+		}
+		if to == StatusOnHold {
+			holdOrigin = status
+		}
+		history = append(history, e)
+		status = to
+	}
+	return history, status, holdOrigin
+}
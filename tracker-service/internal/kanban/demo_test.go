@@ -0,0 +1,42 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestDemoBoard_FollowsTheStateMachine(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	histories, statuses, holdOrigins := kanban.DemoBoardHistories(now)
+
+	seen := map[kanban.Status]bool{}
+	for i, h := range histories {
+		seen[statuses[i]] = true
+		prev := time.Time{}
+		for _, e := range h {
+			if !kanban.IsTransitionAllowed(kanban.Status(e.From), kanban.Status(e.To)) {
+				t.Errorf("card %d: transition %s → %s is not allowed", i, e.From, e.To)
+			}
+			if !e.At.After(prev) || e.At.After(now) {
+				t.Errorf("card %d: move to %s at %v, out of order", i, e.To, e.At)
+			}
+			if e.To == string(kanban.StatusRejected) && (e.RejectionReason == "" || e.RejectionStage != e.From) {
+				t.Errorf("card %d: rejection reason %q, stage %q", i, e.RejectionReason, e.RejectionStage)
+			}
+			prev = e.At
+		}
+		if (statuses[i] == kanban.StatusOnHold) != (holdOrigins[i] != "") {
+			t.Errorf("card %d: status %s with hold origin %q", i, statuses[i], holdOrigins[i])
+		}
+	}
+	for _, st := range []kanban.Status{
+		kanban.StatusToApply, kanban.StatusApplied, kanban.StatusInterview, kanban.StatusOffer,
+		kanban.StatusHired, kanban.StatusRejected, kanban.StatusWithdrawn, kanban.StatusOnHold,
+	} {
+		if !seen[st] {
+			t.Errorf("no demo card in %s", st)
+		}
+	}
+}
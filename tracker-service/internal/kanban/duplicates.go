@@ -0,0 +1,38 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+)
+
+// DuplicateError is returned by CreateApplication and CreateManualApplication
+// when the user already has an active — or recently rejected — application
+// for the same company and job title. Retrying with confirmDuplicate set
+// creates the application anyway.
+type DuplicateError struct {
+	ApplicationIDs []string // the existing applications, most recent first
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("you already have %d application(s) for this job at this company — confirm to apply again",
+		len(e.ApplicationIDs))
+}
+
+// checkDuplicate returns a DuplicateError when userID has an application
+// matching company and title (compared like companyKey) that is still
+// active, or was rejected within Options.DuplicateRejectionDays. A job
+// without a known company is never considered a duplicate.
+func (s *Service) checkDuplicate(ctx context.Context, tx ApplicationTx, userID, company, title string) error {
+	companyK, titleK := companyKey(company), companyKey(title)
+	if companyK == "" || titleK == "" {
+		return nil
+	}
+	ids, err := tx.Duplicates(ctx, userID, companyK, titleK, s.opts.DuplicateRejectionDays)
+	if err != nil {
+		return fmt.Errorf("duplicate check: %w", err)
+	}
+	if len(ids) > 0 {
+		return &DuplicateError{ApplicationIDs: ids}
+	}
+	return nil
+}
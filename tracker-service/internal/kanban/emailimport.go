@@ -0,0 +1,463 @@
+package kanban
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Confirmation emails ("Thank you for applying to Acme") are what job
+// boards and applicant tracking systems send once an application went
+// through. Users who applied before JobMate forward them to
+// ImportConfirmationEmail instead of recreating each card by hand.
+
+// ConfirmationEmail is what ParseConfirmationEmail found in an application
+// confirmation email. One of Company or JobTitle may be empty.
+type ConfirmationEmail struct {
+	Company  string
+	JobTitle string
+	// AppliedAt is the date the email was sent; zero when it has none.
+	AppliedAt time.Time
+}
+
+// maxConfirmationEmailLen bounds the raw email accepted for parsing.
+const maxConfirmationEmailLen = 1 << 20
+
+// importReason is the Reason of the APPLIED move an import records.
+const importReason = "Imported from a confirmation email"
+
+// ImportConfirmationEmail creates a card for the application a confirmation
+// email (see ParseConfirmationEmail) is about, as CreateManualApplication
+// does. status is TO_APPLY or APPLIED ("" means APPLIED): an APPLIED card
+// gets a TO_APPLY → APPLIED move dated when the email was sent.
+func (s *Service) ImportConfirmationEmail(ctx context.Context, userID string, email ConfirmationEmail, status Status, confirmDuplicate bool) (*Application, error) {
+	if status == "" {
+		status = StatusApplied
+	}
+	var history []HistoryEntry
+	switch status {
+	case StatusToApply:
+	case StatusApplied:
+		at := email.AppliedAt
+		if at.IsZero() || at.After(time.Now()) {
+			at = time.Now()
+		}
+		history = []HistoryEntry{{From: string(StatusToApply), To: string(StatusApplied), At: at.UTC(), Reason: importReason}}
+	default:
+		return nil, &ValidationError{Field: "status", Msg: "imported cards start at TO_APPLY or APPLIED"}
+	}
+	return s.createManualApplication(ctx, userID, ManualJob{
+		Title:   email.JobTitle,
+		Company: email.Company,
+	}, confirmDuplicate, status, history, nil)
+}
+
+// ParseConfirmationEmail reads the company, job title and date of an
+// application confirmation email, given as a raw MIME message (forwarded
+// as an attachment or not) or as plain text pasted from a mail client.
+// When the email quotes a forwarded message, the forwarded headers win.
+// The company comes from the email's wording, else from its sender unless
+// that is an applicant tracking system, a personal mailbox or, for a
+// forward, whoever forwarded it. A ValidationError on "email" when neither a
+// company nor a title is found.
+func ParseConfirmationEmail(raw string) (ConfirmationEmail, error) {
+	if strings.TrimSpace(raw) == "" {
+		return ConfirmationEmail{}, &ValidationError{Field: "email", Msg: "email is required"}
+	}
+	if len(raw) > maxConfirmationEmailLen {
+		return ConfirmationEmail{}, &ValidationError{Field: "email", Msg: fmt.Sprintf("email must be at most %d bytes", maxConfirmationEmailLen)}
+	}
+
+	var subject, from, date, body string
+	if msg, err := mail.ReadMessage(strings.NewReader(raw)); err == nil && (msg.Header.Get("Subject") != "" || msg.Header.Get("From") != "") {
+		subject = msg.Header.Get("Subject")
+		from = msg.Header.Get("From")
+		date = msg.Header.Get("Date")
+		body = decodeEmailPart(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	} else {
+		body = raw
+	}
+	fwd := forwardedHeaders(body)
+	if fwd != nil || forwardPrefix.MatchString(subject) || forwardMarker.MatchString(body) {
+		// The outer From of a forward is whoever forwarded it.
+		from = ""
+	}
+	for key, v := range map[string]*string{"subject": &subject, "from": &from, "date": &date} {
+		if fwd[key] != "" {
+			*v = fwd[key]
+		}
+	}
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err == nil {
+		subject = decoded
+	}
+
+	var email ConfirmationEmail
+	lines := append([]string{forwardPrefix.ReplaceAllString(subject, "")}, strings.Split(body, "\n")...)
+scan:
+	for _, line := range lines {
+		for _, sentence := range splitSentences(line) {
+			title, company := matchConfirmation(sentence)
+			if email.Company == "" {
+				email.Company = company
+			}
+			if email.JobTitle == "" && !strings.EqualFold(title, company) && !strings.EqualFold(title, email.Company) {
+				email.JobTitle = title
+			}
+			if email.JobTitle != "" && email.Company != "" {
+				break scan
+			}
+		}
+	}
+	if email.Company == "" {
+		email.Company = senderCompany(from)
+	}
+	if strings.EqualFold(email.JobTitle, email.Company) {
+		email.JobTitle = "" // "applied to Acme" read as a title
+	}
+	if email.Company == "" && email.JobTitle == "" {
+		return ConfirmationEmail{}, &ValidationError{Field: "email", Msg: "no company or job title found in the email"}
+	}
+	email.AppliedAt = parseEmailDate(date)
+	return email, nil
+}
+
+// decodeEmailPart returns the text of a MIME part: its text/plain
+// alternative when it has one, else its HTML one without the markup.
+func decodeEmailPart(contentType, encoding string, body io.Reader) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		var plain, htmlText string
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err != nil {
+				break
+			}
+			text := decodeEmailPart(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			switch ct := part.Header.Get("Content-Type"); {
+			case strings.HasPrefix(ct, "text/html"):
+				if htmlText == "" {
+					htmlText = text
+				}
+			case plain == "":
+				plain = text
+			}
+		}
+		if strings.TrimSpace(plain) != "" {
+			return plain
+		}
+		return htmlText
+	}
+	if mediaType == "message/rfc822" {
+		// A message forwarded as an attachment.
+		if msg, err := mail.ReadMessage(body); err == nil {
+			headers := "From: " + msg.Header.Get("From") + "\nDate: " + msg.Header.Get("Date") + "\nSubject: " + msg.Header.Get("Subject") + "\n"
+			return headers + decodeEmailPart(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+		}
+		return ""
+	}
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "" // attachments
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(body, maxConfirmationEmailLen))
+	text := string(b)
+	if cs := strings.ToLower(params["charset"]); !utf8.ValidString(text) || cs == "iso-8859-1" || cs == "latin1" || cs == "windows-1252" {
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		text = string(runes)
+	}
+	if mediaType == "text/html" {
+		text = htmlToText(text)
+	}
+	return strings.ReplaceAll(text, "\r\n", "\n")
+}
+
+var (
+	htmlHidden = regexp.MustCompile(`(?is)<(style|script|head)\b.*?</(style|script|head)>`)
+	htmlBreak  = regexp.MustCompile(`(?i)<(br|/p|/div|/tr|/h[1-6]|/li)\b[^>]*>`)
+	htmlTag    = regexp.MustCompile(`<[^>]*>`)
+)
+
+// htmlToText strips the markup of an HTML email, one line per block.
+func htmlToText(s string) string {
+	s = htmlHidden.ReplaceAllString(s, "")
+	s = htmlBreak.ReplaceAllString(s, "\n")
+	s = htmlTag.ReplaceAllString(s, "")
+	return html.UnescapeString(s)
+}
+
+var (
+	// forwardPrefix matches the "Fwd:" mail clients prepend to a subject.
+	forwardPrefix = regexp.MustCompile(`(?i)^\s*((fwd?|tr|wg)\s*:\s*)+`)
+	// forwardMarker matches the line mail clients put above a forwarded
+	// message.
+	forwardMarker = regexp.MustCompile(`(?im)^[>\s]*(?:-{2,}\s*(?:forwarded message|original message|message transféré|message d'origine|weitergeleitete nachricht|ursprüngliche nachricht)|begin forwarded message:)`)
+	// forwardedHeader matches a header line of a message quoted by a
+	// forward, as Gmail, Outlook and Apple Mail write them.
+	forwardedHeader = regexp.MustCompile(`(?i)^[>\s]*\*?(from|de|von|date|sent|envoyé|gesendet|subject|objet|betreff)\s*:\*?\s*(.+)$`)
+)
+
+// forwardedHeaderKeys maps the header names forwardedHeader matches.
+var forwardedHeaderKeys = map[string]string{
+	"from": "from", "de": "from", "von": "from",
+	"date": "date", "sent": "date", "envoyé": "date", "gesendet": "date",
+	"subject": "subject", "objet": "subject", "betreff": "subject",
+}
+
+// forwardedHeaders returns the From, Date and Subject of the first message
+// body quotes (keys "from", "date", "subject"), nil when it quotes none.
+func forwardedHeaders(body string) map[string]string {
+	var fwd map[string]string
+	for _, line := range strings.Split(body, "\n") {
+		m := forwardedHeader.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if m == nil {
+			continue
+		}
+		if fwd == nil {
+			fwd = make(map[string]string)
+		}
+		key := forwardedHeaderKeys[strings.ToLower(m[1])]
+		if fwd[key] == "" {
+			fwd[key] = strings.TrimSpace(m[2])
+		}
+		if fwd["subject"] != "" && fwd["from"] != "" {
+			break
+		}
+	}
+	if fwd["subject"] == "" {
+		return nil // a stray "Date:" line, not a forward
+	}
+	return fwd
+}
+
+// confirmationPatterns match the wording of confirmation emails, the most
+// specific first.
+var confirmationPatterns = []*regexp.Regexp{
+	// "Your application for the Backend Engineer position at Acme"
+	// "Thank you for applying for Backend Engineer at Acme"
+	regexp.MustCompile(`(?i)(?:applying|application|applied)\s+(?:for|to)\s+(?:the\s+)?(?P<title>.+?)(?:\s+(?:position|role|job|opening|vacancy))?\s+(?:at|with)\s+(?P<company>.+)`),
+	// "Thank you for applying to Acme for the Backend Engineer role"
+	regexp.MustCompile(`(?i)(?:applying|application|applied)\s+(?:to|at|with)\s+(?P<company>.+?)\s+for\s+(?:the\s+)?(?P<title>.+)`),
+	// "Thank you for applying to Acme", "Thanks for your application with Acme"
+	regexp.MustCompile(`(?i)(?:applying|application|applied)\s+(?:to|at|with)\s+(?P<company>.+)`),
+	// "We received your application for the Backend Engineer position"
+	regexp.MustCompile(`(?i)(?:applying|application|applied)\s+for\s+(?:the\s+)?(?P<title>.+?)\s+(?:position|role|job|opening|vacancy)\b`),
+	// "Application received: Backend Engineer"
+	regexp.MustCompile(`(?i)application\s+(?:received|confirmation|submitted)\s*(?:for\s+|[:\-–|]\s*)(?P<title>.+)`),
+	// "Backend Engineer - Application received"
+	regexp.MustCompile(`(?i)^(?P<title>.+?)\s*[\-–|:]\s*application\s+(?:received|confirmation|submitted)`),
+}
+
+// sentenceEnd matches the end of a sentence followed by another one.
+var sentenceEnd = regexp.MustCompile(`[.!?]+\s+\p{Lu}`)
+
+// titleAbbreviations end with a period without ending a sentence
+// ("Sr. Backend Engineer").
+var titleAbbreviations = map[string]bool{"sr": true, "jr": true, "dr": true, "mr": true, "mrs": true, "ms": true, "st": true}
+
+// abbreviated reports whether s ends with one of titleAbbreviations.
+func abbreviated(s string) bool {
+	words := strings.Fields(s)
+	return len(words) > 0 && titleAbbreviations[strings.ToLower(strings.TrimLeft(words[len(words)-1], "(\"'“"))]
+}
+
+// splitSentences splits a line of text into its sentences, so that a
+// pattern cannot match across two of them ("…applying to Acme. We look
+// forward to working with you").
+func splitSentences(line string) []string {
+	var sentences []string
+	start := 0
+	for _, m := range sentenceEnd.FindAllStringIndex(line, -1) {
+		if line[m[0]] == '.' && abbreviated(line[start:m[0]]) {
+			continue
+		}
+		_, size := utf8.DecodeLastRuneInString(line[:m[1]])
+		end := m[1] - size // the capital starts the next sentence
+		sentences = append(sentences, line[start:end])
+		start = end
+	}
+	return append(sentences, line[start:])
+}
+
+// confirmationEnd cuts a matched value at the end of its phrase.
+var confirmationEnd = regexp.MustCompile(`[.!?,;|(]| - | – |\s(?:has|have|was|is|and)\s`)
+
+// titleSuffix is the word a title is followed by in "the X position".
+var titleSuffix = regexp.MustCompile(`(?i)\s+(?:position|role|job|opening|vacancy)$`)
+
+// matchConfirmation returns the job title and company a line of a
+// confirmation email names, either one possibly empty.
+func matchConfirmation(line string) (title, company string) {
+	line = strings.TrimSpace(strings.TrimLeft(line, "> "))
+	for _, re := range confirmationPatterns {
+		m := re.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		for i, name := range re.SubexpNames() {
+			switch name {
+			case "title":
+				title = cleanConfirmationValue(titleSuffix.ReplaceAllString(cleanConfirmationValue(m[i]), ""))
+			case "company":
+				company = cleanConfirmationValue(m[i])
+			}
+		}
+		if title != "" || company != "" {
+			return title, company
+		}
+	}
+	return "", ""
+}
+
+// cleanConfirmationValue trims a matched title or company, "" when what is
+// left does not look like one.
+func cleanConfirmationValue(v string) string {
+	for _, loc := range confirmationEnd.FindAllStringIndex(v, -1) {
+		if v[loc[0]] == '.' && abbreviated(v[:loc[0]]) {
+			continue
+		}
+		v = v[:loc[0]]
+		break
+	}
+	v = strings.Trim(strings.TrimSpace(v), `"'“”`)
+	if n := utf8.RuneCountInString(v); n < 2 || n > 120 {
+		return ""
+	}
+	switch strings.ToLower(v) {
+	case "us", "our team", "the team", "this", "this position", "this role", "this job":
+		return ""
+	}
+	return v
+}
+
+// atsDomains are applicant tracking systems sending confirmation emails on
+// behalf of companies: their name is not the company's.
+var atsDomains = []string{
+	"greenhouse", "lever", "workday", "myworkday", "smartrecruiters", "ashbyhq", "ashby",
+	"workable", "icims", "taleo", "jobvite", "teamtailor", "recruitee", "bamboohr",
+	"personio", "welcometothejungle", "linkedin", "indeed", "successfactors",
+}
+
+// senderSuffix matches the team part of a sender's display name
+// ("Acme Careers", "Talent Acquisition at Acme").
+var senderSuffix = regexp.MustCompile(`(?i)\s*(?:\bvia\s+.*|\b(?:careers?|recruiting|recruitment|talent(?:\s+acquisition)?|hiring(?:\s+team)?|jobs|hr|team|no-?reply)\b\s*(?:at\s+)?)`)
+
+// consumerMailDomains are personal mailbox providers (the name before the
+// public suffix): a sender there is a person, not a company.
+var consumerMailDomains = map[string]bool{
+	"gmail": true, "googlemail": true, "outlook": true, "hotmail": true, "live": true, "msn": true,
+	"yahoo": true, "ymail": true, "icloud": true, "me": true, "mac": true, "aol": true,
+	"proton": true, "protonmail": true, "pm": true, "gmx": true, "mail": true, "yandex": true,
+}
+
+// senderCompany guesses the company from the From header of an email: its
+// display name without "Careers" and the like, else its domain unless that
+// belongs to an applicant tracking system. Nothing for a personal mailbox.
+func senderCompany(from string) string {
+	if from == "" {
+		return ""
+	}
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		addr = &mail.Address{Address: strings.Trim(from, "<> ")}
+	}
+	domain := ""
+	if i := strings.LastIndex(addr.Address, "@"); i >= 0 {
+		domain = strings.ToLower(addr.Address[i+1:])
+	}
+	label := domainName(domain)
+	if consumerMailDomains[label] {
+		return ""
+	}
+	isATS := func(s string) bool {
+		s = strings.ToLower(s)
+		for _, ats := range atsDomains {
+			if strings.Contains(s, ats) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if name := strings.TrimSpace(senderSuffix.ReplaceAllString(addr.Name, " ")); name != "" && !isATS(name) {
+		return strings.Join(strings.Fields(name), " ")
+	}
+	if label == "" || isATS(domain) {
+		return ""
+	}
+	r, size := utf8.DecodeRuneInString(label)
+	return string(unicode.ToUpper(r)) + label[size:]
+}
+
+// domainName returns the label of a domain before its public suffix
+// ("acme" for careers.acme.co.uk), "" for a bare name.
+func domainName(domain string) string {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return ""
+	}
+	label := labels[len(labels)-2]
+	if len(labels) >= 3 && len(labels[len(labels)-1]) == 2 { // acme.co.uk
+		switch label {
+		case "co", "com", "org", "net", "ac":
+			label = labels[len(labels)-3]
+		}
+	}
+	return label
+}
+
+// forwardedDateLayouts are how mail clients write the date of a forwarded
+// message.
+var forwardedDateLayouts = []string{
+	"Mon, Jan 2, 2006 at 3:04 PM",
+	"Monday, January 2, 2006 3:04 PM",
+	"Monday, January 2, 2006 at 3:04 PM",
+	"Monday, 2 January 2006 15:04",
+	"2 January 2006 at 15:04",
+	"January 2, 2006 at 3:04 PM",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseEmailDate parses the Date header of an email or of a forwarded
+// message, zero when it cannot.
+func parseEmailDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}
+	}
+	if t, err := mail.ParseDate(s); err == nil {
+		return t
+	}
+	s = strings.Join(strings.Fields(strings.ReplaceAll(s, " ", " ")), " ")
+	for _, layout := range forwardedDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,155 @@
+package kanban_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseConfirmationEmail(t *testing.T) {
+	tests := []struct {
+		name                   string
+		raw                    string
+		wantCompany, wantTitle string
+		wantDate               time.Time
+	}{
+		{
+			name: "MIME with an HTML alternative",
+			raw: strings.Join([]string{
+				"From: Acme Careers <careers@acme.com>",
+				"Date: Mon, 02 Mar 2026 10:14:00 +0100",
+				"Subject: =?UTF-8?Q?Your_application_for_Backend_Engineer_at_Acme?=",
+				"MIME-Version: 1.0",
+				`Content-Type: multipart/alternative; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: text/html; charset=utf-8",
+				"Content-Transfer-Encoding: quoted-printable",
+				"",
+				"<p>Thank you for applying!</p>",
+				"--b1--",
+				"",
+			}, "\r\n"),
+			wantCompany: "Acme",
+			wantTitle:   "Backend Engineer",
+			wantDate:    time.Date(2026, 3, 2, 9, 14, 0, 0, time.UTC),
+		},
+		{
+			name: "text forwarded from Gmail",
+			raw: strings.Join([]string{
+				"---------- Forwarded message ---------",
+				"From: Globex Talent <no-reply@globex.io>",
+				"Date: Tue, Feb 10, 2026 at 4:05 PM",
+				"Subject: Thank you for applying to Globex",
+				"To: <me@example.com>",
+				"",
+				"We received your application for the Data Analyst position and will be in touch.",
+			}, "\n"),
+			wantCompany: "Globex",
+			wantTitle:   "Data Analyst",
+			wantDate:    time.Date(2026, 2, 10, 16, 5, 0, 0, time.UTC),
+		},
+		{
+			name: "ATS sender",
+			raw: strings.Join([]string{
+				"From: Initech via Greenhouse <no-reply@us.greenhouse-mail.io>",
+				"Subject: Application received: Site Reliability Engineer",
+				"",
+				"Hi, thanks for your interest.",
+			}, "\r\n"),
+			wantCompany: "Initech",
+			wantTitle:   "Site Reliability Engineer",
+		},
+		{
+			name: "company from the sender's domain",
+			raw: strings.Join([]string{
+				"From: <jobs@umbrella.co.uk>",
+				"Subject: Frontend Developer - Application received",
+				"Content-Type: text/plain; charset=utf-8",
+				"Content-Transfer-Encoding: base64",
+				"",
+				"SGVsbG8sIHdlIGdvdCBpdC4=",
+			}, "\r\n"),
+			wantCompany: "Umbrella",
+			wantTitle:   "Frontend Developer",
+		},
+		{
+			name: "company named in a sentence followed by another",
+			raw: strings.Join([]string{
+				"From: <no-reply@myworkday.com>",
+				"Subject: Thank you for your application to Acme",
+				"",
+				"We received your application to Acme. We look forward to working with you.",
+			}, "\r\n"),
+			wantCompany: "Acme",
+		},
+		{
+			name: "abbreviation in the title",
+			raw: strings.Join([]string{
+				"From: <no-reply@lever.co>",
+				"Subject: Thank you for applying for Sr. Backend Engineer at Hooli",
+				"",
+			}, "\r\n"),
+			wantCompany: "Hooli",
+			wantTitle:   "Sr. Backend Engineer",
+		},
+		{
+			name: "forward without quoted headers",
+			raw: strings.Join([]string{
+				"From: Jane Doe <jane@gmail.com>",
+				"Subject: Fwd: Your application",
+				"",
+				"We received your application for the Data Analyst position.",
+			}, "\r\n"),
+			wantTitle: "Data Analyst",
+		},
+		{
+			name: "forward marker without quoted headers",
+			raw: strings.Join([]string{
+				"From: Jane Doe <jane.doe@janedoe.dev>",
+				"Subject: see below",
+				"",
+				"-----Original Message-----",
+				"Application received: QA Engineer",
+			}, "\r\n"),
+			wantTitle: "QA Engineer",
+		},
+		{
+			name: "personal mailbox",
+			raw: strings.Join([]string{
+				"From: jane@gmail.com",
+				"Subject: Backend Engineer - Application received",
+				"",
+			}, "\r\n"),
+			wantTitle: "Backend Engineer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kanban.ParseConfirmationEmail(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseConfirmationEmail: %v", err)
+			}
+			if got.Company != tt.wantCompany || got.JobTitle != tt.wantTitle {
+				t.Errorf("got company %q, title %q, want %q, %q", got.Company, got.JobTitle, tt.wantCompany, tt.wantTitle)
+			}
+			if !got.AppliedAt.Equal(tt.wantDate) {
+				t.Errorf("AppliedAt = %v, want %v", got.AppliedAt, tt.wantDate)
+			}
+		})
+	}
+}
+
+// An email naming neither a company nor a job, from an applicant tracking
+// system, is rejected.
+func TestParseConfirmationEmail_NothingFound(t *testing.T) {
+	raw := "From: <no-reply@myworkday.com>\r\nSubject: Hello\r\n\r\nSee you soon.\r\n"
+	_, err := kanban.ParseConfirmationEmail(raw)
+	var ve *kanban.ValidationError
+	if !errors.As(err, &ve) || ve.Field != "email" {
+		t.Errorf("err = %v, want ValidationError on email", err)
+	}
+}
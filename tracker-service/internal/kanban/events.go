@@ -0,0 +1,131 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Domain events are not published directly: they are queued in the outbox
+// with the transaction that makes the change (see enqueueEvent) and
+// published by RelayOutbox.
+
+// ApplicationEvent is the payload shared by EVENT_APPLICATION_CREATED and
+// EVENT_APPLICATION_UPDATED, forwarded by the Gateway to the SSE stream so
+// open boards can refresh the card.
+type ApplicationEvent struct {
+	Type          string    `json:"type"`
+	ApplicationID string    `json:"applicationId"`
+	UserID        string    `json:"userId"`
+	Status        string    `json:"status"`           // current Kanban status
+	Fields        []string  `json:"fields,omitempty"` // changed columns, UPDATED only
+	At            time.Time `json:"at"`
+}
+
+// enqueueApplicationCreated queues EVENT_APPLICATION_CREATED in the outbox.
+func enqueueApplicationCreated(ctx context.Context, tx ApplicationTx, userID string, app *Application) error {
+	return tx.Enqueue(ctx, "EVENT_APPLICATION_CREATED", ApplicationEvent{
+		Type:          "EVENT_APPLICATION_CREATED",
+		ApplicationID: app.ID,
+		UserID:        userID,
+		Status:        app.CurrentStatus,
+		At:            app.CreatedAt,
+	})
+}
+
+// enqueueApplicationUpdated queues EVENT_APPLICATION_UPDATED for a change to
+// the given fields. Status moves have their own EVENT_CARD_MOVED and are not
+// reported here.
+func enqueueApplicationUpdated(ctx context.Context, q querier, userID string, app *Application, fields ...string) error {
+	return enqueueEvent(ctx, q, "EVENT_APPLICATION_UPDATED", ApplicationEvent{
+		Type:          "EVENT_APPLICATION_UPDATED",
+		ApplicationID: app.ID,
+		UserID:        userID,
+		Status:        app.CurrentStatus,
+		Fields:        fields,
+		At:            app.UpdatedAt,
+	})
+}
+
+// updateApplicationRow runs query — a single-application UPDATE … RETURNING
+// selecting appColumns — and queues EVENT_APPLICATION_UPDATED for fields in
+// the same transaction. Returns ErrNotFound if the query yields no row.
+func (s *Service) updateApplicationRow(ctx context.Context, userID string, fields []string, query string, args ...any) (*Application, error) {
+	return s.updateRecordedApplicationRow(ctx, userID, "", "", fields, query, args...)
+}
+
+// updateRecordedApplicationRow is updateApplicationRow for an undoable
+// action on application appID, recorded first (see recordLastAction).
+func (s *Service) updateRecordedApplicationRow(ctx context.Context, userID string, action UndoableAction, appID string, fields []string, query string, args ...any) (*Application, error) {
+	var app Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		if action != "" {
+			if err := recordLastAction(ctx, tx, action, appID, userID); err != nil {
+				return err
+			}
+		}
+		err := tx.QueryRow(ctx, query, args...).Scan(s.appScanDest(&app)...)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return enqueueApplicationUpdated(ctx, tx, userID, &app, fields...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// touchApplication bumps an application's updated_at after a change to one
+// of its sub-resources (interviews, contacts…), clears its ghosted flag,
+// appends logged to its history_log and queues EVENT_APPLICATION_UPDATED for
+// fields.
+func touchApplication(ctx context.Context, q querier, userID, appID string, fields []string, logged ...HistoryEntry) error {
+	entries := []byte("[]")
+	if len(logged) > 0 {
+		entries, _ = json.Marshal(logged)
+	}
+
+	var app Application
+	err := q.QueryRow(ctx,
+		`UPDATE applications
+		 SET history_log = history_log || $2::jsonb, updated_at = NOW(), ghosted_at = NULL
+		 WHERE id = $1
+		 RETURNING id::text, current_status::text, updated_at`,
+		appID, string(entries),
+	).Scan(&app.ID, &app.CurrentStatus, &app.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("touch application: %w", err)
+	}
+	return enqueueApplicationUpdated(ctx, q, userID, &app, fields...)
+}
+
+// enqueueCardMoved queues EVENT_CARD_MOVED for Gateway SSE forward.
+// columnID is the custom column the card landed in ("" = default lane).
+func enqueueCardMoved(ctx context.Context, tx ApplicationTx, userID, appID string, from, to Status, columnID string) error {
+	return tx.Enqueue(ctx, "EVENT_CARD_MOVED", map[string]string{
+		"type":          "EVENT_CARD_MOVED",
+		"applicationId": appID,
+		"userId":        userID,
+		"from":          string(from),
+		"to":            string(to),
+		"columnId":      columnID,
+	})
+}
+
+// enqueueAnalyzeJob queues CMD_ANALYZE_JOB so the AI Coach scores the application.
+func enqueueAnalyzeJob(ctx context.Context, tx ApplicationTx, userID, appID, jobFeedID string) error {
+	return tx.Enqueue(ctx, "CMD_ANALYZE_JOB", map[string]string{
+		"type":          "CMD_ANALYZE_JOB",
+		"applicationId": appID,
+		"jobFeedId":     jobFeedID,
+		"userId":        userID,
+	})
+}
@@ -0,0 +1,81 @@
+package kanban
+
+import "time"
+
+// Exported aliases of unexported helpers, for the kanban_test package only.
+
+var (
+	PlanUndo                  = planUndo
+	CleanText                 = cleanText
+	SummarizeCompanies        = summarizeCompanies
+	MergeHistories            = mergeHistories
+	NormalizeOffer            = normalizeOffer
+	NormalizeNegotiationEntry = normalizeNegotiationEntry
+	RankOffers                = rankOffers
+	RenderICS                 = renderICS
+	FoldICSLine               = foldICSLine
+	PlanGooglePush            = planGooglePush
+	GoogleEventFingerprint    = googleEventFingerprint
+	NormalizeReminderChannels = normalizeReminderChannels
+	ParseLocalTime            = parseLocalTime
+	NextHistoryPageToken      = nextHistoryPageToken
+	ParseHistoryPage          = parseHistoryPage
+	MergeTimeline             = mergeTimeline
+	ParseFollowUpTone         = parseFollowUpTone
+	DaysSince                 = daysSince
+	NormalizeRating           = normalizeRating
+	SearchArchivedByLastMove  = searchArchivedByLastMove
+	WebhookBackoff            = webhookBackoff
+	Retryable                 = retryable
+	ParseHeadline             = parseHeadline
+	TSVector                  = tsVector
+	TSQuery                   = tsQuery
+	DiffSnapshots             = diffSnapshots
+	CheckUndoable             = checkUndoable
+	ParseRejection            = parseRejection
+)
+
+// CommitError wraps err as a failed COMMIT.
+func CommitError(err error) error { return &commitError{err} }
+
+// Sealed-column helpers of Service.
+var (
+	ServiceSeal             = (*Service).seal
+	ServiceSealCompensation = (*Service).sealCompensation
+	ServiceOpenExportRows   = (*Service).openExportRows
+	ServiceSealCached       = (*Service).sealCached
+	ServiceOpenCached       = (*Service).openCached
+)
+
+// SealedDest returns the Scan destination of a sealed column.
+func (s *Service) SealedDest(dst any) interface{ Scan(any) error } { return s.sealed(dst) }
+
+// SealedAmountsDest returns the Scan destination of a compensation column.
+func (s *Service) SealedAmountsDest(baseSalary, bonus, equityValue *int64) interface{ Scan(any) error } {
+	return s.sealedAmounts(baseSalary, bonus, equityValue)
+}
+
+type (
+	UndoPlan           = undoPlan
+	CompanyApp         = companyApp
+	CalendarEvent      = calendarEvent
+	GoogleEventMapping = googleEventMapping
+	GoogleOp           = googleOp
+)
+
+const (
+	GoogleInsert = googleInsert
+	GoogleUpdate = googleUpdate
+	GoogleDelete = googleDelete
+	GoogleForget = googleForget
+)
+
+// DemoBoardHistories returns the moves, resulting status and hold origin
+// of each card of the demo board, as SeedDemoBoard writes them at now.
+func DemoBoardHistories(now time.Time) (histories [][]HistoryEntry, statuses, holdOrigins []Status) {
+	for _, c := range demoBoard {
+		h, st, origin := demoHistory(c, now.AddDate(0, 0, -c.AgeDays), now)
+		histories, statuses, holdOrigins = append(histories, h), append(statuses, st), append(holdOrigins, origin)
+	}
+	return histories, statuses, holdOrigins
+}
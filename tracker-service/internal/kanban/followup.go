@@ -0,0 +1,112 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Tones of the follow-up messages drafted by RequestFollowUpDraft.
+const (
+	FollowUpToneProfessional = "PROFESSIONAL" // the default
+	FollowUpToneFriendly     = "FRIENDLY"
+	FollowUpToneBrief        = "BRIEF"
+)
+
+// followUpContext is what the AI Coach is told about an application to
+// draft a follow-up message for it.
+type followUpContext struct {
+	Status        string
+	JobTitle      string
+	Company       string
+	LastContactAt time.Time
+}
+
+// RequestFollowUpDraft asks the AI Coach to draft a follow-up message about
+// an application by queuing CMD_GENERATE_FOLLOWUP with its context: status,
+// job, days since the last contact and the tone wanted ("" =
+// FollowUpToneProfessional). The last contact is the latest of the card's
+// last move, its last interview held and the last exchange with a linked
+// contact — or its creation. The draft lands asynchronously in
+// FollowUpDraft, replacing the previous one, announced by
+// EVENT_FOLLOWUP_DRAFTED.
+//
+// Calls for the same application are rate-limited by Options.FollowUpCooldown,
+// as ReanalyzeApplication is.
+func (s *Service) RequestFollowUpDraft(ctx context.Context, userID, appID, tone string) error {
+	tone, err := parseFollowUpTone(tone)
+	if err != nil {
+		return err
+	}
+
+	var fc followUpContext
+	err = s.pool.QueryRow(ctx,
+		`SELECT a.current_status::text, `+jobTitleExpr+`, `+jobCompanyExpr+`,
+		        GREATEST(a.created_at,
+		                 (SELECT MAX((e->>'at')::timestamptz)
+		                  FROM jsonb_array_elements(a.history_log) e
+		                  WHERE NOT e ? 'kind' AND NOT e ? 'mergedFrom'),
+		                 (SELECT MAX(i.scheduled_at) FROM interviews i
+		                  WHERE i.application_id = a.id AND i.scheduled_at <= NOW()),
+		                 (SELECT MAX(c.last_contacted_at)
+		                  FROM application_contacts ac JOIN contacts c ON c.id = ac.contact_id
+		                  WHERE ac.application_id = a.id))
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.id = $1 AND a.user_id = $2`,
+		appID, userID,
+	).Scan(&fc.Status, &fc.JobTitle, &fc.Company, &fc.LastContactAt)
+	if err != nil {
+		return ErrNotFound
+	}
+	if Status(fc.Status) == StatusToApply {
+		return &ValidationError{Msg: "there is nothing to follow up on before applying"}
+	}
+
+	key := fmt.Sprintf("tracker:followup:%s", appID)
+	if err := s.startCooldown(ctx, key, userID, "follow-up draft", s.opts.FollowUpCooldown); err != nil {
+		return err
+	}
+
+	err = enqueueEvent(ctx, s.pool, "CMD_GENERATE_FOLLOWUP", map[string]any{
+		"type":                 "CMD_GENERATE_FOLLOWUP",
+		"applicationId":        appID,
+		"userId":               userID,
+		"status":               fc.Status,
+		"jobTitle":             fc.JobTitle,
+		"company":              fc.Company,
+		"daysSinceLastContact": daysSince(fc.LastContactAt, time.Now()),
+		"tone":                 tone,
+	})
+	if err != nil {
+		// Nothing was queued: let the user retry right away.
+		s.rdb.Del(ctx, key)
+		return fmt.Errorf("requestFollowUpDraft: %w", err)
+	}
+	slog.Info("follow-up draft requested", "userId", userID, "applicationId", appID, "tone", tone)
+	return nil
+}
+
+// parseFollowUpTone validates a follow-up tone, in any case; "" is
+// FollowUpToneProfessional.
+func parseFollowUpTone(tone string) (string, error) {
+	switch t := strings.ToUpper(strings.TrimSpace(tone)); t {
+	case "":
+		return FollowUpToneProfessional, nil
+	case FollowUpToneProfessional, FollowUpToneFriendly, FollowUpToneBrief:
+		return t, nil
+	}
+	return "", &ValidationError{Field: "tone",
+		Msg: fmt.Sprintf("tone must be %s, %s or %s", FollowUpToneProfessional, FollowUpToneFriendly, FollowUpToneBrief)}
+}
+
+// daysSince returns the number of whole days from t to now (0 if t is not
+// in the past).
+func daysSince(t, now time.Time) int {
+	if !now.After(t) {
+		return 0
+	}
+	return int(now.Sub(t) / (24 * time.Hour))
+}
@@ -0,0 +1,40 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseFollowUpTone(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"", kanban.FollowUpToneProfessional},
+		{"friendly", kanban.FollowUpToneFriendly},
+		{" BRIEF ", kanban.FollowUpToneBrief},
+		{"PROFESSIONAL", kanban.FollowUpToneProfessional},
+	} {
+		got, err := kanban.ParseFollowUpTone(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseFollowUpTone(%q) = %q, %v; want %q", tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestDaysSince(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		t    time.Time
+		want int
+	}{
+		{now.Add(-9*24*time.Hour - time.Hour), 9},
+		{now.Add(-23 * time.Hour), 0},
+		{now.Add(time.Hour), 0}, // clock skew
+	} {
+		if got := kanban.DaysSince(tc.t, now); got != tc.want {
+			t.Errorf("DaysSince(%s) = %d, want %d", tc.t, got, tc.want)
+		}
+	}
+}
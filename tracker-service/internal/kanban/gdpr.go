@@ -0,0 +1,298 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Data-subject requests: ExportUserData hands users everything the tracker
+// stores about them, EraseUserData removes it. The latter also runs when the
+// auth service deletes an account (HandleUserDeleted), before or after the
+// users row — whose ON DELETE CASCADE would not reach the audit log, keyed
+// by actor without a foreign key, nor the attachment files.
+
+// exportSections are the parts of ExportUserData's document, each a query
+// of the user's rows ($1 = user ID). Secrets (calendar feed token, Google
+// OAuth tokens, webhook secrets) are left out.
+var exportSections = []struct{ name, query string }{
+	{"settings", `SELECT * FROM tracker_settings WHERE user_id = $1`},
+	{"boardColumns", `SELECT * FROM board_columns WHERE user_id = $1 ORDER BY status, position`},
+	// History (history_log) and reminders (relance_*) are columns of the
+	// applications.
+	{"applications", `SELECT * FROM applications WHERE user_id = $1 ORDER BY created_at`},
+	{"jobs", `SELECT jf.* FROM job_feed jf
+	          WHERE jf.id IN (SELECT job_feed_id FROM applications WHERE user_id = $1)
+	          ORDER BY jf.created_at`},
+	{"notes", `SELECT * FROM application_notes WHERE user_id = $1 ORDER BY created_at`},
+	{"lastActions", `SELECT application_id, action, note_id, at FROM application_last_actions
+	                 WHERE user_id = $1 ORDER BY at`},
+	{"interviews", `SELECT * FROM interviews WHERE user_id = $1 ORDER BY application_id, round`},
+	{"contacts", `SELECT * FROM contacts WHERE user_id = $1 ORDER BY created_at`},
+	{"applicationContacts", `SELECT ac.* FROM application_contacts ac
+	                         JOIN contacts c ON c.id = ac.contact_id
+	                         WHERE c.user_id = $1 ORDER BY ac.created_at`},
+	{"offers", `SELECT * FROM offers WHERE user_id = $1 ORDER BY created_at`},
+	{"offerNegotiations", `SELECT * FROM offer_negotiations WHERE user_id = $1 ORDER BY occurred_at`},
+	{"attachments", `SELECT * FROM attachments WHERE user_id = $1 ORDER BY created_at`},
+	{"coverLetterVersions", `SELECT * FROM cover_letter_versions WHERE user_id = $1 ORDER BY application_id, version`},
+	{"calendarFeed", `SELECT user_id, generated_at, created_at FROM calendar_feeds WHERE user_id = $1`},
+	{"googleCalendar", `SELECT user_id, calendar_id, needs_reconnect, last_synced_at, last_error, connected_at
+	                    FROM google_calendar_links WHERE user_id = $1`},
+	{"boardShares", `SELECT id, owner_id, grantee_id, statuses, expires_at, revoked_at, created_at
+	                 FROM board_shares WHERE owner_id = $1 OR grantee_id = $1 ORDER BY created_at`},
+	{"webhooks", `SELECT id, url, events, is_active, created_at, updated_at
+	              FROM webhooks WHERE user_id = $1 ORDER BY created_at`},
+	{"webhookDeliveries", `SELECT d.* FROM webhook_deliveries d
+	                       JOIN webhooks w ON w.id = d.webhook_id
+	                       WHERE w.user_id = $1 ORDER BY d.created_at`},
+	{"boardSnapshots", `SELECT * FROM board_snapshots WHERE user_id = $1 ORDER BY taken_at`},
+	{"templates", `SELECT * FROM application_templates WHERE user_id = $1 ORDER BY created_at`},
+	{"templateNotes", `SELECT * FROM application_template_notes WHERE user_id = $1 ORDER BY template_id, position`},
+	{"auditLog", `SELECT * FROM audit_log WHERE actor_id = $1 ORDER BY id`},
+}
+
+// exportSealed are the sealed columns (see sealedColumns) of the
+// exportSections, exported opened. compensation is exported as the JSON
+// object it holds.
+var exportSealed = map[string][]string{
+	"applications":        {"user_notes", "generated_cover_letter", "followup_draft", "rating_review"},
+	"notes":               {"body"},
+	"templateNotes":       {"body"},
+	"offers":              {"compensation", "equity_details"},
+	"offerNegotiations":   {"compensation", "note"},
+	"coverLetterVersions": {"body"},
+}
+
+// ExportUserData returns every tracker row about the user as one JSON
+// document: an object with one array of rows (columns as stored, sealed ones
+// opened) per exportSections entry, plus userId and exportedAt. The sections
+// are read from a single snapshot. Attachment files are referenced, not
+// included: GetAttachmentURL downloads them.
+func (s *Service) ExportUserData(ctx context.Context, userID string) ([]byte, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("exportUserData begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // read-only
+
+	doc := map[string]any{
+		"userId":     userID,
+		"exportedAt": time.Now().UTC().Truncate(time.Second),
+	}
+	for _, sec := range exportSections {
+		var rows []byte
+		err := tx.QueryRow(ctx,
+			`SELECT COALESCE(jsonb_agg(to_jsonb(t)), '[]') FROM (`+sec.query+`) t`,
+			userID,
+		).Scan(&rows)
+		if err != nil {
+			return nil, fmt.Errorf("exportUserData %s: %w", sec.name, err)
+		}
+		if columns := exportSealed[sec.name]; len(columns) > 0 {
+			if rows, err = s.openExportRows(rows, columns); err != nil {
+				return nil, fmt.Errorf("exportUserData %s: %w", sec.name, err)
+			}
+		}
+		doc[sec.name] = json.RawMessage(rows)
+	}
+	return json.Marshal(doc)
+}
+
+// openExportRows opens the sealed columns of a JSON array of rows.
+func (s *Service) openExportRows(rows []byte, columns []string) ([]byte, error) {
+	var decoded []map[string]json.RawMessage
+	if err := json.Unmarshal(rows, &decoded); err != nil {
+		return nil, err
+	}
+	for _, row := range decoded {
+		for _, col := range columns {
+			var value *string
+			if err := json.Unmarshal(row[col], &value); err != nil || value == nil {
+				continue
+			}
+			plaintext := s.opts.FieldKeys.Reveal(*value)
+			if col == "compensation" && json.Valid([]byte(plaintext)) {
+				row[col] = json.RawMessage(plaintext)
+			} else {
+				row[col], _ = json.Marshal(plaintext)
+			}
+		}
+	}
+	return json.Marshal(decoded)
+}
+
+// ErasureMode is how EraseUserData removes a user's data.
+type ErasureMode string
+
+const (
+	// ErasureDelete deletes every tracker row of the user.
+	ErasureDelete ErasureMode = "DELETE"
+	// ErasureAnonymize keeps the bare pipeline — applications with their
+	// jobs, statuses and dates, board columns and settings — and deletes or
+	// blanks everything the user wrote or that identifies people: notes,
+	// cover letters, attachments, contacts, interview feedback, comments in
+	// the history, board shares, calendar connections, webhooks and audited
+	// request contents.
+	ErasureAnonymize ErasureMode = "ANONYMIZE"
+)
+
+// ParseErasureMode validates an erasure mode; "" means ErasureDelete.
+func ParseErasureMode(s string) (ErasureMode, error) {
+	switch m := ErasureMode(s); m {
+	case "":
+		return ErasureDelete, nil
+	case ErasureDelete, ErasureAnonymize:
+		return m, nil
+	}
+	return "", &ValidationError{Field: "mode", Msg: fmt.Sprintf("unknown erasure mode %q (DELETE or ANONYMIZE)", s)}
+}
+
+// erasureStep is one statement of an erasure, reported under table.
+type erasureStep struct{ table, query string }
+
+// Erasure statements ($1 = user ID), children before parents so every
+// table's count is its own. accessErasure — shares, calendar connections,
+// webhooks and the published events kept for replays — runs in both modes.
+var (
+	accessErasure = []erasureStep{
+		{"board_shares", `DELETE FROM board_shares WHERE owner_id = $1 OR grantee_id = $1`},
+		{"google_calendar_events", `DELETE FROM google_calendar_events WHERE user_id = $1`},
+		{"google_calendar_links", `DELETE FROM google_calendar_links WHERE user_id = $1`},
+		{"google_calendar_auth_states", `DELETE FROM google_calendar_auth_states WHERE user_id = $1`},
+		{"calendar_feeds", `DELETE FROM calendar_feeds WHERE user_id = $1`},
+		{"webhook_deliveries", `DELETE FROM webhook_deliveries d USING webhooks w
+		                        WHERE w.id = d.webhook_id AND w.user_id = $1`},
+		{"webhooks", `DELETE FROM webhooks WHERE user_id = $1`},
+		{"outbox_events", `DELETE FROM outbox_events WHERE published_at IS NOT NULL AND payload->>'userId' = $1`},
+	}
+	erasureSteps = map[ErasureMode][]erasureStep{
+		ErasureDelete: append([]erasureStep{
+			{"audit_log", `DELETE FROM audit_log WHERE actor_id = $1`},
+			{"application_contacts", `DELETE FROM application_contacts ac USING contacts c
+			                          WHERE c.id = ac.contact_id AND c.user_id = $1`},
+			{"contacts", `DELETE FROM contacts WHERE user_id = $1`},
+			{"offer_negotiations", `DELETE FROM offer_negotiations WHERE user_id = $1`},
+			{"offers", `DELETE FROM offers WHERE user_id = $1`},
+			{"interviews", `DELETE FROM interviews WHERE user_id = $1`},
+			{"attachments", `DELETE FROM attachments WHERE user_id = $1`},
+			{"cover_letter_versions", `DELETE FROM cover_letter_versions WHERE user_id = $1`},
+			{"application_notes", `DELETE FROM application_notes WHERE user_id = $1`},
+			{"application_last_actions", `DELETE FROM application_last_actions WHERE user_id = $1`},
+			{"application_search", `DELETE FROM application_search WHERE user_id = $1`},
+			{"applications", `DELETE FROM applications WHERE user_id = $1`},
+			{"job_feed", `DELETE FROM job_feed WHERE user_id = $1 AND is_manual`},
+			{"board_snapshots", `DELETE FROM board_snapshots WHERE user_id = $1`},
+			{"application_templates", `DELETE FROM application_templates WHERE user_id = $1`},
+			{"board_columns", `DELETE FROM board_columns WHERE user_id = $1`},
+			{"tracker_settings", `DELETE FROM tracker_settings WHERE user_id = $1`},
+		}, accessErasure...),
+		ErasureAnonymize: append([]erasureStep{
+			{"audit_log", `UPDATE audit_log SET old_values = NULL, new_values = NULL, request = NULL
+			               WHERE actor_id = $1`},
+			{"application_contacts", `DELETE FROM application_contacts ac USING contacts c
+			                          WHERE c.id = ac.contact_id AND c.user_id = $1`},
+			{"contacts", `DELETE FROM contacts WHERE user_id = $1`},
+			{"offer_negotiations", `UPDATE offer_negotiations SET note = NULL WHERE user_id = $1`},
+			{"offers", `UPDATE offers SET equity_details = NULL WHERE user_id = $1`},
+			{"interviews", `UPDATE interviews
+			                SET interviewer = NULL, notes = NULL, went_well = NULL,
+			                    red_flags = NULL, questions_asked = '{}'
+			                WHERE user_id = $1`},
+			{"attachments", `DELETE FROM attachments WHERE user_id = $1`},
+			{"cover_letter_versions", `DELETE FROM cover_letter_versions WHERE user_id = $1`},
+			{"application_notes", `DELETE FROM application_notes WHERE user_id = $1`},
+			{"application_last_actions", `DELETE FROM application_last_actions WHERE user_id = $1`},
+			{"application_search", `DELETE FROM application_search WHERE user_id = $1`},
+			{"board_snapshots", `DELETE FROM board_snapshots WHERE user_id = $1`},
+			{"application_templates", `DELETE FROM application_templates WHERE user_id = $1`},
+			{"applications", `UPDATE applications
+			                  SET generated_cover_letter = NULL, user_notes = NULL,
+			                      followup_draft = NULL, followup_draft_at = NULL, rating_review = NULL,
+			                      ai_analysis = '{}', next_step_label = NULL,
+			                      history_log = (SELECT COALESCE(jsonb_agg(h.e - 'reason' ORDER BY h.i), '[]')
+			                                     FROM jsonb_array_elements(history_log) WITH ORDINALITY AS h(e, i))
+			                  WHERE user_id = $1`},
+		}, accessErasure...),
+	}
+)
+
+// EraseUserData removes the user's tracker data as mode says, in one
+// transaction, and returns how many rows of each table were deleted or
+// anonymized (tables left untouched are omitted). Attachment files are
+// deleted once it commits, best-effort.
+func (s *Service) EraseUserData(ctx context.Context, userID string, mode ErasureMode) (map[string]int64, error) {
+	steps, ok := erasureSteps[mode]
+	if !ok {
+		return nil, fmt.Errorf("eraseUserData: unknown mode %q", mode)
+	}
+
+	var keys []string
+	counts := make(map[string]int64)
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		clear(counts) // from a failed run
+		rows, err := tx.Query(ctx, `SELECT object_key FROM attachments WHERE user_id = $1`, userID)
+		if err != nil {
+			return fmt.Errorf("eraseUserData attachments: %w", err)
+		}
+		if keys, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+			return fmt.Errorf("eraseUserData attachments: %w", err)
+		}
+
+		// Lift the audit log's append-only guard for this transaction.
+		if _, err := tx.Exec(ctx, `SELECT set_config('jobmate.audit_purge', 'on', true)`); err != nil {
+			return fmt.Errorf("eraseUserData: %w", err)
+		}
+		for _, step := range steps {
+			tag, err := tx.Exec(ctx, step.query, userID)
+			if err != nil {
+				return fmt.Errorf("eraseUserData %s: %w", step.table, err)
+			}
+			if n := tag.RowsAffected(); n > 0 {
+				counts[step.table] += n
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.opts.Storage != nil {
+		for _, key := range keys {
+			if err := s.opts.Storage.Delete(ctx, key); err != nil {
+				slog.Warn("erased attachment object not deleted", "key", key, "err", err)
+			}
+		}
+	}
+	s.InvalidateUserCache(ctx, userID)
+	slog.Info("user data erased", "userId", userID, "mode", mode, "rows", counts)
+	return counts, nil
+}
+
+// UserDeleted is the EVENT_USER_DELETED payload published by the auth
+// service when an account is deleted.
+type UserDeleted struct {
+	UserID string `json:"userId"`
+}
+
+// HandleUserDeleted erases (ErasureDelete) the tracker data of the user an
+// EVENT_USER_DELETED payload names. Erasing twice is harmless.
+func (s *Service) HandleUserDeleted(ctx context.Context, payload string) error {
+	var ev UserDeleted
+	if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+		return fmt.Errorf("userDeleted: bad payload: %w", err)
+	}
+	if ev.UserID == "" {
+		return errors.New("userDeleted: missing userId")
+	}
+	if _, err := s.EraseUserData(ctx, ev.UserID, ErasureDelete); err != nil {
+		return fmt.Errorf("userDeleted: %w", err)
+	}
+	return nil
+}
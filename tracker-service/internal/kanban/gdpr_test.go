@@ -0,0 +1,28 @@
+package kanban_test
+
+import (
+	"errors"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseErasureMode(t *testing.T) {
+	cases := map[string]kanban.ErasureMode{
+		"":          kanban.ErasureDelete,
+		"DELETE":    kanban.ErasureDelete,
+		"ANONYMIZE": kanban.ErasureAnonymize,
+	}
+	for in, want := range cases {
+		got, err := kanban.ParseErasureMode(in)
+		if err != nil || got != want {
+			t.Errorf("ParseErasureMode(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	_, err := kanban.ParseErasureMode("anonymize")
+	var ve *kanban.ValidationError
+	if !errors.As(err, &ve) || ve.Field != "mode" {
+		t.Errorf("ParseErasureMode(lowercase) err = %v, want ValidationError on mode", err)
+	}
+}
@@ -0,0 +1,94 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// DetectGhosted flags applications that have sat in APPLIED or INTERVIEW
+// without any update for longer than the owner's ghost_after_days (default:
+// Options.GhostAfterDays), and queues EVENT_APPLICATION_GHOSTED for each
+// so the UI can suggest a follow-up or closing the card.
+//
+// A card is flagged once; the flag is cleared by the next activity on it: a
+// move, a note, a change to its interviews, contacts or offer (see
+// touchApplication).
+// Archived cards and users who disabled ghost detection are skipped.
+// Returns the number of newly flagged applications.
+func (s *Service) DetectGhosted(ctx context.Context) (int, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("detectGhosted begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	rows, err := tx.Query(ctx,
+		`WITH candidates AS (
+		   SELECT a.id, a.updated_at AS last_activity_at
+		   FROM applications a
+		   LEFT JOIN tracker_settings ts ON ts.user_id = a.user_id
+		   WHERE a.current_status IN ('APPLIED', 'INTERVIEW')
+		     AND a.ghosted_at  IS NULL
+		     AND a.archived_at IS NULL
+		     AND COALESCE(ts.ghosting_enabled, TRUE)
+		     AND a.updated_at < NOW() - make_interval(days => COALESCE(ts.ghost_after_days, $1))
+		   FOR UPDATE OF a SKIP LOCKED
+		 )
+		 UPDATE applications a
+		 SET ghosted_at = NOW()
+		 FROM candidates c
+		 WHERE a.id = c.id
+		 RETURNING a.id::text, a.user_id::text, a.current_status::text, c.last_activity_at`,
+		s.opts.GhostAfterDays,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("detectGhosted: %w", err)
+	}
+	type ghosted struct {
+		appID, userID, status string
+		lastActivity          time.Time
+	}
+	var flagged []ghosted
+	for rows.Next() {
+		var g ghosted
+		if err := rows.Scan(&g.appID, &g.userID, &g.status, &g.lastActivity); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("detectGhosted scan: %w", err)
+		}
+		flagged = append(flagged, g)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("detectGhosted rows: %w", err)
+	}
+
+	for _, g := range flagged {
+		err := enqueueEvent(ctx, tx, "EVENT_APPLICATION_GHOSTED", map[string]any{
+			"type":           "EVENT_APPLICATION_GHOSTED",
+			"applicationId":  g.appID,
+			"userId":         g.userID,
+			"status":         g.status,
+			"lastActivityAt": g.lastActivity.UTC().Format(time.RFC3339),
+			"silentDays":     int(time.Since(g.lastActivity).Hours() / 24),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("detectGhosted: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("detectGhosted commit: %w", err)
+	}
+	invalidated := make(map[string]bool)
+	for _, g := range flagged {
+		if !invalidated[g.userID] {
+			s.InvalidateUserCache(ctx, g.userID)
+			invalidated[g.userID] = true
+		}
+	}
+	if len(flagged) > 0 {
+		slog.Info("ghosted applications flagged", "count", len(flagged))
+	}
+	return len(flagged), nil
+}
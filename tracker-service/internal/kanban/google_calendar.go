@@ -0,0 +1,604 @@
+package kanban
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"jobmate/tracker-service/internal/gcal"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GoogleCalendarStatus describes a user's Google Calendar connection.
+type GoogleCalendarStatus struct {
+	Connected bool `json:"connected"`
+	// NeedsReconnect is set once Google revoked the grant: syncing is paused
+	// until the user connects again.
+	NeedsReconnect bool       `json:"needsReconnect"`
+	CalendarID     string     `json:"calendarId"`
+	ConnectedAt    *time.Time `json:"connectedAt"`
+	LastSyncedAt   *time.Time `json:"lastSyncedAt"`
+	LastError      string     `json:"lastError"` // of the last sync, "" when it succeeded
+}
+
+const (
+	// googleCalendarName is the secondary calendar events are pushed to.
+	googleCalendarName = "JobMate"
+	// googleAuthStateTTL is how long the user has to complete the consent page.
+	googleAuthStateTTL = 15 * time.Minute
+	// googleSyncBatch caps the users synced per SyncGoogleCalendars run.
+	googleSyncBatch = 100
+	// googleSyncLease is how long a sync may run before another replica may
+	// take the same user over.
+	googleSyncLease = 10 * time.Minute
+)
+
+// ErrGoogleCalendarDisabled is returned when the deployment has no Google
+// OAuth client (or no token encryption key) configured.
+var ErrGoogleCalendarDisabled = errors.New("google calendar integration is not configured on this deployment")
+
+// googleLink is a user's stored connection, tokens decrypted.
+type googleLink struct {
+	UserID       string
+	CalendarID   string
+	RefreshToken string
+	AccessToken  string
+	Expiry       *time.Time
+	SyncToken    string
+}
+
+// googleEventMapping ties a calendarEvent (by UID) to the Google event it
+// was pushed as. StartAt and Fingerprint are what was last pushed.
+type googleEventMapping struct {
+	UID           string
+	Kind          string
+	SourceID      string
+	GoogleEventID string
+	StartAt       time.Time
+	Fingerprint   string
+	// Detached mappings were deleted in Google by the user: they are not
+	// pushed again until their start time changes in the tracker.
+	Detached bool
+}
+
+func (s *Service) googleCalendar() (*gcal.Client, error) {
+	if s.opts.GoogleCalendar == nil || s.opts.Secrets == nil {
+		return nil, ErrGoogleCalendarDisabled
+	}
+	return s.opts.GoogleCalendar, nil
+}
+
+// StartGoogleCalendarAuth returns the Google consent page URL the user must
+// visit to connect their calendar. Google then redirects to the Gateway,
+// which completes the flow with CompleteGoogleCalendarAuth.
+func (s *Service) StartGoogleCalendarAuth(ctx context.Context, userID string) (string, error) {
+	gc, err := s.googleCalendar()
+	if err != nil {
+		return "", err
+	}
+	state := newCalendarToken()
+	_, err = s.pool.Exec(ctx,
+		`WITH expired AS (
+		   DELETE FROM google_calendar_auth_states WHERE expires_at < NOW()
+		 )
+		 INSERT INTO google_calendar_auth_states (state, user_id, expires_at) VALUES ($1, $2, $3)`,
+		state, userID, time.Now().Add(googleAuthStateTTL))
+	if err != nil {
+		return "", fmt.Errorf("startGoogleCalendarAuth: %w", err)
+	}
+	return gc.AuthCodeURL(state), nil
+}
+
+// CompleteGoogleCalendarAuth finishes the OAuth flow started by
+// StartGoogleCalendarAuth: state identifies the user (it is single-use and
+// expires after googleAuthStateTTL), code is exchanged for a grant whose
+// tokens are stored encrypted. The "JobMate" calendar is created on first
+// connection; reconnecting keeps the existing one.
+func (s *Service) CompleteGoogleCalendarAuth(ctx context.Context, state, code string) (*GoogleCalendarStatus, error) {
+	gc, err := s.googleCalendar()
+	if err != nil {
+		return nil, err
+	}
+	if state == "" || code == "" {
+		return nil, &ValidationError{Field: "code", Msg: "state and code are required"}
+	}
+
+	var userID, calendarID string
+	err = s.pool.QueryRow(ctx,
+		`WITH st AS (
+		   DELETE FROM google_calendar_auth_states WHERE state = $1 AND expires_at > NOW()
+		   RETURNING user_id
+		 )
+		 SELECT st.user_id::text, COALESCE(l.calendar_id, '')
+		 FROM st LEFT JOIN google_calendar_links l ON l.user_id = st.user_id`,
+		state,
+	).Scan(&userID, &calendarID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &ValidationError{Field: "state", Msg: "unknown or expired authorization request, please connect again"}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("completeGoogleCalendarAuth state: %w", err)
+	}
+
+	tok, err := gc.Exchange(ctx, code)
+	if errors.Is(err, gcal.ErrUnauthorized) {
+		return nil, &ValidationError{Field: "code", Msg: "authorization code rejected by Google, please connect again"}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("completeGoogleCalendarAuth: %w", err)
+	}
+	if calendarID == "" {
+		if calendarID, err = gc.CreateCalendar(ctx, tok.AccessToken, googleCalendarName); err != nil {
+			return nil, fmt.Errorf("completeGoogleCalendarAuth: %w", err)
+		}
+	}
+
+	_, err = s.pool.Exec(ctx,
+		`INSERT INTO google_calendar_links (user_id, calendar_id, refresh_token, access_token, access_token_expires_at)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (user_id) DO UPDATE
+		 SET calendar_id = EXCLUDED.calendar_id, refresh_token = EXCLUDED.refresh_token,
+		     access_token = EXCLUDED.access_token, access_token_expires_at = EXCLUDED.access_token_expires_at,
+		     needs_reconnect = FALSE, last_error = NULL, connected_at = NOW()`,
+		userID, calendarID, s.opts.Secrets.Seal([]byte(tok.RefreshToken)),
+		s.opts.Secrets.Seal([]byte(tok.AccessToken)), tok.Expiry)
+	if err != nil {
+		return nil, fmt.Errorf("completeGoogleCalendarAuth store: %w", err)
+	}
+	slog.Info("google calendar connected", "user_id", userID)
+	return s.GetGoogleCalendarStatus(ctx, userID)
+}
+
+// GetGoogleCalendarStatus reports whether the user's Google Calendar is
+// connected and how its last sync went.
+func (s *Service) GetGoogleCalendarStatus(ctx context.Context, userID string) (*GoogleCalendarStatus, error) {
+	if _, err := s.googleCalendar(); err != nil {
+		return nil, err
+	}
+	st := GoogleCalendarStatus{Connected: true}
+	err := s.pool.QueryRow(ctx,
+		`SELECT calendar_id, needs_reconnect, connected_at, last_synced_at, COALESCE(last_error, '')
+		 FROM google_calendar_links WHERE user_id = $1`,
+		userID,
+	).Scan(&st.CalendarID, &st.NeedsReconnect, &st.ConnectedAt, &st.LastSyncedAt, &st.LastError)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &GoogleCalendarStatus{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getGoogleCalendarStatus: %w", err)
+	}
+	return &st, nil
+}
+
+// DisconnectGoogleCalendar revokes the user's grant and forgets their tokens.
+// The "JobMate" calendar is left in their Google account. Disconnecting
+// twice is a no-op.
+func (s *Service) DisconnectGoogleCalendar(ctx context.Context, userID string) error {
+	gc, err := s.googleCalendar()
+	if err != nil {
+		return err
+	}
+	link, err := s.loadGoogleLink(ctx, userID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil
+	case err != nil:
+		// Undecryptable tokens cannot be revoked, but must still go.
+		slog.Warn("google calendar disconnect: tokens unreadable", "user_id", userID, "err", err)
+	default:
+		if err := gc.Revoke(ctx, link.RefreshToken); err != nil {
+			slog.Warn("google calendar disconnect: revoke failed", "user_id", userID, "err", err)
+		}
+	}
+	if _, err := s.pool.Exec(ctx, `DELETE FROM google_calendar_links WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("disconnectGoogleCalendar: %w", err)
+	}
+	return nil
+}
+
+// SyncGoogleCalendars synchronizes the connected calendars least recently
+// synced first, up to googleSyncBatch users per run. For each user it
+// applies the reschedules made in Google since the last run, then pushes the
+// events of their iCalendar feed (interviews, relance reminders, offer
+// deadlines). A failing user does not stop the run: the error is recorded on
+// their connection. Returns the number of users synced successfully.
+func (s *Service) SyncGoogleCalendars(ctx context.Context) (int, error) {
+	if _, err := s.googleCalendar(); err != nil {
+		return 0, nil
+	}
+	rows, err := s.pool.Query(ctx,
+		`UPDATE google_calendar_links SET sync_started_at = NOW()
+		 WHERE user_id IN (
+		   SELECT user_id FROM google_calendar_links
+		   WHERE NOT needs_reconnect
+		     AND (sync_started_at IS NULL OR sync_started_at < NOW() - make_interval(secs => $2))
+		   ORDER BY last_synced_at NULLS FIRST
+		   LIMIT $1
+		   FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING user_id::text`,
+		googleSyncBatch, googleSyncLease.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("syncGoogleCalendars claim: %w", err)
+	}
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, fmt.Errorf("syncGoogleCalendars claim: %w", err)
+	}
+
+	synced := 0
+	for _, userID := range userIDs {
+		syncErr := s.syncGoogleCalendar(ctx, userID)
+		var lastError *string
+		if syncErr != nil {
+			slog.Warn("google calendar sync failed", "user_id", userID, "err", syncErr)
+			msg := syncErr.Error()
+			lastError = &msg
+		} else {
+			synced++
+		}
+		_, err := s.pool.Exec(ctx,
+			`UPDATE google_calendar_links
+			 SET sync_started_at = NULL, last_synced_at = NOW(), last_error = $2,
+			     needs_reconnect = needs_reconnect OR $3
+			 WHERE user_id = $1`,
+			userID, lastError, errors.Is(syncErr, gcal.ErrUnauthorized))
+		if err != nil {
+			return synced, fmt.Errorf("syncGoogleCalendars record: %w", err)
+		}
+	}
+	return synced, nil
+}
+
+func (s *Service) syncGoogleCalendar(ctx context.Context, userID string) error {
+	link, err := s.loadGoogleLink(ctx, userID)
+	if err != nil {
+		return err
+	}
+	accessToken, err := s.googleAccessToken(ctx, link)
+	if err != nil {
+		return err
+	}
+	if err := s.pullGoogleChanges(ctx, link, accessToken); err != nil {
+		return err
+	}
+	return s.pushGoogleEvents(ctx, link, accessToken)
+}
+
+func (s *Service) loadGoogleLink(ctx context.Context, userID string) (*googleLink, error) {
+	var (
+		refresh, access []byte
+		link            = googleLink{UserID: userID}
+	)
+	err := s.pool.QueryRow(ctx,
+		`SELECT calendar_id, refresh_token, access_token, access_token_expires_at, COALESCE(sync_token, '')
+		 FROM google_calendar_links WHERE user_id = $1`,
+		userID,
+	).Scan(&link.CalendarID, &refresh, &access, &link.Expiry, &link.SyncToken)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := s.opts.Secrets.Open(refresh)
+	if err != nil {
+		return nil, fmt.Errorf("google refresh token: %w", err)
+	}
+	link.RefreshToken = string(plain)
+	if access != nil {
+		if plain, err = s.opts.Secrets.Open(access); err == nil {
+			link.AccessToken = string(plain)
+		}
+	}
+	return &link, nil
+}
+
+// googleAccessToken returns a valid access token, refreshing (and storing)
+// it when it is about to expire.
+func (s *Service) googleAccessToken(ctx context.Context, link *googleLink) (string, error) {
+	if link.AccessToken != "" && link.Expiry != nil && time.Until(*link.Expiry) > time.Minute {
+		return link.AccessToken, nil
+	}
+	tok, err := s.opts.GoogleCalendar.Refresh(ctx, link.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	_, err = s.pool.Exec(ctx,
+		`UPDATE google_calendar_links SET access_token = $2, access_token_expires_at = $3 WHERE user_id = $1`,
+		link.UserID, s.opts.Secrets.Seal([]byte(tok.AccessToken)), tok.Expiry)
+	if err != nil {
+		return "", fmt.Errorf("google access token store: %w", err)
+	}
+	return tok.AccessToken, nil
+}
+
+// pullGoogleChanges applies to the tracker the events the user moved in
+// Google since the last sync, and detaches those they deleted. A tracker
+// change made in the meantime wins: it is pushed over the Google one.
+func (s *Service) pullGoogleChanges(ctx context.Context, link *googleLink, accessToken string) error {
+	gc := s.opts.GoogleCalendar
+	changed, next, err := gc.ListChanges(ctx, accessToken, link.CalendarID, link.SyncToken)
+	if errors.Is(err, gcal.ErrSyncTokenExpired) {
+		changed, next, err = gc.ListChanges(ctx, accessToken, link.CalendarID, "")
+	}
+	if errors.Is(err, gcal.ErrNotFound) {
+		return s.recreateGoogleCalendar(ctx, link, accessToken)
+	}
+	if err != nil {
+		return err
+	}
+
+	mappings, err := s.googleEventMappings(ctx, link.UserID)
+	if err != nil {
+		return err
+	}
+	byGoogleID := make(map[string]googleEventMapping, len(mappings))
+	for _, m := range mappings {
+		byGoogleID[m.GoogleEventID] = m
+	}
+	for _, e := range changed {
+		m, ok := byGoogleID[e.ID]
+		if !ok || m.Detached {
+			continue
+		}
+		if e.Cancelled {
+			if _, err := s.pool.Exec(ctx,
+				`UPDATE google_calendar_events SET detached = TRUE WHERE user_id = $1 AND uid = $2`,
+				link.UserID, m.UID,
+			); err != nil {
+				return fmt.Errorf("google detach event: %w", err)
+			}
+			continue
+		}
+		if e.Start.IsZero() || e.Start.Equal(m.StartAt) {
+			continue
+		}
+		if err := s.applyGoogleReschedule(ctx, link.UserID, m, e.Start); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.pool.Exec(ctx,
+		`UPDATE google_calendar_links SET sync_token = NULLIF($2, '') WHERE user_id = $1`,
+		link.UserID, next,
+	); err != nil {
+		return fmt.Errorf("google sync token store: %w", err)
+	}
+	return nil
+}
+
+// recreateGoogleCalendar replaces a calendar the user deleted in Google;
+// every event is pushed to the new one.
+func (s *Service) recreateGoogleCalendar(ctx context.Context, link *googleLink, accessToken string) error {
+	calendarID, err := s.opts.GoogleCalendar.CreateCalendar(ctx, accessToken, googleCalendarName)
+	if err != nil {
+		return err
+	}
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx,
+			`UPDATE google_calendar_links SET calendar_id = $2, sync_token = NULL WHERE user_id = $1`,
+			link.UserID, calendarID,
+		); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `DELETE FROM google_calendar_events WHERE user_id = $1`, link.UserID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("google recreate calendar: %w", err)
+	}
+	link.CalendarID, link.SyncToken = calendarID, ""
+	return nil
+}
+
+// applyGoogleReschedule moves the tracker source of m to start, unless it
+// changed in the tracker since it was pushed. A moved relance reminder is
+// logged as set by the user.
+func (s *Service) applyGoogleReschedule(ctx context.Context, userID string, m googleEventMapping, start time.Time) error {
+	var (
+		query, field string
+		logged       []HistoryEntry
+	)
+	switch m.Kind {
+	case "interview":
+		query = `UPDATE interviews SET scheduled_at = $3, updated_at = NOW()
+		         WHERE id = $1 AND user_id = $2 AND date_trunc('second', scheduled_at) = $4
+		         RETURNING application_id::text`
+		field = "interviews"
+	case "relance":
+		query = `UPDATE applications SET relance_reminder_at = $3
+		         WHERE id = $1 AND user_id = $2 AND date_trunc('second', relance_reminder_at) = $4
+		         RETURNING id::text`
+		field = "relance_reminder_at"
+		remindAt := start.UTC()
+		logged = append(logged, HistoryEntry{Kind: HistoryReminderSet, At: time.Now().UTC().Truncate(time.Second), RemindAt: &remindAt})
+	case "offer":
+		query = `UPDATE offers SET response_deadline = $3, updated_at = NOW()
+		         WHERE application_id = $1 AND user_id = $2 AND date_trunc('second', response_deadline) = $4
+		         RETURNING application_id::text`
+		field = "offer"
+	default:
+		return nil
+	}
+
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		var appID string
+		err := tx.QueryRow(ctx, query, m.SourceID, userID, start, m.StartAt).Scan(&appID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil // changed or deleted in the tracker: the push wins
+		}
+		if err != nil {
+			return fmt.Errorf("google reschedule %s: %w", m.Kind, err)
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE google_calendar_events SET start_at = $3 WHERE user_id = $1 AND uid = $2`,
+			userID, m.UID, start,
+		); err != nil {
+			return fmt.Errorf("google reschedule: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{field}, logged...)
+	})
+	if err == nil {
+		s.InvalidateUserCache(ctx, userID)
+	}
+	return err
+}
+
+// pushGoogleEvents brings the Google calendar in line with the user's feed.
+func (s *Service) pushGoogleEvents(ctx context.Context, link *googleLink, accessToken string) error {
+	events, err := s.calendarEvents(ctx, link.UserID)
+	if err != nil {
+		return err
+	}
+	mappings, err := s.googleEventMappings(ctx, link.UserID)
+	if err != nil {
+		return err
+	}
+
+	gc := s.opts.GoogleCalendar
+	for _, op := range planGooglePush(events, mappings, time.Now().Add(-calendarHistory)) {
+		switch op.Kind {
+		case googleInsert, googleUpdate:
+			ge := gcal.Event{
+				ID:          op.Mapping.GoogleEventID,
+				UID:         op.Event.UID,
+				Summary:     op.Event.Summary,
+				Description: op.Event.Description,
+				Start:       op.Event.Start,
+				End:         op.Event.Start.Add(op.Event.Duration),
+			}
+			err := gcal.ErrNotFound
+			if op.Kind == googleUpdate {
+				err = gc.UpdateEvent(ctx, accessToken, link.CalendarID, ge)
+			}
+			if errors.Is(err, gcal.ErrNotFound) {
+				var created *gcal.Event
+				if created, err = gc.InsertEvent(ctx, accessToken, link.CalendarID, ge); err == nil {
+					ge.ID = created.ID
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if err := s.storeGoogleEventMapping(ctx, link.UserID, op.Event, ge.ID); err != nil {
+				return err
+			}
+		case googleDelete, googleForget:
+			if op.Kind == googleDelete {
+				if err := gc.DeleteEvent(ctx, accessToken, link.CalendarID, op.Mapping.GoogleEventID); err != nil {
+					return err
+				}
+			}
+			if _, err := s.pool.Exec(ctx,
+				`DELETE FROM google_calendar_events WHERE user_id = $1 AND uid = $2`,
+				link.UserID, op.Mapping.UID,
+			); err != nil {
+				return fmt.Errorf("google forget event: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) googleEventMappings(ctx context.Context, userID string) ([]googleEventMapping, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT uid, source_kind, source_id::text, google_event_id, start_at, fingerprint, detached
+		 FROM google_calendar_events WHERE user_id = $1`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("googleEventMappings query: %w", err)
+	}
+	defer rows.Close()
+
+	var mappings []googleEventMapping
+	for rows.Next() {
+		var m googleEventMapping
+		if err := rows.Scan(&m.UID, &m.Kind, &m.SourceID, &m.GoogleEventID, &m.StartAt, &m.Fingerprint, &m.Detached); err != nil {
+			return nil, fmt.Errorf("googleEventMappings scan: %w", err)
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, rows.Err()
+}
+
+func (s *Service) storeGoogleEventMapping(ctx context.Context, userID string, e calendarEvent, googleEventID string) error {
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO google_calendar_events (user_id, uid, source_kind, source_id, google_event_id, start_at, fingerprint)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 ON CONFLICT (user_id, uid) DO UPDATE
+		 SET google_event_id = EXCLUDED.google_event_id, start_at = EXCLUDED.start_at,
+		     fingerprint = EXCLUDED.fingerprint, detached = FALSE`,
+		userID, e.UID, e.Kind, e.SourceID, googleEventID, e.Start.Truncate(time.Second), googleEventFingerprint(e))
+	if err != nil {
+		return fmt.Errorf("google store event: %w", err)
+	}
+	return nil
+}
+
+// ─── Push planning ────────────────────────────────────────────────────────────
+
+type googleOpKind int
+
+const (
+	googleInsert googleOpKind = iota // create the event in Google
+	googleUpdate                     // overwrite the Google event
+	googleDelete                     // delete the Google event and its mapping
+	googleForget                     // drop the mapping, leave Google alone
+)
+
+type googleOp struct {
+	Kind    googleOpKind
+	Event   calendarEvent
+	Mapping googleEventMapping
+}
+
+// planGooglePush compares the user's feed with what was last pushed. Events
+// that left the feed are deleted from Google, except those that merely aged
+// out of it (started before cutoff): past interviews stay in the calendar.
+// Cancelled interviews are removed rather than pushed.
+func planGooglePush(events []calendarEvent, mappings []googleEventMapping, cutoff time.Time) []googleOp {
+	byUID := make(map[string]googleEventMapping, len(mappings))
+	for _, m := range mappings {
+		byUID[m.UID] = m
+	}
+
+	var ops []googleOp
+	inFeed := make(map[string]bool, len(events))
+	for _, e := range events {
+		m, mapped := byUID[e.UID]
+		inFeed[e.UID] = !e.Cancelled && mapped
+		switch {
+		case e.Cancelled:
+			// Handled with the events no longer in the feed.
+		case !mapped:
+			ops = append(ops, googleOp{Kind: googleInsert, Event: e})
+		case m.Detached:
+			if !e.Start.Truncate(time.Second).Equal(m.StartAt) {
+				ops = append(ops, googleOp{Kind: googleInsert, Event: e, Mapping: m})
+			}
+		case m.Fingerprint != googleEventFingerprint(e):
+			ops = append(ops, googleOp{Kind: googleUpdate, Event: e, Mapping: m})
+		}
+	}
+	for _, m := range mappings {
+		if inFeed[m.UID] {
+			continue
+		}
+		kind := googleForget
+		if !m.Detached && !m.StartAt.Before(cutoff) {
+			kind = googleDelete
+		}
+		ops = append(ops, googleOp{Kind: kind, Mapping: m})
+	}
+	return ops
+}
+
+// googleEventFingerprint identifies the pushed content of an event.
+func googleEventFingerprint(e calendarEvent) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%s\x00%d\x00%d",
+		e.Summary, e.Description, e.Start.Unix(), e.Duration))
+	return hex.EncodeToString(sum[:16])
+}
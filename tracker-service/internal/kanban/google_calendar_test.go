@@ -0,0 +1,74 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestPlanGooglePush(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-90 * 24 * time.Hour)
+	ev := func(uid string, start time.Time) kanban.CalendarEvent {
+		return kanban.CalendarEvent{UID: uid, Start: start, Duration: time.Hour, Summary: "Interview " + uid}
+	}
+	pushed := func(e kanban.CalendarEvent) kanban.GoogleEventMapping {
+		return kanban.GoogleEventMapping{UID: e.UID, GoogleEventID: "g-" + e.UID, StartAt: e.Start, Fingerprint: kanban.GoogleEventFingerprint(e)}
+	}
+
+	unchanged := ev("unchanged", now)
+	moved := ev("moved", now.Add(time.Hour))
+	movedBefore := pushed(ev("moved", now))
+	cancelled := ev("cancelled", now)
+	cancelled.Cancelled = true
+	detached := ev("detached", now)
+	detachedMapping := pushed(detached)
+	detachedMapping.Detached = true
+	detachedMoved := ev("detached-moved", now.Add(24*time.Hour))
+	detachedMovedMapping := pushed(ev("detached-moved", now))
+	detachedMovedMapping.Detached = true
+
+	events := []kanban.CalendarEvent{unchanged, moved, cancelled, detached, detachedMoved, ev("new", now)}
+	mappings := []kanban.GoogleEventMapping{
+		pushed(unchanged),
+		movedBefore,
+		pushed(ev("cancelled", now)),
+		detachedMapping,
+		detachedMovedMapping,
+		pushed(ev("removed", now)),
+		pushed(ev("aged-out", cutoff.Add(-time.Hour))),
+	}
+
+	got := make(map[string]kanban.GoogleOp)
+	for _, op := range kanban.PlanGooglePush(events, mappings, cutoff) {
+		uid := op.Event.UID
+		if uid == "" {
+			uid = op.Mapping.UID
+		}
+		if _, dup := got[uid]; dup {
+			t.Errorf("several operations for %q", uid)
+		}
+		got[uid] = op
+	}
+
+	want := map[string]kanban.GoogleOp{
+		"moved":          {Kind: kanban.GoogleUpdate},
+		"cancelled":      {Kind: kanban.GoogleDelete},
+		"detached-moved": {Kind: kanban.GoogleInsert},
+		"new":            {Kind: kanban.GoogleInsert},
+		"removed":        {Kind: kanban.GoogleDelete},
+		"aged-out":       {Kind: kanban.GoogleForget},
+	}
+	if len(got) != len(want) {
+		t.Errorf("got operations for %d events, want %d: %+v", len(got), len(want), got)
+	}
+	for uid, w := range want {
+		if op, ok := got[uid]; !ok || op.Kind != w.Kind {
+			t.Errorf("%s: got %+v (present: %v), want kind %d", uid, op, ok, w.Kind)
+		}
+	}
+	if got["moved"].Mapping.GoogleEventID != "g-moved" {
+		t.Errorf("update of %q does not target its Google event: %+v", "moved", got["moved"])
+	}
+}
@@ -6,20 +6,146 @@ import (
 	"time"
 )
 
+// ListFilter narrows a ListApplications call. The zero value lists every
+// non-archived application.
+type ListFilter struct {
+	// Status restricts results to a single Kanban column when non-empty.
+	Status string
+	// IncludeArchived also returns archived applications.
+	IncludeArchived bool
+	// Priority restricts results to a single priority when non-empty.
+	Priority string
+	// Sort is one of the Sort* constants; empty = SortUpdatedAt.
+	Sort string
+	// View is one of the View* constants; empty = ViewFull.
+	View string
+}
+
 // Application is the canonical representation of a job application row.
 // It is returned by all Service methods and converted to proto messages
-// by the gRPC server layer.
+// by the gRPC server layer. Its raw JSON fields are omitted from its own JSON
+// when NULL, so cached copies (readThrough, Idempotent) decode back to nil.
 type Application struct {
 	ID                   string          `json:"id"`
 	CurrentStatus        string          `json:"currentStatus"`
-	AIAnalysis           json.RawMessage `json:"aiAnalysis"`
+	AIAnalysis           json.RawMessage `json:"aiAnalysis,omitempty"`
 	GeneratedCoverLetter *string         `json:"generatedCoverLetter"`
 	UserNotes            *string         `json:"userNotes"`
+	FollowUpDraft        *string         `json:"followUpDraft"` // see RequestFollowUpDraft
 	UserRating           *int32          `json:"userRating"`
-	HistoryLog           json.RawMessage `json:"historyLog"`
+	RatingCriteria       *RatingCriteria `json:"ratingCriteria"` // see RateApplication
+	RatingReview         *string         `json:"ratingReview"`
+	HistoryLog           json.RawMessage `json:"historyLog,omitempty"`
 	JobFeedID            string          `json:"jobFeedId"`
 	SearchConfigID       string          `json:"searchConfigId"`
 	RelanceReminderAt    *time.Time      `json:"relanceReminderAt"`
+	ArchivedAt           *time.Time      `json:"archivedAt"`
+	HoldOrigin           string          `json:"holdOrigin"` // set while ON_HOLD
+	GhostedAt            *time.Time      `json:"ghostedAt"`
+	ColumnID             string          `json:"columnId"` // custom column, "" = default lane
+	Priority             string          `json:"priority"`
+	NextStepDueAt        *time.Time      `json:"nextStepDueAt"`
+	NextStepLabel        *string         `json:"nextStepLabel"`
+	FollowUpDraftAt      *time.Time      `json:"followUpDraftAt"`
+	RejectionReason      string          `json:"rejectionReason"` // set while REJECTED, may be ""
+	RejectionStage       string          `json:"rejectionStage"`  // set while REJECTED
+	ReminderRule         ReminderRule    `json:"reminderRule"`    // zero = no rule
 	CreatedAt            time.Time       `json:"createdAt"`
 	UpdatedAt            time.Time       `json:"updatedAt"`
+
+	// Denormalized from the linked job_feed row ("" when unknown/deleted).
+	JobTitle  string `json:"jobTitle"`
+	Company   string `json:"company"`
+	Location  string `json:"location"`
+	SourceURL string `json:"sourceUrl"`
+
+	// Loaded by GetApplication only.
+	Interviews []Interview `json:"interviews,omitempty"`
+	Offer      *Offer      `json:"offer,omitempty"`
+}
+
+// HistoryEntry is one element of applications.history_log. Entries without
+// a Kind are status moves; others record an event on the card and leave
+// From/To empty.
+type HistoryEntry struct {
+	From string    `json:"from,omitempty"`
+	To   string    `json:"to,omitempty"`
+	At   time.Time `json:"at"`
+	// Undo marks a compensating entry written by UndoLastMove.
+	Undo bool `json:"undo,omitempty"`
+	// Reason is the user's comment on a move (see MoveCard).
+	Reason string `json:"reason,omitempty"`
+	// Set on moves to REJECTED (see Rejection).
+	RejectionReason string `json:"rejectionReason,omitempty"`
+	RejectionStage  string `json:"rejectionStage,omitempty"`
+
+	Kind string `json:"kind,omitempty"` // "" or one of the History* kinds
+	// Set on HistoryInterviewFeedback entries; Outcome also on HistoryAnalyzed
+	// ones.
+	InterviewID string `json:"interviewId,omitempty"`
+	Round       int32  `json:"round,omitempty"`
+	Outcome     string `json:"outcome,omitempty"`
+	// MergedFrom is the application a HistoryMerge entry absorbed, or that an
+	// entry was carried over from by MergeApplications.
+	MergedFrom string `json:"mergedFrom,omitempty"`
+	// Set on HistoryReminderSet and HistoryReminderSnoozed entries: the new
+	// reminder time; on HistoryReminderFired entries: the time it was due.
+	RemindAt *time.Time `json:"remindAt,omitempty"`
+	// SearchConfigID is the search a HistorySearchArchived, HistorySearchKept
+	// or HistorySearchReactivated entry is about.
+	SearchConfigID string `json:"searchConfigId,omitempty"`
+	// Action is the action a HistoryUndo entry reverted (ActionRating or
+	// ActionNoteEdit).
+	Action string `json:"action,omitempty"`
+	// Actor is who made the change: "" (the card's owner) or one of the
+	// Actor* values.
+	Actor string `json:"actor,omitempty"`
+}
+
+// History entry actors.
+const (
+	ActorUser     = "USER"     // the card's owner
+	ActorSystem   = "SYSTEM"   // a background job
+	ActorOperator = "OPERATOR" // support staff, with trackerctl (see ForceMove)
+)
+
+// History entry kinds other than status moves.
+const (
+	// HistoryMove is the Kind GetHistory reports for status moves, which are
+	// stored without one.
+	HistoryMove = "MOVE"
+	// HistoryInterviewFeedback is written when feedback is recorded for an interview.
+	HistoryInterviewFeedback = "INTERVIEW_FEEDBACK"
+	// HistoryMerge is written when another application is merged into this one.
+	HistoryMerge = "MERGE"
+	// HistoryReminderSet is written when a relance reminder time is set.
+	HistoryReminderSet = "REMINDER_SET"
+	// HistoryReminderFired is written when the relance reminder, fixed or
+	// from a reminder rule, is dispatched.
+	HistoryReminderFired = "REMINDER_FIRED"
+	// HistoryReminderSnoozed is written when the relance reminder is pushed back.
+	HistoryReminderSnoozed = "REMINDER_SNOOZED"
+	// HistoryReminderCleared is written when the relance reminder is dismissed.
+	HistoryReminderCleared = "REMINDER_CLEARED"
+	// HistoryAnalyzed is written when the AI Coach's analysis results are
+	// stored, with the analysis status as Outcome.
+	HistoryAnalyzed = "ANALYZED"
+	// HistorySearchArchived is written when a move to HIRED deactivates the
+	// search the card came from.
+	HistorySearchArchived = "SEARCH_ARCHIVED"
+	// HistorySearchKept is written when a move to HIRED leaves that search
+	// active, as the user's preferences ask.
+	HistorySearchKept = "SEARCH_KEPT"
+	// HistorySearchReactivated is written when that search is reactivated
+	// (ReactivateSearchConfig, ReopenSearch, or undoing the move to HIRED).
+	HistorySearchReactivated = "SEARCH_REACTIVATED"
+	// HistoryUndo is written when UndoLastAction restores a rating or a note
+	// (undone moves are compensating moves, marked Undo).
+	HistoryUndo = "UNDO"
+)
+
+// IsMove reports whether the entry records a status change of this
+// application (as opposed to one carried over from a merged card).
+func (h HistoryEntry) IsMove() bool {
+	return (h.Kind == "" || h.Kind == HistoryMove) && h.MergedFrom == ""
 }
@@ -0,0 +1,193 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrSearchConfigNotFound is returned when a search_config does not exist or
+// belong to the caller.
+var ErrSearchConfigNotFound = errors.New("search config not found")
+
+// Moving a card to HIRED deactivates the search_config its job came from, so
+// the Discovery Service stops looking for jobs the user no longer needs.
+// Users running several searches in parallel can keep them active: for all
+// of them (Settings.ArchiveSearchOnHired) or for one (SetSearchConfigArchival).
+// Either way the decision is recorded in the card's history.
+
+// reactivateSearchConfig reactivates the inactive search the application came
+// from, queues a scan of it (see queueSearchScan) and returns the
+// HistorySearchReactivated entry recording it, or nil when there was none.
+func reactivateSearchConfig(ctx context.Context, q querier, appID string, at time.Time) (*HistoryEntry, error) {
+	var configID, userID string
+	err := q.QueryRow(ctx,
+		`UPDATE search_configs sc
+		 SET is_active  = true,
+		     updated_at = NOW()
+		 FROM applications a
+		 JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.id  = $1
+		   AND sc.id = jf.search_config_id
+		   AND NOT sc.is_active
+		 RETURNING sc.id, sc.user_id`,
+		appID,
+	).Scan(&configID, &userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reactivateSearchConfig: %w", err)
+	}
+	if err := queueSearchScan(ctx, q, userID, configID); err != nil {
+		return nil, fmt.Errorf("reactivateSearchConfig: %w", err)
+	}
+	return &HistoryEntry{Kind: HistorySearchReactivated, At: at, SearchConfigID: configID}, nil
+}
+
+// queueSearchScan asks the Discovery Service to scan a reactivated search
+// right away (CMD_SCRAPE_CONFIG) rather than at its next scheduled run, so
+// offers resume the moment the user is looking again.
+func queueSearchScan(ctx context.Context, q querier, userID, configID string) error {
+	return enqueueEvent(ctx, q, "CMD_SCRAPE_CONFIG", map[string]any{
+		"type":           "CMD_SCRAPE_CONFIG",
+		"searchConfigId": configID,
+		"userId":         userID,
+	})
+}
+
+// searchArchivedByLastMove reports whether the last move of history archived
+// the card's search, i.e. is followed by a HistorySearchArchived entry.
+func searchArchivedByLastMove(history []HistoryEntry) bool {
+	for i := len(history) - 1; i >= 0; i-- {
+		switch {
+		case history[i].Kind == HistorySearchArchived:
+			return true
+		case history[i].IsMove():
+			return false
+		}
+	}
+	return false
+}
+
+// SetSearchConfigArchival sets whether moving one of the search's cards to
+// HIRED deactivates it. The user-wide Settings.ArchiveSearchOnHired must
+// allow it too.
+func (s *Service) SetSearchConfigArchival(ctx context.Context, userID, configID string, archiveOnHired bool) error {
+	if configID == "" {
+		return &ValidationError{Field: "search_config_id", Msg: "search_config_id is required"}
+	}
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE search_configs SET archive_on_hired = $1, updated_at = NOW()
+		 WHERE id = $2 AND user_id = $3`,
+		archiveOnHired, configID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("setSearchConfigArchival: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSearchConfigNotFound
+	}
+	return nil
+}
+
+// ReactivateSearchConfig reactivates the search an application came from,
+// typically after a move to HIRED archived it, and records it in the card's
+// history. Returns a ValidationError if that search is already active or the
+// card has none.
+func (s *Service) ReactivateSearchConfig(ctx context.Context, userID, appID string) (*Application, error) {
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		var one int
+		err := tx.QueryRow(ctx,
+			`SELECT 1 FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+			appID, userID,
+		).Scan(&one)
+		if err != nil {
+			return ErrNotFound
+		}
+		entry, err := reactivateSearchConfig(ctx, tx, appID, time.Now().UTC().Truncate(time.Second))
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return &ValidationError{Msg: "application has no inactive search to reactivate"}
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"history_log"}, *entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetApplication(ctx, userID, appID)
+}
+
+// ReopenSearch reactivates one of the user's searches, typically one a move
+// to HIRED archived before the hire fell through, and queues a scan of it so
+// discovery resumes immediately. The cards whose move to HIRED archived it
+// record the reactivation in their history. Returns a ValidationError if the
+// search is already active.
+func (s *Service) ReopenSearch(ctx context.Context, userID, configID string) error {
+	if configID == "" {
+		return &ValidationError{Field: "search_config_id", Msg: "search_config_id is required"}
+	}
+	return s.inTx(ctx, func(tx pgx.Tx) error {
+		var active bool
+		err := tx.QueryRow(ctx,
+			`SELECT is_active FROM search_configs WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+			configID, userID,
+		).Scan(&active)
+		if err != nil {
+			return ErrSearchConfigNotFound
+		}
+		if active {
+			return &ValidationError{Msg: "search is already active"}
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE search_configs SET is_active = true, updated_at = NOW() WHERE id = $1`,
+			configID,
+		); err != nil {
+			return fmt.Errorf("reopenSearch: %w", err)
+		}
+
+		// The cards whose archival this undoes: those whose log last decided
+		// on this search by archiving it.
+		entry := HistoryEntry{
+			Kind:           HistorySearchReactivated,
+			At:             time.Now().UTC().Truncate(time.Second),
+			SearchConfigID: configID,
+		}
+		rows, err := tx.Query(ctx,
+			`SELECT a.id::text
+			 FROM applications a
+			 JOIN job_feed jf ON jf.id = a.job_feed_id
+			 WHERE jf.search_config_id = $1 AND a.user_id = $2
+			   AND (SELECT e->>'kind'
+			        FROM jsonb_array_elements(a.history_log) WITH ORDINALITY AS h(e, n)
+			        WHERE e->>'kind' IN ($3, $4, $5)
+			        ORDER BY n DESC LIMIT 1) = $3
+			 FOR UPDATE OF a`,
+			configID, userID, HistorySearchArchived, HistorySearchKept, HistorySearchReactivated,
+		)
+		if err != nil {
+			return fmt.Errorf("reopenSearch cards: %w", err)
+		}
+		appIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("reopenSearch cards: %w", err)
+		}
+		for _, appID := range appIDs {
+			if err := touchApplication(ctx, tx, userID, appID, []string{"history_log"}, entry); err != nil {
+				return err
+			}
+		}
+
+		if err := queueSearchScan(ctx, tx, userID, configID); err != nil {
+			return fmt.Errorf("reopenSearch: %w", err)
+		}
+		slog.Info("search reopened", "userId", userID, "searchConfigId", configID, "cards", len(appIDs))
+		return nil
+	})
+}
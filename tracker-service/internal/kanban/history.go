@@ -0,0 +1,111 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// HistoryPage is one page of an application's history, newest entries first.
+type HistoryPage struct {
+	Entries       []HistoryEntry `json:"entries"`
+	NextPageToken string         `json:"nextPageToken"` // "" on the last page
+	TotalCount    int            `json:"totalCount"`
+}
+
+// GetHistory page sizes.
+const (
+	defaultHistoryPageSize = 50
+	maxHistoryPageSize     = 200
+)
+
+// GetHistory returns a page of the application's history_log, newest first,
+// so clients need not load the whole log. pageSize 0 means
+// defaultHistoryPageSize; pageToken is the NextPageToken of the previous
+// page ("" = first page). Entries are typed: Kind is HistoryMove for status
+// moves and Actor is ActorUser when not recorded.
+//
+// Tokens are positions in the log: a merge, which rewrites it, may make the
+// following page skip or repeat entries.
+func (s *Service) GetHistory(ctx context.Context, userID, appID string, pageSize int, pageToken string) (*HistoryPage, error) {
+	size, before, err := parseHistoryPage(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		total int
+		raw   []byte
+	)
+	err = s.pool.QueryRow(ctx,
+		`SELECT jsonb_array_length(a.history_log),
+		        COALESCE((SELECT jsonb_agg(p.e ORDER BY p.i DESC)
+		                  FROM (SELECT h.e, h.i
+		                        FROM jsonb_array_elements(a.history_log) WITH ORDINALITY AS h(e, i)
+		                        WHERE $3::bigint IS NULL OR h.i < $3
+		                        ORDER BY h.i DESC
+		                        LIMIT $4) p), '[]')
+		 FROM applications a
+		 WHERE a.id = $1 AND a.user_id = $2`,
+		appID, userID, before, size,
+	).Scan(&total, &raw)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	page := &HistoryPage{TotalCount: total}
+	if err := json.Unmarshal(raw, &page.Entries); err != nil {
+		return nil, fmt.Errorf("getHistory decode: %w", err)
+	}
+	for i := range page.Entries {
+		page.Entries[i] = typedHistoryEntry(page.Entries[i])
+	}
+	page.NextPageToken = nextHistoryPageToken(total, before, len(page.Entries))
+	return page, nil
+}
+
+// parseHistoryPage validates GetHistory's paging arguments. before is the
+// 1-based log position entries must precede; nil for the first page.
+func parseHistoryPage(pageSize int, pageToken string) (size int, before *int, err error) {
+	switch {
+	case pageSize < 0 || pageSize > maxHistoryPageSize:
+		return 0, nil, &ValidationError{Field: "page_size", Msg: fmt.Sprintf("page_size must be between 0 and %d", maxHistoryPageSize)}
+	case pageSize == 0:
+		pageSize = defaultHistoryPageSize
+	}
+	if pageToken == "" {
+		return pageSize, nil, nil
+	}
+	pos, err := strconv.Atoi(pageToken)
+	if err != nil || pos < 2 {
+		return 0, nil, &ValidationError{Field: "page_token", Msg: "invalid page_token"}
+	}
+	return pageSize, &pos, nil
+}
+
+// nextHistoryPageToken returns the token of the page following one of n
+// entries read before position before (nil = from the end) of a log of
+// total entries, or "" when that page reached the oldest entry.
+func nextHistoryPageToken(total int, before *int, n int) string {
+	start := total // position of the newest entry of the page
+	if before != nil && *before-1 < start {
+		start = *before - 1
+	}
+	oldest := start - n + 1
+	if n == 0 || oldest <= 1 {
+		return ""
+	}
+	return strconv.Itoa(oldest)
+}
+
+// typedHistoryEntry fills in the defaults left implicit in history_log.
+func typedHistoryEntry(e HistoryEntry) HistoryEntry {
+	if e.Kind == "" {
+		e.Kind = HistoryMove
+	}
+	if e.Actor == "" {
+		e.Actor = ActorUser
+	}
+	return e
+}
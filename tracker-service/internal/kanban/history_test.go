@@ -0,0 +1,55 @@
+package kanban_test
+
+import (
+	"errors"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestNextHistoryPageToken(t *testing.T) {
+	pos := func(n int) *int { return &n }
+	for _, tc := range []struct {
+		name   string
+		total  int
+		before *int
+		n      int
+		want   string
+	}{
+		{"first page, more left", 120, nil, 50, "71"},
+		{"second page, more left", 120, pos(71), 50, "21"},
+		{"last page", 120, pos(21), 20, ""},
+		{"whole log on one page", 10, nil, 10, ""},
+		{"empty log", 0, nil, 0, ""},
+		{"log shrunk by a merge", 30, pos(71), 30, ""},
+	} {
+		if got := kanban.NextHistoryPageToken(tc.total, tc.before, tc.n); got != tc.want {
+			t.Errorf("%s: NextHistoryPageToken(%d, _, %d) = %q, want %q", tc.name, tc.total, tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestParseHistoryPage(t *testing.T) {
+	if size, before, err := kanban.ParseHistoryPage(0, ""); err != nil || size != 50 || before != nil {
+		t.Errorf("ParseHistoryPage(0, \"\") = %d, %v, %v; want the default size from the newest entry", size, before, err)
+	}
+	if size, before, err := kanban.ParseHistoryPage(20, "71"); err != nil || size != 20 || before == nil || *before != 71 {
+		t.Errorf("ParseHistoryPage(20, \"71\") = %d, %v, %v; want 20 entries before position 71", size, before, err)
+	}
+	for _, tc := range []struct {
+		size  int
+		token string
+		field string
+	}{
+		{-1, "", "page_size"},
+		{201, "", "page_size"},
+		{10, "abc", "page_token"},
+		{10, "1", "page_token"},
+	} {
+		_, _, err := kanban.ParseHistoryPage(tc.size, tc.token)
+		var verr *kanban.ValidationError
+		if !errors.As(err, &verr) || verr.Field != tc.field {
+			t.Errorf("ParseHistoryPage(%d, %q): error = %v, want a %s ValidationError", tc.size, tc.token, err, tc.field)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package kanban
+
+import (
+	"strings"
+	"time"
+)
+
+// calendarEvent is one VEVENT of a user's iCalendar feed.
+type calendarEvent struct {
+	UID         string // stable across renderings so calendars update in place
+	Kind        string // "relance", "interview" or "offer"
+	SourceID    string // application ID, or interview ID for interviews
+	Start       time.Time
+	Duration    time.Duration
+	Summary     string
+	Description string
+	Cancelled   bool
+}
+
+const icsTimeFormat = "20060102T150405Z"
+
+// renderICS renders events as an RFC 5545 VCALENDAR, stamped at stamp.
+func renderICS(events []calendarEvent, stamp time.Time) string {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(foldICSLine(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//JobMate//Tracker//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:JobMate")
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UID)
+		line("DTSTAMP:" + stamp.UTC().Format(icsTimeFormat))
+		line("DTSTART:" + e.Start.UTC().Format(icsTimeFormat))
+		line("DTEND:" + e.Start.Add(e.Duration).UTC().Format(icsTimeFormat))
+		line("SUMMARY:" + escapeICSText(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION:" + escapeICSText(e.Description))
+		}
+		if e.Cancelled {
+			line("STATUS:CANCELLED")
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+// escapeICSText escapes a TEXT property value (RFC 5545 §3.3.11).
+func escapeICSText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// foldICSLine splits a content line into chunks of at most 75 octets,
+// continuation lines starting with a space (RFC 5545 §3.1). UTF-8 sequences
+// are never split.
+func foldICSLine(s string) string {
+	const max = 75
+	if len(s) <= max {
+		return s
+	}
+	var b strings.Builder
+	limit := max
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 { // continuation byte
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		limit = max - 1 // the leading space counts
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package kanban_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestRenderICS(t *testing.T) {
+	stamp := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 5, 4, 14, 30, 0, 0, time.FixedZone("CEST", 2*3600))
+	got := kanban.RenderICS([]kanban.CalendarEvent{{
+		UID:         "interview-42@jobmate",
+		Start:       start,
+		Duration:    time.Hour,
+		Summary:     "Interview: Go developer, Acme; onsite",
+		Description: "Bring ID\nAsk about remote",
+		Cancelled:   true,
+	}}, stamp)
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:interview-42@jobmate\r\n",
+		"DTSTAMP:20260501T080000Z\r\n",
+		"DTSTART:20260504T123000Z\r\n",
+		"DTEND:20260504T133000Z\r\n",
+		`SUMMARY:Interview: Go developer\, Acme\; onsite` + "\r\n",
+		`DESCRIPTION:Bring ID\nAsk about remote` + "\r\n",
+		"STATUS:CANCELLED\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(strings.ReplaceAll(got, "\r\n", ""), "\n") {
+		t.Error("bare LF in output, want CRLF line endings only")
+	}
+}
+
+func TestFoldICSLine(t *testing.T) {
+	if got := kanban.FoldICSLine("SUMMARY:short"); got != "SUMMARY:short" {
+		t.Errorf("short line folded: %q", got)
+	}
+
+	long := "SUMMARY:" + strings.Repeat("é", 100)
+	got := kanban.FoldICSLine(long)
+	lines := strings.Split(got, "\r\n")
+	if len(lines) < 3 {
+		t.Fatalf("got %d lines, want the value folded", len(lines))
+	}
+	var rebuilt strings.Builder
+	for i, l := range lines {
+		if len(l) > 75 {
+			t.Errorf("line %d is %d octets, want at most 75", i, len(l))
+		}
+		if i > 0 {
+			if !strings.HasPrefix(l, " ") {
+				t.Fatalf("continuation line %d does not start with a space: %q", i, l)
+			}
+			l = l[1:]
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("line %d splits a UTF-8 sequence: %q", i, l)
+		}
+		rebuilt.WriteString(l)
+	}
+	if rebuilt.String() != long {
+		t.Error("unfolding does not give back the original line")
+	}
+}
@@ -0,0 +1,137 @@
+package kanban
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// idempotencyTTL is how long a processed idempotency key is remembered.
+// Client retries after a network flap happen within seconds; 24h also covers
+// mobile clients that queue mutations while offline.
+const idempotencyTTL = 24 * time.Hour
+
+// idempotencyPendingTTL is how long a key stays reserved by a call that
+// neither completes nor fails (its process died): longer than any call.
+const idempotencyPendingTTL = time.Minute
+
+// maxIdempotencyKeyLen bounds the key so it cannot be used to bloat Redis.
+const maxIdempotencyKeyLen = 128
+
+var (
+	// ErrIdempotentRequestPending is returned for a retry while the call
+	// that first used its idempotency key is still running.
+	ErrIdempotentRequestPending = errors.New("a request with this idempotency key is still in progress")
+	// ErrIdempotencyKeyReused is returned for a call reusing the
+	// idempotency key of a call with other parameters.
+	ErrIdempotencyKeyReused = errors.New("idempotency key already used for a different request")
+)
+
+// idempotencyRecord is what Redis holds for an idempotency key: the hash of
+// the request that reserved it, and its result once it succeeded.
+type idempotencyRecord struct {
+	Request  string       `json:"request"`
+	Response *Application `json:"response,omitempty"` // sealed, see sealCached
+}
+
+// Idempotent runs fn at most once per (userID, op, key).
+//
+// When key is empty fn is simply executed. Otherwise the key is reserved in
+// Redis before fn runs, and fn's successful result stored in its place and
+// returned as-is to any retry carrying the same key, so a retried MoveCard
+// does not append a second history entry and a retried CreateApplication
+// does not publish CMD_ANALYZE_JOB twice. A retry arriving while fn runs
+// fails with ErrIdempotentRequestPending. request, the call's parameters,
+// is hashed with the key: reusing the key for another request fails with
+// ErrIdempotencyKeyReused. Failed calls are not remembered — the client may
+// retry them. Results are stored with their sealed fields sealed.
+//
+// Redis errors are non-fatal: the mutation still runs, without replay protection.
+func (s *Service) Idempotent(ctx context.Context, userID, op, key string, request any, fn func(ctx context.Context) (*Application, error)) (*Application, error) {
+	if key == "" {
+		return fn(ctx)
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return nil, &ValidationError{Msg: fmt.Sprintf("idempotency_key must be at most %d characters", maxIdempotencyKeyLen)}
+	}
+
+	redisKey := fmt.Sprintf("tracker:idempotency:%s:%s:%s", userID, op, key)
+	hash, err := requestHash(request)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: %w", err)
+	}
+
+	pending, _ := json.Marshal(idempotencyRecord{Request: hash})
+	reserved, err := s.rdb.SetNX(ctx, redisKey, pending, idempotencyPendingTTL).Result()
+	if err != nil {
+		slog.Warn("idempotency: reservation failed", "op", op, "err", err)
+		return fn(ctx)
+	}
+	if !reserved {
+		return s.replayIdempotent(ctx, op, redisKey, hash)
+	}
+
+	// The call outlives a cancelled request: release or store regardless.
+	store := context.WithoutCancel(ctx)
+	app, err := fn(ctx)
+	if err != nil {
+		if err := s.rdb.Del(store, redisKey).Err(); err != nil {
+			slog.Warn("idempotency: release failed", "op", op, "err", err)
+		}
+		return nil, err
+	}
+
+	sealed := s.sealCached(*app)
+	if payload, mErr := json.Marshal(idempotencyRecord{Request: hash, Response: &sealed}); mErr == nil {
+		if err := s.rdb.Set(store, redisKey, payload, idempotencyTTL).Err(); err != nil {
+			slog.Warn("idempotency: store failed", "op", op, "err", err)
+		}
+	}
+	return app, nil
+}
+
+// replayIdempotent answers a call whose idempotency key is already taken:
+// the stored response of the call that took it, or the reason why not.
+func (s *Service) replayIdempotent(ctx context.Context, op, redisKey, hash string) (*Application, error) {
+	cached, err := s.rdb.Get(ctx, redisKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// Released by a failed call since: the client may retry.
+		return nil, ErrIdempotentRequestPending
+	}
+	if err != nil {
+		return nil, fmt.Errorf("idempotency lookup: %w", err)
+	}
+	var record idempotencyRecord
+	if err := json.Unmarshal(cached, &record); err != nil {
+		slog.Warn("idempotency: corrupt record, dropped", "op", op)
+		if err := s.rdb.Del(ctx, redisKey).Err(); err != nil {
+			slog.Warn("idempotency: release failed", "op", op, "err", err)
+		}
+		return nil, ErrIdempotentRequestPending
+	}
+	switch {
+	case record.Request != hash:
+		return nil, ErrIdempotencyKeyReused
+	case record.Response == nil:
+		return nil, ErrIdempotentRequestPending
+	}
+	s.openCached(record.Response)
+	return record.Response, nil
+}
+
+// requestHash returns the hash of the JSON of request.
+func requestHash(request any) (string, error) {
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,225 @@
+package kanban_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// memRedis is a Redis hook answering GET, SET (with NX) and DEL from memory,
+// without a server. Expirations are ignored.
+type memRedis struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func newMemRedis(t *testing.T) (*redis.Client, *memRedis) {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() { rdb.Close() })
+	m := &memRedis{data: map[string]string{}}
+	rdb.AddHook(m)
+	return rdb, m
+}
+
+func (m *memRedis) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (m *memRedis) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func (m *memRedis) ProcessHook(redis.ProcessHook) redis.ProcessHook {
+	return func(_ context.Context, cmd redis.Cmder) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		args := cmd.Args()
+		key := fmt.Sprint(args[1])
+		switch cmd.Name() {
+		case "get":
+			v, ok := m.data[key]
+			if !ok {
+				cmd.SetErr(redis.Nil)
+				return redis.Nil
+			}
+			cmd.(*redis.StringCmd).SetVal(v)
+		case "set":
+			_, exists := m.data[key]
+			if nx := args[len(args)-1] == "nx"; nx {
+				if !exists {
+					m.data[key] = string(args[2].([]byte))
+				}
+				cmd.(*redis.BoolCmd).SetVal(!exists)
+				return nil
+			}
+			m.data[key] = string(args[2].([]byte))
+			cmd.(*redis.StatusCmd).SetVal("OK")
+		case "del":
+			delete(m.data, key)
+			cmd.(*redis.IntCmd).SetVal(1)
+		default:
+			err := fmt.Errorf("memRedis: %s not supported", cmd.Name())
+			cmd.SetErr(err)
+			return err
+		}
+		return nil
+	}
+}
+
+func (m *memRedis) dump() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return fmt.Sprint(m.data)
+}
+
+type moveRequest struct{ ApplicationID, NewStatus string }
+
+func TestIdempotent_Replay(t *testing.T) {
+	rdb, mem := newMemRedis(t)
+	svc := kanban.NewService(nil, rdb, kanban.Options{FieldKeys: sealingKeys(t)})
+	ctx := context.Background()
+	req := moveRequest{"app-1", "APPLIED"}
+
+	calls := 0
+	note := "Recruiter: Jane, call back Monday"
+	fn := func(context.Context) (*kanban.Application, error) {
+		calls++
+		return &kanban.Application{ID: "app-1", CurrentStatus: "APPLIED", UserNotes: &note}, nil
+	}
+	first, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, fn)
+	if err != nil {
+		t.Fatalf("Idempotent: %v", err)
+	}
+	again, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, fn)
+	if err != nil || calls != 1 {
+		t.Fatalf("retry = %v, %d calls; want the stored response, 1 call", err, calls)
+	}
+	if again.ID != first.ID || again.UserNotes == nil || *again.UserNotes != note {
+		t.Errorf("retry = %+v, want the first response", again)
+	}
+	if strings.Contains(mem.dump(), "Jane") {
+		t.Errorf("stored response holds the note in clear: %s", mem.dump())
+	}
+
+	// Another user, operation or key is another call.
+	for _, k := range [][3]string{{"user-2", "MoveCard", "key-1"}, {"user-1", "AddNote", "key-1"}, {"user-1", "MoveCard", "key-2"}} {
+		if _, err := svc.Idempotent(ctx, k[0], k[1], k[2], req, fn); err != nil {
+			t.Fatalf("Idempotent(%v): %v", k, err)
+		}
+	}
+	if calls != 4 {
+		t.Errorf("%d calls, want 4", calls)
+	}
+}
+
+// A retry while the first call runs does not run it again.
+func TestIdempotent_Pending(t *testing.T) {
+	rdb, _ := newMemRedis(t)
+	svc := kanban.NewService(nil, rdb, kanban.Options{})
+	ctx := context.Background()
+	req := moveRequest{"app-1", "APPLIED"}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, func(context.Context) (*kanban.Application, error) {
+			close(started)
+			<-release
+			return &kanban.Application{ID: "app-1"}, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	ran := false
+	_, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, func(context.Context) (*kanban.Application, error) {
+		ran = true
+		return &kanban.Application{ID: "app-1"}, nil
+	})
+	if !errors.Is(err, kanban.ErrIdempotentRequestPending) || ran {
+		t.Errorf("concurrent retry = %v (ran: %t), want ErrIdempotentRequestPending without running", err, ran)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if app, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, nil); err != nil || app.ID != "app-1" {
+		t.Errorf("retry after completion = %v, %v; want the stored response", app, err)
+	}
+}
+
+func TestIdempotent_KeyReused(t *testing.T) {
+	rdb, _ := newMemRedis(t)
+	svc := kanban.NewService(nil, rdb, kanban.Options{})
+	ctx := context.Background()
+	fn := func(context.Context) (*kanban.Application, error) { return &kanban.Application{ID: "app-1"}, nil }
+
+	if _, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", moveRequest{"app-1", "APPLIED"}, fn); err != nil {
+		t.Fatal(err)
+	}
+	_, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", moveRequest{"app-2", "APPLIED"}, fn)
+	if !errors.Is(err, kanban.ErrIdempotencyKeyReused) {
+		t.Errorf("key reused for another request: %v, want ErrIdempotencyKeyReused", err)
+	}
+}
+
+// Failed calls release their key.
+func TestIdempotent_Failure(t *testing.T) {
+	rdb, mem := newMemRedis(t)
+	svc := kanban.NewService(nil, rdb, kanban.Options{})
+	ctx := context.Background()
+	req := moveRequest{"app-1", "APPLIED"}
+
+	boom := errors.New("boom")
+	if _, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, func(context.Context) (*kanban.Application, error) {
+		return nil, boom
+	}); !errors.Is(err, boom) {
+		t.Fatalf("failing call = %v, want its error", err)
+	}
+	if got := mem.dump(); got != "map[]" {
+		t.Errorf("after a failure Redis holds %s, want the key released", got)
+	}
+	app, err := svc.Idempotent(ctx, "user-1", "MoveCard", "key-1", req, func(context.Context) (*kanban.Application, error) {
+		return &kanban.Application{ID: "app-1"}, nil
+	})
+	if err != nil || app.ID != "app-1" {
+		t.Errorf("retry after a failure = %v, %v; want it run", app, err)
+	}
+}
+
+// Without Redis calls run unprotected; without a key, too.
+func TestIdempotent_Unprotected(t *testing.T) {
+	ctx := context.Background()
+	for name, tt := range map[string]struct {
+		rdb *redis.Client
+		key string
+	}{
+		"redis down": {offlineRedis(t), "key-1"},
+		"no key":     {nil, ""},
+	} {
+		svc := kanban.NewService(nil, tt.rdb, kanban.Options{})
+		calls := 0
+		for range 2 {
+			if _, err := svc.Idempotent(ctx, "user-1", "MoveCard", tt.key, nil, func(context.Context) (*kanban.Application, error) {
+				calls++
+				return &kanban.Application{ID: "app-1"}, nil
+			}); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+		if calls != 2 {
+			t.Errorf("%s: %d calls, want 2", name, calls)
+		}
+	}
+
+	svc := kanban.NewService(nil, nil, kanban.Options{})
+	var ve *kanban.ValidationError
+	if _, err := svc.Idempotent(ctx, "user-1", "MoveCard", strings.Repeat("k", 129), nil, nil); !errors.As(err, &ve) {
+		t.Errorf("long key: %v, want a ValidationError", err)
+	}
+}
@@ -0,0 +1,2812 @@
+//go:build integration
+
+// Integration tests of kanban.Service against PostgreSQL (schema from
+// infra/postgres/init.sql) and Redis, started with Testcontainers. They need
+// Docker:
+//
+//	go test -tags integration ./internal/kanban/
+//
+// and are skipped when no container runtime answers.
+
+package kanban_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/db"
+	"jobmate/tracker-service/internal/gcal"
+	"jobmate/tracker-service/internal/kanban"
+	"jobmate/tracker-service/internal/scan"
+	"jobmate/tracker-service/internal/secretbox"
+	"jobmate/tracker-service/internal/storage"
+	"jobmate/tracker-service/internal/streams"
+	"jobmate/tracker-service/internal/worker"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+// integrationEnv is shared by the tests of the package: containers start
+// once, on first use, and every test works with users of its own.
+type integrationEnv struct {
+	pool *pgxpool.Pool
+	rdb  *redis.Client
+	svc  *kanban.Service
+}
+
+var (
+	envOnce    sync.Once
+	envShared  *integrationEnv
+	envErr     error
+	containers []testcontainers.Container
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	for _, c := range containers {
+		_ = testcontainers.TerminateContainer(c)
+	}
+	os.Exit(code)
+}
+
+// setupIntegration returns the shared environment, starting it if needed.
+func setupIntegration(t *testing.T) *integrationEnv {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	envOnce.Do(func() { envShared, envErr = startIntegrationEnv(context.Background()) })
+	if envErr != nil {
+		t.Fatalf("integration environment: %v", envErr)
+	}
+	return envShared
+}
+
+func startIntegrationEnv(ctx context.Context) (*integrationEnv, error) {
+	pg, err := tcpostgres.Run(ctx, "postgres:16-alpine",
+		tcpostgres.WithDatabase("jobmate"),
+		tcpostgres.WithUsername("jobmate"),
+		tcpostgres.WithPassword("jobmate"),
+		tcpostgres.WithInitScripts("../../../infra/postgres/init.sql"),
+		tcpostgres.BasicWaitStrategies(),
+	)
+	if pg != nil {
+		containers = append(containers, pg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("postgres: %w", err)
+	}
+	rc, err := tcredis.Run(ctx, "redis:7-alpine")
+	if rc != nil {
+		containers = append(containers, rc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis: %w", err)
+	}
+
+	dsn, err := pg.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		return nil, err
+	}
+	pool, err := db.NewPostgresPool(ctx, dsn, db.PoolConfig{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	redisURL, err := rc.ConnectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rdb, _, err := db.NewRedisClient(ctx, redisURL)
+	if err != nil {
+		return nil, err
+	}
+	svc := kanban.NewService(pool, rdb, kanban.Options{
+		UndoGracePeriod:        15 * time.Minute,
+		DuplicateRejectionDays: 90,
+	})
+	return &integrationEnv{pool: pool, rdb: rdb, svc: svc}, nil
+}
+
+// newUser inserts a user and returns their ID.
+func (e *integrationEnv) newUser(t *testing.T) string {
+	t.Helper()
+	var id string
+	err := e.pool.QueryRow(context.Background(),
+		`INSERT INTO users (email, password_hash)
+		 VALUES ('it-' || uuid_generate_v4() || '@jobmate.test', 'x')
+		 RETURNING id::text`,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	return id
+}
+
+// newSearch inserts an active search config of userID.
+func (e *integrationEnv) newSearch(t *testing.T, userID string, archiveOnHired bool) string {
+	t.Helper()
+	var id string
+	err := e.pool.QueryRow(context.Background(),
+		`INSERT INTO search_configs (user_id, job_titles, locations, archive_on_hired)
+		 VALUES ($1, '{Go Developer}', '{Paris}', $2)
+		 RETURNING id::text`,
+		userID, archiveOnHired,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("insert search config: %v", err)
+	}
+	return id
+}
+
+// newJob inserts a job found by searchID ("" = none) and returns its ID.
+func (e *integrationEnv) newJob(t *testing.T, userID, searchID, title, company string) string {
+	t.Helper()
+	var id string
+	err := e.pool.QueryRow(context.Background(),
+		`INSERT INTO job_feed (user_id, search_config_id, title, company_name, raw_data, status)
+		 VALUES ($1, NULLIF($2, '')::uuid, $3, $4, jsonb_build_object('company', $4::text), 'APPROVED')
+		 RETURNING id::text`,
+		userID, searchID, title, company,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("insert job: %v", err)
+	}
+	return id
+}
+
+// history returns the history log of an application.
+func (e *integrationEnv) history(t *testing.T, appID string) []kanban.HistoryEntry {
+	t.Helper()
+	var raw []byte
+	if err := e.pool.QueryRow(context.Background(),
+		`SELECT history_log FROM applications WHERE id = $1`, appID,
+	).Scan(&raw); err != nil {
+		t.Fatalf("read history: %v", err)
+	}
+	var h []kanban.HistoryEntry
+	if err := json.Unmarshal(raw, &h); err != nil {
+		t.Fatalf("decode history: %v", err)
+	}
+	return h
+}
+
+// outboxStreams returns the streams of the pending outbox events about
+// appID, in order.
+func (e *integrationEnv) outboxStreams(t *testing.T, appID string) []string {
+	t.Helper()
+	rows, err := e.pool.Query(context.Background(),
+		`SELECT stream FROM outbox_events WHERE payload->>'applicationId' = $1 ORDER BY id`, appID)
+	if err != nil {
+		t.Fatalf("read outbox: %v", err)
+	}
+	defer rows.Close()
+	var got []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, s)
+	}
+	return got
+}
+
+func TestIntegrationCreateApplicationConflicts(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	job := e.newJob(t, user, "", "Go Developer", "Acme")
+
+	first, err := e.svc.CreateApplication(ctx, user, job, false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if first.CurrentStatus != string(kanban.StatusToApply) {
+		t.Errorf("status = %s, want TO_APPLY", first.CurrentStatus)
+	}
+
+	// Same company and title: a duplicate, unless confirmed.
+	other := e.newJob(t, user, "", "go  developer", "ACME")
+	_, err = e.svc.CreateApplication(ctx, user, other, false)
+	var dup *kanban.DuplicateError
+	if !errors.As(err, &dup) || len(dup.ApplicationIDs) != 1 || dup.ApplicationIDs[0] != first.ID {
+		t.Fatalf("CreateApplication(duplicate) = %v, want DuplicateError naming %s", err, first.ID)
+	}
+	second, err := e.svc.CreateApplication(ctx, user, other, true)
+	if err != nil || second.ID == first.ID {
+		t.Fatalf("CreateApplication(confirmed duplicate) = %v, %v, want a new application", second, err)
+	}
+
+	// The same job again: the existing card, nothing queued twice.
+	before := len(e.outboxStreams(t, first.ID))
+	again, err := e.svc.CreateApplication(ctx, user, job, true)
+	if err != nil {
+		t.Fatalf("CreateApplication(same job): %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("CreateApplication(same job) = %s, want the existing %s", again.ID, first.ID)
+	}
+	if after := len(e.outboxStreams(t, first.ID)); after != before {
+		t.Errorf("outbox events for the card: %d → %d, want none added", before, after)
+	}
+
+	// Someone else's card for the job is no conflict.
+	stranger := e.newUser(t)
+	if _, err := e.svc.CreateApplication(ctx, stranger, job, false); err != nil {
+		t.Errorf("CreateApplication(other user): %v", err)
+	}
+}
+
+func TestIntegrationMoveCardConcurrency(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "SRE", "Initech"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	// The same drag sent several times at once: moves of a card are
+	// serialized, so one applies and the others see the card already moved.
+	const movers = 8
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, movers)
+	)
+	for i := range movers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", "")
+		}()
+	}
+	wg.Wait()
+
+	moved := 0
+	for _, err := range errs {
+		var invalid *kanban.ValidationError
+		switch {
+		case err == nil:
+			moved++
+		case !errors.As(err, &invalid):
+			t.Errorf("MoveCard: %v, want a ValidationError", err)
+		}
+	}
+	if moved != 1 {
+		t.Errorf("%d concurrent moves applied, want 1", moved)
+	}
+	moves := 0
+	for _, h := range e.history(t, app.ID) {
+		if h.IsMove() {
+			moves++
+		}
+	}
+	if moves != 1 {
+		t.Errorf("history has %d moves, want 1", moves)
+	}
+}
+
+// hire moves a new card of job through to HIRED.
+func (e *integrationEnv) hire(t *testing.T, user, job string) *kanban.Application {
+	t.Helper()
+	ctx := context.Background()
+	app, err := e.svc.CreateApplication(ctx, user, job, false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	for _, to := range []kanban.Status{kanban.StatusApplied, kanban.StatusInterview, kanban.StatusOffer, kanban.StatusHired} {
+		if app, err = e.svc.MoveCard(ctx, user, app.ID, string(to), "", "", ""); err != nil {
+			t.Fatalf("MoveCard(%s): %v", to, err)
+		}
+	}
+	return app
+}
+
+func (e *integrationEnv) searchActive(t *testing.T, searchID string) bool {
+	t.Helper()
+	var active bool
+	if err := e.pool.QueryRow(context.Background(),
+		`SELECT is_active FROM search_configs WHERE id = $1`, searchID,
+	).Scan(&active); err != nil {
+		t.Fatal(err)
+	}
+	return active
+}
+
+func lastKind(h []kanban.HistoryEntry) string {
+	if len(h) == 0 {
+		return ""
+	}
+	return h[len(h)-1].Kind
+}
+
+func TestIntegrationHiredArchivesSearch(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	search := e.newSearch(t, user, true)
+
+	app := e.hire(t, user, e.newJob(t, user, search, "Go Developer", "Globex"))
+	if e.searchActive(t, search) {
+		t.Error("search still active after HIRED")
+	}
+	if got := lastKind(e.history(t, app.ID)); got != kanban.HistorySearchArchived {
+		t.Errorf("last history entry = %q, want %s", got, kanban.HistorySearchArchived)
+	}
+
+	// The hire falls through: reopening the search resumes discovery.
+	if err := e.svc.ReopenSearch(ctx, user, search); err != nil {
+		t.Fatalf("ReopenSearch: %v", err)
+	}
+	if !e.searchActive(t, search) {
+		t.Error("search inactive after ReopenSearch")
+	}
+	if got := lastKind(e.history(t, app.ID)); got != kanban.HistorySearchReactivated {
+		t.Errorf("last history entry = %q, want %s", got, kanban.HistorySearchReactivated)
+	}
+	var scans int
+	if err := e.pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM outbox_events WHERE stream = 'CMD_SCRAPE_CONFIG' AND payload->>'searchConfigId' = $1`,
+		search,
+	).Scan(&scans); err != nil {
+		t.Fatal(err)
+	}
+	if scans != 1 {
+		t.Errorf("%d CMD_SCRAPE_CONFIG queued, want 1", scans)
+	}
+}
+
+func TestIntegrationHiredKeepsOptedOutSearch(t *testing.T) {
+	e := setupIntegration(t)
+	user := e.newUser(t)
+	search := e.newSearch(t, user, false)
+
+	app := e.hire(t, user, e.newJob(t, user, search, "Go Developer", "Umbrella"))
+	if !e.searchActive(t, search) {
+		t.Error("search deactivated despite archive_on_hired = false")
+	}
+	if got := lastKind(e.history(t, app.ID)); got != kanban.HistorySearchKept {
+		t.Errorf("last history entry = %q, want %s", got, kanban.HistorySearchKept)
+	}
+}
+
+func TestIntegrationEventsPublished(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Data Engineer", "Hooli"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+
+	// Queued with the changes, in order…
+	want := []string{"CMD_ANALYZE_JOB", "EVENT_APPLICATION_CREATED", "EVENT_CARD_MOVED"}
+	if got := e.outboxStreams(t, app.ID); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("outbox = %v, want %v", got, want)
+	}
+
+	// …then relayed to their streams and removed from the outbox.
+	if _, err := e.svc.RelayOutbox(ctx); err != nil {
+		t.Fatalf("RelayOutbox: %v", err)
+	}
+	if got := e.outboxStreams(t, app.ID); len(got) != 0 {
+		t.Errorf("outbox after relay = %v, want empty", got)
+	}
+	for _, stream := range want {
+		msgs, err := e.rdb.XRange(ctx, stream, "-", "+").Result()
+		if err != nil {
+			t.Fatalf("XRANGE %s: %v", stream, err)
+		}
+		found := false
+		for _, m := range msgs {
+			var payload map[string]any
+			raw, _ := m.Values[streams.PayloadField].(string)
+			if json.Unmarshal([]byte(raw), &payload) == nil && payload["applicationId"] == app.ID {
+				found = true
+				if payload["userId"] != user {
+					t.Errorf("%s userId = %v, want %s", stream, payload["userId"], user)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("no %s published for the application", stream)
+		}
+	}
+}
+
+// fakeBucket is an in-memory object store answering storage.Client's
+// requests (signatures are not checked).
+func fakeBucket(t *testing.T) *storage.Client {
+	t.Helper()
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path], _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(body) //nolint:errcheck
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	client, err := storage.New(storage.Config{Endpoint: srv.URL, Bucket: "attachments", AccessKey: "k", SecretKey: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// eicarScanner finds malware in files containing "EICAR".
+type eicarScanner struct{}
+
+func (eicarScanner) Scan(_ context.Context, r io.Reader) (scan.Result, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return scan.Result{}, err
+	}
+	if strings.Contains(string(body), "EICAR") {
+		return scan.Result{Infected: true, Signature: "EICAR"}, nil
+	}
+	return scan.Result{}, nil
+}
+
+// upload PUTs body to a presigned upload URL.
+func upload(t *testing.T, url, contentType, body string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	resp.Body.Close()
+}
+
+// An attachment is only scanned once its upload URL expired: a file PUT
+// again after the scan would otherwise be served unscanned.
+func TestIntegrationAttachmentScannedAfterUploadURL(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{Storage: fakeBucket(t), Scanner: eicarScanner{}})
+
+	const cv = "clean résumé"
+	att, put, err := svc.CreateAttachment(ctx, user, app.ID, kanban.AttachmentUpload{
+		Kind: kanban.AttachmentCV, FileName: "cv.txt", ContentType: "text/plain", SizeBytes: int64(len(cv)),
+	})
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+	upload(t, put.URL, "text/plain", cv)
+
+	if n, err := svc.ScanAttachments(ctx); err != nil || n != 0 {
+		t.Errorf("ScanAttachments while the upload URL is valid = %d, %v; want nothing scanned", n, err)
+	}
+	if _, _, err := svc.AttachmentDownloadURL(ctx, user, att.ID); !errors.Is(err, kanban.ErrAttachmentScanPending) {
+		t.Errorf("AttachmentDownloadURL before the scan: %v, want ErrAttachmentScanPending", err)
+	}
+	var due time.Time
+	if err := e.pool.QueryRow(ctx, `SELECT scan_next_at FROM attachments WHERE id = $1`, att.ID).Scan(&due); err != nil {
+		t.Fatal(err)
+	}
+	if !due.After(put.ExpiresAt) {
+		t.Errorf("scan due at %v, before the upload URL expires at %v", due, put.ExpiresAt)
+	}
+
+	// The URL expired: the file is final and scanned.
+	if _, err := e.pool.Exec(ctx, `UPDATE attachments SET scan_next_at = NOW() WHERE id = $1`, att.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := svc.ScanAttachments(ctx); err != nil || n != 1 {
+		t.Fatalf("ScanAttachments after the upload URL expired = %d, %v; want 1", n, err)
+	}
+	got, _, err := svc.AttachmentDownloadURL(ctx, user, att.ID)
+	if err != nil || got.ScanStatus != kanban.ScanClean {
+		t.Errorf("AttachmentDownloadURL after the scan = %+v, %v; want a CLEAN attachment", got, err)
+	}
+}
+
+// ghosted reports whether an application is flagged as ghosted.
+func (e *integrationEnv) ghosted(t *testing.T, appID string) bool {
+	t.Helper()
+	var ghosted bool
+	if err := e.pool.QueryRow(context.Background(),
+		`SELECT ghosted_at IS NOT NULL FROM applications WHERE id = $1`, appID,
+	).Scan(&ghosted); err != nil {
+		t.Fatal(err)
+	}
+	return ghosted
+}
+
+// Any activity on a ghosted card clears the flag, not only a move.
+func TestIntegrationGhostedClearedByActivity(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Vandelay"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+
+	for name, activity := range map[string]func() error{
+		"note": func() error {
+			_, err := e.svc.AddNote(ctx, user, app.ID, "Called the recruiter")
+			return err
+		},
+		"interview": func() error {
+			_, err := e.svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Type: kanban.InterviewPhoneScreen})
+			return err
+		},
+	} {
+		if _, err := e.pool.Exec(ctx, `UPDATE applications SET ghosted_at = NOW() WHERE id = $1`, app.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := activity(); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if e.ghosted(t, app.ID) {
+			t.Errorf("still ghosted after a new %s", name)
+		}
+	}
+}
+
+// Contacts belong to their user: another user can neither see, link nor
+// delete them, and deleting one unlinks it from its applications.
+func TestIntegrationContacts(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Initech"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	jane, err := e.svc.CreateContact(ctx, user, kanban.Contact{Name: "Jane", Email: "jane@initech.test"}, app.ID)
+	if err != nil {
+		t.Fatalf("CreateContact: %v", err)
+	}
+	if jane.Company != "Initech" || len(jane.ApplicationIDs) != 1 || jane.ApplicationIDs[0] != app.ID {
+		t.Errorf("CreateContact = %+v, want linked to %s with its company", jane, app.ID)
+	}
+	bob, err := e.svc.CreateContact(ctx, user, kanban.Contact{Name: "Bob", Company: "Globex"}, "")
+	if err != nil {
+		t.Fatalf("CreateContact(unlinked): %v", err)
+	}
+
+	names := func(cs []kanban.Contact) string {
+		var n []string
+		for _, c := range cs {
+			n = append(n, c.Name)
+		}
+		return strings.Join(n, ",")
+	}
+	if all, err := e.svc.ListContacts(ctx, user, kanban.ContactFilter{}); err != nil || names(all) != "Bob,Jane" {
+		t.Errorf("ListContacts = %s, %v; want Bob,Jane", names(all), err)
+	}
+	if linked, err := e.svc.ListContacts(ctx, user, kanban.ContactFilter{ApplicationID: app.ID}); err != nil || names(linked) != "Jane" {
+		t.Errorf("ListContacts(application) = %s, %v; want Jane", names(linked), err)
+	}
+	if byCompany, err := e.svc.ListContacts(ctx, user, kanban.ContactFilter{Company: "globex"}); err != nil || names(byCompany) != "Bob" {
+		t.Errorf("ListContacts(company) = %s, %v; want Bob", names(byCompany), err)
+	}
+
+	// Another user's view.
+	if theirs, err := e.svc.ListContacts(ctx, other, kanban.ContactFilter{}); err != nil || len(theirs) != 0 {
+		t.Errorf("ListContacts(other user) = %s, %v; want none", names(theirs), err)
+	}
+	if _, err := e.svc.ListContacts(ctx, other, kanban.ContactFilter{ApplicationID: app.ID}); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("ListContacts(other user's application) = %v, want ErrNotFound", err)
+	}
+	if _, err := e.svc.LinkContact(ctx, other, bob.ID, app.ID); !errors.Is(err, kanban.ErrContactNotFound) {
+		t.Errorf("LinkContact(other user) = %v, want ErrContactNotFound", err)
+	}
+	if err := e.svc.DeleteContact(ctx, other, jane.ID); !errors.Is(err, kanban.ErrContactNotFound) {
+		t.Errorf("DeleteContact(other user) = %v, want ErrContactNotFound", err)
+	}
+	theirApp, err := e.svc.CreateApplication(ctx, other, e.newJob(t, other, "", "Go Developer", "Globex"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.LinkContact(ctx, user, bob.ID, theirApp.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("LinkContact(to another user's application) = %v, want ErrNotFound", err)
+	}
+
+	if linked, err := e.svc.LinkContact(ctx, user, bob.ID, app.ID); err != nil || len(linked.ApplicationIDs) != 1 {
+		t.Fatalf("LinkContact = %+v, %v; want linked", linked, err)
+	}
+	if again, err := e.svc.LinkContact(ctx, user, bob.ID, app.ID); err != nil || len(again.ApplicationIDs) != 1 {
+		t.Errorf("LinkContact(twice) = %+v, %v; want a single link", again, err)
+	}
+	if err := e.svc.DeleteContact(ctx, user, jane.ID); err != nil {
+		t.Fatalf("DeleteContact: %v", err)
+	}
+	if linked, err := e.svc.ListContacts(ctx, user, kanban.ContactFilter{ApplicationID: app.ID}); err != nil || names(linked) != "Bob" {
+		t.Errorf("ListContacts(application) after delete = %s, %v; want Bob", names(linked), err)
+	}
+	if err := e.svc.DeleteContact(ctx, user, jane.ID); !errors.Is(err, kanban.ErrContactNotFound) {
+		t.Errorf("DeleteContact(twice) = %v, want ErrContactNotFound", err)
+	}
+}
+
+// Attachments belong to their user, count toward their quota and take their
+// stored file with them when deleted.
+func TestIntegrationAttachments(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Hooli"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{Storage: fakeBucket(t), AttachmentQuota: 1 << 20})
+
+	const cv = "my résumé"
+	up := kanban.AttachmentUpload{Kind: kanban.AttachmentCV, FileName: "cv.txt", ContentType: "text/plain", SizeBytes: int64(len(cv))}
+	att, put, err := svc.CreateAttachment(ctx, user, app.ID, up)
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+	if att.ApplicationID != app.ID || att.ScanStatus != kanban.ScanUnscanned {
+		t.Errorf("CreateAttachment = %+v, want an unscanned attachment of %s", att, app.ID)
+	}
+	upload(t, put.URL, "text/plain", cv)
+
+	if atts, err := svc.ListAttachments(ctx, user, app.ID); err != nil || len(atts) != 1 || atts[0].ID != att.ID {
+		t.Errorf("ListAttachments = %+v, %v; want the attachment", atts, err)
+	}
+	_, get, err := svc.AttachmentDownloadURL(ctx, user, att.ID)
+	if err != nil {
+		t.Fatalf("AttachmentDownloadURL: %v", err)
+	}
+	if body := download(t, get.URL); body != cv {
+		t.Errorf("downloaded %q, want %q", body, cv)
+	}
+
+	big := up
+	big.SizeBytes = 1 << 20
+	var ve *kanban.ValidationError
+	if _, _, err := svc.CreateAttachment(ctx, user, app.ID, big); !errors.As(err, &ve) || ve.Field != "size_bytes" {
+		t.Errorf("CreateAttachment(over quota) = %v, want a ValidationError on size_bytes", err)
+	}
+
+	// Another user's view.
+	if _, _, err := svc.CreateAttachment(ctx, other, app.ID, up); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("CreateAttachment(other user) = %v, want ErrNotFound", err)
+	}
+	if _, err := svc.ListAttachments(ctx, other, app.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("ListAttachments(other user) = %v, want ErrNotFound", err)
+	}
+	if _, _, err := svc.AttachmentDownloadURL(ctx, other, att.ID); !errors.Is(err, kanban.ErrAttachmentNotFound) {
+		t.Errorf("AttachmentDownloadURL(other user) = %v, want ErrAttachmentNotFound", err)
+	}
+	if err := svc.DeleteAttachment(ctx, other, att.ID); !errors.Is(err, kanban.ErrAttachmentNotFound) {
+		t.Errorf("DeleteAttachment(other user) = %v, want ErrAttachmentNotFound", err)
+	}
+
+	if err := svc.DeleteAttachment(ctx, user, att.ID); err != nil {
+		t.Fatalf("DeleteAttachment: %v", err)
+	}
+	if atts, err := svc.ListAttachments(ctx, user, app.ID); err != nil || len(atts) != 0 {
+		t.Errorf("ListAttachments after delete = %+v, %v; want none", atts, err)
+	}
+	resp, err := http.Get(get.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("stored file after delete: %s, want it removed", resp.Status)
+	}
+	// The quota freed, the large file now fits.
+	if _, _, err := svc.CreateAttachment(ctx, user, app.ID, big); err != nil {
+		t.Errorf("CreateAttachment after delete: %v", err)
+	}
+}
+
+// download GETs a presigned download URL.
+func download(t *testing.T, url string) string {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("download: %s, %v", resp.Status, err)
+	}
+	return string(body)
+}
+
+// A manual card behaves like one created from the feed: listed, analyzed,
+// checked for duplicates among its user's cards only.
+func TestIntegrationManualApplication(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	job := kanban.ManualJob{Title: "Site Reliability Engineer", Company: "Pied Piper", URL: "https://piedpiper.test/jobs/7"}
+
+	app, err := e.svc.CreateManualApplication(ctx, user, job, false)
+	if err != nil {
+		t.Fatalf("CreateManualApplication: %v", err)
+	}
+	if app.CurrentStatus != string(kanban.StatusToApply) || app.JobTitle != job.Title || app.Company != job.Company {
+		t.Errorf("CreateManualApplication = %+v, want a TO_APPLY card for the job", app)
+	}
+	if got := e.outboxStreams(t, app.ID); len(got) == 0 || got[0] != "CMD_ANALYZE_JOB" {
+		t.Errorf("outbox = %v, want CMD_ANALYZE_JOB first", got)
+	}
+	board, err := e.svc.ListApplications(ctx, user, kanban.ListFilter{})
+	if err != nil || len(board) != 1 || board[0].ID != app.ID {
+		t.Errorf("ListApplications = %+v, %v; want the manual card", board, err)
+	}
+
+	var dup *kanban.DuplicateError
+	if _, err := e.svc.CreateManualApplication(ctx, user, job, false); !errors.As(err, &dup) || dup.ApplicationIDs[0] != app.ID {
+		t.Errorf("CreateManualApplication(duplicate) = %v, want DuplicateError naming %s", err, app.ID)
+	}
+	if again, err := e.svc.CreateManualApplication(ctx, user, job, true); err != nil || again.ID == app.ID {
+		t.Errorf("CreateManualApplication(confirmed duplicate) = %v, %v; want a new card", again, err)
+	}
+
+	// Another user tracking the same job has a card of their own.
+	theirs, err := e.svc.CreateManualApplication(ctx, other, job, false)
+	if err != nil {
+		t.Fatalf("CreateManualApplication(other user): %v", err)
+	}
+	if _, err := e.svc.GetApplication(ctx, other, app.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetApplication(other user) = %v, want ErrNotFound", err)
+	}
+	if _, err := e.svc.GetApplication(ctx, user, theirs.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetApplication(their card) = %v, want ErrNotFound", err)
+	}
+}
+
+// Templates belong to their user; cards created from one start with its
+// setup and outlive it.
+func TestIntegrationTemplates(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	setup := kanban.CardSetup{
+		Priority:      kanban.PriorityHigh,
+		NextStepLabel: "Ask Dana for the referral",
+		Notes:         []string{"Referred by Dana", "Mention the Go meetup"},
+	}
+
+	tpl, err := e.svc.CreateTemplate(ctx, user, "Referral", setup)
+	if err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	var ve *kanban.ValidationError
+	if _, err := e.svc.CreateTemplate(ctx, user, "Referral", kanban.CardSetup{}); !errors.As(err, &ve) || ve.Field != "name" {
+		t.Errorf("CreateTemplate(same name) = %v, want a ValidationError on name", err)
+	}
+	list, err := e.svc.ListTemplates(ctx, user)
+	if err != nil || len(list) != 1 || list[0].ID != tpl.ID || fmt.Sprint(list[0].Notes) != fmt.Sprint(setup.Notes) {
+		t.Errorf("ListTemplates = %+v, %v; want the template with its notes", list, err)
+	}
+
+	job := kanban.NewCardJob{Manual: kanban.ManualJob{Title: "Platform Engineer", Company: "Umbrella"}}
+	app, err := e.svc.CreateApplicationFromTemplate(ctx, user, tpl.ID, job, false)
+	if err != nil {
+		t.Fatalf("CreateApplicationFromTemplate: %v", err)
+	}
+	if app.Priority != string(kanban.PriorityHigh) || app.NextStepLabel == nil || *app.NextStepLabel != setup.NextStepLabel {
+		t.Errorf("CreateApplicationFromTemplate = %+v, want the template's priority and next step", app)
+	}
+
+	// Another user's view.
+	if theirs, err := e.svc.ListTemplates(ctx, other); err != nil || len(theirs) != 0 {
+		t.Errorf("ListTemplates(other user) = %+v, %v; want none", theirs, err)
+	}
+	if _, err := e.svc.CreateApplicationFromTemplate(ctx, other, tpl.ID, job, false); !errors.Is(err, kanban.ErrTemplateNotFound) {
+		t.Errorf("CreateApplicationFromTemplate(other user) = %v, want ErrTemplateNotFound", err)
+	}
+	if _, err := e.svc.UpdateTemplate(ctx, other, tpl.ID, "Mine now", kanban.CardSetup{}); !errors.Is(err, kanban.ErrTemplateNotFound) {
+		t.Errorf("UpdateTemplate(other user) = %v, want ErrTemplateNotFound", err)
+	}
+	if err := e.svc.DeleteTemplate(ctx, other, tpl.ID); !errors.Is(err, kanban.ErrTemplateNotFound) {
+		t.Errorf("DeleteTemplate(other user) = %v, want ErrTemplateNotFound", err)
+	}
+
+	if err := e.svc.DeleteTemplate(ctx, user, tpl.ID); err != nil {
+		t.Fatalf("DeleteTemplate: %v", err)
+	}
+	if list, err := e.svc.ListTemplates(ctx, user); err != nil || len(list) != 0 {
+		t.Errorf("ListTemplates after delete = %+v, %v; want none", list, err)
+	}
+	notes, err := e.svc.ListNotes(ctx, user, app.ID)
+	if err != nil || len(notes) != 2 || notes[0].Text != setup.Notes[0] || notes[1].Text != setup.Notes[1] {
+		t.Errorf("card notes after the template was deleted = %+v, %v; want the template's notes", notes, err)
+	}
+}
+
+// Interviews belong to their user; rounds number themselves, feedback is
+// logged on the card and later rounds keep their number when one is deleted.
+func TestIntegrationInterviews(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Soylent"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	screen, err := e.svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Type: kanban.InterviewPhoneScreen})
+	if err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+	tech, err := e.svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Type: kanban.InterviewTechnical, Interviewer: "Sam"})
+	if err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+	if screen.Round != 1 || tech.Round != 2 || screen.Outcome != kanban.OutcomePending {
+		t.Errorf("rounds = %d, %d (outcome %s); want 1, 2, PENDING", screen.Round, tech.Round, screen.Outcome)
+	}
+	if ivs, err := e.svc.ListInterviews(ctx, user, app.ID); err != nil || len(ivs) != 2 || ivs[0].ID != screen.ID {
+		t.Errorf("ListInterviews = %+v, %v; want both, by round", ivs, err)
+	}
+
+	fb := kanban.InterviewFeedback{Outcome: kanban.OutcomePassed, WentWell: "System design", QuestionsAsked: []string{"Why Go?"}}
+	got, err := e.svc.RecordInterviewFeedback(ctx, user, screen.ID, fb)
+	if err != nil {
+		t.Fatalf("RecordInterviewFeedback: %v", err)
+	}
+	if got.Outcome != kanban.OutcomePassed || got.Feedback == nil || got.Feedback.WentWell != fb.WentWell {
+		t.Errorf("RecordInterviewFeedback = %+v, want the feedback stored", got)
+	}
+	if h := e.history(t, app.ID); lastKind(h) != kanban.HistoryInterviewFeedback || h[len(h)-1].InterviewID != screen.ID {
+		t.Errorf("history = %+v, want an INTERVIEW_FEEDBACK entry last", h)
+	}
+
+	// Another user's view.
+	if _, err := e.svc.CreateInterview(ctx, other, app.ID, kanban.Interview{}); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("CreateInterview(other user) = %v, want ErrNotFound", err)
+	}
+	if _, err := e.svc.ListInterviews(ctx, other, app.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("ListInterviews(other user) = %v, want ErrNotFound", err)
+	}
+	if _, err := e.svc.RecordInterviewFeedback(ctx, other, tech.ID, fb); !errors.Is(err, kanban.ErrInterviewNotFound) {
+		t.Errorf("RecordInterviewFeedback(other user) = %v, want ErrInterviewNotFound", err)
+	}
+	if err := e.svc.DeleteInterview(ctx, other, tech.ID); !errors.Is(err, kanban.ErrInterviewNotFound) {
+		t.Errorf("DeleteInterview(other user) = %v, want ErrInterviewNotFound", err)
+	}
+
+	if err := e.svc.DeleteInterview(ctx, user, screen.ID); err != nil {
+		t.Fatalf("DeleteInterview: %v", err)
+	}
+	ivs, err := e.svc.ListInterviews(ctx, user, app.ID)
+	if err != nil || len(ivs) != 1 || ivs[0].ID != tech.ID || ivs[0].Round != 2 {
+		t.Errorf("ListInterviews after delete = %+v, %v; want round 2 only", ivs, err)
+	}
+	if err := e.svc.DeleteInterview(ctx, user, screen.ID); !errors.Is(err, kanban.ErrInterviewNotFound) {
+		t.Errorf("DeleteInterview(twice) = %v, want ErrInterviewNotFound", err)
+	}
+}
+
+// An analysis is stored on its user's card only; a failed one is only
+// forwarded.
+func TestIntegrationAnalysisDone(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Cyberdyne"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	analyzed := func(userID, status, analysis string) {
+		t.Helper()
+		payload := fmt.Sprintf(`{"applicationId":%q,"userId":%q,"status":%q}`, app.ID, userID, status)
+		if analysis != "" {
+			payload = payload[:len(payload)-1] + `,"aiAnalysis":` + analysis + `}`
+		}
+		if err := e.svc.HandleAnalysisDone(ctx, payload); err != nil {
+			t.Fatalf("HandleAnalysisDone: %v", err)
+		}
+	}
+	score := func() any {
+		t.Helper()
+		got, err := e.svc.GetApplication(ctx, user, app.ID)
+		if err != nil {
+			t.Fatalf("GetApplication: %v", err)
+		}
+		var analysis map[string]any
+		json.Unmarshal(got.AIAnalysis, &analysis) //nolint:errcheck // nil when absent
+		return analysis["matchScore"]
+	}
+	queued := func() int {
+		n := 0
+		for _, s := range e.outboxStreams(t, app.ID) {
+			if s == "EVENT_APPLICATION_ANALYZED" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Another user's results are dropped, and not announced.
+	analyzed(other, "", `{"matchScore":12}`)
+	if got := score(); got != nil || queued() != 0 {
+		t.Errorf("after another user's analysis: score %v, %d events; want neither", got, queued())
+	}
+
+	analyzed(user, "", `{"matchScore":82}`)
+	if got := score(); got != 82.0 {
+		t.Errorf("score = %v, want 82", got)
+	}
+	if h := e.history(t, app.ID); lastKind(h) != kanban.HistoryAnalyzed {
+		t.Errorf("history = %+v, want an ANALYZED entry last", h)
+	}
+	if queued() != 1 {
+		t.Errorf("%d EVENT_APPLICATION_ANALYZED queued, want 1", queued())
+	}
+
+	// A timeout keeps the stored results.
+	analyzed(user, "timeout", "")
+	if got := score(); got != 82.0 || queued() != 2 {
+		t.Errorf("after a timeout: score %v, %d events; want 82 kept and the timeout forwarded", got, queued())
+	}
+}
+
+// An offer approved in the feed reaches the board through
+// EVENT_OFFER_ACCEPTED alone, once, at TO_APPLY and queued for analysis.
+func TestIntegrationOfferAcceptedCreatesCard(t *testing.T) {
+	e := setupIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	user := e.newUser(t)
+	job := e.newJob(t, user, "", "Go Developer", "Wonka")
+
+	const stream, group = "EVENT_OFFER_ACCEPTED", "tracker-offer-accepted-it"
+	if err := e.rdb.XGroupCreateMkStream(ctx, stream, group, "$").Err(); err != nil {
+		t.Fatalf("XGROUP CREATE: %v", err)
+	}
+	go worker.Consume(ctx, e.rdb, "accepted-offers", stream, group, e.svc.HandleOfferAccepted)
+
+	payload := fmt.Sprintf(`{"userId":%q,"jobFeedId":%q}`, user, job)
+	for range 2 { // a redelivery changes nothing
+		if err := streams.Publish(ctx, e.rdb, stream, []byte(payload)); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+	}
+
+	var app *kanban.Application
+	for deadline := time.Now().Add(15 * time.Second); app == nil; time.Sleep(100 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("no application created for the accepted offer")
+		}
+		app, _ = e.svc.GetApplicationByJobFeedID(ctx, user, job)
+	}
+	if app.CurrentStatus != string(kanban.StatusToApply) {
+		t.Errorf("status = %s, want TO_APPLY", app.CurrentStatus)
+	}
+	time.Sleep(time.Second) // let the second entry through
+	var cards int
+	if err := e.pool.QueryRow(ctx, `SELECT COUNT(*) FROM applications WHERE user_id = $1`, user).Scan(&cards); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.outboxStreams(t, app.ID); cards != 1 || len(got) == 0 || got[0] != "CMD_ANALYZE_JOB" {
+		t.Errorf("%d cards, outbox %v; want one card queued for analysis", cards, got)
+	}
+}
+
+// A share shows its columns of the owner's board to its grantee or token
+// holder only, until it is revoked or expires; its token is stored hashed.
+func TestIntegrationBoardShares(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	owner, grantee, stranger := e.newUser(t), e.newUser(t), e.newUser(t)
+	toApply, err := e.svc.CreateApplication(ctx, owner, e.newJob(t, owner, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	applied, err := e.svc.CreateApplication(ctx, owner, e.newJob(t, owner, "", "SRE", "Globex"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, owner, applied.ID, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	week := time.Now().Add(7 * 24 * time.Hour)
+	ids := func(apps []kanban.Application) string {
+		var got []string
+		for _, a := range apps {
+			got = append(got, a.ID)
+		}
+		return strings.Join(got, ",")
+	}
+
+	var ve *kanban.ValidationError
+	if _, err := e.svc.CreateBoardShare(ctx, owner, kanban.BoardShareInput{GranteeID: "00000000-0000-0000-0000-000000000000", ExpiresAt: week}); !errors.As(err, &ve) || ve.Field != "grantee_user_id" {
+		t.Errorf("CreateBoardShare(unknown grantee) = %v, want a ValidationError on grantee_user_id", err)
+	}
+
+	// A grantee share of one column.
+	share, err := e.svc.CreateBoardShare(ctx, owner, kanban.BoardShareInput{GranteeID: grantee, Statuses: []string{"APPLIED"}, ExpiresAt: week})
+	if err != nil {
+		t.Fatalf("CreateBoardShare: %v", err)
+	}
+	if share.Token != "" {
+		t.Errorf("grantee share has a token")
+	}
+	if got, err := e.svc.ListSharedApplications(ctx, grantee, share.ID, "", kanban.ListFilter{}); err != nil || ids(got) != applied.ID {
+		t.Errorf("ListSharedApplications(grantee) = %s, %v; want the APPLIED card only", ids(got), err)
+	}
+	if _, err := e.svc.GetSharedApplication(ctx, grantee, share.ID, "", toApply.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetSharedApplication(card outside the share) = %v, want ErrNotFound", err)
+	}
+	if _, err := e.svc.ListSharedApplications(ctx, stranger, share.ID, "", kanban.ListFilter{}); !errors.Is(err, kanban.ErrShareNotFound) {
+		t.Errorf("ListSharedApplications(not the grantee) = %v, want ErrShareNotFound", err)
+	}
+	if received, err := e.svc.ListBoardShares(ctx, grantee, true); err != nil || len(received) != 1 || received[0].ID != share.ID {
+		t.Errorf("ListBoardShares(received) = %+v, %v; want the share", received, err)
+	}
+
+	// A token share of the whole board.
+	link, err := e.svc.CreateBoardShare(ctx, owner, kanban.BoardShareInput{ExpiresAt: week})
+	if err != nil || link.Token == "" {
+		t.Fatalf("CreateBoardShare(token) = %+v, %v; want a token", link, err)
+	}
+	var stored []byte
+	if err := e.pool.QueryRow(ctx, `SELECT token_hash FROM board_shares WHERE id = $1`, link.ID).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if sum := sha256.Sum256([]byte(link.Token)); !bytes.Equal(stored, sum[:]) {
+		t.Errorf("stored token_hash %x, want the token's SHA-256", stored)
+	}
+	if got, err := e.svc.ListSharedApplications(ctx, stranger, "", link.Token, kanban.ListFilter{}); err != nil || len(got) != 2 {
+		t.Errorf("ListSharedApplications(token) = %s, %v; want both cards", ids(got), err)
+	}
+	if _, err := e.svc.ListSharedApplications(ctx, stranger, "", link.Token+"x", kanban.ListFilter{}); !errors.Is(err, kanban.ErrShareNotFound) {
+		t.Errorf("ListSharedApplications(wrong token) = %v, want ErrShareNotFound", err)
+	}
+	if given, err := e.svc.ListBoardShares(ctx, owner, false); err != nil || len(given) != 2 || given[0].Token != "" {
+		t.Errorf("ListBoardShares(owner) = %+v, %v; want both shares, without token", given, err)
+	}
+
+	// Revocation, by the owner only, and expiry.
+	if err := e.svc.RevokeBoardShare(ctx, grantee, share.ID); !errors.Is(err, kanban.ErrShareNotFound) {
+		t.Errorf("RevokeBoardShare(grantee) = %v, want ErrShareNotFound", err)
+	}
+	if err := e.svc.RevokeBoardShare(ctx, owner, share.ID); err != nil {
+		t.Fatalf("RevokeBoardShare: %v", err)
+	}
+	if _, err := e.svc.GetSharedApplication(ctx, grantee, share.ID, "", applied.ID); !errors.Is(err, kanban.ErrShareNotFound) {
+		t.Errorf("GetSharedApplication(revoked) = %v, want ErrShareNotFound", err)
+	}
+	if _, err := e.pool.Exec(ctx, `UPDATE board_shares SET expires_at = NOW() - INTERVAL '1 second' WHERE id = $1`, link.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.svc.ListSharedApplications(ctx, stranger, "", link.Token, kanban.ListFilter{}); !errors.Is(err, kanban.ErrShareNotFound) {
+		t.Errorf("ListSharedApplications(expired) = %v, want ErrShareNotFound", err)
+	}
+	if given, err := e.svc.ListBoardShares(ctx, owner, false); err != nil || len(given) != 0 {
+		t.Errorf("ListBoardShares after revocation and expiry = %+v, %v; want none", given, err)
+	}
+}
+
+// gdprUser is a user with a card carrying some of everything erasure deals
+// with: a commented move, a note, a contact and an interview.
+func (e *integrationEnv) gdprUser(t *testing.T, svc *kanban.Service) (user, appID string) {
+	t.Helper()
+	ctx := context.Background()
+	user = e.newUser(t)
+	app, err := svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Initrode"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", "Referred by Dana"); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if _, err := svc.AddNote(ctx, user, app.ID, "Recruiter said the budget is flexible"); err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	if _, err := svc.CreateContact(ctx, user, kanban.Contact{Name: "Dana"}, app.ID); err != nil {
+		t.Fatalf("CreateContact: %v", err)
+	}
+	if _, err := svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Interviewer: "Sam", Notes: "Asked about Kafka"}); err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+	return user, app.ID
+}
+
+// count returns the number of rows of table matching where ($1 = arg).
+func (e *integrationEnv) count(t *testing.T, table, where, arg string) int {
+	t.Helper()
+	var n int
+	if err := e.pool.QueryRow(context.Background(), `SELECT COUNT(*) FROM `+table+` WHERE `+where, arg).Scan(&n); err != nil {
+		t.Fatalf("count %s: %v", table, err)
+	}
+	return n
+}
+
+// An export holds the user's rows, sealed ones opened, and nobody else's.
+func TestIntegrationExportUserData(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{FieldKeys: sealingKeys(t)})
+	user, appID := e.gdprUser(t, svc)
+	_, otherApp := e.gdprUser(t, svc)
+
+	raw, err := svc.ExportUserData(ctx, user)
+	if err != nil {
+		t.Fatalf("ExportUserData: %v", err)
+	}
+	var doc struct {
+		UserID       string `json:"userId"`
+		Applications []struct {
+			ID string `json:"id"`
+		} `json:"applications"`
+		Notes []struct {
+			Body string `json:"body"`
+		} `json:"notes"`
+		Contacts []struct {
+			Name string `json:"name"`
+		} `json:"contacts"`
+		Interviews []struct {
+			Interviewer string `json:"interviewer"`
+		} `json:"interviews"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("export is not JSON: %v", err)
+	}
+	if doc.UserID != user || len(doc.Applications) != 1 || doc.Applications[0].ID != appID {
+		t.Errorf("export of %s holds applications %+v, want %s only", doc.UserID, doc.Applications, appID)
+	}
+	if len(doc.Notes) != 1 || doc.Notes[0].Body != "Recruiter said the budget is flexible" {
+		t.Errorf("exported notes = %+v, want the note opened", doc.Notes)
+	}
+	if len(doc.Contacts) != 1 || doc.Contacts[0].Name != "Dana" || len(doc.Interviews) != 1 || doc.Interviews[0].Interviewer != "Sam" {
+		t.Errorf("exported contacts %+v, interviews %+v; want the user's", doc.Contacts, doc.Interviews)
+	}
+	if strings.Contains(string(raw), otherApp) || strings.Contains(string(raw), "enc:") {
+		t.Errorf("export holds another user's card or sealed values: %s", raw)
+	}
+}
+
+// Erasure removes or blanks the user's rows as its mode says, and only theirs.
+func TestIntegrationEraseUserData(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	_, bystanderApp := e.gdprUser(t, e.svc)
+
+	t.Run("delete", func(t *testing.T) {
+		user, _ := e.gdprUser(t, e.svc)
+		counts, err := e.svc.EraseUserData(ctx, user, kanban.ErasureDelete)
+		if err != nil {
+			t.Fatalf("EraseUserData: %v", err)
+		}
+		if counts["applications"] != 1 || counts["application_notes"] != 1 || counts["contacts"] != 1 {
+			t.Errorf("counts = %v, want the card, its note and contact", counts)
+		}
+		for _, table := range []string{"applications", "application_notes", "contacts", "interviews"} {
+			if n := e.count(t, table, "user_id = $1", user); n != 0 {
+				t.Errorf("%d %s rows left", n, table)
+			}
+		}
+		// Erasing again (a redelivered EVENT_USER_DELETED) is harmless.
+		if err := e.svc.HandleUserDeleted(ctx, fmt.Sprintf(`{"userId":%q}`, user)); err != nil {
+			t.Errorf("HandleUserDeleted after erasure: %v", err)
+		}
+	})
+
+	t.Run("anonymize", func(t *testing.T) {
+		user, appID := e.gdprUser(t, e.svc)
+		if _, err := e.svc.EraseUserData(ctx, user, kanban.ErasureAnonymize); err != nil {
+			t.Fatalf("EraseUserData: %v", err)
+		}
+		app, err := e.svc.GetApplication(ctx, user, appID)
+		if err != nil || app.CurrentStatus != string(kanban.StatusApplied) {
+			t.Fatalf("GetApplication = %+v, %v; want the APPLIED card kept", app, err)
+		}
+		for _, h := range e.history(t, appID) {
+			if h.Reason != "" {
+				t.Errorf("history keeps the move's comment %q", h.Reason)
+			}
+		}
+		if len(app.Interviews) != 1 || app.Interviews[0].Interviewer != "" || app.Interviews[0].Notes != "" {
+			t.Errorf("interviews = %+v, want one without interviewer nor notes", app.Interviews)
+		}
+		for _, table := range []string{"application_notes", "contacts"} {
+			if n := e.count(t, table, "user_id = $1", user); n != 0 {
+				t.Errorf("%d %s rows left", n, table)
+			}
+		}
+	})
+
+	if n := e.count(t, "application_notes", "application_id = $1", bystanderApp); n != 1 {
+		t.Errorf("another user's card has %d notes after the erasures, want 1", n)
+	}
+}
+
+// backdate makes an application look untouched for age, bypassing the
+// updated_at trigger as retention does.
+func (e *integrationEnv) backdate(t *testing.T, appID string, age time.Duration) {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := e.pool.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+	if _, err := tx.Exec(ctx, `SELECT set_config('jobmate.retention', 'on', true)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE applications SET updated_at = NOW() - make_interval(secs => $2) WHERE id = $1`,
+		appID, age.Seconds()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Retention only reaches closed cards left untouched past the period, and
+// compacts or deletes them as their owner chose.
+func TestIntegrationClosedRetention(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	const year = 365 * 24 * time.Hour
+	card := func(user, title string, status kanban.Status, age time.Duration) string {
+		t.Helper()
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), true)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		if _, err := e.pool.Exec(ctx, `UPDATE applications SET ai_analysis = '{"score": 71, "summary": "Strong match"}' WHERE id = $1`, app.ID); err != nil {
+			t.Fatal(err)
+		}
+		if status != kanban.StatusToApply {
+			if _, err := e.svc.MoveCard(ctx, user, app.ID, string(status), "", "", ""); err != nil {
+				t.Fatalf("MoveCard: %v", err)
+			}
+		}
+		e.backdate(t, app.ID, age)
+		return app.ID
+	}
+
+	compacting, deleting := e.newUser(t), e.newUser(t)
+	mode := kanban.RetentionDelete
+	if _, err := e.svc.UpdateSettings(ctx, deleting, kanban.SettingsUpdate{ClosedRetention: &mode}); err != nil {
+		t.Fatalf("UpdateSettings: %v", err)
+	}
+	oldRejected := card(compacting, "Go Developer", kanban.StatusRejected, year)
+	newRejected := card(compacting, "SRE", kanban.StatusRejected, time.Hour)
+	oldOpen := card(compacting, "Data Engineer", kanban.StatusToApply, year)
+	oldDeleted := card(deleting, "Go Developer", kanban.StatusRejected, year)
+	newKept := card(deleting, "SRE", kanban.StatusRejected, time.Hour)
+
+	// Without a period nothing happens.
+	if n, err := e.svc.ApplyClosedRetention(ctx); n != 0 || err != nil {
+		t.Fatalf("ApplyClosedRetention(disabled) = %d, %v; want 0", n, err)
+	}
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{ClosedRetentionMonths: 6})
+	if _, err := svc.ApplyClosedRetention(ctx); err != nil {
+		t.Fatalf("ApplyClosedRetention: %v", err)
+	}
+
+	compacted := func(appID string) bool {
+		t.Helper()
+		return e.count(t, "applications", "id = $1 AND compacted_at IS NOT NULL", appID) == 1
+	}
+	if !compacted(oldRejected) {
+		t.Errorf("old rejected card not compacted")
+	}
+	app, err := svc.GetApplication(ctx, compacting, oldRejected)
+	if err != nil {
+		t.Fatalf("GetApplication(compacted): %v", err)
+	}
+	var analysis map[string]any
+	if err := json.Unmarshal(app.AIAnalysis, &analysis); err != nil || len(analysis) != 1 || analysis["score"] != 71.0 {
+		t.Errorf("compacted analysis = %s, want its score only", app.AIAnalysis)
+	}
+	for name, id := range map[string]string{"recent rejected": newRejected, "old open": oldOpen} {
+		if compacted(id) {
+			t.Errorf("%s card compacted", name)
+		}
+	}
+	if e.count(t, "applications", "id = $1", oldDeleted) != 0 || e.count(t, "applications", "id = $1", newKept) != 1 {
+		t.Errorf("with DELETE, want the old rejected card deleted and the recent one kept")
+	}
+
+	// Compacted cards are not picked again.
+	if n, err := svc.ApplyClosedRetention(ctx); err != nil || n != 0 {
+		t.Errorf("second ApplyClosedRetention = %d, %v; want nothing handled", n, err)
+	}
+}
+
+func TestIntegrationJobFeedRemoval(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	jobFeedID := func(appID string) *string {
+		t.Helper()
+		var id *string
+		if err := e.pool.QueryRow(ctx, `SELECT job_feed_id::text FROM applications WHERE id = $1`, appID).Scan(&id); err != nil {
+			t.Fatalf("job_feed_id: %v", err)
+		}
+		return id
+	}
+
+	// A job of a month without a partition yet, moved out of job_feed_default
+	// once its partition is created, is not taken for removed.
+	var later string
+	err := e.pool.QueryRow(ctx,
+		`INSERT INTO job_feed (user_id, title, company_name, status, created_at)
+		 VALUES ($1, 'Go Developer', 'Acme', 'APPROVED', NOW() + INTERVAL '6 months')
+		 RETURNING id::text`,
+		user,
+	).Scan(&later)
+	if err != nil {
+		t.Fatalf("insert job: %v", err)
+	}
+	kept, err := e.svc.CreateApplication(ctx, user, later, true)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.pool.Exec(ctx, `SELECT job_feed_create_partitions(NOW(), 7)`); err != nil {
+		t.Fatalf("job_feed_create_partitions: %v", err)
+	}
+	if id := jobFeedID(kept.ID); id == nil || *id != later {
+		t.Errorf("after the partition move, job_feed_id = %v, want %s", id, later)
+	}
+
+	// Deleted offers leave their applications without one, several per user.
+	var apps, jobs []string
+	for _, title := range []string{"SRE", "Data Engineer"} {
+		job := e.newJob(t, user, "", title, "Acme")
+		app, err := e.svc.CreateApplication(ctx, user, job, true)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		apps, jobs = append(apps, app.ID), append(jobs, job)
+	}
+	if _, err := e.pool.Exec(ctx, `DELETE FROM job_feed WHERE id = ANY($1::uuid[])`, jobs); err != nil {
+		t.Fatalf("delete jobs: %v", err)
+	}
+	for _, id := range apps {
+		if got := jobFeedID(id); got != nil {
+			t.Errorf("application %s: job_feed_id = %s after its offer was deleted, want NULL", id, *got)
+		}
+		if _, err := e.svc.GetApplication(ctx, user, id); err != nil {
+			t.Errorf("GetApplication(%s): %v", id, err)
+		}
+	}
+}
+
+// reminders returns how many reminders fired for an application: its
+// REMINDER_FIRED history entries and its queued EVENT_RELANCE_DUE.
+func (e *integrationEnv) reminders(t *testing.T, appID string) (fired, queued int) {
+	t.Helper()
+	for _, h := range e.history(t, appID) {
+		if h.Kind == kanban.HistoryReminderFired {
+			fired++
+		}
+	}
+	for _, s := range e.outboxStreams(t, appID) {
+		if s == "EVENT_RELANCE_DUE" {
+			queued++
+		}
+	}
+	return fired, queued
+}
+
+// dispatch runs DispatchDueReminders and returns how many reminders fired
+// for appID.
+func (e *integrationEnv) dispatch(t *testing.T, appID string) int {
+	t.Helper()
+	if _, err := e.svc.DispatchDueReminders(context.Background()); err != nil {
+		t.Fatalf("DispatchDueReminders: %v", err)
+	}
+	fired, _ := e.reminders(t, appID)
+	return fired
+}
+
+// shiftMoves dates the application's moves to status age ago.
+func (e *integrationEnv) shiftMoves(t *testing.T, appID string, to kanban.Status, age time.Duration) {
+	t.Helper()
+	_, err := e.pool.Exec(context.Background(),
+		`UPDATE applications
+		 SET history_log = (SELECT jsonb_agg(CASE WHEN h.e->>'to' = $2 AND NOT h.e ? 'kind'
+		                                          THEN jsonb_set(h.e, '{at}', to_jsonb(date_trunc('second', NOW() - make_interval(secs => $3))))
+		                                          ELSE h.e END ORDER BY h.i)
+		                    FROM jsonb_array_elements(history_log) WITH ORDINALITY AS h(e, i))
+		 WHERE id = $1`,
+		appID, string(to), age.Seconds())
+	if err != nil {
+		t.Fatalf("shift moves: %v", err)
+	}
+}
+
+// A fixed reminder fires once when due, is skipped while its card is
+// locked, fires again once re-armed, and silently for muted users.
+func TestIntegrationFixedReminders(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	due := func(user, appID string) {
+		t.Helper()
+		if _, err := e.svc.SetRelanceReminder(ctx, user, appID, time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("SetRelanceReminder: %v", err)
+		}
+		if _, err := e.pool.Exec(ctx, `UPDATE applications SET relance_reminder_at = NOW() - INTERVAL '1 minute' WHERE id = $1`, appID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := e.dispatch(t, app.ID); n != 0 {
+		t.Fatalf("%d reminders fired without a reminder", n)
+	}
+	due(user, app.ID)
+
+	// Another dispatcher holds the card: it is skipped, not waited for.
+	tx, err := e.pool.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM applications WHERE id = $1 FOR UPDATE`, app.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n := e.dispatch(t, app.ID); n != 0 {
+		t.Errorf("locked card: %d reminders fired, want it skipped", n)
+	}
+	tx.Rollback(ctx) //nolint:errcheck
+
+	if n := e.dispatch(t, app.ID); n != 1 {
+		t.Fatalf("due reminder: %d fired, want 1", n)
+	}
+	if n := e.dispatch(t, app.ID); n != 1 {
+		t.Errorf("reminder fired again: %d, want once", n)
+	}
+	due(user, app.ID) // a new time re-arms it
+	e.dispatch(t, app.ID)
+	if fired, queued := e.reminders(t, app.ID); fired != 2 || queued != 2 {
+		t.Errorf("re-armed reminder: %d fired, %d queued; want 2", fired, queued)
+	}
+
+	// A user who muted every channel gets the reminder logged, not sent.
+	muted := e.newUser(t)
+	none := []string{}
+	if _, err := e.svc.UpdateSettings(ctx, muted, kanban.SettingsUpdate{ReminderChannels: &none}); err != nil {
+		t.Fatalf("UpdateSettings: %v", err)
+	}
+	quiet, err := e.svc.CreateApplication(ctx, muted, e.newJob(t, muted, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	due(muted, quiet.ID)
+	e.dispatch(t, quiet.ID)
+	if fired, queued := e.reminders(t, quiet.ID); fired != 1 || queued != 0 {
+		t.Errorf("muted user: %d fired, %d queued; want 1 fired, none queued", fired, queued)
+	}
+}
+
+// A reminder rule fires AfterDays after the card entered its status, then
+// every EveryDays, only while the card is in it, and starts over when the
+// card enters it again.
+func TestIntegrationRuleReminders(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	const day = 24 * time.Hour
+	user := e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if _, err := e.svc.SetReminderRule(ctx, user, app.ID, kanban.ReminderRule{AfterDays: 3, EveryDays: 7}); err != nil {
+		t.Fatalf("SetReminderRule: %v", err)
+	}
+	firedAgo := func(age time.Duration) {
+		t.Helper()
+		if _, err := e.pool.Exec(ctx, `UPDATE applications SET relance_rule_fired_at = NOW() - make_interval(secs => $2) WHERE id = $1`,
+			app.ID, age.Seconds()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := e.dispatch(t, app.ID); n != 0 {
+		t.Fatalf("rule fired %d times on the day the card was applied", n)
+	}
+	e.shiftMoves(t, app.ID, kanban.StatusApplied, 20*day)
+	if n := e.dispatch(t, app.ID); n != 1 {
+		t.Fatalf("first reminder: %d fired, want 1", n)
+	}
+	if n := e.dispatch(t, app.ID); n != 1 {
+		t.Errorf("first reminder fired again: %d", n)
+	}
+	firedAgo(6 * day)
+	if n := e.dispatch(t, app.ID); n != 1 {
+		t.Errorf("repeat fired before EveryDays: %d", n)
+	}
+	firedAgo(8 * day)
+	if n := e.dispatch(t, app.ID); n != 2 {
+		t.Fatalf("repeat: %d fired, want 2", n)
+	}
+
+	// Outside its status the rule is idle…
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusOnHold), "", "", ""); err != nil {
+		t.Fatalf("MoveCard(ON_HOLD): %v", err)
+	}
+	firedAgo(8 * day)
+	if n := e.dispatch(t, app.ID); n != 2 {
+		t.Errorf("rule fired while ON_HOLD: %d", n)
+	}
+	// …and back in it, AfterDays counts from the return.
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard(APPLIED): %v", err)
+	}
+	if n := e.dispatch(t, app.ID); n != 2 {
+		t.Errorf("rule fired on the day the card came back: %d", n)
+	}
+	e.shiftMoves(t, app.ID, kanban.StatusApplied, 4*day)
+	if n := e.dispatch(t, app.ID); n != 3 {
+		t.Errorf("rule after the return: %d fired, want 3", n)
+	}
+	if _, queued := e.reminders(t, app.ID); queued != 3 {
+		t.Errorf("%d EVENT_RELANCE_DUE queued, want 3", queued)
+	}
+}
+
+// A batch returns the caller's cards among the IDs, in request order; other
+// users' and unknown IDs are left out without failing the rest.
+func TestIntegrationBatchGetApplications(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	var mine []string
+	for _, title := range []string{"Go Developer", "SRE", "Data Engineer"} {
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		mine = append(mine, app.ID)
+	}
+	theirs, err := e.svc.CreateApplication(ctx, other, e.newJob(t, other, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	const unknown = "00000000-0000-0000-0000-000000000000"
+	got, err := e.svc.BatchGetApplications(ctx, user,
+		[]string{mine[2], theirs.ID, unknown, strings.ToUpper(mine[0]), mine[2], mine[1]})
+	if err != nil {
+		t.Fatalf("BatchGetApplications: %v", err)
+	}
+	var ids []string
+	for _, a := range got {
+		ids = append(ids, a.ID)
+	}
+	if want := []string{mine[2], mine[0], mine[1]}; fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Errorf("BatchGetApplications = %v, want %v", ids, want)
+	}
+	if got, err := e.svc.BatchGetApplications(ctx, user, []string{theirs.ID, unknown}); err != nil || len(got) != 0 {
+		t.Errorf("BatchGetApplications(none of theirs) = %d cards, %v; want none", len(got), err)
+	}
+}
+
+// A job feed entry's card is found for its user only.
+func TestIntegrationGetApplicationByJobFeedID(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	job := e.newJob(t, user, "", "Go Developer", "Acme")
+
+	if _, err := e.svc.GetApplicationByJobFeedID(ctx, user, job); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetApplicationByJobFeedID(untracked) = %v, want ErrNotFound", err)
+	}
+	app, err := e.svc.CreateApplication(ctx, user, job, false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if got, err := e.svc.GetApplicationByJobFeedID(ctx, user, job); err != nil || got.ID != app.ID {
+		t.Errorf("GetApplicationByJobFeedID = %v, %v; want %s", got, err, app.ID)
+	}
+	if _, err := e.svc.GetApplicationByJobFeedID(ctx, other, job); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetApplicationByJobFeedID(other user) = %v, want ErrNotFound", err)
+	}
+}
+
+// A snapshot taken, the board changed, CompareSnapshots tells what changed
+// since; SnapshotBoards skips fresh boards and prunes old snapshots.
+func TestIntegrationBoardSnapshots(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	newCard := func(user, title string) string {
+		t.Helper()
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		return app.ID
+	}
+	moved, restored, archived := newCard(user, "Go Developer"), newCard(user, "SRE"), newCard(user, "Data Engineer")
+	if _, err := e.svc.ArchiveApplication(ctx, user, restored); err != nil {
+		t.Fatalf("ArchiveApplication: %v", err)
+	}
+
+	snap, err := e.svc.TakeBoardSnapshot(ctx, user)
+	if err != nil || snap.Cards != 3 {
+		t.Fatalf("TakeBoardSnapshot = %+v, %v; want 3 cards", snap, err)
+	}
+	if _, err := e.pool.Exec(ctx, `UPDATE board_snapshots SET taken_at = NOW() - INTERVAL '2 days' WHERE id = $1`, snap.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, moved, string(kanban.StatusApplied), "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if _, err := e.svc.RestoreApplication(ctx, user, restored); err != nil {
+		t.Fatalf("RestoreApplication: %v", err)
+	}
+	if _, err := e.svc.ArchiveApplication(ctx, user, archived); err != nil {
+		t.Fatalf("ArchiveApplication: %v", err)
+	}
+	added := newCard(user, "Platform Engineer")
+
+	check := func(name string, d *kanban.SnapshotDiff) {
+		t.Helper()
+		if len(d.Added) != 1 || d.Added[0].ApplicationID != added {
+			t.Errorf("%s: Added = %+v, want the new card", name, d.Added)
+		}
+		if len(d.Moved) != 1 || d.Moved[0].Card.ApplicationID != moved || d.Moved[0].From != kanban.StatusToApply {
+			t.Errorf("%s: Moved = %+v, want the card moved from TO_APPLY", name, d.Moved)
+		}
+		if len(d.Restored) != 1 || d.Restored[0].ApplicationID != restored || len(d.Archived) != 1 || d.Archived[0].ApplicationID != archived {
+			t.Errorf("%s: Restored = %+v, Archived = %+v", name, d.Restored, d.Archived)
+		}
+		if len(d.Removed) != 0 {
+			t.Errorf("%s: Removed = %+v, want none", name, d.Removed)
+		}
+	}
+	diff, err := e.svc.CompareSnapshots(ctx, user, time.Now().Add(-24*time.Hour), time.Time{})
+	if err != nil {
+		t.Fatalf("CompareSnapshots(to now): %v", err)
+	}
+	check("to now", diff)
+
+	// The same between two stored snapshots.
+	if _, err := e.svc.TakeBoardSnapshot(ctx, user); err != nil {
+		t.Fatalf("TakeBoardSnapshot: %v", err)
+	}
+	diff, err = e.svc.CompareSnapshots(ctx, user, time.Now().Add(-24*time.Hour), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("CompareSnapshots(stored): %v", err)
+	}
+	check("stored", diff)
+
+	if _, err := e.svc.CompareSnapshots(ctx, other, time.Now().Add(-24*time.Hour), time.Time{}); !errors.Is(err, kanban.ErrSnapshotNotFound) {
+		t.Errorf("CompareSnapshots(no snapshot) = %v, want ErrSnapshotNotFound", err)
+	}
+
+	// Periodic snapshots: only boards without a fresh one, old ones pruned.
+	newCard(other, "Go Developer")
+	if _, err := e.svc.SnapshotBoards(ctx, time.Hour, 24*time.Hour); err != nil {
+		t.Fatalf("SnapshotBoards: %v", err)
+	}
+	if n := e.count(t, "board_snapshots", "user_id = $1", user); n != 1 {
+		t.Errorf("%d snapshots of a board with a fresh one, want the old one pruned and none added", n)
+	}
+	if n := e.count(t, "board_snapshots", "user_id = $1", other); n != 1 {
+		t.Errorf("%d snapshots of a board without one, want 1", n)
+	}
+}
+
+// A merge moves the merged card's notes, interviews and contacts to the
+// kept card, carries its history over marked MergedFrom, and deletes it.
+func TestIntegrationMergeApplications(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	kept, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	merged, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Golang Developer", "Acme"), true)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	for _, st := range []kanban.Status{kanban.StatusApplied, kanban.StatusInterview} {
+		if _, err := e.svc.MoveCard(ctx, user, merged.ID, string(st), "", "", ""); err != nil {
+			t.Fatalf("MoveCard: %v", err)
+		}
+	}
+	if _, err := e.svc.AddNote(ctx, user, merged.ID, "Found it on LinkedIn too"); err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	if _, err := e.svc.CreateInterview(ctx, user, merged.ID, kanban.Interview{Type: kanban.InterviewPhoneScreen}); err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+	contact, err := e.svc.CreateContact(ctx, user, kanban.Contact{Name: "Jane"}, merged.ID)
+	if err != nil {
+		t.Fatalf("CreateContact: %v", err)
+	}
+
+	var ve *kanban.ValidationError
+	if _, err := e.svc.MergeApplications(ctx, user, kept.ID, kept.ID); !errors.As(err, &ve) {
+		t.Errorf("MergeApplications(itself) = %v, want a ValidationError", err)
+	}
+	if _, err := e.svc.MergeApplications(ctx, other, kept.ID, merged.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("MergeApplications(other user) = %v, want ErrNotFound", err)
+	}
+
+	app, err := e.svc.MergeApplications(ctx, user, kept.ID, merged.ID)
+	if err != nil {
+		t.Fatalf("MergeApplications: %v", err)
+	}
+	if app.CurrentStatus != string(kanban.StatusToApply) {
+		t.Errorf("kept card status = %s, want its own TO_APPLY", app.CurrentStatus)
+	}
+	if _, err := e.svc.GetApplication(ctx, user, merged.ID); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("merged card still there: %v", err)
+	}
+	if notes, err := e.svc.ListNotes(ctx, user, kept.ID); err != nil || len(notes) != 1 {
+		t.Errorf("kept card notes = %+v, %v; want the merged card's", notes, err)
+	}
+	if len(app.Interviews) != 1 {
+		t.Errorf("kept card interviews = %+v, want the merged card's", app.Interviews)
+	}
+	if linked, err := e.svc.ListContacts(ctx, user, kanban.ContactFilter{ApplicationID: kept.ID}); err != nil || len(linked) != 1 || linked[0].ID != contact.ID {
+		t.Errorf("kept card contacts = %+v, %v; want Jane", linked, err)
+	}
+
+	h := e.history(t, kept.ID)
+	carried := 0
+	for _, entry := range h {
+		if entry.MergedFrom == merged.ID && entry.Kind != kanban.HistoryMerge {
+			carried++
+		}
+	}
+	if carried != 2 || lastKind(h) != kanban.HistoryMerge || h[len(h)-1].MergedFrom != merged.ID {
+		t.Errorf("history = %+v, want both moves carried over and a MERGE entry last", h)
+	}
+	if got := e.outboxStreams(t, kept.ID); got[len(got)-1] != "EVENT_APPLICATION_MERGED" {
+		t.Errorf("outbox = %v, want EVENT_APPLICATION_MERGED last", got)
+	}
+}
+
+// Notes are cleaned before they are stored, user_notes mirrors the latest
+// one, and a deleted note hands back to the previous one.
+func TestIntegrationNotes(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	for name, note := range map[string]string{
+		"empty":              "",
+		"only control chars": "\x00\x07 \r\n",
+		"too long":           strings.Repeat("x", 10_001),
+	} {
+		var ve *kanban.ValidationError
+		if _, err := e.svc.AddNote(ctx, user, app.ID, note); !errors.As(err, &ve) || ve.Field != "note" {
+			t.Errorf("AddNote(%s) = %v, want a note ValidationError", name, err)
+		}
+	}
+	if _, err := e.svc.AddNote(ctx, other, app.ID, "Not my card"); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("AddNote(other user) = %v, want ErrNotFound", err)
+	}
+	if n := e.count(t, "application_notes", "application_id = $1", app.ID); n != 0 {
+		t.Fatalf("%d notes stored by rejected calls, want none", n)
+	}
+
+	first, err := e.svc.AddNote(ctx, user, app.ID, "  Called the\a recruiter\r\n")
+	if err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	if first.UserNotes == nil || *first.UserNotes != "Called the recruiter" {
+		t.Errorf("user_notes = %v, want the cleaned note", first.UserNotes)
+	}
+	latest, err := e.svc.AddNote(ctx, user, app.ID, "Sent portfolio")
+	if err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	if latest.UserNotes == nil || *latest.UserNotes != "Sent portfolio" {
+		t.Errorf("user_notes = %v, want the latest note", latest.UserNotes)
+	}
+
+	notes, err := e.svc.ListNotes(ctx, user, app.ID)
+	if err != nil || len(notes) != 2 || notes[0].Text != "Called the recruiter" || notes[1].Text != "Sent portfolio" {
+		t.Fatalf("ListNotes = %+v, %v; want both notes, oldest first", notes, err)
+	}
+	if _, err := e.svc.EditNote(ctx, other, notes[1].ID, "Hijacked"); !errors.Is(err, kanban.ErrNoteNotFound) {
+		t.Errorf("EditNote(other user) = %v, want ErrNoteNotFound", err)
+	}
+	edited, err := e.svc.EditNote(ctx, user, notes[1].ID, "Sent portfolio and references")
+	if err != nil || edited.EditedAt == nil {
+		t.Fatalf("EditNote = %+v, %v; want it stamped edited", edited, err)
+	}
+	if got, err := e.svc.GetApplication(ctx, user, app.ID); err != nil || got.UserNotes == nil || *got.UserNotes != edited.Text {
+		t.Errorf("user_notes after EditNote = %v, %v; want %q", got.UserNotes, err, edited.Text)
+	}
+
+	if err := e.svc.DeleteNote(ctx, user, notes[1].ID); err != nil {
+		t.Fatalf("DeleteNote: %v", err)
+	}
+	if got, err := e.svc.GetApplication(ctx, user, app.ID); err != nil || got.UserNotes == nil || *got.UserNotes != "Called the recruiter" {
+		t.Errorf("user_notes after DeleteNote = %v, %v; want the previous note", got.UserNotes, err)
+	}
+	if err := e.svc.DeleteNote(ctx, user, notes[1].ID); !errors.Is(err, kanban.ErrNoteNotFound) {
+		t.Errorf("DeleteNote(twice) = %v, want ErrNoteNotFound", err)
+	}
+}
+
+// A move's comment and a rejection's reason and stage are kept on the card
+// and in its history; GetRejectionStats groups rejected cards by them.
+func TestIntegrationMoveReasonAndRejection(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	newCard := func(title string) string {
+		t.Helper()
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		return app.ID
+	}
+	screened, explicit, early := newCard("Go Developer"), newCard("SRE"), newCard("Data Engineer")
+
+	var ve *kanban.ValidationError
+	if _, err := e.svc.MoveCard(ctx, user, screened, "APPLIED", "", "", strings.Repeat("x", 501)); !errors.As(err, &ve) || ve.Field != "reason" {
+		t.Errorf("MoveCard(long reason) = %v, want a reason ValidationError", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, screened, "APPLIED", kanban.RejectionNoResponse, "", ""); !errors.As(err, &ve) {
+		t.Errorf("MoveCard(rejection reason on APPLIED) = %v, want a ValidationError", err)
+	}
+	if h := e.history(t, screened); len(h) != 0 {
+		t.Fatalf("history = %+v after rejected moves, want none", h)
+	}
+
+	for _, id := range []string{screened, explicit} {
+		if _, err := e.svc.MoveCard(ctx, user, id, "APPLIED", "", "", "Applied through a referral"); err != nil {
+			t.Fatalf("MoveCard: %v", err)
+		}
+	}
+	if h := e.history(t, screened); h[len(h)-1].Reason != "Applied through a referral" {
+		t.Errorf("history = %+v, want the move's reason", h)
+	}
+
+	app, err := e.svc.MoveCard(ctx, user, screened, "REJECTED", kanban.RejectionAfterScreening, "", "")
+	if err != nil {
+		t.Fatalf("MoveCard(REJECTED): %v", err)
+	}
+	if app.RejectionReason != kanban.RejectionAfterScreening || app.RejectionStage != "APPLIED" {
+		t.Errorf("rejection = %q at %q, want AFTER_SCREENING at the stage it left, APPLIED", app.RejectionReason, app.RejectionStage)
+	}
+	if last := e.history(t, screened)[1]; last.RejectionReason != kanban.RejectionAfterScreening || last.RejectionStage != "APPLIED" {
+		t.Errorf("history entry = %+v, want the rejection details", last)
+	}
+	if app, err = e.svc.MoveCard(ctx, user, explicit, "REJECTED", kanban.RejectionAfterScreening, "APPLIED", ""); err != nil || app.RejectionStage != "APPLIED" {
+		t.Fatalf("MoveCard(REJECTED at APPLIED) = %+v, %v", app, err)
+	}
+	if app, err = e.svc.MoveCard(ctx, user, early, "REJECTED", "", "", ""); err != nil || app.RejectionReason != "" || app.RejectionStage != "TO_APPLY" {
+		t.Fatalf("MoveCard(REJECTED, no details) = %+v, %v; want no reason at TO_APPLY", app, err)
+	}
+
+	stats, err := e.svc.GetRejectionStats(ctx, user)
+	if err != nil {
+		t.Fatalf("GetRejectionStats: %v", err)
+	}
+	want := []kanban.RejectionStat{
+		{Reason: kanban.RejectionAfterScreening, Stage: "APPLIED", Count: 2},
+		{Reason: "", Stage: "TO_APPLY", Count: 1},
+	}
+	if fmt.Sprint(stats) != fmt.Sprint(want) {
+		t.Errorf("GetRejectionStats = %+v, want %+v", stats, want)
+	}
+}
+
+func TestIntegrationBulkMoveReasonAndRejection(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	var ids []string
+	for _, title := range []string{"Go Developer", "SRE"} {
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		ids = append(ids, app.ID)
+	}
+
+	var ve *kanban.ValidationError
+	if _, err := e.svc.BulkMove(ctx, user, ids, "REJECTED", "", "REJECTED", ""); !errors.As(err, &ve) || ve.Field != "rejection_stage" {
+		t.Errorf("BulkMove(stage REJECTED) = %v, want a rejection_stage ValidationError", err)
+	}
+	if _, err := e.svc.BulkMove(ctx, user, ids, "APPLIED", "", "", strings.Repeat("x", 501)); !errors.As(err, &ve) || ve.Field != "reason" {
+		t.Errorf("BulkMove(long reason) = %v, want a reason ValidationError", err)
+	}
+
+	if _, err := e.svc.BulkMove(ctx, user, ids, "APPLIED", "", "", "Sent at the job fair"); err != nil {
+		t.Fatalf("BulkMove(APPLIED): %v", err)
+	}
+	results, err := e.svc.BulkMove(ctx, user, ids, "REJECTED", kanban.RejectionAfterScreening, "INTERVIEW", "Position filled")
+	if err != nil {
+		t.Fatalf("BulkMove(REJECTED): %v", err)
+	}
+	for _, r := range results {
+		if r.Err != nil || r.App.RejectionReason != kanban.RejectionAfterScreening || r.App.RejectionStage != "INTERVIEW" {
+			t.Errorf("BulkMove(REJECTED) result = %+v, want AFTER_SCREENING at INTERVIEW", r)
+			continue
+		}
+		h := e.history(t, r.ApplicationID)
+		if len(h) != 2 || h[0].Reason != "Sent at the job fair" || h[1].Reason != "Position filled" ||
+			h[1].RejectionReason != kanban.RejectionAfterScreening || h[1].RejectionStage != "INTERVIEW" {
+			t.Errorf("history = %+v, want both moves with their reason and the rejection details", h)
+		}
+	}
+}
+
+// offerCard creates a card of a new job and moves it through to OFFER.
+func (e *integrationEnv) offerCard(t *testing.T, user, title string) string {
+	t.Helper()
+	ctx := context.Background()
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	for _, to := range []kanban.Status{kanban.StatusApplied, kanban.StatusInterview, kanban.StatusOffer} {
+		if _, err := e.svc.MoveCard(ctx, user, app.ID, string(to), "", "", ""); err != nil {
+			t.Fatalf("MoveCard(%s): %v", to, err)
+		}
+	}
+	return app.ID
+}
+
+// Offer terms can only be recorded from OFFER on; a second call replaces
+// them.
+func TestIntegrationOfferDetails(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	early, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "SRE", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	var ve *kanban.ValidationError
+	if _, err := e.svc.SetOfferDetails(ctx, user, early.ID, kanban.Offer{BaseSalary: 60000}); !errors.As(err, &ve) {
+		t.Errorf("SetOfferDetails(TO_APPLY) = %v, want a ValidationError", err)
+	}
+
+	appID := e.offerCard(t, user, "Go Developer")
+	if _, err := e.svc.SetOfferDetails(ctx, user, appID, kanban.Offer{Currency: "EURO"}); !errors.As(err, &ve) || ve.Field != "currency" {
+		t.Errorf("SetOfferDetails(bad currency) = %v, want a currency ValidationError", err)
+	}
+	if _, err := e.svc.SetOfferDetails(ctx, other, appID, kanban.Offer{BaseSalary: 60000}); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("SetOfferDetails(other user) = %v, want ErrNotFound", err)
+	}
+	if n := e.count(t, "offers", "application_id = $1", appID); n != 0 {
+		t.Fatalf("%d offers stored by rejected calls, want none", n)
+	}
+
+	start := time.Date(2027, 1, 4, 15, 0, 0, 0, time.UTC)
+	offer, err := e.svc.SetOfferDetails(ctx, user, appID, kanban.Offer{
+		BaseSalary: 60000, Bonus: 5000, EquityDetails: "0.1% over 4 years", StartDate: &start,
+	})
+	if err != nil {
+		t.Fatalf("SetOfferDetails: %v", err)
+	}
+	if offer.Currency != "EUR" || offer.BaseSalary != 60000 || offer.Bonus != 5000 || offer.EquityDetails != "0.1% over 4 years" ||
+		offer.StartDate == nil || !offer.StartDate.Equal(time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SetOfferDetails = %+v, want the normalized terms", offer)
+	}
+
+	offer, err = e.svc.SetOfferDetails(ctx, user, appID, kanban.Offer{Currency: "usd", BaseSalary: 70000})
+	if err != nil {
+		t.Fatalf("SetOfferDetails(replace): %v", err)
+	}
+	if offer.Currency != "USD" || offer.BaseSalary != 70000 || offer.Bonus != 0 || offer.EquityDetails != "" || offer.StartDate != nil {
+		t.Errorf("SetOfferDetails(replace) = %+v, want the new terms only", offer)
+	}
+	if n := e.count(t, "offers", "application_id = $1", appID); n != 1 {
+		t.Errorf("%d offers stored, want the replaced one only", n)
+	}
+	if got := e.outboxStreams(t, appID); got[len(got)-1] != "EVENT_APPLICATION_UPDATED" {
+		t.Errorf("outbox = %v, want EVENT_APPLICATION_UPDATED last", got)
+	}
+
+	// Still editable once hired.
+	if _, err := e.svc.MoveCard(ctx, user, appID, "HIRED", "", "", ""); err != nil {
+		t.Fatalf("MoveCard(HIRED): %v", err)
+	}
+	if _, err := e.svc.SetOfferDetails(ctx, user, appID, kanban.Offer{Currency: "USD", BaseSalary: 72000}); err != nil {
+		t.Errorf("SetOfferDetails(HIRED): %v", err)
+	}
+}
+
+// CompareOffers lays the user's cards at OFFER side by side, ranked per
+// currency, each with its negotiation log.
+func TestIntegrationCompareOffers(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	low, high, usd, bare := e.offerCard(t, user, "Go Developer"), e.offerCard(t, user, "SRE"),
+		e.offerCard(t, user, "Platform Engineer"), e.offerCard(t, user, "Data Engineer")
+	for id, o := range map[string]kanban.Offer{
+		low:  {BaseSalary: 50000},
+		high: {BaseSalary: 60000, Bonus: 10000, EquityValue: 10000},
+		usd:  {Currency: "USD", BaseSalary: 120000},
+	} {
+		if _, err := e.svc.SetOfferDetails(ctx, user, id, o); err != nil {
+			t.Fatalf("SetOfferDetails: %v", err)
+		}
+	}
+	// Not compared: a card still in INTERVIEW, and another user's offer.
+	early, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Backend Engineer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	var ve *kanban.ValidationError
+	if _, err := e.svc.AddNegotiationEntry(ctx, user, early.ID, kanban.NegotiationEntry{Note: "Too early"}); !errors.As(err, &ve) {
+		t.Errorf("AddNegotiationEntry(TO_APPLY) = %v, want a ValidationError", err)
+	}
+	e.offerCard(t, other, "Go Developer")
+
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 9, 0, 0, 0, time.UTC) }
+	counter, err := e.svc.AddNegotiationEntry(ctx, user, low, kanban.NegotiationEntry{
+		Kind: kanban.NegotiationCounterOffer, BaseSalary: 55000, Note: "Asked for 55k", OccurredAt: day(2),
+	})
+	if err != nil {
+		t.Fatalf("AddNegotiationEntry: %v", err)
+	}
+	if _, err := e.svc.AddNegotiationEntry(ctx, user, low, kanban.NegotiationEntry{Note: "Recruiter will check", OccurredAt: day(3)}); err != nil {
+		t.Fatalf("AddNegotiationEntry: %v", err)
+	}
+	mistake, err := e.svc.AddNegotiationEntry(ctx, user, low, kanban.NegotiationEntry{Note: "Wrong card", OccurredAt: day(1)})
+	if err != nil {
+		t.Fatalf("AddNegotiationEntry: %v", err)
+	}
+	if err := e.svc.DeleteNegotiationEntry(ctx, other, mistake.ID); !errors.Is(err, kanban.ErrNegotiationEntryNotFound) {
+		t.Errorf("DeleteNegotiationEntry(other user) = %v, want ErrNegotiationEntryNotFound", err)
+	}
+	if err := e.svc.DeleteNegotiationEntry(ctx, user, mistake.ID); err != nil {
+		t.Fatalf("DeleteNegotiationEntry: %v", err)
+	}
+	if log, err := e.svc.ListNegotiationEntries(ctx, user, low); err != nil || len(log) != 2 || log[0].ID != counter.ID || log[0].BaseSalary != 55000 {
+		t.Errorf("ListNegotiationEntries = %+v, %v; want the counter-offer first, then the note", log, err)
+	}
+	if _, err := e.svc.ListNegotiationEntries(ctx, other, low); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("ListNegotiationEntries(other user) = %v, want ErrNotFound", err)
+	}
+
+	got, err := e.svc.CompareOffers(ctx, user)
+	if err != nil {
+		t.Fatalf("CompareOffers: %v", err)
+	}
+	want := []struct {
+		id          string
+		total       int64
+		rank        int
+		negotiation int
+	}{
+		{high, 80000, 1, 0},
+		{low, 50000, 2, 2},
+		{usd, 120000, 1, 0},
+		{bare, 0, 0, 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CompareOffers returned %d offers, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.ApplicationID != w.id || g.TotalCompensation != w.total || g.Rank != w.rank || len(g.Negotiation) != w.negotiation {
+			t.Errorf("offer %d = {%s total %d rank %d, %d negotiation steps}, want %+v",
+				i, g.ApplicationID, g.TotalCompensation, g.Rank, len(g.Negotiation), w)
+		}
+	}
+	if got[3].Offer != nil || got[3].Negotiation == nil {
+		t.Errorf("card without terms = %+v, want no offer and an empty negotiation log", got[3])
+	}
+}
+
+// fakeGoogle answers gcal.Client's OAuth and Calendar API requests. It
+// keeps the pushed events; moved lists the reschedules the next sync will
+// see, as if made by the user in Google.
+type fakeGoogle struct {
+	mu      sync.Mutex
+	events  map[string]time.Time // start of each event, by Google ID
+	uids    map[string]string    // tracker UID of each event, by Google ID
+	moved   map[string]time.Time // changes the next listing reports
+	revoked []string
+}
+
+func (g *fakeGoogle) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	type dateTime struct {
+		DateTime string `json:"dateTime"`
+	}
+	const events = "/calendar/v3/calendars/jobmate-cal/events"
+	switch {
+	case r.URL.Path == "/token":
+		r.ParseForm() //nolint:errcheck
+		if r.Form.Get("code") == "rejected" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"}) //nolint:errcheck
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "at", "refresh_token": "refresh-1", "expires_in": 3600}) //nolint:errcheck
+	case r.URL.Path == "/revoke":
+		r.ParseForm() //nolint:errcheck
+		g.revoked = append(g.revoked, r.Form.Get("token"))
+	case r.URL.Path == "/calendar/v3/calendars" && r.Method == http.MethodPost:
+		json.NewEncoder(w).Encode(map[string]string{"id": "jobmate-cal"}) //nolint:errcheck
+	case r.URL.Path == events && r.Method == http.MethodGet:
+		var items []map[string]any
+		for id, start := range g.moved {
+			items = append(items, map[string]any{"id": id, "start": dateTime{start.Format(time.RFC3339)}})
+		}
+		g.moved = nil
+		json.NewEncoder(w).Encode(map[string]any{"items": items, "nextSyncToken": "sync-1"}) //nolint:errcheck
+	case strings.HasPrefix(r.URL.Path, events) && r.Method != http.MethodGet:
+		id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, events), "/")
+		if r.Method == http.MethodDelete {
+			delete(g.events, id)
+			return
+		}
+		var in struct {
+			Start              dateTime `json:"start"`
+			ExtendedProperties struct {
+				Private map[string]string `json:"private"`
+			} `json:"extendedProperties"`
+		}
+		json.NewDecoder(r.Body).Decode(&in) //nolint:errcheck
+		if id == "" {
+			id = fmt.Sprintf("ev-%d", len(g.uids)+1)
+		}
+		g.events[id], _ = time.Parse(time.RFC3339, in.Start.DateTime)
+		g.uids[id] = in.ExtendedProperties.Private["jobmateUid"]
+		json.NewEncoder(w).Encode(map[string]any{"id": id, "start": in.Start}) //nolint:errcheck
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// redirectTransport sends every request to target instead of its host.
+type redirectTransport struct{ target *url.URL }
+
+func (rt redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host = rt.target.Scheme, rt.target.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// Connecting Google Calendar stores the grant; syncs push the user's
+// interviews and bring back the ones moved in Google; disconnecting revokes
+// the grant.
+func TestIntegrationGoogleCalendarSync(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+
+	if _, err := e.svc.StartGoogleCalendarAuth(ctx, user); !errors.Is(err, kanban.ErrGoogleCalendarDisabled) {
+		t.Errorf("StartGoogleCalendarAuth(not configured) = %v, want ErrGoogleCalendarDisabled", err)
+	}
+
+	google := &fakeGoogle{events: map[string]time.Time{}, uids: map[string]string{}}
+	srv := httptest.NewServer(google)
+	t.Cleanup(srv.Close)
+	target, _ := url.Parse(srv.URL)
+	gc, err := gcal.New(gcal.Config{
+		ClientID: "id", ClientSecret: "secret", RedirectURL: "https://api.jobmate.test/callback",
+		HTTPClient: &http.Client{Transport: redirectTransport{target}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	box, err := secretbox.New(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{GoogleCalendar: gc, Secrets: box})
+
+	consent, err := svc.StartGoogleCalendarAuth(ctx, user)
+	if err != nil {
+		t.Fatalf("StartGoogleCalendarAuth: %v", err)
+	}
+	u, err := url.Parse(consent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := u.Query().Get("state")
+	var ve *kanban.ValidationError
+	if _, err := svc.CompleteGoogleCalendarAuth(ctx, "forged", "code"); !errors.As(err, &ve) || ve.Field != "state" {
+		t.Errorf("CompleteGoogleCalendarAuth(unknown state) = %v, want a state ValidationError", err)
+	}
+	st, err := svc.CompleteGoogleCalendarAuth(ctx, state, "code")
+	if err != nil || !st.Connected || st.CalendarID != "jobmate-cal" {
+		t.Fatalf("CompleteGoogleCalendarAuth = %+v, %v; want connected to the JobMate calendar", st, err)
+	}
+	if _, err := svc.CompleteGoogleCalendarAuth(ctx, state, "code"); !errors.As(err, &ve) || ve.Field != "state" {
+		t.Errorf("CompleteGoogleCalendarAuth(state reused) = %v, want a state ValidationError", err)
+	}
+	var refresh []byte
+	if err := e.pool.QueryRow(ctx, `SELECT refresh_token FROM google_calendar_links WHERE user_id = $1`, user).Scan(&refresh); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(refresh, []byte("refresh-1")) {
+		t.Errorf("refresh token stored in clear")
+	}
+
+	app, err := svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	scheduled := time.Now().Add(72 * time.Hour).UTC().Truncate(time.Second)
+	iv, err := svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Type: kanban.InterviewPhoneScreen, ScheduledAt: &scheduled})
+	if err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+
+	if n, err := svc.SyncGoogleCalendars(ctx); err != nil || n < 1 {
+		t.Fatalf("SyncGoogleCalendars = %d, %v", n, err)
+	}
+	google.mu.Lock()
+	var pushed string
+	for id, uid := range google.uids {
+		if uid == "interview-"+iv.ID+"@jobmate" {
+			pushed = id
+		}
+	}
+	if pushed == "" || !google.events[pushed].Equal(scheduled) {
+		t.Errorf("pushed events = %v, want the interview at %s", google.events, scheduled)
+	}
+	// The user moves it by a day in Google.
+	moved := scheduled.Add(24 * time.Hour)
+	google.moved = map[string]time.Time{pushed: moved}
+	google.mu.Unlock()
+
+	if _, err := svc.SyncGoogleCalendars(ctx); err != nil {
+		t.Fatalf("SyncGoogleCalendars: %v", err)
+	}
+	got, err := svc.GetApplication(ctx, user, app.ID)
+	if err != nil {
+		t.Fatalf("GetApplication: %v", err)
+	}
+	if len(got.Interviews) != 1 || got.Interviews[0].ScheduledAt == nil || !got.Interviews[0].ScheduledAt.Equal(moved) {
+		t.Errorf("interviews = %+v, want it rescheduled to %s", got.Interviews, moved)
+	}
+	if st, err := svc.GetGoogleCalendarStatus(ctx, user); err != nil || st.LastSyncedAt == nil || st.LastError != "" {
+		t.Errorf("GetGoogleCalendarStatus = %+v, %v; want a clean last sync", st, err)
+	}
+
+	if err := svc.DisconnectGoogleCalendar(ctx, user); err != nil {
+		t.Fatalf("DisconnectGoogleCalendar: %v", err)
+	}
+	if st, err := svc.GetGoogleCalendarStatus(ctx, user); err != nil || st.Connected {
+		t.Errorf("GetGoogleCalendarStatus after disconnecting = %+v, %v", st, err)
+	}
+	if fmt.Sprint(google.revoked) != "[refresh-1]" {
+		t.Errorf("revoked tokens = %v, want the refresh token", google.revoked)
+	}
+	if err := svc.DisconnectGoogleCalendar(ctx, user); err != nil {
+		t.Errorf("DisconnectGoogleCalendar(twice) = %v, want a no-op", err)
+	}
+}
+
+// Feed tokens are stored hashed and only returned on creation and rotation.
+func TestIntegrationCalendarFeedToken(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+
+	feed, err := e.svc.GetCalendarFeed(ctx, user)
+	if err != nil || feed.Token == "" || feed.Path() != "/calendar/"+feed.Token+".ics" {
+		t.Fatalf("GetCalendarFeed(first) = %+v, %v; want a new feed with its token", feed, err)
+	}
+	var stored []byte
+	if err := e.pool.QueryRow(ctx, `SELECT token_hash FROM calendar_feeds WHERE user_id = $1`, user).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if sum := sha256.Sum256([]byte(feed.Token)); !bytes.Equal(stored, sum[:]) {
+		t.Errorf("stored token_hash = %x, want the token's SHA-256", stored)
+	}
+	if ics, err := e.svc.RenderCalendarFeed(ctx, feed.Token); err != nil || !strings.HasPrefix(ics, "BEGIN:VCALENDAR") {
+		t.Errorf("RenderCalendarFeed = %.40q, %v; want the feed", ics, err)
+	}
+
+	again, err := e.svc.GetCalendarFeed(ctx, user)
+	if err != nil || again.Token != "" || again.Path() != "" || !again.CreatedAt.Equal(feed.CreatedAt) {
+		t.Errorf("GetCalendarFeed(again) = %+v, %v; want the same feed without its token", again, err)
+	}
+
+	rotated, err := e.svc.RotateCalendarFeedToken(ctx, user)
+	if err != nil || rotated.Token == "" || rotated.Token == feed.Token {
+		t.Fatalf("RotateCalendarFeedToken = %+v, %v; want a new token", rotated, err)
+	}
+	if _, err := e.svc.RenderCalendarFeed(ctx, feed.Token); !errors.Is(err, kanban.ErrCalendarFeedNotFound) {
+		t.Errorf("RenderCalendarFeed(old token) = %v, want ErrCalendarFeedNotFound", err)
+	}
+	if _, err := e.svc.RenderCalendarFeed(ctx, rotated.Token); err != nil {
+		t.Errorf("RenderCalendarFeed(rotated token): %v", err)
+	}
+}
+
+// GetHistory pages through the log newest first, each entry typed.
+func TestIntegrationGetHistory(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	moves := []kanban.Status{kanban.StatusApplied, kanban.StatusInterview, kanban.StatusOnHold, kanban.StatusInterview, kanban.StatusOffer}
+	for _, st := range moves {
+		if _, err := e.svc.MoveCard(ctx, user, app.ID, string(st), "", "", ""); err != nil {
+			t.Fatalf("MoveCard(%s): %v", st, err)
+		}
+	}
+
+	var (
+		got   []string
+		token string
+	)
+	for pages := 1; ; pages++ {
+		page, err := e.svc.GetHistory(ctx, user, app.ID, 2, token)
+		if err != nil {
+			t.Fatalf("GetHistory(page %d): %v", pages, err)
+		}
+		if page.TotalCount != len(moves) || len(page.Entries) > 2 {
+			t.Fatalf("page %d = %d entries of %d, want at most 2 of %d", pages, len(page.Entries), page.TotalCount, len(moves))
+		}
+		for _, entry := range page.Entries {
+			if entry.Kind != kanban.HistoryMove || entry.Actor != kanban.ActorUser {
+				t.Errorf("entry %+v, want a user's move", entry)
+			}
+			got = append(got, entry.To)
+		}
+		if token = page.NextPageToken; token == "" {
+			if pages != 3 {
+				t.Errorf("%d pages, want 3", pages)
+			}
+			break
+		}
+	}
+	if want := "[OFFER INTERVIEW ON_HOLD INTERVIEW APPLIED]"; fmt.Sprint(got) != want {
+		t.Errorf("history = %v, want %s", got, want)
+	}
+
+	if _, err := e.svc.GetHistory(ctx, other, app.ID, 0, ""); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetHistory(other user) = %v, want ErrNotFound", err)
+	}
+}
+
+// SearchApplications ranks title matches above note matches, pages through
+// the results and leaves out archived cards unless asked for them.
+func TestIntegrationSearchApplications(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	newCard := func(user, title string) string {
+		t.Helper()
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", title, "Acme"), false)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		return app.ID
+	}
+	titled, noted, archived := newCard(user, "Kubernetes Engineer"), newCard(user, "Go Developer"), newCard(user, "Kubernetes Operator")
+	newCard(user, "Data Engineer")
+	newCard(other, "Kubernetes Engineer")
+	if _, err := e.svc.AddNote(ctx, user, noted, "The recruiter said the team runs its own Kubernetes clusters"); err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	notes, err := e.svc.ListNotes(ctx, user, noted)
+	if err != nil {
+		t.Fatalf("ListNotes: %v", err)
+	}
+	if _, err := e.svc.ArchiveApplication(ctx, user, archived); err != nil {
+		t.Fatalf("ArchiveApplication: %v", err)
+	}
+
+	for _, tc := range []struct {
+		query string
+		size  int
+		token string
+		field string
+	}{
+		{" \t", 0, "", "query"},
+		{strings.Repeat("k", 201), 0, "", "query"},
+		{"kubernetes", 51, "", "page_size"},
+		{"kubernetes", 10, "0", "page_token"},
+	} {
+		_, err := e.svc.SearchApplications(ctx, user, tc.query, false, tc.size, tc.token)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) || ve.Field != tc.field {
+			t.Errorf("SearchApplications(%.20q, %d, %q) = %v, want a %s ValidationError", tc.query, tc.size, tc.token, err, tc.field)
+		}
+	}
+
+	first, err := e.svc.SearchApplications(ctx, user, "kubernetes", false, 1, "")
+	if err != nil {
+		t.Fatalf("SearchApplications: %v", err)
+	}
+	if len(first.Hits) != 1 || first.Hits[0].Application.ID != titled || first.NextPageToken == "" {
+		t.Fatalf("first page = %+v, want the title match and a next page", first)
+	}
+	if h := first.Hits[0].Highlights; len(h) == 0 || h[0].Field != kanban.SearchFieldTitle || len(h[0].Matches) == 0 {
+		t.Errorf("title match highlights = %+v", h)
+	}
+	second, err := e.svc.SearchApplications(ctx, user, "kubernetes", false, 1, first.NextPageToken)
+	if err != nil {
+		t.Fatalf("SearchApplications(page 2): %v", err)
+	}
+	if len(second.Hits) != 1 || second.Hits[0].Application.ID != noted || second.NextPageToken != "" {
+		t.Fatalf("second page = %+v, want the note match, last", second)
+	}
+	if h := second.Hits[0].Highlights; len(h) != 1 || h[0].Field != kanban.SearchFieldNote || h[0].NoteID != notes[0].ID {
+		t.Errorf("note match highlights = %+v", h)
+	}
+
+	all, err := e.svc.SearchApplications(ctx, user, "kubernetes", true, 0, "")
+	if err != nil {
+		t.Fatalf("SearchApplications(archived too): %v", err)
+	}
+	if len(all.Hits) != 3 {
+		t.Errorf("SearchApplications(archived too) = %d hits, want 3", len(all.Hits))
+	}
+	if page, err := e.svc.SearchApplications(ctx, user, "the and of", false, 0, ""); err != nil || len(page.Hits) != 0 {
+		t.Errorf("SearchApplications(stop words) = %+v, %v; want no hits", page, err)
+	}
+}
+
+// GetTimeline interleaves a card's moves, notes and interviews, newest
+// first, and pages through them.
+func TestIntegrationGetTimeline(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, "APPLIED", "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if _, err := e.svc.AddNote(ctx, user, app.ID, "Called the recruiter"); err != nil {
+		t.Fatalf("AddNote: %v", err)
+	}
+	scheduled := time.Now().Add(48 * time.Hour)
+	if _, err := e.svc.CreateInterview(ctx, user, app.ID, kanban.Interview{Type: kanban.InterviewPhoneScreen, ScheduledAt: &scheduled}); err != nil {
+		t.Fatalf("CreateInterview: %v", err)
+	}
+	// The log and the notes table are both read: shift the move back so
+	// the order does not depend on how fast the calls above ran.
+	e.shiftMoves(t, app.ID, kanban.StatusApplied, time.Hour)
+
+	for _, tc := range []struct {
+		size  int
+		token string
+		field string
+	}{
+		{201, "", "page_size"},
+		{10, "abc", "page_token"},
+	} {
+		var ve *kanban.ValidationError
+		if _, err := e.svc.GetTimeline(ctx, user, app.ID, tc.size, tc.token); !errors.As(err, &ve) || ve.Field != tc.field {
+			t.Errorf("GetTimeline(%d, %q) = %v, want a %s ValidationError", tc.size, tc.token, err, tc.field)
+		}
+	}
+
+	first, err := e.svc.GetTimeline(ctx, user, app.ID, 2, "")
+	if err != nil {
+		t.Fatalf("GetTimeline: %v", err)
+	}
+	if first.TotalCount != 3 || len(first.Entries) != 2 || first.NextPageToken != "2" {
+		t.Fatalf("first page = %+v, want 2 of 3 entries and a next page", first)
+	}
+	if first.Entries[0].Kind != kanban.TimelineInterview || first.Entries[1].Kind != kanban.TimelineNote || first.Entries[1].Note.Text != "Called the recruiter" {
+		t.Errorf("first page = %s, %s; want the scheduled interview, then the note", first.Entries[0].Kind, first.Entries[1].Kind)
+	}
+	last, err := e.svc.GetTimeline(ctx, user, app.ID, 2, first.NextPageToken)
+	if err != nil {
+		t.Fatalf("GetTimeline(page 2): %v", err)
+	}
+	if len(last.Entries) != 1 || last.Entries[0].Kind != kanban.HistoryMove || last.Entries[0].History.To != "APPLIED" || last.NextPageToken != "" {
+		t.Errorf("last page = %+v, want the move, and no next page", last)
+	}
+	if page, err := e.svc.GetTimeline(ctx, user, app.ID, 2, "10"); err != nil || len(page.Entries) != 0 {
+		t.Errorf("GetTimeline(past the end) = %+v, %v; want an empty page", page, err)
+	}
+
+	if _, err := e.svc.GetTimeline(ctx, other, app.ID, 0, ""); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("GetTimeline(other user) = %v, want ErrNotFound", err)
+	}
+}
+
+// RequestFollowUpDraft queues CMD_GENERATE_FOLLOWUP with the days since the
+// card's last move, at most once per cooldown.
+func TestIntegrationRequestFollowUpDraft(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user, other := e.newUser(t), e.newUser(t)
+	svc := kanban.NewService(e.pool, e.rdb, kanban.Options{FollowUpCooldown: time.Hour})
+	app, err := svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	var ve *kanban.ValidationError
+	if err := svc.RequestFollowUpDraft(ctx, user, app.ID, ""); !errors.As(err, &ve) {
+		t.Errorf("RequestFollowUpDraft(TO_APPLY) = %v, want a ValidationError", err)
+	}
+	if _, err := svc.MoveCard(ctx, user, app.ID, "APPLIED", "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if err := svc.RequestFollowUpDraft(ctx, user, app.ID, "sarcastic"); !errors.As(err, &ve) || ve.Field != "tone" {
+		t.Errorf("RequestFollowUpDraft(bad tone) = %v, want a tone ValidationError", err)
+	}
+	if err := svc.RequestFollowUpDraft(ctx, other, app.ID, ""); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("RequestFollowUpDraft(other user) = %v, want ErrNotFound", err)
+	}
+	e.shiftMoves(t, app.ID, kanban.StatusApplied, 9*24*time.Hour+time.Hour)
+	if _, err := e.pool.Exec(ctx, `UPDATE applications SET created_at = NOW() - INTERVAL '20 days' WHERE id = $1`, app.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.RequestFollowUpDraft(ctx, user, app.ID, "friendly"); err != nil {
+		t.Fatalf("RequestFollowUpDraft: %v", err)
+	}
+	var cooldown *kanban.CooldownError
+	if err := svc.RequestFollowUpDraft(ctx, user, app.ID, "friendly"); !errors.As(err, &cooldown) {
+		t.Errorf("RequestFollowUpDraft(again) = %v, want a CooldownError", err)
+	}
+
+	var raw []byte
+	if err := e.pool.QueryRow(ctx,
+		`SELECT payload FROM outbox_events WHERE stream = 'CMD_GENERATE_FOLLOWUP' AND payload->>'applicationId' = $1`, app.ID,
+	).Scan(&raw); err != nil {
+		t.Fatalf("read queued command: %v", err)
+	}
+	var cmd map[string]any
+	if err := json.Unmarshal(raw, &cmd); err != nil {
+		t.Fatal(err)
+	}
+	if cmd["status"] != "APPLIED" || cmd["jobTitle"] != "Go Developer" || cmd["tone"] != "FRIENDLY" || cmd["daysSinceLastContact"] != float64(9) {
+		t.Errorf("CMD_GENERATE_FOLLOWUP = %v, want APPLIED, FRIENDLY, 9 days since the move", cmd)
+	}
+}
+
+// SeedDemoBoard fills an empty board with a card in every status, and
+// refuses a board that already has cards.
+func TestIntegrationSeedDemoBoard(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+
+	var ve *kanban.ValidationError
+	if _, err := e.svc.SeedDemoBoard(ctx, ""); !errors.As(err, &ve) || ve.Field != "user_id" {
+		t.Errorf("SeedDemoBoard(\"\") = %v, want a user_id ValidationError", err)
+	}
+	if _, err := e.svc.SeedDemoBoard(ctx, "00000000-0000-0000-0000-000000000000"); !errors.Is(err, kanban.ErrNotFound) {
+		t.Errorf("SeedDemoBoard(unknown user) = %v, want ErrNotFound", err)
+	}
+
+	n, err := e.svc.SeedDemoBoard(ctx, user)
+	if err != nil {
+		t.Fatalf("SeedDemoBoard: %v", err)
+	}
+	_, statuses, holdOrigins := kanban.DemoBoardHistories(time.Now())
+	if n != len(statuses) || e.count(t, "applications", "user_id = $1", user) != n {
+		t.Fatalf("SeedDemoBoard = %d cards, want %d stored", n, len(statuses))
+	}
+
+	rows, err := e.pool.Query(ctx,
+		`SELECT current_status::text, COALESCE(hold_origin::text, ''), jsonb_array_length(history_log) > 0
+		 FROM applications WHERE user_id = $1 ORDER BY current_status::text, hold_origin::text`, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want []string
+	for rows.Next() {
+		var status, origin string
+		var logged bool
+		if err := rows.Scan(&status, &origin, &logged); err != nil {
+			t.Fatal(err)
+		}
+		if !logged && status != string(kanban.StatusToApply) {
+			t.Errorf("%s card without history", status)
+		}
+		got = append(got, status+"/"+origin)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	for i, st := range statuses {
+		want = append(want, string(st)+"/"+string(holdOrigins[i]))
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("seeded cards = %v, want %v", got, want)
+	}
+	if created := e.count(t, "outbox_events", "stream = 'EVENT_APPLICATION_CREATED' AND payload->>'userId' = $1", user); created != n {
+		t.Errorf("%d EVENT_APPLICATION_CREATED queued, want %d", created, n)
+	}
+
+	if _, err := e.svc.SeedDemoBoard(ctx, user); !errors.Is(err, kanban.ErrBoardNotEmpty) {
+		t.Errorf("SeedDemoBoard(again) = %v, want ErrBoardNotEmpty", err)
+	}
+}
+
+// ReplayEvents queues again the published events of a user, an application
+// or a stream, marked replayed; pruned events are gone for good.
+func TestIntegrationReplayEvents(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+
+	var ve *kanban.ValidationError
+	for name, f := range map[string]kanban.ReplayFilter{
+		"no scope":           {},
+		"streams only":       {Streams: []string{"EVENT_CARD_MOVED"}},
+		"until before since": {UserID: user, Since: time.Now(), Until: time.Now().Add(-time.Hour)},
+	} {
+		if _, err := e.svc.ReplayEvents(ctx, f); !errors.As(err, &ve) {
+			t.Errorf("ReplayEvents(%s) = %v, want a ValidationError", name, err)
+		}
+	}
+
+	app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, "", "Go Developer", "Acme"), false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, "APPLIED", "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if n, err := e.svc.ReplayEvents(ctx, kanban.ReplayFilter{UserID: user}); err != nil || n != 0 {
+		t.Errorf("ReplayEvents(unpublished) = %d, %v; want nothing replayed", n, err)
+	}
+	if _, err := e.svc.RelayOutbox(ctx); err != nil {
+		t.Fatalf("RelayOutbox: %v", err)
+	}
+	published := e.outboxStreams(t, app.ID)
+
+	n, err := e.svc.ReplayEvents(ctx, kanban.ReplayFilter{ApplicationID: app.ID, Streams: []string{"EVENT_CARD_MOVED"}})
+	if err != nil || n != 1 {
+		t.Fatalf("ReplayEvents(one stream) = %d, %v; want the move", n, err)
+	}
+	var replayed bool
+	if err := e.pool.QueryRow(ctx,
+		`SELECT (payload->>'replayed')::boolean FROM outbox_events
+		 WHERE payload->>'applicationId' = $1 AND published_at IS NULL`, app.ID,
+	).Scan(&replayed); err != nil || !replayed {
+		t.Errorf("replayed copy: replayed = %v, %v", replayed, err)
+	}
+	if n, err := e.svc.ReplayEvents(ctx, kanban.ReplayFilter{UserID: user, Since: time.Now().Add(-time.Hour)}); err != nil || n != len(published) {
+		t.Errorf("ReplayEvents(user) = %d, %v; want the %d published events", n, err, len(published))
+	}
+	if n, err := e.svc.ReplayEvents(ctx, kanban.ReplayFilter{UserID: user, Since: time.Now().Add(time.Hour)}); err != nil || n != 0 {
+		t.Errorf("ReplayEvents(since later) = %d, %v; want none", n, err)
+	}
+
+	if _, err := e.pool.Exec(ctx,
+		`UPDATE outbox_events SET published_at = NOW() - INTERVAL '2 days'
+		 WHERE payload->>'userId' = $1 AND published_at IS NOT NULL`, user); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := e.svc.PruneOutbox(ctx, 24*time.Hour); err != nil || n < len(published) {
+		t.Fatalf("PruneOutbox = %d, %v; want at least the user's %d events", n, err, len(published))
+	}
+	if n, err := e.svc.ReplayEvents(ctx, kanban.ReplayFilter{UserID: user}); err != nil || n != 0 {
+		t.Errorf("ReplayEvents(pruned) = %d, %v; want nothing left to replay", n, err)
+	}
+}
+
+// GetSearchConfigStats counts each config's funnel; a card rejected before
+// it was sent is an application, not an applied one.
+func TestIntegrationSearchConfigStats(t *testing.T) {
+	e := setupIntegration(t)
+	ctx := context.Background()
+	user := e.newUser(t)
+	primary, other, empty := e.newSearch(t, user, false), e.newSearch(t, user, false), e.newSearch(t, user, false)
+	card := func(searchID, title string, path ...kanban.Status) {
+		t.Helper()
+		app, err := e.svc.CreateApplication(ctx, user, e.newJob(t, user, searchID, title, "Acme"), true)
+		if err != nil {
+			t.Fatalf("CreateApplication: %v", err)
+		}
+		for _, st := range path {
+			if _, err := e.svc.MoveCard(ctx, user, app.ID, string(st), "", "", ""); err != nil {
+				t.Fatalf("MoveCard(%s): %v", st, err)
+			}
+		}
+	}
+	card(primary, "Go Developer")
+	card(primary, "SRE", kanban.StatusRejected)
+	card(primary, "Platform Engineer", kanban.StatusApplied)
+	card(primary, "Backend Engineer", kanban.StatusApplied, kanban.StatusInterview, kanban.StatusRejected)
+	card(primary, "Data Engineer", kanban.StatusApplied, kanban.StatusInterview, kanban.StatusOffer, kanban.StatusHired)
+	e.newJob(t, user, primary, "Go Developer", "Globex") // discovered, no card
+	// Found by other, linked to primary.
+	linked := e.newJob(t, user, other, "Golang Developer", "Initech")
+	if _, err := e.pool.Exec(ctx, `INSERT INTO job_feed_matches (job_feed_id, search_config_id) VALUES ($1, $2)`, linked, primary); err != nil {
+		t.Fatal(err)
+	}
+	app, err := e.svc.CreateApplication(ctx, user, linked, true)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if _, err := e.svc.MoveCard(ctx, user, app.ID, "APPLIED", "", "", ""); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	// Added by hand: no config's.
+	if _, err := e.pool.Exec(ctx, `UPDATE job_feed SET is_manual = TRUE WHERE id = $1`, e.newJob(t, user, primary, "CTO", "Hooli")); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := e.svc.GetSearchConfigStats(ctx, user)
+	if err != nil {
+		t.Fatalf("GetSearchConfigStats: %v", err)
+	}
+	type funnel struct {
+		id                                                    string
+		discovered, applications, applied, interviewed, hired int
+	}
+	want := []funnel{
+		{primary, 7, 6, 4, 2, 1},
+		{other, 1, 1, 1, 0, 0},
+		{empty, 0, 0, 0, 0, 0},
+	}
+	var got []funnel
+	for _, st := range stats {
+		got = append(got, funnel{st.SearchConfigID, st.Discovered, st.Applications, st.Applied, st.Interviewed, st.Hired})
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("GetSearchConfigStats = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,379 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Interview is one round of an application's interview process.
+type Interview struct {
+	ID            string     `json:"id"`
+	ApplicationID string     `json:"applicationId"`
+	Round         int32      `json:"round"`
+	Type          string     `json:"type"`
+	ScheduledAt   *time.Time `json:"scheduledAt"` // nil = not scheduled yet
+	Interviewer   string     `json:"interviewer"`
+	Outcome       string     `json:"outcome"`
+	Notes         string     `json:"notes"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+
+	// Feedback is nil until RecordInterviewFeedback is called.
+	Feedback *InterviewFeedback `json:"feedback"`
+}
+
+// InterviewFeedback is the structured debrief written after an interview.
+type InterviewFeedback struct {
+	Outcome        string    `json:"outcome"`
+	WentWell       string    `json:"wentWell"`
+	RedFlags       string    `json:"redFlags"`
+	QuestionsAsked []string  `json:"questionsAsked"`
+	RecordedAt     time.Time `json:"recordedAt"` // set by the server
+}
+
+// InterviewUpdate lists the interview fields to change; nil fields are left
+// untouched. A zero ScheduledAt clears the date.
+type InterviewUpdate struct {
+	Round       *int32
+	Type        *string
+	ScheduledAt *time.Time
+	Interviewer *string
+	Outcome     *string
+	Notes       *string
+}
+
+// Interview types.
+const (
+	InterviewPhoneScreen = "PHONE_SCREEN"
+	InterviewTechnical   = "TECHNICAL"
+	InterviewBehavioral  = "BEHAVIORAL"
+	InterviewOnsite      = "ONSITE"
+	InterviewHR          = "HR"
+	InterviewFinal       = "FINAL"
+	InterviewOther       = "OTHER"
+)
+
+// Interview outcomes.
+const (
+	OutcomePending   = "PENDING"
+	OutcomePassed    = "PASSED"
+	OutcomeFailed    = "FAILED"
+	OutcomeCancelled = "CANCELLED"
+)
+
+const (
+	maxInterviewRound       = 50
+	maxInterviewerLen       = 200
+	maxInterviewsPerListing = 100
+	maxInterviewQuestions   = 50
+	maxInterviewQuestionLen = 500
+)
+
+// ErrInterviewNotFound is returned when an interview is missing or owned by someone else.
+var ErrInterviewNotFound = fmt.Errorf("interview not found")
+
+const interviewColumns = `id::text, application_id::text, round, type, scheduled_at,
+	COALESCE(interviewer, ''), outcome, COALESCE(notes, ''), created_at, updated_at,
+	COALESCE(went_well, ''), COALESCE(red_flags, ''), questions_asked, feedback_at`
+
+func scanInterview(row pgx.Row) (*Interview, error) {
+	var (
+		iv         Interview
+		fb         InterviewFeedback
+		feedbackAt *time.Time
+	)
+	if err := row.Scan(&iv.ID, &iv.ApplicationID, &iv.Round, &iv.Type, &iv.ScheduledAt,
+		&iv.Interviewer, &iv.Outcome, &iv.Notes, &iv.CreatedAt, &iv.UpdatedAt,
+		&fb.WentWell, &fb.RedFlags, &fb.QuestionsAsked, &feedbackAt); err != nil {
+		return nil, err
+	}
+	if feedbackAt != nil {
+		fb.Outcome = iv.Outcome
+		fb.RecordedAt = *feedbackAt
+		iv.Feedback = &fb
+	}
+	return &iv, nil
+}
+
+// CreateInterview adds an interview to an application. A zero Round takes
+// the next one after the application's last interview; Type defaults to
+// OTHER and Outcome to PENDING.
+func (s *Service) CreateInterview(ctx context.Context, userID, appID string, in Interview) (*Interview, error) {
+	in, err := normalizeInterview(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var iv *Interview
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		iv, err = scanInterview(tx.QueryRow(ctx,
+			`INSERT INTO interviews (user_id, application_id, round, type, scheduled_at, interviewer, outcome, notes)
+			 SELECT a.user_id, a.id,
+			        COALESCE(NULLIF($3, 0), (SELECT COALESCE(MAX(round), 0) + 1 FROM interviews WHERE application_id = a.id)),
+			        $4, $5, NULLIF($6, ''), $7, NULLIF($8, '')
+			 FROM applications a
+			 WHERE a.id = $1 AND a.user_id = $2
+			 RETURNING `+interviewColumns,
+			appID, userID, in.Round, in.Type, in.ScheduledAt, in.Interviewer, in.Outcome, in.Notes,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("createInterview insert: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"interviews"})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iv, nil
+}
+
+// ListInterviews returns an application's interviews, by round.
+func (s *Service) ListInterviews(ctx context.Context, userID, appID string) ([]Interview, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM applications WHERE id = $1 AND user_id = $2)`,
+		appID, userID,
+	).Scan(&exists)
+	if err != nil || !exists {
+		return nil, ErrNotFound
+	}
+	return listInterviews(ctx, s.pool, userID, appID)
+}
+
+// UpdateInterview applies the non-nil fields of upd to an interview.
+func (s *Service) UpdateInterview(ctx context.Context, userID, interviewID string, upd InterviewUpdate) (*Interview, error) {
+	var iv *Interview
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		cur, err := scanInterview(tx.QueryRow(ctx,
+			`SELECT `+interviewColumns+` FROM interviews WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+			interviewID, userID,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInterviewNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("updateInterview: %w", err)
+		}
+
+		next := applyInterviewUpdate(*cur, upd)
+		if next.Round == 0 {
+			return &ValidationError{Field: "round", Msg: fmt.Sprintf("round must be between 1 and %d", maxInterviewRound)}
+		}
+		if next, err = normalizeInterview(next); err != nil {
+			return err
+		}
+
+		iv, err = scanInterview(tx.QueryRow(ctx,
+			`UPDATE interviews
+			 SET round = $2, type = $3, scheduled_at = $4, interviewer = NULLIF($5, ''),
+			     outcome = $6, notes = NULLIF($7, ''), updated_at = NOW()
+			 WHERE id = $1
+			 RETURNING `+interviewColumns,
+			interviewID, next.Round, next.Type, next.ScheduledAt, next.Interviewer, next.Outcome, next.Notes,
+		))
+		if err != nil {
+			return fmt.Errorf("updateInterview: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, iv.ApplicationID, []string{"interviews"})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iv, nil
+}
+
+// RecordInterviewFeedback stores the debrief of an interview and sets its
+// outcome. Recording again replaces the previous feedback. Each call also
+// appends an INTERVIEW_FEEDBACK entry to the application's history_log.
+func (s *Service) RecordInterviewFeedback(ctx context.Context, userID, interviewID string, fb InterviewFeedback) (*Interview, error) {
+	fb, err := normalizeInterviewFeedback(fb)
+	if err != nil {
+		return nil, err
+	}
+
+	var iv *Interview
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		iv, err = scanInterview(tx.QueryRow(ctx,
+			`UPDATE interviews
+			 SET outcome = $3, went_well = NULLIF($4, ''), red_flags = NULLIF($5, ''),
+			     questions_asked = $6, feedback_at = NOW(), updated_at = NOW()
+			 WHERE id = $1 AND user_id = $2
+			 RETURNING `+interviewColumns,
+			interviewID, userID, fb.Outcome, fb.WentWell, fb.RedFlags, fb.QuestionsAsked,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInterviewNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("recordInterviewFeedback: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, iv.ApplicationID, []string{"interviews", "history_log"}, HistoryEntry{
+			Kind:        HistoryInterviewFeedback,
+			At:          iv.Feedback.RecordedAt.UTC().Truncate(time.Second),
+			InterviewID: iv.ID,
+			Round:       iv.Round,
+			Outcome:     iv.Outcome,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iv, nil
+}
+
+// DeleteInterview removes an interview. Later rounds keep their numbers.
+func (s *Service) DeleteInterview(ctx context.Context, userID, interviewID string) error {
+	return s.inTx(ctx, func(tx pgx.Tx) error {
+		var appID string
+		err := tx.QueryRow(ctx,
+			`DELETE FROM interviews WHERE id = $1 AND user_id = $2 RETURNING application_id::text`,
+			interviewID, userID,
+		).Scan(&appID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInterviewNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("deleteInterview: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"interviews"})
+	})
+}
+
+func listInterviews(ctx context.Context, q querier, userID, appID string) ([]Interview, error) {
+	rows, err := q.Query(ctx,
+		`SELECT `+interviewColumns+` FROM interviews
+		 WHERE application_id = $1 AND user_id = $2
+		 ORDER BY round, scheduled_at NULLS LAST, created_at
+		 LIMIT $3`,
+		appID, userID, maxInterviewsPerListing)
+	if err != nil {
+		return nil, fmt.Errorf("listInterviews query: %w", err)
+	}
+	defer rows.Close()
+
+	ivs := make([]Interview, 0)
+	for rows.Next() {
+		iv, err := scanInterview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listInterviews scan: %w", err)
+		}
+		ivs = append(ivs, *iv)
+	}
+	return ivs, rows.Err()
+}
+
+func applyInterviewUpdate(iv Interview, upd InterviewUpdate) Interview {
+	if upd.Round != nil {
+		iv.Round = *upd.Round
+	}
+	if upd.Type != nil {
+		iv.Type = *upd.Type
+	}
+	if upd.ScheduledAt != nil {
+		iv.ScheduledAt = upd.ScheduledAt
+		if upd.ScheduledAt.IsZero() {
+			iv.ScheduledAt = nil
+		}
+	}
+	if upd.Interviewer != nil {
+		iv.Interviewer = *upd.Interviewer
+	}
+	if upd.Outcome != nil {
+		iv.Outcome = *upd.Outcome
+	}
+	if upd.Notes != nil {
+		iv.Notes = *upd.Notes
+	}
+	return iv
+}
+
+// normalizeInterview validates an interview's user-supplied fields and fills
+// in the Type and Outcome defaults. Round 0 is left for the caller to assign.
+func normalizeInterview(iv Interview) (Interview, error) {
+	if iv.Round < 0 || iv.Round > maxInterviewRound {
+		return iv, &ValidationError{Field: "round", Msg: fmt.Sprintf("round must be between 1 and %d", maxInterviewRound)}
+	}
+
+	switch iv.Type {
+	case "":
+		iv.Type = InterviewOther
+	case InterviewPhoneScreen, InterviewTechnical, InterviewBehavioral, InterviewOnsite,
+		InterviewHR, InterviewFinal, InterviewOther:
+	default:
+		return iv, &ValidationError{Field: "type", Msg: fmt.Sprintf("unknown interview type %q", iv.Type)}
+	}
+
+	if iv.Outcome == "" {
+		iv.Outcome = OutcomePending
+	} else if err := validateInterviewOutcome(iv.Outcome); err != nil {
+		return iv, err
+	}
+
+	if iv.ScheduledAt != nil && iv.ScheduledAt.IsZero() {
+		iv.ScheduledAt = nil
+	}
+
+	var err error
+	if iv.Interviewer, err = cleanText("interviewer", iv.Interviewer, maxInterviewerLen); err != nil {
+		return iv, err
+	}
+	if iv.Notes, err = cleanText("notes", iv.Notes, maxNoteLen); err != nil {
+		return iv, err
+	}
+	return iv, nil
+}
+
+// normalizeInterviewFeedback validates a debrief: a known outcome is
+// required, texts are cleaned and blank questions dropped.
+func normalizeInterviewFeedback(fb InterviewFeedback) (InterviewFeedback, error) {
+	if fb.Outcome == "" {
+		return fb, &ValidationError{Field: "outcome", Msg: "outcome is required"}
+	}
+	if err := validateInterviewOutcome(fb.Outcome); err != nil {
+		return fb, err
+	}
+
+	var err error
+	if fb.WentWell, err = cleanText("went_well", fb.WentWell, maxNoteLen); err != nil {
+		return fb, err
+	}
+	if fb.RedFlags, err = cleanText("red_flags", fb.RedFlags, maxNoteLen); err != nil {
+		return fb, err
+	}
+
+	questions := make([]string, 0, len(fb.QuestionsAsked))
+	for _, q := range fb.QuestionsAsked {
+		q, err := cleanText("questions_asked", q, maxInterviewQuestionLen)
+		if err != nil {
+			return fb, err
+		}
+		if q != "" {
+			questions = append(questions, q)
+		}
+	}
+	if len(questions) > maxInterviewQuestions {
+		return fb, &ValidationError{
+			Field: "questions_asked",
+			Msg:   fmt.Sprintf("at most %d questions", maxInterviewQuestions),
+		}
+	}
+	fb.QuestionsAsked = questions
+	return fb, nil
+}
+
+func validateInterviewOutcome(outcome string) error {
+	switch outcome {
+	case OutcomePending, OutcomePassed, OutcomeFailed, OutcomeCancelled:
+		return nil
+	}
+	return &ValidationError{Field: "outcome", Msg: fmt.Sprintf("unknown interview outcome %q", outcome)}
+}
@@ -0,0 +1,156 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ManualJob holds the details of a job found outside JobMate.
+type ManualJob struct {
+	Title       string
+	Company     string
+	URL         string
+	Location    string
+	Description string
+}
+
+// Field limits mirror the job_feed column sizes.
+const (
+	maxManualTitleLen       = 512
+	maxManualCompanyLen     = 255
+	maxManualURLLen         = 2048
+	maxManualLocationLen    = 255
+	maxManualDescriptionLen = 20000
+)
+
+// CreateManualApplication creates an application at TO_APPLY for a job the
+// user found elsewhere. The job details are stored as a user-scoped, already
+// approved job_feed row (is_manual = TRUE, same raw_data keys as the Discovery
+// service's AddJobManually), so the card behaves exactly like one created
+// from the feed — including the CMD_ANALYZE_JOB published afterwards.
+// Duplicates are detected as in CreateApplication.
+func (s *Service) CreateManualApplication(ctx context.Context, userID string, job ManualJob, confirmDuplicate bool) (*Application, error) {
+	return s.createManualApplication(ctx, userID, job, confirmDuplicate, StatusToApply, nil, nil)
+}
+
+// createManualApplication is CreateManualApplication creating the card at
+// status with history as its history log and, unless nil, setup applied.
+func (s *Service) createManualApplication(ctx context.Context, userID string, job ManualJob, confirmDuplicate bool, status Status, history []HistoryEntry, setup *CardSetup) (*Application, error) {
+	job, err := normalizeManualJob(job)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = []HistoryEntry{}
+	}
+	historyJSON, _ := json.Marshal(history)
+
+	rawData, _ := json.Marshal(map[string]string{
+		"title":        job.Title,
+		"company":      job.Company,
+		"company_name": job.Company,
+		"location":     job.Location,
+		"description":  job.Description,
+		"url":          job.URL,
+	})
+
+	var created *Application
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		if !confirmDuplicate {
+			if err := s.checkDuplicate(ctx, s.pgTx(tx), userID, job.Company, job.Title); err != nil {
+				return err
+			}
+		}
+
+		var jobFeedID string
+		err := tx.QueryRow(ctx,
+			`INSERT INTO job_feed
+			   (user_id, source_url, status, raw_data, is_manual, title, description, company_name)
+			 VALUES ($1, NULLIF($2, ''), 'APPROVED', $3, TRUE, $4, NULLIF($5, ''), NULLIF($6, ''))
+			 RETURNING id::text`,
+			userID, job.URL, string(rawData), job.Title, job.Description, job.Company,
+		).Scan(&jobFeedID)
+		if err != nil {
+			return fmt.Errorf("createManualApplication job_feed: %w", err)
+		}
+
+		var app Application
+		err = tx.QueryRow(ctx,
+			`WITH ins AS (
+			   INSERT INTO applications (user_id, job_feed_id, current_status, history_log)
+			   VALUES ($1, $2, $3, $4::jsonb)
+			   RETURNING *
+			 )
+			 SELECT `+appColumns("ins")+`
+			 FROM ins
+			 LEFT JOIN job_feed jf ON jf.id = ins.job_feed_id`,
+			userID, jobFeedID, string(status), string(historyJSON),
+		).Scan(s.appScanDest(&app)...)
+		if err != nil {
+			return fmt.Errorf("createManualApplication: %w", err)
+		}
+		if setup != nil {
+			if err := s.applyCardSetup(ctx, tx, userID, &app, *setup); err != nil {
+				return fmt.Errorf("createManualApplication: %w", err)
+			}
+		}
+		if err := enqueueAnalyzeJob(ctx, s.pgTx(tx), userID, app.ID, jobFeedID); err != nil {
+			return fmt.Errorf("createManualApplication: %w", err)
+		}
+		if err := enqueueApplicationCreated(ctx, s.pgTx(tx), userID, &app); err != nil {
+			return fmt.Errorf("createManualApplication: %w", err)
+		}
+		created = &app
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// normalizeManualJob trims and validates manual job details.
+// At least a title or a company is required; the other one defaults to it.
+func normalizeManualJob(job ManualJob) (ManualJob, error) {
+	job.Title = strings.TrimSpace(job.Title)
+	job.Company = strings.TrimSpace(job.Company)
+	job.URL = strings.TrimSpace(job.URL)
+	job.Location = strings.TrimSpace(job.Location)
+	job.Description = strings.TrimSpace(job.Description)
+
+	if job.Title == "" && job.Company == "" {
+		return job, &ValidationError{Msg: "title or company is required"}
+	}
+	if job.Title == "" {
+		job.Title = job.Company
+	}
+
+	for _, f := range []struct {
+		name, value string
+		max         int
+	}{
+		{"title", job.Title, maxManualTitleLen},
+		{"company", job.Company, maxManualCompanyLen},
+		{"url", job.URL, maxManualURLLen},
+		{"location", job.Location, maxManualLocationLen},
+		{"description", job.Description, maxManualDescriptionLen},
+	} {
+		if utf8.RuneCountInString(f.value) > f.max {
+			return job, &ValidationError{Msg: fmt.Sprintf("%s must be at most %d characters", f.name, f.max)}
+		}
+	}
+
+	if job.URL != "" {
+		u, err := url.Parse(job.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return job, &ValidationError{Msg: "url must be an absolute http(s) URL"}
+		}
+	}
+	return job, nil
+}
@@ -0,0 +1,223 @@
+package kanban_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+// memRepo is an in-memory kanban.ApplicationRepo. Transactions run one at a
+// time on a copy of the state, kept only if they succeed.
+type memRepo struct {
+	mu    sync.Mutex
+	state memState
+}
+
+type memState struct {
+	jobs        map[string]memJob
+	apps        map[string]memApp
+	searches    map[string]kanban.SearchArchival
+	transitions map[string][]kanban.Transition
+	events      []memEvent
+}
+
+type memJob struct{ company, title, searchID string }
+
+type memApp struct {
+	id, userID, jobFeedID string
+	status, holdOrigin    kanban.Status
+	archived              bool
+	history               []kanban.HistoryEntry
+}
+
+type memEvent struct {
+	Stream  string
+	Payload map[string]any
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{state: memState{
+		jobs:        map[string]memJob{},
+		apps:        map[string]memApp{},
+		searches:    map[string]kanban.SearchArchival{},
+		transitions: map[string][]kanban.Transition{},
+	}}
+}
+
+// addJob adds a job_feed entry found by searchID ("" = none).
+func (r *memRepo) addJob(id, company, title, searchID string) {
+	r.state.jobs[id] = memJob{company: company, title: title, searchID: searchID}
+}
+
+// addSearch adds an active search.
+func (r *memRepo) addSearch(id string, archive bool) {
+	r.state.searches[id] = kanban.SearchArchival{ConfigID: id, Active: true, Archive: archive}
+}
+
+// addApp adds a card of userID at status.
+func (r *memRepo) addApp(id, userID, jobFeedID string, status kanban.Status) {
+	r.state.apps[id] = memApp{id: id, userID: userID, jobFeedID: jobFeedID, status: status}
+}
+
+// streams returns the streams of the committed events, in order.
+func (r *memRepo) streams() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var got []string
+	for _, ev := range r.state.events {
+		got = append(got, ev.Stream)
+	}
+	return got
+}
+
+func (r *memRepo) app(id string) memApp {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state.apps[id]
+}
+
+func (r *memRepo) search(id string) kanban.SearchArchival {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state.searches[id]
+}
+
+func (r *memRepo) InTx(ctx context.Context, fn func(tx kanban.ApplicationTx) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tx := &memTx{state: memState{
+		jobs:        r.state.jobs,
+		apps:        maps.Clone(r.state.apps),
+		searches:    maps.Clone(r.state.searches),
+		transitions: r.state.transitions,
+		events:      append([]memEvent(nil), r.state.events...),
+	}}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	r.state = tx.state
+	return nil
+}
+
+type memTx struct{ state memState }
+
+func (t *memTx) JobIdentity(_ context.Context, jobFeedID string) (string, string, error) {
+	job, ok := t.state.jobs[jobFeedID]
+	if !ok {
+		return "", "", kanban.ErrJobNotFound
+	}
+	return job.company, job.title, nil
+}
+
+func (t *memTx) Duplicates(_ context.Context, userID, companyKey, titleKey string, _ int) ([]string, error) {
+	key := func(s string) string { return strings.ToLower(strings.Join(strings.Fields(s), " ")) }
+	var ids []string
+	for _, a := range t.state.apps {
+		job := t.state.jobs[a.jobFeedID]
+		if a.userID != userID || key(job.company) != companyKey || key(job.title) != titleKey {
+			continue
+		}
+		switch a.status {
+		case kanban.StatusHired, kanban.StatusWithdrawn:
+			continue
+		case kanban.StatusRejected: // recently, as far as the fake knows
+		default:
+			if a.archived {
+				continue
+			}
+		}
+		ids = append(ids, a.id)
+	}
+	return ids, nil
+}
+
+func (t *memTx) InsertApplication(_ context.Context, userID, jobFeedID string) (*kanban.Application, bool, error) {
+	for _, a := range t.state.apps {
+		if a.userID == userID && a.jobFeedID == jobFeedID {
+			return t.application(a), false, nil
+		}
+	}
+	a := memApp{
+		id:        fmt.Sprintf("app-%d", len(t.state.apps)+1),
+		userID:    userID,
+		jobFeedID: jobFeedID,
+		status:    kanban.StatusToApply,
+	}
+	t.state.apps[a.id] = a
+	return t.application(a), true, nil
+}
+
+func (t *memTx) LockCard(_ context.Context, userID, appID string) (kanban.CardState, error) {
+	a, ok := t.state.apps[appID]
+	if !ok || a.userID != userID {
+		return kanban.CardState{}, kanban.ErrNotFound
+	}
+	return kanban.CardState{Status: a.status, Archived: a.archived, HoldOrigin: a.holdOrigin}, nil
+}
+
+func (t *memTx) UserTransitions(_ context.Context, userID string) ([]kanban.Transition, error) {
+	return t.state.transitions[userID], nil
+}
+
+func (t *memTx) SearchOnHired(_ context.Context, appID string) (*kanban.SearchArchival, error) {
+	job := t.state.jobs[t.state.apps[appID].jobFeedID]
+	search, ok := t.state.searches[job.searchID]
+	if !ok {
+		return nil, nil
+	}
+	return &search, nil
+}
+
+func (t *memTx) DeactivateSearch(_ context.Context, configID string) error {
+	search := t.state.searches[configID]
+	search.Active = false
+	t.state.searches[configID] = search
+	return nil
+}
+
+func (t *memTx) WriteMove(_ context.Context, userID, appID string, to, holdOrigin kanban.Status, entries []kanban.HistoryEntry) (*kanban.Application, error) {
+	a, ok := t.state.apps[appID]
+	if !ok || a.userID != userID {
+		return nil, kanban.ErrNotFound
+	}
+	a.status, a.holdOrigin = to, holdOrigin
+	a.history = append(append([]kanban.HistoryEntry(nil), a.history...), entries...)
+	t.state.apps[appID] = a
+	return t.application(a), nil
+}
+
+func (t *memTx) Enqueue(_ context.Context, stream string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	var ev memEvent
+	ev.Stream = stream
+	if err := json.Unmarshal(data, &ev.Payload); err != nil {
+		return err
+	}
+	t.state.events = append(t.state.events, ev)
+	return nil
+}
+
+func (t *memTx) application(a memApp) *kanban.Application {
+	history, _ := json.Marshal(a.history)
+	job := t.state.jobs[a.jobFeedID]
+	return &kanban.Application{
+		ID:             a.id,
+		CurrentStatus:  string(a.status),
+		HoldOrigin:     string(a.holdOrigin),
+		HistoryLog:     history,
+		JobFeedID:      a.jobFeedID,
+		SearchConfigID: job.searchID,
+		JobTitle:       job.title,
+		Company:        job.company,
+		CreatedAt:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
@@ -0,0 +1,142 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// MergeApplications folds the application mergedID into appID — typically
+// the same job found on two boards — and deletes mergedID.
+//
+// Notes, attachments, interviews, contact links and cover letter versions
+// move over to appID, as do the negotiation log and the offer unless appID
+// has its own. appID keeps its own rating, reminder and next step,
+// and takes mergedID's only where it has none. mergedID's history is carried
+// over (marked MergedFrom) and a MERGE entry is appended; carried-over moves
+// are never undone.
+func (s *Service) MergeApplications(ctx context.Context, userID, appID, mergedID string) (*Application, error) {
+	if appID == mergedID {
+		return nil, &ValidationError{Field: "merged_application_id", Msg: "cannot merge an application into itself"}
+	}
+
+	var app *Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		histories := make(map[string][]HistoryEntry, 2)
+		rows, err := tx.Query(ctx,
+			`SELECT id::text, history_log FROM applications
+			 WHERE id IN ($1, $2) AND user_id = $3
+			 ORDER BY id
+			 FOR UPDATE`,
+			appID, mergedID, userID)
+		if err != nil {
+			return ErrNotFound
+		}
+		for rows.Next() {
+			var (
+				id  string
+				raw []byte
+				h   []HistoryEntry
+			)
+			if err := rows.Scan(&id, &raw); err != nil {
+				rows.Close()
+				return fmt.Errorf("mergeApplications scan: %w", err)
+			}
+			if err := json.Unmarshal(raw, &h); err != nil {
+				rows.Close()
+				return fmt.Errorf("mergeApplications history: %w", err)
+			}
+			histories[id] = h
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("mergeApplications: %w", err)
+		}
+		if len(histories) != 2 {
+			return ErrNotFound
+		}
+
+		history := mergeHistories(histories[appID], histories[mergedID], mergedID,
+			time.Now().UTC().Truncate(time.Second))
+		rawHistory, _ := json.Marshal(history)
+
+		for _, stmt := range []string{
+			`UPDATE application_notes SET application_id = $1 WHERE application_id = $2`,
+			`UPDATE attachments SET application_id = $1 WHERE application_id = $2`,
+			`UPDATE interviews SET application_id = $1 WHERE application_id = $2`,
+			`UPDATE offer_negotiations SET application_id = $1 WHERE application_id = $2`,
+			`UPDATE offers SET application_id = $1
+			 WHERE application_id = $2 AND NOT EXISTS (SELECT 1 FROM offers WHERE application_id = $1)`,
+			`INSERT INTO application_contacts (application_id, contact_id, created_at)
+			 SELECT $1, contact_id, created_at FROM application_contacts WHERE application_id = $2
+			 ON CONFLICT DO NOTHING`,
+			// Versions are renumbered after the kept card's own.
+			`UPDATE cover_letter_versions
+			 SET application_id = $1,
+			     version = version + (SELECT COALESCE(MAX(version), 0) FROM cover_letter_versions
+			                          WHERE application_id = $1)
+			 WHERE application_id = $2`,
+			`UPDATE applications k
+			 SET user_rating         = COALESCE(k.user_rating, m.user_rating),
+			     rating_criteria     = CASE WHEN k.user_rating IS NULL THEN m.rating_criteria ELSE k.rating_criteria END,
+			     rating_review       = CASE WHEN k.user_rating IS NULL THEN m.rating_review ELSE k.rating_review END,
+			     relance_reminder_at = COALESCE(k.relance_reminder_at, m.relance_reminder_at),
+			     next_step_due_at    = CASE WHEN k.next_step_due_at IS NULL AND k.next_step_label IS NULL
+			                                THEN m.next_step_due_at ELSE k.next_step_due_at END,
+			     next_step_label     = CASE WHEN k.next_step_due_at IS NULL AND k.next_step_label IS NULL
+			                                THEN m.next_step_label ELSE k.next_step_label END
+			 FROM applications m
+			 WHERE k.id = $1 AND m.id = $2`,
+			`DELETE FROM applications WHERE id = $2`,
+		} {
+			if _, err := tx.Exec(ctx, stmt, appID, mergedID); err != nil {
+				return fmt.Errorf("mergeApplications: %w", err)
+			}
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE applications SET history_log = $2::jsonb WHERE id = $1`, appID, string(rawHistory),
+		); err != nil {
+			return fmt.Errorf("mergeApplications history: %w", err)
+		}
+
+		// Also bumps updated_at and re-reads the card.
+		if app, err = s.refreshLatestNote(ctx, tx, appID); err != nil {
+			return fmt.Errorf("mergeApplications: %w", err)
+		}
+		if err := enqueueApplicationUpdated(ctx, tx, userID, app,
+			"user_notes", "user_rating", "rating_criteria", "rating_review", "relance_reminder_at", "next_step_due_at", "next_step_label",
+			"history_log", "attachments", "interviews", "offer", "negotiation", "contacts", "cover_letter_versions",
+		); err != nil {
+			return fmt.Errorf("mergeApplications: %w", err)
+		}
+		return enqueueEvent(ctx, tx, "EVENT_APPLICATION_MERGED", map[string]string{
+			"type":                "EVENT_APPLICATION_MERGED",
+			"applicationId":       appID,
+			"mergedApplicationId": mergedID,
+			"userId":              userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// mergeHistories interleaves merged's entries into kept's by time, marking
+// them as carried over from mergedID, and appends the MERGE entry.
+func mergeHistories(kept, merged []HistoryEntry, mergedID string, now time.Time) []HistoryEntry {
+	out := make([]HistoryEntry, 0, len(kept)+len(merged)+1)
+	out = append(out, kept...)
+	for _, h := range merged {
+		if h.MergedFrom == "" {
+			h.MergedFrom = mergedID
+		}
+		out = append(out, h)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].At.Before(out[j].At) })
+	return append(out, HistoryEntry{Kind: HistoryMerge, At: now, MergedFrom: mergedID})
+}
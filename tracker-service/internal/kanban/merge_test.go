@@ -0,0 +1,42 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestMergeHistories(t *testing.T) {
+	kept := []kanban.HistoryEntry{
+		entry(kanban.StatusToApply, kanban.StatusApplied, 3*time.Hour),
+		entry(kanban.StatusApplied, kanban.StatusInterview, time.Hour),
+	}
+	merged := []kanban.HistoryEntry{
+		entry(kanban.StatusToApply, kanban.StatusApplied, 2*time.Hour),
+	}
+
+	got := kanban.MergeHistories(kept, merged, "m1", undoNow)
+	if len(got) != 4 {
+		t.Fatalf("MergeHistories returned %d entries, want 4", len(got))
+	}
+	if got[1].MergedFrom != "m1" || got[1].IsMove() {
+		t.Errorf("carried-over entry = %+v, want MergedFrom m1 and not a move", got[1])
+	}
+	if got[0].MergedFrom != "" || got[2].MergedFrom != "" {
+		t.Errorf("kept entries should not be marked: %+v, %+v", got[0], got[2])
+	}
+	last := got[3]
+	if last.Kind != kanban.HistoryMerge || last.MergedFrom != "m1" || !last.At.Equal(undoNow) {
+		t.Errorf("last entry = %+v, want MERGE from m1", last)
+	}
+
+	// Undo still targets the kept card's own last move.
+	plan, err := kanban.PlanUndo(got, undoNow.Add(-time.Hour+time.Minute), 15*time.Minute)
+	if err != nil {
+		t.Fatalf("PlanUndo unexpected error: %v", err)
+	}
+	if plan.From != kanban.StatusInterview || plan.To != kanban.StatusApplied {
+		t.Errorf("PlanUndo = %s → %s, want INTERVIEW → APPLIED", plan.From, plan.To)
+	}
+}
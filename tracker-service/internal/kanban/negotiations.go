@@ -0,0 +1,176 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// NegotiationEntry is one step of an offer negotiation: a counter-offer
+// made by the user, a revised offer from the company, or a plain note.
+// Amounts are the proposed yearly figures in the offer's currency; 0 = not
+// part of this step.
+type NegotiationEntry struct {
+	ID            string    `json:"id"`
+	ApplicationID string    `json:"applicationId"`
+	Kind          string    `json:"kind"`
+	BaseSalary    int64     `json:"baseSalary"`
+	Bonus         int64     `json:"bonus"`
+	EquityValue   int64     `json:"equityValue"`
+	Note          string    `json:"note"`
+	OccurredAt    time.Time `json:"occurredAt"` // defaults to now
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// Negotiation entry kinds.
+const (
+	NegotiationCounterOffer = "COUNTER_OFFER"
+	NegotiationRevisedOffer = "REVISED_OFFER"
+	NegotiationNote         = "NOTE"
+)
+
+const maxNegotiationEntries = 200
+
+// ErrNegotiationEntryNotFound is returned when a negotiation entry is missing
+// or owned by someone else.
+var ErrNegotiationEntryNotFound = fmt.Errorf("negotiation entry not found")
+
+// As for offers, the amounts are sealed together in compensation and the
+// note is sealed too.
+const negotiationColumns = `id::text, application_id::text, kind, compensation, note, occurred_at, created_at`
+
+func (s *Service) scanNegotiationEntry(row pgx.Row) (*NegotiationEntry, error) {
+	var e NegotiationEntry
+	if err := row.Scan(&e.ID, &e.ApplicationID, &e.Kind, s.sealedAmounts(&e.BaseSalary, &e.Bonus, &e.EquityValue),
+		s.sealed(&e.Note), &e.OccurredAt, &e.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// AddNegotiationEntry logs a negotiation step on an application at OFFER
+// (or HIRED). It does not change the recorded offer: once terms are agreed,
+// SetOfferDetails is called with them.
+func (s *Service) AddNegotiationEntry(ctx context.Context, userID, appID string, e NegotiationEntry) (*NegotiationEntry, error) {
+	e, err := normalizeNegotiationEntry(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *NegotiationEntry
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lockOfferStage(ctx, tx, userID, appID, "negotiation steps"); err != nil {
+			return err
+		}
+
+		var err error
+		entry, err = s.scanNegotiationEntry(tx.QueryRow(ctx,
+			`INSERT INTO offer_negotiations (user_id, application_id, kind, compensation, note, occurred_at)
+			 VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), COALESCE($6, NOW()))
+			 RETURNING `+negotiationColumns,
+			userID, appID, e.Kind, s.sealCompensation(e.BaseSalary, e.Bonus, e.EquityValue), s.seal(e.Note),
+			nullableTime(e.OccurredAt),
+		))
+		if err != nil {
+			return fmt.Errorf("addNegotiationEntry: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"negotiation"})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ListNegotiationEntries returns an application's negotiation log, oldest first.
+func (s *Service) ListNegotiationEntries(ctx context.Context, userID, appID string) ([]NegotiationEntry, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM applications WHERE id = $1 AND user_id = $2)`,
+		appID, userID,
+	).Scan(&exists)
+	if err != nil || !exists {
+		return nil, ErrNotFound
+	}
+	byApp, err := s.listNegotiations(ctx, s.pool, userID, []string{appID})
+	if err != nil {
+		return nil, err
+	}
+	if byApp[appID] == nil {
+		return []NegotiationEntry{}, nil
+	}
+	return byApp[appID], nil
+}
+
+// DeleteNegotiationEntry removes a negotiation step logged by mistake.
+func (s *Service) DeleteNegotiationEntry(ctx context.Context, userID, entryID string) error {
+	return s.inTx(ctx, func(tx pgx.Tx) error {
+		var appID string
+		err := tx.QueryRow(ctx,
+			`DELETE FROM offer_negotiations WHERE id = $1 AND user_id = $2 RETURNING application_id::text`,
+			entryID, userID,
+		).Scan(&appID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNegotiationEntryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("deleteNegotiationEntry: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"negotiation"})
+	})
+}
+
+// listNegotiations returns the negotiation logs of appIDs, oldest first,
+// keyed by application.
+func (s *Service) listNegotiations(ctx context.Context, q querier, userID string, appIDs []string) (map[string][]NegotiationEntry, error) {
+	rows, err := q.Query(ctx,
+		`SELECT `+negotiationColumns+` FROM (
+		   SELECT *, row_number() OVER (PARTITION BY application_id ORDER BY occurred_at DESC, created_at DESC) AS n
+		   FROM offer_negotiations
+		   WHERE user_id = $1 AND application_id::text = ANY($2)
+		 ) o
+		 WHERE n <= $3
+		 ORDER BY occurred_at, created_at`,
+		userID, appIDs, maxNegotiationEntries)
+	if err != nil {
+		return nil, fmt.Errorf("listNegotiations query: %w", err)
+	}
+	defer rows.Close()
+
+	byApp := make(map[string][]NegotiationEntry, len(appIDs))
+	for rows.Next() {
+		e, err := s.scanNegotiationEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listNegotiations scan: %w", err)
+		}
+		byApp[e.ApplicationID] = append(byApp[e.ApplicationID], *e)
+	}
+	return byApp, rows.Err()
+}
+
+// normalizeNegotiationEntry validates a negotiation step's user-supplied
+// fields. Kind defaults to NOTE.
+func normalizeNegotiationEntry(e NegotiationEntry) (NegotiationEntry, error) {
+	switch e.Kind {
+	case "":
+		e.Kind = NegotiationNote
+	case NegotiationCounterOffer, NegotiationRevisedOffer, NegotiationNote:
+	default:
+		return e, &ValidationError{Field: "kind", Msg: fmt.Sprintf("unknown negotiation kind %q", e.Kind)}
+	}
+	if err := validateOfferAmounts(e.BaseSalary, e.Bonus, e.EquityValue); err != nil {
+		return e, err
+	}
+
+	var err error
+	if e.Note, err = cleanText("note", e.Note, maxNoteLen); err != nil {
+		return e, err
+	}
+	if e.Kind == NegotiationNote && e.Note == "" {
+		return e, &ValidationError{Field: "note", Msg: "note must not be empty"}
+	}
+	return e, nil
+}
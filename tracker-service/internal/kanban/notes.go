@@ -0,0 +1,218 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Note is one timestamped entry of an application's append-only notes
+// (interview feedback, call summary, …). applications.user_notes mirrors the
+// most recent one so board cards can show it without a join.
+type Note struct {
+	ID            string     `json:"id"`
+	ApplicationID string     `json:"applicationId"`
+	Text          string     `json:"text"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	EditedAt      *time.Time `json:"editedAt"` // nil = never edited
+}
+
+// ErrNoteNotFound is returned when a note is missing or owned by someone else.
+var ErrNoteNotFound = fmt.Errorf("note not found")
+
+const noteSelect = `SELECT id::text, application_id::text, body, created_at, edited_at FROM application_notes`
+
+func (s *Service) scanNote(row pgx.Row) (*Note, error) {
+	var n Note
+	if err := row.Scan(&n.ID, &n.ApplicationID, s.sealed(&n.Text), &n.CreatedAt, &n.EditedAt); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// AddNote appends a note to an application and returns the updated
+// application (its user_notes now holds this note).
+func (s *Service) AddNote(ctx context.Context, userID, appID, text string) (*Application, error) {
+	text, err := cleanNote(text)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("addNote begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO application_notes (application_id, user_id, body)
+		 SELECT id, user_id, $3 FROM applications WHERE id = $1 AND user_id = $2`,
+		appID, userID, s.seal(text),
+	)
+	if err != nil || tag.RowsAffected() == 0 {
+		return nil, ErrNotFound
+	}
+	app, err := s.refreshLatestNote(ctx, tx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("addNote: %w", err)
+	}
+	if err := enqueueApplicationUpdated(ctx, tx, userID, app, "user_notes"); err != nil {
+		return nil, fmt.Errorf("addNote: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("addNote commit: %w", err)
+	}
+	return app, nil
+}
+
+// ListNotes returns an application's notes, oldest first.
+func (s *Service) ListNotes(ctx context.Context, userID, appID string) ([]Note, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM applications WHERE id = $1 AND user_id = $2)`,
+		appID, userID,
+	).Scan(&exists)
+	if err != nil || !exists {
+		return nil, ErrNotFound
+	}
+	return s.listNotes(ctx, userID, appID)
+}
+
+// listNotes is ListNotes once the application is known to be the user's.
+func (s *Service) listNotes(ctx context.Context, userID, appID string) ([]Note, error) {
+	rows, err := s.pool.Query(ctx,
+		noteSelect+` WHERE application_id = $1 AND user_id = $2 ORDER BY created_at, id`,
+		appID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listNotes query: %w", err)
+	}
+	defer rows.Close()
+
+	notes := make([]Note, 0)
+	for rows.Next() {
+		n, err := s.scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listNotes scan: %w", err)
+		}
+		notes = append(notes, *n)
+	}
+	return notes, rows.Err()
+}
+
+// EditNote replaces the text of a note and stamps edited_at.
+func (s *Service) EditNote(ctx context.Context, userID, noteID, text string) (*Note, error) {
+	text, err := cleanNote(text)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("editNote begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	if err := recordLastAction(ctx, tx, ActionNoteEdit, noteID, userID); err != nil {
+		return nil, fmt.Errorf("editNote: %w", err)
+	}
+	n, err := s.scanNote(tx.QueryRow(ctx,
+		`UPDATE application_notes SET body = $1, edited_at = NOW()
+		 WHERE id = $2 AND user_id = $3
+		 RETURNING id::text, application_id::text, body, created_at, edited_at`,
+		s.seal(text), noteID, userID,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("editNote: %w", err)
+	}
+	app, err := s.refreshLatestNote(ctx, tx, n.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("editNote: %w", err)
+	}
+	if err := enqueueApplicationUpdated(ctx, tx, userID, app, "user_notes"); err != nil {
+		return nil, fmt.Errorf("editNote: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("editNote commit: %w", err)
+	}
+	return n, nil
+}
+
+// DeleteNote removes a note. user_notes falls back to the previous note.
+func (s *Service) DeleteNote(ctx context.Context, userID, noteID string) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("deleteNote begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	var appID string
+	err = tx.QueryRow(ctx,
+		`DELETE FROM application_notes WHERE id = $1 AND user_id = $2
+		 RETURNING application_id::text`,
+		noteID, userID,
+	).Scan(&appID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNoteNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("deleteNote: %w", err)
+	}
+	app, err := s.refreshLatestNote(ctx, tx, appID)
+	if err != nil {
+		return fmt.Errorf("deleteNote: %w", err)
+	}
+	if err := enqueueApplicationUpdated(ctx, tx, userID, app, "user_notes"); err != nil {
+		return fmt.Errorf("deleteNote: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("deleteNote commit: %w", err)
+	}
+	return nil
+}
+
+// cleanNote applies cleanText to a note and rejects empty ones.
+func cleanNote(text string) (string, error) {
+	text, err := cleanText("note", text, maxNoteLen)
+	if err != nil {
+		return "", err
+	}
+	if text == "" {
+		return "", &ValidationError{Field: "note", Msg: "note must not be empty"}
+	}
+	return text, nil
+}
+
+// refreshLatestNote copies the application's most recent note into
+// applications.user_notes — sealed, as it is — clears its ghosted flag and
+// returns the updated application.
+func (s *Service) refreshLatestNote(ctx context.Context, q querier, appID string) (*Application, error) {
+	var app Application
+	err := q.QueryRow(ctx,
+		`WITH upd AS (
+		   UPDATE applications
+		   SET user_notes = (SELECT body FROM application_notes
+		                     WHERE application_id = applications.id
+		                     ORDER BY created_at DESC, id DESC LIMIT 1),
+		       updated_at = NOW(),
+		       ghosted_at = NULL
+		   WHERE id = $1
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		appID,
+	).Scan(s.appScanDest(&app)...)
+	if err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
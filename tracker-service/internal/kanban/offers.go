@@ -0,0 +1,289 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Offer holds the terms of a job offer. Amounts are yearly and gross, in
+// Currency; 0 = not given.
+type Offer struct {
+	ApplicationID    string     `json:"applicationId"`
+	Currency         string     `json:"currency"` // ISO 4217, e.g. "EUR"
+	BaseSalary       int64      `json:"baseSalary"`
+	Bonus            int64      `json:"bonus"`
+	EquityValue      int64      `json:"equityValue"` // estimated value per year
+	EquityDetails    string     `json:"equityDetails"`
+	StartDate        *time.Time `json:"startDate"` // date only, UTC midnight
+	ResponseDeadline *time.Time `json:"responseDeadline"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+const (
+	defaultOfferCurrency = "EUR"
+	maxOfferAmount       = 1_000_000_000
+	maxEquityDetailsLen  = 500
+)
+
+// The amounts are stored together, sealed, in the compensation column; the
+// equity details are sealed too (see sealedColumns).
+const offerColumns = `application_id::text, currency, compensation, equity_details,
+	start_date, response_deadline, updated_at`
+
+func (s *Service) scanOffer(row pgx.Row) (*Offer, error) {
+	var o Offer
+	if err := row.Scan(&o.ApplicationID, &o.Currency, s.sealedAmounts(&o.BaseSalary, &o.Bonus, &o.EquityValue),
+		s.sealed(&o.EquityDetails), &o.StartDate, &o.ResponseDeadline, &o.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// SetOfferDetails records (or replaces) the terms of an application's offer.
+// Only applications at OFFER — or HIRED after it — can have one.
+func (s *Service) SetOfferDetails(ctx context.Context, userID, appID string, o Offer) (*Offer, error) {
+	o, err := normalizeOffer(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var offer *Offer
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lockOfferStage(ctx, tx, userID, appID, "offer details"); err != nil {
+			return err
+		}
+
+		var err error
+		offer, err = s.scanOffer(tx.QueryRow(ctx,
+			`INSERT INTO offers (application_id, user_id, currency, compensation,
+			                     equity_details, start_date, response_deadline)
+			 VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7)
+			 ON CONFLICT (application_id) DO UPDATE
+			 SET currency = EXCLUDED.currency, compensation = EXCLUDED.compensation,
+			     equity_details = EXCLUDED.equity_details, start_date = EXCLUDED.start_date,
+			     response_deadline = EXCLUDED.response_deadline, updated_at = NOW()
+			 RETURNING `+offerColumns,
+			appID, userID, o.Currency, s.sealCompensation(o.BaseSalary, o.Bonus, o.EquityValue),
+			s.seal(o.EquityDetails), o.StartDate, o.ResponseDeadline,
+		))
+		if err != nil {
+			return fmt.Errorf("setOfferDetails: %w", err)
+		}
+		return touchApplication(ctx, tx, userID, appID, []string{"offer"})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// lockOfferStage locks an application that must be at OFFER, or HIRED
+// after it, for what is being recorded.
+func lockOfferStage(ctx context.Context, tx pgx.Tx, userID, appID, what string) error {
+	var status string
+	err := tx.QueryRow(ctx,
+		`SELECT current_status::text FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		appID, userID,
+	).Scan(&status)
+	if err != nil {
+		return ErrNotFound
+	}
+	if st := Status(status); st != StatusOffer && st != StatusHired {
+		return &ValidationError{Msg: what + " can only be recorded once the application reaches OFFER"}
+	}
+	return nil
+}
+
+// loadOffer returns an application's offer, or nil if it has none.
+func (s *Service) loadOffer(ctx context.Context, q querier, userID, appID string) (*Offer, error) {
+	o, err := s.scanOffer(q.QueryRow(ctx,
+		`SELECT `+offerColumns+` FROM offers WHERE application_id = $1 AND user_id = $2`,
+		appID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loadOffer: %w", err)
+	}
+	return o, nil
+}
+
+// normalizeOffer validates an offer's user-supplied fields, upper-cases the
+// currency (EUR when empty) and truncates the start date to a day.
+func normalizeOffer(o Offer) (Offer, error) {
+	o.Currency = strings.ToUpper(strings.TrimSpace(o.Currency))
+	if o.Currency == "" {
+		o.Currency = defaultOfferCurrency
+	}
+	if len(o.Currency) != 3 || strings.Trim(o.Currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return o, &ValidationError{Field: "currency", Msg: "currency must be a 3-letter ISO 4217 code"}
+	}
+
+	if err := validateOfferAmounts(o.BaseSalary, o.Bonus, o.EquityValue); err != nil {
+		return o, err
+	}
+
+	var err error
+	if o.EquityDetails, err = cleanText("equity_details", o.EquityDetails, maxEquityDetailsLen); err != nil {
+		return o, err
+	}
+
+	if o.StartDate != nil {
+		if o.StartDate.IsZero() {
+			o.StartDate = nil
+		} else {
+			d := o.StartDate.UTC().Truncate(24 * time.Hour)
+			o.StartDate = &d
+		}
+	}
+	if o.ResponseDeadline != nil && o.ResponseDeadline.IsZero() {
+		o.ResponseDeadline = nil
+	}
+	return o, nil
+}
+
+// validateOfferAmounts bounds the yearly amounts of an offer or a
+// negotiation step.
+func validateOfferAmounts(baseSalary, bonus, equityValue int64) error {
+	for _, f := range []struct {
+		name  string
+		value int64
+	}{{"base_salary", baseSalary}, {"bonus", bonus}, {"equity_value", equityValue}} {
+		if f.value < 0 || f.value > maxOfferAmount {
+			return &ValidationError{Field: f.name, Msg: fmt.Sprintf("%s must be between 0 and %d", f.name, maxOfferAmount)}
+		}
+	}
+	return nil
+}
+
+// OfferComparison is one column of CompareOffers: an application at OFFER
+// with its terms, normalized, and its negotiation log.
+type OfferComparison struct {
+	ApplicationID string `json:"applicationId"`
+	JobTitle      string `json:"jobTitle"`
+	Company       string `json:"company"`
+	Offer         *Offer `json:"offer"` // nil until SetOfferDetails is called
+	// TotalCompensation is base salary + bonus + yearly equity value, in the
+	// offer's currency.
+	TotalCompensation int64 `json:"totalCompensation"`
+	// Rank is 1 for the best total among offers in the same currency; 0 when
+	// the offer has no amounts. RelativeToBest is the total as a fraction of
+	// that best one.
+	Rank           int                `json:"rank"`
+	RelativeToBest float64            `json:"relativeToBest"`
+	Negotiation    []NegotiationEntry `json:"negotiation"` // oldest first
+}
+
+// CompareOffers lays the user's current offers (applications at OFFER, not
+// archived) side by side, best total compensation first. Totals are only
+// ranked against offers in the same currency.
+func (s *Service) CompareOffers(ctx context.Context, userID string) ([]OfferComparison, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT a.id::text, `+jobTitleExpr+`, `+jobCompanyExpr+`,
+		        o.application_id IS NOT NULL, COALESCE(o.currency, ''), o.compensation, o.equity_details,
+		        o.start_date, o.response_deadline, COALESCE(o.updated_at, a.updated_at)
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 LEFT JOIN offers o ON o.application_id = a.id
+		 WHERE a.user_id = $1 AND a.current_status = 'OFFER' AND a.archived_at IS NULL`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("compareOffers query: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		cmp []OfferComparison
+		ids []string
+	)
+	for rows.Next() {
+		var (
+			c        OfferComparison
+			o        Offer
+			hasOffer bool
+		)
+		if err := rows.Scan(&c.ApplicationID, &c.JobTitle, &c.Company,
+			&hasOffer, &o.Currency, s.sealedAmounts(&o.BaseSalary, &o.Bonus, &o.EquityValue), s.sealed(&o.EquityDetails),
+			&o.StartDate, &o.ResponseDeadline, &o.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("compareOffers scan: %w", err)
+		}
+		if hasOffer {
+			o.ApplicationID = c.ApplicationID
+			c.Offer = &o
+		}
+		cmp = append(cmp, c)
+		ids = append(ids, c.ApplicationID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("compareOffers rows: %w", err)
+	}
+
+	negotiations, err := s.listNegotiations(ctx, s.pool, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range cmp {
+		cmp[i].Negotiation = negotiations[cmp[i].ApplicationID]
+		if cmp[i].Negotiation == nil {
+			cmp[i].Negotiation = []NegotiationEntry{}
+		}
+	}
+	return rankOffers(cmp), nil
+}
+
+// rankOffers fills in the totals, ranks and ratios of cmp and sorts it by
+// currency, then best total first; offers without amounts come last.
+func rankOffers(cmp []OfferComparison) []OfferComparison {
+	best := make(map[string]int64)
+	for i := range cmp {
+		o := cmp[i].Offer
+		if o == nil {
+			continue
+		}
+		cmp[i].TotalCompensation = o.BaseSalary + o.Bonus + o.EquityValue
+		if cmp[i].TotalCompensation > best[o.Currency] {
+			best[o.Currency] = cmp[i].TotalCompensation
+		}
+	}
+
+	currency := func(c OfferComparison) string {
+		if c.Offer == nil || c.TotalCompensation == 0 {
+			return ""
+		}
+		return c.Offer.Currency
+	}
+	sort.SliceStable(cmp, func(i, j int) bool {
+		ci, cj := currency(cmp[i]), currency(cmp[j])
+		if (ci == "") != (cj == "") {
+			return cj == ""
+		}
+		if ci != cj {
+			return ci < cj
+		}
+		if cmp[i].TotalCompensation != cmp[j].TotalCompensation {
+			return cmp[i].TotalCompensation > cmp[j].TotalCompensation
+		}
+		return cmp[i].Company < cmp[j].Company
+	})
+
+	rank, prev := 0, ""
+	for i := range cmp {
+		cur := currency(cmp[i])
+		if cur == "" {
+			continue
+		}
+		if cur != prev {
+			rank, prev = 0, cur
+		}
+		rank++
+		cmp[i].Rank = rank
+		cmp[i].RelativeToBest = float64(cmp[i].TotalCompensation) / float64(best[cur])
+	}
+	return cmp
+}
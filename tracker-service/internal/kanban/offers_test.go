@@ -0,0 +1,113 @@
+package kanban_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestNormalizeOffer(t *testing.T) {
+	start := time.Date(2026, 9, 1, 15, 30, 0, 0, time.UTC)
+	got, err := kanban.NormalizeOffer(kanban.Offer{Currency: " usd ", BaseSalary: 90000, StartDate: &start})
+	if err != nil {
+		t.Fatalf("NormalizeOffer unexpected error: %v", err)
+	}
+	if got.Currency != "USD" {
+		t.Errorf("Currency = %q, want USD", got.Currency)
+	}
+	if want := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC); got.StartDate == nil || !got.StartDate.Equal(want) {
+		t.Errorf("StartDate = %v, want %v", got.StartDate, want)
+	}
+
+	got, err = kanban.NormalizeOffer(kanban.Offer{})
+	if err != nil {
+		t.Fatalf("NormalizeOffer unexpected error: %v", err)
+	}
+	if got.Currency != "EUR" {
+		t.Errorf("default Currency = %q, want EUR", got.Currency)
+	}
+}
+
+func TestNormalizeOffer_Rejects(t *testing.T) {
+	cases := map[string]struct {
+		offer kanban.Offer
+		field string
+	}{
+		"bad currency":       {kanban.Offer{Currency: "EURO"}, "currency"},
+		"non-letter code":    {kanban.Offer{Currency: "E1R"}, "currency"},
+		"negative salary":    {kanban.Offer{BaseSalary: -1}, "base_salary"},
+		"huge bonus":         {kanban.Offer{Bonus: 2_000_000_000}, "bonus"},
+		"negative equity":    {kanban.Offer{EquityValue: -5}, "equity_value"},
+		"long equity detail": {kanban.Offer{EquityDetails: strings.Repeat("x", 501)}, "equity_details"},
+	}
+	for name, c := range cases {
+		_, err := kanban.NormalizeOffer(c.offer)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) || ve.Field != c.field {
+			t.Errorf("%s: NormalizeOffer error = %v, want a %s ValidationError", name, err, c.field)
+		}
+	}
+}
+
+func TestRankOffers(t *testing.T) {
+	offer := func(currency string, base, bonus, equity int64) *kanban.Offer {
+		return &kanban.Offer{Currency: currency, BaseSalary: base, Bonus: bonus, EquityValue: equity}
+	}
+	got := kanban.RankOffers([]kanban.OfferComparison{
+		{ApplicationID: "none"},
+		{ApplicationID: "eur-low", Offer: offer("EUR", 50000, 0, 0)},
+		{ApplicationID: "usd", Offer: offer("USD", 120000, 0, 0)},
+		{ApplicationID: "eur-high", Offer: offer("EUR", 60000, 10000, 10000)},
+		{ApplicationID: "empty", Offer: offer("EUR", 0, 0, 0)},
+	})
+
+	want := []struct {
+		id    string
+		total int64
+		rank  int
+		rel   float64
+	}{
+		{"eur-high", 80000, 1, 1},
+		{"eur-low", 50000, 2, 0.625},
+		{"usd", 120000, 1, 1},
+		{"none", 0, 0, 0},
+		{"empty", 0, 0, 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RankOffers returned %d offers, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.ApplicationID != w.id || g.TotalCompensation != w.total || g.Rank != w.rank || g.RelativeToBest != w.rel {
+			t.Errorf("offer %d = {%s %d rank %d rel %v}, want %+v",
+				i, g.ApplicationID, g.TotalCompensation, g.Rank, g.RelativeToBest, w)
+		}
+	}
+}
+
+func TestNormalizeNegotiationEntry(t *testing.T) {
+	got, err := kanban.NormalizeNegotiationEntry(kanban.NegotiationEntry{Note: "  Asked for 65k "})
+	if err != nil || got.Kind != kanban.NegotiationNote || got.Note != "Asked for 65k" {
+		t.Errorf("NormalizeNegotiationEntry = %+v, %v; want a cleaned NOTE", got, err)
+	}
+
+	cases := map[string]struct {
+		entry kanban.NegotiationEntry
+		field string
+	}{
+		"unknown kind":     {kanban.NegotiationEntry{Kind: "ULTIMATUM", Note: "x"}, "kind"},
+		"empty note":       {kanban.NegotiationEntry{Kind: kanban.NegotiationNote}, "note"},
+		"default kind":     {kanban.NegotiationEntry{}, "note"},
+		"negative counter": {kanban.NegotiationEntry{Kind: kanban.NegotiationCounterOffer, BaseSalary: -1}, "base_salary"},
+	}
+	for name, c := range cases {
+		_, err := kanban.NormalizeNegotiationEntry(c.entry)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) || ve.Field != c.field {
+			t.Errorf("%s: NormalizeNegotiationEntry error = %v, want a %s ValidationError", name, err, c.field)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Operator actions, run by support staff with cmd/trackerctl to repair what
+// users cannot: cards stuck in a wrong status, reminders or events lost
+// while a worker or Redis was down. They act on any user's data; callers
+// record them in the audit log.
+
+// ForceMove moves a card to status toStr regardless of the transition policy
+// and of where an ON_HOLD card was paused from, with the side effects of a
+// regular move (search archival on HIRED, EVENT_CARD_MOVED). The history
+// entry carries reason and ActorOperator. Archived cards must be restored
+// first. Returns the card's previous status along with it.
+func (s *Service) ForceMove(ctx context.Context, userID, appID, toStr, reason string) (*Application, Status, error) {
+	to, err := ParseStatus(toStr)
+	if err != nil {
+		return nil, "", &ValidationError{Field: "status", Msg: err.Error()}
+	}
+	reason, err = cleanText("reason", reason, maxMoveReasonLen)
+	if err != nil {
+		return nil, "", err
+	}
+	if reason == "" {
+		return nil, "", &ValidationError{Field: "reason", Msg: "a reason is required to force a move"}
+	}
+
+	var (
+		app  *Application
+		from Status
+	)
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		cur, err := scanCardState(tx.QueryRow(ctx,
+			`SELECT `+cardStateColumns+` FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+			appID, userID,
+		))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("forceMove: %w", err)
+		}
+		switch {
+		case cur.Archived:
+			return &ValidationError{Msg: "application is archived — restore it before moving"}
+		case cur.Status == to:
+			return &ValidationError{Msg: fmt.Sprintf("application is already %s", to)}
+		}
+
+		entry := HistoryEntry{
+			From:   string(cur.Status),
+			To:     string(to),
+			At:     time.Now().UTC().Truncate(time.Second),
+			Reason: reason,
+			Actor:  ActorOperator,
+		}
+		if to == StatusRejected {
+			entry.RejectionStage = string(cur.Status)
+		}
+		var holdOrigin Status
+		if to == StatusOnHold {
+			holdOrigin = cur.Status
+		}
+		if app, err = s.commitMove(ctx, s.pgTx(tx), userID, appID, holdOrigin, entry); err != nil {
+			return fmt.Errorf("forceMove update: %w", err)
+		}
+		from = cur.Status
+		return enqueueCardMoved(ctx, s.pgTx(tx), userID, appID, cur.Status, to, "")
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	s.InvalidateUserCache(ctx, userID)
+	slog.Warn("card moved by an operator", "userId", userID, "applicationId", appID, "from", from, "to", to)
+	return app, from, nil
+}
+
+// RearmFiredReminders re-arms the reminders fired since since — of userID,
+// or of everyone when userID is "" — so the next DispatchDueReminders fires
+// them again, for notifications lost downstream. Reminders on archived cards
+// stay fired. Returns the number of cards re-armed.
+func (s *Service) RearmFiredReminders(ctx context.Context, userID string, since time.Time) (int, error) {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE applications
+		 SET relance_reminder_fired_at = CASE WHEN relance_reminder_fired_at >= $1 THEN NULL
+		                                      ELSE relance_reminder_fired_at END,
+		     relance_rule_fired_at     = CASE WHEN relance_rule_fired_at >= $1 THEN NULL
+		                                      ELSE relance_rule_fired_at END
+		 WHERE (relance_reminder_fired_at >= $1 OR relance_rule_fired_at >= $1)
+		   AND archived_at IS NULL
+		   AND ($2 = '' OR user_id::text = $2)`,
+		since, userID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("rearmFiredReminders: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// DispatchAllDueReminders runs DispatchDueReminders until no reminder is
+// due, for catching up after the dispatcher was down. Returns the number of
+// reminders fired.
+func (s *Service) DispatchAllDueReminders(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := s.DispatchDueReminders(ctx)
+		total += n
+		if err != nil || n == 0 {
+			return total, err
+		}
+	}
+}
+
+// ReemitApplicationEvents queues EVENT_APPLICATION_UPDATED, without changed
+// fields, for the user's applications updated since since (appID "") or for
+// one of them, so consumers that lost events (a Redis flush, a trimmed
+// stream) refresh those cards. Returns the number of events queued.
+func (s *Service) ReemitApplicationEvents(ctx context.Context, userID, appID string, since time.Time) (int, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT id::text, current_status::text, updated_at FROM applications
+		 WHERE user_id = $1
+		   AND CASE WHEN $2 = '' THEN updated_at >= $3 ELSE id::text = $2 END
+		 ORDER BY updated_at`,
+		userID, appID, since,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("reemitApplicationEvents: %w", err)
+	}
+	apps, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Application, error) {
+		var app Application
+		err := row.Scan(&app.ID, &app.CurrentStatus, &app.UpdatedAt)
+		return app, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("reemitApplicationEvents: %w", err)
+	}
+	if appID != "" && len(apps) == 0 {
+		return 0, ErrNotFound
+	}
+
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		for i := range apps {
+			if err := enqueueApplicationUpdated(ctx, tx, userID, &apps[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("reemitApplicationEvents: %w", err)
+	}
+	return len(apps), nil
+}
+
+// ReplayFilter selects the published events ReplayEvents queues again. At
+// least an application, a user or Since is required; Until zero means now,
+// and no Streams means every stream.
+type ReplayFilter struct {
+	UserID        string
+	ApplicationID string
+	Since, Until  time.Time
+	Streams       []string
+}
+
+// ReplayEvents queues again, as they were first published and in the same
+// order, the events still kept in the outbox (see PruneOutbox) that match f,
+// so consumers that missed them (a Gateway or AI Coach restart, a trimmed
+// stream) catch up. Replayed copies carry "replayed": true; user webhooks are
+// not delivered again. Returns the number of events queued.
+func (s *Service) ReplayEvents(ctx context.Context, f ReplayFilter) (int, error) {
+	if f.UserID == "" && f.ApplicationID == "" && f.Since.IsZero() {
+		return 0, &ValidationError{Msg: "an application, a user or a start time is required"}
+	}
+	if !f.Until.IsZero() && !f.Until.After(f.Since) {
+		return 0, &ValidationError{Field: "until", Msg: "must be after since"}
+	}
+	var until *time.Time
+	if !f.Until.IsZero() {
+		until = &f.Until
+	}
+	tag, err := s.pool.Exec(ctx,
+		`INSERT INTO outbox_events (stream, payload)
+		 SELECT stream, payload || '{"replayed": true}'::jsonb
+		 FROM outbox_events
+		 WHERE published_at IS NOT NULL
+		   AND ($1 = '' OR payload->>'userId' = $1)
+		   AND ($2 = '' OR payload->>'applicationId' = $2)
+		   AND created_at >= $3
+		   AND ($4::timestamptz IS NULL OR created_at < $4)
+		   AND (COALESCE(cardinality($5::text[]), 0) = 0 OR stream = ANY($5))
+		 ORDER BY id`,
+		f.UserID, f.ApplicationID, f.Since, until, f.Streams,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("replayEvents: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
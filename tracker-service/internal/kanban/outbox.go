@@ -0,0 +1,158 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"jobmate/tracker-service/internal/redact"
+	"jobmate/tracker-service/internal/requestid"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// outboxBatch is how many events RelayOutbox publishes per transaction.
+const outboxBatch = 100
+
+// enqueueEvent records payload in the outbox, to be published on stream by
+// RelayOutbox. Called with the transaction that makes the change the event
+// describes, the event exists if and only if the change was committed.
+// Events caused by an RPC carry its request ID (see package requestid).
+// Events users can subscribe webhooks to are queued for those too. Payloads
+// are redacted (see package redact): events carry IDs, never user content.
+func enqueueEvent(ctx context.Context, q querier, stream string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("enqueue %s: %w", stream, err)
+	}
+	data = redact.JSON(data)
+	if _, err := q.Exec(ctx,
+		`INSERT INTO outbox_events (stream, payload)
+		 VALUES ($1, CASE WHEN $3 = '' THEN $2::jsonb
+		                  ELSE $2::jsonb || jsonb_build_object($4::text, $3::text) END)`,
+		stream, string(data), requestid.FromContext(ctx), requestid.LogKey,
+	); err != nil {
+		return fmt.Errorf("enqueue %s: %w", stream, err)
+	}
+	if err := enqueueWebhookDeliveries(ctx, q, stream, string(data)); err != nil {
+		return fmt.Errorf("enqueue %s webhooks: %w", stream, err)
+	}
+	return nil
+}
+
+// RelayOutbox publishes pending outbox events to their Redis streams, oldest
+// first, marking each batch published once sent: published events are kept
+// for ReplayEvents until PruneOutbox deletes them. It stops at the first failed
+// publish — recorded on the event and retried on the next run — so events
+// are not reordered. Concurrent relays (several tracker instances) skip each
+// other's locked rows. Returns the number of events published.
+//
+// Delivery is at-least-once: events published right before a crash are
+// published again, so consumers must tolerate duplicates.
+func (s *Service) RelayOutbox(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := s.relayOutboxBatch(ctx)
+		total += n
+		if err != nil || n < outboxBatch {
+			return total, err
+		}
+	}
+}
+
+func (s *Service) relayOutboxBatch(ctx context.Context) (int, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("relayOutbox begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	rows, err := tx.Query(ctx,
+		`SELECT id, stream, payload::text FROM outbox_events
+		 WHERE published_at IS NULL
+		 ORDER BY id LIMIT $1
+		 FOR UPDATE SKIP LOCKED`,
+		outboxBatch)
+	if err != nil {
+		return 0, fmt.Errorf("relayOutbox select: %w", err)
+	}
+	type event struct {
+		id      int64
+		stream  string
+		payload string
+	}
+	var pending []event
+	for rows.Next() {
+		var ev event
+		if err := rows.Scan(&ev.id, &ev.stream, &ev.payload); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("relayOutbox scan: %w", err)
+		}
+		pending = append(pending, ev)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("relayOutbox rows: %w", err)
+	}
+
+	var (
+		published []int64
+		pubErr    error
+	)
+	for _, ev := range pending {
+		if err := s.events.Publish(ctx, ev.stream, []byte(ev.payload)); err != nil {
+			pubErr = fmt.Errorf("relayOutbox publish %s: %w", ev.stream, err)
+			if _, err := tx.Exec(ctx,
+				`UPDATE outbox_events SET attempts = attempts + 1, last_error = $2 WHERE id = $1`,
+				ev.id, err.Error(),
+			); err != nil {
+				return 0, fmt.Errorf("relayOutbox record failure: %w", err)
+			}
+			break
+		}
+		published = append(published, ev.id)
+	}
+	if len(published) > 0 {
+		if _, err := tx.Exec(ctx, `UPDATE outbox_events SET published_at = NOW() WHERE id = ANY($1)`, published); err != nil {
+			return 0, fmt.Errorf("relayOutbox mark published: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("relayOutbox commit: %w", err)
+	}
+	return len(published), pubErr
+}
+
+// PruneOutbox deletes the events published more than retention ago: they
+// can no longer be replayed. Returns the number of events deleted.
+func (s *Service) PruneOutbox(ctx context.Context, retention time.Duration) (int, error) {
+	tag, err := s.pool.Exec(ctx,
+		`DELETE FROM outbox_events WHERE published_at < $1`,
+		time.Now().Add(-retention))
+	if err != nil {
+		return 0, fmt.Errorf("pruneOutbox: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// inTx runs fn in a transaction, committed if fn returns nil. The
+// transaction is run again when it fails with a transient error (see
+// retry): fn must not act outside the database, nor keep state across runs.
+func (s *Service) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return s.retry(ctx, func() error {
+		tx, err := s.pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin: %w", err)
+		}
+		defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return &commitError{err}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,163 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// pgRepo is the PostgreSQL ApplicationRepo of a Service.
+type pgRepo struct{ s *Service }
+
+func (r pgRepo) InTx(ctx context.Context, fn func(tx ApplicationTx) error) error {
+	return r.s.inTx(ctx, func(tx pgx.Tx) error { return fn(r.s.pgTx(tx)) })
+}
+
+// pgTx is an ApplicationTx running its statements on q: a transaction of
+// pgRepo, or of the Service methods that still query PostgreSQL directly.
+type pgTx struct {
+	s *Service
+	q querier
+}
+
+// pgTx returns the ApplicationTx running on q.
+func (s *Service) pgTx(q querier) pgTx { return pgTx{s: s, q: q} }
+
+func (t pgTx) JobIdentity(ctx context.Context, jobFeedID string) (company, title string, err error) {
+	err = t.q.QueryRow(ctx,
+		`SELECT `+jobCompanyExpr+`, `+jobTitleExpr+` FROM job_feed jf WHERE jf.id = $1`,
+		jobFeedID,
+	).Scan(&company, &title)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", ErrJobNotFound
+	}
+	return strings.TrimSpace(company), strings.TrimSpace(title), err
+}
+
+func (t pgTx) Duplicates(ctx context.Context, userID, companyKey, titleKey string, rejectedWithinDays int) ([]string, error) {
+	rows, err := t.q.Query(ctx,
+		`SELECT a.id::text
+		 FROM applications a
+		 JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1
+		   AND lower(regexp_replace(btrim(`+jobCompanyExpr+`), '\s+', ' ', 'g')) = $2
+		   AND lower(regexp_replace(btrim(`+jobTitleExpr+`), '\s+', ' ', 'g')) = $3
+		   AND ((a.archived_at IS NULL AND a.current_status NOT IN ('HIRED', 'REJECTED', 'WITHDRAWN'))
+		        OR (a.current_status = 'REJECTED'
+		            AND a.updated_at > NOW() - make_interval(days => $4)))
+		 ORDER BY a.updated_at DESC`,
+		userID, companyKey, titleKey, rejectedWithinDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+func (t pgTx) InsertApplication(ctx context.Context, userID, jobFeedID string) (*Application, bool, error) {
+	var a Application
+	err := t.q.QueryRow(ctx,
+		`WITH ins AS (
+		   INSERT INTO applications (user_id, job_feed_id, current_status)
+		   VALUES ($1, $2, 'TO_APPLY')
+		   ON CONFLICT (user_id, job_feed_id) DO NOTHING
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("ins")+`
+		 FROM ins
+		 LEFT JOIN job_feed jf ON jf.id = ins.job_feed_id`,
+		userID, jobFeedID,
+	).Scan(t.s.appScanDest(&a)...)
+	if err == nil {
+		return &a, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+	err = t.q.QueryRow(ctx,
+		`SELECT `+appColumns("a")+`
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1 AND a.job_feed_id = $2`,
+		userID, jobFeedID,
+	).Scan(t.s.appScanDest(&a)...)
+	if err != nil {
+		return nil, false, fmt.Errorf("existing: %w", err)
+	}
+	return &a, false, nil
+}
+
+func (t pgTx) LockCard(ctx context.Context, userID, appID string) (CardState, error) {
+	cur, err := scanCardState(t.q.QueryRow(ctx,
+		`SELECT `+cardStateColumns+` FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		appID, userID,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return cur, ErrNotFound
+	}
+	return cur, err
+}
+
+func (t pgTx) UserTransitions(ctx context.Context, userID string) ([]Transition, error) {
+	var raw []byte
+	err := t.q.QueryRow(ctx,
+		`SELECT extra_transitions FROM tracker_settings WHERE user_id = $1`, userID,
+	).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) || err == nil && len(raw) == 0 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var edges []Transition
+	if err := json.Unmarshal(raw, &edges); err != nil {
+		slog.Warn("ignoring unreadable extra_transitions", "userId", userID, "err", err)
+		return nil, nil
+	}
+	return edges, nil
+}
+
+func (t pgTx) SearchOnHired(ctx context.Context, appID string) (*SearchArchival, error) {
+	var sa SearchArchival
+	err := t.q.QueryRow(ctx,
+		`SELECT sc.id, sc.is_active,
+		        sc.archive_on_hired AND COALESCE(ts.archive_search_on_hired, TRUE)
+		 FROM applications a
+		 JOIN job_feed jf       ON jf.id = a.job_feed_id
+		 JOIN search_configs sc ON sc.id = jf.search_config_id
+		 LEFT JOIN tracker_settings ts ON ts.user_id = a.user_id
+		 WHERE a.id = $1
+		 FOR UPDATE OF sc`,
+		appID,
+	).Scan(&sa.ConfigID, &sa.Active, &sa.Archive)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("searchOnHired: %w", err)
+	}
+	return &sa, nil
+}
+
+func (t pgTx) DeactivateSearch(ctx context.Context, configID string) error {
+	if _, err := t.q.Exec(ctx,
+		`UPDATE search_configs SET is_active = false, updated_at = NOW() WHERE id = $1`,
+		configID,
+	); err != nil {
+		return fmt.Errorf("deactivateSearch: %w", err)
+	}
+	return nil
+}
+
+func (t pgTx) WriteMove(ctx context.Context, userID, appID string, to, holdOrigin Status, entries []HistoryEntry) (*Application, error) {
+	return t.s.writeMove(ctx, t.q, userID, appID, to, holdOrigin, entries[0], entries[1:]...)
+}
+
+func (t pgTx) Enqueue(ctx context.Context, stream string, payload any) error {
+	return enqueueEvent(ctx, t.q, stream, payload)
+}
@@ -0,0 +1,110 @@
+package kanban
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transition is a single (from → to) edge of the status graph.
+type Transition struct {
+	From Status `json:"from"`
+	To   Status `json:"to"`
+}
+
+// TransitionPolicy relaxes the default state machine with extra allowed
+// edges, e.g. TO_APPLY → INTERVIEW for referrals that skip applying.
+// The zero value is the default state machine.
+//
+// A policy can only add edges: the default transitions are always allowed,
+// terminal states stay terminal and ON_HOLD keeps its resume rules.
+type TransitionPolicy struct {
+	extra map[Transition]struct{}
+}
+
+// NewTransitionPolicy validates extra edges and builds a policy from them.
+func NewTransitionPolicy(extra []Transition) (TransitionPolicy, error) {
+	p := TransitionPolicy{extra: make(map[Transition]struct{}, len(extra))}
+	for _, t := range extra {
+		if err := validateExtraTransition(t); err != nil {
+			return TransitionPolicy{}, err
+		}
+		p.extra[t] = struct{}{}
+	}
+	return p, nil
+}
+
+// ParseTransitionPolicy parses a comma-separated list of FROM>TO edges, the
+// format of the EXTRA_TRANSITIONS environment variable.
+func ParseTransitionPolicy(spec string) (TransitionPolicy, error) {
+	var edges []Transition
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(part, ">")
+		if !ok {
+			return TransitionPolicy{}, fmt.Errorf("invalid transition %q: want FROM>TO", part)
+		}
+		edges = append(edges, Transition{
+			From: Status(strings.TrimSpace(from)),
+			To:   Status(strings.TrimSpace(to)),
+		})
+	}
+	return NewTransitionPolicy(edges)
+}
+
+// With returns a policy allowing the edges of both p and other.
+func (p TransitionPolicy) With(other TransitionPolicy) TransitionPolicy {
+	merged := TransitionPolicy{extra: make(map[Transition]struct{}, len(p.extra)+len(other.extra))}
+	for t := range p.extra {
+		merged.extra[t] = struct{}{}
+	}
+	for t := range other.extra {
+		merged.extra[t] = struct{}{}
+	}
+	return merged
+}
+
+// Extra returns the policy's additional edges.
+func (p TransitionPolicy) Extra() []Transition {
+	out := make([]Transition, 0, len(p.extra))
+	for t := range p.extra {
+		out = append(out, t)
+	}
+	return out
+}
+
+// IsTransitionAllowed reports whether from → to is permitted by the default
+// state machine or by one of the policy's extra edges.
+func (p TransitionPolicy) IsTransitionAllowed(from, to Status) bool {
+	if IsTransitionAllowed(from, to) {
+		return true
+	}
+	_, ok := p.extra[Transition{From: from, To: to}]
+	return ok
+}
+
+func validateExtraTransition(t Transition) error {
+	if _, err := ParseStatus(string(t.From)); err != nil {
+		return err
+	}
+	if _, err := ParseStatus(string(t.To)); err != nil {
+		return err
+	}
+	switch {
+	case t.From == t.To:
+		return fmt.Errorf("transition %s → %s: self-transitions are not allowed", t.From, t.To)
+	case isTerminal(t.From):
+		return fmt.Errorf("transition %s → %s: %s is a terminal state", t.From, t.To, t.From)
+	case t.From == StatusOnHold || t.To == StatusOnHold:
+		return fmt.Errorf("transition %s → %s: ON_HOLD transitions cannot be customized", t.From, t.To)
+	}
+	return nil
+}
+
+// isTerminal reports whether s has no outgoing transitions by default.
+func isTerminal(s Status) bool {
+	_, ok := validTransitions[s]
+	return !ok
+}
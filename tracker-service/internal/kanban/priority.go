@@ -0,0 +1,44 @@
+package kanban
+
+import "fmt"
+
+// Priority values mirror the application_priority enum in PostgreSQL.
+type Priority string
+
+const (
+	PriorityLow    Priority = "LOW"
+	PriorityMedium Priority = "MEDIUM"
+	PriorityHigh   Priority = "HIGH"
+)
+
+// ParsePriority converts a raw string to a Priority, returning an error for
+// unknown values.
+func ParsePriority(s string) (Priority, error) {
+	p := Priority(s)
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown priority %q", s)
+}
+
+// Sort orders accepted by ListApplications.
+const (
+	SortUpdatedAt = "UPDATED_AT" // most recently updated first (default)
+	SortPriority  = "PRIORITY"   // HIGH first, then most recently updated
+	SortNextStep  = "NEXT_STEP"  // earliest next-step due date first, undated last
+)
+
+// listOrderBy maps a sort key to its ORDER BY clause on alias a.
+func listOrderBy(sort string) (string, error) {
+	switch sort {
+	case "", SortUpdatedAt:
+		return `a.updated_at DESC`, nil
+	case SortPriority:
+		// application_priority is declared LOW < MEDIUM < HIGH.
+		return `a.priority DESC, a.updated_at DESC`, nil
+	case SortNextStep:
+		return `a.next_step_due_at ASC NULLS LAST, a.updated_at DESC`, nil
+	}
+	return "", fmt.Errorf("unknown sort order %q", sort)
+}
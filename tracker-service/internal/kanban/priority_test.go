@@ -0,0 +1,24 @@
+package kanban_test
+
+import (
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParsePriority(t *testing.T) {
+	for _, s := range []string{"LOW", "MEDIUM", "HIGH"} {
+		got, err := kanban.ParsePriority(s)
+		if err != nil {
+			t.Errorf("ParsePriority(%q) returned unexpected error: %v", s, err)
+		}
+		if string(got) != s {
+			t.Errorf("ParsePriority(%q) = %q, want %q", s, got, s)
+		}
+	}
+	for _, s := range []string{"", "high", "URGENT", " HIGH"} {
+		if _, err := kanban.ParsePriority(s); err == nil {
+			t.Errorf("ParsePriority(%q) expected error, got nil", s)
+		}
+	}
+}
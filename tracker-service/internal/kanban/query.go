@@ -0,0 +1,133 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx, so helpers can run
+// either standalone or inside a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// jobDetailColumns denormalizes the job a card refers to from job_feed (jf).
+// Scraped rows keep Adzuna's raw_data shape ({"company": {"display_name"}},
+// {"location": {"display_name"}}); manual rows use flat strings. Placeholder
+// source_urls such as "manual://…" are not exposed.
+const jobDetailColumns = jobTitleExpr + `,
+		       ` + jobCompanyExpr + `,
+		       COALESCE(CASE WHEN jsonb_typeof(jf.raw_data->'location') = 'object'
+		                     THEN jf.raw_data->'location'->>'display_name'
+		                     ELSE jf.raw_data->>'location' END, ''),
+		       CASE WHEN jf.source_url ~* '^https?://' THEN jf.source_url
+		            ELSE COALESCE(jf.raw_data->>'url', '') END`
+
+// jobTitleExpr is the title of the job joined as jf ("" when unknown).
+const jobTitleExpr = `COALESCE(jf.title, jf.raw_data->>'title', '')`
+
+// jobCompanyExpr is the company of the job joined as jf ("" when unknown).
+const jobCompanyExpr = `COALESCE(jf.company_name,
+		                CASE WHEN jsonb_typeof(jf.raw_data->'company') = 'object'
+		                     THEN jf.raw_data->'company'->>'display_name'
+		                     ELSE jf.raw_data->>'company' END, '')`
+
+// appColumns returns the projection shared by every query that yields an
+// Application. alias is the applications row alias (a, ins, upd); the query
+// must also LEFT JOIN job_feed as jf.
+//
+// The column order must match appScanDest.
+func appColumns(alias string) string {
+	return strings.ReplaceAll(`{t}.id, {t}.current_status, {t}.ai_analysis, {t}.generated_cover_letter,
+		       {t}.user_notes, {t}.followup_draft, {t}.rating_review, {t}.user_rating, {t}.history_log,`+appCommonColumns, "{t}", alias) + jobDetailColumns
+}
+
+// appListColumns is appColumns without history_log, which lists never
+// carry: it grows with every move (see GetHistory).
+func appListColumns(alias string) string {
+	return strings.ReplaceAll(`{t}.id, {t}.current_status, {t}.ai_analysis, {t}.generated_cover_letter,
+		       {t}.user_notes, {t}.followup_draft, {t}.rating_review, {t}.user_rating, NULL::jsonb,`+appCommonColumns, "{t}", alias) + jobDetailColumns
+}
+
+// List views accepted by ListApplications.
+const (
+	ViewFull    = "FULL"    // every field but history_log (default)
+	ViewSummary = "SUMMARY" // board-card fields only, see appSummaryColumns
+)
+
+// listColumns maps a list view to its projection on alias a.
+func listColumns(view string) (string, error) {
+	switch view {
+	case "", ViewFull:
+		return appListColumns("a"), nil
+	case ViewSummary:
+		return appSummaryColumns("a"), nil
+	}
+	return "", fmt.Errorf("unknown view %q", view)
+}
+
+// appSummaryColumns is appColumns with the heavy fields (ai_analysis, cover
+// letter, notes, follow-up draft, rating review, history_log) projected as
+// NULL, so they are neither read from TOAST nor shipped to clients that only
+// render board cards.
+func appSummaryColumns(alias string) string {
+	return strings.ReplaceAll(`{t}.id, {t}.current_status, NULL::jsonb, NULL::text,
+		       NULL::text, NULL::text, NULL::text, {t}.user_rating, NULL::jsonb,`+appCommonColumns, "{t}", alias) + jobDetailColumns
+}
+
+// appCommonColumns are the light columns shared by every application view.
+const appCommonColumns = `
+		       COALESCE({t}.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
+		       {t}.relance_reminder_at, {t}.archived_at, COALESCE({t}.hold_origin::text, ''), {t}.ghosted_at,
+		       COALESCE({t}.column_id::text, ''), {t}.priority,
+		       {t}.next_step_due_at, {t}.next_step_label, {t}.followup_draft_at, {t}.rating_criteria,
+		       COALESCE({t}.rejection_reason, ''), COALESCE({t}.rejection_stage::text, ''),
+		       COALESCE({t}.relance_rule_status::text, ''), COALESCE({t}.relance_rule_after_days, 0),
+		       COALESCE({t}.relance_rule_every_days, 0),
+		       {t}.created_at, {t}.updated_at,
+		       `
+
+// appScanDest returns the Scan destinations matching appColumns, opening
+// the sealed ones.
+func (s *Service) appScanDest(a *Application) []any {
+	return []any{
+		&a.ID, &a.CurrentStatus, &a.AIAnalysis, s.sealed(&a.GeneratedCoverLetter),
+		s.sealed(&a.UserNotes), s.sealed(&a.FollowUpDraft), s.sealed(&a.RatingReview), &a.UserRating, &a.HistoryLog,
+		&a.JobFeedID, &a.SearchConfigID,
+		&a.RelanceReminderAt, &a.ArchivedAt, &a.HoldOrigin, &a.GhostedAt, &a.ColumnID, &a.Priority,
+		&a.NextStepDueAt, &a.NextStepLabel, &a.FollowUpDraftAt, &a.RatingCriteria,
+		&a.RejectionReason, &a.RejectionStage,
+		&a.ReminderRule.Status, &a.ReminderRule.AfterDays, &a.ReminderRule.EveryDays,
+		&a.CreatedAt, &a.UpdatedAt,
+		&a.JobTitle, &a.Company, &a.Location, &a.SourceURL,
+	}
+}
+
+// CardState is the subset of an application row the state machine needs.
+type CardState struct {
+	Status     Status
+	Archived   bool
+	HoldOrigin Status // stage the card was paused from; empty unless ON_HOLD
+}
+
+// cardStateColumns is the projection scanned by scanCardState.
+const cardStateColumns = `current_status, archived_at IS NOT NULL, COALESCE(hold_origin::text, '')`
+
+// scanCardState scans cardStateColumns, optionally preceded by extra columns.
+func scanCardState(row pgx.Row, extra ...any) (CardState, error) {
+	var (
+		c              CardState
+		status, origin string
+	)
+	if err := row.Scan(append(extra, &status, &c.Archived, &origin)...); err != nil {
+		return c, err
+	}
+	c.Status, c.HoldOrigin = Status(status), Status(origin)
+	return c, nil
+}
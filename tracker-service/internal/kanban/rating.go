@@ -0,0 +1,72 @@
+package kanban
+
+import (
+	"fmt"
+)
+
+// RatingCriteria are the optional sub-scores of an application's rating,
+// from 1 to 5; 0 = not rated.
+type RatingCriteria struct {
+	RoleFit      int32 `json:"roleFit,omitempty"`
+	Compensation int32 `json:"compensation,omitempty"`
+	Culture      int32 `json:"culture,omitempty"` // company culture
+	Commute      int32 `json:"commute,omitempty"`
+}
+
+// IsZero reports whether no criterion is rated.
+func (c RatingCriteria) IsZero() bool {
+	return c == RatingCriteria{}
+}
+
+// Stars returns the star rating the criteria amount to: the mean of the
+// rated ones, rounded half up (0 when none is).
+func (c RatingCriteria) Stars() int32 {
+	var sum, n int32
+	for _, score := range []int32{c.RoleFit, c.Compensation, c.Culture, c.Commute} {
+		if score > 0 {
+			sum += score
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return (2*sum + n) / (2 * n)
+}
+
+// Rating is what RateApplication records: a star rating, or sub-scores whose
+// rounded mean becomes the star rating, optionally with a short review.
+type Rating struct {
+	Stars    int32 // 1–5; ignored when Criteria are given
+	Criteria RatingCriteria
+	Review   string
+}
+
+// normalizeRating validates a rating and fills in Stars from Criteria.
+func normalizeRating(r Rating) (Rating, error) {
+	for _, c := range []struct {
+		field string
+		score int32
+	}{
+		{"role_fit", r.Criteria.RoleFit},
+		{"compensation", r.Criteria.Compensation},
+		{"culture", r.Criteria.Culture},
+		{"commute", r.Criteria.Commute},
+	} {
+		if c.score < 0 || c.score > 5 {
+			return r, &ValidationError{Field: c.field, Msg: fmt.Sprintf("%s must be between 1 and 5 (0 = not rated)", c.field)}
+		}
+	}
+	if !r.Criteria.IsZero() {
+		r.Stars = r.Criteria.Stars()
+	} else if r.Stars < 1 || r.Stars > 5 {
+		return r, &ValidationError{Msg: "rating must be between 1 and 5"}
+	}
+
+	review, err := cleanText("review", r.Review, maxRatingReviewLen)
+	if err != nil {
+		return r, err
+	}
+	r.Review = review
+	return r, nil
+}
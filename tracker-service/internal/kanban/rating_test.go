@@ -0,0 +1,58 @@
+package kanban_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestRatingCriteriaStars(t *testing.T) {
+	for _, tc := range []struct {
+		c    kanban.RatingCriteria
+		want int32
+	}{
+		{kanban.RatingCriteria{}, 0},
+		{kanban.RatingCriteria{RoleFit: 4}, 4},
+		{kanban.RatingCriteria{RoleFit: 4, Compensation: 3}, 4}, // 3.5 rounds up
+		{kanban.RatingCriteria{RoleFit: 5, Compensation: 2, Culture: 2}, 3},
+		{kanban.RatingCriteria{RoleFit: 1, Compensation: 1, Culture: 2, Commute: 1}, 1}, // 1.25
+	} {
+		if got := tc.c.Stars(); got != tc.want {
+			t.Errorf("%+v.Stars() = %d, want %d", tc.c, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeRating(t *testing.T) {
+	r, err := kanban.NormalizeRating(kanban.Rating{
+		Stars:    1,
+		Criteria: kanban.RatingCriteria{RoleFit: 5, Culture: 4},
+		Review:   "  Great team\r\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Stars != 5 || r.Review != "Great team" {
+		t.Errorf("NormalizeRating = %+v, want 5 stars from the criteria and a cleaned review", r)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		r     kanban.Rating
+		field string
+	}{
+		{"no stars nor criteria", kanban.Rating{}, ""},
+		{"stars out of range", kanban.Rating{Stars: 6}, ""},
+		{"criterion out of range", kanban.Rating{Criteria: kanban.RatingCriteria{Commute: 6}}, "commute"},
+		{"negative criterion", kanban.Rating{Stars: 3, Criteria: kanban.RatingCriteria{RoleFit: -1}}, "role_fit"},
+		{"review too long", kanban.Rating{Stars: 3, Review: strings.Repeat("a", 501)}, "review"},
+	} {
+		_, err := kanban.NormalizeRating(tc.r)
+		var verr *kanban.ValidationError
+		if !errors.As(err, &verr) || verr.Field != tc.field {
+			t.Errorf("%s: error = %v, want a ValidationError on %q", tc.name, err, tc.field)
+		}
+	}
+}
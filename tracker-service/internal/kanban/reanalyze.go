@@ -0,0 +1,62 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ReanalyzeApplication re-publishes CMD_ANALYZE_JOB for an existing
+// application, e.g. after the job description was enriched or the user's CV
+// changed. The AI Coach overwrites ai_analysis and writes a new cover letter
+// version.
+//
+// Calls for the same application are rate-limited by
+// Options.ReanalyzeCooldown (a CooldownError is returned meanwhile) so the
+// AI Coach, and the LLM bill behind it, cannot be hammered.
+func (s *Service) ReanalyzeApplication(ctx context.Context, userID, appID string) error {
+	jobFeedID, err := s.ownedJobFeedID(ctx, userID, appID)
+	if err != nil {
+		return err
+	}
+	if jobFeedID == "" {
+		return &ValidationError{Msg: "application has no job offer to analyze"}
+	}
+
+	key := fmt.Sprintf("tracker:reanalyze:%s", appID)
+	if err := s.startCooldown(ctx, key, userID, "reanalysis", s.opts.ReanalyzeCooldown); err != nil {
+		return err
+	}
+
+	if err := enqueueAnalyzeJob(ctx, s.pgTx(s.pool), userID, appID, jobFeedID); err != nil {
+		// Nothing was queued: let the user retry right away.
+		s.rdb.Del(ctx, key)
+		return fmt.Errorf("reanalyzeApplication: %w", err)
+	}
+	slog.Info("reanalysis requested", "userId", userID, "applicationId", appID)
+	return nil
+}
+
+// startCooldown starts the cooldown of an action rate-limited per key, or
+// returns a CooldownError while the previous one runs. A zero cooldown
+// disables the limit. Callers that end up not acting delete key, so the
+// user may retry right away.
+func (s *Service) startCooldown(ctx context.Context, key, userID, action string, cooldown time.Duration) error {
+	if cooldown <= 0 {
+		return nil
+	}
+	ok, err := s.rdb.SetNX(ctx, key, userID, cooldown).Result()
+	switch {
+	case err != nil:
+		// Same stance as Idempotent: Redis trouble must not block the user.
+		slog.Warn("cooldown check failed", "action", action, "err", err)
+	case !ok:
+		ttl, _ := s.rdb.TTL(ctx, key).Result()
+		if ttl <= 0 {
+			ttl = cooldown
+		}
+		return &CooldownError{Action: action, RetryAfter: ttl}
+	}
+	return nil
+}
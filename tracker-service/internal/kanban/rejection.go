@@ -0,0 +1,90 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+)
+
+// Why an application was rejected, given optionally when moving to REJECTED.
+const (
+	RejectionNoResponse     = "NO_RESPONSE"
+	RejectionAfterScreening = "AFTER_SCREENING"
+	RejectionAfterInterview = "AFTER_INTERVIEW"
+	RejectionSalaryMismatch = "SALARY_MISMATCH"
+	RejectionWithdrew       = "WITHDREW" // the company withdrew the position
+)
+
+var rejectionReasons = map[string]bool{
+	RejectionNoResponse:     true,
+	RejectionAfterScreening: true,
+	RejectionAfterInterview: true,
+	RejectionSalaryMismatch: true,
+	RejectionWithdrew:       true,
+}
+
+// Rejection qualifies a move to REJECTED. The zero value records no reason
+// and the status the card left as the stage.
+type Rejection struct {
+	Reason string // one of the Rejection* constants, or ""
+	Stage  Status // "" = the status the card was rejected from
+}
+
+// parseRejection validates the rejection details of a move to to. They may
+// only be given when to is REJECTED.
+func parseRejection(to Status, reason, stage string) (Rejection, error) {
+	if to != StatusRejected {
+		if reason != "" || stage != "" {
+			return Rejection{}, &ValidationError{Field: "rejection_reason", Msg: "rejection details are only accepted when moving to REJECTED"}
+		}
+		return Rejection{}, nil
+	}
+	var r Rejection
+	if reason != "" {
+		if !rejectionReasons[reason] {
+			return Rejection{}, &ValidationError{Field: "rejection_reason", Msg: fmt.Sprintf("unknown rejection reason %q", reason)}
+		}
+		r.Reason = reason
+	}
+	if stage != "" {
+		st, err := ParseStatus(stage)
+		if err != nil || st == StatusRejected {
+			return Rejection{}, &ValidationError{Field: "rejection_stage", Msg: fmt.Sprintf("invalid rejection stage %q", stage)}
+		}
+		r.Stage = st
+	}
+	return r, nil
+}
+
+// RejectionStat counts the user's rejected applications for one reason and
+// stage ("" when not recorded).
+type RejectionStat struct {
+	Reason string `json:"reason"`
+	Stage  string `json:"stage"`
+	Count  int    `json:"count"`
+}
+
+// GetRejectionStats breaks the user's currently REJECTED applications
+// (archived ones included) down by reason and stage, most frequent first.
+func (s *Service) GetRejectionStats(ctx context.Context, userID string) ([]RejectionStat, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT COALESCE(rejection_reason, ''), COALESCE(rejection_stage::text, ''), COUNT(*)
+		 FROM applications
+		 WHERE user_id = $1 AND current_status = 'REJECTED'
+		 GROUP BY 1, 2
+		 ORDER BY 3 DESC, 1, 2`,
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("getRejectionStats query: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]RejectionStat, 0)
+	for rows.Next() {
+		var st RejectionStat
+		if err := rows.Scan(&st.Reason, &st.Stage, &st.Count); err != nil {
+			return nil, fmt.Errorf("getRejectionStats scan: %w", err)
+		}
+		stats = append(stats, st)
+	}
+	return stats, rows.Err()
+}
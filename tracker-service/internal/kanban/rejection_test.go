@@ -0,0 +1,57 @@
+package kanban_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseRejection(t *testing.T) {
+	got, err := kanban.ParseRejection(kanban.StatusRejected, kanban.RejectionAfterInterview, "INTERVIEW")
+	if want := (kanban.Rejection{Reason: kanban.RejectionAfterInterview, Stage: kanban.StatusInterview}); err != nil || got != want {
+		t.Errorf("ParseRejection = %+v, %v; want %+v", got, err, want)
+	}
+	if got, err := kanban.ParseRejection(kanban.StatusApplied, "", ""); err != nil || got != (kanban.Rejection{}) {
+		t.Errorf("ParseRejection(no details) = %+v, %v; want the zero value", got, err)
+	}
+
+	cases := map[string]struct {
+		to                   kanban.Status
+		reason, stage, field string
+	}{
+		"reason on non-rejection": {kanban.StatusApplied, kanban.RejectionNoResponse, "", "rejection_reason"},
+		"stage on non-rejection":  {kanban.StatusInterview, "", "APPLIED", "rejection_reason"},
+		"unknown reason":          {kanban.StatusRejected, "BAD_LUCK", "", "rejection_reason"},
+		"invalid stage":           {kanban.StatusRejected, "", "NOPE", "rejection_stage"},
+		"rejected stage":          {kanban.StatusRejected, "", "REJECTED", "rejection_stage"},
+	}
+	for name, c := range cases {
+		_, err := kanban.ParseRejection(c.to, c.reason, c.stage)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) || ve.Field != c.field {
+			t.Errorf("%s: ParseRejection error = %v, want a %s ValidationError", name, err, c.field)
+		}
+	}
+}
+
+func TestPlanUndo_RestoresRejection(t *testing.T) {
+	rejected := entry(kanban.StatusInterview, kanban.StatusRejected, time.Hour)
+	rejected.RejectionReason = kanban.RejectionAfterInterview
+	rejected.RejectionStage = string(kanban.StatusInterview)
+	history := []kanban.HistoryEntry{
+		entry(kanban.StatusApplied, kanban.StatusInterview, 2*time.Hour),
+		rejected,
+		entry(kanban.StatusRejected, kanban.StatusApplied, time.Minute),
+	}
+
+	got, err := kanban.PlanUndo(history, undoNow, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("PlanUndo unexpected error: %v", err)
+	}
+	want := kanban.Rejection{Reason: kanban.RejectionAfterInterview, Stage: kanban.StatusInterview}
+	if got.To != kanban.StatusRejected || got.Rejection != want {
+		t.Errorf("PlanUndo = %+v, want back to REJECTED with %+v", got, want)
+	}
+}
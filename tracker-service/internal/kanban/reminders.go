@@ -0,0 +1,427 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Delivery channels of relance reminders, picked per user in their settings.
+// The Gateway delivers EVENT_RELANCE_DUE on the channels it lists.
+const (
+	ChannelInApp = "IN_APP" // SSE to the user's open clients
+	ChannelPush  = "PUSH"   // mobile push notification
+)
+
+// reminderChannels lists the known channels, in canonical order. Users
+// without settings get all of them.
+var reminderChannels = []string{ChannelInApp, ChannelPush}
+
+// reminderDispatchBatch caps the reminders of each kind fired per
+// DispatchDueReminders run.
+const reminderDispatchBatch = 500
+
+// ReminderRule schedules relance reminders relative to a card's status
+// instead of at a fixed time, e.g. "10 days after applying" (Status APPLIED,
+// AfterDays 10) or "every 7 days until the status changes" (the current
+// status, AfterDays = EveryDays = 7). It only fires while the card is in
+// Status, and starts over each time the card enters it.
+type ReminderRule struct {
+	Status    Status `json:"status"`
+	AfterDays int    `json:"afterDays"` // first reminder, days after entering Status
+	EveryDays int    `json:"everyDays"` // then every EveryDays days (0 = once)
+}
+
+// IsZero reports whether r is "no rule".
+func (r ReminderRule) IsZero() bool { return r.AfterDays == 0 }
+
+// maxReminderRuleDays bounds rule intervals to something meaningful.
+const maxReminderRuleDays = 365
+
+// SetReminderRule sets the application's reminder rule, or removes it when
+// rule is zero. An empty Status means the card's current one. The rule's
+// first reminder is due AfterDays after the card entered Status — possibly
+// already, in which case the next DispatchDueReminders run fires it.
+func (s *Service) SetReminderRule(ctx context.Context, userID, appID string, rule ReminderRule) (*Application, error) {
+	rule, err := normalizeReminderRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateApplicationRow(ctx, userID, []string{"reminder_rule"},
+		`WITH upd AS (
+		   UPDATE applications
+		   SET relance_rule_status     = CASE WHEN $1 > 0 THEN COALESCE(NULLIF($3, '')::application_status, current_status) END,
+		       relance_rule_after_days = NULLIF($1, 0),
+		       relance_rule_every_days = CASE WHEN $1 > 0 THEN $2::int END,
+		       relance_rule_fired_at   = NULL,
+		       updated_at = NOW()
+		   WHERE id = $4 AND user_id = $5
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		rule.AfterDays, rule.EveryDays, string(rule.Status), appID, userID,
+	)
+}
+
+// normalizeReminderRule validates rule. Rules cannot target a terminal
+// status: there is nobody left to follow up with.
+func normalizeReminderRule(r ReminderRule) (ReminderRule, error) {
+	if r.IsZero() && r.EveryDays == 0 && r.Status == "" {
+		return r, nil
+	}
+	if r.AfterDays < 1 || r.AfterDays > maxReminderRuleDays {
+		return r, &ValidationError{Field: "after_days", Msg: fmt.Sprintf("after_days must be between 1 and %d", maxReminderRuleDays)}
+	}
+	if r.EveryDays < 0 || r.EveryDays > maxReminderRuleDays {
+		return r, &ValidationError{Field: "every_days", Msg: fmt.Sprintf("every_days must be between 0 and %d", maxReminderRuleDays)}
+	}
+	if r.Status != "" {
+		status, err := ParseStatus(string(r.Status))
+		if err != nil {
+			return r, &ValidationError{Field: "status", Msg: err.Error()}
+		}
+		if isTerminal(status) {
+			return r, &ValidationError{Field: "status", Msg: fmt.Sprintf("reminder rules cannot target the final status %s", status)}
+		}
+		r.Status = status
+	}
+	return r, nil
+}
+
+// DispatchDueReminders fires the relance reminders whose time has come, both
+// the fixed ones (relance_reminder_at, stamped relance_reminder_fired_at)
+// and those of reminder rules (stamped relance_rule_fired_at), queuing
+// EVENT_RELANCE_DUE with the owner's delivery channels. Users who muted every
+// channel get theirs stamped silently. Each fired reminder is logged as a
+// REMINDER_FIRED history entry. Archived cards are skipped; setting
+// a new reminder time re-arms it (see the rearm_relance_reminder trigger).
+// Returns the number of reminders fired.
+func (s *Service) DispatchDueReminders(ctx context.Context) (int, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("dispatchDueReminders begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	fixed, err := collectDueReminders(tx.Query(ctx,
+		`WITH due AS (
+		   SELECT a.id, `+jobTitleExpr+` AS title, `+jobCompanyExpr+` AS company,
+		          COALESCE(ts.reminder_channels, $2) AS channels
+		   FROM applications a
+		   LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		   LEFT JOIN tracker_settings ts ON ts.user_id = a.user_id
+		   WHERE a.relance_reminder_at IS NOT NULL
+		     AND a.relance_reminder_fired_at IS NULL
+		     AND a.relance_reminder_at <= NOW()
+		     AND a.archived_at IS NULL
+		   ORDER BY a.relance_reminder_at
+		   LIMIT $1
+		   FOR UPDATE OF a SKIP LOCKED
+		 )
+		 UPDATE applications a
+		 SET relance_reminder_fired_at = NOW(),
+		     history_log = a.history_log || `+reminderFiredEntry("a.relance_reminder_at")+`
+		 FROM due d
+		 WHERE a.id = d.id
+		 RETURNING a.id::text, a.user_id::text, a.current_status::text, a.relance_reminder_at,
+		           d.title, d.company, d.channels`,
+		reminderDispatchBatch, reminderChannels,
+	))
+	if err != nil {
+		return 0, fmt.Errorf("dispatchDueReminders: %w", err)
+	}
+
+	// A rule is due AfterDays after the card entered its status (last move
+	// to it, or creation), then EveryDays after it last fired.
+	ruled, err := collectDueReminders(tx.Query(ctx,
+		`WITH ruled AS (
+		   SELECT a.id, a.relance_rule_after_days AS after_days, a.relance_rule_every_days AS every_days,
+		          a.relance_rule_fired_at AS fired,
+		          COALESCE((SELECT MAX((e->>'at')::timestamptz)
+		                    FROM jsonb_array_elements(a.history_log) e
+		                    WHERE e->>'to' = a.current_status::text
+		                      AND NOT e ? 'kind' AND NOT e ? 'mergedFrom'),
+		                   a.created_at) AS entered
+		   FROM applications a
+		   WHERE a.relance_rule_status = a.current_status AND a.archived_at IS NULL
+		 ), due AS (
+		   SELECT r.id,
+		          CASE WHEN r.fired IS NULL OR r.fired < r.entered THEN r.entered + make_interval(days => r.after_days)
+		               WHEN r.every_days > 0 THEN r.fired + make_interval(days => r.every_days)
+		          END AS due_at
+		   FROM ruled r
+		 ), picked AS (
+		   SELECT a.id, d.due_at, `+jobTitleExpr+` AS title, `+jobCompanyExpr+` AS company,
+		          COALESCE(ts.reminder_channels, $2) AS channels
+		   FROM due d
+		   JOIN applications a ON a.id = d.id
+		   LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		   LEFT JOIN tracker_settings ts ON ts.user_id = a.user_id
+		   WHERE d.due_at <= NOW()
+		   ORDER BY d.due_at
+		   LIMIT $1
+		   FOR UPDATE OF a SKIP LOCKED
+		 )
+		 UPDATE applications a
+		 SET relance_rule_fired_at = NOW(),
+		     history_log = a.history_log || `+reminderFiredEntry("p.due_at")+`
+		 FROM picked p
+		 WHERE a.id = p.id
+		 RETURNING a.id::text, a.user_id::text, a.current_status::text, p.due_at,
+		           p.title, p.company, p.channels`,
+		reminderDispatchBatch, reminderChannels,
+	))
+	if err != nil {
+		return 0, fmt.Errorf("dispatchDueReminders rules: %w", err)
+	}
+	for i := range ruled {
+		ruled[i].fromRule = true
+	}
+
+	fired := append(fixed, ruled...)
+	for _, r := range fired {
+		if len(r.channels) == 0 {
+			continue
+		}
+		err := enqueueEvent(ctx, tx, "EVENT_RELANCE_DUE", map[string]any{
+			"type":          "EVENT_RELANCE_DUE",
+			"applicationId": r.appID,
+			"userId":        r.userID,
+			"status":        r.status,
+			"jobTitle":      r.title,
+			"company":       r.company,
+			"remindAt":      r.remindAt.UTC().Format(time.RFC3339),
+			"fromRule":      r.fromRule,
+			"channels":      r.channels,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("dispatchDueReminders: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("dispatchDueReminders commit: %w", err)
+	}
+	if len(fired) > 0 {
+		slog.Info("relance reminders fired", "count", len(fired), "from_rules", len(ruled))
+	}
+	return len(fired), nil
+}
+
+// dueReminder is a reminder fired by DispatchDueReminders.
+type dueReminder struct {
+	appID, userID, status, title, company string
+	remindAt                              time.Time
+	channels                              []string
+	fromRule                              bool
+}
+
+// collectDueReminders scans the RETURNING rows of a dispatch query.
+func collectDueReminders(rows pgx.Rows, err error) ([]dueReminder, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fired []dueReminder
+	for rows.Next() {
+		var r dueReminder
+		if err := rows.Scan(&r.appID, &r.userID, &r.status, &r.remindAt, &r.title, &r.company, &r.channels); err != nil {
+			return nil, err
+		}
+		fired = append(fired, r)
+	}
+	return fired, rows.Err()
+}
+
+// reminderFiredEntry returns the SQL expression of the history_log entry
+// (as a one-element array) recording that a reminder due at the dueAt
+// expression was dispatched.
+func reminderFiredEntry(dueAt string) string {
+	return `jsonb_build_array(jsonb_build_object(
+		'kind', '` + HistoryReminderFired + `', 'at', date_trunc('second', NOW()),
+		'remindAt', ` + dueAt + `, 'actor', '` + ActorSystem + `'))`
+}
+
+// maxReminderSnooze bounds how far SnoozeReminder pushes a reminder at once.
+const maxReminderSnooze = 90 * 24 * time.Hour
+
+// SnoozeReminder pushes the application's relance reminder back by by (at
+// least an hour): from its current time if still ahead, from now otherwise —
+// typically when snoozing a reminder that just fired. Cards without a
+// reminder get one. Logs a REMINDER_SNOOZED history entry.
+func (s *Service) SnoozeReminder(ctx context.Context, userID, appID string, by time.Duration) (*Application, error) {
+	if by < time.Hour || by > maxReminderSnooze {
+		return nil, &ValidationError{Field: "snooze",
+			Msg: fmt.Sprintf("reminders can be snoozed by 1 hour to %d days", maxReminderSnooze/(24*time.Hour))}
+	}
+
+	var app Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		cur, err := lockReminder(ctx, tx, userID, appID)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		from := now
+		if cur != nil && cur.After(now) {
+			from = cur.UTC()
+		}
+		remindAt := from.Add(by)
+		if err := validateRemindAt(remindAt, now); err != nil {
+			return err
+		}
+		return s.writeReminder(ctx, tx, userID, appID, &remindAt, &app,
+			HistoryEntry{Kind: HistoryReminderSnoozed, At: now, RemindAt: &remindAt})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// ClearReminder dismisses the application's relance reminder, logging a
+// REMINDER_CLEARED history entry if there was one.
+func (s *Service) ClearReminder(ctx context.Context, userID, appID string) (*Application, error) {
+	var app Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		cur, err := lockReminder(ctx, tx, userID, appID)
+		if err != nil {
+			return err
+		}
+		var logged []HistoryEntry
+		if cur != nil {
+			logged = append(logged, HistoryEntry{Kind: HistoryReminderCleared, At: time.Now().UTC().Truncate(time.Second)})
+		}
+		return s.writeReminder(ctx, tx, userID, appID, nil, &app, logged...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// lockReminder locks the application row and returns its relance reminder.
+func lockReminder(ctx context.Context, tx pgx.Tx, userID, appID string) (*time.Time, error) {
+	var cur *time.Time
+	err := tx.QueryRow(ctx,
+		`SELECT relance_reminder_at FROM applications WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+		appID, userID,
+	).Scan(&cur)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	return cur, nil
+}
+
+// writeReminder sets the relance reminder (nil clears it), appends logged to
+// the history and queues EVENT_APPLICATION_UPDATED, scanning the row into app.
+func (s *Service) writeReminder(ctx context.Context, tx pgx.Tx, userID, appID string, remindAt *time.Time, app *Application, logged ...HistoryEntry) error {
+	entries := []byte("[]")
+	if len(logged) > 0 {
+		entries, _ = json.Marshal(logged)
+	}
+	err := tx.QueryRow(ctx,
+		`WITH upd AS (
+		   UPDATE applications
+		   SET relance_reminder_at = $3, history_log = history_log || $4::jsonb, updated_at = NOW()
+		   WHERE id = $1 AND user_id = $2
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		appID, userID, remindAt, string(entries),
+	).Scan(s.appScanDest(app)...)
+	if err != nil {
+		return fmt.Errorf("write reminder: %w", err)
+	}
+	fields := []string{"relance_reminder_at"}
+	if len(logged) > 0 {
+		fields = append(fields, "history_log")
+	}
+	return enqueueApplicationUpdated(ctx, tx, userID, app, fields...)
+}
+
+// reminderLogged returns the history_log entry UpdateApplication appends
+// when it sets the relance reminder to remindAt (zero = clears it), as a
+// JSON array, and the expression appending it, with a %d verb for its
+// placeholder: like ClearReminder, clearing is only logged when there was
+// a reminder.
+func reminderLogged(remindAt time.Time) (entry, expr string) {
+	now := time.Now().UTC().Truncate(time.Second)
+	e := HistoryEntry{Kind: HistoryReminderSet, At: now, RemindAt: &remindAt}
+	expr = "history_log || $%d::jsonb"
+	if remindAt.IsZero() {
+		e = HistoryEntry{Kind: HistoryReminderCleared, At: now}
+		expr = "history_log || CASE WHEN relance_reminder_at IS NULL THEN '[]'::jsonb ELSE $%d::jsonb END"
+	}
+	b, _ := json.Marshal([]HistoryEntry{e})
+	return string(b), expr
+}
+
+// Bounds of a relance reminder time: remindAtSkew tolerates clocks of
+// clients slightly behind ours, maxRemindAhead rejects typos like 2062.
+const (
+	remindAtSkew   = time.Minute
+	maxRemindAhead = 366 * 24 * time.Hour
+)
+
+// validateRemindAt rejects reminder times the reminder worker could not
+// usefully fire: in the past, or more than a year ahead of now.
+func validateRemindAt(at, now time.Time) error {
+	if at.Before(now.Add(-remindAtSkew)) {
+		return &ValidationError{Field: "remind_at",
+			Msg: fmt.Sprintf("remind_at %s is in the past", at.UTC().Format(time.RFC3339))}
+	}
+	if at.After(now.Add(maxRemindAhead)) {
+		return &ValidationError{Field: "remind_at",
+			Msg: fmt.Sprintf("remind_at %s is more than a year ahead", at.UTC().Format(time.RFC3339))}
+	}
+	return nil
+}
+
+// localTimeLayouts are the wall-clock formats parseLocalTime accepts.
+var localTimeLayouts = []string{"2006-01-02T15:04", "2006-01-02T15:04:05"}
+
+// parseLocalTime reads a wall-clock time without offset, such as
+// "2026-10-20T09:00", in loc and returns it in UTC. Times skipped by a DST
+// change are shifted forward like time.Date does.
+func parseLocalTime(local string, loc *time.Location) (time.Time, error) {
+	local = strings.TrimSpace(local)
+	for _, layout := range localTimeLayouts {
+		if t, err := time.ParseInLocation(layout, local, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid local time %q (want YYYY-MM-DDTHH:MM, without offset)", local)
+}
+
+// normalizeReminderChannels validates a user's channel list: known channels
+// only, case-insensitive, duplicates dropped. An empty list mutes reminders.
+func normalizeReminderChannels(channels []string) ([]string, error) {
+	want := make(map[string]bool, len(channels))
+	for _, c := range channels {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		known := false
+		for _, k := range reminderChannels {
+			known = known || c == k
+		}
+		if !known {
+			return nil, &ValidationError{Field: "reminder_channels",
+				Msg: fmt.Sprintf("unknown reminder channel %q (want %s)", c, strings.Join(reminderChannels, ", "))}
+		}
+		want[c] = true
+	}
+	out := make([]string, 0, len(want))
+	for _, k := range reminderChannels {
+		if want[k] {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
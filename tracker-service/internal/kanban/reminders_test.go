@@ -0,0 +1,57 @@
+package kanban_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestNormalizeReminderChannels(t *testing.T) {
+	for _, tc := range []struct {
+		in   []string
+		want []string
+	}{
+		{nil, []string{}},
+		{[]string{"push"}, []string{kanban.ChannelPush}},
+		{[]string{" PUSH ", "in_app", "IN_APP"}, []string{kanban.ChannelInApp, kanban.ChannelPush}},
+	} {
+		got, err := kanban.NormalizeReminderChannels(tc.in)
+		if err != nil || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("NormalizeReminderChannels(%q) = %q, %v; want %q", tc.in, got, err, tc.want)
+		}
+	}
+
+	_, err := kanban.NormalizeReminderChannels([]string{"IN_APP", "SMS"})
+	var verr *kanban.ValidationError
+	if !errors.As(err, &verr) || verr.Field != "reminder_channels" {
+		t.Errorf("unknown channel: error = %v, want a reminder_channels ValidationError", err)
+	}
+}
+
+func TestParseLocalTime(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("no tz database: %v", err)
+	}
+	for _, tc := range []struct {
+		in   string
+		want time.Time
+	}{
+		{"2026-10-20T09:00", time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC)},      // CEST, UTC+2
+		{" 2026-12-01T09:00:30 ", time.Date(2026, 12, 1, 8, 0, 30, 0, time.UTC)}, // CET, UTC+1
+	} {
+		got, err := kanban.ParseLocalTime(tc.in, paris)
+		if err != nil || !got.Equal(tc.want) || got.Location() != time.UTC {
+			t.Errorf("ParseLocalTime(%q) = %v, %v; want %v", tc.in, got, err, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "2026-10-20", "2026-10-20T09:00:00+02:00", "tomorrow 9am"} {
+		if _, err := kanban.ParseLocalTime(in, paris); err == nil {
+			t.Errorf("ParseLocalTime(%q): want an error", in)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package kanban
+
+import (
+	"context"
+	"time"
+
+	"jobmate/tracker-service/internal/streams"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// The card lifecycle — creating a card from a job_feed entry
+// (CreateApplication), moving cards (MoveCard, BulkMove), archiving their
+// search on HIRED — runs against an ApplicationRepo, and outbox events are
+// published through an EventPublisher, so that its branching can be tested
+// with in-memory fakes. NewService backs both with its PostgreSQL pool and
+// Redis client unless Options provides others; the rest of the Service still
+// queries the pool directly — manual and template cards, which write their
+// own job_feed row and card setup, and the reminder dispatcher, which
+// batch-updates every user's due cards, included.
+
+// ApplicationRepo stores applications for the card lifecycle.
+type ApplicationRepo interface {
+	// InTx runs fn in a transaction, committed if fn returns nil. fn may run
+	// more than once (see Service.retry): it must not act outside tx.
+	InTx(ctx context.Context, fn func(tx ApplicationTx) error) error
+}
+
+// ApplicationTx is the work of one ApplicationRepo transaction. Events are
+// queued with the changes they describe: they exist if and only if the
+// transaction commits.
+type ApplicationTx interface {
+	// JobIdentity returns the company and title of a job_feed entry, or
+	// ErrJobNotFound.
+	JobIdentity(ctx context.Context, jobFeedID string) (company, title string, err error)
+	// Duplicates returns the IDs of userID's applications whose company and
+	// title match (compared like companyKey) that are still active, or were
+	// rejected within rejectedWithinDays, most recent first.
+	Duplicates(ctx context.Context, userID, companyKey, titleKey string, rejectedWithinDays int) ([]string, error)
+	// InsertApplication creates userID's TO_APPLY card for a job_feed entry.
+	// When the user already has one, it is returned instead, created false.
+	InsertApplication(ctx context.Context, userID, jobFeedID string) (app *Application, created bool, err error)
+	// LockCard returns the state of a card, locked until the transaction
+	// ends, or ErrNotFound if it does not exist or belong to userID.
+	LockCard(ctx context.Context, userID, appID string) (CardState, error)
+	// UserTransitions returns the extra transitions userID allowed in their
+	// settings (nil if none or unreadable).
+	UserTransitions(ctx context.Context, userID string) ([]Transition, error)
+	// SearchOnHired returns the search an application's job came from,
+	// locked until the transaction ends, or nil for a card without one.
+	SearchOnHired(ctx context.Context, appID string) (*SearchArchival, error)
+	// DeactivateSearch deactivates a search config.
+	DeactivateSearch(ctx context.Context, configID string) error
+	// WriteMove sets a card's status and appends entries to its history (see
+	// Service.writeMove).
+	WriteMove(ctx context.Context, userID, appID string, to, holdOrigin Status, entries []HistoryEntry) (*Application, error)
+	// Enqueue queues payload in the outbox, to be published on stream.
+	Enqueue(ctx context.Context, stream string, payload any) error
+}
+
+// SearchArchival is what moving a card to HIRED decides on: the search its
+// job came from.
+type SearchArchival struct {
+	ConfigID string
+	Active   bool
+	// Archive is whether the search (archive_on_hired) and the user
+	// (Settings.ArchiveSearchOnHired) both let HIRED deactivate it.
+	Archive bool
+}
+
+// EventPublisher publishes outbox events to their stream (see RelayOutbox).
+type EventPublisher interface {
+	Publish(ctx context.Context, stream string, payload []byte) error
+}
+
+// redisPublisher publishes to Redis Streams (see package streams).
+type redisPublisher struct{ rdb *redis.Client }
+
+func (p redisPublisher) Publish(ctx context.Context, stream string, payload []byte) error {
+	return streams.Publish(ctx, p.rdb, stream, payload)
+}
+
+// archiveSearchOnHired applies the HIRED archival preferences to the search
+// the application came from and returns the history entry recording the
+// decision: HistorySearchArchived, HistorySearchKept, or nil when there is
+// nothing to decide (manual card, search already inactive).
+func archiveSearchOnHired(ctx context.Context, tx ApplicationTx, appID string, at time.Time) (*HistoryEntry, error) {
+	search, err := tx.SearchOnHired(ctx, appID)
+	if err != nil || search == nil || !search.Active {
+		return nil, err
+	}
+	entry := &HistoryEntry{Kind: HistorySearchKept, At: at, SearchConfigID: search.ConfigID, Actor: ActorSystem}
+	if search.Archive {
+		if err := tx.DeactivateSearch(ctx, search.ConfigID); err != nil {
+			return nil, err
+		}
+		entry.Kind = HistorySearchArchived
+	}
+	return entry, nil
+}
@@ -0,0 +1,280 @@
+package kanban_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func newMemService(repo *memRepo, opts kanban.Options) *kanban.Service {
+	opts.Applications = repo
+	return kanban.NewService(nil, nil, opts)
+}
+
+func historyOf(t *testing.T, app *kanban.Application) []kanban.HistoryEntry {
+	t.Helper()
+	var h []kanban.HistoryEntry
+	if err := json.Unmarshal(app.HistoryLog, &h); err != nil {
+		t.Fatalf("decode history: %v", err)
+	}
+	return h
+}
+
+func TestCreateApplication_QueuesAnalysisAndCreated(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	svc := newMemService(repo, kanban.Options{})
+
+	app, err := svc.CreateApplication(context.Background(), "user-1", "job-1", false)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if app.CurrentStatus != string(kanban.StatusToApply) {
+		t.Errorf("status = %s, want TO_APPLY", app.CurrentStatus)
+	}
+	want := []string{"CMD_ANALYZE_JOB", "EVENT_APPLICATION_CREATED"}
+	if got := repo.streams(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestCreateApplication_Duplicate(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addJob("job-2", " ACME ", "go  developer", "")
+	repo.addApp("app-old", "user-1", "job-1", kanban.StatusApplied)
+	svc := newMemService(repo, kanban.Options{})
+
+	_, err := svc.CreateApplication(context.Background(), "user-1", "job-2", false)
+	var dup *kanban.DuplicateError
+	if !errors.As(err, &dup) || fmt.Sprint(dup.ApplicationIDs) != "[app-old]" {
+		t.Fatalf("CreateApplication = %v, want DuplicateError naming app-old", err)
+	}
+	if got := repo.streams(); len(got) != 0 {
+		t.Errorf("events after a duplicate = %v, want none", got)
+	}
+
+	if _, err := svc.CreateApplication(context.Background(), "user-1", "job-2", true); err != nil {
+		t.Errorf("CreateApplication(confirmed): %v", err)
+	}
+}
+
+func TestCreateApplication_UnknownCompanyIsNoDuplicate(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "", "Go Developer", "")
+	repo.addJob("job-2", "", "Go Developer", "")
+	repo.addApp("app-old", "user-1", "job-1", kanban.StatusApplied)
+	svc := newMemService(repo, kanban.Options{})
+
+	if _, err := svc.CreateApplication(context.Background(), "user-1", "job-2", false); err != nil {
+		t.Errorf("CreateApplication: %v, want no duplicate without a company", err)
+	}
+}
+
+func TestCreateApplication_ExistingCardQueuesNothing(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addApp("app-old", "user-1", "job-1", kanban.StatusInterview)
+	svc := newMemService(repo, kanban.Options{})
+
+	app, err := svc.CreateApplication(context.Background(), "user-1", "job-1", true)
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+	if app.ID != "app-old" || app.CurrentStatus != string(kanban.StatusInterview) {
+		t.Errorf("CreateApplication = %s at %s, want the existing app-old", app.ID, app.CurrentStatus)
+	}
+	if got := repo.streams(); len(got) != 0 {
+		t.Errorf("events = %v, want none", got)
+	}
+}
+
+func TestMoveCard_QueuesCardMoved(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addApp("app-1", "user-1", "job-1", kanban.StatusToApply)
+	svc := newMemService(repo, kanban.Options{})
+
+	app, err := svc.MoveCard(context.Background(), "user-1", "app-1", "APPLIED", "", "", "sent on Monday")
+	if err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	h := historyOf(t, app)
+	if len(h) != 1 || h[0].From != "TO_APPLY" || h[0].To != "APPLIED" || h[0].Reason != "sent on Monday" {
+		t.Errorf("history = %+v, want the TO_APPLY → APPLIED move with its reason", h)
+	}
+	if got := repo.streams(); fmt.Sprint(got) != "[EVENT_CARD_MOVED]" {
+		t.Fatalf("events = %v, want [EVENT_CARD_MOVED]", got)
+	}
+	ev := repo.state.events[0].Payload
+	if ev["from"] != "TO_APPLY" || ev["to"] != "APPLIED" || ev["userId"] != "user-1" {
+		t.Errorf("EVENT_CARD_MOVED = %v", ev)
+	}
+}
+
+func TestMoveCard_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(*memRepo)
+		userID string
+		to     string
+		want   func(error) bool
+	}{
+		{
+			name:   "forbidden transition",
+			to:     "HIRED",
+			userID: "user-1",
+			want:   func(err error) bool { var v *kanban.ValidationError; return errors.As(err, &v) },
+		},
+		{
+			name:   "archived card",
+			setup:  func(r *memRepo) { a := r.state.apps["app-1"]; a.archived = true; r.state.apps["app-1"] = a },
+			to:     "APPLIED",
+			userID: "user-1",
+			want:   func(err error) bool { var v *kanban.ValidationError; return errors.As(err, &v) },
+		},
+		{
+			name:   "someone else's card",
+			to:     "APPLIED",
+			userID: "user-2",
+			want:   func(err error) bool { return errors.Is(err, kanban.ErrNotFound) },
+		},
+		{
+			name:   "unknown status",
+			to:     "DREAMING",
+			userID: "user-1",
+			want:   func(err error) bool { var v *kanban.ValidationError; return errors.As(err, &v) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemRepo()
+			repo.addJob("job-1", "Acme", "Go Developer", "")
+			repo.addApp("app-1", "user-1", "job-1", kanban.StatusToApply)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			svc := newMemService(repo, kanban.Options{})
+
+			_, err := svc.MoveCard(context.Background(), tt.userID, "app-1", tt.to, "", "", "")
+			if !tt.want(err) {
+				t.Errorf("MoveCard = %v", err)
+			}
+			if got := repo.app("app-1").status; got != kanban.StatusToApply {
+				t.Errorf("status = %s, want TO_APPLY unchanged", got)
+			}
+			if got := repo.streams(); len(got) != 0 {
+				t.Errorf("events = %v, want none", got)
+			}
+		})
+	}
+}
+
+func TestMoveCard_UserTransitions(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addApp("app-1", "user-1", "job-1", kanban.StatusToApply)
+	repo.state.transitions["user-1"] = []kanban.Transition{{From: kanban.StatusToApply, To: kanban.StatusInterview}}
+	svc := newMemService(repo, kanban.Options{})
+
+	if _, err := svc.MoveCard(context.Background(), "user-1", "app-1", "INTERVIEW", "", "", ""); err != nil {
+		t.Errorf("MoveCard with the user's extra transition: %v", err)
+	}
+}
+
+func TestBulkMove_PerCardResults(t *testing.T) {
+	repo := newMemRepo()
+	repo.addJob("job-1", "Acme", "Go Developer", "")
+	repo.addJob("job-2", "Globex", "SRE", "")
+	repo.addJob("job-3", "Initech", "Data Engineer", "")
+	repo.addApp("app-1", "user-1", "job-1", kanban.StatusApplied)
+	repo.addApp("app-2", "user-1", "job-2", kanban.StatusHired)
+	repo.addApp("app-3", "user-2", "job-3", kanban.StatusApplied)
+	svc := newMemService(repo, kanban.Options{})
+
+	results, err := svc.BulkMove(context.Background(), "user-1", []string{"app-1", "app-2", "app-3", "app-1"},
+		"REJECTED", kanban.RejectionAfterScreening, "INTERVIEW", "position filled")
+	if err != nil {
+		t.Fatalf("BulkMove: %v", err)
+	}
+	if len(results) != 3 || results[0].App == nil || results[1].Err == nil || !errors.Is(results[2].Err, kanban.ErrNotFound) {
+		t.Fatalf("BulkMove = %+v, want app-1 moved, app-2 refused, app-3 not found", results)
+	}
+	app := results[0].App
+	h := historyOf(t, app)
+	if len(h) != 1 || h[0].Reason != "position filled" || h[0].RejectionReason != kanban.RejectionAfterScreening || h[0].RejectionStage != "INTERVIEW" {
+		t.Errorf("history = %+v, want the move with its reason and rejection details", h)
+	}
+	if got := repo.app("app-2").status; got != kanban.StatusHired {
+		t.Errorf("refused card status = %s, want HIRED unchanged", got)
+	}
+	if got := repo.streams(); fmt.Sprint(got) != "[EVENT_CARD_MOVED]" {
+		t.Errorf("events = %v, want one EVENT_CARD_MOVED", got)
+	}
+}
+
+// hiredRepo returns a repo with an OFFER card of user-1 whose job came from
+// search-1 (archive_on_hired = archive), or from no search if search is false.
+func hiredRepo(search, archive bool) *memRepo {
+	repo := newMemRepo()
+	searchID := ""
+	if search {
+		searchID = "search-1"
+		repo.addSearch(searchID, archive)
+	}
+	repo.addJob("job-1", "Acme", "Go Developer", searchID)
+	repo.addApp("app-1", "user-1", "job-1", kanban.StatusOffer)
+	return repo
+}
+
+func TestMoveCard_HiredSearchArchival(t *testing.T) {
+	tests := []struct {
+		name       string
+		search     bool
+		archive    bool
+		inactive   bool
+		wantKind   string // of the entry after the move, "" = none
+		wantActive bool
+	}{
+		{name: "archived", search: true, archive: true, wantKind: kanban.HistorySearchArchived},
+		{name: "kept", search: true, archive: false, wantKind: kanban.HistorySearchKept, wantActive: true},
+		{name: "already inactive", search: true, archive: true, inactive: true},
+		{name: "no search"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := hiredRepo(tt.search, tt.archive)
+			if tt.inactive {
+				s := repo.state.searches["search-1"]
+				s.Active = false
+				repo.state.searches["search-1"] = s
+			}
+			svc := newMemService(repo, kanban.Options{})
+
+			app, err := svc.MoveCard(context.Background(), "user-1", "app-1", "HIRED", "", "", "")
+			if err != nil {
+				t.Fatalf("MoveCard: %v", err)
+			}
+			h := historyOf(t, app)
+			gotKind := ""
+			if len(h) == 2 {
+				gotKind = h[1].Kind
+				if h[1].SearchConfigID != "search-1" {
+					t.Errorf("search entry names %q, want search-1", h[1].SearchConfigID)
+				}
+			}
+			if !h[0].IsMove() || gotKind != tt.wantKind {
+				t.Errorf("history = %+v, want the move then %q", h, tt.wantKind)
+			}
+			if tt.search {
+				if got := repo.search("search-1").Active; got != tt.wantActive {
+					t.Errorf("search active = %t, want %t", got, tt.wantActive)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RetentionMode is what ApplyClosedRetention does with a user's closed
+// (REJECTED, WITHDRAWN) applications once Options.ClosedRetentionMonths
+// went by without an update (Settings.ClosedRetention).
+type RetentionMode string
+
+const (
+	// RetentionCompact keeps a summary of the card — its job, status,
+	// dates, rejection reason and stage, moves, notes and rating — and drops
+	// the bulky rest: the AI analysis but its score, cover letters,
+	// follow-up drafts, the other history entries and the job's scraped
+	// payload and description.
+	RetentionCompact RetentionMode = "COMPACT"
+	// RetentionDelete deletes the card with everything attached to it.
+	RetentionDelete RetentionMode = "DELETE"
+)
+
+// ParseRetentionMode validates a retention mode; "" means RetentionCompact.
+func ParseRetentionMode(s string) (RetentionMode, error) {
+	switch m := RetentionMode(s); m {
+	case "":
+		return RetentionCompact, nil
+	case RetentionCompact, RetentionDelete:
+		return m, nil
+	}
+	return "", &ValidationError{Field: "closed_retention", Msg: fmt.Sprintf("unknown retention mode %q (COMPACT or DELETE)", s)}
+}
+
+// retentionBatch is how many applications one retention transaction handles.
+const retentionBatch = 200
+
+// Retention statements ($1 = application IDs), reported as "<mode> <table>".
+// Compaction drops the search index rows too: refreshSearchIndex rebuilds
+// them without the cover letter. Offers linked to other configs
+// (job_feed_matches) are shared and keep their payload.
+var (
+	compactSteps = []erasureStep{
+		{"cover_letter_versions", `DELETE FROM cover_letter_versions WHERE application_id = ANY($1::uuid[])`},
+		{"application_search", `DELETE FROM application_search WHERE application_id = ANY($1::uuid[])`},
+		{"application_last_actions", `DELETE FROM application_last_actions WHERE application_id = ANY($1::uuid[])`},
+		{"job_feed", `UPDATE job_feed jf
+		              SET raw_data = jsonb_strip_nulls(jsonb_build_object(
+		                    'title', jf.raw_data->'title', 'company', jf.raw_data->'company',
+		                    'location', jf.raw_data->'location', 'url', jf.raw_data->'url')),
+		                  description = NULL
+		              FROM applications a
+		              WHERE a.id = ANY($1::uuid[]) AND jf.id = a.job_feed_id
+		                AND NOT EXISTS (SELECT 1 FROM job_feed_matches m WHERE m.job_feed_id = jf.id)`},
+		{"applications", `UPDATE applications
+		                  SET ai_analysis = jsonb_strip_nulls(jsonb_build_object('score', ai_analysis->'score')),
+		                      generated_cover_letter = NULL, followup_draft = NULL, followup_draft_at = NULL,
+		                      history_log = (SELECT COALESCE(jsonb_agg(h.e ORDER BY h.i), '[]')
+		                                     FROM jsonb_array_elements(history_log) WITH ORDINALITY AS h(e, i)
+		                                     WHERE COALESCE(h.e->>'kind', 'MOVE') = 'MOVE'),
+		                      compacted_at = NOW()
+		                  WHERE id = ANY($1::uuid[])`},
+	}
+	// Children go with the applications (ON DELETE CASCADE); manual jobs
+	// are the tracker's own and go too.
+	deleteSteps = []erasureStep{
+		{"job_feed", `DELETE FROM job_feed
+		              WHERE is_manual AND id IN (SELECT job_feed_id FROM applications WHERE id = ANY($1::uuid[]))`},
+		{"applications", `DELETE FROM applications WHERE id = ANY($1::uuid[])`},
+	}
+)
+
+// ApplyClosedRetention compacts or deletes, as their owners' settings say,
+// the REJECTED and WITHDRAWN applications not updated for
+// Options.ClosedRetentionMonths, and returns how many it handled. Compacted
+// cards are only picked again when their owner switches to
+// RetentionDelete. Attachment files of deleted cards are deleted once their
+// batch commits, best-effort. Does nothing when ClosedRetentionMonths is 0.
+//
+// Compacting leaves updated_at untouched (the jobmate.retention setting
+// turns its trigger off), so compacted cards keep their place on the board.
+func (s *Service) ApplyClosedRetention(ctx context.Context) (int, error) {
+	if s.opts.ClosedRetentionMonths <= 0 {
+		return 0, nil
+	}
+	total := 0
+	counts := make(map[string]int64)
+	users := make(map[string]bool)
+	for {
+		n, err := s.retentionBatch(ctx, counts, users)
+		if err != nil {
+			return total, fmt.Errorf("applyClosedRetention: %w", err)
+		}
+		total += n
+		if n < retentionBatch {
+			break
+		}
+	}
+	for userID := range users {
+		s.InvalidateUserCache(ctx, userID)
+	}
+	if total > 0 {
+		slog.Info("closed applications retained", "count", total, "rows", counts)
+	}
+	return total, nil
+}
+
+// retentionBatch compacts or deletes up to retentionBatch applications, the
+// oldest first, adding the rows it touched to counts and their owners to
+// users, and returns how many applications it handled.
+func (s *Service) retentionBatch(ctx context.Context, counts map[string]int64, users map[string]bool) (int, error) {
+	var (
+		n    int
+		keys []string
+	)
+	batchCounts := make(map[string]int64)
+	batchUsers := make(map[string]bool)
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		n, keys = 0, nil
+		clear(batchCounts) // from a failed run
+		clear(batchUsers)
+		if _, err := tx.Exec(ctx, `SELECT set_config('jobmate.retention', 'on', true)`); err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx,
+			`SELECT a.id::text, a.user_id::text, COALESCE(ts.closed_retention, 'COMPACT')
+			 FROM applications a
+			 LEFT JOIN tracker_settings ts ON ts.user_id = a.user_id
+			 WHERE a.current_status IN ('REJECTED', 'WITHDRAWN')
+			   AND a.updated_at < NOW() - make_interval(months => $1)
+			   AND (a.compacted_at IS NULL OR ts.closed_retention = 'DELETE')
+			 ORDER BY a.updated_at
+			 LIMIT $2
+			 FOR UPDATE OF a SKIP LOCKED`,
+			s.opts.ClosedRetentionMonths, retentionBatch)
+		if err != nil {
+			return err
+		}
+		byMode := make(map[RetentionMode][]string)
+		for rows.Next() {
+			var appID, userID string
+			var mode RetentionMode
+			if err := rows.Scan(&appID, &userID, &mode); err != nil {
+				rows.Close()
+				return err
+			}
+			byMode[mode] = append(byMode[mode], appID)
+			batchUsers[userID] = true
+			n++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if ids := byMode[RetentionDelete]; len(ids) > 0 {
+			rows, err := tx.Query(ctx, `SELECT object_key FROM attachments WHERE application_id = ANY($1::uuid[])`, ids)
+			if err != nil {
+				return fmt.Errorf("attachments: %w", err)
+			}
+			if keys, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+				return fmt.Errorf("attachments: %w", err)
+			}
+		}
+		for mode, steps := range map[RetentionMode][]erasureStep{RetentionCompact: compactSteps, RetentionDelete: deleteSteps} {
+			ids := byMode[mode]
+			if len(ids) == 0 {
+				continue
+			}
+			for _, step := range steps {
+				tag, err := tx.Exec(ctx, step.query, ids)
+				if err != nil {
+					return fmt.Errorf("%s %s: %w", mode, step.table, err)
+				}
+				if k := tag.RowsAffected(); k > 0 {
+					batchCounts[string(mode)+" "+step.table] += k
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if s.opts.Storage != nil {
+		for _, key := range keys {
+			if err := s.opts.Storage.Delete(ctx, key); err != nil {
+				slog.Warn("retained attachment object not deleted", "key", key, "err", err)
+			}
+		}
+	}
+	for table, k := range batchCounts {
+		counts[table] += k
+	}
+	for userID := range batchUsers {
+		users[userID] = true
+	}
+	return n, nil
+}
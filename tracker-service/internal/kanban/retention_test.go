@@ -0,0 +1,28 @@
+package kanban_test
+
+import (
+	"errors"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseRetentionMode(t *testing.T) {
+	cases := map[string]kanban.RetentionMode{
+		"":        kanban.RetentionCompact,
+		"COMPACT": kanban.RetentionCompact,
+		"DELETE":  kanban.RetentionDelete,
+	}
+	for in, want := range cases {
+		got, err := kanban.ParseRetentionMode(in)
+		if err != nil || got != want {
+			t.Errorf("ParseRetentionMode(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	_, err := kanban.ParseRetentionMode("ARCHIVE")
+	var ve *kanban.ValidationError
+	if !errors.As(err, &ve) || ve.Field != "closed_retention" {
+		t.Errorf("ParseRetentionMode(ARCHIVE) err = %v, want ValidationError on closed_retention", err)
+	}
+}
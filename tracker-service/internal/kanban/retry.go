@@ -0,0 +1,87 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Transient PostgreSQL errors — a serialization failure, a deadlock, a
+// connection reset or a failover — are retried so that a brief hiccup does
+// not reach the user as an internal error. Only work known not to have
+// taken effect is run again: a read, or a transaction that failed before
+// its commit was sent (or whose commit the server refused). A commit lost
+// with its connection may have been applied and is never retried.
+
+const (
+	// maxDBAttempts bounds how many times an operation is run.
+	maxDBAttempts = 3
+	// dbRetryBackoff is the delay before the first retry, doubled after.
+	dbRetryBackoff = 50 * time.Millisecond
+)
+
+// commitError marks a failed COMMIT, whose outcome may be unknown.
+type commitError struct{ err error }
+
+func (e *commitError) Error() string { return "commit: " + e.err.Error() }
+func (e *commitError) Unwrap() error { return e.err }
+
+// retry runs fn until it succeeds, fails with an error that is not
+// transient (see retryable) or has run maxDBAttempts times. fn must only
+// touch the database: it may run more than once.
+func (s *Service) retry(ctx context.Context, fn func() error) error {
+	backoff := dbRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt == maxDBAttempts || !retryable(err) {
+			return err
+		}
+		slog.WarnContext(ctx, "Transient database error, retrying", "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// retryable reports whether err is transient and the work that failed
+// certainly did not take effect.
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", // serialization_failure
+			"40P01": // deadlock_detected
+			return true // the server rolled the transaction back, commit included
+		case "57P01", // admin_shutdown
+			"57P02", // crash_shutdown
+			"57P03": // cannot_connect_now (starting up, e.g. after a failover)
+			return !isCommitError(err)
+		}
+		return strings.HasPrefix(pgErr.Code, "08") && !isCommitError(err) // connection_exception
+	}
+	if pgconn.SafeToRetry(err) {
+		return true // nothing was sent to the server
+	}
+	if isCommitError(err) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isCommitError(err error) bool {
+	var ce *commitError
+	return errors.As(err, &ce)
+}
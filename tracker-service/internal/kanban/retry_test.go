@@ -0,0 +1,42 @@
+package kanban_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestRetryable(t *testing.T) {
+	pgErr := func(code string) error { return fmt.Errorf("moveCard: %w", &pgconn.PgError{Code: code}) }
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", pgErr("40001"), true},
+		{"deadlock", pgErr("40P01"), true},
+		{"serialization failure at commit", kanban.CommitError(pgErr("40001")), true},
+		{"admin shutdown", pgErr("57P01"), true},
+		{"connection failure", pgErr("08006"), true},
+		{"connection failure at commit", kanban.CommitError(pgErr("08006")), false},
+		{"connection reset", fmt.Errorf("query: %w", io.ErrUnexpectedEOF), true},
+		{"connection reset at commit", kanban.CommitError(io.ErrUnexpectedEOF), false},
+		{"unique violation", pgErr("23505"), false},
+		{"no rows", pgx.ErrNoRows, false},
+		{"not found", kanban.ErrNotFound, false},
+		{"cancelled", fmt.Errorf("query: %w", context.Canceled), false},
+		{"other", errors.New("boom"), false},
+	}
+	for _, c := range cases {
+		if got := kanban.Retryable(c.err); got != c.want {
+			t.Errorf("%s: retryable = %t, want %t", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"jobmate/tracker-service/internal/secretbox"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// What users write about their applications — notes, cover letters,
+// reviews, offer amounts and details, negotiation terms — is stored sealed
+// with Options.FieldKeys (AES-256-GCM, see secretbox.Keyring), so a database
+// dump does not reveal it. Values are sealed here before they are written and
+// opened as they are scanned (sealedText, sealedAmounts). Values in clear —
+// written before encryption was enabled, or by the AI Coach, which holds no
+// key — are read as they are, and sealed by ResealFields. Values that look
+// sealed but do not open are read as they are too (see
+// secretbox.Keyring.Reveal), so that one bad value does not fail a board.
+
+// sealedColumns are the sealed columns, with their table's key.
+var sealedColumns = []struct{ table, key, column string }{
+	{"applications", "id", "user_notes"},
+	{"applications", "id", "generated_cover_letter"},
+	{"applications", "id", "followup_draft"},
+	{"applications", "id", "rating_review"},
+	{"application_notes", "id", "body"},
+	{"application_template_notes", "id", "body"},
+	{"cover_letter_versions", "id", "body"},
+	{"offers", "application_id", "compensation"},
+	{"offers", "application_id", "equity_details"},
+	{"offer_negotiations", "id", "compensation"},
+	{"offer_negotiations", "id", "note"},
+}
+
+// resealBatch is how many values ResealFields rewrites per transaction.
+const resealBatch = 500
+
+// seal seals a value for a sealed column. An empty value stays empty, so
+// that the NULLIF of the statements writing it still stores NULL.
+func (s *Service) seal(plaintext string) string {
+	if plaintext == "" {
+		return ""
+	}
+	return s.opts.FieldKeys.Seal(plaintext)
+}
+
+// sealed returns a Scan destination opening a sealed column into dst, a
+// *string or, for nullable columns, a **string.
+func (s *Service) sealed(dst any) sealedText {
+	return sealedText{keys: s.opts.FieldKeys, dst: dst}
+}
+
+// sealedText is a sql.Scanner opening a sealed text column.
+type sealedText struct {
+	keys *secretbox.Keyring
+	dst  any
+}
+
+// Scan implements sql.Scanner.
+func (t sealedText) Scan(src any) error {
+	if src == nil {
+		switch d := t.dst.(type) {
+		case *string:
+			*d = ""
+		case **string:
+			*d = nil
+		}
+		return nil
+	}
+	value, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("sealed column: unexpected %T", src)
+	}
+	plaintext := t.keys.Reveal(value)
+	switch d := t.dst.(type) {
+	case *string:
+		*d = plaintext
+	case **string:
+		*d = &plaintext
+	default:
+		return fmt.Errorf("sealed column: unsupported destination %T", t.dst)
+	}
+	return nil
+}
+
+// sealCached returns app as stored in cache payloads (readThrough,
+// Idempotent): with its sealed fields sealed, so that Redis does not hold
+// them in clear, and without its offer, which only GetApplication loads.
+func (s *Service) sealCached(app Application) Application {
+	for _, f := range cachedSealedFields(&app) {
+		if *f != nil {
+			v := s.seal(**f)
+			*f = &v
+		}
+	}
+	app.Offer = nil
+	return app
+}
+
+// openCached opens the sealed fields of an application read from a cache
+// payload.
+func (s *Service) openCached(app *Application) {
+	for _, f := range cachedSealedFields(app) {
+		if *f != nil {
+			v := s.opts.FieldKeys.Reveal(**f)
+			*f = &v
+		}
+	}
+}
+
+// cachedSealedFields are the fields of app read from sealed columns.
+func cachedSealedFields(app *Application) []**string {
+	return []**string{&app.GeneratedCoverLetter, &app.UserNotes, &app.FollowUpDraft, &app.RatingReview}
+}
+
+// compensation is the JSON, sealed, of the amounts of an offer or a
+// negotiation step (the compensation columns). Zero amounts are left out.
+type compensation struct {
+	BaseSalary  int64 `json:"baseSalary,omitempty"`
+	Bonus       int64 `json:"bonus,omitempty"`
+	EquityValue int64 `json:"equityValue,omitempty"`
+}
+
+// sealCompensation returns the compensation column value for the amounts,
+// "" when they are all zero.
+func (s *Service) sealCompensation(baseSalary, bonus, equityValue int64) string {
+	if baseSalary == 0 && bonus == 0 && equityValue == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(compensation{BaseSalary: baseSalary, Bonus: bonus, EquityValue: equityValue})
+	return s.seal(string(raw))
+}
+
+// sealedAmounts returns a Scan destination opening a compensation column
+// into the three amounts (0 when NULL).
+func (s *Service) sealedAmounts(baseSalary, bonus, equityValue *int64) sealedAmountsDest {
+	return sealedAmountsDest{keys: s.opts.FieldKeys, base: baseSalary, bonus: bonus, equity: equityValue}
+}
+
+// sealedAmountsDest is a sql.Scanner opening a compensation column.
+type sealedAmountsDest struct {
+	keys                *secretbox.Keyring
+	base, bonus, equity *int64
+}
+
+// Scan implements sql.Scanner.
+func (d sealedAmountsDest) Scan(src any) error {
+	var raw string
+	if err := (sealedText{keys: d.keys, dst: &raw}).Scan(src); err != nil {
+		return err
+	}
+	var c compensation
+	if raw != "" {
+		if err := json.Unmarshal([]byte(raw), &c); err != nil {
+			return fmt.Errorf("compensation column: %w", err)
+		}
+	}
+	*d.base, *d.bonus, *d.equity = c.BaseSalary, c.Bonus, c.EquityValue
+	return nil
+}
+
+// ResealFields seals, under the active key, the values of sealedColumns
+// stored in clear or under an older key, and returns how many it rewrote.
+// Run it after enabling encryption or adding a key; once it has nothing left
+// to do, keys other than the active one can be removed. Values it cannot
+// open (sealed under a key no longer configured) are logged and left as
+// they are. Does nothing without FieldKeys.
+//
+// Rewrites leave updated_at and the cover letter history untouched (the
+// jobmate.reseal setting turns those triggers off).
+func (s *Service) ResealFields(ctx context.Context) (int, error) {
+	keys := s.opts.FieldKeys
+	if keys == nil {
+		return 0, nil
+	}
+	total := 0
+	for _, c := range sealedColumns {
+		after := ""
+		for {
+			n, last, err := s.resealBatch(ctx, c.table, c.key, c.column, after)
+			if err != nil {
+				return total, fmt.Errorf("resealFields %s.%s: %w", c.table, c.column, err)
+			}
+			total += n
+			if last == "" {
+				break
+			}
+			after = last
+		}
+	}
+	if total > 0 {
+		slog.Info("sealed fields rewritten", "count", total, "keyId", keys.ActiveKeyID())
+	}
+	return total, nil
+}
+
+// resealBatch reseals up to resealBatch values of table.column with keys
+// greater than after, and returns how many it rewrote and the last key it
+// looked at ("" when there are no more).
+func (s *Service) resealBatch(ctx context.Context, table, key, column, after string) (n int, last string, err error) {
+	keys := s.opts.FieldKeys
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		n, last = 0, ""
+		if _, err := tx.Exec(ctx, `SELECT set_config('jobmate.reseal', 'on', true)`); err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx,
+			`SELECT `+key+`::text, `+column+` FROM `+table+`
+			 WHERE `+column+` IS NOT NULL AND `+column+` <> ''
+			   AND `+column+` NOT LIKE 'enc:' || $1 || ':%'
+			   AND `+key+`::text > $2
+			 ORDER BY `+key+`::text
+			 LIMIT $3
+			 FOR UPDATE SKIP LOCKED`,
+			keys.ActiveKeyID(), after, resealBatch)
+		if err != nil {
+			return err
+		}
+		type stale struct{ id, value string }
+		var batch []stale
+		for rows.Next() {
+			var v stale
+			if err := rows.Scan(&v.id, &v.value); err != nil {
+				rows.Close()
+				return err
+			}
+			batch = append(batch, v)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, v := range batch {
+			last = v.id
+			plaintext, err := keys.Open(v.value)
+			if err != nil {
+				slog.Warn("sealed field not resealed", "table", table, "column", column, "key", v.id, "err", err)
+				continue
+			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE `+table+` SET `+column+` = $1 WHERE `+key+` = $2`,
+				keys.Seal(plaintext), v.id,
+			); err != nil {
+				return err
+			}
+			n++
+		}
+		if len(batch) < resealBatch {
+			last = ""
+		}
+		return nil
+	})
+	return n, last, err
+}
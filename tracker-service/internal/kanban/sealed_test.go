@@ -0,0 +1,131 @@
+package kanban_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+	"jobmate/tracker-service/internal/secretbox"
+)
+
+func sealingKeys(t *testing.T) *secretbox.Keyring {
+	t.Helper()
+	keys, err := secretbox.ParseKeyring("k1:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func sealingService(t *testing.T) *kanban.Service {
+	t.Helper()
+	return kanban.NewService(nil, nil, kanban.Options{FieldKeys: sealingKeys(t)})
+}
+
+func TestSealedColumns(t *testing.T) {
+	svc := sealingService(t)
+
+	sealed := kanban.ServiceSeal(svc, "Recruiter said the budget is flexible")
+	if !strings.HasPrefix(sealed, "enc:k1:") || strings.Contains(sealed, "budget") {
+		t.Fatalf("seal = %q, want a value sealed under k1", sealed)
+	}
+	if got := kanban.ServiceSeal(svc, ""); got != "" {
+		t.Errorf("seal(\"\") = %q, want \"\" (stored as NULL)", got)
+	}
+
+	var note *string
+	if err := svc.SealedDest(&note).Scan(sealed); err != nil || note == nil || *note != "Recruiter said the budget is flexible" {
+		t.Errorf("scan sealed = %v, %v", note, err)
+	}
+	if err := svc.SealedDest(&note).Scan(nil); err != nil || note != nil {
+		t.Errorf("scan NULL = %v, %v; want nil", note, err)
+	}
+	var legacy string
+	if err := svc.SealedDest(&legacy).Scan("written in clear"); err != nil || legacy != "written in clear" {
+		t.Errorf("scan clear value = %q, %v; want it as is", legacy, err)
+	}
+
+	// Values that do not open are read as they are rather than failing the
+	// board.
+	other := kanban.NewService(nil, nil, kanban.Options{})
+	if err := other.SealedDest(&legacy).Scan(sealed); err != nil || legacy != sealed {
+		t.Errorf("scan sealed value without keys = %q, %v; want it as is", legacy, err)
+	}
+	if err := svc.SealedDest(&legacy).Scan("enc:k1:typed by the user"); err != nil || legacy != "enc:k1:typed by the user" {
+		t.Errorf("scan look-alike value = %q, %v; want it as is", legacy, err)
+	}
+}
+
+// Cache payloads hold sealed fields sealed, and read back opened.
+func TestSealedCachePayload(t *testing.T) {
+	svc := sealingService(t)
+	note, letter := "Salary talk went well", "Dear hiring manager"
+	app := kanban.Application{ID: "a1", UserNotes: &note, GeneratedCoverLetter: &letter, Offer: &kanban.Offer{BaseSalary: 70000}}
+
+	payload, err := json.Marshal(kanban.ServiceSealCached(svc, app))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(payload), "Salary") || strings.Contains(string(payload), "hiring") || strings.Contains(string(payload), "70000") {
+		t.Fatalf("cache payload holds plaintext: %s", payload)
+	}
+	if *app.UserNotes != note {
+		t.Error("sealCached modified its argument")
+	}
+
+	var cached kanban.Application
+	if err := json.Unmarshal(payload, &cached); err != nil {
+		t.Fatal(err)
+	}
+	kanban.ServiceOpenCached(svc, &cached)
+	if cached.UserNotes == nil || *cached.UserNotes != note || *cached.GeneratedCoverLetter != letter || cached.FollowUpDraft != nil {
+		t.Errorf("openCached = %+v, want the fields opened", cached)
+	}
+}
+
+func TestSealedCompensation(t *testing.T) {
+	svc := sealingService(t)
+
+	if got := kanban.ServiceSealCompensation(svc, 0, 0, 0); got != "" {
+		t.Errorf("sealCompensation(0, 0, 0) = %q, want \"\"", got)
+	}
+	sealed := kanban.ServiceSealCompensation(svc, 65000, 5000, 0)
+	if strings.Contains(sealed, "65000") {
+		t.Fatalf("sealCompensation = %q, contains the amount", sealed)
+	}
+
+	var base, bonus, equity int64
+	if err := svc.SealedAmountsDest(&base, &bonus, &equity).Scan(sealed); err != nil {
+		t.Fatal(err)
+	}
+	if base != 65000 || bonus != 5000 || equity != 0 {
+		t.Errorf("amounts = %d, %d, %d; want 65000, 5000, 0", base, bonus, equity)
+	}
+	if err := svc.SealedAmountsDest(&base, &bonus, &equity).Scan(nil); err != nil || base != 0 || bonus != 0 {
+		t.Errorf("scan NULL = %d, %d, %v; want zeros", base, bonus, err)
+	}
+}
+
+func TestOpenExportRows(t *testing.T) {
+	svc := sealingService(t)
+	rows, _ := json.Marshal([]map[string]any{{
+		"application_id": "a1",
+		"compensation":   kanban.ServiceSealCompensation(svc, 70000, 0, 0),
+		"equity_details": kanban.ServiceSeal(svc, "0.1% over 4 years"),
+	}, {
+		"application_id": "a2",
+		"compensation":   nil,
+		"equity_details": "in clear",
+	}})
+
+	out, err := kanban.ServiceOpenExportRows(svc, rows, []string{"compensation", "equity_details"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `[{"application_id":"a1","compensation":{"baseSalary":70000},"equity_details":"0.1% over 4 years"},` +
+		`{"application_id":"a2","compensation":null,"equity_details":"in clear"}]`
+	if string(out) != want {
+		t.Errorf("openExportRows =\n%s\nwant\n%s", out, want)
+	}
+}
@@ -0,0 +1,459 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SearchApplications finds applications by the words of their job title and
+// company (ranked highest), notes (next) and cover letter, with PostgreSQL
+// full-text search (Options.SearchTextConfig stems words and drops stop
+// words).
+//
+// Notes and cover letters are sealed, so the index (application_search)
+// holds keyed hashes of their lexemes (secretbox.Keyring.Token), not the
+// lexemes themselves; without FieldKeys it holds the lexemes. It is brought
+// up to date, for the user searching, before each search: rows of
+// applications changed since they were indexed, or indexed under another
+// configuration or key, are rebuilt.
+
+// Search highlight fields (SearchHighlight.Field).
+const (
+	SearchFieldTitle       = "TITLE"
+	SearchFieldCompany     = "COMPANY"
+	SearchFieldNote        = "NOTE"
+	SearchFieldCoverLetter = "COVER_LETTER"
+)
+
+// SearchPage is one page of SearchApplications results, best matches first.
+type SearchPage struct {
+	Hits          []SearchHit `json:"hits"`
+	NextPageToken string      `json:"nextPageToken"` // "" on the last page
+}
+
+// SearchHit is an application found by SearchApplications.
+type SearchHit struct {
+	Application Application       `json:"application"` // without history_log
+	Rank        float32           `json:"rank"`
+	Highlights  []SearchHighlight `json:"highlights"` // at most maxSearchHighlights
+}
+
+// SearchHighlight is a fragment of a field that matched, with the matching
+// words located.
+type SearchHighlight struct {
+	Field   string      `json:"field"`
+	NoteID  string      `json:"noteId,omitempty"` // SearchFieldNote only
+	Text    string      `json:"text"`
+	Matches []TextRange `json:"matches"`
+}
+
+// TextRange locates a match in a text, in characters (Unicode code points).
+type TextRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// SearchApplications limits.
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+	maxSearchQueryLen     = 200
+	maxSearchHighlights   = 3
+	// searchIndexBatch is how many applications a search indexes per query.
+	searchIndexBatch = 200
+)
+
+// Matches in ts_headline output are delimited by control characters, which
+// cleanText strips from what users write.
+const (
+	headlineStart   = '\x01'
+	headlineStop    = '\x02'
+	headlineOptions = "StartSel=\x01, StopSel=\x02, MinWords=10, MaxWords=30, MaxFragments=2"
+)
+
+// SearchApplications returns a page of the user's applications matching
+// query, best matches first, each with up to maxSearchHighlights fragments
+// of the fields that matched. Applications matching any of the query's
+// words are returned, those matching more of them (or in their title or
+// company) ranking higher. Archived applications are left out unless
+// includeArchived. pageSize 0 means defaultSearchPageSize; pageToken is the
+// NextPageToken of the previous page ("" = first page).
+func (s *Service) SearchApplications(ctx context.Context, userID, query string, includeArchived bool, pageSize int, pageToken string) (*SearchPage, error) {
+	query, err := cleanText("query", query, maxSearchQueryLen)
+	if err != nil {
+		return nil, err
+	}
+	if query == "" {
+		return nil, &ValidationError{Field: "query", Msg: "query must not be empty"}
+	}
+	size, offset, err := parseSearchPage(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SearchPage{Hits: make([]SearchHit, 0)}
+	var terms []string
+	if err := s.pool.QueryRow(ctx,
+		`SELECT tsvector_to_array(to_tsvector($1::regconfig, $2))`,
+		s.searchTextConfig(), query,
+	).Scan(&terms); err != nil {
+		return nil, fmt.Errorf("searchApplications terms: %w", err)
+	}
+	if len(terms) == 0 {
+		return page, nil // only stop words
+	}
+	if err := s.refreshSearchIndex(ctx, userID); err != nil {
+		return nil, fmt.Errorf("searchApplications index: %w", err)
+	}
+
+	tokens := make([]string, len(terms))
+	for i, t := range terms {
+		tokens[i] = s.opts.FieldKeys.Token(t)
+	}
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+appListColumns("a")+`, ts_rank(x.document, $2::tsquery) AS rank
+		 FROM application_search x
+		 JOIN applications a ON a.id = x.application_id
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE x.user_id = $1 AND a.user_id = $1
+		   AND x.document @@ $2::tsquery
+		   AND ($3 OR a.archived_at IS NULL)
+		 ORDER BY rank DESC, a.updated_at DESC, a.id
+		 LIMIT $4 OFFSET $5`,
+		userID, tsQuery(tokens), includeArchived, size+1, offset)
+	if err != nil {
+		return nil, fmt.Errorf("searchApplications query: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var hit SearchHit
+		if err := rows.Scan(append(s.appScanDest(&hit.Application), &hit.Rank)...); err != nil {
+			return nil, fmt.Errorf("searchApplications scan: %w", err)
+		}
+		page.Hits = append(page.Hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("searchApplications query: %w", err)
+	}
+	if len(page.Hits) > size {
+		page.Hits = page.Hits[:size]
+		page.NextPageToken = strconv.Itoa(offset + size)
+	}
+
+	if err := s.highlightSearchHits(ctx, userID, page.Hits, terms); err != nil {
+		return nil, fmt.Errorf("searchApplications highlight: %w", err)
+	}
+	return page, nil
+}
+
+// parseSearchPage validates SearchApplications' paging arguments. Tokens
+// are offsets in the results.
+func parseSearchPage(pageSize int, pageToken string) (size, offset int, err error) {
+	switch {
+	case pageSize < 0 || pageSize > maxSearchPageSize:
+		return 0, 0, &ValidationError{Field: "page_size", Msg: fmt.Sprintf("page_size must be between 0 and %d", maxSearchPageSize)}
+	case pageSize == 0:
+		pageSize = defaultSearchPageSize
+	}
+	if pageToken == "" {
+		return pageSize, 0, nil
+	}
+	offset, err = strconv.Atoi(pageToken)
+	if err != nil || offset <= 0 {
+		return 0, 0, &ValidationError{Field: "page_token", Msg: "invalid page_token"}
+	}
+	return pageSize, offset, nil
+}
+
+// searchTextConfig returns the text search configuration in use.
+func (s *Service) searchTextConfig() string {
+	if s.opts.SearchTextConfig == "" {
+		return "english"
+	}
+	return s.opts.SearchTextConfig
+}
+
+// searchVersion identifies how application_search rows are built now: rows
+// of another version are rebuilt.
+func (s *Service) searchVersion() string {
+	return s.searchTextConfig() + ":" + s.opts.FieldKeys.ActiveKeyID()
+}
+
+// refreshSearchIndex rebuilds the user's stale application_search rows.
+func (s *Service) refreshSearchIndex(ctx context.Context, userID string) error {
+	after := ""
+	for {
+		n, last, err := s.indexSearchBatch(ctx, userID, after)
+		if err != nil || n < searchIndexBatch {
+			return err
+		}
+		after = last
+	}
+}
+
+// searchDoc is the text of an application to index.
+type searchDoc struct {
+	id, title, company, notes, letter string
+	updatedAt                         time.Time
+}
+
+// indexSearchBatch rebuilds up to searchIndexBatch stale rows of the user's
+// applications with IDs greater than after, and returns how many it looked
+// at and the last ID.
+func (s *Service) indexSearchBatch(ctx context.Context, userID, after string) (n int, last string, err error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT a.id::text, `+jobTitleExpr+`, `+jobCompanyExpr+`, a.generated_cover_letter, a.updated_at
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 LEFT JOIN application_search x ON x.application_id = a.id
+		 WHERE a.user_id = $1 AND a.id::text > $2
+		   AND (x.application_id IS NULL OR x.indexed_at < a.updated_at OR x.version <> $3)
+		 ORDER BY a.id::text
+		 LIMIT $4`,
+		userID, after, s.searchVersion(), searchIndexBatch)
+	if err != nil {
+		return 0, "", err
+	}
+	var docs []searchDoc
+	byID := make(map[string]*searchDoc)
+	for rows.Next() {
+		var d searchDoc
+		if err := rows.Scan(&d.id, &d.title, &d.company, s.sealed(&d.letter), &d.updatedAt); err != nil {
+			rows.Close()
+			return 0, "", err
+		}
+		docs = append(docs, d)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, "", err
+	}
+	if len(docs) == 0 {
+		return 0, "", nil
+	}
+	ids := make([]string, len(docs))
+	for i := range docs {
+		ids[i] = docs[i].id
+		byID[docs[i].id] = &docs[i]
+	}
+
+	notes, err := s.pool.Query(ctx,
+		`SELECT application_id::text, body FROM application_notes
+		 WHERE application_id = ANY($1::uuid[]) AND user_id = $2
+		 ORDER BY created_at, id`,
+		ids, userID)
+	if err != nil {
+		return 0, "", err
+	}
+	for notes.Next() {
+		var appID, body string
+		if err := notes.Scan(&appID, s.sealed(&body)); err != nil {
+			notes.Close()
+			return 0, "", err
+		}
+		byID[appID].notes += body + "\n"
+	}
+	if err := notes.Err(); err != nil {
+		return 0, "", err
+	}
+
+	heads, bodies, letters := make([]string, len(docs)), make([]string, len(docs)), make([]string, len(docs))
+	for i, d := range docs {
+		heads[i], bodies[i], letters[i] = d.title+"\n"+d.company, d.notes, d.letter
+	}
+	lexemes, err := s.pool.Query(ctx,
+		`SELECT d.i, l.lexeme, array_to_string(l.weights, '')
+		 FROM unnest($2::text[], $3::text[], $4::text[]) WITH ORDINALITY AS d(head, notes, letter, i)
+		 CROSS JOIN LATERAL unnest(setweight(to_tsvector($1::regconfig, d.head), 'A') ||
+		                           setweight(to_tsvector($1::regconfig, d.notes), 'B') ||
+		                           setweight(to_tsvector($1::regconfig, d.letter), 'C')) AS l`,
+		s.searchTextConfig(), heads, bodies, letters)
+	if err != nil {
+		return 0, "", err
+	}
+	weights := make([]map[string]byte, len(docs))
+	for lexemes.Next() {
+		var (
+			i       int64
+			lexeme  string
+			weight  string
+			highest byte = 'D'
+		)
+		if err := lexemes.Scan(&i, &lexeme, &weight); err != nil {
+			lexemes.Close()
+			return 0, "", err
+		}
+		for j := 0; j < len(weight); j++ {
+			highest = min(highest, weight[j]) // 'A' ranks highest
+		}
+		if weights[i-1] == nil {
+			weights[i-1] = make(map[string]byte)
+		}
+		token := s.opts.FieldKeys.Token(lexeme)
+		if w, ok := weights[i-1][token]; !ok || highest < w {
+			weights[i-1][token] = highest
+		}
+	}
+	if err := lexemes.Err(); err != nil {
+		return 0, "", err
+	}
+
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		for i, d := range docs {
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO application_search (application_id, user_id, document, version, indexed_at)
+				 SELECT id, user_id, $3::tsvector, $4, $5 FROM applications WHERE id = $1 AND user_id = $2
+				 ON CONFLICT (application_id) DO UPDATE
+				 SET document = EXCLUDED.document, version = EXCLUDED.version, indexed_at = EXCLUDED.indexed_at`,
+				d.id, userID, tsVector(weights[i]), s.searchVersion(), d.updatedAt,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return len(docs), docs[len(docs)-1].id, err
+}
+
+// tsVector returns the tsvector literal of weighted lexemes. Positions are
+// left out: the index is not meant to hold the text.
+func tsVector(weights map[string]byte) string {
+	entries := make([]string, 0, len(weights))
+	for lexeme, w := range weights {
+		entries = append(entries, tsLexeme(lexeme)+":1"+string(w))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, " ")
+}
+
+// tsQuery returns the tsquery literal matching any of lexemes.
+func tsQuery(lexemes []string) string {
+	quoted := make([]string, len(lexemes))
+	for i, l := range lexemes {
+		quoted[i] = tsLexeme(l)
+	}
+	return strings.Join(quoted, " | ")
+}
+
+// tsLexeme quotes a lexeme for a tsvector or tsquery literal.
+func tsLexeme(l string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(l) + "'"
+}
+
+// highlightCandidate is a text of a hit that may hold matches.
+type highlightCandidate struct {
+	hit           int
+	field, noteID string
+}
+
+// highlightSearchHits fills in the highlights of hits: ts_headline
+// fragments, in clear, of the texts matching terms — title, company, notes
+// (newest first) and cover letter, in that order.
+func (s *Service) highlightSearchHits(ctx context.Context, userID string, hits []SearchHit, terms []string) error {
+	if len(hits) == 0 {
+		return nil
+	}
+	ids := make([]string, len(hits))
+	for i := range hits {
+		ids[i] = hits[i].Application.ID
+		hits[i].Highlights = make([]SearchHighlight, 0)
+	}
+
+	notes := make(map[string][]Note)
+	rows, err := s.pool.Query(ctx,
+		noteSelect+` WHERE application_id = ANY($1::uuid[]) AND user_id = $2 ORDER BY created_at DESC, id DESC`,
+		ids, userID)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		n, err := s.scanNote(rows)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		notes[n.ApplicationID] = append(notes[n.ApplicationID], *n)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var (
+		texts      []string
+		candidates []highlightCandidate
+	)
+	add := func(hit int, field, noteID, text string) {
+		if text != "" {
+			texts = append(texts, text)
+			candidates = append(candidates, highlightCandidate{hit: hit, field: field, noteID: noteID})
+		}
+	}
+	for i, h := range hits {
+		app := h.Application
+		add(i, SearchFieldTitle, "", app.JobTitle)
+		add(i, SearchFieldCompany, "", app.Company)
+		for _, n := range notes[app.ID] {
+			add(i, SearchFieldNote, n.ID, n.Text)
+		}
+		if app.GeneratedCoverLetter != nil {
+			add(i, SearchFieldCoverLetter, "", *app.GeneratedCoverLetter)
+		}
+	}
+
+	rows, err = s.pool.Query(ctx,
+		`SELECT d.i, ts_headline($1::regconfig, d.t, $2::tsquery, $3)
+		 FROM unnest($4::text[]) WITH ORDINALITY AS d(t, i)
+		 WHERE to_tsvector($1::regconfig, d.t) @@ $2::tsquery
+		 ORDER BY d.i`,
+		s.searchTextConfig(), tsQuery(terms), headlineOptions, texts)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			i        int64
+			headline string
+		)
+		if err := rows.Scan(&i, &headline); err != nil {
+			return err
+		}
+		c := candidates[i-1]
+		if len(hits[c.hit].Highlights) == maxSearchHighlights {
+			continue
+		}
+		text, matches := parseHeadline(headline)
+		hits[c.hit].Highlights = append(hits[c.hit].Highlights, SearchHighlight{
+			Field: c.field, NoteID: c.noteID, Text: text, Matches: matches,
+		})
+	}
+	return rows.Err()
+}
+
+// parseHeadline splits ts_headline output (headlineOptions) into its text
+// and the ranges of the matches it marks.
+func parseHeadline(headline string) (string, []TextRange) {
+	var b strings.Builder
+	matches := make([]TextRange, 0)
+	pos, start := 0, -1
+	for _, r := range headline {
+		switch r {
+		case headlineStart:
+			start = pos
+		case headlineStop:
+			if start >= 0 && pos > start {
+				matches = append(matches, TextRange{Start: start, Length: pos - start})
+			}
+			start = -1
+		default:
+			b.WriteRune(r)
+			pos++
+		}
+	}
+	return b.String(), matches
+}
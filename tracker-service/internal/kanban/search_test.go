@@ -0,0 +1,30 @@
+package kanban_test
+
+import (
+	"reflect"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestParseHeadline(t *testing.T) {
+	text, matches := kanban.ParseHeadline("Recruiter said \x01Kubernetes\x01 was a must … the \x01kubernetes\x02 cluster, déjà \x01vu\x02")
+	if want := "Recruiter said Kubernetes was a must … the kubernetes cluster, déjà vu"; text != want {
+		t.Errorf("text = %q, want %q", text, want)
+	}
+	// A start without a stop is dropped; offsets count characters, not bytes.
+	want := []kanban.TextRange{{Start: 43, Length: 10}, {Start: 68, Length: 2}}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("matches = %v, want %v", matches, want)
+	}
+}
+
+func TestTSLiterals(t *testing.T) {
+	got := kanban.TSVector(map[string]byte{"kubernet": 'A', `o'brien`: 'B', `a\b`: 'C'})
+	if want := `'a\\b':1C 'kubernet':1A 'o''brien':1B`; got != want {
+		t.Errorf("TSVector = %s, want %s", got, want)
+	}
+	if got, want := kanban.TSQuery([]string{"recruit", "it's"}), `'recruit' | 'it''s'`; got != want {
+		t.Errorf("TSQuery = %s, want %s", got, want)
+	}
+}
@@ -5,11 +5,20 @@ package kanban
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log/slog"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"jobmate/tracker-service/internal/gcal"
+	"jobmate/tracker-service/internal/scan"
+	"jobmate/tracker-service/internal/secretbox"
+	"jobmate/tracker-service/internal/storage"
+	"jobmate/tracker-service/internal/webhook"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 )
@@ -19,38 +28,143 @@ import (
 // Service encapsulates all Kanban business logic.
 // It has no dependency on net/http — it can be used by any transport layer.
 type Service struct {
-	pool *pgxpool.Pool
-	rdb  *redis.Client
+	pool   *pgxpool.Pool
+	rdb    *redis.Client
+	repo   ApplicationRepo
+	events EventPublisher
+	opts   Options
+}
+
+// Options holds deployment-wide defaults for the Service.
+type Options struct {
+	// GhostAfterDays is the default silence threshold used by DetectGhosted
+	// for users who did not set their own.
+	GhostAfterDays int
+	// UndoGracePeriod is how long after a move UndoLastMove may revert it.
+	UndoGracePeriod time.Duration
+	// TransitionPolicy relaxes the state machine for every user; users can
+	// add their own edges on top of it in their settings.
+	TransitionPolicy TransitionPolicy
+	// Storage holds attachment files; nil disables the attachment RPCs.
+	Storage *storage.Client
+	// AttachmentQuota caps the total attachment bytes per user (0 = no cap).
+	AttachmentQuota int64
+	// Scanner scans uploaded attachments for malware before they can be
+	// downloaded (see ScanAttachments); nil leaves them unscanned.
+	Scanner scan.Scanner
+	// ReanalyzeCooldown is the minimum delay between two ReanalyzeApplication
+	// calls for the same application.
+	ReanalyzeCooldown time.Duration
+	// FollowUpCooldown is the minimum delay between two RequestFollowUpDraft
+	// calls for the same application.
+	FollowUpCooldown time.Duration
+	// DuplicateRejectionDays is how long a rejection still makes a new
+	// application to the same job a duplicate (see DuplicateError).
+	DuplicateRejectionDays int
+	// BenchmarkMinUsers is the smallest cohort ComputeBenchmarks publishes.
+	BenchmarkMinUsers int
+	// ClosedRetentionMonths is how long REJECTED and WITHDRAWN cards stay
+	// untouched before ApplyClosedRetention compacts or deletes them
+	// (0 = kept as they are).
+	ClosedRetentionMonths int
+	// GoogleCalendar enables the Google Calendar sync; the users' OAuth
+	// tokens are stored sealed with Secrets. Either nil disables it.
+	GoogleCalendar *gcal.Client
+	Secrets        *secretbox.Box
+	// Webhooks sends the users' webhook deliveries; their secrets are
+	// stored sealed with Secrets. Either nil disables webhooks.
+	Webhooks *webhook.Client
+	// FieldKeys seals notes, cover letters and offer terms at rest (see
+	// sealedColumns); nil stores them in clear.
+	FieldKeys *secretbox.Keyring
+	// SearchTextConfig is the text search configuration of
+	// SearchApplications ("" = english).
+	SearchTextConfig string
+	// Applications and Events replace the pool and the Redis client for the
+	// card lifecycle and RelayOutbox's publishing (see ApplicationRepo);
+	// nil uses them.
+	Applications ApplicationRepo
+	Events       EventPublisher
 }
 
 // NewService returns a configured Service.
-func NewService(pool *pgxpool.Pool, rdb *redis.Client) *Service {
-	return &Service{pool: pool, rdb: rdb}
+func NewService(pool *pgxpool.Pool, rdb *redis.Client, opts Options) *Service {
+	s := &Service{pool: pool, rdb: rdb, repo: opts.Applications, events: opts.Events, opts: opts}
+	if s.repo == nil {
+		s.repo = pgRepo{s}
+	}
+	if s.events == nil {
+		s.events = redisPublisher{rdb}
+	}
+	return s
 }
 
 // ─── Business logic ───────────────────────────────────────────────────────────
 
-// ListApplications returns all applications for the given user, newest first.
-// If statusFilter is non-empty, only applications with that status are returned.
-func (s *Service) ListApplications(ctx context.Context, userID, statusFilter string) ([]Application, error) {
-	const base = `
-		SELECT a.id, a.current_status, a.ai_analysis, a.generated_cover_letter,
-		       a.user_notes, a.user_rating, a.history_log,
-		       COALESCE(a.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		       a.relance_reminder_at, a.created_at, a.updated_at
+// ListApplications returns the user's applications in filter.Sort order
+// (most recently updated first by default).
+// Archived applications are skipped unless filter.IncludeArchived is set.
+// With filter.View = ViewSummary the heavy fields are left empty.
+// Results are cached per filter (see readThrough), sealed fields sealed:
+// boards poll this.
+func (s *Service) ListApplications(ctx context.Context, userID string, filter ListFilter) ([]Application, error) {
+	orderBy, err := listOrderBy(filter.Sort)
+	if err != nil {
+		return nil, &ValidationError{Msg: err.Error()}
+	}
+	columns, err := listColumns(filter.View)
+	if err != nil {
+		return nil, &ValidationError{Msg: err.Error()}
+	}
+	if filter.Priority != "" {
+		if _, err := ParsePriority(filter.Priority); err != nil {
+			return nil, &ValidationError{Msg: err.Error()}
+		}
+	}
+
+	view := fmt.Sprintf("list:%s:%t:%s:%s:%s", filter.Status, filter.IncludeArchived, filter.Priority, filter.Sort, filter.View)
+	apps, err := readThrough(ctx, s, userID, view, func(ctx context.Context) (apps []Application, err error) {
+		err = s.retry(ctx, func() error {
+			apps, err = s.listApplications(ctx, userID, filter, columns, orderBy)
+			return err
+		})
+		for i := range apps {
+			apps[i] = s.sealCached(apps[i])
+		}
+		return apps, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range apps {
+		s.openCached(&apps[i])
+	}
+	return apps, nil
+}
+
+// listApplications runs ListApplications' query.
+func (s *Service) listApplications(ctx context.Context, userID string, filter ListFilter, columns, orderBy string) ([]Application, error) {
+	query := `
+		SELECT ` + columns + `
 		FROM applications a
 		LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
 		WHERE a.user_id = $1`
+	args := []any{userID}
 
-	var (
-		rows pgx.Rows
-		err  error
-	)
-	if statusFilter != "" {
-		rows, err = s.pool.Query(ctx, base+` AND a.current_status = $2::application_status ORDER BY a.updated_at DESC`, userID, statusFilter)
-	} else {
-		rows, err = s.pool.Query(ctx, base+` ORDER BY a.updated_at DESC`, userID)
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		query += fmt.Sprintf(` AND a.current_status = $%d::application_status`, len(args))
+	}
+	if filter.Priority != "" {
+		args = append(args, filter.Priority)
+		query += fmt.Sprintf(` AND a.priority = $%d::application_priority`, len(args))
 	}
+	if !filter.IncludeArchived {
+		query += ` AND a.archived_at IS NULL`
+	}
+	query += ` ORDER BY ` + orderBy
+
+	rows, err := s.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("listApplications query: %w", err)
 	}
@@ -59,12 +173,7 @@ func (s *Service) ListApplications(ctx context.Context, userID, statusFilter str
 	apps := make([]Application, 0)
 	for rows.Next() {
 		var a Application
-		if err := rows.Scan(
-			&a.ID, &a.CurrentStatus, &a.AIAnalysis, &a.GeneratedCoverLetter,
-			&a.UserNotes, &a.UserRating, &a.HistoryLog,
-			&a.JobFeedID, &a.SearchConfigID, &a.RelanceReminderAt,
-			&a.CreatedAt, &a.UpdatedAt,
-		); err != nil {
+		if err := rows.Scan(s.appScanDest(&a)...); err != nil {
 			return nil, fmt.Errorf("listApplications scan: %w", err)
 		}
 		apps = append(apps, a)
@@ -72,255 +181,394 @@ func (s *Service) ListApplications(ctx context.Context, userID, statusFilter str
 	return apps, nil
 }
 
-// GetApplication returns a single application by ID, validating ownership.
+// GetApplication returns a single application by ID, validating ownership,
+// along with its interviews.
 func (s *Service) GetApplication(ctx context.Context, userID, appID string) (*Application, error) {
 	var a Application
-	err := s.pool.QueryRow(ctx,
-		`SELECT a.id, a.current_status, a.ai_analysis, a.generated_cover_letter,
-		        a.user_notes, a.user_rating, a.history_log,
-		        COALESCE(a.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        a.relance_reminder_at, a.created_at, a.updated_at
-		 FROM applications a
-		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
-		 WHERE a.id = $1 AND a.user_id = $2`,
-		appID, userID,
-	).Scan(
-		&a.ID, &a.CurrentStatus, &a.AIAnalysis, &a.GeneratedCoverLetter,
-		&a.UserNotes, &a.UserRating, &a.HistoryLog,
-		&a.JobFeedID, &a.SearchConfigID, &a.RelanceReminderAt,
-		&a.CreatedAt, &a.UpdatedAt,
-	)
-	if err != nil {
+	err := s.retry(ctx, func() error {
+		return s.pool.QueryRow(ctx,
+			`SELECT `+appColumns("a")+`
+			 FROM applications a
+			 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+			 WHERE a.id = $1 AND a.user_id = $2`,
+			appID, userID,
+		).Scan(s.appScanDest(&a)...)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, ErrNotFound
 	}
+	if err != nil {
+		return nil, fmt.Errorf("getApplication: %w", err)
+	}
+	if a.Interviews, err = listInterviews(ctx, s.pool, userID, appID); err != nil {
+		return nil, fmt.Errorf("getApplication: %w", err)
+	}
+	if a.Offer, err = s.loadOffer(ctx, s.pool, userID, appID); err != nil {
+		return nil, fmt.Errorf("getApplication: %w", err)
+	}
 	return &a, nil
 }
 
-// CreateApplication inserts a new application at TO_APPLY status for the given job feed entry.
-// It then publishes CMD_ANALYZE_JOB to kick off the AI Coach pipeline.
-func (s *Service) CreateApplication(ctx context.Context, userID, jobFeedID string) (*Application, error) {
+// GetApplicationByJobFeedID returns the user's application for a job_feed
+// entry, without its interviews and offer, or ErrNotFound if they do not
+// track it.
+func (s *Service) GetApplicationByJobFeedID(ctx context.Context, userID, jobFeedID string) (*Application, error) {
+	if jobFeedID == "" {
+		return nil, &ValidationError{Field: "job_feed_id", Msg: "job_feed_id must not be empty"}
+	}
 	var a Application
-	err := s.pool.QueryRow(ctx,
-		`WITH ins AS (
-		   INSERT INTO applications (user_id, job_feed_id, current_status)
-		   VALUES ($1, $2, 'TO_APPLY')
-		   ON CONFLICT (user_id, job_feed_id) DO NOTHING
-		   RETURNING *
-		 )
-		 SELECT ins.id, ins.current_status, ins.ai_analysis, ins.generated_cover_letter,
-		        ins.user_notes, ins.user_rating, ins.history_log,
-		        COALESCE(ins.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        ins.relance_reminder_at, ins.created_at, ins.updated_at
-		 FROM ins
-		 LEFT JOIN job_feed jf ON jf.id = ins.job_feed_id`,
-		userID, jobFeedID,
-	).Scan(
-		&a.ID, &a.CurrentStatus, &a.AIAnalysis, &a.GeneratedCoverLetter,
-		&a.UserNotes, &a.UserRating, &a.HistoryLog,
-		&a.JobFeedID, &a.SearchConfigID, &a.RelanceReminderAt,
-		&a.CreatedAt, &a.UpdatedAt,
-	)
+	err := s.retry(ctx, func() error {
+		return s.pool.QueryRow(ctx,
+			`SELECT `+appColumns("a")+`
+			 FROM applications a
+			 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+			 WHERE a.user_id = $1 AND a.job_feed_id = $2`,
+			userID, jobFeedID,
+		).Scan(s.appScanDest(&a)...)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("createApplication: %w", err)
+		return nil, fmt.Errorf("getApplicationByJobFeedID: %w", err)
 	}
+	return &a, nil
+}
 
-	// Publish CMD_ANALYZE_JOB so the AI Coach scores this application (non-fatal).
-	event, _ := json.Marshal(map[string]string{
-		"type":          "CMD_ANALYZE_JOB",
-		"applicationId": a.ID,
-		"jobFeedId":     jobFeedID,
-		"userId":        userID,
+// CreateApplication inserts a new application at TO_APPLY status for the given job feed entry.
+// CMD_ANALYZE_JOB is queued in the same transaction to kick off the AI Coach pipeline.
+// Unless confirmDuplicate is set, a DuplicateError is returned when the user
+// already applied to the same job at the same company. Creating an
+// application for a job that already has one returns that application,
+// queuing nothing.
+func (s *Service) CreateApplication(ctx context.Context, userID, jobFeedID string, confirmDuplicate bool) (*Application, error) {
+	var app *Application
+	err := s.repo.InTx(ctx, func(tx ApplicationTx) error {
+		// Also the check that the job exists: job_feed_id is no foreign key
+		// (job_feed is partitioned).
+		company, title, err := tx.JobIdentity(ctx, jobFeedID)
+		if err != nil {
+			return fmt.Errorf("createApplication job: %w", err)
+		}
+		if !confirmDuplicate {
+			if err := s.checkDuplicate(ctx, tx, userID, company, title); err != nil {
+				return err
+			}
+		}
+
+		a, created, err := tx.InsertApplication(ctx, userID, jobFeedID)
+		if err != nil {
+			return fmt.Errorf("createApplication: %w", err)
+		}
+		app = a
+		if !created {
+			return nil // already on the board (a retried approval): that card, as is
+		}
+		if err := enqueueAnalyzeJob(ctx, tx, userID, a.ID, jobFeedID); err != nil {
+			return fmt.Errorf("createApplication: %w", err)
+		}
+		if err := enqueueApplicationCreated(ctx, tx, userID, a); err != nil {
+			return fmt.Errorf("createApplication: %w", err)
+		}
+		return nil
 	})
-	if err := s.rdb.Publish(ctx, "CMD_ANALYZE_JOB", event).Err(); err != nil {
-		slog.Warn("publish CMD_ANALYZE_JOB failed", "err", err)
+	if err != nil {
+		return nil, err
 	}
+	return app, nil
+}
 
-	return &a, nil
+// SetRelanceReminder sets the reminder time on an application, stored in
+// UTC. The zero time clears the reminder; others must be in the future, at
+// most a year ahead. Logs a REMINDER_SET (or REMINDER_CLEARED) history entry.
+func (s *Service) SetRelanceReminder(ctx context.Context, userID, appID string, remindAt time.Time) (*Application, error) {
+	remindAt = remindAt.UTC()
+	return s.UpdateApplication(ctx, userID, appID, ApplicationUpdate{RelanceReminderAt: &remindAt})
 }
 
-// SetRelanceReminder sets the reminder timestamp on an application.
-func (s *Service) SetRelanceReminder(ctx context.Context, userID, appID, remindAt string) (*Application, error) {
-	var a Application
-	err := s.pool.QueryRow(ctx,
-		`WITH upd AS (
-		   UPDATE applications
-		   SET relance_reminder_at = $1::timestamptz, updated_at = NOW()
-		   WHERE id = $2 AND user_id = $3
-		   RETURNING *
-		 )
-		 SELECT upd.id, upd.current_status, upd.ai_analysis, upd.generated_cover_letter,
-		        upd.user_notes, upd.user_rating, upd.history_log,
-		        COALESCE(upd.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        upd.relance_reminder_at, upd.created_at, upd.updated_at
-		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
-		remindAt, appID, userID,
-	).Scan(
-		&a.ID, &a.CurrentStatus, &a.AIAnalysis, &a.GeneratedCoverLetter,
-		&a.UserNotes, &a.UserRating, &a.HistoryLog,
-		&a.JobFeedID, &a.SearchConfigID, &a.RelanceReminderAt,
-		&a.CreatedAt, &a.UpdatedAt,
-	)
+// SetRelanceReminderLocal sets the reminder at a wall-clock time of the
+// user's timezone (Settings.Timezone), e.g. "2026-10-20T09:00" for 9am
+// their time. See parseLocalTime.
+func (s *Service) SetRelanceReminderLocal(ctx context.Context, userID, appID, local string) (*Application, error) {
+	loc, err := s.userLocation(ctx, userID)
 	if err != nil {
-		return nil, ErrNotFound
+		return nil, err
 	}
-	return &a, nil
+	remindAt, err := parseLocalTime(local, loc)
+	if err != nil {
+		return nil, &ValidationError{Field: "local_remind_at", Msg: err.Error()}
+	}
+	return s.SetRelanceReminder(ctx, userID, appID, remindAt)
 }
 
 // MoveCard transitions an application to a new Kanban status.
 // Returns ErrNotFound if the application does not exist or belong to userID.
 // Returns ErrForbiddenTransition if the state machine rejects the transition.
-func (s *Service) MoveCard(ctx context.Context, userID, appID, newStatusStr string) (*Application, error) {
+// rejectionReason and rejectionStage are only accepted when moving to
+// REJECTED (see Rejection). reason is an optional comment on the move
+// ("Recruiter called, phone screen booked"), kept in its history entry.
+func (s *Service) MoveCard(ctx context.Context, userID, appID, newStatusStr, rejectionReason, rejectionStage, reason string) (*Application, error) {
 	newStatus, err := ParseStatus(newStatusStr)
 	if err != nil {
 		return nil, &ValidationError{Msg: err.Error()}
 	}
+	rej, err := parseRejection(newStatus, rejectionReason, rejectionStage)
+	if err != nil {
+		return nil, err
+	}
+	if reason, err = cleanText("reason", reason, maxMoveReasonLen); err != nil {
+		return nil, err
+	}
 
-	// Fetch current state (also validates ownership)
-	var currentStatusStr string
-	err = s.pool.QueryRow(ctx,
-		`SELECT current_status FROM applications WHERE id = $1 AND user_id = $2`,
-		appID, userID,
-	).Scan(&currentStatusStr)
+	// Read, validate and write under the row lock: concurrent moves of the
+	// same card are serialized, each validated against the state the
+	// previous one left.
+	var app *Application
+	err = s.repo.InTx(ctx, func(tx ApplicationTx) error {
+		cur, err := tx.LockCard(ctx, userID, appID)
+		if errors.Is(err, ErrNotFound) {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("moveCard: %w", err)
+		}
+		policy, err := s.transitionPolicy(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if err := checkMove(cur, newStatus, policy); err != nil {
+			return err
+		}
+
+		if app, err = s.applyMove(ctx, tx, userID, appID, cur.Status, newStatus, rej, reason); err != nil {
+			return fmt.Errorf("moveCard update: %w", err)
+		}
+		if err := enqueueCardMoved(ctx, tx, userID, appID, cur.Status, newStatus, ""); err != nil {
+			return fmt.Errorf("moveCard: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, ErrNotFound
+		return nil, err
 	}
+	return app, nil
+}
 
-	currentStatus, _ := ParseStatus(currentStatusStr)
-	if !IsTransitionAllowed(currentStatus, newStatus) {
-		return nil, &ValidationError{
-			Msg: fmt.Sprintf("transition %s → %s is not allowed", currentStatus, newStatus),
+// checkMove validates a status change against the user's transition policy.
+func checkMove(cur CardState, to Status, policy TransitionPolicy) error {
+	if cur.Archived {
+		return &ValidationError{Msg: "application is archived — restore it before moving"}
+	}
+	if !policy.IsTransitionAllowed(cur.Status, to) {
+		return &ValidationError{
+			Msg: fmt.Sprintf("transition %s → %s is not allowed", cur.Status, to),
+		}
+	}
+	if cur.Status == StatusOnHold && !IsResumeAllowed(cur.HoldOrigin, to) {
+		return &ValidationError{
+			Msg: fmt.Sprintf("application was put on hold from %s and can only resume there", cur.HoldOrigin),
 		}
 	}
+	return nil
+}
 
-	historyEntry, _ := json.Marshal(map[string]string{
-		"from": string(currentStatus),
-		"to":   string(newStatus),
-		"at":   time.Now().UTC().Format(time.RFC3339),
-	})
+// applyMove writes a validated status change and appends the history entry,
+// with the user's comment reason if any. rej is only used when moving to
+// REJECTED. Moves to HIRED also deactivate the card's search unless the
+// user's preferences keep it (see archiveSearchOnHired).
+func (s *Service) applyMove(ctx context.Context, tx ApplicationTx, userID, appID string, from, to Status, rej Rejection, reason string) (*Application, error) {
+	var holdOrigin Status
+	if to == StatusOnHold {
+		holdOrigin = from
+	}
+	entry := HistoryEntry{
+		From:   string(from),
+		To:     string(to),
+		At:     time.Now().UTC().Truncate(time.Second),
+		Reason: reason,
+	}
+	if to == StatusRejected {
+		if rej.Stage == "" {
+			rej.Stage = from
+		}
+		entry.RejectionReason, entry.RejectionStage = rej.Reason, string(rej.Stage)
+	}
+	return s.commitMove(ctx, tx, userID, appID, holdOrigin, entry)
+}
+
+// commitMove writes the status change entry records, deactivating the card's
+// search on moves to HIRED (see archiveSearchOnHired).
+func (s *Service) commitMove(ctx context.Context, tx ApplicationTx, userID, appID string, holdOrigin Status, entry HistoryEntry) (*Application, error) {
+	to := Status(entry.To)
+	entries := []HistoryEntry{entry}
+	if IsHired(to) {
+		searchEntry, err := archiveSearchOnHired(ctx, tx, appID, entry.At)
+		if err != nil {
+			return nil, err
+		}
+		if searchEntry != nil {
+			entries = append(entries, *searchEntry)
+		}
+	}
+	return tx.WriteMove(ctx, userID, appID, to, holdOrigin, entries)
+}
+
+// writeMove sets current_status and appends entry, then extra, to
+// history_log.
+// holdOrigin is recorded when moving to ON_HOLD and cleared otherwise, as is
+// the rejection reason and stage of entry; any status change also clears the
+// ghosted flag and drops the card into the new status' default lane. The
+// move becomes the card's last action (see UndoLastAction) — unless it is a
+// compensating one (entry.Undo), which clears a recorded move.
+func (s *Service) writeMove(ctx context.Context, q querier, userID, appID string, to, holdOrigin Status, entry HistoryEntry, extra ...HistoryEntry) (*Application, error) {
+	historyEntry, _ := json.Marshal(append([]HistoryEntry{entry}, extra...))
 
 	var app Application
-	err = s.pool.QueryRow(ctx,
+	err := q.QueryRow(ctx,
 		`WITH upd AS (
 		   UPDATE applications
-		   SET current_status = $1::application_status,
-		       history_log    = history_log || $2::jsonb,
-		       updated_at     = NOW()
+		   SET current_status   = $1::application_status,
+		       hold_origin      = NULLIF($5, '')::application_status,
+		       ghosted_at       = NULL,
+		       column_id        = NULL,
+		       rejection_reason = NULLIF($6, ''),
+		       rejection_stage  = NULLIF($7, '')::application_status,
+		       history_log      = history_log || $2::jsonb,
+		       updated_at       = NOW()
 		   WHERE id = $3 AND user_id = $4
 		   RETURNING *
 		 )
-		 SELECT upd.id, upd.current_status, upd.ai_analysis, upd.generated_cover_letter,
-		        upd.user_notes, upd.user_rating, upd.history_log,
-		        COALESCE(upd.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        upd.relance_reminder_at, upd.created_at, upd.updated_at
+		 SELECT `+appColumns("upd")+`
 		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
-		string(newStatus),
-		fmt.Sprintf("[%s]", historyEntry),
-		appID, userID,
-	).Scan(
-		&app.ID, &app.CurrentStatus, &app.AIAnalysis, &app.GeneratedCoverLetter,
-		&app.UserNotes, &app.UserRating, &app.HistoryLog,
-		&app.JobFeedID, &app.SearchConfigID, &app.RelanceReminderAt,
-		&app.CreatedAt, &app.UpdatedAt,
-	)
+		string(to),
+		string(historyEntry),
+		appID, userID, string(holdOrigin),
+		entry.RejectionReason, entry.RejectionStage,
+	).Scan(s.appScanDest(&app)...)
 	if err != nil {
-		return nil, fmt.Errorf("moveCard update: %w", err)
+		return nil, err
 	}
-
-	// On HIRED: deactivate the linked search_config (non-fatal)
-	if IsHired(newStatus) {
-		if err := s.archiveSearchConfig(ctx, appID); err != nil {
-			slog.Warn("archiveSearchConfig failed", "applicationId", appID, "err", err)
-		}
+	if entry.Undo {
+		_, err = q.Exec(ctx, `DELETE FROM application_last_actions WHERE application_id = $1 AND action = 'MOVE'`, appID)
+	} else {
+		err = recordLastAction(ctx, q, ActionMove, appID, userID)
 	}
-
-	// Publish SSE event (non-fatal)
-	event, _ := json.Marshal(map[string]string{
-		"type":          "EVENT_CARD_MOVED",
-		"applicationId": appID,
-		"userId":        userID,
-		"from":          string(currentStatus),
-		"to":            string(newStatus),
-	})
-	if err := s.rdb.Publish(ctx, "EVENT_CARD_MOVED", event).Err(); err != nil {
-		slog.Warn("publish EVENT_CARD_MOVED failed", "err", err)
+	if err != nil {
+		return nil, err
 	}
-
 	return &app, nil
 }
 
-// AddNote sets or replaces the free-text note on an application.
-func (s *Service) AddNote(ctx context.Context, userID, appID, note string) (*Application, error) {
-	var app Application
-	err := s.pool.QueryRow(ctx,
+// RateApplication rates an application: 1–5 stars, or per criterion (see
+// Rating), the stars then being the criteria's rounded mean, and optionally
+// a review. Each rating replaces the previous one, criteria and review
+// included.
+func (s *Service) RateApplication(ctx context.Context, userID, appID string, rating Rating) (*Application, error) {
+	rating, err := normalizeRating(rating)
+	if err != nil {
+		return nil, err
+	}
+	var criteria *RatingCriteria
+	if !rating.Criteria.IsZero() {
+		criteria = &rating.Criteria
+	}
+
+	return s.updateRecordedApplicationRow(ctx, userID, ActionRating, appID, []string{"user_rating", "rating_criteria", "rating_review"},
 		`WITH upd AS (
-		   UPDATE applications SET user_notes = $1, updated_at = NOW()
-		   WHERE id = $2 AND user_id = $3
+		   UPDATE applications
+		   SET user_rating = $1, rating_criteria = $2, rating_review = NULLIF($3, ''), updated_at = NOW()
+		   WHERE id = $4 AND user_id = $5
 		   RETURNING *
 		 )
-		 SELECT upd.id, upd.current_status, upd.ai_analysis, upd.generated_cover_letter,
-		        upd.user_notes, upd.user_rating, upd.history_log,
-		        COALESCE(upd.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        upd.relance_reminder_at, upd.created_at, upd.updated_at
+		 SELECT `+appColumns("upd")+`
 		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
-		note, appID, userID,
-	).Scan(
-		&app.ID, &app.CurrentStatus, &app.AIAnalysis, &app.GeneratedCoverLetter,
-		&app.UserNotes, &app.UserRating, &app.HistoryLog,
-		&app.JobFeedID, &app.SearchConfigID, &app.RelanceReminderAt,
-		&app.CreatedAt, &app.UpdatedAt,
+		rating.Stars, criteria, s.seal(rating.Review), appID, userID,
 	)
-	if err != nil {
-		return nil, ErrNotFound
-	}
-	return &app, nil
 }
 
-// RateApplication sets a 1–5 star rating on an application.
-func (s *Service) RateApplication(ctx context.Context, userID, appID string, rating int32) (*Application, error) {
-	if rating < 1 || rating > 5 {
-		return nil, &ValidationError{Msg: "rating must be between 1 and 5"}
+// SetPriority sets the LOW/MEDIUM/HIGH priority of an application.
+func (s *Service) SetPriority(ctx context.Context, userID, appID, priorityStr string) (*Application, error) {
+	priority, err := ParsePriority(priorityStr)
+	if err != nil {
+		return nil, &ValidationError{Msg: err.Error()}
 	}
 
-	var app Application
-	err := s.pool.QueryRow(ctx,
+	return s.updateApplicationRow(ctx, userID, []string{"priority"},
 		`WITH upd AS (
-		   UPDATE applications SET user_rating = $1, updated_at = NOW()
+		   UPDATE applications SET priority = $1::application_priority, updated_at = NOW()
 		   WHERE id = $2 AND user_id = $3
 		   RETURNING *
 		 )
-		 SELECT upd.id, upd.current_status, upd.ai_analysis, upd.generated_cover_letter,
-		        upd.user_notes, upd.user_rating, upd.history_log,
-		        COALESCE(upd.job_feed_id::text, ''), COALESCE(jf.search_config_id::text, ''),
-		        upd.relance_reminder_at, upd.created_at, upd.updated_at
+		 SELECT `+appColumns("upd")+`
 		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
-		rating, appID, userID,
-	).Scan(
-		&app.ID, &app.CurrentStatus, &app.AIAnalysis, &app.GeneratedCoverLetter,
-		&app.UserNotes, &app.UserRating, &app.HistoryLog,
-		&app.JobFeedID, &app.SearchConfigID, &app.RelanceReminderAt,
-		&app.CreatedAt, &app.UpdatedAt,
+		string(priority), appID, userID,
 	)
-	if err != nil {
-		return nil, ErrNotFound
+}
+
+// maxNextStepLabelLen bounds the next-step label ("Take-home test due").
+const maxNextStepLabelLen = 200
+
+// SetNextStep sets the due date and label of the application's next step
+// (take-home test, offer deadline, …). A nil dueAt clears both.
+func (s *Service) SetNextStep(ctx context.Context, userID, appID string, dueAt *time.Time, label string) (*Application, error) {
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > maxNextStepLabelLen {
+		return nil, &ValidationError{Msg: fmt.Sprintf("next step label must be at most %d characters", maxNextStepLabelLen)}
 	}
-	return &app, nil
+	if dueAt == nil {
+		label = ""
+	}
+
+	return s.updateApplicationRow(ctx, userID, []string{"next_step_due_at", "next_step_label"},
+		`WITH upd AS (
+		   UPDATE applications
+		   SET next_step_due_at = $1, next_step_label = NULLIF($2, ''), updated_at = NOW()
+		   WHERE id = $3 AND user_id = $4
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		dueAt, label, appID, userID,
+	)
+}
+
+// ArchiveApplication hides an application from the board without deleting it.
+// Status, notes and history are kept intact; RestoreApplication undoes it.
+// Archiving an already-archived application keeps the original archived_at.
+func (s *Service) ArchiveApplication(ctx context.Context, userID, appID string) (*Application, error) {
+	return s.setArchived(ctx, userID, appID, true)
+}
+
+// RestoreApplication puts an archived application back on the board.
+func (s *Service) RestoreApplication(ctx context.Context, userID, appID string) (*Application, error) {
+	return s.setArchived(ctx, userID, appID, false)
 }
 
-// archiveSearchConfig deactivates the search_config linked to an application.
-// Handles nullable job_feed_id (manual additions have no search_config; those are skipped gracefully).
-func (s *Service) archiveSearchConfig(ctx context.Context, appID string) error {
-	_, err := s.pool.Exec(ctx,
-		`UPDATE search_configs sc
-		 SET is_active  = false,
-		     updated_at = NOW()
-		 FROM applications a
-		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
-		 WHERE a.id         = $1
-		   AND jf.search_config_id IS NOT NULL
-		   AND sc.id        = jf.search_config_id`,
-		appID,
+func (s *Service) setArchived(ctx context.Context, userID, appID string, archived bool) (*Application, error) {
+	return s.updateApplicationRow(ctx, userID, []string{"archived_at"},
+		`WITH upd AS (
+		   UPDATE applications
+		   SET archived_at = CASE WHEN $1 THEN COALESCE(archived_at, NOW()) ELSE NULL END,
+		       updated_at  = NOW()
+		   WHERE id = $2 AND user_id = $3
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		archived, appID, userID,
 	)
-	return err
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique_violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// isForeignKeyViolation reports whether err is a PostgreSQL foreign_key_violation.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
 }
 
 // ─── Sentinel errors ─────────────────────────────────────────────────────────
@@ -328,7 +576,24 @@ func (s *Service) archiveSearchConfig(ctx context.Context, appID string) error {
 // ErrNotFound is returned when an application is missing or does not belong to the user.
 var ErrNotFound = fmt.Errorf("application not found")
 
-// ValidationError wraps a user-facing validation message.
-type ValidationError struct{ Msg string }
+// ErrJobNotFound is returned when a job_feed entry to apply to is missing.
+var ErrJobNotFound = fmt.Errorf("job not found")
+
+// CooldownError is returned when an action was repeated too soon.
+type CooldownError struct {
+	Action     string
+	RetryAfter time.Duration
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("%s was requested too recently, retry in %s", e.Action, e.RetryAfter.Round(time.Second))
+}
+
+// ValidationError wraps a user-facing validation message. Field optionally
+// names the offending request field so clients can highlight it.
+type ValidationError struct {
+	Field string
+	Msg   string
+}
 
 func (e *ValidationError) Error() string { return e.Msg }
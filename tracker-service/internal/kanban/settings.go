@@ -0,0 +1,211 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Settings holds a user's tracker preferences. Users without a
+// tracker_settings row get the deployment defaults from Options.
+type Settings struct {
+	GhostingEnabled bool `json:"ghostingEnabled"`
+	GhostAfterDays  int  `json:"ghostAfterDays"`
+	// ExtraTransitions are the user's own additions to the state machine,
+	// on top of the deployment-wide Options.TransitionPolicy.
+	ExtraTransitions []Transition `json:"extraTransitions"`
+	// ShareBenchmarks opts in to the anonymous benchmarks (see GetBenchmark).
+	ShareBenchmarks bool `json:"shareBenchmarks"`
+	// ReminderChannels are where relance reminders are delivered (see
+	// ChannelInApp, ChannelPush); empty = reminders muted.
+	ReminderChannels []string `json:"reminderChannels"`
+	// Timezone is the user's IANA time zone (e.g. "Europe/Paris"), which
+	// wall-clock reminder times are read in. Defaults to UTC.
+	Timezone string `json:"timezone"`
+	// ArchiveSearchOnHired deactivates the search a card came from when it
+	// is moved to HIRED (see archiveSearchOnHired). Defaults to true.
+	ArchiveSearchOnHired bool `json:"archiveSearchOnHired"`
+	// ClosedRetention is what becomes of the user's old REJECTED and
+	// WITHDRAWN cards (see ApplyClosedRetention). Defaults to
+	// RetentionCompact.
+	ClosedRetention RetentionMode `json:"closedRetention"`
+}
+
+// SettingsUpdate is a partial update: nil fields are left unchanged.
+type SettingsUpdate struct {
+	GhostingEnabled  *bool
+	GhostAfterDays   *int
+	ExtraTransitions *[]Transition // replaces the whole list when set
+	ShareBenchmarks  *bool
+	ReminderChannels *[]string // replaces the whole list when set
+	Timezone         *string   // "" resets to UTC
+
+	ArchiveSearchOnHired *bool
+	ClosedRetention      *RetentionMode
+}
+
+// defaultTimezone is the timezone of users who did not pick one.
+const defaultTimezone = "UTC"
+
+// maxGhostAfterDays bounds the silence threshold to something meaningful.
+const maxGhostAfterDays = 365
+
+// GetSettings returns the user's effective settings.
+func (s *Service) GetSettings(ctx context.Context, userID string) (*Settings, error) {
+	st := &Settings{
+		GhostingEnabled:  true,
+		GhostAfterDays:   s.opts.GhostAfterDays,
+		ExtraTransitions: []Transition{},
+		ReminderChannels: append([]string(nil), reminderChannels...),
+		Timezone:         defaultTimezone,
+
+		ArchiveSearchOnHired: true,
+		ClosedRetention:      RetentionCompact,
+	}
+	var (
+		days  *int32
+		extra []byte
+	)
+	err := s.pool.QueryRow(ctx,
+		`SELECT ghosting_enabled, ghost_after_days, extra_transitions, share_benchmarks, reminder_channels, timezone, archive_search_on_hired, closed_retention
+		 FROM tracker_settings WHERE user_id = $1`,
+		userID,
+	).Scan(&st.GhostingEnabled, &days, &extra, &st.ShareBenchmarks, &st.ReminderChannels, &st.Timezone, &st.ArchiveSearchOnHired, &st.ClosedRetention)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getSettings: %w", err)
+	}
+	if days != nil {
+		st.GhostAfterDays = int(*days)
+	}
+	if err := json.Unmarshal(extra, &st.ExtraTransitions); err != nil {
+		return nil, fmt.Errorf("getSettings extra_transitions: %w", err)
+	}
+	return st, nil
+}
+
+// UpdateSettings applies a partial update and returns the effective settings.
+func (s *Service) UpdateSettings(ctx context.Context, userID string, upd SettingsUpdate) (*Settings, error) {
+	if upd.GhostAfterDays != nil && (*upd.GhostAfterDays < 1 || *upd.GhostAfterDays > maxGhostAfterDays) {
+		return nil, &ValidationError{Msg: fmt.Sprintf("ghost_after_days must be between 1 and %d", maxGhostAfterDays)}
+	}
+	var extra []byte
+	if upd.ExtraTransitions != nil {
+		if _, err := NewTransitionPolicy(*upd.ExtraTransitions); err != nil {
+			return nil, &ValidationError{Msg: err.Error()}
+		}
+		edges := *upd.ExtraTransitions
+		if edges == nil {
+			edges = []Transition{}
+		}
+		extra, _ = json.Marshal(edges)
+	}
+	var channels any // nil = unchanged
+	if upd.ReminderChannels != nil {
+		normalized, err := normalizeReminderChannels(*upd.ReminderChannels)
+		if err != nil {
+			return nil, err
+		}
+		channels = normalized
+	}
+	if upd.Timezone != nil {
+		tz, err := normalizeTimezone(*upd.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		upd.Timezone = &tz
+	}
+	if upd.ClosedRetention != nil {
+		mode, err := ParseRetentionMode(string(*upd.ClosedRetention))
+		if err != nil {
+			return nil, err
+		}
+		upd.ClosedRetention = &mode
+	}
+
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO tracker_settings (user_id, ghosting_enabled, ghost_after_days, extra_transitions, share_benchmarks, reminder_channels, timezone, archive_search_on_hired, closed_retention)
+		 VALUES ($1, COALESCE($2, TRUE), $3, COALESCE($4::jsonb, '[]'), COALESCE($5, FALSE), COALESCE($6::text[], $7), COALESCE($8, $9), COALESCE($10, TRUE), COALESCE($11, $12))
+		 ON CONFLICT (user_id) DO UPDATE
+		 SET ghosting_enabled  = COALESCE($2, tracker_settings.ghosting_enabled),
+		     ghost_after_days  = COALESCE($3, tracker_settings.ghost_after_days),
+		     extra_transitions = COALESCE($4::jsonb, tracker_settings.extra_transitions),
+		     share_benchmarks  = COALESCE($5, tracker_settings.share_benchmarks),
+		     reminder_channels = COALESCE($6::text[], tracker_settings.reminder_channels),
+		     timezone          = COALESCE($8, tracker_settings.timezone),
+		     archive_search_on_hired = COALESCE($10, tracker_settings.archive_search_on_hired),
+		     closed_retention  = COALESCE($11, tracker_settings.closed_retention),
+		     updated_at        = NOW()`,
+		userID, upd.GhostingEnabled, upd.GhostAfterDays, nullableJSON(extra), upd.ShareBenchmarks,
+		channels, reminderChannels, upd.Timezone, defaultTimezone, upd.ArchiveSearchOnHired,
+		upd.ClosedRetention, string(RetentionCompact),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("updateSettings: %w", err)
+	}
+	return s.GetSettings(ctx, userID)
+}
+
+// userLocation returns the user's timezone. Unloadable stored zones (e.g.
+// dropped from the tz database) are logged and read as UTC.
+func (s *Service) userLocation(ctx context.Context, userID string) (*time.Location, error) {
+	tz := defaultTimezone
+	err := s.pool.QueryRow(ctx,
+		`SELECT timezone FROM tracker_settings WHERE user_id = $1`, userID,
+	).Scan(&tz)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("userLocation: %w", err)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		slog.Warn("ignoring unknown timezone", "userId", userID, "timezone", tz, "err", err)
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+// normalizeTimezone validates an IANA time zone name; "" means UTC.
+func normalizeTimezone(tz string) (string, error) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" {
+		return defaultTimezone, nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+		return "", &ValidationError{Field: "timezone", Msg: fmt.Sprintf("unknown time zone %q (want an IANA name such as Europe/Paris)", tz)}
+	}
+	return tz, nil
+}
+
+// transitionPolicy returns the deployment policy extended with the user's
+// own extra transitions. Invalid stored edges are logged and ignored.
+func (s *Service) transitionPolicy(ctx context.Context, tx ApplicationTx, userID string) (TransitionPolicy, error) {
+	edges, err := tx.UserTransitions(ctx, userID)
+	if err != nil {
+		return TransitionPolicy{}, fmt.Errorf("transitionPolicy: %w", err)
+	}
+	if len(edges) == 0 {
+		return s.opts.TransitionPolicy, nil
+	}
+	user, err := NewTransitionPolicy(edges)
+	if err != nil {
+		slog.Warn("ignoring invalid extra_transitions", "userId", userID, "err", err)
+		return s.opts.TransitionPolicy, nil
+	}
+	return s.opts.TransitionPolicy.With(user), nil
+}
+
+// nullableJSON maps an empty payload to SQL NULL.
+func nullableJSON(b []byte) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
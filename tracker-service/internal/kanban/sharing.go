@@ -0,0 +1,230 @@
+package kanban
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BoardShare grants another identity read-only access to a user's board —
+// ListSharedApplications and GetSharedApplication — until ExpiresAt: a
+// JobMate user (GranteeID) or, for token shares, whoever holds Token.
+// Archived cards are never shared.
+type BoardShare struct {
+	ID        string `json:"id"`
+	OwnerID   string `json:"ownerId"`
+	GranteeID string `json:"granteeId"` // "" for token shares
+	// Token is only set on the share CreateBoardShare returns: only its hash
+	// is stored.
+	Token string `json:"token,omitempty"`
+	// Statuses are the columns the grantee sees; empty = the whole board.
+	Statuses  []Status   `json:"statuses"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// allows reports whether the share covers cards in status st.
+func (b *BoardShare) allows(st Status) bool {
+	return len(b.Statuses) == 0 || slices.Contains(b.Statuses, st)
+}
+
+// ErrShareNotFound is returned for unknown, expired or revoked shares, and
+// shares granted to someone else.
+var ErrShareNotFound = errors.New("board share not found")
+
+// maxShareLifetime bounds how far in the future a share may expire.
+const maxShareLifetime = 90 * 24 * time.Hour
+
+// BoardShareInput describes a share to create. Without GranteeID a token
+// share is created.
+type BoardShareInput struct {
+	GranteeID string
+	Statuses  []string
+	ExpiresAt time.Time
+}
+
+const boardShareColumns = `id, owner_id, COALESCE(grantee_id::text, ''), statuses::text[], expires_at, revoked_at, created_at`
+
+func scanBoardShare(row pgx.Row) (*BoardShare, error) {
+	var (
+		b        BoardShare
+		statuses []string
+	)
+	if err := row.Scan(&b.ID, &b.OwnerID, &b.GranteeID, &statuses, &b.ExpiresAt, &b.RevokedAt, &b.CreatedAt); err != nil {
+		return nil, err
+	}
+	b.Statuses = make([]Status, len(statuses))
+	for i, st := range statuses {
+		b.Statuses[i] = Status(st)
+	}
+	return &b, nil
+}
+
+// CreateBoardShare grants read-only access to the owner's board.
+func (s *Service) CreateBoardShare(ctx context.Context, ownerID string, in BoardShareInput) (*BoardShare, error) {
+	if in.GranteeID == ownerID {
+		return nil, &ValidationError{Field: "grantee_user_id", Msg: "a board cannot be shared with its owner"}
+	}
+	statuses := make([]string, 0, len(in.Statuses))
+	for _, raw := range in.Statuses {
+		st, err := ParseStatus(raw)
+		if err != nil {
+			return nil, &ValidationError{Field: "statuses", Msg: err.Error()}
+		}
+		statuses = append(statuses, string(st))
+	}
+	statuses = dedupe(statuses)
+	now := time.Now()
+	if !in.ExpiresAt.After(now) || in.ExpiresAt.Sub(now) > maxShareLifetime {
+		return nil, &ValidationError{Field: "expires_at", Msg: fmt.Sprintf("expires_at must be in the future and within %d days", int(maxShareLifetime.Hours()/24))}
+	}
+
+	var token string
+	var tokenHash []byte
+	if in.GranteeID == "" {
+		token = newCalendarToken() // same format as feed tokens
+		tokenHash = hashToken(token)
+	}
+	b, err := scanBoardShare(s.pool.QueryRow(ctx,
+		`INSERT INTO board_shares (owner_id, grantee_id, token_hash, statuses, expires_at)
+		 VALUES ($1, NULLIF($2, '')::uuid, $3, $4::application_status[], $5)
+		 RETURNING `+boardShareColumns,
+		ownerID, in.GranteeID, tokenHash, statuses, in.ExpiresAt,
+	))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, &ValidationError{Field: "grantee_user_id", Msg: "unknown grantee user"}
+		}
+		return nil, fmt.Errorf("createBoardShare: %w", err)
+	}
+	b.Token = token
+	return b, nil
+}
+
+// ListBoardShares returns the shares of the user's board, or with received
+// the shares other users granted them, newest first. Expired and revoked
+// shares are left out.
+func (s *Service) ListBoardShares(ctx context.Context, userID string, received bool) ([]BoardShare, error) {
+	column := "owner_id"
+	if received {
+		column = "grantee_id"
+	}
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+boardShareColumns+`
+		 FROM board_shares
+		 WHERE `+column+` = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		 ORDER BY created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listBoardShares: %w", err)
+	}
+	defer rows.Close()
+
+	shares := []BoardShare{}
+	for rows.Next() {
+		b, err := scanBoardShare(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listBoardShares scan: %w", err)
+		}
+		shares = append(shares, *b)
+	}
+	return shares, rows.Err()
+}
+
+// RevokeBoardShare ends one of the owner's shares at once.
+func (s *Service) RevokeBoardShare(ctx context.Context, ownerID, shareID string) error {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE board_shares SET revoked_at = NOW()
+		 WHERE id = $1 AND owner_id = $2 AND revoked_at IS NULL`,
+		shareID, ownerID,
+	)
+	if err != nil {
+		return fmt.Errorf("revokeBoardShare: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// resolveShare returns the live share callerID may use: the one granted to
+// them with ID shareID or, when shareID is empty, the one of token.
+func (s *Service) resolveShare(ctx context.Context, callerID, shareID, token string) (*BoardShare, error) {
+	var row pgx.Row
+	switch {
+	case shareID != "":
+		row = s.pool.QueryRow(ctx,
+			`SELECT `+boardShareColumns+` FROM board_shares
+			 WHERE id = $1 AND grantee_id = $2 AND revoked_at IS NULL AND expires_at > NOW()`,
+			shareID, callerID)
+	case token != "":
+		row = s.pool.QueryRow(ctx,
+			`SELECT `+boardShareColumns+` FROM board_shares
+			 WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > NOW()`,
+			hashToken(token))
+	default:
+		return nil, &ValidationError{Field: "share_id", Msg: "share_id or share_token is required"}
+	}
+	b, err := scanBoardShare(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrShareNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolveShare: %w", err)
+	}
+	return b, nil
+}
+
+// ListSharedApplications returns the applications of a board shared with
+// the caller (see resolveShare), as ListApplications would to its owner but
+// restricted to the share's statuses and never archived.
+func (s *Service) ListSharedApplications(ctx context.Context, callerID, shareID, token string, filter ListFilter) ([]Application, error) {
+	share, err := s.resolveShare(ctx, callerID, shareID, token)
+	if err != nil {
+		return nil, err
+	}
+	filter.IncludeArchived = false
+	apps, err := s.ListApplications(ctx, share.OwnerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	visible := apps[:0:0]
+	for _, a := range apps {
+		if share.allows(Status(a.CurrentStatus)) {
+			visible = append(visible, a)
+		}
+	}
+	return visible, nil
+}
+
+// GetSharedApplication returns one application of a board shared with the
+// caller. Cards outside the share's statuses, and archived ones, are
+// reported as ErrNotFound.
+func (s *Service) GetSharedApplication(ctx context.Context, callerID, shareID, token, appID string) (*Application, error) {
+	share, err := s.resolveShare(ctx, callerID, shareID, token)
+	if err != nil {
+		return nil, err
+	}
+	app, err := s.GetApplication(ctx, share.OwnerID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if app.ArchivedAt != nil || !share.allows(Status(app.CurrentStatus)) {
+		return nil, ErrNotFound
+	}
+	return app, nil
+}
+
+// hashToken is the SHA-256 stored in place of a secret token (board shares,
+// calendar feeds).
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,223 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Board snapshots record every card of a user's board at one moment, so
+// CompareSnapshots can tell what happened between two dates without
+// replaying the cards' history logs. SnapshotBoards takes them
+// periodically, TakeBoardSnapshot on demand.
+
+// SnapshotCard is a card as a snapshot recorded it.
+type SnapshotCard struct {
+	ApplicationID string `json:"id"`
+	Status        Status `json:"status"`
+	Archived      bool   `json:"archived"`
+	Company       string `json:"company"`
+	JobTitle      string `json:"title"`
+}
+
+// BoardSnapshot is one stored snapshot of a user's board.
+type BoardSnapshot struct {
+	ID      string    `json:"id"`
+	TakenAt time.Time `json:"takenAt"`
+	Cards   int       `json:"cards"`
+}
+
+// SnapshotMove is a card whose status changed: Card is as it ended up.
+type SnapshotMove struct {
+	Card SnapshotCard `json:"card"`
+	From Status       `json:"from"`
+}
+
+// SnapshotDiff is what changed on a board between the snapshots taken at
+// FromTakenAt and ToTakenAt. A card both moved and archived (or restored)
+// is listed in both.
+type SnapshotDiff struct {
+	FromTakenAt time.Time      `json:"fromTakenAt"`
+	ToTakenAt   time.Time      `json:"toTakenAt"`
+	Added       []SnapshotCard `json:"added"`
+	Removed     []SnapshotCard `json:"removed"` // deleted or merged into another card
+	Moved       []SnapshotMove `json:"moved"`
+	Archived    []SnapshotCard `json:"archived"`
+	Restored    []SnapshotCard `json:"restored"`
+}
+
+// ErrSnapshotNotFound is returned by CompareSnapshots when the user has no
+// snapshot to compare.
+var ErrSnapshotNotFound = errors.New("board snapshot not found")
+
+// snapshotCards aggregates the cards of the applications a (joined to
+// their job as jf) into a SnapshotCard array, oldest card first.
+const snapshotCards = `COALESCE(jsonb_agg(jsonb_build_object(
+		         'id', a.id, 'status', a.current_status, 'archived', a.archived_at IS NOT NULL,
+		         'company', ` + jobCompanyExpr + `, 'title', ` + jobTitleExpr + `)
+		       ORDER BY a.created_at, a.id), '[]')`
+
+// TakeBoardSnapshot stores a snapshot of the user's board as it is now.
+func (s *Service) TakeBoardSnapshot(ctx context.Context, userID string) (*BoardSnapshot, error) {
+	var b BoardSnapshot
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO board_snapshots (user_id, cards)
+		 SELECT $1, `+snapshotCards+`
+		 FROM applications a
+		 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+		 WHERE a.user_id = $1
+		 RETURNING id::text, taken_at, jsonb_array_length(cards)`,
+		userID).Scan(&b.ID, &b.TakenAt, &b.Cards)
+	if err != nil {
+		return nil, fmt.Errorf("takeBoardSnapshot: %w", err)
+	}
+	return &b, nil
+}
+
+// SnapshotBoards snapshots the board of every user with at least one card
+// whose latest snapshot is older than every (an on-demand one included),
+// then deletes the snapshots older than retention. Returns the number of
+// snapshots taken.
+func (s *Service) SnapshotBoards(ctx context.Context, every, retention time.Duration) (int, error) {
+	var taken int64
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		// Replicas run this side by side.
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('board_snapshots'))`); err != nil {
+			return err
+		}
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO board_snapshots (user_id, cards)
+			 SELECT a.user_id, `+snapshotCards+`
+			 FROM applications a
+			 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+			 WHERE NOT EXISTS (SELECT 1 FROM board_snapshots b
+			                   WHERE b.user_id = a.user_id AND b.taken_at > $1)
+			 GROUP BY a.user_id`,
+			time.Now().Add(-every))
+		if err != nil {
+			return err
+		}
+		taken = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("snapshotBoards: %w", err)
+	}
+
+	tag, err := s.pool.Exec(ctx, `DELETE FROM board_snapshots WHERE taken_at < $1`, time.Now().Add(-retention))
+	if err != nil {
+		return int(taken), fmt.Errorf("snapshotBoards prune: %w", err)
+	}
+	if taken > 0 || tag.RowsAffected() > 0 {
+		slog.Info("board snapshots taken", "count", taken, "pruned", tag.RowsAffected())
+	}
+	return int(taken), nil
+}
+
+// CompareSnapshots returns what changed on the user's board between since
+// and until: from the latest snapshot taken at or before since — or, when
+// there is none, the earliest one taken after — to the latest one taken at
+// or before until. A zero until compares with the board as it is now.
+// ErrSnapshotNotFound when no snapshot qualifies.
+func (s *Service) CompareSnapshots(ctx context.Context, userID string, since, until time.Time) (*SnapshotDiff, error) {
+	if !until.IsZero() && !since.Before(until) {
+		return nil, &ValidationError{Field: "until", Msg: "until must be after since"}
+	}
+
+	var (
+		fromAt, toAt   time.Time
+		fromRaw, toRaw []byte
+	)
+	err := s.pool.QueryRow(ctx,
+		`SELECT taken_at, cards FROM board_snapshots
+		 WHERE user_id = $1
+		 ORDER BY taken_at <= $2 DESC,
+		          CASE WHEN taken_at <= $2 THEN taken_at END DESC,
+		          taken_at
+		 LIMIT 1`,
+		userID, since).Scan(&fromAt, &fromRaw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("compareSnapshots from: %w", err)
+	}
+
+	if until.IsZero() {
+		err = s.pool.QueryRow(ctx,
+			`SELECT NOW(), `+snapshotCards+`
+			 FROM applications a
+			 LEFT JOIN job_feed jf ON jf.id = a.job_feed_id
+			 WHERE a.user_id = $1`,
+			userID).Scan(&toAt, &toRaw)
+	} else {
+		err = s.pool.QueryRow(ctx,
+			`SELECT taken_at, cards FROM board_snapshots
+			 WHERE user_id = $1 AND taken_at <= $2
+			 ORDER BY taken_at DESC
+			 LIMIT 1`,
+			userID, until).Scan(&toAt, &toRaw)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("compareSnapshots to: %w", err)
+	}
+
+	var from, to []SnapshotCard
+	if err := json.Unmarshal(fromRaw, &from); err != nil {
+		return nil, fmt.Errorf("compareSnapshots decode: %w", err)
+	}
+	if err := json.Unmarshal(toRaw, &to); err != nil {
+		return nil, fmt.Errorf("compareSnapshots decode: %w", err)
+	}
+	diff := diffSnapshots(from, to)
+	diff.FromTakenAt, diff.ToTakenAt = fromAt, toAt
+	return diff, nil
+}
+
+// diffSnapshots compares two snapshots' cards. Added, moved, archived and
+// restored cards keep to's order, removed ones from's.
+func diffSnapshots(from, to []SnapshotCard) *SnapshotDiff {
+	d := &SnapshotDiff{
+		Added:    make([]SnapshotCard, 0),
+		Removed:  make([]SnapshotCard, 0),
+		Moved:    make([]SnapshotMove, 0),
+		Archived: make([]SnapshotCard, 0),
+		Restored: make([]SnapshotCard, 0),
+	}
+	before := make(map[string]SnapshotCard, len(from))
+	for _, c := range from {
+		before[c.ApplicationID] = c
+	}
+	after := make(map[string]bool, len(to))
+	for _, c := range to {
+		after[c.ApplicationID] = true
+		old, ok := before[c.ApplicationID]
+		if !ok {
+			d.Added = append(d.Added, c)
+			continue
+		}
+		if old.Status != c.Status {
+			d.Moved = append(d.Moved, SnapshotMove{Card: c, From: old.Status})
+		}
+		switch {
+		case c.Archived && !old.Archived:
+			d.Archived = append(d.Archived, c)
+		case !c.Archived && old.Archived:
+			d.Restored = append(d.Restored, c)
+		}
+	}
+	for _, c := range from {
+		if !after[c.ApplicationID] {
+			d.Removed = append(d.Removed, c)
+		}
+	}
+	return d
+}
@@ -0,0 +1,52 @@
+package kanban_test
+
+import (
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	from := []kanban.SnapshotCard{
+		{ApplicationID: "a1", Status: kanban.StatusToApply},
+		{ApplicationID: "a2", Status: kanban.StatusApplied},
+		{ApplicationID: "a3", Status: kanban.StatusApplied, Archived: true},
+		{ApplicationID: "a4", Status: kanban.StatusInterview},
+	}
+	to := []kanban.SnapshotCard{
+		{ApplicationID: "a1", Status: kanban.StatusApplied},
+		{ApplicationID: "a2", Status: kanban.StatusRejected, Archived: true},
+		{ApplicationID: "a3", Status: kanban.StatusApplied},
+		{ApplicationID: "a5", Status: kanban.StatusToApply},
+	}
+	d := kanban.DiffSnapshots(from, to)
+
+	if len(d.Added) != 1 || d.Added[0].ApplicationID != "a5" {
+		t.Errorf("Added = %+v, want a5", d.Added)
+	}
+	if len(d.Removed) != 1 || d.Removed[0].ApplicationID != "a4" {
+		t.Errorf("Removed = %+v, want a4", d.Removed)
+	}
+	if len(d.Moved) != 2 ||
+		d.Moved[0].Card.ApplicationID != "a1" || d.Moved[0].From != kanban.StatusToApply ||
+		d.Moved[1].Card.ApplicationID != "a2" || d.Moved[1].Card.Status != kanban.StatusRejected {
+		t.Errorf("Moved = %+v, want a1 TO_APPLY→APPLIED, a2 APPLIED→REJECTED", d.Moved)
+	}
+	if len(d.Archived) != 1 || d.Archived[0].ApplicationID != "a2" {
+		t.Errorf("Archived = %+v, want a2", d.Archived)
+	}
+	if len(d.Restored) != 1 || d.Restored[0].ApplicationID != "a3" {
+		t.Errorf("Restored = %+v, want a3", d.Restored)
+	}
+}
+
+func TestDiffSnapshots_Unchanged(t *testing.T) {
+	cards := []kanban.SnapshotCard{{ApplicationID: "a1", Status: kanban.StatusApplied}}
+	d := kanban.DiffSnapshots(cards, cards)
+	if len(d.Added)+len(d.Removed)+len(d.Moved)+len(d.Archived)+len(d.Restored) != 0 {
+		t.Errorf("diff of identical snapshots = %+v, want empty", d)
+	}
+	if d.Added == nil || d.Moved == nil {
+		t.Error("empty lists must not be nil")
+	}
+}
@@ -0,0 +1,415 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CardSetup is what a new card starts with besides its job: what
+// CloneApplication copies from another card, and what an
+// ApplicationTemplate holds for a kind of application ("Backend role via
+// referral", "Agency posting", …).
+type CardSetup struct {
+	Priority      Priority `json:"priority"`
+	NextStepLabel string   `json:"nextStepLabel,omitempty"` // the label only: no due date
+	// ReminderRule is set on the card unless zero; an empty Status means
+	// the card's own.
+	ReminderRule ReminderRule `json:"reminderRule"`
+	// Notes become the card's first notes, in order.
+	Notes []string `json:"notes"`
+}
+
+// ApplicationTemplate is a user-defined CardSetup cards can be created from
+// (CreateApplicationFromTemplate).
+type ApplicationTemplate struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	CardSetup
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewCardJob is the job of a card created by CloneApplication or
+// CreateApplicationFromTemplate: a job_feed entry (JobFeedID) or, without
+// one, a job found outside JobMate (Manual, as in CreateManualApplication).
+type NewCardJob struct {
+	JobFeedID string
+	Manual    ManualJob
+}
+
+const (
+	maxTemplatesPerUser = 50
+	maxTemplateNameLen  = 100
+	maxTemplateNotes    = 20
+)
+
+// ErrTemplateNotFound is returned when a template is missing or owned by someone else.
+var ErrTemplateNotFound = fmt.Errorf("application template not found")
+
+const templateSelect = `SELECT id::text, name, priority::text, COALESCE(next_step_label, ''),
+	COALESCE(relance_rule_status::text, ''), COALESCE(relance_rule_after_days, 0), COALESCE(relance_rule_every_days, 0),
+	created_at, updated_at
+	FROM application_templates`
+
+func scanTemplate(row pgx.Row) (*ApplicationTemplate, error) {
+	var t ApplicationTemplate
+	if err := row.Scan(&t.ID, &t.Name, &t.Priority, &t.NextStepLabel,
+		&t.ReminderRule.Status, &t.ReminderRule.AfterDays, &t.ReminderRule.EveryDays,
+		&t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	t.Notes = make([]string, 0)
+	return &t, nil
+}
+
+// CloneApplication creates a card for another job with the setup of an
+// existing one: its priority, next step label, reminder rule and notes.
+// The new card starts at TO_APPLY, like any new card; duplicates are
+// detected as in CreateApplication.
+func (s *Service) CloneApplication(ctx context.Context, userID, appID string, job NewCardJob, confirmDuplicate bool) (*Application, error) {
+	setup := CardSetup{Notes: make([]string, 0)}
+	err := s.pool.QueryRow(ctx,
+		`SELECT priority::text, COALESCE(next_step_label, ''), COALESCE(relance_rule_status::text, ''),
+		        COALESCE(relance_rule_after_days, 0), COALESCE(relance_rule_every_days, 0)
+		 FROM applications WHERE id = $1 AND user_id = $2`,
+		appID, userID,
+	).Scan(&setup.Priority, &setup.NextStepLabel,
+		&setup.ReminderRule.Status, &setup.ReminderRule.AfterDays, &setup.ReminderRule.EveryDays)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cloneApplication: %w", err)
+	}
+	notes, err := s.listNotes(ctx, userID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("cloneApplication notes: %w", err)
+	}
+	for _, n := range notes {
+		setup.Notes = append(setup.Notes, n.Text)
+	}
+	return s.createCard(ctx, userID, job, confirmDuplicate, setup)
+}
+
+// CreateApplicationFromTemplate creates a card for a job with a template's
+// setup, as CloneApplication does.
+func (s *Service) CreateApplicationFromTemplate(ctx context.Context, userID, templateID string, job NewCardJob, confirmDuplicate bool) (*Application, error) {
+	t, err := s.getTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return s.createCard(ctx, userID, job, confirmDuplicate, t.CardSetup)
+}
+
+// createCard creates userID's TO_APPLY card for job with setup applied,
+// publishing the same events as CreateApplication and
+// CreateManualApplication. A job_feed entry already on the board is a
+// ValidationError: there can only be one card per job.
+func (s *Service) createCard(ctx context.Context, userID string, job NewCardJob, confirmDuplicate bool, setup CardSetup) (*Application, error) {
+	if job.JobFeedID == "" {
+		return s.createManualApplication(ctx, userID, job.Manual, confirmDuplicate, StatusToApply, nil, &setup)
+	}
+
+	var app *Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		ptx := s.pgTx(tx)
+		company, title, err := ptx.JobIdentity(ctx, job.JobFeedID)
+		if err != nil {
+			return err
+		}
+		if !confirmDuplicate {
+			if err := s.checkDuplicate(ctx, ptx, userID, company, title); err != nil {
+				return err
+			}
+		}
+		a, created, err := ptx.InsertApplication(ctx, userID, job.JobFeedID)
+		if err != nil {
+			return err
+		}
+		if !created {
+			return &ValidationError{Field: "job_feed_id", Msg: "this job is already on your board"}
+		}
+		if err := s.applyCardSetup(ctx, tx, userID, a, setup); err != nil {
+			return err
+		}
+		if err := enqueueAnalyzeJob(ctx, ptx, userID, a.ID, job.JobFeedID); err != nil {
+			return err
+		}
+		app = a
+		return enqueueApplicationCreated(ctx, ptx, userID, a)
+	})
+	var (
+		ve  *ValidationError
+		dup *DuplicateError
+	)
+	if errors.As(err, &ve) || errors.As(err, &dup) || errors.Is(err, ErrJobNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("createCard: %w", err)
+	}
+	return app, nil
+}
+
+// applyCardSetup adds setup's notes to a card just created and sets its
+// priority, next step label and reminder rule, updating app.
+func (s *Service) applyCardSetup(ctx context.Context, q querier, userID string, app *Application, setup CardSetup) error {
+	if setup.Priority == "" {
+		setup.Priority = PriorityMedium
+	}
+	if len(setup.Notes) > 0 {
+		sealed := make([]string, len(setup.Notes))
+		for i, n := range setup.Notes {
+			sealed[i] = s.seal(n)
+		}
+		// One microsecond apart, so they list in order.
+		if _, err := q.Exec(ctx,
+			`INSERT INTO application_notes (application_id, user_id, body, created_at)
+			 SELECT $1, $2, n.body, NOW() + n.i * INTERVAL '1 microsecond'
+			 FROM unnest($3::text[]) WITH ORDINALITY AS n(body, i)`,
+			app.ID, userID, sealed); err != nil {
+			return fmt.Errorf("card setup notes: %w", err)
+		}
+	}
+	err := q.QueryRow(ctx,
+		`WITH upd AS (
+		   UPDATE applications
+		   SET priority                = $2::application_priority,
+		       next_step_label         = NULLIF($3, ''),
+		       relance_rule_status     = CASE WHEN $4 > 0 THEN COALESCE(NULLIF($6, '')::application_status, current_status) END,
+		       relance_rule_after_days = NULLIF($4, 0),
+		       relance_rule_every_days = CASE WHEN $4 > 0 THEN $5::int END,
+		       user_notes = (SELECT body FROM application_notes
+		                     WHERE application_id = applications.id
+		                     ORDER BY created_at DESC, id DESC LIMIT 1)
+		   WHERE id = $1
+		   RETURNING *
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		app.ID, string(setup.Priority), setup.NextStepLabel,
+		setup.ReminderRule.AfterDays, setup.ReminderRule.EveryDays, string(setup.ReminderRule.Status),
+	).Scan(s.appScanDest(app)...)
+	if err != nil {
+		return fmt.Errorf("card setup: %w", err)
+	}
+	return nil
+}
+
+// ListTemplates returns the user's application templates by name.
+func (s *Service) ListTemplates(ctx context.Context, userID string) ([]ApplicationTemplate, error) {
+	rows, err := s.pool.Query(ctx, templateSelect+` WHERE user_id = $1 ORDER BY name`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listTemplates query: %w", err)
+	}
+	templates := make([]ApplicationTemplate, 0)
+	byID := make(map[string]int)
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("listTemplates scan: %w", err)
+		}
+		byID[t.ID] = len(templates)
+		templates = append(templates, *t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listTemplates: %w", err)
+	}
+
+	rows, err = s.pool.Query(ctx,
+		`SELECT template_id::text, body FROM application_template_notes
+		 WHERE user_id = $1 ORDER BY template_id, position`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listTemplates notes: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var templateID, body string
+		if err := rows.Scan(&templateID, s.sealed(&body)); err != nil {
+			return nil, fmt.Errorf("listTemplates notes scan: %w", err)
+		}
+		if i, ok := byID[templateID]; ok {
+			templates[i].Notes = append(templates[i].Notes, body)
+		}
+	}
+	return templates, rows.Err()
+}
+
+// getTemplate returns one of the user's templates with its notes.
+func (s *Service) getTemplate(ctx context.Context, userID, templateID string) (*ApplicationTemplate, error) {
+	t, err := scanTemplate(s.pool.QueryRow(ctx, templateSelect+` WHERE id = $1 AND user_id = $2`, templateID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getTemplate: %w", err)
+	}
+	rows, err := s.pool.Query(ctx,
+		`SELECT body FROM application_template_notes WHERE template_id = $1 ORDER BY position`, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("getTemplate notes: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var body string
+		if err := rows.Scan(s.sealed(&body)); err != nil {
+			return nil, fmt.Errorf("getTemplate notes scan: %w", err)
+		}
+		t.Notes = append(t.Notes, body)
+	}
+	return t, rows.Err()
+}
+
+// CreateTemplate saves a new application template.
+func (s *Service) CreateTemplate(ctx context.Context, userID, name string, setup CardSetup) (*ApplicationTemplate, error) {
+	return s.saveTemplate(ctx, userID, "", name, setup)
+}
+
+// UpdateTemplate replaces a template's name and setup.
+func (s *Service) UpdateTemplate(ctx context.Context, userID, templateID, name string, setup CardSetup) (*ApplicationTemplate, error) {
+	return s.saveTemplate(ctx, userID, templateID, name, setup)
+}
+
+// saveTemplate creates (templateID empty) or replaces a template.
+func (s *Service) saveTemplate(ctx context.Context, userID, templateID, name string, setup CardSetup) (*ApplicationTemplate, error) {
+	name, setup, err := normalizeTemplate(name, setup)
+	if err != nil {
+		return nil, err
+	}
+
+	var t *ApplicationTemplate
+	err = s.inTx(ctx, func(tx pgx.Tx) error {
+		args := []any{userID, name, string(setup.Priority), setup.NextStepLabel,
+			setup.ReminderRule.AfterDays, setup.ReminderRule.EveryDays, string(setup.ReminderRule.Status)}
+		returning := ` RETURNING id::text, name, priority::text, COALESCE(next_step_label, ''),
+			COALESCE(relance_rule_status::text, ''), COALESCE(relance_rule_after_days, 0), COALESCE(relance_rule_every_days, 0),
+			created_at, updated_at`
+		var row pgx.Row
+		if templateID == "" {
+			// Serialize the count check per user.
+			if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('application_templates:' || $1))`, userID); err != nil {
+				return err
+			}
+			var count int
+			if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM application_templates WHERE user_id = $1`, userID).Scan(&count); err != nil {
+				return err
+			}
+			if count >= maxTemplatesPerUser {
+				return &ValidationError{Msg: fmt.Sprintf("at most %d application templates are allowed", maxTemplatesPerUser)}
+			}
+			row = tx.QueryRow(ctx,
+				`INSERT INTO application_templates
+				   (user_id, name, priority, next_step_label, relance_rule_after_days, relance_rule_every_days, relance_rule_status)
+				 VALUES ($1, $2, $3::application_priority, NULLIF($4, ''), NULLIF($5, 0),
+				         CASE WHEN $5 > 0 THEN $6::int END, CASE WHEN $5 > 0 THEN NULLIF($7, '')::application_status END)`+returning,
+				args...)
+		} else {
+			row = tx.QueryRow(ctx,
+				`UPDATE application_templates
+				 SET name = $2, priority = $3::application_priority, next_step_label = NULLIF($4, ''),
+				     relance_rule_after_days = NULLIF($5, 0),
+				     relance_rule_every_days = CASE WHEN $5 > 0 THEN $6::int END,
+				     relance_rule_status     = CASE WHEN $5 > 0 THEN NULLIF($7, '')::application_status END,
+				     updated_at = NOW()
+				 WHERE id = $8 AND user_id = $1`+returning,
+				append(args, templateID)...)
+		}
+		saved, err := scanTemplate(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTemplateNotFound
+		}
+		if isUniqueViolation(err) {
+			return &ValidationError{Field: "name", Msg: fmt.Sprintf("a template named %q already exists", name)}
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM application_template_notes WHERE template_id = $1`, saved.ID); err != nil {
+			return err
+		}
+		if len(setup.Notes) > 0 {
+			sealed := make([]string, len(setup.Notes))
+			for i, n := range setup.Notes {
+				sealed[i] = s.seal(n)
+			}
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO application_template_notes (template_id, user_id, position, body)
+				 SELECT $1, $2, n.i, n.body FROM unnest($3::text[]) WITH ORDINALITY AS n(body, i)`,
+				saved.ID, userID, sealed); err != nil {
+				return err
+			}
+		}
+		saved.Notes = setup.Notes
+		t = saved
+		return nil
+	})
+	var ve *ValidationError
+	if errors.Is(err, ErrTemplateNotFound) || errors.As(err, &ve) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("saveTemplate: %w", err)
+	}
+	return t, nil
+}
+
+// DeleteTemplate removes a template; cards created from it are unaffected.
+func (s *Service) DeleteTemplate(ctx context.Context, userID, templateID string) error {
+	tag, err := s.pool.Exec(ctx,
+		`DELETE FROM application_templates WHERE id = $1 AND user_id = $2`, templateID, userID)
+	if err != nil {
+		return fmt.Errorf("deleteTemplate: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrTemplateNotFound
+	}
+	return nil
+}
+
+// normalizeTemplate validates a template's name and setup.
+func normalizeTemplate(name string, setup CardSetup) (string, CardSetup, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", setup, &ValidationError{Field: "name", Msg: "name is required"}
+	}
+	if utf8.RuneCountInString(name) > maxTemplateNameLen {
+		return "", setup, &ValidationError{Field: "name", Msg: fmt.Sprintf("name must be at most %d characters", maxTemplateNameLen)}
+	}
+
+	if setup.Priority == "" {
+		setup.Priority = PriorityMedium
+	}
+	p, err := ParsePriority(string(setup.Priority))
+	if err != nil {
+		return "", setup, &ValidationError{Field: "priority", Msg: err.Error()}
+	}
+	setup.Priority = p
+	setup.NextStepLabel = strings.TrimSpace(setup.NextStepLabel)
+	if utf8.RuneCountInString(setup.NextStepLabel) > maxNextStepLabelLen {
+		return "", setup, &ValidationError{Field: "next_step_label", Msg: fmt.Sprintf("next step label must be at most %d characters", maxNextStepLabelLen)}
+	}
+	if setup.ReminderRule, err = normalizeReminderRule(setup.ReminderRule); err != nil {
+		return "", setup, err
+	}
+
+	if len(setup.Notes) > maxTemplateNotes {
+		return "", setup, &ValidationError{Field: "notes", Msg: fmt.Sprintf("at most %d notes per template", maxTemplateNotes)}
+	}
+	notes := make([]string, len(setup.Notes))
+	for i, n := range setup.Notes {
+		if notes[i], err = cleanNote(n); err != nil {
+			return "", setup, err
+		}
+	}
+	setup.Notes = notes
+	return name, setup, nil
+}
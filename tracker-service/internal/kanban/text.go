@@ -0,0 +1,42 @@
+package kanban
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Size limits for user- and AI-supplied free text, in characters. They keep
+// a pasted document from bloating rows and every listing that carries them.
+const (
+	maxNoteLen         = 10_000
+	maxMoveReasonLen   = 500
+	maxRatingReviewLen = 500
+)
+
+// cleanText validates and normalizes free text stored on an application:
+// it must be valid UTF-8, CRLF line endings become LF, control characters
+// other than newline and tab are stripped, surrounding whitespace is trimmed,
+// and the result may not exceed maxLen characters.
+// field names the offending request field in the returned ValidationError.
+func cleanText(field, s string, maxLen int) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", &ValidationError{Field: field, Msg: field + " must be valid UTF-8"}
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, s)
+	s = strings.TrimSpace(s)
+	if n := utf8.RuneCountInString(s); n > maxLen {
+		return "", &ValidationError{
+			Field: field,
+			Msg:   fmt.Sprintf("%s must be at most %d characters (got %d)", field, maxLen, n),
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,49 @@
+package kanban_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestCleanText(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"  hello  ", "hello"},
+		{"line 1\r\nline 2", "line 1\nline 2"},
+		{"tab\tkept", "tab\tkept"},
+		{"bell\a and nul\x00 stripped", "bell and nul stripped"},
+		{"esc\x1b[31mred", "esc[31mred"},
+		{"déjà vu — ok", "déjà vu — ok"},
+	}
+	for _, c := range cases {
+		got, err := kanban.CleanText("note", c.in, 100)
+		if err != nil {
+			t.Errorf("CleanText(%q) returned unexpected error: %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("CleanText(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestCleanText_Rejects(t *testing.T) {
+	for name, in := range map[string]string{
+		"invalid utf-8": "bad \xff byte",
+		"too long":      strings.Repeat("é", 11),
+	} {
+		_, err := kanban.CleanText("note", in, 10)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("%s: error = %v, want ValidationError", name, err)
+			continue
+		}
+		if ve.Field != "note" {
+			t.Errorf("%s: Field = %q, want %q", name, ve.Field, "note")
+		}
+	}
+}
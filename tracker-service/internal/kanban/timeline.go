@@ -0,0 +1,137 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Timeline entry kinds besides the History* ones, for what is not recorded
+// in history_log but in its own table.
+const (
+	// TimelineNote is a note added to the card.
+	TimelineNote = "NOTE"
+	// TimelineInterview is an interview, at its scheduled time (or when it
+	// was added, while unscheduled).
+	TimelineInterview = "INTERVIEW"
+)
+
+// TimelineEntry is one event of an application's activity. Exactly one of
+// History, Note and Interview is set, according to Kind.
+type TimelineEntry struct {
+	Kind      string        `json:"kind"` // one of the History* or Timeline* kinds
+	At        time.Time     `json:"at"`
+	Actor     string        `json:"actor"` // one of the Actor* values
+	History   *HistoryEntry `json:"history,omitempty"`
+	Note      *Note         `json:"note,omitempty"`
+	Interview *Interview    `json:"interview,omitempty"`
+}
+
+// TimelinePage is one page of an application's timeline, newest entries
+// first.
+type TimelinePage struct {
+	Entries       []TimelineEntry `json:"entries"`
+	NextPageToken string          `json:"nextPageToken"` // "" on the last page
+	TotalCount    int             `json:"totalCount"`
+}
+
+// GetTimeline page sizes.
+const (
+	defaultTimelinePageSize = 50
+	maxTimelinePageSize     = 200
+)
+
+// GetTimeline returns a page of everything that happened to an application,
+// newest first, for its detail screen: the entries of its history log (moves,
+// reminders set, snoozed, cleared and fired, analyses, interview feedback,
+// merges…), its notes and its interviews. pageSize 0 means
+// defaultTimelinePageSize; pageToken is the NextPageToken of the previous
+// page ("" = first page).
+//
+// Tokens are offsets: activity recorded between two calls shifts the
+// following page, which may then repeat entries.
+func (s *Service) GetTimeline(ctx context.Context, userID, appID string, pageSize int, pageToken string) (*TimelinePage, error) {
+	size, offset, err := parseTimelinePage(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []byte
+	err = s.pool.QueryRow(ctx,
+		`SELECT history_log FROM applications WHERE id = $1 AND user_id = $2`,
+		appID, userID,
+	).Scan(&raw)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var history []HistoryEntry
+	if err := json.Unmarshal(raw, &history); err != nil {
+		return nil, fmt.Errorf("getTimeline decode: %w", err)
+	}
+	notes, err := s.listNotes(ctx, userID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeline: %w", err)
+	}
+	interviews, err := listInterviews(ctx, s.pool, userID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("getTimeline: %w", err)
+	}
+
+	entries := mergeTimeline(history, notes, interviews)
+	page := &TimelinePage{TotalCount: len(entries), Entries: []TimelineEntry{}}
+	if offset < len(entries) {
+		end := min(offset+size, len(entries))
+		page.Entries = entries[offset:end]
+		if end < len(entries) {
+			page.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return page, nil
+}
+
+// parseTimelinePage validates GetTimeline's paging arguments. offset is the
+// number of entries of the previous pages.
+func parseTimelinePage(pageSize int, pageToken string) (size, offset int, err error) {
+	switch {
+	case pageSize < 0 || pageSize > maxTimelinePageSize:
+		return 0, 0, &ValidationError{Field: "page_size", Msg: fmt.Sprintf("page_size must be between 0 and %d", maxTimelinePageSize)}
+	case pageSize == 0:
+		pageSize = defaultTimelinePageSize
+	}
+	if pageToken == "" {
+		return pageSize, 0, nil
+	}
+	offset, err = strconv.Atoi(pageToken)
+	if err != nil || offset < 1 {
+		return 0, 0, &ValidationError{Field: "page_token", Msg: "invalid page_token"}
+	}
+	return pageSize, offset, nil
+}
+
+// mergeTimeline orders the history log, notes and interviews of an
+// application into its timeline, newest first. Entries at the same time keep
+// the order of the log (later entries first), then notes, then interviews.
+func mergeTimeline(history []HistoryEntry, notes []Note, interviews []Interview) []TimelineEntry {
+	entries := make([]TimelineEntry, 0, len(history)+len(notes)+len(interviews))
+	for i := len(history) - 1; i >= 0; i-- {
+		e := typedHistoryEntry(history[i])
+		entries = append(entries, TimelineEntry{Kind: e.Kind, At: e.At, Actor: e.Actor, History: &e})
+	}
+	for i := len(notes) - 1; i >= 0; i-- {
+		n := notes[i]
+		entries = append(entries, TimelineEntry{Kind: TimelineNote, At: n.CreatedAt, Actor: ActorUser, Note: &n})
+	}
+	for i := range interviews {
+		iv := interviews[i]
+		at := iv.CreatedAt
+		if iv.ScheduledAt != nil {
+			at = *iv.ScheduledAt
+		}
+		entries = append(entries, TimelineEntry{Kind: TimelineInterview, At: at, Actor: ActorUser, Interview: &iv})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].At.After(entries[j].At) })
+	return entries
+}
@@ -0,0 +1,62 @@
+package kanban_test
+
+import (
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+func TestMergeTimeline(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 9, 0, 0, 0, time.UTC) }
+	scheduled := day(8)
+	history := []kanban.HistoryEntry{
+		{From: "TO_APPLY", To: "APPLIED", At: day(1)},
+		{Kind: kanban.HistoryAnalyzed, At: day(1), Outcome: "done", Actor: kanban.ActorSystem},
+		{Kind: kanban.HistoryReminderFired, At: day(6), Actor: kanban.ActorSystem},
+	}
+	notes := []kanban.Note{
+		{ID: "note-1", Text: "Called the recruiter", CreatedAt: day(3)},
+		{ID: "note-2", Text: "Sent portfolio", CreatedAt: day(7)},
+	}
+	interviews := []kanban.Interview{
+		{ID: "iv-1", Round: 1, ScheduledAt: &scheduled, CreatedAt: day(4)},
+		{ID: "iv-2", Round: 2, CreatedAt: day(5)},
+	}
+
+	got := kanban.MergeTimeline(history, notes, interviews)
+	want := []struct {
+		kind  string
+		at    time.Time
+		actor string
+	}{
+		{kanban.TimelineInterview, day(8), kanban.ActorUser},
+		{kanban.TimelineNote, day(7), kanban.ActorUser},
+		{kanban.HistoryReminderFired, day(6), kanban.ActorSystem},
+		{kanban.TimelineInterview, day(5), kanban.ActorUser}, // unscheduled: when it was added
+		{kanban.TimelineNote, day(3), kanban.ActorUser},
+		{kanban.HistoryAnalyzed, day(1), kanban.ActorSystem}, // later in the log
+		{kanban.HistoryMove, day(1), kanban.ActorUser},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("MergeTimeline: %d entries, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		e := got[i]
+		if e.Kind != w.kind || !e.At.Equal(w.at) || e.Actor != w.actor {
+			t.Errorf("entry %d = %s at %s by %s, want %s at %s by %s", i, e.Kind, e.At, e.Actor, w.kind, w.at, w.actor)
+		}
+		set := 0
+		for _, p := range []bool{e.History != nil, e.Note != nil, e.Interview != nil} {
+			if p {
+				set++
+			}
+		}
+		if set != 1 {
+			t.Errorf("entry %d (%s): %d of History, Note and Interview set, want 1", i, e.Kind, set)
+		}
+	}
+	if got[0].Interview.ID != "iv-1" || got[1].Note.ID != "note-2" || got[6].History.To != "APPLIED" {
+		t.Errorf("MergeTimeline attached the wrong records: %+v", got)
+	}
+}
@@ -5,8 +5,17 @@
 //	TO_APPLY ──► APPLIED ──► INTERVIEW ──► OFFER ──► HIRED
 //	    │            │             │           │
 //	    └────────────┴─────────────┴───────────┴──► REJECTED
+//	                 │             │           │
+//	                 └─────────────┴───────────┴──► WITHDRAWN
 //
-// HIRED and REJECTED are terminal states.
+//	APPLIED ◄──► ON_HOLD ◄──► INTERVIEW
+//
+// ON_HOLD parks a paused process (hiring freeze, recruiter on leave) outside
+// the active columns. A held card resumes only to the stage it was paused
+// from (see IsResumeAllowed), or closes as REJECTED / WITHDRAWN.
+//
+// HIRED, REJECTED and WITHDRAWN are terminal states. WITHDRAWN records that
+// the candidate left the process themselves, as opposed to being rejected.
 package kanban
 
 import "fmt"
@@ -21,15 +30,18 @@ const (
 	StatusOffer     Status = "OFFER"
 	StatusHired     Status = "HIRED"
 	StatusRejected  Status = "REJECTED"
+	StatusWithdrawn Status = "WITHDRAWN"
+	StatusOnHold    Status = "ON_HOLD"
 )
 
 // validTransitions lists every allowed (from → to) pair.
 var validTransitions = map[Status][]Status{
 	StatusToApply:   {StatusApplied, StatusRejected},
-	StatusApplied:   {StatusInterview, StatusRejected},
-	StatusInterview: {StatusOffer, StatusRejected},
-	StatusOffer:     {StatusHired, StatusRejected},
-	// HIRED and REJECTED are terminal — no outgoing transitions
+	StatusApplied:   {StatusInterview, StatusRejected, StatusWithdrawn, StatusOnHold},
+	StatusInterview: {StatusOffer, StatusRejected, StatusWithdrawn, StatusOnHold},
+	StatusOffer:     {StatusHired, StatusRejected, StatusWithdrawn},
+	StatusOnHold:    {StatusApplied, StatusInterview, StatusRejected, StatusWithdrawn},
+	// HIRED, REJECTED and WITHDRAWN are terminal — no outgoing transitions
 }
 
 // ParseStatus converts a raw string to a Status, returning an error for
@@ -37,7 +49,7 @@ var validTransitions = map[Status][]Status{
 func ParseStatus(s string) (Status, error) {
 	st := Status(s)
 	switch st {
-	case StatusToApply, StatusApplied, StatusInterview, StatusOffer, StatusHired, StatusRejected:
+	case StatusToApply, StatusApplied, StatusInterview, StatusOffer, StatusHired, StatusRejected, StatusWithdrawn, StatusOnHold:
 		return st, nil
 	}
 	return "", fmt.Errorf("unknown application status %q", s)
@@ -58,5 +70,16 @@ func IsTransitionAllowed(from, to Status) bool {
 	return false
 }
 
+// IsResumeAllowed reports whether a card held since origin may move to `to`.
+// Leaving ON_HOLD for an active column is only allowed back to the stage the
+// card was paused from; closing moves (REJECTED, WITHDRAWN) are always fine.
+// It complements IsTransitionAllowed, which cannot know the origin.
+func IsResumeAllowed(origin, to Status) bool {
+	if to == StatusApplied || to == StatusInterview {
+		return to == origin
+	}
+	return IsTransitionAllowed(StatusOnHold, to)
+}
+
 // IsHired returns true when status is HIRED (triggers search-config archival).
 func IsHired(s Status) bool { return s == StatusHired }
@@ -14,7 +14,7 @@ import (
 
 // ParseStatus must be case-sensitive — lowercase variants must not be valid.
 func TestParseStatus_CaseSensitive(t *testing.T) {
-	lowercase := []string{"to_apply", "applied", "interview", "offer", "hired", "rejected"}
+	lowercase := []string{"to_apply", "applied", "interview", "offer", "hired", "rejected", "withdrawn", "on_hold", "On_Hold"}
 	for _, s := range lowercase {
 		_, err := kanban.ParseStatus(s)
 		if err == nil {
@@ -34,7 +34,7 @@ func TestParseStatus_WithWhitespace(t *testing.T) {
 	}
 }
 
-// All six constants must round-trip through ParseStatus without error.
+// All status constants must round-trip through ParseStatus without error.
 func TestParseStatus_AllConstantsRoundTrip(t *testing.T) {
 	all := []kanban.Status{
 		kanban.StatusToApply,
@@ -43,6 +43,8 @@ func TestParseStatus_AllConstantsRoundTrip(t *testing.T) {
 		kanban.StatusOffer,
 		kanban.StatusHired,
 		kanban.StatusRejected,
+		kanban.StatusWithdrawn,
+		kanban.StatusOnHold,
 	}
 	for _, s := range all {
 		got, err := kanban.ParseStatus(string(s))
@@ -55,10 +57,10 @@ func TestParseStatus_AllConstantsRoundTrip(t *testing.T) {
 	}
 }
 
-// Every terminal state (HIRED, REJECTED) must NOT be the source of any
-// allowed transition, regardless of target.
+// Every terminal state (HIRED, REJECTED, WITHDRAWN) must NOT be the source of
+// any allowed transition, regardless of target.
 func TestIsTransitionAllowed_TerminalStatesHaveNoOutgoing(t *testing.T) {
-	terminals := []kanban.Status{kanban.StatusHired, kanban.StatusRejected}
+	terminals := []kanban.Status{kanban.StatusHired, kanban.StatusRejected, kanban.StatusWithdrawn}
 	allStatuses := []kanban.Status{
 		kanban.StatusToApply,
 		kanban.StatusApplied,
@@ -66,6 +68,7 @@ func TestIsTransitionAllowed_TerminalStatesHaveNoOutgoing(t *testing.T) {
 		kanban.StatusOffer,
 		kanban.StatusHired,
 		kanban.StatusRejected,
+		kanban.StatusWithdrawn,
 	}
 	for _, from := range terminals {
 		for _, to := range allStatuses {
@@ -88,6 +91,7 @@ func TestIsHired_StrictEquality(t *testing.T) {
 		kanban.StatusInterview,
 		kanban.StatusOffer,
 		kanban.StatusRejected,
+		kanban.StatusWithdrawn,
 	}
 	if !kanban.IsHired(kanban.StatusHired) {
 		t.Error("IsHired(StatusHired) must be true")
@@ -108,6 +112,7 @@ func TestIsTransitionAllowed_ToApplyIsNeverReachable(t *testing.T) {
 		kanban.StatusOffer,
 		kanban.StatusHired,
 		kanban.StatusRejected,
+		kanban.StatusWithdrawn,
 	}
 	for _, from := range sources {
 		if kanban.IsTransitionAllowed(from, kanban.StatusToApply) {
@@ -9,7 +9,7 @@ import (
 // ── ParseStatus ────────────────────────────────────────────────────────────
 
 func TestParseStatus_ValidValues(t *testing.T) {
-	valid := []string{"TO_APPLY", "APPLIED", "INTERVIEW", "OFFER", "HIRED", "REJECTED"}
+	valid := []string{"TO_APPLY", "APPLIED", "INTERVIEW", "OFFER", "HIRED", "REJECTED", "WITHDRAWN", "ON_HOLD"}
 	for _, s := range valid {
 		got, err := kanban.ParseStatus(s)
 		if err != nil {
@@ -92,7 +92,7 @@ func TestIsTransitionAllowed_ToRejected(t *testing.T) {
 // ── IsTransitionAllowed — terminal states have no outgoing transitions ─────
 
 func TestIsTransitionAllowed_FromTerminal(t *testing.T) {
-	terminals := []kanban.Status{kanban.StatusHired, kanban.StatusRejected}
+	terminals := []kanban.Status{kanban.StatusHired, kanban.StatusRejected, kanban.StatusWithdrawn}
 	targets := []kanban.Status{
 		kanban.StatusToApply,
 		kanban.StatusApplied,
@@ -100,6 +100,7 @@ func TestIsTransitionAllowed_FromTerminal(t *testing.T) {
 		kanban.StatusOffer,
 		kanban.StatusHired,
 		kanban.StatusRejected,
+		kanban.StatusWithdrawn,
 	}
 	for _, from := range terminals {
 		for _, to := range targets {
@@ -155,6 +156,7 @@ func TestIsTransitionAllowed_Self(t *testing.T) {
 	all := []kanban.Status{
 		kanban.StatusToApply, kanban.StatusApplied, kanban.StatusInterview,
 		kanban.StatusOffer, kanban.StatusHired, kanban.StatusRejected,
+		kanban.StatusWithdrawn, kanban.StatusOnHold,
 	}
 	for _, s := range all {
 		if kanban.IsTransitionAllowed(s, s) {
@@ -162,3 +164,124 @@ func TestIsTransitionAllowed_Self(t *testing.T) {
 		}
 	}
 }
+
+// ── IsTransitionAllowed — WITHDRAWN ────────────────────────────────────────
+
+func TestIsTransitionAllowed_ToWithdrawn(t *testing.T) {
+	for _, from := range []kanban.Status{
+		kanban.StatusApplied,
+		kanban.StatusInterview,
+		kanban.StatusOffer,
+	} {
+		if !kanban.IsTransitionAllowed(from, kanban.StatusWithdrawn) {
+			t.Errorf("IsTransitionAllowed(%s → WITHDRAWN) should be true", from)
+		}
+	}
+	// Nothing has been sent yet at TO_APPLY — there is nothing to withdraw from.
+	if kanban.IsTransitionAllowed(kanban.StatusToApply, kanban.StatusWithdrawn) {
+		t.Error("IsTransitionAllowed(TO_APPLY → WITHDRAWN) should be false")
+	}
+}
+
+// ── ON_HOLD ────────────────────────────────────────────────────────────────
+
+func TestIsTransitionAllowed_OnHold(t *testing.T) {
+	allowed := []struct {
+		from kanban.Status
+		to   kanban.Status
+	}{
+		{kanban.StatusApplied, kanban.StatusOnHold},
+		{kanban.StatusInterview, kanban.StatusOnHold},
+		{kanban.StatusOnHold, kanban.StatusApplied},
+		{kanban.StatusOnHold, kanban.StatusInterview},
+		{kanban.StatusOnHold, kanban.StatusRejected},
+		{kanban.StatusOnHold, kanban.StatusWithdrawn},
+	}
+	for _, c := range allowed {
+		if !kanban.IsTransitionAllowed(c.from, c.to) {
+			t.Errorf("IsTransitionAllowed(%s → %s) should be true", c.from, c.to)
+		}
+	}
+
+	forbidden := []struct {
+		from kanban.Status
+		to   kanban.Status
+	}{
+		{kanban.StatusToApply, kanban.StatusOnHold},
+		{kanban.StatusOffer, kanban.StatusOnHold},
+		{kanban.StatusOnHold, kanban.StatusOffer},
+		{kanban.StatusOnHold, kanban.StatusHired},
+		{kanban.StatusOnHold, kanban.StatusToApply},
+	}
+	for _, c := range forbidden {
+		if kanban.IsTransitionAllowed(c.from, c.to) {
+			t.Errorf("IsTransitionAllowed(%s → %s) should be false", c.from, c.to)
+		}
+	}
+}
+
+func TestIsResumeAllowed(t *testing.T) {
+	cases := []struct {
+		origin, to kanban.Status
+		want       bool
+	}{
+		{kanban.StatusApplied, kanban.StatusApplied, true},
+		{kanban.StatusInterview, kanban.StatusInterview, true},
+		{kanban.StatusApplied, kanban.StatusInterview, false}, // cannot skip ahead while held
+		{kanban.StatusInterview, kanban.StatusApplied, false}, // nor fall back
+		{kanban.StatusApplied, kanban.StatusRejected, true},
+		{kanban.StatusInterview, kanban.StatusWithdrawn, true},
+		{kanban.StatusInterview, kanban.StatusOffer, false},
+	}
+	for _, c := range cases {
+		if got := kanban.IsResumeAllowed(c.origin, c.to); got != c.want {
+			t.Errorf("IsResumeAllowed(%s, %s) = %v, want %v", c.origin, c.to, got, c.want)
+		}
+	}
+}
+
+// ── TransitionPolicy ───────────────────────────────────────────────────────
+
+func TestTransitionPolicy_ZeroValueIsDefault(t *testing.T) {
+	var p kanban.TransitionPolicy
+	if !p.IsTransitionAllowed(kanban.StatusToApply, kanban.StatusApplied) {
+		t.Error("zero policy should allow TO_APPLY → APPLIED")
+	}
+	if p.IsTransitionAllowed(kanban.StatusToApply, kanban.StatusInterview) {
+		t.Error("zero policy should not allow TO_APPLY → INTERVIEW")
+	}
+}
+
+func TestParseTransitionPolicy_AddsEdges(t *testing.T) {
+	p, err := kanban.ParseTransitionPolicy("TO_APPLY>INTERVIEW, APPLIED > OFFER")
+	if err != nil {
+		t.Fatalf("ParseTransitionPolicy unexpected error: %v", err)
+	}
+	for _, c := range [][2]kanban.Status{
+		{kanban.StatusToApply, kanban.StatusInterview},
+		{kanban.StatusApplied, kanban.StatusOffer},
+		{kanban.StatusToApply, kanban.StatusApplied}, // defaults still apply
+	} {
+		if !p.IsTransitionAllowed(c[0], c[1]) {
+			t.Errorf("policy should allow %s → %s", c[0], c[1])
+		}
+	}
+	if p.IsTransitionAllowed(kanban.StatusToApply, kanban.StatusOffer) {
+		t.Error("policy should not allow unlisted TO_APPLY → OFFER")
+	}
+}
+
+func TestParseTransitionPolicy_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"TO_APPLY-INTERVIEW", // bad separator
+		"TO_APPLY>NOPE",      // unknown status
+		"APPLIED>APPLIED",    // self
+		"HIRED>OFFER",        // terminal states stay terminal
+		"REJECTED>APPLIED",
+		"APPLIED>ON_HOLD", // ON_HOLD rules are fixed
+	} {
+		if _, err := kanban.ParseTransitionPolicy(spec); err == nil {
+			t.Errorf("ParseTransitionPolicy(%q) expected error, got nil", spec)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package kanban
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// UndoLastMove reverts the most recent status change of an application, as
+// long as it happened less than Options.UndoGracePeriod ago — a mistaken drag
+// on the board can be fixed without relaxing the state machine.
+//
+// The revert is recorded as a compensating history entry (undo=true); the
+// original entry is kept. Undoing a HIRED move re-activates the search config
+// archived by it; undoing a move out of REJECTED restores the rejection
+// reason and stage. An undo cannot itself be undone.
+func (s *Service) UndoLastMove(ctx context.Context, userID, appID string) (*Application, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("undoLastMove begin: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after Commit
+
+	app, err := s.undoMove(ctx, tx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("undoLastMove commit: %w", err)
+	}
+	return app, nil
+}
+
+// undoMove is UndoLastMove within tx.
+func (s *Service) undoMove(ctx context.Context, tx pgx.Tx, userID, appID string) (*Application, error) {
+	var (
+		rawHistory []byte
+		archived   bool
+	)
+	err := tx.QueryRow(ctx,
+		`SELECT history_log, archived_at IS NOT NULL
+		 FROM applications WHERE id = $1 AND user_id = $2
+		 FOR UPDATE`,
+		appID, userID,
+	).Scan(&rawHistory, &archived)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	if archived {
+		return nil, &ValidationError{Msg: "application is archived — restore it before undoing"}
+	}
+
+	var history []HistoryEntry
+	if err := json.Unmarshal(rawHistory, &history); err != nil {
+		return nil, fmt.Errorf("undoLastMove history: %w", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	plan, err := planUndo(history, now, s.opts.UndoGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reactivate the search the move to HIRED archived, if it did.
+	var extra []HistoryEntry
+	if IsHired(plan.From) && searchArchivedByLastMove(history) {
+		entry, err := reactivateSearchConfig(ctx, tx, appID, now)
+		if err != nil {
+			return nil, fmt.Errorf("undoLastMove: %w", err)
+		}
+		if entry != nil {
+			extra = append(extra, *entry)
+		}
+	}
+
+	app, err := s.writeMove(ctx, tx, userID, appID, plan.To, plan.HoldOrigin, HistoryEntry{
+		From:            string(plan.From),
+		To:              string(plan.To),
+		At:              now,
+		Undo:            true,
+		RejectionReason: plan.Rejection.Reason,
+		RejectionStage:  string(plan.Rejection.Stage),
+	}, extra...)
+	if err != nil {
+		return nil, fmt.Errorf("undoLastMove update: %w", err)
+	}
+
+	if err := enqueueCardMoved(ctx, s.pgTx(tx), userID, appID, plan.From, plan.To, ""); err != nil {
+		return nil, fmt.Errorf("undoLastMove: %w", err)
+	}
+	return app, nil
+}
+
+// undoPlan describes the compensating move computed by planUndo.
+type undoPlan struct {
+	From, To   Status
+	HoldOrigin Status    // restored when To is ON_HOLD
+	Rejection  Rejection // restored when To is REJECTED
+}
+
+// planUndo works out how to revert the last move of history. Entries that
+// are not moves (interview feedback, …) are skipped.
+func planUndo(history []HistoryEntry, now time.Time, grace time.Duration) (undoPlan, error) {
+	moves := make([]HistoryEntry, 0, len(history))
+	for _, h := range history {
+		if h.IsMove() {
+			moves = append(moves, h)
+		}
+	}
+	history = moves
+	if len(history) == 0 {
+		return undoPlan{}, &ValidationError{Msg: "nothing to undo"}
+	}
+	last := history[len(history)-1]
+	if last.Undo {
+		return undoPlan{}, &ValidationError{Msg: "the last move was already undone"}
+	}
+	if now.Sub(last.At) > grace {
+		return undoPlan{}, &ValidationError{
+			Msg: fmt.Sprintf("the last move is older than %s and can no longer be undone", grace),
+		}
+	}
+
+	plan := undoPlan{From: Status(last.To), To: Status(last.From)}
+	switch plan.To {
+	case StatusOnHold:
+		// The card was paused before the move being undone: find the stage it
+		// had been paused from so it can still only resume there.
+		for i := len(history) - 2; i >= 0; i-- {
+			if Status(history[i].To) == StatusOnHold {
+				plan.HoldOrigin = Status(history[i].From)
+				break
+			}
+		}
+	case StatusRejected:
+		// Likewise, bring back why and when it had been rejected.
+		for i := len(history) - 2; i >= 0; i-- {
+			if Status(history[i].To) == StatusRejected {
+				plan.Rejection = Rejection{
+					Reason: history[i].RejectionReason,
+					Stage:  Status(history[i].RejectionStage),
+				}
+				break
+			}
+		}
+	}
+	return plan, nil
+}
+
+// UndoableAction is an action UndoLastAction can revert.
+type UndoableAction string
+
+const (
+	ActionMove     UndoableAction = "MOVE"      // a status change
+	ActionRating   UndoableAction = "RATING"    // RateApplication, or UpdateApplication's rating
+	ActionNoteEdit UndoableAction = "NOTE_EDIT" // EditNote
+)
+
+// lastActionSources select, for each action, its application, the
+// application's owner, the values the action replaces — sealed ones as
+// stored — and the note it edits ($1 = application ID, or note ID for
+// ActionNoteEdit; $2 = user ID).
+var lastActionSources = map[UndoableAction]string{
+	ActionMove: `SELECT a.id, a.user_id, '{}'::jsonb, NULL::uuid
+	             FROM applications a WHERE a.id = $1 AND a.user_id = $2`,
+	ActionRating: `SELECT a.id, a.user_id,
+	                      jsonb_build_object('userRating', a.user_rating, 'ratingCriteria', a.rating_criteria,
+	                                         'ratingReview', a.rating_review),
+	                      NULL::uuid
+	               FROM applications a WHERE a.id = $1 AND a.user_id = $2`,
+	ActionNoteEdit: `SELECT n.application_id, n.user_id, jsonb_build_object('body', n.body, 'editedAt', n.edited_at), n.id
+	                 FROM application_notes n WHERE n.id = $1 AND n.user_id = $2`,
+}
+
+// recordLastAction records action as the last one of its application (see
+// lastActionSources for id). It must run in the action's transaction,
+// before the action writes anything. Does nothing for rows that do not
+// exist or belong to userID.
+func recordLastAction(ctx context.Context, q querier, action UndoableAction, id, userID string) error {
+	_, err := q.Exec(ctx,
+		`INSERT INTO application_last_actions (application_id, user_id, previous, note_id, action)
+		 SELECT src.*, $3 FROM (`+lastActionSources[action]+`) src
+		 ON CONFLICT (application_id) DO UPDATE
+		   SET action = EXCLUDED.action, note_id = EXCLUDED.note_id, previous = EXCLUDED.previous, at = NOW()`,
+		id, userID, string(action))
+	if err != nil {
+		return fmt.Errorf("record last action: %w", err)
+	}
+	return nil
+}
+
+// UndoLastAction reverts the last move, rating change or note edit of an
+// application, as long as it was made less than Options.UndoGracePeriod
+// ago. A move is undone as by UndoLastMove; otherwise the rating (stars,
+// criteria and review) or the note's text is restored and a HistoryUndo
+// entry is appended to the history. An undo cannot itself be undone.
+func (s *Service) UndoLastAction(ctx context.Context, userID, appID string) (*Application, error) {
+	var app *Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		var (
+			action *UndoableAction
+			at     *time.Time
+		)
+		err := tx.QueryRow(ctx,
+			`SELECT l.action, l.at
+			 FROM applications a
+			 LEFT JOIN application_last_actions l ON l.application_id = a.id
+			 WHERE a.id = $1 AND a.user_id = $2
+			 FOR UPDATE OF a`,
+			appID, userID,
+		).Scan(&action, &at)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		if err := checkUndoable(action, at, now, s.opts.UndoGracePeriod); err != nil {
+			return err
+		}
+
+		// The compensating move clears the record itself (see writeMove).
+		if *action == ActionMove {
+			app, err = s.undoMove(ctx, tx, userID, appID)
+			return err
+		}
+		entry, _ := json.Marshal([]HistoryEntry{{Kind: HistoryUndo, At: now, Action: string(*action)}})
+		switch *action {
+		case ActionRating:
+			app, err = s.undoRating(ctx, tx, userID, appID, entry)
+		case ActionNoteEdit:
+			app, err = s.undoNoteEdit(ctx, tx, userID, appID, entry)
+		default:
+			err = fmt.Errorf("unknown action %q", *action)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `DELETE FROM application_last_actions WHERE application_id = $1`, appID)
+		return err
+	})
+	var ve *ValidationError
+	if errors.Is(err, ErrNotFound) || errors.As(err, &ve) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("undoLastAction: %w", err)
+	}
+	return app, nil
+}
+
+// checkUndoable tells whether the last action recorded (nil if none), made
+// at at, can still be undone at now.
+func checkUndoable(action *UndoableAction, at *time.Time, now time.Time, grace time.Duration) error {
+	if action == nil {
+		return &ValidationError{Msg: "nothing to undo"}
+	}
+	if now.Sub(*at) > grace {
+		return &ValidationError{
+			Msg: fmt.Sprintf("the last action is older than %s and can no longer be undone", grace),
+		}
+	}
+	return nil
+}
+
+// undoRating restores the rating the last action replaced and appends
+// entry (a JSON array) to the history.
+func (s *Service) undoRating(ctx context.Context, tx pgx.Tx, userID, appID string, entry []byte) (*Application, error) {
+	var app Application
+	err := tx.QueryRow(ctx,
+		`WITH upd AS (
+		   UPDATE applications a
+		   SET user_rating     = (l.previous->>'userRating')::smallint,
+		       rating_criteria = NULLIF(l.previous->'ratingCriteria', 'null'::jsonb),
+		       rating_review   = l.previous->>'ratingReview',
+		       history_log     = a.history_log || $3::jsonb,
+		       updated_at      = NOW()
+		   FROM application_last_actions l
+		   WHERE a.id = $1 AND a.user_id = $2 AND l.application_id = a.id
+		   RETURNING a.*
+		 )
+		 SELECT `+appColumns("upd")+`
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`,
+		appID, userID, string(entry),
+	).Scan(s.appScanDest(&app)...)
+	if err != nil {
+		return nil, fmt.Errorf("undo rating: %w", err)
+	}
+	if err := enqueueApplicationUpdated(ctx, tx, userID, &app, "user_rating", "rating_criteria", "rating_review"); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// undoNoteEdit restores the text (and edit date) the last action replaced
+// in its note and appends entry (a JSON array) to the history.
+func (s *Service) undoNoteEdit(ctx context.Context, tx pgx.Tx, userID, appID string, entry []byte) (*Application, error) {
+	tag, err := tx.Exec(ctx,
+		`UPDATE application_notes n
+		 SET body = l.previous->>'body', edited_at = (l.previous->>'editedAt')::timestamptz
+		 FROM application_last_actions l
+		 WHERE l.application_id = $1 AND n.id = l.note_id`,
+		appID)
+	if err != nil {
+		return nil, fmt.Errorf("undo note edit: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, &ValidationError{Msg: "the edited note was deleted"}
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE applications SET history_log = history_log || $2::jsonb WHERE id = $1`,
+		appID, string(entry)); err != nil {
+		return nil, fmt.Errorf("undo note edit: %w", err)
+	}
+	app, err := s.refreshLatestNote(ctx, tx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("undo note edit: %w", err)
+	}
+	if err := enqueueApplicationUpdated(ctx, tx, userID, app, "user_notes"); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
@@ -0,0 +1,126 @@
+package kanban_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+)
+
+var undoNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func entry(from, to kanban.Status, ago time.Duration) kanban.HistoryEntry {
+	return kanban.HistoryEntry{From: string(from), To: string(to), At: undoNow.Add(-ago)}
+}
+
+func TestPlanUndo_RevertsLastMove(t *testing.T) {
+	history := []kanban.HistoryEntry{
+		entry(kanban.StatusToApply, kanban.StatusApplied, time.Hour),
+		entry(kanban.StatusApplied, kanban.StatusInterview, time.Minute),
+	}
+	got, err := kanban.PlanUndo(history, undoNow, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("PlanUndo unexpected error: %v", err)
+	}
+	if got.From != kanban.StatusInterview || got.To != kanban.StatusApplied {
+		t.Errorf("PlanUndo = %s → %s, want INTERVIEW → APPLIED", got.From, got.To)
+	}
+}
+
+func TestPlanUndo_Rejections(t *testing.T) {
+	undone := entry(kanban.StatusInterview, kanban.StatusApplied, time.Minute)
+	undone.Undo = true
+
+	cases := map[string][]kanban.HistoryEntry{
+		"empty history": nil,
+		"outside grace": {entry(kanban.StatusApplied, kanban.StatusInterview, time.Hour)},
+		"already undone": {
+			entry(kanban.StatusApplied, kanban.StatusInterview, 2*time.Minute),
+			undone,
+		},
+	}
+	for name, history := range cases {
+		_, err := kanban.PlanUndo(history, undoNow, 15*time.Minute)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("%s: PlanUndo error = %v, want ValidationError", name, err)
+		}
+	}
+}
+
+// Undoing a move out of ON_HOLD must restore the stage the card was paused
+// from, otherwise IsResumeAllowed would have nothing to check against.
+func TestPlanUndo_RestoresHoldOrigin(t *testing.T) {
+	history := []kanban.HistoryEntry{
+		entry(kanban.StatusApplied, kanban.StatusInterview, 48*time.Hour),
+		entry(kanban.StatusInterview, kanban.StatusOnHold, 24*time.Hour),
+		entry(kanban.StatusOnHold, kanban.StatusRejected, time.Minute),
+	}
+	got, err := kanban.PlanUndo(history, undoNow, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("PlanUndo unexpected error: %v", err)
+	}
+	if got.To != kanban.StatusOnHold || got.HoldOrigin != kanban.StatusInterview {
+		t.Errorf("PlanUndo = to %s (origin %q), want ON_HOLD (origin INTERVIEW)", got.To, got.HoldOrigin)
+	}
+}
+
+// Interview feedback entries share history_log with moves but are not moves.
+func TestPlanUndo_SkipsNonMoveEntries(t *testing.T) {
+	history := []kanban.HistoryEntry{
+		entry(kanban.StatusApplied, kanban.StatusInterview, 5*time.Minute),
+		{Kind: kanban.HistoryInterviewFeedback, At: undoNow.Add(-time.Minute), Outcome: kanban.OutcomePassed},
+	}
+	got, err := kanban.PlanUndo(history, undoNow, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("PlanUndo unexpected error: %v", err)
+	}
+	if got.From != kanban.StatusInterview || got.To != kanban.StatusApplied {
+		t.Errorf("PlanUndo = %s → %s, want INTERVIEW → APPLIED", got.From, got.To)
+	}
+}
+
+// Undoing a move to HIRED only reactivates the search if that move archived
+// it, not if the preferences kept it active or the user archived it later.
+func TestSearchArchivedByLastMove(t *testing.T) {
+	hired := entry(kanban.StatusOffer, kanban.StatusHired, time.Minute)
+	searchEntry := func(kind string) kanban.HistoryEntry {
+		return kanban.HistoryEntry{Kind: kind, At: hired.At, SearchConfigID: "sc-1", Actor: kanban.ActorSystem}
+	}
+	cases := []struct {
+		name    string
+		history []kanban.HistoryEntry
+		want    bool
+	}{
+		{"archived", []kanban.HistoryEntry{hired, searchEntry(kanban.HistorySearchArchived)}, true},
+		{"kept", []kanban.HistoryEntry{hired, searchEntry(kanban.HistorySearchKept)}, false},
+		{"manual card", []kanban.HistoryEntry{hired}, false},
+		{"archived by an earlier move", []kanban.HistoryEntry{
+			hired, searchEntry(kanban.HistorySearchArchived),
+			entry(kanban.StatusHired, kanban.StatusOffer, 0),
+			entry(kanban.StatusOffer, kanban.StatusHired, 0),
+		}, false},
+	}
+	for _, c := range cases {
+		if got := kanban.SearchArchivedByLastMove(c.history); got != c.want {
+			t.Errorf("%s: SearchArchivedByLastMove = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestCheckUndoable(t *testing.T) {
+	rating := kanban.ActionRating
+	recent, old := undoNow.Add(-time.Minute), undoNow.Add(-time.Hour)
+	var ve *kanban.ValidationError
+
+	if err := kanban.CheckUndoable(&rating, &recent, undoNow, 15*time.Minute); err != nil {
+		t.Errorf("CheckUndoable(1 min ago) = %v, want nil", err)
+	}
+	if err := kanban.CheckUndoable(&rating, &old, undoNow, 15*time.Minute); !errors.As(err, &ve) {
+		t.Errorf("CheckUndoable(1 h ago) = %v, want ValidationError", err)
+	}
+	if err := kanban.CheckUndoable(nil, nil, undoNow, 15*time.Minute); !errors.As(err, &ve) {
+		t.Errorf("CheckUndoable(nothing recorded) = %v, want ValidationError", err)
+	}
+}
@@ -0,0 +1,132 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ApplicationUpdate is a partial update of an application's user-editable
+// fields: nil fields are left unchanged, zero values clear the field.
+type ApplicationUpdate struct {
+	UserRating        *int32     // 0 clears the rating; criteria and review are cleared either way
+	Priority          *string    // LOW, MEDIUM or HIGH — cannot be cleared
+	RelanceReminderAt *time.Time // zero time clears the reminder
+	NextStepDueAt     *time.Time // zero time clears the next step (label included)
+	NextStepLabel     *string
+}
+
+// IsEmpty reports whether the update touches no field.
+func (u ApplicationUpdate) IsEmpty() bool {
+	return u.UserRating == nil && u.Priority == nil &&
+		u.RelanceReminderAt == nil && u.NextStepDueAt == nil && u.NextStepLabel == nil
+}
+
+// UpdateApplication applies several field updates atomically, in a single
+// UPDATE. It is the general form of RateApplication, SetPriority,
+// SetRelanceReminder and SetNextStep, with the same validation rules.
+func (s *Service) UpdateApplication(ctx context.Context, userID, appID string, upd ApplicationUpdate) (*Application, error) {
+	if upd.IsEmpty() {
+		return nil, &ValidationError{Msg: "update must set at least one field"}
+	}
+
+	var (
+		sets    []string
+		args    []any
+		changed []string
+	)
+	set := func(column, expr string, v any) {
+		args = append(args, v)
+		sets = append(sets, column+" = "+fmt.Sprintf(expr, len(args)))
+		changed = append(changed, column)
+	}
+
+	if upd.UserRating != nil {
+		if r := *upd.UserRating; r < 0 || r > 5 {
+			return nil, &ValidationError{Msg: "rating must be between 1 and 5 (0 clears it)"}
+		}
+		set("user_rating", "NULLIF($%d::int, 0)", *upd.UserRating)
+		// Plain stars replace a rating by criteria, as in RateApplication.
+		sets = append(sets, "rating_criteria = NULL", "rating_review = NULL")
+		changed = append(changed, "rating_criteria", "rating_review")
+	}
+	if upd.Priority != nil {
+		p, err := ParsePriority(*upd.Priority)
+		if err != nil {
+			return nil, &ValidationError{Msg: err.Error()}
+		}
+		set("priority", "$%d::application_priority", string(p))
+	}
+	if upd.RelanceReminderAt != nil {
+		if at := *upd.RelanceReminderAt; !at.IsZero() {
+			if err := validateRemindAt(at, time.Now()); err != nil {
+				return nil, err
+			}
+		}
+		set("relance_reminder_at", "$%d", nullableTime(*upd.RelanceReminderAt))
+		entry, expr := reminderLogged(*upd.RelanceReminderAt)
+		set("history_log", expr, entry)
+	}
+	if upd.NextStepDueAt != nil && upd.NextStepDueAt.IsZero() {
+		// Same as SetNextStep: clearing the due date clears the whole step.
+		sets = append(sets, "next_step_due_at = NULL", "next_step_label = NULL")
+		changed = append(changed, "next_step_due_at", "next_step_label")
+	} else {
+		if upd.NextStepDueAt != nil {
+			set("next_step_due_at", "$%d", *upd.NextStepDueAt)
+		}
+		if upd.NextStepLabel != nil {
+			label := strings.TrimSpace(*upd.NextStepLabel)
+			if utf8.RuneCountInString(label) > maxNextStepLabelLen {
+				return nil, &ValidationError{Msg: fmt.Sprintf("next step label must be at most %d characters", maxNextStepLabelLen)}
+			}
+			set("next_step_label", "NULLIF($%d, '')", label)
+		}
+	}
+
+	args = append(args, appID, userID)
+	query := `WITH upd AS (
+		   UPDATE applications SET ` + strings.Join(sets, ", ") + `, updated_at = NOW()
+		   WHERE id = $` + fmt.Sprint(len(args)-1) + ` AND user_id = $` + fmt.Sprint(len(args)) + `
+		   RETURNING *
+		 )
+		 SELECT ` + appColumns("upd") + `
+		 FROM upd LEFT JOIN job_feed jf ON jf.id = upd.job_feed_id`
+
+	var app Application
+	err := s.inTx(ctx, func(tx pgx.Tx) error {
+		if upd.UserRating != nil {
+			if err := recordLastAction(ctx, tx, ActionRating, appID, userID); err != nil {
+				return err
+			}
+		}
+		err := tx.QueryRow(ctx, query, args...).Scan(s.appScanDest(&app)...)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return enqueueApplicationUpdated(ctx, tx, userID, &app, changed...)
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updateApplication: %w", err)
+	}
+	return &app, nil
+}
+
+// nullableTime maps the zero time to SQL NULL.
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,388 @@
+package kanban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"jobmate/tracker-service/internal/webhook"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Users register webhooks to receive some of their tracker events (the
+// webhookEvents) over HTTP. enqueueEvent queues a webhook_deliveries row per
+// subscribed webhook with the event itself, and DispatchWebhooks sends them
+// (package webhook describes the requests and their signature). Failed
+// deliveries are retried with backoff (webhookBackoff) and end up DEAD after
+// maxWebhookAttempts; the user can retry those (RetryWebhookDelivery).
+
+// webhookEvents are the event types webhooks can subscribe to.
+var webhookEvents = []string{"EVENT_CARD_MOVED", "EVENT_APPLICATION_CREATED", "EVENT_RELANCE_DUE"}
+
+// Webhook delivery statuses.
+const (
+	DeliveryPending   = "PENDING"
+	DeliveryDelivered = "DELIVERED"
+	DeliveryDead      = "DEAD" // gave up after maxWebhookAttempts
+)
+
+const (
+	maxWebhooksPerUser = 10
+	maxWebhookAttempts = 8
+	// webhookBatch is how many deliveries DispatchWebhooks claims at once,
+	// each for webhookLease so that other replicas leave them alone.
+	webhookBatch = 20
+	webhookLease = 2 * time.Minute
+)
+
+// Webhook is a URL a user registered for some of their events.
+type Webhook struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret signs the deliveries. Only set on the webhook CreateWebhook
+	// returns.
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDelivery is one event to send to a webhook.
+type WebhookDelivery struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhookId"`
+	EventType      string     `json:"eventType"`
+	Status         string     `json:"status"` // one of the Delivery* values
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastStatusCode int        `json:"lastStatusCode"` // 0 = no response
+	LastError      string     `json:"lastError"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt"`
+}
+
+var (
+	// ErrWebhooksDisabled is returned when the deployment cannot store
+	// webhook secrets (no TOKEN_ENCRYPTION_KEY).
+	ErrWebhooksDisabled = errors.New("webhooks are not configured on this deployment")
+	// ErrWebhookNotFound is returned when a webhook or delivery does not
+	// exist or belong to the caller.
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+func (s *Service) webhooksEnabled() bool {
+	return s.opts.Secrets != nil && s.opts.Webhooks != nil
+}
+
+// CreateWebhook registers url for the user's events of the given types and
+// returns it with its signing secret, which is never shown again.
+func (s *Service) CreateWebhook(ctx context.Context, userID, rawURL string, events []string) (*Webhook, error) {
+	if !s.webhooksEnabled() {
+		return nil, ErrWebhooksDisabled
+	}
+	u, err := s.opts.Webhooks.CheckURL(rawURL)
+	if err != nil {
+		return nil, &ValidationError{Field: "url", Msg: err.Error()}
+	}
+	events = dedupe(events)
+	if len(events) == 0 {
+		return nil, &ValidationError{Field: "events", Msg: "at least one event type is required"}
+	}
+	for _, ev := range events {
+		if !slices.Contains(webhookEvents, ev) {
+			return nil, &ValidationError{Field: "events", Msg: fmt.Sprintf("unknown event type %q (want one of %v)", ev, webhookEvents)}
+		}
+	}
+
+	secret := newCalendarToken() // same format as feed tokens
+	w := Webhook{Secret: secret}
+	err = s.pool.QueryRow(ctx,
+		`INSERT INTO webhooks (user_id, url, secret, events)
+		 SELECT $1, $2, $3, $4
+		 WHERE (SELECT COUNT(*) FROM webhooks WHERE user_id = $1) < $5
+		 RETURNING id, url, events, is_active, created_at, updated_at`,
+		userID, u, s.opts.Secrets.Seal([]byte(secret)), events, maxWebhooksPerUser,
+	).Scan(&w.ID, &w.URL, &w.Events, &w.Active, &w.CreatedAt, &w.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &ValidationError{Msg: fmt.Sprintf("at most %d webhooks per user", maxWebhooksPerUser)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("createWebhook: %w", err)
+	}
+	return &w, nil
+}
+
+// ListWebhooks returns the user's webhooks, oldest first, without secrets.
+func (s *Service) ListWebhooks(ctx context.Context, userID string) ([]Webhook, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT id, url, events, is_active, created_at, updated_at
+		 FROM webhooks WHERE user_id = $1 ORDER BY created_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listWebhooks: %w", err)
+	}
+	defer rows.Close()
+
+	hooks := []Webhook{}
+	for rows.Next() {
+		var w Webhook
+		if err := rows.Scan(&w.ID, &w.URL, &w.Events, &w.Active, &w.CreatedAt, &w.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("listWebhooks scan: %w", err)
+		}
+		hooks = append(hooks, w)
+	}
+	return hooks, rows.Err()
+}
+
+// DeleteWebhook removes one of the user's webhooks and its deliveries.
+func (s *Service) DeleteWebhook(ctx context.Context, userID, webhookID string) error {
+	tag, err := s.pool.Exec(ctx,
+		`DELETE FROM webhooks WHERE id = $1 AND user_id = $2`, webhookID, userID)
+	if err != nil {
+		return fmt.Errorf("deleteWebhook: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// maxWebhookDeliveriesListed caps ListWebhookDeliveries.
+const maxWebhookDeliveriesListed = 100
+
+// ListWebhookDeliveries returns the latest deliveries of one of the user's
+// webhooks, newest first, optionally only those in status (e.g. DEAD).
+func (s *Service) ListWebhookDeliveries(ctx context.Context, userID, webhookID, status string) ([]WebhookDelivery, error) {
+	switch status {
+	case "", DeliveryPending, DeliveryDelivered, DeliveryDead:
+	default:
+		return nil, &ValidationError{Field: "status", Msg: fmt.Sprintf("unknown delivery status %q", status)}
+	}
+	var owned bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1 AND user_id = $2)`,
+		webhookID, userID,
+	).Scan(&owned)
+	if err != nil {
+		return nil, fmt.Errorf("listWebhookDeliveries: %w", err)
+	}
+	if !owned {
+		return nil, ErrWebhookNotFound
+	}
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT id, webhook_id, event_type, status, attempts, next_attempt_at,
+		        COALESCE(last_status_code, 0), COALESCE(last_error, ''), created_at, delivered_at
+		 FROM webhook_deliveries
+		 WHERE webhook_id = $1 AND ($2 = '' OR status = $2)
+		 ORDER BY created_at DESC
+		 LIMIT $3`,
+		webhookID, status, maxWebhookDeliveriesListed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listWebhookDeliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		var d WebhookDelivery
+		if err := rows.Scan(&d.ID, &d.WebhookID, &d.EventType, &d.Status, &d.Attempts, &d.NextAttemptAt,
+			&d.LastStatusCode, &d.LastError, &d.CreatedAt, &d.DeliveredAt); err != nil {
+			return nil, fmt.Errorf("listWebhookDeliveries scan: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// RetryWebhookDelivery queues a DEAD delivery of one of the user's webhooks
+// again, with a fresh attempt budget.
+func (s *Service) RetryWebhookDelivery(ctx context.Context, userID, deliveryID string) error {
+	var deliveryStatus string
+	err := s.pool.QueryRow(ctx,
+		`WITH target AS (
+		   SELECT d.id, d.status
+		   FROM webhook_deliveries d
+		   JOIN webhooks w ON w.id = d.webhook_id
+		   WHERE d.id = $1 AND w.user_id = $2
+		   FOR UPDATE OF d
+		 ), upd AS (
+		   UPDATE webhook_deliveries d
+		   SET status = 'PENDING', attempts = 0, next_attempt_at = NOW()
+		   FROM target t
+		   WHERE d.id = t.id AND t.status = 'DEAD'
+		 )
+		 SELECT status FROM target`,
+		deliveryID, userID,
+	).Scan(&deliveryStatus)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("retryWebhookDelivery: %w", err)
+	}
+	if deliveryStatus != DeliveryDead {
+		return &ValidationError{Msg: fmt.Sprintf("only DEAD deliveries can be retried (this one is %s)", deliveryStatus)}
+	}
+	return nil
+}
+
+// enqueueWebhookDeliveries queues payload, just recorded in the outbox as
+// stream, for the active webhooks of its user subscribed to it.
+func enqueueWebhookDeliveries(ctx context.Context, q querier, stream, payload string) error {
+	if !slices.Contains(webhookEvents, stream) {
+		return nil
+	}
+	_, err := q.Exec(ctx,
+		`INSERT INTO webhook_deliveries (webhook_id, event_type, payload)
+		 SELECT w.id, $1, $2::jsonb
+		 FROM webhooks w
+		 WHERE w.user_id = ($2::jsonb->>'userId')::uuid AND w.is_active AND $1 = ANY(w.events)`,
+		stream, payload,
+	)
+	return err
+}
+
+// claimedDelivery is a delivery DispatchWebhooks is sending.
+type claimedDelivery struct {
+	id, eventType, payload, url string
+	secret                      []byte // sealed
+	attempts                    int    // this one included
+}
+
+// DispatchWebhooks sends the due webhook deliveries and records the outcome
+// of each: DELIVERED on a 2xx response; otherwise retried after
+// webhookBackoff, or DEAD after maxWebhookAttempts. Returns the number of
+// deliveries made.
+func (s *Service) DispatchWebhooks(ctx context.Context) (int, error) {
+	if !s.webhooksEnabled() {
+		return 0, nil
+	}
+	delivered := 0
+	for {
+		batch, err := s.claimWebhookDeliveries(ctx)
+		if err != nil {
+			return delivered, err
+		}
+		for _, d := range batch {
+			code, err := s.deliverWebhook(ctx, d)
+			if err := s.recordWebhookAttempt(ctx, d, code, err); err != nil {
+				return delivered, err
+			}
+			if err == nil {
+				delivered++
+			}
+		}
+		if len(batch) < webhookBatch || ctx.Err() != nil {
+			return delivered, nil
+		}
+	}
+}
+
+// claimWebhookDeliveries leases a batch of due deliveries, counting the
+// attempt about to be made.
+func (s *Service) claimWebhookDeliveries(ctx context.Context) ([]claimedDelivery, error) {
+	rows, err := s.pool.Query(ctx,
+		`WITH due AS (
+		   SELECT d.id
+		   FROM webhook_deliveries d
+		   JOIN webhooks w ON w.id = d.webhook_id
+		   WHERE d.status = 'PENDING' AND d.next_attempt_at <= NOW() AND w.is_active
+		   ORDER BY d.next_attempt_at
+		   LIMIT $1
+		   FOR UPDATE OF d SKIP LOCKED
+		 )
+		 UPDATE webhook_deliveries d
+		 SET next_attempt_at = NOW() + make_interval(secs => $2), attempts = d.attempts + 1
+		 FROM due, webhooks w
+		 WHERE d.id = due.id AND w.id = d.webhook_id
+		 RETURNING d.id, d.event_type, d.payload::text, w.url, w.secret, d.attempts`,
+		webhookBatch, webhookLease.Seconds(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dispatchWebhooks claim: %w", err)
+	}
+	defer rows.Close()
+
+	var batch []claimedDelivery
+	for rows.Next() {
+		var d claimedDelivery
+		if err := rows.Scan(&d.id, &d.eventType, &d.payload, &d.url, &d.secret, &d.attempts); err != nil {
+			return nil, fmt.Errorf("dispatchWebhooks scan: %w", err)
+		}
+		batch = append(batch, d)
+	}
+	return batch, rows.Err()
+}
+
+// deliverWebhook sends one delivery and returns the response status code
+// (0 if none).
+func (s *Service) deliverWebhook(ctx context.Context, d claimedDelivery) (int, error) {
+	secret, err := s.opts.Secrets.Open(d.secret)
+	if err != nil {
+		return 0, fmt.Errorf("unreadable secret: %w", err)
+	}
+	return s.opts.Webhooks.Send(ctx, webhook.Delivery{
+		ID:      d.id,
+		URL:     d.url,
+		Secret:  secret,
+		Event:   d.eventType,
+		Payload: []byte(d.payload),
+	})
+}
+
+// recordWebhookAttempt stores the outcome of an attempt.
+func (s *Service) recordWebhookAttempt(ctx context.Context, d claimedDelivery, code int, sendErr error) error {
+	var err error
+	switch {
+	case sendErr == nil:
+		_, err = s.pool.Exec(ctx,
+			`UPDATE webhook_deliveries
+			 SET status = 'DELIVERED', delivered_at = NOW(), last_status_code = $2, last_error = NULL
+			 WHERE id = $1`,
+			d.id, code)
+	case d.attempts >= maxWebhookAttempts:
+		slog.Warn("webhook delivery dead", "deliveryId", d.id, "event", d.eventType, "attempts", d.attempts, "err", sendErr)
+		_, err = s.pool.Exec(ctx,
+			`UPDATE webhook_deliveries
+			 SET status = 'DEAD', last_status_code = NULLIF($2, 0), last_error = $3
+			 WHERE id = $1`,
+			d.id, code, sendErr.Error())
+	default:
+		_, err = s.pool.Exec(ctx,
+			`UPDATE webhook_deliveries
+			 SET next_attempt_at = NOW() + make_interval(secs => $4),
+			     last_status_code = NULLIF($2, 0), last_error = $3
+			 WHERE id = $1`,
+			d.id, code, sendErr.Error(), webhookBackoff(d.attempts).Seconds())
+	}
+	if err != nil {
+		return fmt.Errorf("dispatchWebhooks record: %w", err)
+	}
+	return nil
+}
+
+// webhookBackoff is the delay before retrying a delivery that failed its
+// attempt-th attempt: 30s doubling each time, capped at 6h — about 15h
+// between the first and the last of maxWebhookAttempts.
+func webhookBackoff(attempt int) time.Duration {
+	const (
+		base = 30 * time.Second
+		max  = 6 * time.Hour
+	)
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := base << (attempt - 1)
+	if d <= 0 || d > max {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,68 @@
+package kanban_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"jobmate/tracker-service/internal/kanban"
+	"jobmate/tracker-service/internal/secretbox"
+	"jobmate/tracker-service/internal/webhook"
+)
+
+func TestCreateWebhook_Disabled(t *testing.T) {
+	svc := kanban.NewService(nil, nil, kanban.Options{Webhooks: webhook.New(false)})
+	_, err := svc.CreateWebhook(context.Background(), "u1", "https://hooks.example.com", []string{"EVENT_CARD_MOVED"})
+	if !errors.Is(err, kanban.ErrWebhooksDisabled) {
+		t.Errorf("CreateWebhook without Secrets = %v, want ErrWebhooksDisabled", err)
+	}
+}
+
+// Invalid webhooks are refused before touching the database.
+func TestCreateWebhook_Validation(t *testing.T) {
+	box, err := secretbox.New(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := kanban.NewService(nil, nil, kanban.Options{Secrets: box, Webhooks: webhook.New(false)})
+	cases := map[string]struct {
+		url    string
+		events []string
+		field  string
+	}{
+		"http url":      {"http://hooks.example.com", []string{"EVENT_CARD_MOVED"}, "url"},
+		"no events":     {"https://hooks.example.com", nil, "events"},
+		"unknown event": {"https://hooks.example.com", []string{"EVENT_CARD_MOVED", "EVENT_USER_DELETED"}, "events"},
+	}
+	for name, tc := range cases {
+		_, err := svc.CreateWebhook(context.Background(), "u1", tc.url, tc.events)
+		var ve *kanban.ValidationError
+		if !errors.As(err, &ve) || ve.Field != tc.field {
+			t.Errorf("%s: err = %v, want ValidationError on %s", name, err, tc.field)
+		}
+	}
+
+	var ve *kanban.ValidationError
+	if _, err := svc.ListWebhookDeliveries(context.Background(), "u1", "w1", "FAILED"); !errors.As(err, &ve) {
+		t.Errorf("ListWebhookDeliveries(unknown status) err = %v, want ValidationError", err)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		5:  8 * time.Minute,
+		7:  32 * time.Minute,
+		10: 4*time.Hour + 16*time.Minute,
+		11: 6 * time.Hour,
+		80: 6 * time.Hour,
+	}
+	for attempt, want := range cases {
+		if got := kanban.WebhookBackoff(attempt); got != want {
+			t.Errorf("webhookBackoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,8 +26,20 @@ const (
 type ListApplicationsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// When non-empty, filters results to this Kanban column only.
-	// Valid values: TO_APPLY, APPLIED, INTERVIEW, OFFER, REJECTED, HIRED
-	StatusFilter  string `protobuf:"bytes,1,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	// Valid values: TO_APPLY, APPLIED, INTERVIEW, OFFER, REJECTED, HIRED, WITHDRAWN, ON_HOLD
+	StatusFilter string `protobuf:"bytes,1,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	// When true, archived applications are returned as well.
+	IncludeArchived bool `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// When non-empty, filters results to this priority: LOW, MEDIUM, HIGH
+	PriorityFilter string `protobuf:"bytes,3,opt,name=priority_filter,json=priorityFilter,proto3" json:"priority_filter,omitempty"`
+	// Result order: UPDATED_AT (default, newest first), PRIORITY (HIGH first)
+	// or NEXT_STEP (earliest next-step due date first, undated last).
+	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// FULL (default) or SUMMARY. SUMMARY leaves ai_analysis,
+	// generated_cover_letter and user_notes empty — enough to render the board
+	// at a fraction of the payload size. history_log is never listed (see
+	// GetHistory).
+	View          string `protobuf:"bytes,5,opt,name=view,proto3" json:"view,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -68,6 +81,34 @@ func (x *ListApplicationsRequest) GetStatusFilter() string {
 	return ""
 }
 
+func (x *ListApplicationsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListApplicationsRequest) GetPriorityFilter() string {
+	if x != nil {
+		return x.PriorityFilter
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
 type GetApplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
@@ -112,28 +153,27 @@ func (x *GetApplicationRequest) GetApplicationId() string {
 	return ""
 }
 
-type CreateApplicationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The approved job_feed entry to create an application for.
-	JobFeedId     string `protobuf:"bytes,1,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BatchGetApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationIds []string               `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"` // at most 200
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateApplicationRequest) Reset() {
-	*x = CreateApplicationRequest{}
+func (x *BatchGetApplicationsRequest) Reset() {
+	*x = BatchGetApplicationsRequest{}
 	mi := &file_tracker_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationRequest) String() string {
+func (x *BatchGetApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationRequest) ProtoMessage() {}
+func (*BatchGetApplicationsRequest) ProtoMessage() {}
 
-func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetApplicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -145,42 +185,39 @@ func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetApplicationsRequest) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateApplicationRequest) GetJobFeedId() string {
+func (x *BatchGetApplicationsRequest) GetApplicationIds() []string {
 	if x != nil {
-		return x.JobFeedId
+		return x.ApplicationIds
 	}
-	return ""
+	return nil
 }
 
-type MoveCardRequest struct {
+type BatchGetApplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	// Target status — must be a valid ApplicationStatus string.
-	// Valid values: TO_APPLY, APPLIED, INTERVIEW, OFFER, REJECTED, HIRED
-	NewStatus     string `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Applications  []*ApplicationProto    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveCardRequest) Reset() {
-	*x = MoveCardRequest{}
+func (x *BatchGetApplicationsResponse) Reset() {
+	*x = BatchGetApplicationsResponse{}
 	mi := &file_tracker_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCardRequest) String() string {
+func (x *BatchGetApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCardRequest) ProtoMessage() {}
+func (*BatchGetApplicationsResponse) ProtoMessage() {}
 
-func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetApplicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,47 +229,39 @@ func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCardRequest.ProtoReflect.Descriptor instead.
-func (*MoveCardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetApplicationsResponse) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MoveCardRequest) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
-	}
-	return ""
-}
-
-func (x *MoveCardRequest) GetNewStatus() string {
+func (x *BatchGetApplicationsResponse) GetApplications() []*ApplicationProto {
 	if x != nil {
-		return x.NewStatus
+		return x.Applications
 	}
-	return ""
+	return nil
 }
 
-type AddNoteRequest struct {
+type GetApplicationByJobFeedIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	JobFeedId     string                 `protobuf:"bytes,1,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddNoteRequest) Reset() {
-	*x = AddNoteRequest{}
+func (x *GetApplicationByJobFeedIdRequest) Reset() {
+	*x = GetApplicationByJobFeedIdRequest{}
 	mi := &file_tracker_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddNoteRequest) String() string {
+func (x *GetApplicationByJobFeedIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddNoteRequest) ProtoMessage() {}
+func (*GetApplicationByJobFeedIdRequest) ProtoMessage() {}
 
-func (x *AddNoteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetApplicationByJobFeedIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,47 +273,39 @@ func (x *AddNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddNoteRequest.ProtoReflect.Descriptor instead.
-func (*AddNoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetApplicationByJobFeedIdRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationByJobFeedIdRequest) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddNoteRequest) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
-	}
-	return ""
-}
-
-func (x *AddNoteRequest) GetNote() string {
+func (x *GetApplicationByJobFeedIdRequest) GetJobFeedId() string {
 	if x != nil {
-		return x.Note
+		return x.JobFeedId
 	}
 	return ""
 }
 
-type RateApplicationRequest struct {
+type GetApplicationByJobFeedIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // 1–5
+	Application   *ApplicationProto      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"` // unset when the entry is not tracked
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RateApplicationRequest) Reset() {
-	*x = RateApplicationRequest{}
+func (x *GetApplicationByJobFeedIdResponse) Reset() {
+	*x = GetApplicationByJobFeedIdResponse{}
 	mi := &file_tracker_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RateApplicationRequest) String() string {
+func (x *GetApplicationByJobFeedIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateApplicationRequest) ProtoMessage() {}
+func (*GetApplicationByJobFeedIdResponse) ProtoMessage() {}
 
-func (x *RateApplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetApplicationByJobFeedIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,48 +317,42 @@ func (x *RateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*RateApplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetApplicationByJobFeedIdResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationByJobFeedIdResponse) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RateApplicationRequest) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
-	}
-	return ""
-}
-
-func (x *RateApplicationRequest) GetRating() int32 {
+func (x *GetApplicationByJobFeedIdResponse) GetApplication() *ApplicationProto {
 	if x != nil {
-		return x.Rating
+		return x.Application
 	}
-	return 0
+	return nil
 }
 
-type SetRelanceReminderRequest struct {
+type GetHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	// ISO 8601 timestamp string. Empty string = clear the reminder.
-	RemindAt      string `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 = 50, at most 200
+	// next_page_token of the previous page; empty = newest entries.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetRelanceReminderRequest) Reset() {
-	*x = SetRelanceReminderRequest{}
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
 	mi := &file_tracker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetRelanceReminderRequest) String() string {
+func (x *GetHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRelanceReminderRequest) ProtoMessage() {}
+func (*GetHistoryRequest) ProtoMessage() {}
 
-func (x *SetRelanceReminderRequest) ProtoReflect() protoreflect.Message {
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,46 +364,55 @@ func (x *SetRelanceReminderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRelanceReminderRequest.ProtoReflect.Descriptor instead.
-func (*SetRelanceReminderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SetRelanceReminderRequest) GetApplicationId() string {
+func (x *GetHistoryRequest) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *SetRelanceReminderRequest) GetRemindAt() string {
+func (x *GetHistoryRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RemindAt
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type ListApplicationsResponse struct {
+type GetHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Applications  []*ApplicationProto    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Entries       []*HistoryEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                    // newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListApplicationsResponse) Reset() {
-	*x = ListApplicationsResponse{}
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
 	mi := &file_tracker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListApplicationsResponse) String() string {
+func (x *GetHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListApplicationsResponse) ProtoMessage() {}
+func (*GetHistoryResponse) ProtoMessage() {}
 
-func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,58 +424,56 @@ func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListApplicationsResponse) GetApplications() []*ApplicationProto {
+func (x *GetHistoryResponse) GetEntries() []*HistoryEntry {
 	if x != nil {
-		return x.Applications
+		return x.Entries
 	}
 	return nil
 }
 
-// ApplicationProto mirrors the Applications table row returned to clients.
-// JSON blobs (ai_analysis, history_log) are carried as raw bytes so the
-// Gateway can forward them to the frontend without an extra parse/marshal cycle.
-type ApplicationProto struct {
+func (x *GetHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetTimelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CurrentStatus string                 `protobuf:"bytes,2,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
-	// Raw JSON bytes — forwarded as-is to GraphQL clients.
-	AiAnalysis []byte `protobuf:"bytes,3,opt,name=ai_analysis,json=aiAnalysis,proto3" json:"ai_analysis,omitempty"`
-	HistoryLog []byte `protobuf:"bytes,7,opt,name=history_log,json=historyLog,proto3" json:"history_log,omitempty"`
-	// Nullable string fields (empty string = NULL in DB)
-	GeneratedCoverLetter string `protobuf:"bytes,4,opt,name=generated_cover_letter,json=generatedCoverLetter,proto3" json:"generated_cover_letter,omitempty"`
-	UserNotes            string `protobuf:"bytes,5,opt,name=user_notes,json=userNotes,proto3" json:"user_notes,omitempty"`
-	// Nullable int32 — 0 means unrated
-	UserRating int32                  `protobuf:"varint,6,opt,name=user_rating,json=userRating,proto3" json:"user_rating,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Relational context — used by Gateway for archiveSearchConfig + UI deep links
-	JobFeedId      string `protobuf:"bytes,10,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`                // empty if job_feed was deleted
-	SearchConfigId string `protobuf:"bytes,11,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"` // derived via job_feed.search_config_id (empty if manual/deleted)
-	// Relance reminder — empty string = not set
-	RelanceReminderAt string `protobuf:"bytes,12,opt,name=relance_reminder_at,json=relanceReminderAt,proto3" json:"relance_reminder_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 = 50, at most 200
+	// next_page_token of the previous page; empty = newest entries.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplicationProto) Reset() {
-	*x = ApplicationProto{}
+func (x *GetTimelineRequest) Reset() {
+	*x = GetTimelineRequest{}
 	mi := &file_tracker_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationProto) String() string {
+func (x *GetTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationProto) ProtoMessage() {}
+func (*GetTimelineRequest) ProtoMessage() {}
 
-func (x *ApplicationProto) ProtoReflect() protoreflect.Message {
+func (x *GetTimelineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tracker_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,121 +485,11001 @@ func (x *ApplicationProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationProto.ProtoReflect.Descriptor instead.
-func (*ApplicationProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
 	return file_tracker_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ApplicationProto) GetId() string {
+func (x *GetTimelineRequest) GetApplicationId() string {
 	if x != nil {
-		return x.Id
+		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *ApplicationProto) GetCurrentStatus() string {
+func (x *GetTimelineRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CurrentStatus
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTimelineRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ApplicationProto) GetAiAnalysis() []byte {
+type GetTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                    // newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineResponse) Reset() {
+	*x = GetTimelineResponse{}
+	mi := &file_tracker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineResponse) ProtoMessage() {}
+
+func (x *GetTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[9]
 	if x != nil {
-		return x.AiAnalysis
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ApplicationProto) GetHistoryLog() []byte {
+// Deprecated: Use GetTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTimelineResponse) GetEntries() []*TimelineEntry {
 	if x != nil {
-		return x.HistoryLog
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *ApplicationProto) GetGeneratedCoverLetter() string {
+func (x *GetTimelineResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.GeneratedCoverLetter
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ApplicationProto) GetUserNotes() string {
+func (x *GetTimelineResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.UserNotes
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ApplicationProto) GetUserRating() int32 {
+// One event of an application's timeline: a history entry, a note or an
+// interview, according to kind.
+type TimelineEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// NOTE, INTERVIEW or one of the HistoryEntry kinds.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// NOTE: when it was added. INTERVIEW: its scheduled time, or when it was
+	// added while unscheduled.
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`         // USER (the card's owner), SYSTEM or OPERATOR (support staff)
+	History       *HistoryEntry          `protobuf:"bytes,4,opt,name=history,proto3" json:"history,omitempty"`     // HistoryEntry kinds
+	Note          *Note                  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`           // NOTE
+	Interview     *Interview             `protobuf:"bytes,6,opt,name=interview,proto3" json:"interview,omitempty"` // INTERVIEW
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_tracker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[10]
 	if x != nil {
-		return x.UserRating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ApplicationProto) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimelineEntry) GetKind() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *ApplicationProto) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *TimelineEntry) GetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.At
 	}
 	return nil
 }
 
-func (x *ApplicationProto) GetJobFeedId() string {
+func (x *TimelineEntry) GetActor() string {
 	if x != nil {
-		return x.JobFeedId
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *ApplicationProto) GetSearchConfigId() string {
+func (x *TimelineEntry) GetHistory() *HistoryEntry {
 	if x != nil {
-		return x.SearchConfigId
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationProto) GetRelanceReminderAt() string {
+func (x *TimelineEntry) GetNote() *Note {
 	if x != nil {
-		return x.RelanceReminderAt
+		return x.Note
 	}
-	return ""
+	return nil
 }
 
-var File_tracker_proto protoreflect.FileDescriptor
+func (x *TimelineEntry) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
 
-const file_tracker_proto_rawDesc = "" +
-	"\n" +
-	"\rtracker.proto\x12\atracker\x1a\x1fgoogle/protobuf/timestamp.proto\">\n" +
-	"\x17ListApplicationsRequest\x12#\n" +
-	"\rstatus_filter\x18\x01 \x01(\tR\fstatusFilter\">\n" +
-	"\x15GetApplicationRequest\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\":\n" +
-	"\x18CreateApplicationRequest\x12\x1e\n" +
-	"\vjob_feed_id\x18\x01 \x01(\tR\tjobFeedId\"W\n" +
-	"\x0fMoveCardRequest\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
+type SearchApplicationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words to look for, at most 200 characters. Applications matching any of
+	// them are returned, those matching more ranking higher.
+	Query           string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	IncludeArchived bool   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	PageSize        int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 0 = 20, at most 50
+	PageToken       string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchApplicationsRequest) Reset() {
+	*x = SearchApplicationsRequest{}
+	mi := &file_tracker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchApplicationsRequest) ProtoMessage() {}
+
+func (x *SearchApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchApplicationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchApplicationsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *SearchApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`                                          // best matches first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchApplicationsResponse) Reset() {
+	*x = SearchApplicationsResponse{}
+	mi := &file_tracker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchApplicationsResponse) ProtoMessage() {}
+
+func (x *SearchApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchApplicationsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *ApplicationProto      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"` // without history_log
+	Rank          float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Highlights    []*SearchHighlight     `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"` // at most 3
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_tracker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchHit) GetApplication() *ApplicationProto {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// A fragment of a field that matched, with the matching words located.
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                 // TITLE, COMPANY, NOTE or COVER_LETTER
+	NoteId        string                 `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"` // NOTE: which note
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Matches       []*TextRange           `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_tracker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetMatches() []*TextRange {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// A range of a text, in characters (Unicode code points).
+type TextRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Length        int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_tracker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRange) ProtoMessage() {}
+
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TextRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// One entry of an application's history. Fields beyond kind, at and actor
+// are set depending on kind.
+type HistoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MOVE, INTERVIEW_FEEDBACK, MERGE, REMINDER_SET, REMINDER_SNOOZED,
+	// REMINDER_CLEARED, REMINDER_FIRED, ANALYZED, SEARCH_ARCHIVED, SEARCH_KEPT,
+	// SEARCH_REACTIVATED or UNDO.
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	At    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Actor string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"` // USER (the card's owner), SYSTEM or OPERATOR (support staff)
+	// MOVE: the transition; undo marks the compensation of an earlier move.
+	FromStatus string `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Undo       bool   `protobuf:"varint,6,opt,name=undo,proto3" json:"undo,omitempty"`
+	// MOVE to REJECTED: why and at which status (see MoveCardRequest).
+	RejectionReason string `protobuf:"bytes,7,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	RejectionStage  string `protobuf:"bytes,8,opt,name=rejection_stage,json=rejectionStage,proto3" json:"rejection_stage,omitempty"`
+	// MOVE: the user's comment, if any (see MoveCardRequest).
+	Reason string `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	// INTERVIEW_FEEDBACK; outcome also on ANALYZED (the analysis status).
+	InterviewId string `protobuf:"bytes,9,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Round       int32  `protobuf:"varint,10,opt,name=round,proto3" json:"round,omitempty"`
+	Outcome     string `protobuf:"bytes,11,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// MERGE: the application absorbed. On other kinds: the merged
+	// application the entry was carried over from.
+	MergedFrom string `protobuf:"bytes,12,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"`
+	// REMINDER_SET / REMINDER_SNOOZED: the new reminder time.
+	// REMINDER_FIRED: the time the reminder was due.
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// SEARCH_ARCHIVED / SEARCH_KEPT (a move to HIRED deactivated the card's
+	// search or, per the preferences, left it active) and SEARCH_REACTIVATED.
+	SearchConfigId string `protobuf:"bytes,15,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"`
+	// UNDO: the action UndoLastAction reverted (RATING or NOTE_EDIT).
+	Action        string `protobuf:"bytes,16,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	mi := &file_tracker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HistoryEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetUndo() bool {
+	if x != nil {
+		return x.Undo
+	}
+	return false
+}
+
+func (x *HistoryEntry) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetRejectionStage() string {
+	if x != nil {
+		return x.RejectionStage
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetMergedFrom() string {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type CreateApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The approved job_feed entry to create an application for.
+	JobFeedId string `protobuf:"bytes,1,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`
+	// Optional client-generated key. Retries carrying the same key replay the
+	// original response instead of running the mutation again.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// When the caller already has an active or recently rejected application
+	// for the same company and job title, the call fails with ALREADY_EXISTS
+	// and a google.rpc.ErrorInfo detail (reason DUPLICATE_APPLICATION,
+	// metadata application_ids = comma-separated existing IDs). Set to create
+	// the application anyway.
+	ConfirmDuplicate bool `protobuf:"varint,3,opt,name=confirm_duplicate,json=confirmDuplicate,proto3" json:"confirm_duplicate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateApplicationRequest) GetJobFeedId() string {
+	if x != nil {
+		return x.JobFeedId
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetConfirmDuplicate() bool {
+	if x != nil {
+		return x.ConfirmDuplicate
+	}
+	return false
+}
+
+type CreateManualApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of title / company is required.
+	Title            string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Company          string `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	Url              string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"` // optional, absolute http(s) URL
+	Location         string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Description      string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey   string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`        // optional (see CreateApplicationRequest)
+	ConfirmDuplicate bool   `protobuf:"varint,7,opt,name=confirm_duplicate,json=confirmDuplicate,proto3" json:"confirm_duplicate,omitempty"` // see CreateApplicationRequest
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateManualApplicationRequest) Reset() {
+	*x = CreateManualApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManualApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManualApplicationRequest) ProtoMessage() {}
+
+func (x *CreateManualApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManualApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateManualApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateManualApplicationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateManualApplicationRequest) GetConfirmDuplicate() bool {
+	if x != nil {
+		return x.ConfirmDuplicate
+	}
+	return false
+}
+
+type ImportApplicationEmailRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Email            string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`                                                // raw MIME message or plain text, at most 1 MiB
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                              // TO_APPLY or APPLIED (default)
+	ConfirmDuplicate bool                   `protobuf:"varint,3,opt,name=confirm_duplicate,json=confirmDuplicate,proto3" json:"confirm_duplicate,omitempty"` // see CreateApplicationRequest
+	IdempotencyKey   string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`        // optional (see CreateApplicationRequest)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportApplicationEmailRequest) Reset() {
+	*x = ImportApplicationEmailRequest{}
+	mi := &file_tracker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportApplicationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportApplicationEmailRequest) ProtoMessage() {}
+
+func (x *ImportApplicationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportApplicationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ImportApplicationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportApplicationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportApplicationEmailRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportApplicationEmailRequest) GetConfirmDuplicate() bool {
+	if x != nil {
+		return x.ConfirmDuplicate
+	}
+	return false
+}
+
+func (x *ImportApplicationEmailRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ImportApplicationEmailResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Application *ApplicationProto      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	// What was read from the email.
+	Company       string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,3,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"` // unset when the email has no date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportApplicationEmailResponse) Reset() {
+	*x = ImportApplicationEmailResponse{}
+	mi := &file_tracker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportApplicationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportApplicationEmailResponse) ProtoMessage() {}
+
+func (x *ImportApplicationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportApplicationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ImportApplicationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportApplicationEmailResponse) GetApplication() *ApplicationProto {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ImportApplicationEmailResponse) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *ImportApplicationEmailResponse) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *ImportApplicationEmailResponse) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+// The job of a card created by CloneApplication or
+// CreateApplicationFromTemplate: a feed entry (job_feed_id) or, without
+// one, a job found outside JobMate (fields as in
+// CreateManualApplicationRequest).
+type NewCardJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobFeedId     string                 `protobuf:"bytes,1,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Company       string                 `protobuf:"bytes,3,opt,name=company,proto3" json:"company,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewCardJob) Reset() {
+	*x = NewCardJob{}
+	mi := &file_tracker_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewCardJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewCardJob) ProtoMessage() {}
+
+func (x *NewCardJob) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewCardJob.ProtoReflect.Descriptor instead.
+func (*NewCardJob) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NewCardJob) GetJobFeedId() string {
+	if x != nil {
+		return x.JobFeedId
+	}
+	return ""
+}
+
+func (x *NewCardJob) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewCardJob) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *NewCardJob) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NewCardJob) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *NewCardJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CloneApplicationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId    string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"` // the card whose setup is copied
+	Job              *NewCardJob            `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	ConfirmDuplicate bool                   `protobuf:"varint,3,opt,name=confirm_duplicate,json=confirmDuplicate,proto3" json:"confirm_duplicate,omitempty"` // see CreateApplicationRequest
+	IdempotencyKey   string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`        // optional (see CreateApplicationRequest)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CloneApplicationRequest) Reset() {
+	*x = CloneApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneApplicationRequest) ProtoMessage() {}
+
+func (x *CloneApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CloneApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CloneApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CloneApplicationRequest) GetJob() *NewCardJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *CloneApplicationRequest) GetConfirmDuplicate() bool {
+	if x != nil {
+		return x.ConfirmDuplicate
+	}
+	return false
+}
+
+func (x *CloneApplicationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ApplicationTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`         // unique per user, at most 100 characters
+	Priority      string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"` // LOW, MEDIUM (default) or HIGH
+	NextStepLabel string                 `protobuf:"bytes,4,opt,name=next_step_label,json=nextStepLabel,proto3" json:"next_step_label,omitempty"`
+	ReminderRule  *ReminderRule          `protobuf:"bytes,5,opt,name=reminder_rule,json=reminderRule,proto3" json:"reminder_rule,omitempty"` // unset = none
+	Notes         []string               `protobuf:"bytes,6,rep,name=notes,proto3" json:"notes,omitempty"`                                   // the new card's first notes, in order (at most 20)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationTemplate) Reset() {
+	*x = ApplicationTemplate{}
+	mi := &file_tracker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationTemplate) ProtoMessage() {}
+
+func (x *ApplicationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationTemplate.ProtoReflect.Descriptor instead.
+func (*ApplicationTemplate) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplicationTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplicationTemplate) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ApplicationTemplate) GetNextStepLabel() string {
+	if x != nil {
+		return x.NextStepLabel
+	}
+	return ""
+}
+
+func (x *ApplicationTemplate) GetReminderRule() *ReminderRule {
+	if x != nil {
+		return x.ReminderRule
+	}
+	return nil
+}
+
+func (x *ApplicationTemplate) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ApplicationTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApplicationTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListApplicationTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationTemplatesRequest) Reset() {
+	*x = ListApplicationTemplatesRequest{}
+	mi := &file_tracker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationTemplatesRequest) ProtoMessage() {}
+
+func (x *ListApplicationTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{24}
+}
+
+type ListApplicationTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ApplicationTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationTemplatesResponse) Reset() {
+	*x = ListApplicationTemplatesResponse{}
+	mi := &file_tracker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationTemplatesResponse) ProtoMessage() {}
+
+func (x *ListApplicationTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListApplicationTemplatesResponse) GetTemplates() []*ApplicationTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateApplicationTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ApplicationTemplate   `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // id and timestamps ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationTemplateRequest) Reset() {
+	*x = CreateApplicationTemplateRequest{}
+	mi := &file_tracker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationTemplateRequest) ProtoMessage() {}
+
+func (x *CreateApplicationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateApplicationTemplateRequest) GetTemplate() *ApplicationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateApplicationTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ApplicationTemplate   `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // identified by id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationTemplateRequest) Reset() {
+	*x = UpdateApplicationTemplateRequest{}
+	mi := &file_tracker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateApplicationTemplateRequest) GetTemplate() *ApplicationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteApplicationTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationTemplateRequest) Reset() {
+	*x = DeleteApplicationTemplateRequest{}
+	mi := &file_tracker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteApplicationTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteApplicationTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationTemplateResponse) Reset() {
+	*x = DeleteApplicationTemplateResponse{}
+	mi := &file_tracker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteApplicationTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{29}
+}
+
+type CreateApplicationFromTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId       string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Job              *NewCardJob            `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	ConfirmDuplicate bool                   `protobuf:"varint,3,opt,name=confirm_duplicate,json=confirmDuplicate,proto3" json:"confirm_duplicate,omitempty"` // see CreateApplicationRequest
+	IdempotencyKey   string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`        // optional (see CreateApplicationRequest)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateApplicationFromTemplateRequest) Reset() {
+	*x = CreateApplicationFromTemplateRequest{}
+	mi := &file_tracker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateApplicationFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateApplicationFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateApplicationFromTemplateRequest) GetJob() *NewCardJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *CreateApplicationFromTemplateRequest) GetConfirmDuplicate() bool {
+	if x != nil {
+		return x.ConfirmDuplicate
+	}
+	return false
+}
+
+func (x *CreateApplicationFromTemplateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type MoveCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Target status — must be a valid ApplicationStatus string.
+	// Valid values: TO_APPLY, APPLIED, INTERVIEW, OFFER, REJECTED, HIRED, WITHDRAWN, ON_HOLD
+	NewStatus string `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	// Optional client-generated key (see CreateApplicationRequest).
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Only accepted when new_status is REJECTED, both optional.
+	// Reason: NO_RESPONSE, AFTER_SCREENING, AFTER_INTERVIEW, SALARY_MISMATCH,
+	// WITHDREW (the company withdrew the position).
+	RejectionReason string `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// Status the rejection happened at — defaults to the card's current status.
+	RejectionStage string `protobuf:"bytes,5,opt,name=rejection_stage,json=rejectionStage,proto3" json:"rejection_stage,omitempty"`
+	// Optional comment on the move ("Recruiter called, phone screen booked"),
+	// at most 500 characters, kept in the history entry.
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardRequest) Reset() {
+	*x = MoveCardRequest{}
+	mi := &file_tracker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardRequest) ProtoMessage() {}
+
+func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardRequest.ProtoReflect.Descriptor instead.
+func (*MoveCardRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MoveCardRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetRejectionStage() string {
+	if x != nil {
+		return x.RejectionStage
+	}
+	return ""
+}
+
+func (x *MoveCardRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UndoLastMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastMoveRequest) Reset() {
+	*x = UndoLastMoveRequest{}
+	mi := &file_tracker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastMoveRequest) ProtoMessage() {}
+
+func (x *UndoLastMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastMoveRequest.ProtoReflect.Descriptor instead.
+func (*UndoLastMoveRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UndoLastMoveRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type UndoLastActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastActionRequest) Reset() {
+	*x = UndoLastActionRequest{}
+	mi := &file_tracker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastActionRequest) ProtoMessage() {}
+
+func (x *UndoLastActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastActionRequest.ProtoReflect.Descriptor instead.
+func (*UndoLastActionRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UndoLastActionRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type BulkMoveRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationIds []string               `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"` // at most 200
+	// Target status for every listed application (same values as MoveCardRequest).
+	NewStatus string `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	// See MoveCardRequest, for every card. Without rejection_stage each
+	// card's stage is its current status.
+	RejectionReason string `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	RejectionStage  string `protobuf:"bytes,4,opt,name=rejection_stage,json=rejectionStage,proto3" json:"rejection_stage,omitempty"`
+	// Optional comment on the move (see MoveCardRequest), kept in every
+	// card's history entry.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkMoveRequest) Reset() {
+	*x = BulkMoveRequest{}
+	mi := &file_tracker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkMoveRequest) ProtoMessage() {}
+
+func (x *BulkMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkMoveRequest.ProtoReflect.Descriptor instead.
+func (*BulkMoveRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BulkMoveRequest) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *BulkMoveRequest) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *BulkMoveRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *BulkMoveRequest) GetRejectionStage() string {
+	if x != nil {
+		return x.RejectionStage
+	}
+	return ""
+}
+
+func (x *BulkMoveRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddNoteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId  string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Note           string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`                                           // at most 10,000 characters
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // optional (see CreateApplicationRequest)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddNoteRequest) Reset() {
+	*x = AddNoteRequest{}
+	mi := &file_tracker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNoteRequest) ProtoMessage() {}
+
+func (x *AddNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddNoteRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddNoteRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *AddNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AddNoteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ListNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_tracker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListNotesRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type EditNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // at most 10,000 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditNoteRequest) Reset() {
+	*x = EditNoteRequest{}
+	mi := &file_tracker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditNoteRequest) ProtoMessage() {}
+
+func (x *EditNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditNoteRequest.ProtoReflect.Descriptor instead.
+func (*EditNoteRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EditNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *EditNoteRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_tracker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type RateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // 1–5; ignored when criteria are given
+	// Optional sub-scores. When any is set, the star rating is their mean,
+	// rounded half up.
+	Criteria      *RatingCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	Review        string          `protobuf:"bytes,4,opt,name=review,proto3" json:"review,omitempty"` // optional, at most 500 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateApplicationRequest) Reset() {
+	*x = RateApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateApplicationRequest) ProtoMessage() {}
+
+func (x *RateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RateApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *RateApplicationRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RateApplicationRequest) GetCriteria() *RatingCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RateApplicationRequest) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+// Sub-scores of a rating, 1–5 each; 0 = not rated.
+type RatingCriteria struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleFit       int32                  `protobuf:"varint,1,opt,name=role_fit,json=roleFit,proto3" json:"role_fit,omitempty"`
+	Compensation  int32                  `protobuf:"varint,2,opt,name=compensation,proto3" json:"compensation,omitempty"`
+	Culture       int32                  `protobuf:"varint,3,opt,name=culture,proto3" json:"culture,omitempty"` // company culture
+	Commute       int32                  `protobuf:"varint,4,opt,name=commute,proto3" json:"commute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingCriteria) Reset() {
+	*x = RatingCriteria{}
+	mi := &file_tracker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCriteria) ProtoMessage() {}
+
+func (x *RatingCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCriteria.ProtoReflect.Descriptor instead.
+func (*RatingCriteria) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RatingCriteria) GetRoleFit() int32 {
+	if x != nil {
+		return x.RoleFit
+	}
+	return 0
+}
+
+func (x *RatingCriteria) GetCompensation() int32 {
+	if x != nil {
+		return x.Compensation
+	}
+	return 0
+}
+
+func (x *RatingCriteria) GetCulture() int32 {
+	if x != nil {
+		return x.Culture
+	}
+	return 0
+}
+
+func (x *RatingCriteria) GetCommute() int32 {
+	if x != nil {
+		return x.Commute
+	}
+	return 0
+}
+
+type SetPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Priority      string                 `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"` // LOW, MEDIUM, HIGH
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriorityRequest) Reset() {
+	*x = SetPriorityRequest{}
+	mi := &file_tracker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriorityRequest) ProtoMessage() {}
+
+func (x *SetPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetPriorityRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SetPriorityRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+type SetNextStepRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Unset = clear the next step.
+	DueAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// What is due, e.g. "Take-home test" (at most 200 characters).
+	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNextStepRequest) Reset() {
+	*x = SetNextStepRequest{}
+	mi := &file_tracker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNextStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNextStepRequest) ProtoMessage() {}
+
+func (x *SetNextStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNextStepRequest.ProtoReflect.Descriptor instead.
+func (*SetNextStepRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetNextStepRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SetNextStepRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *SetNextStepRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type SetRelanceReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// When to remind — unset (and no local_remind_at) = clear the reminder.
+	// Must be in the future and at most a year ahead (InvalidArgument).
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// Alternatively, a wall-clock time without offset read in the user's
+	// timezone (TrackerSettings.timezone), e.g. "2026-10-20T09:00" for 9am
+	// their time. Takes precedence over remind_at.
+	LocalRemindAt string `protobuf:"bytes,4,opt,name=local_remind_at,json=localRemindAt,proto3" json:"local_remind_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRelanceReminderRequest) Reset() {
+	*x = SetRelanceReminderRequest{}
+	mi := &file_tracker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRelanceReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRelanceReminderRequest) ProtoMessage() {}
+
+func (x *SetRelanceReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRelanceReminderRequest.ProtoReflect.Descriptor instead.
+func (*SetRelanceReminderRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetRelanceReminderRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SetRelanceReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *SetRelanceReminderRequest) GetLocalRemindAt() string {
+	if x != nil {
+		return x.LocalRemindAt
+	}
+	return ""
+}
+
+type SnoozeReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Added up; at least 1 hour, at most 90 days in total.
+	Hours         int32 `protobuf:"varint,2,opt,name=hours,proto3" json:"hours,omitempty"`
+	Days          int32 `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderRequest) Reset() {
+	*x = SnoozeReminderRequest{}
+	mi := &file_tracker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderRequest) ProtoMessage() {}
+
+func (x *SnoozeReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SnoozeReminderRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SnoozeReminderRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *SnoozeReminderRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type ClearReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearReminderRequest) Reset() {
+	*x = ClearReminderRequest{}
+	mi := &file_tracker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearReminderRequest) ProtoMessage() {}
+
+func (x *ClearReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearReminderRequest.ProtoReflect.Descriptor instead.
+func (*ClearReminderRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ClearReminderRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type SetReminderRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Unset = remove the rule.
+	Rule          *ReminderRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReminderRuleRequest) Reset() {
+	*x = SetReminderRuleRequest{}
+	mi := &file_tracker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReminderRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReminderRuleRequest) ProtoMessage() {}
+
+func (x *SetReminderRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReminderRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetReminderRuleRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetReminderRuleRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SetReminderRuleRequest) GetRule() *ReminderRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Fires while the card is in status: after_days days after it entered it,
+// then every every_days days. The rule starts over when the card re-enters
+// the status.
+type ReminderRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Non-final status; empty = the card's current status.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	AfterDays     int32  `protobuf:"varint,2,opt,name=after_days,json=afterDays,proto3" json:"after_days,omitempty"` // 1-365
+	EveryDays     int32  `protobuf:"varint,3,opt,name=every_days,json=everyDays,proto3" json:"every_days,omitempty"` // 0-365, 0 = fire once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderRule) Reset() {
+	*x = ReminderRule{}
+	mi := &file_tracker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderRule) ProtoMessage() {}
+
+func (x *ReminderRule) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderRule.ProtoReflect.Descriptor instead.
+func (*ReminderRule) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReminderRule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReminderRule) GetAfterDays() int32 {
+	if x != nil {
+		return x.AfterDays
+	}
+	return 0
+}
+
+func (x *ReminderRule) GetEveryDays() int32 {
+	if x != nil {
+		return x.EveryDays
+	}
+	return 0
+}
+
+type UpdateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// New values, read only for the paths listed in update_mask.
+	Application *ApplicationProto `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	// ApplicationProto field names. Supported: user_rating, priority,
+	// relance_reminder_at, next_step_due_at, next_step_label.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationRequest) Reset() {
+	*x = UpdateApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationRequest) GetApplication() *ApplicationProto {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *UpdateApplicationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ArchiveApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveApplicationRequest) Reset() {
+	*x = ArchiveApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveApplicationRequest) ProtoMessage() {}
+
+func (x *ArchiveApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ArchiveApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type RestoreApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreApplicationRequest) Reset() {
+	*x = RestoreApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreApplicationRequest) ProtoMessage() {}
+
+func (x *RestoreApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RestoreApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestoreApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type MergeApplicationsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId       string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`                     // kept
+	MergedApplicationId string                 `protobuf:"bytes,2,opt,name=merged_application_id,json=mergedApplicationId,proto3" json:"merged_application_id,omitempty"` // deleted
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MergeApplicationsRequest) Reset() {
+	*x = MergeApplicationsRequest{}
+	mi := &file_tracker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeApplicationsRequest) ProtoMessage() {}
+
+func (x *MergeApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*MergeApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MergeApplicationsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *MergeApplicationsRequest) GetMergedApplicationId() string {
+	if x != nil {
+		return x.MergedApplicationId
+	}
+	return ""
+}
+
+type ListColumnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColumnsRequest) Reset() {
+	*x = ListColumnsRequest{}
+	mi := &file_tracker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColumnsRequest) ProtoMessage() {}
+
+func (x *ListColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ListColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{52}
+}
+
+type CreateColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`          // unique per user, at most 64 characters
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`      // canonical status the column belongs to
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // ordering within the status (ascending)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateColumnRequest) Reset() {
+	*x = CreateColumnRequest{}
+	mi := &file_tracker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateColumnRequest) ProtoMessage() {}
+
+func (x *CreateColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateColumnRequest.ProtoReflect.Descriptor instead.
+func (*CreateColumnRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateColumnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateColumnRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateColumnRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type UpdateColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Position      *int32                 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateColumnRequest) Reset() {
+	*x = UpdateColumnRequest{}
+	mi := &file_tracker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateColumnRequest) ProtoMessage() {}
+
+func (x *UpdateColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateColumnRequest.ProtoReflect.Descriptor instead.
+func (*UpdateColumnRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateColumnRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type DeleteColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnRequest) Reset() {
+	*x = DeleteColumnRequest{}
+	mi := &file_tracker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnRequest) ProtoMessage() {}
+
+func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColumnRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteColumnRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+type MoveToColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"` // empty = the status' default lane
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToColumnRequest) Reset() {
+	*x = MoveToColumnRequest{}
+	mi := &file_tracker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToColumnRequest) ProtoMessage() {}
+
+func (x *MoveToColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToColumnRequest.ProtoReflect.Descriptor instead.
+func (*MoveToColumnRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MoveToColumnRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *MoveToColumnRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+type ReanalyzeApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReanalyzeApplicationRequest) Reset() {
+	*x = ReanalyzeApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReanalyzeApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReanalyzeApplicationRequest) ProtoMessage() {}
+
+func (x *ReanalyzeApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReanalyzeApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReanalyzeApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReanalyzeApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ListCoverLetterVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverLetterVersionsRequest) Reset() {
+	*x = ListCoverLetterVersionsRequest{}
+	mi := &file_tracker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverLetterVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverLetterVersionsRequest) ProtoMessage() {}
+
+func (x *ListCoverLetterVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverLetterVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoverLetterVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCoverLetterVersionsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type RegenerateCoverLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateCoverLetterRequest) Reset() {
+	*x = RegenerateCoverLetterRequest{}
+	mi := &file_tracker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateCoverLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateCoverLetterRequest) ProtoMessage() {}
+
+func (x *RegenerateCoverLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateCoverLetterRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateCoverLetterRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RegenerateCoverLetterRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type RestoreCoverLetterVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCoverLetterVersionRequest) Reset() {
+	*x = RestoreCoverLetterVersionRequest{}
+	mi := &file_tracker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCoverLetterVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCoverLetterVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCoverLetterVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCoverLetterVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCoverLetterVersionRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RestoreCoverLetterVersionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type RequestFollowUpDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Tone          string                 `protobuf:"bytes,2,opt,name=tone,proto3" json:"tone,omitempty"` // PROFESSIONAL (default), FRIENDLY or BRIEF
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestFollowUpDraftRequest) Reset() {
+	*x = RequestFollowUpDraftRequest{}
+	mi := &file_tracker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestFollowUpDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFollowUpDraftRequest) ProtoMessage() {}
+
+func (x *RequestFollowUpDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFollowUpDraftRequest.ProtoReflect.Descriptor instead.
+func (*RequestFollowUpDraftRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RequestFollowUpDraftRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *RequestFollowUpDraftRequest) GetTone() string {
+	if x != nil {
+		return x.Tone
+	}
+	return ""
+}
+
+type CreateAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // CV, COVER_LETTER or OTHER (default)
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentRequest) Reset() {
+	*x = CreateAttachmentRequest{}
+	mi := &file_tracker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateAttachmentRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_tracker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAttachmentsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type GetAttachmentDownloadUrlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentDownloadUrlRequest) Reset() {
+	*x = GetAttachmentDownloadUrlRequest{}
+	mi := &file_tracker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentDownloadUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentDownloadUrlRequest) ProtoMessage() {}
+
+func (x *GetAttachmentDownloadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentDownloadUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentDownloadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAttachmentDownloadUrlRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_tracker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type CreateInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// id, application_id and the timestamps are ignored.
+	Interview     *Interview `protobuf:"bytes,2,opt,name=interview,proto3" json:"interview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInterviewRequest) Reset() {
+	*x = CreateInterviewRequest{}
+	mi := &file_tracker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInterviewRequest) ProtoMessage() {}
+
+func (x *CreateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateInterviewRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+type SetOfferDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// application_id and updated_at are ignored. Replaces the whole offer.
+	Offer         *Offer `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOfferDetailsRequest) Reset() {
+	*x = SetOfferDetailsRequest{}
+	mi := &file_tracker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOfferDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfferDetailsRequest) ProtoMessage() {}
+
+func (x *SetOfferDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfferDetailsRequest.ProtoReflect.Descriptor instead.
+func (*SetOfferDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetOfferDetailsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SetOfferDetailsRequest) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type CompareOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareOffersRequest) Reset() {
+	*x = CompareOffersRequest{}
+	mi := &file_tracker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareOffersRequest) ProtoMessage() {}
+
+func (x *CompareOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareOffersRequest.ProtoReflect.Descriptor instead.
+func (*CompareOffersRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{68}
+}
+
+type AddNegotiationEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// id, application_id and created_at are ignored.
+	Entry         *NegotiationEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNegotiationEntryRequest) Reset() {
+	*x = AddNegotiationEntryRequest{}
+	mi := &file_tracker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNegotiationEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNegotiationEntryRequest) ProtoMessage() {}
+
+func (x *AddNegotiationEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNegotiationEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddNegotiationEntryRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddNegotiationEntryRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *AddNegotiationEntryRequest) GetEntry() *NegotiationEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListNegotiationEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNegotiationEntriesRequest) Reset() {
+	*x = ListNegotiationEntriesRequest{}
+	mi := &file_tracker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNegotiationEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNegotiationEntriesRequest) ProtoMessage() {}
+
+func (x *ListNegotiationEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNegotiationEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListNegotiationEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListNegotiationEntriesRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type DeleteNegotiationEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNegotiationEntryRequest) Reset() {
+	*x = DeleteNegotiationEntryRequest{}
+	mi := &file_tracker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNegotiationEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNegotiationEntryRequest) ProtoMessage() {}
+
+func (x *DeleteNegotiationEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNegotiationEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNegotiationEntryRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteNegotiationEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type ListInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterviewsRequest) Reset() {
+	*x = ListInterviewsRequest{}
+	mi := &file_tracker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsRequest) ProtoMessage() {}
+
+func (x *ListInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListInterviewsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type UpdateInterviewRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	// New values, read only for the paths listed in update_mask.
+	Interview *Interview `protobuf:"bytes,2,opt,name=interview,proto3" json:"interview,omitempty"`
+	// Interview field names. Supported: round, type, scheduled_at, interviewer,
+	// outcome, notes. Clearing scheduled_at unschedules the interview.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInterviewRequest) Reset() {
+	*x = UpdateInterviewRequest{}
+	mi := &file_tracker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterviewRequest) ProtoMessage() {}
+
+func (x *UpdateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+func (x *UpdateInterviewRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type RecordInterviewFeedbackRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Outcome     string                 `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`                   // required: PENDING, PASSED, FAILED or CANCELLED
+	WentWell    string                 `protobuf:"bytes,3,opt,name=went_well,json=wentWell,proto3" json:"went_well,omitempty"` // at most 10,000 characters
+	RedFlags    string                 `protobuf:"bytes,4,opt,name=red_flags,json=redFlags,proto3" json:"red_flags,omitempty"` // at most 10,000 characters
+	// At most 50, each at most 500 characters. Blank entries are dropped.
+	QuestionsAsked []string `protobuf:"bytes,5,rep,name=questions_asked,json=questionsAsked,proto3" json:"questions_asked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordInterviewFeedbackRequest) Reset() {
+	*x = RecordInterviewFeedbackRequest{}
+	mi := &file_tracker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordInterviewFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordInterviewFeedbackRequest) ProtoMessage() {}
+
+func (x *RecordInterviewFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordInterviewFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*RecordInterviewFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RecordInterviewFeedbackRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+func (x *RecordInterviewFeedbackRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RecordInterviewFeedbackRequest) GetWentWell() string {
+	if x != nil {
+		return x.WentWell
+	}
+	return ""
+}
+
+func (x *RecordInterviewFeedbackRequest) GetRedFlags() string {
+	if x != nil {
+		return x.RedFlags
+	}
+	return ""
+}
+
+func (x *RecordInterviewFeedbackRequest) GetQuestionsAsked() []string {
+	if x != nil {
+		return x.QuestionsAsked
+	}
+	return nil
+}
+
+type DeleteInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   string                 `protobuf:"bytes,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInterviewRequest) Reset() {
+	*x = DeleteInterviewRequest{}
+	mi := &file_tracker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterviewRequest) ProtoMessage() {}
+
+func (x *DeleteInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteInterviewRequest) GetInterviewId() string {
+	if x != nil {
+		return x.InterviewId
+	}
+	return ""
+}
+
+type CreateContactRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id, application_ids and the timestamps are ignored.
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	// Optional: link the new contact to this application. When contact.company
+	// is empty it then defaults to the application's company.
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactRequest) Reset() {
+	*x = CreateContactRequest{}
+	mi := &file_tracker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactRequest) ProtoMessage() {}
+
+func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateContactRequest) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *CreateContactRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ListContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"` // optional: contacts linked to this application
+	Company       string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`                                  // optional: case-insensitive match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_tracker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListContactsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+type UpdateContactRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContactId string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	// New values, read only for the paths listed in update_mask.
+	Contact *Contact `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	// Contact field names. Supported: name, role, email, linkedin_url, company,
+	// last_contacted_at.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_tracker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *UpdateContactRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactId     string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactRequest) Reset() {
+	*x = DeleteContactRequest{}
+	mi := &file_tracker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactRequest) ProtoMessage() {}
+
+func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+type LinkContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactId     string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkContactRequest) Reset() {
+	*x = LinkContactRequest{}
+	mi := &file_tracker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkContactRequest) ProtoMessage() {}
+
+func (x *LinkContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkContactRequest.ProtoReflect.Descriptor instead.
+func (*LinkContactRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LinkContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *LinkContactRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type UnlinkContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactId     string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkContactRequest) Reset() {
+	*x = UnlinkContactRequest{}
+	mi := &file_tracker_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkContactRequest) ProtoMessage() {}
+
+func (x *UnlinkContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkContactRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkContactRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UnlinkContactRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *UnlinkContactRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_tracker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{82}
+}
+
+type GetCompanyOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       string                 `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"` // company name, as in CompanySummary.name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyOverviewRequest) Reset() {
+	*x = GetCompanyOverviewRequest{}
+	mi := &file_tracker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyOverviewRequest) ProtoMessage() {}
+
+func (x *GetCompanyOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetCompanyOverviewRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+type GetRejectionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRejectionStatsRequest) Reset() {
+	*x = GetRejectionStatsRequest{}
+	mi := &file_tracker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRejectionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRejectionStatsRequest) ProtoMessage() {}
+
+func (x *GetRejectionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRejectionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRejectionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{84}
+}
+
+type GetSearchConfigStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchConfigStatsRequest) Reset() {
+	*x = GetSearchConfigStatsRequest{}
+	mi := &file_tracker_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchConfigStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchConfigStatsRequest) ProtoMessage() {}
+
+func (x *GetSearchConfigStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchConfigStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchConfigStatsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{85}
+}
+
+type CountApplicationsByStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountApplicationsByStatusRequest) Reset() {
+	*x = CountApplicationsByStatusRequest{}
+	mi := &file_tracker_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountApplicationsByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountApplicationsByStatusRequest) ProtoMessage() {}
+
+func (x *CountApplicationsByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountApplicationsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*CountApplicationsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{86}
+}
+
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_tracker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{87}
+}
+
+type RotateCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedTokenRequest) Reset() {
+	*x = RotateCalendarFeedTokenRequest{}
+	mi := &file_tracker_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *RotateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{88}
+}
+
+type RenderCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderCalendarFeedRequest) Reset() {
+	*x = RenderCalendarFeedRequest{}
+	mi := &file_tracker_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RenderCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RenderCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RenderCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StartGoogleCalendarAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGoogleCalendarAuthRequest) Reset() {
+	*x = StartGoogleCalendarAuthRequest{}
+	mi := &file_tracker_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGoogleCalendarAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGoogleCalendarAuthRequest) ProtoMessage() {}
+
+func (x *StartGoogleCalendarAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGoogleCalendarAuthRequest.ProtoReflect.Descriptor instead.
+func (*StartGoogleCalendarAuthRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{90}
+}
+
+type GoogleCalendarAuthUrl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // Google consent page, valid for 15 minutes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoogleCalendarAuthUrl) Reset() {
+	*x = GoogleCalendarAuthUrl{}
+	mi := &file_tracker_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleCalendarAuthUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleCalendarAuthUrl) ProtoMessage() {}
+
+func (x *GoogleCalendarAuthUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleCalendarAuthUrl.ProtoReflect.Descriptor instead.
+func (*GoogleCalendarAuthUrl) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GoogleCalendarAuthUrl) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CompleteGoogleCalendarAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteGoogleCalendarAuthRequest) Reset() {
+	*x = CompleteGoogleCalendarAuthRequest{}
+	mi := &file_tracker_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteGoogleCalendarAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteGoogleCalendarAuthRequest) ProtoMessage() {}
+
+func (x *CompleteGoogleCalendarAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteGoogleCalendarAuthRequest.ProtoReflect.Descriptor instead.
+func (*CompleteGoogleCalendarAuthRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CompleteGoogleCalendarAuthRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteGoogleCalendarAuthRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetGoogleCalendarStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoogleCalendarStatusRequest) Reset() {
+	*x = GetGoogleCalendarStatusRequest{}
+	mi := &file_tracker_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoogleCalendarStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoogleCalendarStatusRequest) ProtoMessage() {}
+
+func (x *GetGoogleCalendarStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoogleCalendarStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetGoogleCalendarStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{93}
+}
+
+type DisconnectGoogleCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectGoogleCalendarRequest) Reset() {
+	*x = DisconnectGoogleCalendarRequest{}
+	mi := &file_tracker_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectGoogleCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectGoogleCalendarRequest) ProtoMessage() {}
+
+func (x *DisconnectGoogleCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectGoogleCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectGoogleCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{94}
+}
+
+type GoogleCalendarStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Connected      bool                   `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	NeedsReconnect bool                   `protobuf:"varint,2,opt,name=needs_reconnect,json=needsReconnect,proto3" json:"needs_reconnect,omitempty"` // Google revoked access: sync paused until reconnected
+	CalendarId     string                 `protobuf:"bytes,3,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	ConnectedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	LastSyncedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	LastError      string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // of the last sync, empty when it succeeded
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoogleCalendarStatus) Reset() {
+	*x = GoogleCalendarStatus{}
+	mi := &file_tracker_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleCalendarStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleCalendarStatus) ProtoMessage() {}
+
+func (x *GoogleCalendarStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleCalendarStatus.ProtoReflect.Descriptor instead.
+func (*GoogleCalendarStatus) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GoogleCalendarStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *GoogleCalendarStatus) GetNeedsReconnect() bool {
+	if x != nil {
+		return x.NeedsReconnect
+	}
+	return false
+}
+
+func (x *GoogleCalendarStatus) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *GoogleCalendarStatus) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *GoogleCalendarStatus) GetLastSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return nil
+}
+
+func (x *GoogleCalendarStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type GetBenchmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobTitle      string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"` // matched ignoring case and extra whitespace; empty = all titles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkRequest) Reset() {
+	*x = GetBenchmarkRequest{}
+	mi := &file_tracker_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkRequest) ProtoMessage() {}
+
+func (x *GetBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetBenchmarkRequest) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_tracker_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{97}
+}
+
+type UpdateSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Flag APPLIED/INTERVIEW cards with no update for ghost_after_days as ghosted.
+	GhostingEnabled *bool  `protobuf:"varint,1,opt,name=ghosting_enabled,json=ghostingEnabled,proto3,oneof" json:"ghosting_enabled,omitempty"`
+	GhostAfterDays  *int32 `protobuf:"varint,2,opt,name=ghost_after_days,json=ghostAfterDays,proto3,oneof" json:"ghost_after_days,omitempty"` // 1–365
+	// Replaces the user's extra transitions when set (send an empty list to
+	// clear them). Edges may not leave a terminal state or involve ON_HOLD.
+	ExtraTransitions *TransitionList `protobuf:"bytes,3,opt,name=extra_transitions,json=extraTransitions,proto3" json:"extra_transitions,omitempty"`
+	// Contribute to, and get access to, the anonymous benchmarks.
+	ShareBenchmarks *bool `protobuf:"varint,4,opt,name=share_benchmarks,json=shareBenchmarks,proto3,oneof" json:"share_benchmarks,omitempty"`
+	// Replaces the relance reminder delivery channels when set (IN_APP, PUSH;
+	// send an empty list to mute reminders).
+	ReminderChannels *ChannelList `protobuf:"bytes,5,opt,name=reminder_channels,json=reminderChannels,proto3" json:"reminder_channels,omitempty"`
+	// IANA time zone, e.g. "Europe/Paris"; empty string = UTC.
+	Timezone *string `protobuf:"bytes,6,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// Deactivate a card's search when it is moved to HIRED.
+	ArchiveSearchOnHired *bool `protobuf:"varint,7,opt,name=archive_search_on_hired,json=archiveSearchOnHired,proto3,oneof" json:"archive_search_on_hired,omitempty"`
+	// What becomes of REJECTED/WITHDRAWN cards left untouched for the
+	// deployment's retention period: COMPACT (keep a summary) or DELETE.
+	ClosedRetention *string `protobuf:"bytes,8,opt,name=closed_retention,json=closedRetention,proto3,oneof" json:"closed_retention,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_tracker_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateSettingsRequest) GetGhostingEnabled() bool {
+	if x != nil && x.GhostingEnabled != nil {
+		return *x.GhostingEnabled
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetGhostAfterDays() int32 {
+	if x != nil && x.GhostAfterDays != nil {
+		return *x.GhostAfterDays
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetExtraTransitions() *TransitionList {
+	if x != nil {
+		return x.ExtraTransitions
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetShareBenchmarks() bool {
+	if x != nil && x.ShareBenchmarks != nil {
+		return *x.ShareBenchmarks
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetReminderChannels() *ChannelList {
+	if x != nil {
+		return x.ReminderChannels
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetArchiveSearchOnHired() bool {
+	if x != nil && x.ArchiveSearchOnHired != nil {
+		return *x.ArchiveSearchOnHired
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetClosedRetention() string {
+	if x != nil && x.ClosedRetention != nil {
+		return *x.ClosedRetention
+	}
+	return ""
+}
+
+type SetSearchConfigArchivalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SearchConfigId string                 `protobuf:"bytes,1,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"`
+	ArchiveOnHired bool                   `protobuf:"varint,2,opt,name=archive_on_hired,json=archiveOnHired,proto3" json:"archive_on_hired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetSearchConfigArchivalRequest) Reset() {
+	*x = SetSearchConfigArchivalRequest{}
+	mi := &file_tracker_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSearchConfigArchivalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSearchConfigArchivalRequest) ProtoMessage() {}
+
+func (x *SetSearchConfigArchivalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSearchConfigArchivalRequest.ProtoReflect.Descriptor instead.
+func (*SetSearchConfigArchivalRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetSearchConfigArchivalRequest) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+func (x *SetSearchConfigArchivalRequest) GetArchiveOnHired() bool {
+	if x != nil {
+		return x.ArchiveOnHired
+	}
+	return false
+}
+
+type SearchConfigArchival struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SearchConfigId string                 `protobuf:"bytes,1,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"`
+	ArchiveOnHired bool                   `protobuf:"varint,2,opt,name=archive_on_hired,json=archiveOnHired,proto3" json:"archive_on_hired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchConfigArchival) Reset() {
+	*x = SearchConfigArchival{}
+	mi := &file_tracker_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConfigArchival) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConfigArchival) ProtoMessage() {}
+
+func (x *SearchConfigArchival) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConfigArchival.ProtoReflect.Descriptor instead.
+func (*SearchConfigArchival) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SearchConfigArchival) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+func (x *SearchConfigArchival) GetArchiveOnHired() bool {
+	if x != nil {
+		return x.ArchiveOnHired
+	}
+	return false
+}
+
+type ReactivateSearchConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateSearchConfigRequest) Reset() {
+	*x = ReactivateSearchConfigRequest{}
+	mi := &file_tracker_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateSearchConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateSearchConfigRequest) ProtoMessage() {}
+
+func (x *ReactivateSearchConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateSearchConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateSearchConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ReactivateSearchConfigRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ReopenSearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SearchConfigId string                 `protobuf:"bytes,1,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReopenSearchRequest) Reset() {
+	*x = ReopenSearchRequest{}
+	mi := &file_tracker_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenSearchRequest) ProtoMessage() {}
+
+func (x *ReopenSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenSearchRequest.ProtoReflect.Descriptor instead.
+func (*ReopenSearchRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReopenSearchRequest) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+type ReopenSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenSearchResponse) Reset() {
+	*x = ReopenSearchResponse{}
+	mi := &file_tracker_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenSearchResponse) ProtoMessage() {}
+
+func (x *ReopenSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenSearchResponse.ProtoReflect.Descriptor instead.
+func (*ReopenSearchResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{103}
+}
+
+// A single (from → to) edge of the Kanban status graph.
+type Transition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transition) Reset() {
+	*x = Transition{}
+	mi := &file_tracker_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transition) ProtoMessage() {}
+
+func (x *Transition) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transition.ProtoReflect.Descriptor instead.
+func (*Transition) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Transition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Transition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type TransitionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Transition          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionList) Reset() {
+	*x = TransitionList{}
+	mi := &file_tracker_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionList) ProtoMessage() {}
+
+func (x *TransitionList) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionList.ProtoReflect.Descriptor instead.
+func (*TransitionList) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TransitionList) GetItems() []*Transition {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ChannelList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []string               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelList) Reset() {
+	*x = ChannelList{}
+	mi := &file_tracker_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelList) ProtoMessage() {}
+
+func (x *ChannelList) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelList.ProtoReflect.Descriptor instead.
+func (*ChannelList) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ChannelList) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*ApplicationProto    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_tracker_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsResponse) ProtoMessage() {}
+
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListApplicationsResponse) GetApplications() []*ApplicationProto {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type BulkMoveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per distinct requested ID, in request order.
+	Results       []*BulkMoveResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	MovedCount    int32             `protobuf:"varint,2,opt,name=moved_count,json=movedCount,proto3" json:"moved_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkMoveResponse) Reset() {
+	*x = BulkMoveResponse{}
+	mi := &file_tracker_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkMoveResponse) ProtoMessage() {}
+
+func (x *BulkMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkMoveResponse.ProtoReflect.Descriptor instead.
+func (*BulkMoveResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BulkMoveResponse) GetResults() []*BulkMoveResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkMoveResponse) GetMovedCount() int32 {
+	if x != nil {
+		return x.MovedCount
+	}
+	return 0
+}
+
+type BulkMoveResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	// Set when ok = false. error_code is NOT_FOUND or INVALID_ARGUMENT;
+	// error_reason the machine-readable reason (APPLICATION_NOT_FOUND,
+	// VALIDATION_FAILED…), as in the ErrorInfo of failed calls.
+	ErrorCode string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The updated application when ok = true.
+	Application   *ApplicationProto `protobuf:"bytes,5,opt,name=application,proto3" json:"application,omitempty"`
+	ErrorReason   string            `protobuf:"bytes,6,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkMoveResult) Reset() {
+	*x = BulkMoveResult{}
+	mi := &file_tracker_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkMoveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkMoveResult) ProtoMessage() {}
+
+func (x *BulkMoveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkMoveResult.ProtoReflect.Descriptor instead.
+func (*BulkMoveResult) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BulkMoveResult) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *BulkMoveResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *BulkMoveResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BulkMoveResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkMoveResult) GetApplication() *ApplicationProto {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *BulkMoveResult) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+type ListColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*BoardColumn         `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"` // ordered by status, then position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColumnsResponse) Reset() {
+	*x = ListColumnsResponse{}
+	mi := &file_tracker_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColumnsResponse) ProtoMessage() {}
+
+func (x *ListColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ListColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListColumnsResponse) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type DeleteColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnResponse) Reset() {
+	*x = DeleteColumnResponse{}
+	mi := &file_tracker_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnResponse) ProtoMessage() {}
+
+func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColumnResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{111}
+}
+
+type ReanalyzeApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReanalyzeApplicationResponse) Reset() {
+	*x = ReanalyzeApplicationResponse{}
+	mi := &file_tracker_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReanalyzeApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReanalyzeApplicationResponse) ProtoMessage() {}
+
+func (x *ReanalyzeApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReanalyzeApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReanalyzeApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{112}
+}
+
+type ListCoverLetterVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*CoverLetterVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverLetterVersionsResponse) Reset() {
+	*x = ListCoverLetterVersionsResponse{}
+	mi := &file_tracker_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverLetterVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverLetterVersionsResponse) ProtoMessage() {}
+
+func (x *ListCoverLetterVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverLetterVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoverLetterVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListCoverLetterVersionsResponse) GetVersions() []*CoverLetterVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RegenerateCoverLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateCoverLetterResponse) Reset() {
+	*x = RegenerateCoverLetterResponse{}
+	mi := &file_tracker_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateCoverLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateCoverLetterResponse) ProtoMessage() {}
+
+func (x *RegenerateCoverLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateCoverLetterResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateCoverLetterResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{114}
+}
+
+type RequestFollowUpDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestFollowUpDraftResponse) Reset() {
+	*x = RequestFollowUpDraftResponse{}
+	mi := &file_tracker_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestFollowUpDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFollowUpDraftResponse) ProtoMessage() {}
+
+func (x *RequestFollowUpDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFollowUpDraftResponse.ProtoReflect.Descriptor instead.
+func (*RequestFollowUpDraftResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{115}
+}
+
+type CoverLetterVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 1, 2, … per application
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverLetterVersion) Reset() {
+	*x = CoverLetterVersion{}
+	mi := &file_tracker_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverLetterVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverLetterVersion) ProtoMessage() {}
+
+func (x *CoverLetterVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverLetterVersion.ProtoReflect.Descriptor instead.
+func (*CoverLetterVersion) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CoverLetterVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoverLetterVersion) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CoverLetterVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CoverLetterVersion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CoverLetterVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Upload        *AttachmentUrl         `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"` // PUT the file here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentResponse) Reset() {
+	*x = CreateAttachmentResponse{}
+	mi := &file_tracker_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentResponse) ProtoMessage() {}
+
+func (x *CreateAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateAttachmentResponse) GetUpload() *AttachmentUrl {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_tracker_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_tracker_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{119}
+}
+
+type ListInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*Interview           `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterviewsResponse) Reset() {
+	*x = ListInterviewsResponse{}
+	mi := &file_tracker_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsResponse) ProtoMessage() {}
+
+func (x *ListInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListInterviewsResponse) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+type DeleteInterviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInterviewResponse) Reset() {
+	*x = DeleteInterviewResponse{}
+	mi := &file_tracker_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterviewResponse) ProtoMessage() {}
+
+func (x *DeleteInterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInterviewResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{121}
+}
+
+type CompareOffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offers        []*OfferComparison     `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareOffersResponse) Reset() {
+	*x = CompareOffersResponse{}
+	mi := &file_tracker_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareOffersResponse) ProtoMessage() {}
+
+func (x *CompareOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareOffersResponse.ProtoReflect.Descriptor instead.
+func (*CompareOffersResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CompareOffersResponse) GetOffers() []*OfferComparison {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type ListNegotiationEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*NegotiationEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNegotiationEntriesResponse) Reset() {
+	*x = ListNegotiationEntriesResponse{}
+	mi := &file_tracker_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNegotiationEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNegotiationEntriesResponse) ProtoMessage() {}
+
+func (x *ListNegotiationEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNegotiationEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListNegotiationEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListNegotiationEntriesResponse) GetEntries() []*NegotiationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DeleteNegotiationEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNegotiationEntryResponse) Reset() {
+	*x = DeleteNegotiationEntryResponse{}
+	mi := &file_tracker_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNegotiationEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNegotiationEntryResponse) ProtoMessage() {}
+
+func (x *DeleteNegotiationEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNegotiationEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNegotiationEntryResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{124}
+}
+
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_tracker_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type DeleteContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactResponse) Reset() {
+	*x = DeleteContactResponse{}
+	mi := &file_tracker_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactResponse) ProtoMessage() {}
+
+func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{126}
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*CompanySummary      `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_tracker_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*CompanySummary {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type CompanySummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // as spelled on the most recent application
+	ApplicationCount int32                  `protobuf:"varint,2,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	ActiveCount      int32                  `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"` // on the board and not HIRED/REJECTED/WITHDRAWN
+	RejectedCount    int32                  `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	StatusCounts     map[string]int32       `protobuf:"bytes,5,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // status → applications
+	ContactCount     int32                  `protobuf:"varint,6,opt,name=contact_count,json=contactCount,proto3" json:"contact_count,omitempty"`
+	LastActivityAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CompanySummary) Reset() {
+	*x = CompanySummary{}
+	mi := &file_tracker_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySummary) ProtoMessage() {}
+
+func (x *CompanySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySummary.ProtoReflect.Descriptor instead.
+func (*CompanySummary) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CompanySummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompanySummary) GetApplicationCount() int32 {
+	if x != nil {
+		return x.ApplicationCount
+	}
+	return 0
+}
+
+func (x *CompanySummary) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *CompanySummary) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *CompanySummary) GetStatusCounts() map[string]int32 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *CompanySummary) GetContactCount() int32 {
+	if x != nil {
+		return x.ContactCount
+	}
+	return 0
+}
+
+func (x *CompanySummary) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+type CompanyOverview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *CompanySummary        `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Applications  []*ApplicationProto    `protobuf:"bytes,2,rep,name=applications,proto3" json:"applications,omitempty"` // most recently updated first
+	Contacts      []*Contact             `protobuf:"bytes,3,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Notes         []*Note                `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"` // newest first, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyOverview) Reset() {
+	*x = CompanyOverview{}
+	mi := &file_tracker_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyOverview) ProtoMessage() {}
+
+func (x *CompanyOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyOverview.ProtoReflect.Descriptor instead.
+func (*CompanyOverview) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CompanyOverview) GetSummary() *CompanySummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *CompanyOverview) GetApplications() []*ApplicationProto {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *CompanyOverview) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *CompanyOverview) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Contact struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // required, at most 200 characters
+	Role            string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                  // e.g. "Tech recruiter"
+	Email           string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                // plain address
+	LinkedinUrl     string                 `protobuf:"bytes,5,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"` // http(s)://…linkedin.com/…
+	Company         string                 `protobuf:"bytes,6,opt,name=company,proto3" json:"company,omitempty"`
+	LastContactedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_contacted_at,json=lastContactedAt,proto3" json:"last_contacted_at,omitempty"`
+	ApplicationIds  []string               `protobuf:"bytes,8,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"` // linked applications
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_tracker_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetLinkedinUrl() string {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return ""
+}
+
+func (x *Contact) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *Contact) GetLastContactedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastContactedAt
+	}
+	return nil
+}
+
+func (x *Contact) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *Contact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contact) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Interview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Round         int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"` // 1, 2, … per application
+	// PHONE_SCREEN, TECHNICAL, BEHAVIORAL, ONSITE, HR, FINAL or OTHER (default).
+	Type        string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // unset = not scheduled yet
+	Interviewer string                 `protobuf:"bytes,6,opt,name=interviewer,proto3" json:"interviewer,omitempty"`                    // at most 200 characters
+	// PENDING (default), PASSED, FAILED or CANCELLED.
+	Outcome   string                 `protobuf:"bytes,7,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Notes     string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"` // at most 10,000 characters
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Unset until RecordInterviewFeedback is called.
+	Feedback      *InterviewFeedback `protobuf:"bytes,11,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interview) Reset() {
+	*x = Interview{}
+	mi := &file_tracker_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interview) ProtoMessage() {}
+
+func (x *Interview) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interview.ProtoReflect.Descriptor instead.
+func (*Interview) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Interview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Interview) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Interview) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Interview) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Interview) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Interview) GetInterviewer() string {
+	if x != nil {
+		return x.Interviewer
+	}
+	return ""
+}
+
+func (x *Interview) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Interview) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Interview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Interview) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Interview) GetFeedback() *InterviewFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+// Offer terms. Amounts are yearly, gross, in currency; 0 = not given.
+type Offer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId    string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217, default EUR
+	BaseSalary       int64                  `protobuf:"varint,3,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	Bonus            int64                  `protobuf:"varint,4,opt,name=bonus,proto3" json:"bonus,omitempty"`
+	EquityValue      int64                  `protobuf:"varint,5,opt,name=equity_value,json=equityValue,proto3" json:"equity_value,omitempty"`      // estimated value per year
+	EquityDetails    string                 `protobuf:"bytes,6,opt,name=equity_details,json=equityDetails,proto3" json:"equity_details,omitempty"` // at most 500 characters
+	StartDate        string                 `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`             // YYYY-MM-DD, empty = unknown
+	ResponseDeadline *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=response_deadline,json=responseDeadline,proto3" json:"response_deadline,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	mi := &file_tracker_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Offer) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Offer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Offer) GetBaseSalary() int64 {
+	if x != nil {
+		return x.BaseSalary
+	}
+	return 0
+}
+
+func (x *Offer) GetBonus() int64 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *Offer) GetEquityValue() int64 {
+	if x != nil {
+		return x.EquityValue
+	}
+	return 0
+}
+
+func (x *Offer) GetEquityDetails() string {
+	if x != nil {
+		return x.EquityDetails
+	}
+	return ""
+}
+
+func (x *Offer) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Offer) GetResponseDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResponseDeadline
+	}
+	return nil
+}
+
+func (x *Offer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// One offer in a CompareOffers result.
+type OfferComparison struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,2,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	Company       string                 `protobuf:"bytes,3,opt,name=company,proto3" json:"company,omitempty"`
+	Offer         *Offer                 `protobuf:"bytes,4,opt,name=offer,proto3" json:"offer,omitempty"` // unset until SetOfferDetails is called
+	// base_salary + bonus + equity_value, in the offer's currency.
+	TotalCompensation int64 `protobuf:"varint,5,opt,name=total_compensation,json=totalCompensation,proto3" json:"total_compensation,omitempty"`
+	// 1 = best total among offers in the same currency; 0 = no amounts given.
+	Rank int32 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	// total_compensation as a fraction of that best total (1 for the best).
+	RelativeToBest float64             `protobuf:"fixed64,7,opt,name=relative_to_best,json=relativeToBest,proto3" json:"relative_to_best,omitempty"`
+	Negotiation    []*NegotiationEntry `protobuf:"bytes,8,rep,name=negotiation,proto3" json:"negotiation,omitempty"` // oldest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OfferComparison) Reset() {
+	*x = OfferComparison{}
+	mi := &file_tracker_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferComparison) ProtoMessage() {}
+
+func (x *OfferComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferComparison.ProtoReflect.Descriptor instead.
+func (*OfferComparison) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *OfferComparison) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *OfferComparison) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *OfferComparison) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *OfferComparison) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *OfferComparison) GetTotalCompensation() int64 {
+	if x != nil {
+		return x.TotalCompensation
+	}
+	return 0
+}
+
+func (x *OfferComparison) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *OfferComparison) GetRelativeToBest() float64 {
+	if x != nil {
+		return x.RelativeToBest
+	}
+	return 0
+}
+
+func (x *OfferComparison) GetNegotiation() []*NegotiationEntry {
+	if x != nil {
+		return x.Negotiation
+	}
+	return nil
+}
+
+// One step of an offer negotiation. Amounts are the yearly figures proposed
+// at this step, in the offer's currency; 0 = not part of it.
+type NegotiationEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// COUNTER_OFFER (made by the user), REVISED_OFFER (from the company) or
+	// NOTE (default, note required).
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	BaseSalary    int64                  `protobuf:"varint,4,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	Bonus         int64                  `protobuf:"varint,5,opt,name=bonus,proto3" json:"bonus,omitempty"`
+	EquityValue   int64                  `protobuf:"varint,6,opt,name=equity_value,json=equityValue,proto3" json:"equity_value,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // unset = now
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NegotiationEntry) Reset() {
+	*x = NegotiationEntry{}
+	mi := &file_tracker_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NegotiationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NegotiationEntry) ProtoMessage() {}
+
+func (x *NegotiationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NegotiationEntry.ProtoReflect.Descriptor instead.
+func (*NegotiationEntry) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *NegotiationEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NegotiationEntry) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *NegotiationEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NegotiationEntry) GetBaseSalary() int64 {
+	if x != nil {
+		return x.BaseSalary
+	}
+	return 0
+}
+
+func (x *NegotiationEntry) GetBonus() int64 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *NegotiationEntry) GetEquityValue() int64 {
+	if x != nil {
+		return x.EquityValue
+	}
+	return 0
+}
+
+func (x *NegotiationEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *NegotiationEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *NegotiationEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InterviewFeedback struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WentWell       string                 `protobuf:"bytes,1,opt,name=went_well,json=wentWell,proto3" json:"went_well,omitempty"`
+	RedFlags       string                 `protobuf:"bytes,2,opt,name=red_flags,json=redFlags,proto3" json:"red_flags,omitempty"`
+	QuestionsAsked []string               `protobuf:"bytes,3,rep,name=questions_asked,json=questionsAsked,proto3" json:"questions_asked,omitempty"`
+	RecordedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InterviewFeedback) Reset() {
+	*x = InterviewFeedback{}
+	mi := &file_tracker_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterviewFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterviewFeedback) ProtoMessage() {}
+
+func (x *InterviewFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterviewFeedback.ProtoReflect.Descriptor instead.
+func (*InterviewFeedback) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *InterviewFeedback) GetWentWell() string {
+	if x != nil {
+		return x.WentWell
+	}
+	return ""
+}
+
+func (x *InterviewFeedback) GetRedFlags() string {
+	if x != nil {
+		return x.RedFlags
+	}
+	return ""
+}
+
+func (x *InterviewFeedback) GetQuestionsAsked() []string {
+	if x != nil {
+		return x.QuestionsAsked
+	}
+	return nil
+}
+
+func (x *InterviewFeedback) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Malware scan: PENDING, CLEAN, QUARANTINED (malware found, file
+	// deleted) or UNSCANNED (uploaded while scanning was off).
+	ScanStatus    string                 `protobuf:"bytes,8,opt,name=scan_status,json=scanStatus,proto3" json:"scan_status,omitempty"`
+	ScanSignature string                 `protobuf:"bytes,9,opt,name=scan_signature,json=scanSignature,proto3" json:"scan_signature,omitempty"` // what a QUARANTINED file contains
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_tracker_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Attachment) GetScanStatus() string {
+	if x != nil {
+		return x.ScanStatus
+	}
+	return ""
+}
+
+func (x *Attachment) GetScanSignature() string {
+	if x != nil {
+		return x.ScanSignature
+	}
+	return ""
+}
+
+func (x *Attachment) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+// A presigned object-store URL, valid until expires_at.
+type AttachmentUrl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUrl) Reset() {
+	*x = AttachmentUrl{}
+	mi := &file_tracker_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUrl) ProtoMessage() {}
+
+func (x *AttachmentUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUrl.ProtoReflect.Descriptor instead.
+func (*AttachmentUrl) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AttachmentUrl) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AttachmentUrl) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_tracker_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_tracker_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{139}
+}
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // unset = never edited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_tracker_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Note) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Note) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Note) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Note) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Note) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_tracker_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *BoardColumn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BoardColumn) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RejectionStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Stage         string                 `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectionStat) Reset() {
+	*x = RejectionStat{}
+	mi := &file_tracker_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectionStat) ProtoMessage() {}
+
+func (x *RejectionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectionStat.ProtoReflect.Descriptor instead.
+func (*RejectionStat) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RejectionStat) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectionStat) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *RejectionStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetRejectionStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*RejectionStat       `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRejectionStatsResponse) Reset() {
+	*x = GetRejectionStatsResponse{}
+	mi := &file_tracker_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRejectionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRejectionStatsResponse) ProtoMessage() {}
+
+func (x *GetRejectionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRejectionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRejectionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetRejectionStatsResponse) GetStats() []*RejectionStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// The funnel of one search config. Manually added jobs are not counted.
+type SearchConfigStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SearchConfigId string                 `protobuf:"bytes,1,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"`
+	JobTitles      []string               `protobuf:"bytes,2,rep,name=job_titles,json=jobTitles,proto3" json:"job_titles,omitempty"`
+	Locations      []string               `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	IsActive       bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Discovered     int32                  `protobuf:"varint,6,opt,name=discovered,proto3" json:"discovered,omitempty"`     // offers found, still in the feed
+	Applications   int32                  `protobuf:"varint,7,opt,name=applications,proto3" json:"applications,omitempty"` // applications created from them, archived included
+	Applied        int32                  `protobuf:"varint,8,opt,name=applied,proto3" json:"applied,omitempty"`           // sent (moved past TO_APPLY)
+	Interviewed    int32                  `protobuf:"varint,9,opt,name=interviewed,proto3" json:"interviewed,omitempty"`   // reached INTERVIEW
+	Hired          int32                  `protobuf:"varint,10,opt,name=hired,proto3" json:"hired,omitempty"`              // reached HIRED
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchConfigStats) Reset() {
+	*x = SearchConfigStats{}
+	mi := &file_tracker_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConfigStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConfigStats) ProtoMessage() {}
+
+func (x *SearchConfigStats) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConfigStats.ProtoReflect.Descriptor instead.
+func (*SearchConfigStats) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SearchConfigStats) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+func (x *SearchConfigStats) GetJobTitles() []string {
+	if x != nil {
+		return x.JobTitles
+	}
+	return nil
+}
+
+func (x *SearchConfigStats) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *SearchConfigStats) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SearchConfigStats) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SearchConfigStats) GetDiscovered() int32 {
+	if x != nil {
+		return x.Discovered
+	}
+	return 0
+}
+
+func (x *SearchConfigStats) GetApplications() int32 {
+	if x != nil {
+		return x.Applications
+	}
+	return 0
+}
+
+func (x *SearchConfigStats) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *SearchConfigStats) GetInterviewed() int32 {
+	if x != nil {
+		return x.Interviewed
+	}
+	return 0
+}
+
+func (x *SearchConfigStats) GetHired() int32 {
+	if x != nil {
+		return x.Hired
+	}
+	return 0
+}
+
+type GetSearchConfigStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*SearchConfigStats   `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchConfigStatsResponse) Reset() {
+	*x = GetSearchConfigStatsResponse{}
+	mi := &file_tracker_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchConfigStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchConfigStatsResponse) ProtoMessage() {}
+
+func (x *GetSearchConfigStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchConfigStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchConfigStatsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetSearchConfigStatsResponse) GetConfigs() []*SearchConfigStats {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type CountApplicationsByStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        map[string]int32       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // every ApplicationStatus, 0 when empty
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountApplicationsByStatusResponse) Reset() {
+	*x = CountApplicationsByStatusResponse{}
+	mi := &file_tracker_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountApplicationsByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountApplicationsByStatusResponse) ProtoMessage() {}
+
+func (x *CountApplicationsByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountApplicationsByStatusResponse.ProtoReflect.Descriptor instead.
+func (*CountApplicationsByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CountApplicationsByStatusResponse) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *CountApplicationsByStatusResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CalendarFeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // empty unless the feed was just created or rotated
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`   // /calendar/<token>.ics, empty without token
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_tracker_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CalendarFeed) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CalendarFeedContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ics           string                 `protobuf:"bytes,1,opt,name=ics,proto3" json:"ics,omitempty"` // text/calendar
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeedContent) Reset() {
+	*x = CalendarFeedContent{}
+	mi := &file_tracker_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeedContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeedContent) ProtoMessage() {}
+
+func (x *CalendarFeedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeedContent.ProtoReflect.Descriptor instead.
+func (*CalendarFeedContent) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *CalendarFeedContent) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+type Benchmark struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	JobTitle            string                 `protobuf:"bytes,1,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"` // empty = all job titles
+	UserCount           int32                  `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	MedianApplications  float64                `protobuf:"fixed64,3,opt,name=median_applications,json=medianApplications,proto3" json:"median_applications,omitempty"`      // applications sent per user
+	MedianInterviewRate float64                `protobuf:"fixed64,4,opt,name=median_interview_rate,json=medianInterviewRate,proto3" json:"median_interview_rate,omitempty"` // interviews / applications sent
+	ComputedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	// The caller's own figures for the same job title(s).
+	Applications  int32   `protobuf:"varint,6,opt,name=applications,proto3" json:"applications,omitempty"`
+	Interviews    int32   `protobuf:"varint,7,opt,name=interviews,proto3" json:"interviews,omitempty"`
+	InterviewRate float64 `protobuf:"fixed64,8,opt,name=interview_rate,json=interviewRate,proto3" json:"interview_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Benchmark) Reset() {
+	*x = Benchmark{}
+	mi := &file_tracker_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Benchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmark) ProtoMessage() {}
+
+func (x *Benchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmark.ProtoReflect.Descriptor instead.
+func (*Benchmark) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *Benchmark) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *Benchmark) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *Benchmark) GetMedianApplications() float64 {
+	if x != nil {
+		return x.MedianApplications
+	}
+	return 0
+}
+
+func (x *Benchmark) GetMedianInterviewRate() float64 {
+	if x != nil {
+		return x.MedianInterviewRate
+	}
+	return 0
+}
+
+func (x *Benchmark) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+func (x *Benchmark) GetApplications() int32 {
+	if x != nil {
+		return x.Applications
+	}
+	return 0
+}
+
+func (x *Benchmark) GetInterviews() int32 {
+	if x != nil {
+		return x.Interviews
+	}
+	return 0
+}
+
+func (x *Benchmark) GetInterviewRate() float64 {
+	if x != nil {
+		return x.InterviewRate
+	}
+	return 0
+}
+
+// TrackerSettings are the caller's effective tracker preferences.
+type TrackerSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GhostingEnabled bool                   `protobuf:"varint,1,opt,name=ghosting_enabled,json=ghostingEnabled,proto3" json:"ghosting_enabled,omitempty"`
+	GhostAfterDays  int32                  `protobuf:"varint,2,opt,name=ghost_after_days,json=ghostAfterDays,proto3" json:"ghost_after_days,omitempty"`
+	// Transitions the user allows on top of the default state machine
+	// (e.g. TO_APPLY → INTERVIEW for referrals that skip applying).
+	ExtraTransitions     []*Transition `protobuf:"bytes,3,rep,name=extra_transitions,json=extraTransitions,proto3" json:"extra_transitions,omitempty"`
+	ShareBenchmarks      bool          `protobuf:"varint,4,opt,name=share_benchmarks,json=shareBenchmarks,proto3" json:"share_benchmarks,omitempty"`
+	ReminderChannels     []string      `protobuf:"bytes,5,rep,name=reminder_channels,json=reminderChannels,proto3" json:"reminder_channels,omitempty"`                  // IN_APP, PUSH; empty = muted
+	Timezone             string        `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                          // IANA time zone wall-clock reminder times are read in
+	ArchiveSearchOnHired bool          `protobuf:"varint,7,opt,name=archive_search_on_hired,json=archiveSearchOnHired,proto3" json:"archive_search_on_hired,omitempty"` // moving a card to HIRED deactivates its search
+	ClosedRetention      string        `protobuf:"bytes,8,opt,name=closed_retention,json=closedRetention,proto3" json:"closed_retention,omitempty"`                     // COMPACT or DELETE old closed cards (see UpdateSettingsRequest)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TrackerSettings) Reset() {
+	*x = TrackerSettings{}
+	mi := &file_tracker_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerSettings) ProtoMessage() {}
+
+func (x *TrackerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerSettings.ProtoReflect.Descriptor instead.
+func (*TrackerSettings) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *TrackerSettings) GetGhostingEnabled() bool {
+	if x != nil {
+		return x.GhostingEnabled
+	}
+	return false
+}
+
+func (x *TrackerSettings) GetGhostAfterDays() int32 {
+	if x != nil {
+		return x.GhostAfterDays
+	}
+	return 0
+}
+
+func (x *TrackerSettings) GetExtraTransitions() []*Transition {
+	if x != nil {
+		return x.ExtraTransitions
+	}
+	return nil
+}
+
+func (x *TrackerSettings) GetShareBenchmarks() bool {
+	if x != nil {
+		return x.ShareBenchmarks
+	}
+	return false
+}
+
+func (x *TrackerSettings) GetReminderChannels() []string {
+	if x != nil {
+		return x.ReminderChannels
+	}
+	return nil
+}
+
+func (x *TrackerSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TrackerSettings) GetArchiveSearchOnHired() bool {
+	if x != nil {
+		return x.ArchiveSearchOnHired
+	}
+	return false
+}
+
+func (x *TrackerSettings) GetClosedRetention() string {
+	if x != nil {
+		return x.ClosedRetention
+	}
+	return ""
+}
+
+// ApplicationProto mirrors the Applications table row returned to clients.
+// JSON blobs (ai_analysis, history_log) are carried as raw bytes so the
+// Gateway can forward them to the frontend without an extra parse/marshal cycle.
+type ApplicationProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentStatus string                 `protobuf:"bytes,2,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
+	// Raw JSON bytes — forwarded as-is to GraphQL clients.
+	AiAnalysis []byte `protobuf:"bytes,3,opt,name=ai_analysis,json=aiAnalysis,proto3" json:"ai_analysis,omitempty"`
+	// Whole log; empty in list responses. Prefer GetHistory.
+	HistoryLog []byte `protobuf:"bytes,7,opt,name=history_log,json=historyLog,proto3" json:"history_log,omitempty"`
+	// Nullable string fields (empty string = NULL in DB)
+	GeneratedCoverLetter string `protobuf:"bytes,4,opt,name=generated_cover_letter,json=generatedCoverLetter,proto3" json:"generated_cover_letter,omitempty"`
+	UserNotes            string `protobuf:"bytes,5,opt,name=user_notes,json=userNotes,proto3" json:"user_notes,omitempty"`
+	// Nullable int32 — 0 means unrated. The mean of rating_criteria when set.
+	UserRating int32                  `protobuf:"varint,6,opt,name=user_rating,json=userRating,proto3" json:"user_rating,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Relational context — used by Gateway for archiveSearchConfig + UI deep links
+	JobFeedId      string `protobuf:"bytes,10,opt,name=job_feed_id,json=jobFeedId,proto3" json:"job_feed_id,omitempty"`                // empty if job_feed was deleted
+	SearchConfigId string `protobuf:"bytes,11,opt,name=search_config_id,json=searchConfigId,proto3" json:"search_config_id,omitempty"` // derived via job_feed.search_config_id (empty if manual/deleted)
+	// Set when the application was archived — unset = on the board
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// While ON_HOLD: the stage the card was paused from (APPLIED or INTERVIEW),
+	// the only active column it may resume to. Empty otherwise.
+	OnHoldFrom string `protobuf:"bytes,14,opt,name=on_hold_from,json=onHoldFrom,proto3" json:"on_hold_from,omitempty"`
+	// Set when the card sat in APPLIED/INTERVIEW without any update for longer
+	// than the user's ghost_after_days. Cleared on the next status change.
+	GhostedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=ghosted_at,json=ghostedAt,proto3" json:"ghosted_at,omitempty"`
+	// Custom board column the card sits in — empty = its status' default lane.
+	ColumnId string `protobuf:"bytes,16,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	// LOW, MEDIUM (default) or HIGH.
+	Priority string `protobuf:"bytes,17,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Next step deadline (take-home test, offer response, …) — unset = none.
+	NextStepDueAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=next_step_due_at,json=nextStepDueAt,proto3" json:"next_step_due_at,omitempty"`
+	NextStepLabel string                 `protobuf:"bytes,19,opt,name=next_step_label,json=nextStepLabel,proto3" json:"next_step_label,omitempty"`
+	// Job details denormalized from the linked job_feed row, so clients can
+	// render a card without a second lookup. Empty when unknown/deleted.
+	JobTitle  string `protobuf:"bytes,20,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	Company   string `protobuf:"bytes,21,opt,name=company,proto3" json:"company,omitempty"`
+	Location  string `protobuf:"bytes,22,opt,name=location,proto3" json:"location,omitempty"`
+	SourceUrl string `protobuf:"bytes,23,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// Interview rounds, by round. Filled by GetApplication only.
+	Interviews []*Interview `protobuf:"bytes,24,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	// While REJECTED: why (empty = not given) and the status it happened at.
+	RejectionReason string `protobuf:"bytes,25,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	RejectionStage  string `protobuf:"bytes,26,opt,name=rejection_stage,json=rejectionStage,proto3" json:"rejection_stage,omitempty"`
+	// Offer terms, if recorded. Filled by GetApplication only.
+	Offer *Offer `protobuf:"bytes,27,opt,name=offer,proto3" json:"offer,omitempty"`
+	// Status-relative reminder rule — unset = none.
+	ReminderRule *ReminderRule `protobuf:"bytes,28,opt,name=reminder_rule,json=reminderRule,proto3" json:"reminder_rule,omitempty"`
+	// Relance reminder — unset = none.
+	RelanceReminderAt *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=relance_reminder_at,json=relanceReminderAt,proto3" json:"relance_reminder_at,omitempty"`
+	// Latest follow-up message drafted by the AI Coach (see
+	// RequestFollowUpDraft) and when — empty / unset = none.
+	FollowupDraft   string                 `protobuf:"bytes,30,opt,name=followup_draft,json=followupDraft,proto3" json:"followup_draft,omitempty"`
+	FollowupDraftAt *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=followup_draft_at,json=followupDraftAt,proto3" json:"followup_draft_at,omitempty"`
+	// Structured rating (see RateApplicationRequest) — unset / empty = none.
+	// user_rating is always set alongside.
+	RatingCriteria *RatingCriteria `protobuf:"bytes,32,opt,name=rating_criteria,json=ratingCriteria,proto3" json:"rating_criteria,omitempty"`
+	RatingReview   string          `protobuf:"bytes,33,opt,name=rating_review,json=ratingReview,proto3" json:"rating_review,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApplicationProto) Reset() {
+	*x = ApplicationProto{}
+	mi := &file_tracker_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationProto) ProtoMessage() {}
+
+func (x *ApplicationProto) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationProto.ProtoReflect.Descriptor instead.
+func (*ApplicationProto) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ApplicationProto) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetCurrentStatus() string {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetAiAnalysis() []byte {
+	if x != nil {
+		return x.AiAnalysis
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetHistoryLog() []byte {
+	if x != nil {
+		return x.HistoryLog
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetGeneratedCoverLetter() string {
+	if x != nil {
+		return x.GeneratedCoverLetter
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetUserNotes() string {
+	if x != nil {
+		return x.UserNotes
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetUserRating() int32 {
+	if x != nil {
+		return x.UserRating
+	}
+	return 0
+}
+
+func (x *ApplicationProto) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetJobFeedId() string {
+	if x != nil {
+		return x.JobFeedId
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetSearchConfigId() string {
+	if x != nil {
+		return x.SearchConfigId
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetOnHoldFrom() string {
+	if x != nil {
+		return x.OnHoldFrom
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetGhostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GhostedAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetNextStepDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextStepDueAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetNextStepLabel() string {
+	if x != nil {
+		return x.NextStepLabel
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetRejectionStage() string {
+	if x != nil {
+		return x.RejectionStage
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetReminderRule() *ReminderRule {
+	if x != nil {
+		return x.ReminderRule
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetRelanceReminderAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RelanceReminderAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetFollowupDraft() string {
+	if x != nil {
+		return x.FollowupDraft
+	}
+	return ""
+}
+
+func (x *ApplicationProto) GetFollowupDraftAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowupDraftAt
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetRatingCriteria() *RatingCriteria {
+	if x != nil {
+		return x.RatingCriteria
+	}
+	return nil
+}
+
+func (x *ApplicationProto) GetRatingReview() string {
+	if x != nil {
+		return x.RatingReview
+	}
+	return ""
+}
+
+type ListAuditLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters — empty/unset matches everything. Non-administrators may only
+	// leave actor_id empty or set it to themselves.
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // e.g. "tracker.MoveCard", "discovery.TriggerScan"
+	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`                          // exclusive
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 0 = 50, at most 500
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_tracker_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditLogRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_tracker_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogResponse) ProtoMessage() {}
+
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// One recorded mutating operation. JSON blobs are raw bytes.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`                // tracker, discovery
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // empty = unauthenticated or background job
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // application, contact, settings, job_feed, …
+	ResourceId    string                 `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	OldValues     []byte                 `protobuf:"bytes,8,opt,name=old_values,json=oldValues,proto3" json:"old_values,omitempty"` // resource before the operation, empty when unknown
+	NewValues     []byte                 `protobuf:"bytes,9,opt,name=new_values,json=newValues,proto3" json:"new_values,omitempty"` // and after
+	Request       []byte                 `protobuf:"bytes,10,opt,name=request,proto3" json:"request,omitempty"`                     // secrets redacted
+	Outcome       string                 `protobuf:"bytes,11,opt,name=outcome,proto3" json:"outcome,omitempty"`                     // OK or the gRPC status code of the failure
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_tracker_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOldValues() []byte {
+	if x != nil {
+		return x.OldValues
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetNewValues() []byte {
+	if x != nil {
+		return x.NewValues
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetRequest() []byte {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_tracker_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{155}
+}
+
+type UserDataExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON object: userId, exportedAt and one array of rows (columns as
+	// stored) per section — settings, applications, notes, interviews, …
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_tracker_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *UserDataExport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type EraseUserDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DELETE (default): every row goes. ANONYMIZE: applications are kept with
+	// their jobs, statuses and dates; everything written by the user or
+	// identifying people (notes, cover letters, attachments, contacts,
+	// interview feedback, move comments, board shares, webhooks, audited
+	// requests) is deleted or blanked.
+	Mode          string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataRequest) Reset() {
+	*x = EraseUserDataRequest{}
+	mi := &file_tracker_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataRequest) ProtoMessage() {}
+
+func (x *EraseUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *EraseUserDataRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type EraseUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          map[string]int64       `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // rows deleted or anonymized, per table
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataResponse) Reset() {
+	*x = EraseUserDataResponse{}
+	mi := &file_tracker_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataResponse) ProtoMessage() {}
+
+func (x *EraseUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *EraseUserDataResponse) GetRows() map[string]int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CreateBoardShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GranteeUserId string                 `protobuf:"bytes,1,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"` // empty = token share
+	Statuses      []string               `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`                                  // visible columns; empty = the whole board
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardShareRequest) Reset() {
+	*x = CreateBoardShareRequest{}
+	mi := &file_tracker_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardShareRequest) ProtoMessage() {}
+
+func (x *CreateBoardShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardShareRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CreateBoardShareRequest) GetGranteeUserId() string {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return ""
+}
+
+func (x *CreateBoardShareRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CreateBoardShareRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BoardShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	GranteeUserId string                 `protobuf:"bytes,3,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"` // empty for token shares
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                                        // token shares, in CreateBoardShare's response only
+	Statuses      []string               `protobuf:"bytes,5,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardShare) Reset() {
+	*x = BoardShare{}
+	mi := &file_tracker_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardShare) ProtoMessage() {}
+
+func (x *BoardShare) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardShare.ProtoReflect.Descriptor instead.
+func (*BoardShare) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *BoardShare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardShare) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *BoardShare) GetGranteeUserId() string {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return ""
+}
+
+func (x *BoardShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BoardShare) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *BoardShare) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BoardShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBoardSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      bool                   `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"` // shares granted to the caller rather than by them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardSharesRequest) Reset() {
+	*x = ListBoardSharesRequest{}
+	mi := &file_tracker_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardSharesRequest) ProtoMessage() {}
+
+func (x *ListBoardSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardSharesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListBoardSharesRequest) GetReceived() bool {
+	if x != nil {
+		return x.Received
+	}
+	return false
+}
+
+type ListBoardSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*BoardShare          `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardSharesResponse) Reset() {
+	*x = ListBoardSharesResponse{}
+	mi := &file_tracker_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardSharesResponse) ProtoMessage() {}
+
+func (x *ListBoardSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardSharesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListBoardSharesResponse) GetShares() []*BoardShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeBoardShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareId       string                 `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeBoardShareRequest) Reset() {
+	*x = RevokeBoardShareRequest{}
+	mi := &file_tracker_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeBoardShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeBoardShareRequest) ProtoMessage() {}
+
+func (x *RevokeBoardShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeBoardShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeBoardShareRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *RevokeBoardShareRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+type RevokeBoardShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeBoardShareResponse) Reset() {
+	*x = RevokeBoardShareResponse{}
+	mi := &file_tracker_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeBoardShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeBoardShareResponse) ProtoMessage() {}
+
+func (x *RevokeBoardShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeBoardShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokeBoardShareResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{164}
+}
+
+type ListSharedApplicationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of share_id (a share granted to the caller) or share_token.
+	ShareId    string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	// As in ListApplicationsRequest.
+	StatusFilter   string `protobuf:"bytes,3,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	PriorityFilter string `protobuf:"bytes,4,opt,name=priority_filter,json=priorityFilter,proto3" json:"priority_filter,omitempty"`
+	SortBy         string `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	View           string `protobuf:"bytes,6,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSharedApplicationsRequest) Reset() {
+	*x = ListSharedApplicationsRequest{}
+	mi := &file_tracker_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedApplicationsRequest) ProtoMessage() {}
+
+func (x *ListSharedApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ListSharedApplicationsRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ListSharedApplicationsRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ListSharedApplicationsRequest) GetStatusFilter() string {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return ""
+}
+
+func (x *ListSharedApplicationsRequest) GetPriorityFilter() string {
+	if x != nil {
+		return x.PriorityFilter
+	}
+	return ""
+}
+
+func (x *ListSharedApplicationsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListSharedApplicationsRequest) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+type GetSharedApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareId       string                 `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedApplicationRequest) Reset() {
+	*x = GetSharedApplicationRequest{}
+	mi := &file_tracker_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedApplicationRequest) ProtoMessage() {}
+
+func (x *GetSharedApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetSharedApplicationRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *GetSharedApplicationRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetSharedApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // EVENT_CARD_MOVED, EVENT_APPLICATION_CREATED, EVENT_RELANCE_DUE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_tracker_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"` // in CreateWebhook's response only
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_tracker_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_tracker_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{169}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_tracker_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_tracker_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_tracker_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{172}
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // PENDING, DELIVERED or DEAD; empty = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_tracker_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType      string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // PENDING, DELIVERED or DEAD
+	Attempts       int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`     // PENDING only
+	LastStatusCode int32                  `protobuf:"varint,6,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"` // 0 = no response
+	LastError      string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_tracker_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_tracker_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_tracker_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type RetryWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryResponse) Reset() {
+	*x = RetryWebhookDeliveryResponse{}
+	mi := &file_tracker_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{177}
+}
+
+type TakeBoardSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeBoardSnapshotRequest) Reset() {
+	*x = TakeBoardSnapshotRequest{}
+	mi := &file_tracker_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeBoardSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeBoardSnapshotRequest) ProtoMessage() {}
+
+func (x *TakeBoardSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeBoardSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*TakeBoardSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{178}
+}
+
+type BoardSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TakenAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	Cards         int32                  `protobuf:"varint,3,opt,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardSnapshot) Reset() {
+	*x = BoardSnapshot{}
+	mi := &file_tracker_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSnapshot) ProtoMessage() {}
+
+func (x *BoardSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSnapshot.ProtoReflect.Descriptor instead.
+func (*BoardSnapshot) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *BoardSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardSnapshot) GetTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakenAt
+	}
+	return nil
+}
+
+func (x *BoardSnapshot) GetCards() int32 {
+	if x != nil {
+		return x.Cards
+	}
+	return 0
+}
+
+type CompareSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"` // unset = now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareSnapshotsRequest) Reset() {
+	*x = CompareSnapshotsRequest{}
+	mi := &file_tracker_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareSnapshotsRequest) ProtoMessage() {}
+
+func (x *CompareSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*CompareSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CompareSnapshotsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// A card as a snapshot recorded it.
+type SnapshotCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	Company       string                 `protobuf:"bytes,4,opt,name=company,proto3" json:"company,omitempty"`
+	JobTitle      string                 `protobuf:"bytes,5,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotCard) Reset() {
+	*x = SnapshotCard{}
+	mi := &file_tracker_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotCard) ProtoMessage() {}
+
+func (x *SnapshotCard) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotCard.ProtoReflect.Descriptor instead.
+func (*SnapshotCard) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SnapshotCard) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SnapshotCard) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SnapshotCard) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *SnapshotCard) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *SnapshotCard) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+// A card whose status changed; card is as it ended up.
+type SnapshotMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *SnapshotCard          `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotMove) Reset() {
+	*x = SnapshotMove{}
+	mi := &file_tracker_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMove) ProtoMessage() {}
+
+func (x *SnapshotMove) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMove.ProtoReflect.Descriptor instead.
+func (*SnapshotMove) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SnapshotMove) GetCard() *SnapshotCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *SnapshotMove) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+type CompareSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromTakenAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from_taken_at,json=fromTakenAt,proto3" json:"from_taken_at,omitempty"`
+	ToTakenAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to_taken_at,json=toTakenAt,proto3" json:"to_taken_at,omitempty"`
+	Added         []*SnapshotCard        `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*SnapshotCard        `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"` // deleted or merged into another card
+	Moved         []*SnapshotMove        `protobuf:"bytes,5,rep,name=moved,proto3" json:"moved,omitempty"`
+	Archived      []*SnapshotCard        `protobuf:"bytes,6,rep,name=archived,proto3" json:"archived,omitempty"`
+	Restored      []*SnapshotCard        `protobuf:"bytes,7,rep,name=restored,proto3" json:"restored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareSnapshotsResponse) Reset() {
+	*x = CompareSnapshotsResponse{}
+	mi := &file_tracker_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareSnapshotsResponse) ProtoMessage() {}
+
+func (x *CompareSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tracker_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*CompareSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_tracker_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *CompareSnapshotsResponse) GetFromTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTakenAt
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetToTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTakenAt
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetAdded() []*SnapshotCard {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetRemoved() []*SnapshotCard {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetMoved() []*SnapshotMove {
+	if x != nil {
+		return x.Moved
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetArchived() []*SnapshotCard {
+	if x != nil {
+		return x.Archived
+	}
+	return nil
+}
+
+func (x *CompareSnapshotsResponse) GetRestored() []*SnapshotCard {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+var File_tracker_proto protoreflect.FileDescriptor
+
+const file_tracker_proto_rawDesc = "" +
+	"\n" +
+	"\rtracker.proto\x12\atracker\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x01\n" +
+	"\x17ListApplicationsRequest\x12#\n" +
+	"\rstatus_filter\x18\x01 \x01(\tR\fstatusFilter\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\x12'\n" +
+	"\x0fpriority_filter\x18\x03 \x01(\tR\x0epriorityFilter\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04view\x18\x05 \x01(\tR\x04view\">\n" +
+	"\x15GetApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"F\n" +
+	"\x1bBatchGetApplicationsRequest\x12'\n" +
+	"\x0fapplication_ids\x18\x01 \x03(\tR\x0eapplicationIds\"]\n" +
+	"\x1cBatchGetApplicationsResponse\x12=\n" +
+	"\fapplications\x18\x01 \x03(\v2\x19.tracker.ApplicationProtoR\fapplications\"B\n" +
+	" GetApplicationByJobFeedIdRequest\x12\x1e\n" +
+	"\vjob_feed_id\x18\x01 \x01(\tR\tjobFeedId\"`\n" +
+	"!GetApplicationByJobFeedIdResponse\x12;\n" +
+	"\vapplication\x18\x01 \x01(\v2\x19.tracker.ApplicationProtoR\vapplication\"v\n" +
+	"\x11GetHistoryRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8e\x01\n" +
+	"\x12GetHistoryResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.tracker.HistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"w\n" +
+	"\x12GetTimelineRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x90\x01\n" +
+	"\x13GetTimelineResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.tracker.TimelineEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xeb\x01\n" +
+	"\rTimelineEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12/\n" +
+	"\ahistory\x18\x04 \x01(\v2\x15.tracker.HistoryEntryR\ahistory\x12!\n" +
+	"\x04note\x18\x05 \x01(\v2\r.tracker.NoteR\x04note\x120\n" +
+	"\tinterview\x18\x06 \x01(\v2\x12.tracker.InterviewR\tinterview\"\x98\x01\n" +
+	"\x19SearchApplicationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"l\n" +
+	"\x1aSearchApplicationsResponse\x12&\n" +
+	"\x04hits\x18\x01 \x03(\v2\x12.tracker.SearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x96\x01\n" +
+	"\tSearchHit\x12;\n" +
+	"\vapplication\x18\x01 \x01(\v2\x19.tracker.ApplicationProtoR\vapplication\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x128\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x18.tracker.SearchHighlightR\n" +
+	"highlights\"\x82\x01\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x17\n" +
+	"\anote_id\x18\x02 \x01(\tR\x06noteId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12,\n" +
+	"\amatches\x18\x04 \x03(\v2\x12.tracker.TextRangeR\amatches\"9\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\"\x91\x04\n" +
+	"\fHistoryEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x12\x12\n" +
+	"\x04undo\x18\x06 \x01(\bR\x04undo\x12)\n" +
+	"\x10rejection_reason\x18\a \x01(\tR\x0frejectionReason\x12'\n" +
+	"\x0frejection_stage\x18\b \x01(\tR\x0erejectionStage\x12\x16\n" +
+	"\x06reason\x18\x0e \x01(\tR\x06reason\x12!\n" +
+	"\finterview_id\x18\t \x01(\tR\vinterviewId\x12\x14\n" +
+	"\x05round\x18\n" +
+	" \x01(\x05R\x05round\x12\x18\n" +
+	"\aoutcome\x18\v \x01(\tR\aoutcome\x12\x1f\n" +
+	"\vmerged_from\x18\f \x01(\tR\n" +
+	"mergedFrom\x127\n" +
+	"\tremind_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12(\n" +
+	"\x10search_config_id\x18\x0f \x01(\tR\x0esearchConfigId\x12\x16\n" +
+	"\x06action\x18\x10 \x01(\tR\x06action\"\x90\x01\n" +
+	"\x18CreateApplicationRequest\x12\x1e\n" +
+	"\vjob_feed_id\x18\x01 \x01(\tR\tjobFeedId\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x12+\n" +
+	"\x11confirm_duplicate\x18\x03 \x01(\bR\x10confirmDuplicate\"\xf6\x01\n" +
+	"\x1eCreateManualApplicationRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12+\n" +
+	"\x11confirm_duplicate\x18\a \x01(\bR\x10confirmDuplicate\"\xa3\x01\n" +
+	"\x1dImportApplicationEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12+\n" +
+	"\x11confirm_duplicate\x18\x03 \x01(\bR\x10confirmDuplicate\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\xcf\x01\n" +
+	"\x1eImportApplicationEmailResponse\x12;\n" +
+	"\vapplication\x18\x01 \x01(\v2\x19.tracker.ApplicationProtoR\vapplication\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12\x1b\n" +
+	"\tjob_title\x18\x03 \x01(\tR\bjobTitle\x129\n" +
 	"\n" +
-	"new_status\x18\x02 \x01(\tR\tnewStatus\"K\n" +
+	"applied_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\"\xac\x01\n" +
+	"\n" +
+	"NewCardJob\x12\x1e\n" +
+	"\vjob_feed_id\x18\x01 \x01(\tR\tjobFeedId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acompany\x18\x03 \x01(\tR\acompany\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xbd\x01\n" +
+	"\x17CloneApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12%\n" +
+	"\x03job\x18\x02 \x01(\v2\x13.tracker.NewCardJobR\x03job\x12+\n" +
+	"\x11confirm_duplicate\x18\x03 \x01(\bR\x10confirmDuplicate\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\xc5\x02\n" +
+	"\x13ApplicationTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x12&\n" +
+	"\x0fnext_step_label\x18\x04 \x01(\tR\rnextStepLabel\x12:\n" +
+	"\rreminder_rule\x18\x05 \x01(\v2\x15.tracker.ReminderRuleR\freminderRule\x12\x14\n" +
+	"\x05notes\x18\x06 \x03(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"!\n" +
+	"\x1fListApplicationTemplatesRequest\"^\n" +
+	" ListApplicationTemplatesResponse\x12:\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1c.tracker.ApplicationTemplateR\ttemplates\"\\\n" +
+	" CreateApplicationTemplateRequest\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.tracker.ApplicationTemplateR\btemplate\"\\\n" +
+	" UpdateApplicationTemplateRequest\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.tracker.ApplicationTemplateR\btemplate\"C\n" +
+	" DeleteApplicationTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"#\n" +
+	"!DeleteApplicationTemplateResponse\"\xc4\x01\n" +
+	"$CreateApplicationFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12%\n" +
+	"\x03job\x18\x02 \x01(\v2\x13.tracker.NewCardJobR\x03job\x12+\n" +
+	"\x11confirm_duplicate\x18\x03 \x01(\bR\x10confirmDuplicate\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\xec\x01\n" +
+	"\x0fMoveCardRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\tR\tnewStatus\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12)\n" +
+	"\x10rejection_reason\x18\x04 \x01(\tR\x0frejectionReason\x12'\n" +
+	"\x0frejection_stage\x18\x05 \x01(\tR\x0erejectionStage\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"<\n" +
+	"\x13UndoLastMoveRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\">\n" +
+	"\x15UndoLastActionRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"\xc5\x01\n" +
+	"\x0fBulkMoveRequest\x12'\n" +
+	"\x0fapplication_ids\x18\x01 \x03(\tR\x0eapplicationIds\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\tR\tnewStatus\x12)\n" +
+	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\x12'\n" +
+	"\x0frejection_stage\x18\x04 \x01(\tR\x0erejectionStage\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"t\n" +
 	"\x0eAddNoteRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
-	"\x04note\x18\x02 \x01(\tR\x04note\"W\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"9\n" +
+	"\x10ListNotesRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\">\n" +
+	"\x0fEditNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\",\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\"\xa4\x01\n" +
 	"\x16RateApplicationRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
-	"\x06rating\x18\x02 \x01(\x05R\x06rating\"_\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x123\n" +
+	"\bcriteria\x18\x03 \x01(\v2\x17.tracker.RatingCriteriaR\bcriteria\x12\x16\n" +
+	"\x06review\x18\x04 \x01(\tR\x06review\"\x83\x01\n" +
+	"\x0eRatingCriteria\x12\x19\n" +
+	"\brole_fit\x18\x01 \x01(\x05R\aroleFit\x12\"\n" +
+	"\fcompensation\x18\x02 \x01(\x05R\fcompensation\x12\x18\n" +
+	"\aculture\x18\x03 \x01(\x05R\aculture\x12\x18\n" +
+	"\acommute\x18\x04 \x01(\x05R\acommute\"W\n" +
+	"\x12SetPriorityRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\tR\bpriority\"\x84\x01\n" +
+	"\x12SetNextStepRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x121\n" +
+	"\x06due_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"\xa9\x01\n" +
 	"\x19SetRelanceReminderRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x127\n" +
+	"\tremind_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12&\n" +
+	"\x0flocal_remind_at\x18\x04 \x01(\tR\rlocalRemindAtJ\x04\b\x02\x10\x03\"h\n" +
+	"\x15SnoozeReminderRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x14\n" +
+	"\x05hours\x18\x02 \x01(\x05R\x05hours\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"=\n" +
+	"\x14ClearReminderRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"j\n" +
+	"\x16SetReminderRuleRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12)\n" +
+	"\x04rule\x18\x02 \x01(\v2\x15.tracker.ReminderRuleR\x04rule\"d\n" +
+	"\fReminderRule\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"after_days\x18\x02 \x01(\x05R\tafterDays\x12\x1d\n" +
+	"\n" +
+	"every_days\x18\x03 \x01(\x05R\teveryDays\"\xbb\x01\n" +
+	"\x18UpdateApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12;\n" +
+	"\vapplication\x18\x02 \x01(\v2\x19.tracker.ApplicationProtoR\vapplication\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"B\n" +
+	"\x19ArchiveApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"B\n" +
+	"\x19RestoreApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"u\n" +
+	"\x18MergeApplicationsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x122\n" +
+	"\x15merged_application_id\x18\x02 \x01(\tR\x13mergedApplicationId\"\x14\n" +
+	"\x12ListColumnsRequest\"]\n" +
+	"\x13CreateColumnRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"\x82\x01\n" +
+	"\x13UpdateColumnRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x05H\x01R\bposition\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_position\"2\n" +
+	"\x13DeleteColumnRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\"Y\n" +
+	"\x13MoveToColumnRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
-	"\tremind_at\x18\x02 \x01(\tR\bremindAt\"Y\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\"D\n" +
+	"\x1bReanalyzeApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"G\n" +
+	"\x1eListCoverLetterVersionsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"E\n" +
+	"\x1cRegenerateCoverLetterRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"A\n" +
+	" RestoreCoverLetterVersionRequest\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\"X\n" +
+	"\x1bRequestFollowUpDraftRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04tone\x18\x02 \x01(\tR\x04tone\"\xb3\x01\n" +
+	"\x17CreateAttachmentRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\"?\n" +
+	"\x16ListAttachmentsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"F\n" +
+	"\x1fGetAttachmentDownloadUrlRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\">\n" +
+	"\x17DeleteAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"q\n" +
+	"\x16CreateInterviewRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x120\n" +
+	"\tinterview\x18\x02 \x01(\v2\x12.tracker.InterviewR\tinterview\"e\n" +
+	"\x16SetOfferDetailsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12$\n" +
+	"\x05offer\x18\x02 \x01(\v2\x0e.tracker.OfferR\x05offer\"\x16\n" +
+	"\x14CompareOffersRequest\"t\n" +
+	"\x1aAddNegotiationEntryRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12/\n" +
+	"\x05entry\x18\x02 \x01(\v2\x19.tracker.NegotiationEntryR\x05entry\"F\n" +
+	"\x1dListNegotiationEntriesRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\":\n" +
+	"\x1dDeleteNegotiationEntryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\">\n" +
+	"\x15ListInterviewsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"\xaa\x01\n" +
+	"\x16UpdateInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x120\n" +
+	"\tinterview\x18\x02 \x01(\v2\x12.tracker.InterviewR\tinterview\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xc0\x01\n" +
+	"\x1eRecordInterviewFeedbackRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x1b\n" +
+	"\twent_well\x18\x03 \x01(\tR\bwentWell\x12\x1b\n" +
+	"\tred_flags\x18\x04 \x01(\tR\bredFlags\x12'\n" +
+	"\x0fquestions_asked\x18\x05 \x03(\tR\x0equestionsAsked\";\n" +
+	"\x16DeleteInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\tR\vinterviewId\"i\n" +
+	"\x14CreateContactRequest\x12*\n" +
+	"\acontact\x18\x01 \x01(\v2\x10.tracker.ContactR\acontact\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\"V\n" +
+	"\x13ListContactsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\"\x9e\x01\n" +
+	"\x14UpdateContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\x12*\n" +
+	"\acontact\x18\x02 \x01(\v2\x10.tracker.ContactR\acontact\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"5\n" +
+	"\x14DeleteContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\"Z\n" +
+	"\x12LinkContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\"\\\n" +
+	"\x14UnlinkContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\"\x16\n" +
+	"\x14ListCompaniesRequest\"5\n" +
+	"\x19GetCompanyOverviewRequest\x12\x18\n" +
+	"\acompany\x18\x01 \x01(\tR\acompany\"\x1a\n" +
+	"\x18GetRejectionStatsRequest\"\x1d\n" +
+	"\x1bGetSearchConfigStatsRequest\"\"\n" +
+	" CountApplicationsByStatusRequest\"\x18\n" +
+	"\x16GetCalendarFeedRequest\" \n" +
+	"\x1eRotateCalendarFeedTokenRequest\"1\n" +
+	"\x19RenderCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\" \n" +
+	"\x1eStartGoogleCalendarAuthRequest\")\n" +
+	"\x15GoogleCalendarAuthUrl\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"M\n" +
+	"!CompleteGoogleCalendarAuthRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\" \n" +
+	"\x1eGetGoogleCalendarStatusRequest\"!\n" +
+	"\x1fDisconnectGoogleCalendarRequest\"\x9e\x02\n" +
+	"\x14GoogleCalendarStatus\x12\x1c\n" +
+	"\tconnected\x18\x01 \x01(\bR\tconnected\x12'\n" +
+	"\x0fneeds_reconnect\x18\x02 \x01(\bR\x0eneedsReconnect\x12\x1f\n" +
+	"\vcalendar_id\x18\x03 \x01(\tR\n" +
+	"calendarId\x12=\n" +
+	"\fconnected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vconnectedAt\x12@\n" +
+	"\x0elast_synced_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\"2\n" +
+	"\x13GetBenchmarkRequest\x12\x1b\n" +
+	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\"\x14\n" +
+	"\x12GetSettingsRequest\"\xb9\x04\n" +
+	"\x15UpdateSettingsRequest\x12.\n" +
+	"\x10ghosting_enabled\x18\x01 \x01(\bH\x00R\x0fghostingEnabled\x88\x01\x01\x12-\n" +
+	"\x10ghost_after_days\x18\x02 \x01(\x05H\x01R\x0eghostAfterDays\x88\x01\x01\x12D\n" +
+	"\x11extra_transitions\x18\x03 \x01(\v2\x17.tracker.TransitionListR\x10extraTransitions\x12.\n" +
+	"\x10share_benchmarks\x18\x04 \x01(\bH\x02R\x0fshareBenchmarks\x88\x01\x01\x12A\n" +
+	"\x11reminder_channels\x18\x05 \x01(\v2\x14.tracker.ChannelListR\x10reminderChannels\x12\x1f\n" +
+	"\btimezone\x18\x06 \x01(\tH\x03R\btimezone\x88\x01\x01\x12:\n" +
+	"\x17archive_search_on_hired\x18\a \x01(\bH\x04R\x14archiveSearchOnHired\x88\x01\x01\x12.\n" +
+	"\x10closed_retention\x18\b \x01(\tH\x05R\x0fclosedRetention\x88\x01\x01B\x13\n" +
+	"\x11_ghosting_enabledB\x13\n" +
+	"\x11_ghost_after_daysB\x13\n" +
+	"\x11_share_benchmarksB\v\n" +
+	"\t_timezoneB\x1a\n" +
+	"\x18_archive_search_on_hiredB\x13\n" +
+	"\x11_closed_retention\"t\n" +
+	"\x1eSetSearchConfigArchivalRequest\x12(\n" +
+	"\x10search_config_id\x18\x01 \x01(\tR\x0esearchConfigId\x12(\n" +
+	"\x10archive_on_hired\x18\x02 \x01(\bR\x0earchiveOnHired\"j\n" +
+	"\x14SearchConfigArchival\x12(\n" +
+	"\x10search_config_id\x18\x01 \x01(\tR\x0esearchConfigId\x12(\n" +
+	"\x10archive_on_hired\x18\x02 \x01(\bR\x0earchiveOnHired\"F\n" +
+	"\x1dReactivateSearchConfigRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"?\n" +
+	"\x13ReopenSearchRequest\x12(\n" +
+	"\x10search_config_id\x18\x01 \x01(\tR\x0esearchConfigId\"\x16\n" +
+	"\x14ReopenSearchResponse\"0\n" +
+	"\n" +
+	"Transition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\";\n" +
+	"\x0eTransitionList\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.tracker.TransitionR\x05items\"#\n" +
+	"\vChannelList\x12\x14\n" +
+	"\x05items\x18\x01 \x03(\tR\x05items\"Y\n" +
 	"\x18ListApplicationsResponse\x12=\n" +
-	"\fapplications\x18\x01 \x03(\v2\x19.tracker.ApplicationProtoR\fapplications\"\xf1\x03\n" +
+	"\fapplications\x18\x01 \x03(\v2\x19.tracker.ApplicationProtoR\fapplications\"f\n" +
+	"\x10BulkMoveResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.tracker.BulkMoveResultR\aresults\x12\x1f\n" +
+	"\vmoved_count\x18\x02 \x01(\x05R\n" +
+	"movedCount\"\xdc\x01\n" +
+	"\x0eBulkMoveResult\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12;\n" +
+	"\vapplication\x18\x05 \x01(\v2\x19.tracker.ApplicationProtoR\vapplication\x12!\n" +
+	"\ferror_reason\x18\x06 \x01(\tR\verrorReason\"E\n" +
+	"\x13ListColumnsResponse\x12.\n" +
+	"\acolumns\x18\x01 \x03(\v2\x14.tracker.BoardColumnR\acolumns\"\x16\n" +
+	"\x14DeleteColumnResponse\"\x1e\n" +
+	"\x1cReanalyzeApplicationResponse\"Z\n" +
+	"\x1fListCoverLetterVersionsResponse\x127\n" +
+	"\bversions\x18\x01 \x03(\v2\x1b.tracker.CoverLetterVersionR\bversions\"\x1f\n" +
+	"\x1dRegenerateCoverLetterResponse\"\x1e\n" +
+	"\x1cRequestFollowUpDraftResponse\"\xb4\x01\n" +
+	"\x12CoverLetterVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x7f\n" +
+	"\x18CreateAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.tracker.AttachmentR\n" +
+	"attachment\x12.\n" +
+	"\x06upload\x18\x02 \x01(\v2\x16.tracker.AttachmentUrlR\x06upload\"P\n" +
+	"\x17ListAttachmentsResponse\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.tracker.AttachmentR\vattachments\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"L\n" +
+	"\x16ListInterviewsResponse\x122\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x12.tracker.InterviewR\n" +
+	"interviews\"\x19\n" +
+	"\x17DeleteInterviewResponse\"I\n" +
+	"\x15CompareOffersResponse\x120\n" +
+	"\x06offers\x18\x01 \x03(\v2\x18.tracker.OfferComparisonR\x06offers\"U\n" +
+	"\x1eListNegotiationEntriesResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.tracker.NegotiationEntryR\aentries\" \n" +
+	"\x1eDeleteNegotiationEntryResponse\"D\n" +
+	"\x14ListContactsResponse\x12,\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x10.tracker.ContactR\bcontacts\"\x17\n" +
+	"\x15DeleteContactResponse\"N\n" +
+	"\x15ListCompaniesResponse\x125\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x17.tracker.CompanySummaryR\tcompanies\"\x97\x03\n" +
+	"\x0eCompanySummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x11application_count\x18\x02 \x01(\x05R\x10applicationCount\x12!\n" +
+	"\factive_count\x18\x03 \x01(\x05R\vactiveCount\x12%\n" +
+	"\x0erejected_count\x18\x04 \x01(\x05R\rrejectedCount\x12N\n" +
+	"\rstatus_counts\x18\x05 \x03(\v2).tracker.CompanySummary.StatusCountsEntryR\fstatusCounts\x12#\n" +
+	"\rcontact_count\x18\x06 \x01(\x05R\fcontactCount\x12D\n" +
+	"\x10last_activity_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\x1a?\n" +
+	"\x11StatusCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd6\x01\n" +
+	"\x0fCompanyOverview\x121\n" +
+	"\asummary\x18\x01 \x01(\v2\x17.tracker.CompanySummaryR\asummary\x12=\n" +
+	"\fapplications\x18\x02 \x03(\v2\x19.tracker.ApplicationProtoR\fapplications\x12,\n" +
+	"\bcontacts\x18\x03 \x03(\v2\x10.tracker.ContactR\bcontacts\x12#\n" +
+	"\x05notes\x18\x04 \x03(\v2\r.tracker.NoteR\x05notes\"\xfb\x02\n" +
+	"\aContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12!\n" +
+	"\flinkedin_url\x18\x05 \x01(\tR\vlinkedinUrl\x12\x18\n" +
+	"\acompany\x18\x06 \x01(\tR\acompany\x12F\n" +
+	"\x11last_contacted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastContactedAt\x12'\n" +
+	"\x0fapplication_ids\x18\b \x03(\tR\x0eapplicationIds\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xab\x03\n" +
+	"\tInterview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\x05R\x05round\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12 \n" +
+	"\vinterviewer\x18\x06 \x01(\tR\vinterviewer\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\bfeedback\x18\v \x01(\v2\x1a.tracker.InterviewFeedbackR\bfeedback\"\xee\x02\n" +
+	"\x05Offer\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vbase_salary\x18\x03 \x01(\x03R\n" +
+	"baseSalary\x12\x14\n" +
+	"\x05bonus\x18\x04 \x01(\x03R\x05bonus\x12!\n" +
+	"\fequity_value\x18\x05 \x01(\x03R\vequityValue\x12%\n" +
+	"\x0eequity_details\x18\x06 \x01(\tR\requityDetails\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tR\tstartDate\x12G\n" +
+	"\x11response_deadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x10responseDeadline\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbf\x02\n" +
+	"\x0fOfferComparison\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tjob_title\x18\x02 \x01(\tR\bjobTitle\x12\x18\n" +
+	"\acompany\x18\x03 \x01(\tR\acompany\x12$\n" +
+	"\x05offer\x18\x04 \x01(\v2\x0e.tracker.OfferR\x05offer\x12-\n" +
+	"\x12total_compensation\x18\x05 \x01(\x03R\x11totalCompensation\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12(\n" +
+	"\x10relative_to_best\x18\a \x01(\x01R\x0erelativeToBest\x12;\n" +
+	"\vnegotiation\x18\b \x03(\v2\x19.tracker.NegotiationEntryR\vnegotiation\"\xc3\x02\n" +
+	"\x10NegotiationEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vbase_salary\x18\x04 \x01(\x03R\n" +
+	"baseSalary\x12\x14\n" +
+	"\x05bonus\x18\x05 \x01(\x03R\x05bonus\x12!\n" +
+	"\fequity_value\x18\x06 \x01(\x03R\vequityValue\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb3\x01\n" +
+	"\x11InterviewFeedback\x12\x1b\n" +
+	"\twent_well\x18\x01 \x01(\tR\bwentWell\x12\x1b\n" +
+	"\tred_flags\x18\x02 \x01(\tR\bredFlags\x12'\n" +
+	"\x0fquestions_asked\x18\x03 \x03(\tR\x0equestionsAsked\x12;\n" +
+	"\vrecorded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"\xf4\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vscan_status\x18\b \x01(\tR\n" +
+	"scanStatus\x12%\n" +
+	"\x0escan_signature\x18\t \x01(\tR\rscanSignature\x129\n" +
+	"\n" +
+	"scanned_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"\\\n" +
+	"\rAttachmentUrl\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"8\n" +
+	"\x11ListNotesResponse\x12#\n" +
+	"\x05notes\x18\x01 \x03(\v2\r.tracker.NoteR\x05notes\"\x14\n" +
+	"\x12DeleteNoteResponse\"\xc5\x01\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xdb\x01\n" +
+	"\vBoardColumn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"S\n" +
+	"\rRejectionStat\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\tR\x05stage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"I\n" +
+	"\x19GetRejectionStatsResponse\x12,\n" +
+	"\x05stats\x18\x01 \x03(\v2\x16.tracker.RejectionStatR\x05stats\"\xe8\x02\n" +
+	"\x11SearchConfigStats\x12(\n" +
+	"\x10search_config_id\x18\x01 \x01(\tR\x0esearchConfigId\x12\x1d\n" +
+	"\n" +
+	"job_titles\x18\x02 \x03(\tR\tjobTitles\x12\x1c\n" +
+	"\tlocations\x18\x03 \x03(\tR\tlocations\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"discovered\x18\x06 \x01(\x05R\n" +
+	"discovered\x12\"\n" +
+	"\fapplications\x18\a \x01(\x05R\fapplications\x12\x18\n" +
+	"\aapplied\x18\b \x01(\x05R\aapplied\x12 \n" +
+	"\vinterviewed\x18\t \x01(\x05R\vinterviewed\x12\x14\n" +
+	"\x05hired\x18\n" +
+	" \x01(\x05R\x05hired\"T\n" +
+	"\x1cGetSearchConfigStatsResponse\x124\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1a.tracker.SearchConfigStatsR\aconfigs\"\xc4\x01\n" +
+	"!CountApplicationsByStatusResponse\x12N\n" +
+	"\x06counts\x18\x01 \x03(\v26.tracker.CountApplicationsByStatusResponse.CountsEntryR\x06counts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"s\n" +
+	"\fCalendarFeed\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\x13CalendarFeedContent\x12\x10\n" +
+	"\x03ics\x18\x01 \x01(\tR\x03ics\"\xd4\x02\n" +
+	"\tBenchmark\x12\x1b\n" +
+	"\tjob_title\x18\x01 \x01(\tR\bjobTitle\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x02 \x01(\x05R\tuserCount\x12/\n" +
+	"\x13median_applications\x18\x03 \x01(\x01R\x12medianApplications\x122\n" +
+	"\x15median_interview_rate\x18\x04 \x01(\x01R\x13medianInterviewRate\x12;\n" +
+	"\vcomputed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\x12\"\n" +
+	"\fapplications\x18\x06 \x01(\x05R\fapplications\x12\x1e\n" +
+	"\n" +
+	"interviews\x18\a \x01(\x05R\n" +
+	"interviews\x12%\n" +
+	"\x0einterview_rate\x18\b \x01(\x01R\rinterviewRate\"\xfe\x02\n" +
+	"\x0fTrackerSettings\x12)\n" +
+	"\x10ghosting_enabled\x18\x01 \x01(\bR\x0fghostingEnabled\x12(\n" +
+	"\x10ghost_after_days\x18\x02 \x01(\x05R\x0eghostAfterDays\x12@\n" +
+	"\x11extra_transitions\x18\x03 \x03(\v2\x13.tracker.TransitionR\x10extraTransitions\x12)\n" +
+	"\x10share_benchmarks\x18\x04 \x01(\bR\x0fshareBenchmarks\x12+\n" +
+	"\x11reminder_channels\x18\x05 \x03(\tR\x10reminderChannels\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x125\n" +
+	"\x17archive_search_on_hired\x18\a \x01(\bR\x14archiveSearchOnHired\x12)\n" +
+	"\x10closed_retention\x18\b \x01(\tR\x0fclosedRetention\"\x85\v\n" +
 	"\x10ApplicationProto\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0ecurrent_status\x18\x02 \x01(\tR\rcurrentStatus\x12\x1f\n" +
@@ -596,16 +11498,281 @@ const file_tracker_proto_rawDesc = "" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1e\n" +
 	"\vjob_feed_id\x18\n" +
 	" \x01(\tR\tjobFeedId\x12(\n" +
-	"\x10search_config_id\x18\v \x01(\tR\x0esearchConfigId\x12.\n" +
-	"\x13relance_reminder_at\x18\f \x01(\tR\x11relanceReminderAt2\xad\x04\n" +
-	"\x0eTrackerService\x12W\n" +
-	"\x10ListApplications\x12 .tracker.ListApplicationsRequest\x1a!.tracker.ListApplicationsResponse\x12K\n" +
-	"\x0eGetApplication\x12\x1e.tracker.GetApplicationRequest\x1a\x19.tracker.ApplicationProto\x12Q\n" +
-	"\x11CreateApplication\x12!.tracker.CreateApplicationRequest\x1a\x19.tracker.ApplicationProto\x12?\n" +
-	"\bMoveCard\x12\x18.tracker.MoveCardRequest\x1a\x19.tracker.ApplicationProto\x12=\n" +
-	"\aAddNote\x12\x17.tracker.AddNoteRequest\x1a\x19.tracker.ApplicationProto\x12M\n" +
-	"\x0fRateApplication\x12\x1f.tracker.RateApplicationRequest\x1a\x19.tracker.ApplicationProto\x12S\n" +
-	"\x12SetRelanceReminder\x12\".tracker.SetRelanceReminderRequest\x1a\x19.tracker.ApplicationProtoB(Z&jobmate/tracker-service/internal/pb;pbb\x06proto3"
+	"\x10search_config_id\x18\v \x01(\tR\x0esearchConfigId\x12;\n" +
+	"\varchived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12 \n" +
+	"\fon_hold_from\x18\x0e \x01(\tR\n" +
+	"onHoldFrom\x129\n" +
+	"\n" +
+	"ghosted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tghostedAt\x12\x1b\n" +
+	"\tcolumn_id\x18\x10 \x01(\tR\bcolumnId\x12\x1a\n" +
+	"\bpriority\x18\x11 \x01(\tR\bpriority\x12C\n" +
+	"\x10next_step_due_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\rnextStepDueAt\x12&\n" +
+	"\x0fnext_step_label\x18\x13 \x01(\tR\rnextStepLabel\x12\x1b\n" +
+	"\tjob_title\x18\x14 \x01(\tR\bjobTitle\x12\x18\n" +
+	"\acompany\x18\x15 \x01(\tR\acompany\x12\x1a\n" +
+	"\blocation\x18\x16 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x17 \x01(\tR\tsourceUrl\x122\n" +
+	"\n" +
+	"interviews\x18\x18 \x03(\v2\x12.tracker.InterviewR\n" +
+	"interviews\x12)\n" +
+	"\x10rejection_reason\x18\x19 \x01(\tR\x0frejectionReason\x12'\n" +
+	"\x0frejection_stage\x18\x1a \x01(\tR\x0erejectionStage\x12$\n" +
+	"\x05offer\x18\x1b \x01(\v2\x0e.tracker.OfferR\x05offer\x12:\n" +
+	"\rreminder_rule\x18\x1c \x01(\v2\x15.tracker.ReminderRuleR\freminderRule\x12J\n" +
+	"\x13relance_reminder_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\x11relanceReminderAt\x12%\n" +
+	"\x0efollowup_draft\x18\x1e \x01(\tR\rfollowupDraft\x12F\n" +
+	"\x11followup_draft_at\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\x0ffollowupDraftAt\x12@\n" +
+	"\x0frating_criteria\x18  \x01(\v2\x17.tracker.RatingCriteriaR\x0eratingCriteria\x12#\n" +
+	"\rrating_review\x18! \x01(\tR\fratingReviewJ\x04\b\f\x10\r\"\x89\x02\n" +
+	"\x13ListAuditLogRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"m\n" +
+	"\x14ListAuditLogResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.tracker.AuditEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xde\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\a \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"old_values\x18\b \x01(\fR\toldValues\x12\x1d\n" +
+	"\n" +
+	"new_values\x18\t \x01(\fR\tnewValues\x12\x18\n" +
+	"\arequest\x18\n" +
+	" \x01(\fR\arequest\x12\x18\n" +
+	"\aoutcome\x18\v \x01(\tR\aoutcome\"\x17\n" +
+	"\x15ExportUserDataRequest\"$\n" +
+	"\x0eUserDataExport\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"*\n" +
+	"\x14EraseUserDataRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"\x8e\x01\n" +
+	"\x15EraseUserDataResponse\x12<\n" +
+	"\x04rows\x18\x01 \x03(\v2(.tracker.EraseUserDataResponse.RowsEntryR\x04rows\x1a7\n" +
+	"\tRowsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x98\x01\n" +
+	"\x17CreateBoardShareRequest\x12&\n" +
+	"\x0fgrantee_user_id\x18\x01 \x01(\tR\rgranteeUserId\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x87\x02\n" +
+	"\n" +
+	"BoardShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12&\n" +
+	"\x0fgrantee_user_id\x18\x03 \x01(\tR\rgranteeUserId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1a\n" +
+	"\bstatuses\x18\x05 \x03(\tR\bstatuses\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x16ListBoardSharesRequest\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\bR\breceived\"F\n" +
+	"\x17ListBoardSharesResponse\x12+\n" +
+	"\x06shares\x18\x01 \x03(\v2\x13.tracker.BoardShareR\x06shares\"4\n" +
+	"\x17RevokeBoardShareRequest\x12\x19\n" +
+	"\bshare_id\x18\x01 \x01(\tR\ashareId\"\x1a\n" +
+	"\x18RevokeBoardShareResponse\"\xd6\x01\n" +
+	"\x1dListSharedApplicationsRequest\x12\x19\n" +
+	"\bshare_id\x18\x01 \x01(\tR\ashareId\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12#\n" +
+	"\rstatus_filter\x18\x03 \x01(\tR\fstatusFilter\x12'\n" +
+	"\x0fpriority_filter\x18\x04 \x01(\tR\x0epriorityFilter\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04view\x18\x06 \x01(\tR\x04view\"\x80\x01\n" +
+	"\x1bGetSharedApplicationRequest\x12\x19\n" +
+	"\bshare_id\x18\x01 \x01(\tR\ashareId\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12%\n" +
+	"\x0eapplication_id\x18\x03 \x01(\tR\rapplicationId\"@\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\"\xae\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x15\n" +
+	"\x13ListWebhooksRequest\"D\n" +
+	"\x14ListWebhooksResponse\x12,\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x10.tracker.WebhookR\bwebhooks\"5\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"\x17\n" +
+	"\x15DeleteWebhookResponse\"U\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xfb\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12(\n" +
+	"\x10last_status_code\x18\x06 \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"Y\n" +
+	"\x1dListWebhookDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.tracker.WebhookDeliveryR\n" +
+	"deliveries\">\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"\x1e\n" +
+	"\x1cRetryWebhookDeliveryResponse\"\x1a\n" +
+	"\x18TakeBoardSnapshotRequest\"l\n" +
+	"\rBoardSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\btaken_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\atakenAt\x12\x14\n" +
+	"\x05cards\x18\x03 \x01(\x05R\x05cards\"}\n" +
+	"\x17CompareSnapshotsRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\xa0\x01\n" +
+	"\fSnapshotCard\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\x12\x18\n" +
+	"\acompany\x18\x04 \x01(\tR\acompany\x12\x1b\n" +
+	"\tjob_title\x18\x05 \x01(\tR\bjobTitle\"Z\n" +
+	"\fSnapshotMove\x12)\n" +
+	"\x04card\x18\x01 \x01(\v2\x15.tracker.SnapshotCardR\x04card\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\tR\n" +
+	"fromStatus\"\x87\x03\n" +
+	"\x18CompareSnapshotsResponse\x12>\n" +
+	"\rfrom_taken_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vfromTakenAt\x12:\n" +
+	"\vto_taken_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttoTakenAt\x12+\n" +
+	"\x05added\x18\x03 \x03(\v2\x15.tracker.SnapshotCardR\x05added\x12/\n" +
+	"\aremoved\x18\x04 \x03(\v2\x15.tracker.SnapshotCardR\aremoved\x12+\n" +
+	"\x05moved\x18\x05 \x03(\v2\x15.tracker.SnapshotMoveR\x05moved\x121\n" +
+	"\barchived\x18\x06 \x03(\v2\x15.tracker.SnapshotCardR\barchived\x121\n" +
+	"\brestored\x18\a \x03(\v2\x15.tracker.SnapshotCardR\brestored2\xb9B\n" +
+	"\x0eTrackerService\x12\\\n" +
+	"\x10ListApplications\x12 .tracker.ListApplicationsRequest\x1a!.tracker.ListApplicationsResponse\"\x03\x90\x02\x01\x12P\n" +
+	"\x0eGetApplication\x12\x1e.tracker.GetApplicationRequest\x1a\x19.tracker.ApplicationProto\"\x03\x90\x02\x01\x12h\n" +
+	"\x14BatchGetApplications\x12$.tracker.BatchGetApplicationsRequest\x1a%.tracker.BatchGetApplicationsResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x19GetApplicationByJobFeedId\x12).tracker.GetApplicationByJobFeedIdRequest\x1a*.tracker.GetApplicationByJobFeedIdResponse\"\x03\x90\x02\x01\x12J\n" +
+	"\n" +
+	"GetHistory\x12\x1a.tracker.GetHistoryRequest\x1a\x1b.tracker.GetHistoryResponse\"\x03\x90\x02\x01\x12M\n" +
+	"\vGetTimeline\x12\x1b.tracker.GetTimelineRequest\x1a\x1c.tracker.GetTimelineResponse\"\x03\x90\x02\x01\x12b\n" +
+	"\x12SearchApplications\x12\".tracker.SearchApplicationsRequest\x1a#.tracker.SearchApplicationsResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\x11CreateApplication\x12!.tracker.CreateApplicationRequest\x1a\x19.tracker.ApplicationProto\x12]\n" +
+	"\x17CreateManualApplication\x12'.tracker.CreateManualApplicationRequest\x1a\x19.tracker.ApplicationProto\x12i\n" +
+	"\x16ImportApplicationEmail\x12&.tracker.ImportApplicationEmailRequest\x1a'.tracker.ImportApplicationEmailResponse\x12O\n" +
+	"\x10CloneApplication\x12 .tracker.CloneApplicationRequest\x1a\x19.tracker.ApplicationProto\x12t\n" +
+	"\x18ListApplicationTemplates\x12(.tracker.ListApplicationTemplatesRequest\x1a).tracker.ListApplicationTemplatesResponse\"\x03\x90\x02\x01\x12d\n" +
+	"\x19CreateApplicationTemplate\x12).tracker.CreateApplicationTemplateRequest\x1a\x1c.tracker.ApplicationTemplate\x12d\n" +
+	"\x19UpdateApplicationTemplate\x12).tracker.UpdateApplicationTemplateRequest\x1a\x1c.tracker.ApplicationTemplate\x12r\n" +
+	"\x19DeleteApplicationTemplate\x12).tracker.DeleteApplicationTemplateRequest\x1a*.tracker.DeleteApplicationTemplateResponse\x12i\n" +
+	"\x1dCreateApplicationFromTemplate\x12-.tracker.CreateApplicationFromTemplateRequest\x1a\x19.tracker.ApplicationProto\x12?\n" +
+	"\bMoveCard\x12\x18.tracker.MoveCardRequest\x1a\x19.tracker.ApplicationProto\x12G\n" +
+	"\fUndoLastMove\x12\x1c.tracker.UndoLastMoveRequest\x1a\x19.tracker.ApplicationProto\x12K\n" +
+	"\x0eUndoLastAction\x12\x1e.tracker.UndoLastActionRequest\x1a\x19.tracker.ApplicationProto\x12?\n" +
+	"\bBulkMove\x12\x18.tracker.BulkMoveRequest\x1a\x19.tracker.BulkMoveResponse\x12=\n" +
+	"\aAddNote\x12\x17.tracker.AddNoteRequest\x1a\x19.tracker.ApplicationProto\x12G\n" +
+	"\tListNotes\x12\x19.tracker.ListNotesRequest\x1a\x1a.tracker.ListNotesResponse\"\x03\x90\x02\x01\x123\n" +
+	"\bEditNote\x12\x18.tracker.EditNoteRequest\x1a\r.tracker.Note\x12E\n" +
+	"\n" +
+	"DeleteNote\x12\x1a.tracker.DeleteNoteRequest\x1a\x1b.tracker.DeleteNoteResponse\x12M\n" +
+	"\x0fRateApplication\x12\x1f.tracker.RateApplicationRequest\x1a\x19.tracker.ApplicationProto\x12E\n" +
+	"\vSetPriority\x12\x1b.tracker.SetPriorityRequest\x1a\x19.tracker.ApplicationProto\x12E\n" +
+	"\vSetNextStep\x12\x1b.tracker.SetNextStepRequest\x1a\x19.tracker.ApplicationProto\x12S\n" +
+	"\x12SetRelanceReminder\x12\".tracker.SetRelanceReminderRequest\x1a\x19.tracker.ApplicationProto\x12K\n" +
+	"\x0eSnoozeReminder\x12\x1e.tracker.SnoozeReminderRequest\x1a\x19.tracker.ApplicationProto\x12I\n" +
+	"\rClearReminder\x12\x1d.tracker.ClearReminderRequest\x1a\x19.tracker.ApplicationProto\x12M\n" +
+	"\x0fSetReminderRule\x12\x1f.tracker.SetReminderRuleRequest\x1a\x19.tracker.ApplicationProto\x12Q\n" +
+	"\x11UpdateApplication\x12!.tracker.UpdateApplicationRequest\x1a\x19.tracker.ApplicationProto\x12S\n" +
+	"\x12ArchiveApplication\x12\".tracker.ArchiveApplicationRequest\x1a\x19.tracker.ApplicationProto\x12S\n" +
+	"\x12RestoreApplication\x12\".tracker.RestoreApplicationRequest\x1a\x19.tracker.ApplicationProto\x12Q\n" +
+	"\x11MergeApplications\x12!.tracker.MergeApplicationsRequest\x1a\x19.tracker.ApplicationProto\x12M\n" +
+	"\vListColumns\x12\x1b.tracker.ListColumnsRequest\x1a\x1c.tracker.ListColumnsResponse\"\x03\x90\x02\x01\x12B\n" +
+	"\fCreateColumn\x12\x1c.tracker.CreateColumnRequest\x1a\x14.tracker.BoardColumn\x12B\n" +
+	"\fUpdateColumn\x12\x1c.tracker.UpdateColumnRequest\x1a\x14.tracker.BoardColumn\x12K\n" +
+	"\fDeleteColumn\x12\x1c.tracker.DeleteColumnRequest\x1a\x1d.tracker.DeleteColumnResponse\x12G\n" +
+	"\fMoveToColumn\x12\x1c.tracker.MoveToColumnRequest\x1a\x19.tracker.ApplicationProto\x12c\n" +
+	"\x14ReanalyzeApplication\x12$.tracker.ReanalyzeApplicationRequest\x1a%.tracker.ReanalyzeApplicationResponse\x12q\n" +
+	"\x17ListCoverLetterVersions\x12'.tracker.ListCoverLetterVersionsRequest\x1a(.tracker.ListCoverLetterVersionsResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x15RegenerateCoverLetter\x12%.tracker.RegenerateCoverLetterRequest\x1a&.tracker.RegenerateCoverLetterResponse\x12a\n" +
+	"\x19RestoreCoverLetterVersion\x12).tracker.RestoreCoverLetterVersionRequest\x1a\x19.tracker.ApplicationProto\x12c\n" +
+	"\x14RequestFollowUpDraft\x12$.tracker.RequestFollowUpDraftRequest\x1a%.tracker.RequestFollowUpDraftResponse\x12W\n" +
+	"\x10CreateAttachment\x12 .tracker.CreateAttachmentRequest\x1a!.tracker.CreateAttachmentResponse\x12Y\n" +
+	"\x0fListAttachments\x12\x1f.tracker.ListAttachmentsRequest\x1a .tracker.ListAttachmentsResponse\"\x03\x90\x02\x01\x12a\n" +
+	"\x18GetAttachmentDownloadUrl\x12(.tracker.GetAttachmentDownloadUrlRequest\x1a\x16.tracker.AttachmentUrl\"\x03\x90\x02\x01\x12W\n" +
+	"\x10DeleteAttachment\x12 .tracker.DeleteAttachmentRequest\x1a!.tracker.DeleteAttachmentResponse\x12F\n" +
+	"\x0fCreateInterview\x12\x1f.tracker.CreateInterviewRequest\x1a\x12.tracker.Interview\x12V\n" +
+	"\x0eListInterviews\x12\x1e.tracker.ListInterviewsRequest\x1a\x1f.tracker.ListInterviewsResponse\"\x03\x90\x02\x01\x12F\n" +
+	"\x0fUpdateInterview\x12\x1f.tracker.UpdateInterviewRequest\x1a\x12.tracker.Interview\x12V\n" +
+	"\x17RecordInterviewFeedback\x12'.tracker.RecordInterviewFeedbackRequest\x1a\x12.tracker.Interview\x12T\n" +
+	"\x0fDeleteInterview\x12\x1f.tracker.DeleteInterviewRequest\x1a .tracker.DeleteInterviewResponse\x12B\n" +
+	"\x0fSetOfferDetails\x12\x1f.tracker.SetOfferDetailsRequest\x1a\x0e.tracker.Offer\x12S\n" +
+	"\rCompareOffers\x12\x1d.tracker.CompareOffersRequest\x1a\x1e.tracker.CompareOffersResponse\"\x03\x90\x02\x01\x12U\n" +
+	"\x13AddNegotiationEntry\x12#.tracker.AddNegotiationEntryRequest\x1a\x19.tracker.NegotiationEntry\x12n\n" +
+	"\x16ListNegotiationEntries\x12&.tracker.ListNegotiationEntriesRequest\x1a'.tracker.ListNegotiationEntriesResponse\"\x03\x90\x02\x01\x12i\n" +
+	"\x16DeleteNegotiationEntry\x12&.tracker.DeleteNegotiationEntryRequest\x1a'.tracker.DeleteNegotiationEntryResponse\x12@\n" +
+	"\rCreateContact\x12\x1d.tracker.CreateContactRequest\x1a\x10.tracker.Contact\x12P\n" +
+	"\fListContacts\x12\x1c.tracker.ListContactsRequest\x1a\x1d.tracker.ListContactsResponse\"\x03\x90\x02\x01\x12@\n" +
+	"\rUpdateContact\x12\x1d.tracker.UpdateContactRequest\x1a\x10.tracker.Contact\x12N\n" +
+	"\rDeleteContact\x12\x1d.tracker.DeleteContactRequest\x1a\x1e.tracker.DeleteContactResponse\x12<\n" +
+	"\vLinkContact\x12\x1b.tracker.LinkContactRequest\x1a\x10.tracker.Contact\x12@\n" +
+	"\rUnlinkContact\x12\x1d.tracker.UnlinkContactRequest\x1a\x10.tracker.Contact\x12S\n" +
+	"\rListCompanies\x12\x1d.tracker.ListCompaniesRequest\x1a\x1e.tracker.ListCompaniesResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x12GetCompanyOverview\x12\".tracker.GetCompanyOverviewRequest\x1a\x18.tracker.CompanyOverview\"\x03\x90\x02\x01\x12_\n" +
+	"\x11GetRejectionStats\x12!.tracker.GetRejectionStatsRequest\x1a\".tracker.GetRejectionStatsResponse\"\x03\x90\x02\x01\x12h\n" +
+	"\x14GetSearchConfigStats\x12$.tracker.GetSearchConfigStatsRequest\x1a%.tracker.GetSearchConfigStatsResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x19CountApplicationsByStatus\x12).tracker.CountApplicationsByStatusRequest\x1a*.tracker.CountApplicationsByStatusResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\x11TakeBoardSnapshot\x12!.tracker.TakeBoardSnapshotRequest\x1a\x16.tracker.BoardSnapshot\x12\\\n" +
+	"\x10CompareSnapshots\x12 .tracker.CompareSnapshotsRequest\x1a!.tracker.CompareSnapshotsResponse\"\x03\x90\x02\x01\x12I\n" +
+	"\x0fGetCalendarFeed\x12\x1f.tracker.GetCalendarFeedRequest\x1a\x15.tracker.CalendarFeed\x12Y\n" +
+	"\x17RotateCalendarFeedToken\x12'.tracker.RotateCalendarFeedTokenRequest\x1a\x15.tracker.CalendarFeed\x12[\n" +
+	"\x12RenderCalendarFeed\x12\".tracker.RenderCalendarFeedRequest\x1a\x1c.tracker.CalendarFeedContent\"\x03\x90\x02\x01\x12b\n" +
+	"\x17StartGoogleCalendarAuth\x12'.tracker.StartGoogleCalendarAuthRequest\x1a\x1e.tracker.GoogleCalendarAuthUrl\x12g\n" +
+	"\x1aCompleteGoogleCalendarAuth\x12*.tracker.CompleteGoogleCalendarAuthRequest\x1a\x1d.tracker.GoogleCalendarStatus\x12f\n" +
+	"\x17GetGoogleCalendarStatus\x12'.tracker.GetGoogleCalendarStatusRequest\x1a\x1d.tracker.GoogleCalendarStatus\"\x03\x90\x02\x01\x12c\n" +
+	"\x18DisconnectGoogleCalendar\x12(.tracker.DisconnectGoogleCalendarRequest\x1a\x1d.tracker.GoogleCalendarStatus\x12E\n" +
+	"\fGetBenchmark\x12\x1c.tracker.GetBenchmarkRequest\x1a\x12.tracker.Benchmark\"\x03\x90\x02\x01\x12I\n" +
+	"\vGetSettings\x12\x1b.tracker.GetSettingsRequest\x1a\x18.tracker.TrackerSettings\"\x03\x90\x02\x01\x12J\n" +
+	"\x0eUpdateSettings\x12\x1e.tracker.UpdateSettingsRequest\x1a\x18.tracker.TrackerSettings\x12a\n" +
+	"\x17SetSearchConfigArchival\x12'.tracker.SetSearchConfigArchivalRequest\x1a\x1d.tracker.SearchConfigArchival\x12[\n" +
+	"\x16ReactivateSearchConfig\x12&.tracker.ReactivateSearchConfigRequest\x1a\x19.tracker.ApplicationProto\x12K\n" +
+	"\fReopenSearch\x12\x1c.tracker.ReopenSearchRequest\x1a\x1d.tracker.ReopenSearchResponse\x12P\n" +
+	"\fListAuditLog\x12\x1c.tracker.ListAuditLogRequest\x1a\x1d.tracker.ListAuditLogResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\x0eExportUserData\x12\x1e.tracker.ExportUserDataRequest\x1a\x17.tracker.UserDataExport\"\x03\x90\x02\x01\x12N\n" +
+	"\rEraseUserData\x12\x1d.tracker.EraseUserDataRequest\x1a\x1e.tracker.EraseUserDataResponse\x12I\n" +
+	"\x10CreateBoardShare\x12 .tracker.CreateBoardShareRequest\x1a\x13.tracker.BoardShare\x12Y\n" +
+	"\x0fListBoardShares\x12\x1f.tracker.ListBoardSharesRequest\x1a .tracker.ListBoardSharesResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\x10RevokeBoardShare\x12 .tracker.RevokeBoardShareRequest\x1a!.tracker.RevokeBoardShareResponse\x12h\n" +
+	"\x16ListSharedApplications\x12&.tracker.ListSharedApplicationsRequest\x1a!.tracker.ListApplicationsResponse\"\x03\x90\x02\x01\x12\\\n" +
+	"\x14GetSharedApplication\x12$.tracker.GetSharedApplicationRequest\x1a\x19.tracker.ApplicationProto\"\x03\x90\x02\x01\x12@\n" +
+	"\rCreateWebhook\x12\x1d.tracker.CreateWebhookRequest\x1a\x10.tracker.Webhook\x12P\n" +
+	"\fListWebhooks\x12\x1c.tracker.ListWebhooksRequest\x1a\x1d.tracker.ListWebhooksResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\rDeleteWebhook\x12\x1d.tracker.DeleteWebhookRequest\x1a\x1e.tracker.DeleteWebhookResponse\x12k\n" +
+	"\x15ListWebhookDeliveries\x12%.tracker.ListWebhookDeliveriesRequest\x1a&.tracker.ListWebhookDeliveriesResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x14RetryWebhookDelivery\x12$.tracker.RetryWebhookDeliveryRequest\x1a%.tracker.RetryWebhookDeliveryResponseB(Z&jobmate/tracker-service/internal/pb;pbb\x06proto3"
 
 var (
 	file_tracker_proto_rawDescOnce sync.Once
@@ -619,42 +11786,529 @@ func file_tracker_proto_rawDescGZIP() []byte {
 	return file_tracker_proto_rawDescData
 }
 
-var file_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 187)
 var file_tracker_proto_goTypes = []any{
-	(*ListApplicationsRequest)(nil),   // 0: tracker.ListApplicationsRequest
-	(*GetApplicationRequest)(nil),     // 1: tracker.GetApplicationRequest
-	(*CreateApplicationRequest)(nil),  // 2: tracker.CreateApplicationRequest
-	(*MoveCardRequest)(nil),           // 3: tracker.MoveCardRequest
-	(*AddNoteRequest)(nil),            // 4: tracker.AddNoteRequest
-	(*RateApplicationRequest)(nil),    // 5: tracker.RateApplicationRequest
-	(*SetRelanceReminderRequest)(nil), // 6: tracker.SetRelanceReminderRequest
-	(*ListApplicationsResponse)(nil),  // 7: tracker.ListApplicationsResponse
-	(*ApplicationProto)(nil),          // 8: tracker.ApplicationProto
-	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*ListApplicationsRequest)(nil),              // 0: tracker.ListApplicationsRequest
+	(*GetApplicationRequest)(nil),                // 1: tracker.GetApplicationRequest
+	(*BatchGetApplicationsRequest)(nil),          // 2: tracker.BatchGetApplicationsRequest
+	(*BatchGetApplicationsResponse)(nil),         // 3: tracker.BatchGetApplicationsResponse
+	(*GetApplicationByJobFeedIdRequest)(nil),     // 4: tracker.GetApplicationByJobFeedIdRequest
+	(*GetApplicationByJobFeedIdResponse)(nil),    // 5: tracker.GetApplicationByJobFeedIdResponse
+	(*GetHistoryRequest)(nil),                    // 6: tracker.GetHistoryRequest
+	(*GetHistoryResponse)(nil),                   // 7: tracker.GetHistoryResponse
+	(*GetTimelineRequest)(nil),                   // 8: tracker.GetTimelineRequest
+	(*GetTimelineResponse)(nil),                  // 9: tracker.GetTimelineResponse
+	(*TimelineEntry)(nil),                        // 10: tracker.TimelineEntry
+	(*SearchApplicationsRequest)(nil),            // 11: tracker.SearchApplicationsRequest
+	(*SearchApplicationsResponse)(nil),           // 12: tracker.SearchApplicationsResponse
+	(*SearchHit)(nil),                            // 13: tracker.SearchHit
+	(*SearchHighlight)(nil),                      // 14: tracker.SearchHighlight
+	(*TextRange)(nil),                            // 15: tracker.TextRange
+	(*HistoryEntry)(nil),                         // 16: tracker.HistoryEntry
+	(*CreateApplicationRequest)(nil),             // 17: tracker.CreateApplicationRequest
+	(*CreateManualApplicationRequest)(nil),       // 18: tracker.CreateManualApplicationRequest
+	(*ImportApplicationEmailRequest)(nil),        // 19: tracker.ImportApplicationEmailRequest
+	(*ImportApplicationEmailResponse)(nil),       // 20: tracker.ImportApplicationEmailResponse
+	(*NewCardJob)(nil),                           // 21: tracker.NewCardJob
+	(*CloneApplicationRequest)(nil),              // 22: tracker.CloneApplicationRequest
+	(*ApplicationTemplate)(nil),                  // 23: tracker.ApplicationTemplate
+	(*ListApplicationTemplatesRequest)(nil),      // 24: tracker.ListApplicationTemplatesRequest
+	(*ListApplicationTemplatesResponse)(nil),     // 25: tracker.ListApplicationTemplatesResponse
+	(*CreateApplicationTemplateRequest)(nil),     // 26: tracker.CreateApplicationTemplateRequest
+	(*UpdateApplicationTemplateRequest)(nil),     // 27: tracker.UpdateApplicationTemplateRequest
+	(*DeleteApplicationTemplateRequest)(nil),     // 28: tracker.DeleteApplicationTemplateRequest
+	(*DeleteApplicationTemplateResponse)(nil),    // 29: tracker.DeleteApplicationTemplateResponse
+	(*CreateApplicationFromTemplateRequest)(nil), // 30: tracker.CreateApplicationFromTemplateRequest
+	(*MoveCardRequest)(nil),                      // 31: tracker.MoveCardRequest
+	(*UndoLastMoveRequest)(nil),                  // 32: tracker.UndoLastMoveRequest
+	(*UndoLastActionRequest)(nil),                // 33: tracker.UndoLastActionRequest
+	(*BulkMoveRequest)(nil),                      // 34: tracker.BulkMoveRequest
+	(*AddNoteRequest)(nil),                       // 35: tracker.AddNoteRequest
+	(*ListNotesRequest)(nil),                     // 36: tracker.ListNotesRequest
+	(*EditNoteRequest)(nil),                      // 37: tracker.EditNoteRequest
+	(*DeleteNoteRequest)(nil),                    // 38: tracker.DeleteNoteRequest
+	(*RateApplicationRequest)(nil),               // 39: tracker.RateApplicationRequest
+	(*RatingCriteria)(nil),                       // 40: tracker.RatingCriteria
+	(*SetPriorityRequest)(nil),                   // 41: tracker.SetPriorityRequest
+	(*SetNextStepRequest)(nil),                   // 42: tracker.SetNextStepRequest
+	(*SetRelanceReminderRequest)(nil),            // 43: tracker.SetRelanceReminderRequest
+	(*SnoozeReminderRequest)(nil),                // 44: tracker.SnoozeReminderRequest
+	(*ClearReminderRequest)(nil),                 // 45: tracker.ClearReminderRequest
+	(*SetReminderRuleRequest)(nil),               // 46: tracker.SetReminderRuleRequest
+	(*ReminderRule)(nil),                         // 47: tracker.ReminderRule
+	(*UpdateApplicationRequest)(nil),             // 48: tracker.UpdateApplicationRequest
+	(*ArchiveApplicationRequest)(nil),            // 49: tracker.ArchiveApplicationRequest
+	(*RestoreApplicationRequest)(nil),            // 50: tracker.RestoreApplicationRequest
+	(*MergeApplicationsRequest)(nil),             // 51: tracker.MergeApplicationsRequest
+	(*ListColumnsRequest)(nil),                   // 52: tracker.ListColumnsRequest
+	(*CreateColumnRequest)(nil),                  // 53: tracker.CreateColumnRequest
+	(*UpdateColumnRequest)(nil),                  // 54: tracker.UpdateColumnRequest
+	(*DeleteColumnRequest)(nil),                  // 55: tracker.DeleteColumnRequest
+	(*MoveToColumnRequest)(nil),                  // 56: tracker.MoveToColumnRequest
+	(*ReanalyzeApplicationRequest)(nil),          // 57: tracker.ReanalyzeApplicationRequest
+	(*ListCoverLetterVersionsRequest)(nil),       // 58: tracker.ListCoverLetterVersionsRequest
+	(*RegenerateCoverLetterRequest)(nil),         // 59: tracker.RegenerateCoverLetterRequest
+	(*RestoreCoverLetterVersionRequest)(nil),     // 60: tracker.RestoreCoverLetterVersionRequest
+	(*RequestFollowUpDraftRequest)(nil),          // 61: tracker.RequestFollowUpDraftRequest
+	(*CreateAttachmentRequest)(nil),              // 62: tracker.CreateAttachmentRequest
+	(*ListAttachmentsRequest)(nil),               // 63: tracker.ListAttachmentsRequest
+	(*GetAttachmentDownloadUrlRequest)(nil),      // 64: tracker.GetAttachmentDownloadUrlRequest
+	(*DeleteAttachmentRequest)(nil),              // 65: tracker.DeleteAttachmentRequest
+	(*CreateInterviewRequest)(nil),               // 66: tracker.CreateInterviewRequest
+	(*SetOfferDetailsRequest)(nil),               // 67: tracker.SetOfferDetailsRequest
+	(*CompareOffersRequest)(nil),                 // 68: tracker.CompareOffersRequest
+	(*AddNegotiationEntryRequest)(nil),           // 69: tracker.AddNegotiationEntryRequest
+	(*ListNegotiationEntriesRequest)(nil),        // 70: tracker.ListNegotiationEntriesRequest
+	(*DeleteNegotiationEntryRequest)(nil),        // 71: tracker.DeleteNegotiationEntryRequest
+	(*ListInterviewsRequest)(nil),                // 72: tracker.ListInterviewsRequest
+	(*UpdateInterviewRequest)(nil),               // 73: tracker.UpdateInterviewRequest
+	(*RecordInterviewFeedbackRequest)(nil),       // 74: tracker.RecordInterviewFeedbackRequest
+	(*DeleteInterviewRequest)(nil),               // 75: tracker.DeleteInterviewRequest
+	(*CreateContactRequest)(nil),                 // 76: tracker.CreateContactRequest
+	(*ListContactsRequest)(nil),                  // 77: tracker.ListContactsRequest
+	(*UpdateContactRequest)(nil),                 // 78: tracker.UpdateContactRequest
+	(*DeleteContactRequest)(nil),                 // 79: tracker.DeleteContactRequest
+	(*LinkContactRequest)(nil),                   // 80: tracker.LinkContactRequest
+	(*UnlinkContactRequest)(nil),                 // 81: tracker.UnlinkContactRequest
+	(*ListCompaniesRequest)(nil),                 // 82: tracker.ListCompaniesRequest
+	(*GetCompanyOverviewRequest)(nil),            // 83: tracker.GetCompanyOverviewRequest
+	(*GetRejectionStatsRequest)(nil),             // 84: tracker.GetRejectionStatsRequest
+	(*GetSearchConfigStatsRequest)(nil),          // 85: tracker.GetSearchConfigStatsRequest
+	(*CountApplicationsByStatusRequest)(nil),     // 86: tracker.CountApplicationsByStatusRequest
+	(*GetCalendarFeedRequest)(nil),               // 87: tracker.GetCalendarFeedRequest
+	(*RotateCalendarFeedTokenRequest)(nil),       // 88: tracker.RotateCalendarFeedTokenRequest
+	(*RenderCalendarFeedRequest)(nil),            // 89: tracker.RenderCalendarFeedRequest
+	(*StartGoogleCalendarAuthRequest)(nil),       // 90: tracker.StartGoogleCalendarAuthRequest
+	(*GoogleCalendarAuthUrl)(nil),                // 91: tracker.GoogleCalendarAuthUrl
+	(*CompleteGoogleCalendarAuthRequest)(nil),    // 92: tracker.CompleteGoogleCalendarAuthRequest
+	(*GetGoogleCalendarStatusRequest)(nil),       // 93: tracker.GetGoogleCalendarStatusRequest
+	(*DisconnectGoogleCalendarRequest)(nil),      // 94: tracker.DisconnectGoogleCalendarRequest
+	(*GoogleCalendarStatus)(nil),                 // 95: tracker.GoogleCalendarStatus
+	(*GetBenchmarkRequest)(nil),                  // 96: tracker.GetBenchmarkRequest
+	(*GetSettingsRequest)(nil),                   // 97: tracker.GetSettingsRequest
+	(*UpdateSettingsRequest)(nil),                // 98: tracker.UpdateSettingsRequest
+	(*SetSearchConfigArchivalRequest)(nil),       // 99: tracker.SetSearchConfigArchivalRequest
+	(*SearchConfigArchival)(nil),                 // 100: tracker.SearchConfigArchival
+	(*ReactivateSearchConfigRequest)(nil),        // 101: tracker.ReactivateSearchConfigRequest
+	(*ReopenSearchRequest)(nil),                  // 102: tracker.ReopenSearchRequest
+	(*ReopenSearchResponse)(nil),                 // 103: tracker.ReopenSearchResponse
+	(*Transition)(nil),                           // 104: tracker.Transition
+	(*TransitionList)(nil),                       // 105: tracker.TransitionList
+	(*ChannelList)(nil),                          // 106: tracker.ChannelList
+	(*ListApplicationsResponse)(nil),             // 107: tracker.ListApplicationsResponse
+	(*BulkMoveResponse)(nil),                     // 108: tracker.BulkMoveResponse
+	(*BulkMoveResult)(nil),                       // 109: tracker.BulkMoveResult
+	(*ListColumnsResponse)(nil),                  // 110: tracker.ListColumnsResponse
+	(*DeleteColumnResponse)(nil),                 // 111: tracker.DeleteColumnResponse
+	(*ReanalyzeApplicationResponse)(nil),         // 112: tracker.ReanalyzeApplicationResponse
+	(*ListCoverLetterVersionsResponse)(nil),      // 113: tracker.ListCoverLetterVersionsResponse
+	(*RegenerateCoverLetterResponse)(nil),        // 114: tracker.RegenerateCoverLetterResponse
+	(*RequestFollowUpDraftResponse)(nil),         // 115: tracker.RequestFollowUpDraftResponse
+	(*CoverLetterVersion)(nil),                   // 116: tracker.CoverLetterVersion
+	(*CreateAttachmentResponse)(nil),             // 117: tracker.CreateAttachmentResponse
+	(*ListAttachmentsResponse)(nil),              // 118: tracker.ListAttachmentsResponse
+	(*DeleteAttachmentResponse)(nil),             // 119: tracker.DeleteAttachmentResponse
+	(*ListInterviewsResponse)(nil),               // 120: tracker.ListInterviewsResponse
+	(*DeleteInterviewResponse)(nil),              // 121: tracker.DeleteInterviewResponse
+	(*CompareOffersResponse)(nil),                // 122: tracker.CompareOffersResponse
+	(*ListNegotiationEntriesResponse)(nil),       // 123: tracker.ListNegotiationEntriesResponse
+	(*DeleteNegotiationEntryResponse)(nil),       // 124: tracker.DeleteNegotiationEntryResponse
+	(*ListContactsResponse)(nil),                 // 125: tracker.ListContactsResponse
+	(*DeleteContactResponse)(nil),                // 126: tracker.DeleteContactResponse
+	(*ListCompaniesResponse)(nil),                // 127: tracker.ListCompaniesResponse
+	(*CompanySummary)(nil),                       // 128: tracker.CompanySummary
+	(*CompanyOverview)(nil),                      // 129: tracker.CompanyOverview
+	(*Contact)(nil),                              // 130: tracker.Contact
+	(*Interview)(nil),                            // 131: tracker.Interview
+	(*Offer)(nil),                                // 132: tracker.Offer
+	(*OfferComparison)(nil),                      // 133: tracker.OfferComparison
+	(*NegotiationEntry)(nil),                     // 134: tracker.NegotiationEntry
+	(*InterviewFeedback)(nil),                    // 135: tracker.InterviewFeedback
+	(*Attachment)(nil),                           // 136: tracker.Attachment
+	(*AttachmentUrl)(nil),                        // 137: tracker.AttachmentUrl
+	(*ListNotesResponse)(nil),                    // 138: tracker.ListNotesResponse
+	(*DeleteNoteResponse)(nil),                   // 139: tracker.DeleteNoteResponse
+	(*Note)(nil),                                 // 140: tracker.Note
+	(*BoardColumn)(nil),                          // 141: tracker.BoardColumn
+	(*RejectionStat)(nil),                        // 142: tracker.RejectionStat
+	(*GetRejectionStatsResponse)(nil),            // 143: tracker.GetRejectionStatsResponse
+	(*SearchConfigStats)(nil),                    // 144: tracker.SearchConfigStats
+	(*GetSearchConfigStatsResponse)(nil),         // 145: tracker.GetSearchConfigStatsResponse
+	(*CountApplicationsByStatusResponse)(nil),    // 146: tracker.CountApplicationsByStatusResponse
+	(*CalendarFeed)(nil),                         // 147: tracker.CalendarFeed
+	(*CalendarFeedContent)(nil),                  // 148: tracker.CalendarFeedContent
+	(*Benchmark)(nil),                            // 149: tracker.Benchmark
+	(*TrackerSettings)(nil),                      // 150: tracker.TrackerSettings
+	(*ApplicationProto)(nil),                     // 151: tracker.ApplicationProto
+	(*ListAuditLogRequest)(nil),                  // 152: tracker.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),                 // 153: tracker.ListAuditLogResponse
+	(*AuditEntry)(nil),                           // 154: tracker.AuditEntry
+	(*ExportUserDataRequest)(nil),                // 155: tracker.ExportUserDataRequest
+	(*UserDataExport)(nil),                       // 156: tracker.UserDataExport
+	(*EraseUserDataRequest)(nil),                 // 157: tracker.EraseUserDataRequest
+	(*EraseUserDataResponse)(nil),                // 158: tracker.EraseUserDataResponse
+	(*CreateBoardShareRequest)(nil),              // 159: tracker.CreateBoardShareRequest
+	(*BoardShare)(nil),                           // 160: tracker.BoardShare
+	(*ListBoardSharesRequest)(nil),               // 161: tracker.ListBoardSharesRequest
+	(*ListBoardSharesResponse)(nil),              // 162: tracker.ListBoardSharesResponse
+	(*RevokeBoardShareRequest)(nil),              // 163: tracker.RevokeBoardShareRequest
+	(*RevokeBoardShareResponse)(nil),             // 164: tracker.RevokeBoardShareResponse
+	(*ListSharedApplicationsRequest)(nil),        // 165: tracker.ListSharedApplicationsRequest
+	(*GetSharedApplicationRequest)(nil),          // 166: tracker.GetSharedApplicationRequest
+	(*CreateWebhookRequest)(nil),                 // 167: tracker.CreateWebhookRequest
+	(*Webhook)(nil),                              // 168: tracker.Webhook
+	(*ListWebhooksRequest)(nil),                  // 169: tracker.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                 // 170: tracker.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),                 // 171: tracker.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                // 172: tracker.DeleteWebhookResponse
+	(*ListWebhookDeliveriesRequest)(nil),         // 173: tracker.ListWebhookDeliveriesRequest
+	(*WebhookDelivery)(nil),                      // 174: tracker.WebhookDelivery
+	(*ListWebhookDeliveriesResponse)(nil),        // 175: tracker.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),          // 176: tracker.RetryWebhookDeliveryRequest
+	(*RetryWebhookDeliveryResponse)(nil),         // 177: tracker.RetryWebhookDeliveryResponse
+	(*TakeBoardSnapshotRequest)(nil),             // 178: tracker.TakeBoardSnapshotRequest
+	(*BoardSnapshot)(nil),                        // 179: tracker.BoardSnapshot
+	(*CompareSnapshotsRequest)(nil),              // 180: tracker.CompareSnapshotsRequest
+	(*SnapshotCard)(nil),                         // 181: tracker.SnapshotCard
+	(*SnapshotMove)(nil),                         // 182: tracker.SnapshotMove
+	(*CompareSnapshotsResponse)(nil),             // 183: tracker.CompareSnapshotsResponse
+	nil,                                          // 184: tracker.CompanySummary.StatusCountsEntry
+	nil,                                          // 185: tracker.CountApplicationsByStatusResponse.CountsEntry
+	nil,                                          // 186: tracker.EraseUserDataResponse.RowsEntry
+	(*timestamppb.Timestamp)(nil),                // 187: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                // 188: google.protobuf.FieldMask
 }
 var file_tracker_proto_depIdxs = []int32{
-	8,  // 0: tracker.ListApplicationsResponse.applications:type_name -> tracker.ApplicationProto
-	9,  // 1: tracker.ApplicationProto.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 2: tracker.ApplicationProto.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: tracker.TrackerService.ListApplications:input_type -> tracker.ListApplicationsRequest
-	1,  // 4: tracker.TrackerService.GetApplication:input_type -> tracker.GetApplicationRequest
-	2,  // 5: tracker.TrackerService.CreateApplication:input_type -> tracker.CreateApplicationRequest
-	3,  // 6: tracker.TrackerService.MoveCard:input_type -> tracker.MoveCardRequest
-	4,  // 7: tracker.TrackerService.AddNote:input_type -> tracker.AddNoteRequest
-	5,  // 8: tracker.TrackerService.RateApplication:input_type -> tracker.RateApplicationRequest
-	6,  // 9: tracker.TrackerService.SetRelanceReminder:input_type -> tracker.SetRelanceReminderRequest
-	7,  // 10: tracker.TrackerService.ListApplications:output_type -> tracker.ListApplicationsResponse
-	8,  // 11: tracker.TrackerService.GetApplication:output_type -> tracker.ApplicationProto
-	8,  // 12: tracker.TrackerService.CreateApplication:output_type -> tracker.ApplicationProto
-	8,  // 13: tracker.TrackerService.MoveCard:output_type -> tracker.ApplicationProto
-	8,  // 14: tracker.TrackerService.AddNote:output_type -> tracker.ApplicationProto
-	8,  // 15: tracker.TrackerService.RateApplication:output_type -> tracker.ApplicationProto
-	8,  // 16: tracker.TrackerService.SetRelanceReminder:output_type -> tracker.ApplicationProto
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	151, // 0: tracker.BatchGetApplicationsResponse.applications:type_name -> tracker.ApplicationProto
+	151, // 1: tracker.GetApplicationByJobFeedIdResponse.application:type_name -> tracker.ApplicationProto
+	16,  // 2: tracker.GetHistoryResponse.entries:type_name -> tracker.HistoryEntry
+	10,  // 3: tracker.GetTimelineResponse.entries:type_name -> tracker.TimelineEntry
+	187, // 4: tracker.TimelineEntry.at:type_name -> google.protobuf.Timestamp
+	16,  // 5: tracker.TimelineEntry.history:type_name -> tracker.HistoryEntry
+	140, // 6: tracker.TimelineEntry.note:type_name -> tracker.Note
+	131, // 7: tracker.TimelineEntry.interview:type_name -> tracker.Interview
+	13,  // 8: tracker.SearchApplicationsResponse.hits:type_name -> tracker.SearchHit
+	151, // 9: tracker.SearchHit.application:type_name -> tracker.ApplicationProto
+	14,  // 10: tracker.SearchHit.highlights:type_name -> tracker.SearchHighlight
+	15,  // 11: tracker.SearchHighlight.matches:type_name -> tracker.TextRange
+	187, // 12: tracker.HistoryEntry.at:type_name -> google.protobuf.Timestamp
+	187, // 13: tracker.HistoryEntry.remind_at:type_name -> google.protobuf.Timestamp
+	151, // 14: tracker.ImportApplicationEmailResponse.application:type_name -> tracker.ApplicationProto
+	187, // 15: tracker.ImportApplicationEmailResponse.applied_at:type_name -> google.protobuf.Timestamp
+	21,  // 16: tracker.CloneApplicationRequest.job:type_name -> tracker.NewCardJob
+	47,  // 17: tracker.ApplicationTemplate.reminder_rule:type_name -> tracker.ReminderRule
+	187, // 18: tracker.ApplicationTemplate.created_at:type_name -> google.protobuf.Timestamp
+	187, // 19: tracker.ApplicationTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 20: tracker.ListApplicationTemplatesResponse.templates:type_name -> tracker.ApplicationTemplate
+	23,  // 21: tracker.CreateApplicationTemplateRequest.template:type_name -> tracker.ApplicationTemplate
+	23,  // 22: tracker.UpdateApplicationTemplateRequest.template:type_name -> tracker.ApplicationTemplate
+	21,  // 23: tracker.CreateApplicationFromTemplateRequest.job:type_name -> tracker.NewCardJob
+	40,  // 24: tracker.RateApplicationRequest.criteria:type_name -> tracker.RatingCriteria
+	187, // 25: tracker.SetNextStepRequest.due_at:type_name -> google.protobuf.Timestamp
+	187, // 26: tracker.SetRelanceReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	47,  // 27: tracker.SetReminderRuleRequest.rule:type_name -> tracker.ReminderRule
+	151, // 28: tracker.UpdateApplicationRequest.application:type_name -> tracker.ApplicationProto
+	188, // 29: tracker.UpdateApplicationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	131, // 30: tracker.CreateInterviewRequest.interview:type_name -> tracker.Interview
+	132, // 31: tracker.SetOfferDetailsRequest.offer:type_name -> tracker.Offer
+	134, // 32: tracker.AddNegotiationEntryRequest.entry:type_name -> tracker.NegotiationEntry
+	131, // 33: tracker.UpdateInterviewRequest.interview:type_name -> tracker.Interview
+	188, // 34: tracker.UpdateInterviewRequest.update_mask:type_name -> google.protobuf.FieldMask
+	130, // 35: tracker.CreateContactRequest.contact:type_name -> tracker.Contact
+	130, // 36: tracker.UpdateContactRequest.contact:type_name -> tracker.Contact
+	188, // 37: tracker.UpdateContactRequest.update_mask:type_name -> google.protobuf.FieldMask
+	187, // 38: tracker.GoogleCalendarStatus.connected_at:type_name -> google.protobuf.Timestamp
+	187, // 39: tracker.GoogleCalendarStatus.last_synced_at:type_name -> google.protobuf.Timestamp
+	105, // 40: tracker.UpdateSettingsRequest.extra_transitions:type_name -> tracker.TransitionList
+	106, // 41: tracker.UpdateSettingsRequest.reminder_channels:type_name -> tracker.ChannelList
+	104, // 42: tracker.TransitionList.items:type_name -> tracker.Transition
+	151, // 43: tracker.ListApplicationsResponse.applications:type_name -> tracker.ApplicationProto
+	109, // 44: tracker.BulkMoveResponse.results:type_name -> tracker.BulkMoveResult
+	151, // 45: tracker.BulkMoveResult.application:type_name -> tracker.ApplicationProto
+	141, // 46: tracker.ListColumnsResponse.columns:type_name -> tracker.BoardColumn
+	116, // 47: tracker.ListCoverLetterVersionsResponse.versions:type_name -> tracker.CoverLetterVersion
+	187, // 48: tracker.CoverLetterVersion.created_at:type_name -> google.protobuf.Timestamp
+	136, // 49: tracker.CreateAttachmentResponse.attachment:type_name -> tracker.Attachment
+	137, // 50: tracker.CreateAttachmentResponse.upload:type_name -> tracker.AttachmentUrl
+	136, // 51: tracker.ListAttachmentsResponse.attachments:type_name -> tracker.Attachment
+	131, // 52: tracker.ListInterviewsResponse.interviews:type_name -> tracker.Interview
+	133, // 53: tracker.CompareOffersResponse.offers:type_name -> tracker.OfferComparison
+	134, // 54: tracker.ListNegotiationEntriesResponse.entries:type_name -> tracker.NegotiationEntry
+	130, // 55: tracker.ListContactsResponse.contacts:type_name -> tracker.Contact
+	128, // 56: tracker.ListCompaniesResponse.companies:type_name -> tracker.CompanySummary
+	184, // 57: tracker.CompanySummary.status_counts:type_name -> tracker.CompanySummary.StatusCountsEntry
+	187, // 58: tracker.CompanySummary.last_activity_at:type_name -> google.protobuf.Timestamp
+	128, // 59: tracker.CompanyOverview.summary:type_name -> tracker.CompanySummary
+	151, // 60: tracker.CompanyOverview.applications:type_name -> tracker.ApplicationProto
+	130, // 61: tracker.CompanyOverview.contacts:type_name -> tracker.Contact
+	140, // 62: tracker.CompanyOverview.notes:type_name -> tracker.Note
+	187, // 63: tracker.Contact.last_contacted_at:type_name -> google.protobuf.Timestamp
+	187, // 64: tracker.Contact.created_at:type_name -> google.protobuf.Timestamp
+	187, // 65: tracker.Contact.updated_at:type_name -> google.protobuf.Timestamp
+	187, // 66: tracker.Interview.scheduled_at:type_name -> google.protobuf.Timestamp
+	187, // 67: tracker.Interview.created_at:type_name -> google.protobuf.Timestamp
+	187, // 68: tracker.Interview.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 69: tracker.Interview.feedback:type_name -> tracker.InterviewFeedback
+	187, // 70: tracker.Offer.response_deadline:type_name -> google.protobuf.Timestamp
+	187, // 71: tracker.Offer.updated_at:type_name -> google.protobuf.Timestamp
+	132, // 72: tracker.OfferComparison.offer:type_name -> tracker.Offer
+	134, // 73: tracker.OfferComparison.negotiation:type_name -> tracker.NegotiationEntry
+	187, // 74: tracker.NegotiationEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	187, // 75: tracker.NegotiationEntry.created_at:type_name -> google.protobuf.Timestamp
+	187, // 76: tracker.InterviewFeedback.recorded_at:type_name -> google.protobuf.Timestamp
+	187, // 77: tracker.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	187, // 78: tracker.Attachment.scanned_at:type_name -> google.protobuf.Timestamp
+	187, // 79: tracker.AttachmentUrl.expires_at:type_name -> google.protobuf.Timestamp
+	140, // 80: tracker.ListNotesResponse.notes:type_name -> tracker.Note
+	187, // 81: tracker.Note.created_at:type_name -> google.protobuf.Timestamp
+	187, // 82: tracker.Note.edited_at:type_name -> google.protobuf.Timestamp
+	187, // 83: tracker.BoardColumn.created_at:type_name -> google.protobuf.Timestamp
+	187, // 84: tracker.BoardColumn.updated_at:type_name -> google.protobuf.Timestamp
+	142, // 85: tracker.GetRejectionStatsResponse.stats:type_name -> tracker.RejectionStat
+	187, // 86: tracker.SearchConfigStats.created_at:type_name -> google.protobuf.Timestamp
+	144, // 87: tracker.GetSearchConfigStatsResponse.configs:type_name -> tracker.SearchConfigStats
+	185, // 88: tracker.CountApplicationsByStatusResponse.counts:type_name -> tracker.CountApplicationsByStatusResponse.CountsEntry
+	187, // 89: tracker.CalendarFeed.created_at:type_name -> google.protobuf.Timestamp
+	187, // 90: tracker.Benchmark.computed_at:type_name -> google.protobuf.Timestamp
+	104, // 91: tracker.TrackerSettings.extra_transitions:type_name -> tracker.Transition
+	187, // 92: tracker.ApplicationProto.created_at:type_name -> google.protobuf.Timestamp
+	187, // 93: tracker.ApplicationProto.updated_at:type_name -> google.protobuf.Timestamp
+	187, // 94: tracker.ApplicationProto.archived_at:type_name -> google.protobuf.Timestamp
+	187, // 95: tracker.ApplicationProto.ghosted_at:type_name -> google.protobuf.Timestamp
+	187, // 96: tracker.ApplicationProto.next_step_due_at:type_name -> google.protobuf.Timestamp
+	131, // 97: tracker.ApplicationProto.interviews:type_name -> tracker.Interview
+	132, // 98: tracker.ApplicationProto.offer:type_name -> tracker.Offer
+	47,  // 99: tracker.ApplicationProto.reminder_rule:type_name -> tracker.ReminderRule
+	187, // 100: tracker.ApplicationProto.relance_reminder_at:type_name -> google.protobuf.Timestamp
+	187, // 101: tracker.ApplicationProto.followup_draft_at:type_name -> google.protobuf.Timestamp
+	40,  // 102: tracker.ApplicationProto.rating_criteria:type_name -> tracker.RatingCriteria
+	187, // 103: tracker.ListAuditLogRequest.since:type_name -> google.protobuf.Timestamp
+	187, // 104: tracker.ListAuditLogRequest.until:type_name -> google.protobuf.Timestamp
+	154, // 105: tracker.ListAuditLogResponse.entries:type_name -> tracker.AuditEntry
+	187, // 106: tracker.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	186, // 107: tracker.EraseUserDataResponse.rows:type_name -> tracker.EraseUserDataResponse.RowsEntry
+	187, // 108: tracker.CreateBoardShareRequest.expires_at:type_name -> google.protobuf.Timestamp
+	187, // 109: tracker.BoardShare.expires_at:type_name -> google.protobuf.Timestamp
+	187, // 110: tracker.BoardShare.created_at:type_name -> google.protobuf.Timestamp
+	160, // 111: tracker.ListBoardSharesResponse.shares:type_name -> tracker.BoardShare
+	187, // 112: tracker.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	168, // 113: tracker.ListWebhooksResponse.webhooks:type_name -> tracker.Webhook
+	187, // 114: tracker.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	187, // 115: tracker.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	187, // 116: tracker.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	174, // 117: tracker.ListWebhookDeliveriesResponse.deliveries:type_name -> tracker.WebhookDelivery
+	187, // 118: tracker.BoardSnapshot.taken_at:type_name -> google.protobuf.Timestamp
+	187, // 119: tracker.CompareSnapshotsRequest.since:type_name -> google.protobuf.Timestamp
+	187, // 120: tracker.CompareSnapshotsRequest.until:type_name -> google.protobuf.Timestamp
+	181, // 121: tracker.SnapshotMove.card:type_name -> tracker.SnapshotCard
+	187, // 122: tracker.CompareSnapshotsResponse.from_taken_at:type_name -> google.protobuf.Timestamp
+	187, // 123: tracker.CompareSnapshotsResponse.to_taken_at:type_name -> google.protobuf.Timestamp
+	181, // 124: tracker.CompareSnapshotsResponse.added:type_name -> tracker.SnapshotCard
+	181, // 125: tracker.CompareSnapshotsResponse.removed:type_name -> tracker.SnapshotCard
+	182, // 126: tracker.CompareSnapshotsResponse.moved:type_name -> tracker.SnapshotMove
+	181, // 127: tracker.CompareSnapshotsResponse.archived:type_name -> tracker.SnapshotCard
+	181, // 128: tracker.CompareSnapshotsResponse.restored:type_name -> tracker.SnapshotCard
+	0,   // 129: tracker.TrackerService.ListApplications:input_type -> tracker.ListApplicationsRequest
+	1,   // 130: tracker.TrackerService.GetApplication:input_type -> tracker.GetApplicationRequest
+	2,   // 131: tracker.TrackerService.BatchGetApplications:input_type -> tracker.BatchGetApplicationsRequest
+	4,   // 132: tracker.TrackerService.GetApplicationByJobFeedId:input_type -> tracker.GetApplicationByJobFeedIdRequest
+	6,   // 133: tracker.TrackerService.GetHistory:input_type -> tracker.GetHistoryRequest
+	8,   // 134: tracker.TrackerService.GetTimeline:input_type -> tracker.GetTimelineRequest
+	11,  // 135: tracker.TrackerService.SearchApplications:input_type -> tracker.SearchApplicationsRequest
+	17,  // 136: tracker.TrackerService.CreateApplication:input_type -> tracker.CreateApplicationRequest
+	18,  // 137: tracker.TrackerService.CreateManualApplication:input_type -> tracker.CreateManualApplicationRequest
+	19,  // 138: tracker.TrackerService.ImportApplicationEmail:input_type -> tracker.ImportApplicationEmailRequest
+	22,  // 139: tracker.TrackerService.CloneApplication:input_type -> tracker.CloneApplicationRequest
+	24,  // 140: tracker.TrackerService.ListApplicationTemplates:input_type -> tracker.ListApplicationTemplatesRequest
+	26,  // 141: tracker.TrackerService.CreateApplicationTemplate:input_type -> tracker.CreateApplicationTemplateRequest
+	27,  // 142: tracker.TrackerService.UpdateApplicationTemplate:input_type -> tracker.UpdateApplicationTemplateRequest
+	28,  // 143: tracker.TrackerService.DeleteApplicationTemplate:input_type -> tracker.DeleteApplicationTemplateRequest
+	30,  // 144: tracker.TrackerService.CreateApplicationFromTemplate:input_type -> tracker.CreateApplicationFromTemplateRequest
+	31,  // 145: tracker.TrackerService.MoveCard:input_type -> tracker.MoveCardRequest
+	32,  // 146: tracker.TrackerService.UndoLastMove:input_type -> tracker.UndoLastMoveRequest
+	33,  // 147: tracker.TrackerService.UndoLastAction:input_type -> tracker.UndoLastActionRequest
+	34,  // 148: tracker.TrackerService.BulkMove:input_type -> tracker.BulkMoveRequest
+	35,  // 149: tracker.TrackerService.AddNote:input_type -> tracker.AddNoteRequest
+	36,  // 150: tracker.TrackerService.ListNotes:input_type -> tracker.ListNotesRequest
+	37,  // 151: tracker.TrackerService.EditNote:input_type -> tracker.EditNoteRequest
+	38,  // 152: tracker.TrackerService.DeleteNote:input_type -> tracker.DeleteNoteRequest
+	39,  // 153: tracker.TrackerService.RateApplication:input_type -> tracker.RateApplicationRequest
+	41,  // 154: tracker.TrackerService.SetPriority:input_type -> tracker.SetPriorityRequest
+	42,  // 155: tracker.TrackerService.SetNextStep:input_type -> tracker.SetNextStepRequest
+	43,  // 156: tracker.TrackerService.SetRelanceReminder:input_type -> tracker.SetRelanceReminderRequest
+	44,  // 157: tracker.TrackerService.SnoozeReminder:input_type -> tracker.SnoozeReminderRequest
+	45,  // 158: tracker.TrackerService.ClearReminder:input_type -> tracker.ClearReminderRequest
+	46,  // 159: tracker.TrackerService.SetReminderRule:input_type -> tracker.SetReminderRuleRequest
+	48,  // 160: tracker.TrackerService.UpdateApplication:input_type -> tracker.UpdateApplicationRequest
+	49,  // 161: tracker.TrackerService.ArchiveApplication:input_type -> tracker.ArchiveApplicationRequest
+	50,  // 162: tracker.TrackerService.RestoreApplication:input_type -> tracker.RestoreApplicationRequest
+	51,  // 163: tracker.TrackerService.MergeApplications:input_type -> tracker.MergeApplicationsRequest
+	52,  // 164: tracker.TrackerService.ListColumns:input_type -> tracker.ListColumnsRequest
+	53,  // 165: tracker.TrackerService.CreateColumn:input_type -> tracker.CreateColumnRequest
+	54,  // 166: tracker.TrackerService.UpdateColumn:input_type -> tracker.UpdateColumnRequest
+	55,  // 167: tracker.TrackerService.DeleteColumn:input_type -> tracker.DeleteColumnRequest
+	56,  // 168: tracker.TrackerService.MoveToColumn:input_type -> tracker.MoveToColumnRequest
+	57,  // 169: tracker.TrackerService.ReanalyzeApplication:input_type -> tracker.ReanalyzeApplicationRequest
+	58,  // 170: tracker.TrackerService.ListCoverLetterVersions:input_type -> tracker.ListCoverLetterVersionsRequest
+	59,  // 171: tracker.TrackerService.RegenerateCoverLetter:input_type -> tracker.RegenerateCoverLetterRequest
+	60,  // 172: tracker.TrackerService.RestoreCoverLetterVersion:input_type -> tracker.RestoreCoverLetterVersionRequest
+	61,  // 173: tracker.TrackerService.RequestFollowUpDraft:input_type -> tracker.RequestFollowUpDraftRequest
+	62,  // 174: tracker.TrackerService.CreateAttachment:input_type -> tracker.CreateAttachmentRequest
+	63,  // 175: tracker.TrackerService.ListAttachments:input_type -> tracker.ListAttachmentsRequest
+	64,  // 176: tracker.TrackerService.GetAttachmentDownloadUrl:input_type -> tracker.GetAttachmentDownloadUrlRequest
+	65,  // 177: tracker.TrackerService.DeleteAttachment:input_type -> tracker.DeleteAttachmentRequest
+	66,  // 178: tracker.TrackerService.CreateInterview:input_type -> tracker.CreateInterviewRequest
+	72,  // 179: tracker.TrackerService.ListInterviews:input_type -> tracker.ListInterviewsRequest
+	73,  // 180: tracker.TrackerService.UpdateInterview:input_type -> tracker.UpdateInterviewRequest
+	74,  // 181: tracker.TrackerService.RecordInterviewFeedback:input_type -> tracker.RecordInterviewFeedbackRequest
+	75,  // 182: tracker.TrackerService.DeleteInterview:input_type -> tracker.DeleteInterviewRequest
+	67,  // 183: tracker.TrackerService.SetOfferDetails:input_type -> tracker.SetOfferDetailsRequest
+	68,  // 184: tracker.TrackerService.CompareOffers:input_type -> tracker.CompareOffersRequest
+	69,  // 185: tracker.TrackerService.AddNegotiationEntry:input_type -> tracker.AddNegotiationEntryRequest
+	70,  // 186: tracker.TrackerService.ListNegotiationEntries:input_type -> tracker.ListNegotiationEntriesRequest
+	71,  // 187: tracker.TrackerService.DeleteNegotiationEntry:input_type -> tracker.DeleteNegotiationEntryRequest
+	76,  // 188: tracker.TrackerService.CreateContact:input_type -> tracker.CreateContactRequest
+	77,  // 189: tracker.TrackerService.ListContacts:input_type -> tracker.ListContactsRequest
+	78,  // 190: tracker.TrackerService.UpdateContact:input_type -> tracker.UpdateContactRequest
+	79,  // 191: tracker.TrackerService.DeleteContact:input_type -> tracker.DeleteContactRequest
+	80,  // 192: tracker.TrackerService.LinkContact:input_type -> tracker.LinkContactRequest
+	81,  // 193: tracker.TrackerService.UnlinkContact:input_type -> tracker.UnlinkContactRequest
+	82,  // 194: tracker.TrackerService.ListCompanies:input_type -> tracker.ListCompaniesRequest
+	83,  // 195: tracker.TrackerService.GetCompanyOverview:input_type -> tracker.GetCompanyOverviewRequest
+	84,  // 196: tracker.TrackerService.GetRejectionStats:input_type -> tracker.GetRejectionStatsRequest
+	85,  // 197: tracker.TrackerService.GetSearchConfigStats:input_type -> tracker.GetSearchConfigStatsRequest
+	86,  // 198: tracker.TrackerService.CountApplicationsByStatus:input_type -> tracker.CountApplicationsByStatusRequest
+	178, // 199: tracker.TrackerService.TakeBoardSnapshot:input_type -> tracker.TakeBoardSnapshotRequest
+	180, // 200: tracker.TrackerService.CompareSnapshots:input_type -> tracker.CompareSnapshotsRequest
+	87,  // 201: tracker.TrackerService.GetCalendarFeed:input_type -> tracker.GetCalendarFeedRequest
+	88,  // 202: tracker.TrackerService.RotateCalendarFeedToken:input_type -> tracker.RotateCalendarFeedTokenRequest
+	89,  // 203: tracker.TrackerService.RenderCalendarFeed:input_type -> tracker.RenderCalendarFeedRequest
+	90,  // 204: tracker.TrackerService.StartGoogleCalendarAuth:input_type -> tracker.StartGoogleCalendarAuthRequest
+	92,  // 205: tracker.TrackerService.CompleteGoogleCalendarAuth:input_type -> tracker.CompleteGoogleCalendarAuthRequest
+	93,  // 206: tracker.TrackerService.GetGoogleCalendarStatus:input_type -> tracker.GetGoogleCalendarStatusRequest
+	94,  // 207: tracker.TrackerService.DisconnectGoogleCalendar:input_type -> tracker.DisconnectGoogleCalendarRequest
+	96,  // 208: tracker.TrackerService.GetBenchmark:input_type -> tracker.GetBenchmarkRequest
+	97,  // 209: tracker.TrackerService.GetSettings:input_type -> tracker.GetSettingsRequest
+	98,  // 210: tracker.TrackerService.UpdateSettings:input_type -> tracker.UpdateSettingsRequest
+	99,  // 211: tracker.TrackerService.SetSearchConfigArchival:input_type -> tracker.SetSearchConfigArchivalRequest
+	101, // 212: tracker.TrackerService.ReactivateSearchConfig:input_type -> tracker.ReactivateSearchConfigRequest
+	102, // 213: tracker.TrackerService.ReopenSearch:input_type -> tracker.ReopenSearchRequest
+	152, // 214: tracker.TrackerService.ListAuditLog:input_type -> tracker.ListAuditLogRequest
+	155, // 215: tracker.TrackerService.ExportUserData:input_type -> tracker.ExportUserDataRequest
+	157, // 216: tracker.TrackerService.EraseUserData:input_type -> tracker.EraseUserDataRequest
+	159, // 217: tracker.TrackerService.CreateBoardShare:input_type -> tracker.CreateBoardShareRequest
+	161, // 218: tracker.TrackerService.ListBoardShares:input_type -> tracker.ListBoardSharesRequest
+	163, // 219: tracker.TrackerService.RevokeBoardShare:input_type -> tracker.RevokeBoardShareRequest
+	165, // 220: tracker.TrackerService.ListSharedApplications:input_type -> tracker.ListSharedApplicationsRequest
+	166, // 221: tracker.TrackerService.GetSharedApplication:input_type -> tracker.GetSharedApplicationRequest
+	167, // 222: tracker.TrackerService.CreateWebhook:input_type -> tracker.CreateWebhookRequest
+	169, // 223: tracker.TrackerService.ListWebhooks:input_type -> tracker.ListWebhooksRequest
+	171, // 224: tracker.TrackerService.DeleteWebhook:input_type -> tracker.DeleteWebhookRequest
+	173, // 225: tracker.TrackerService.ListWebhookDeliveries:input_type -> tracker.ListWebhookDeliveriesRequest
+	176, // 226: tracker.TrackerService.RetryWebhookDelivery:input_type -> tracker.RetryWebhookDeliveryRequest
+	107, // 227: tracker.TrackerService.ListApplications:output_type -> tracker.ListApplicationsResponse
+	151, // 228: tracker.TrackerService.GetApplication:output_type -> tracker.ApplicationProto
+	3,   // 229: tracker.TrackerService.BatchGetApplications:output_type -> tracker.BatchGetApplicationsResponse
+	5,   // 230: tracker.TrackerService.GetApplicationByJobFeedId:output_type -> tracker.GetApplicationByJobFeedIdResponse
+	7,   // 231: tracker.TrackerService.GetHistory:output_type -> tracker.GetHistoryResponse
+	9,   // 232: tracker.TrackerService.GetTimeline:output_type -> tracker.GetTimelineResponse
+	12,  // 233: tracker.TrackerService.SearchApplications:output_type -> tracker.SearchApplicationsResponse
+	151, // 234: tracker.TrackerService.CreateApplication:output_type -> tracker.ApplicationProto
+	151, // 235: tracker.TrackerService.CreateManualApplication:output_type -> tracker.ApplicationProto
+	20,  // 236: tracker.TrackerService.ImportApplicationEmail:output_type -> tracker.ImportApplicationEmailResponse
+	151, // 237: tracker.TrackerService.CloneApplication:output_type -> tracker.ApplicationProto
+	25,  // 238: tracker.TrackerService.ListApplicationTemplates:output_type -> tracker.ListApplicationTemplatesResponse
+	23,  // 239: tracker.TrackerService.CreateApplicationTemplate:output_type -> tracker.ApplicationTemplate
+	23,  // 240: tracker.TrackerService.UpdateApplicationTemplate:output_type -> tracker.ApplicationTemplate
+	29,  // 241: tracker.TrackerService.DeleteApplicationTemplate:output_type -> tracker.DeleteApplicationTemplateResponse
+	151, // 242: tracker.TrackerService.CreateApplicationFromTemplate:output_type -> tracker.ApplicationProto
+	151, // 243: tracker.TrackerService.MoveCard:output_type -> tracker.ApplicationProto
+	151, // 244: tracker.TrackerService.UndoLastMove:output_type -> tracker.ApplicationProto
+	151, // 245: tracker.TrackerService.UndoLastAction:output_type -> tracker.ApplicationProto
+	108, // 246: tracker.TrackerService.BulkMove:output_type -> tracker.BulkMoveResponse
+	151, // 247: tracker.TrackerService.AddNote:output_type -> tracker.ApplicationProto
+	138, // 248: tracker.TrackerService.ListNotes:output_type -> tracker.ListNotesResponse
+	140, // 249: tracker.TrackerService.EditNote:output_type -> tracker.Note
+	139, // 250: tracker.TrackerService.DeleteNote:output_type -> tracker.DeleteNoteResponse
+	151, // 251: tracker.TrackerService.RateApplication:output_type -> tracker.ApplicationProto
+	151, // 252: tracker.TrackerService.SetPriority:output_type -> tracker.ApplicationProto
+	151, // 253: tracker.TrackerService.SetNextStep:output_type -> tracker.ApplicationProto
+	151, // 254: tracker.TrackerService.SetRelanceReminder:output_type -> tracker.ApplicationProto
+	151, // 255: tracker.TrackerService.SnoozeReminder:output_type -> tracker.ApplicationProto
+	151, // 256: tracker.TrackerService.ClearReminder:output_type -> tracker.ApplicationProto
+	151, // 257: tracker.TrackerService.SetReminderRule:output_type -> tracker.ApplicationProto
+	151, // 258: tracker.TrackerService.UpdateApplication:output_type -> tracker.ApplicationProto
+	151, // 259: tracker.TrackerService.ArchiveApplication:output_type -> tracker.ApplicationProto
+	151, // 260: tracker.TrackerService.RestoreApplication:output_type -> tracker.ApplicationProto
+	151, // 261: tracker.TrackerService.MergeApplications:output_type -> tracker.ApplicationProto
+	110, // 262: tracker.TrackerService.ListColumns:output_type -> tracker.ListColumnsResponse
+	141, // 263: tracker.TrackerService.CreateColumn:output_type -> tracker.BoardColumn
+	141, // 264: tracker.TrackerService.UpdateColumn:output_type -> tracker.BoardColumn
+	111, // 265: tracker.TrackerService.DeleteColumn:output_type -> tracker.DeleteColumnResponse
+	151, // 266: tracker.TrackerService.MoveToColumn:output_type -> tracker.ApplicationProto
+	112, // 267: tracker.TrackerService.ReanalyzeApplication:output_type -> tracker.ReanalyzeApplicationResponse
+	113, // 268: tracker.TrackerService.ListCoverLetterVersions:output_type -> tracker.ListCoverLetterVersionsResponse
+	114, // 269: tracker.TrackerService.RegenerateCoverLetter:output_type -> tracker.RegenerateCoverLetterResponse
+	151, // 270: tracker.TrackerService.RestoreCoverLetterVersion:output_type -> tracker.ApplicationProto
+	115, // 271: tracker.TrackerService.RequestFollowUpDraft:output_type -> tracker.RequestFollowUpDraftResponse
+	117, // 272: tracker.TrackerService.CreateAttachment:output_type -> tracker.CreateAttachmentResponse
+	118, // 273: tracker.TrackerService.ListAttachments:output_type -> tracker.ListAttachmentsResponse
+	137, // 274: tracker.TrackerService.GetAttachmentDownloadUrl:output_type -> tracker.AttachmentUrl
+	119, // 275: tracker.TrackerService.DeleteAttachment:output_type -> tracker.DeleteAttachmentResponse
+	131, // 276: tracker.TrackerService.CreateInterview:output_type -> tracker.Interview
+	120, // 277: tracker.TrackerService.ListInterviews:output_type -> tracker.ListInterviewsResponse
+	131, // 278: tracker.TrackerService.UpdateInterview:output_type -> tracker.Interview
+	131, // 279: tracker.TrackerService.RecordInterviewFeedback:output_type -> tracker.Interview
+	121, // 280: tracker.TrackerService.DeleteInterview:output_type -> tracker.DeleteInterviewResponse
+	132, // 281: tracker.TrackerService.SetOfferDetails:output_type -> tracker.Offer
+	122, // 282: tracker.TrackerService.CompareOffers:output_type -> tracker.CompareOffersResponse
+	134, // 283: tracker.TrackerService.AddNegotiationEntry:output_type -> tracker.NegotiationEntry
+	123, // 284: tracker.TrackerService.ListNegotiationEntries:output_type -> tracker.ListNegotiationEntriesResponse
+	124, // 285: tracker.TrackerService.DeleteNegotiationEntry:output_type -> tracker.DeleteNegotiationEntryResponse
+	130, // 286: tracker.TrackerService.CreateContact:output_type -> tracker.Contact
+	125, // 287: tracker.TrackerService.ListContacts:output_type -> tracker.ListContactsResponse
+	130, // 288: tracker.TrackerService.UpdateContact:output_type -> tracker.Contact
+	126, // 289: tracker.TrackerService.DeleteContact:output_type -> tracker.DeleteContactResponse
+	130, // 290: tracker.TrackerService.LinkContact:output_type -> tracker.Contact
+	130, // 291: tracker.TrackerService.UnlinkContact:output_type -> tracker.Contact
+	127, // 292: tracker.TrackerService.ListCompanies:output_type -> tracker.ListCompaniesResponse
+	129, // 293: tracker.TrackerService.GetCompanyOverview:output_type -> tracker.CompanyOverview
+	143, // 294: tracker.TrackerService.GetRejectionStats:output_type -> tracker.GetRejectionStatsResponse
+	145, // 295: tracker.TrackerService.GetSearchConfigStats:output_type -> tracker.GetSearchConfigStatsResponse
+	146, // 296: tracker.TrackerService.CountApplicationsByStatus:output_type -> tracker.CountApplicationsByStatusResponse
+	179, // 297: tracker.TrackerService.TakeBoardSnapshot:output_type -> tracker.BoardSnapshot
+	183, // 298: tracker.TrackerService.CompareSnapshots:output_type -> tracker.CompareSnapshotsResponse
+	147, // 299: tracker.TrackerService.GetCalendarFeed:output_type -> tracker.CalendarFeed
+	147, // 300: tracker.TrackerService.RotateCalendarFeedToken:output_type -> tracker.CalendarFeed
+	148, // 301: tracker.TrackerService.RenderCalendarFeed:output_type -> tracker.CalendarFeedContent
+	91,  // 302: tracker.TrackerService.StartGoogleCalendarAuth:output_type -> tracker.GoogleCalendarAuthUrl
+	95,  // 303: tracker.TrackerService.CompleteGoogleCalendarAuth:output_type -> tracker.GoogleCalendarStatus
+	95,  // 304: tracker.TrackerService.GetGoogleCalendarStatus:output_type -> tracker.GoogleCalendarStatus
+	95,  // 305: tracker.TrackerService.DisconnectGoogleCalendar:output_type -> tracker.GoogleCalendarStatus
+	149, // 306: tracker.TrackerService.GetBenchmark:output_type -> tracker.Benchmark
+	150, // 307: tracker.TrackerService.GetSettings:output_type -> tracker.TrackerSettings
+	150, // 308: tracker.TrackerService.UpdateSettings:output_type -> tracker.TrackerSettings
+	100, // 309: tracker.TrackerService.SetSearchConfigArchival:output_type -> tracker.SearchConfigArchival
+	151, // 310: tracker.TrackerService.ReactivateSearchConfig:output_type -> tracker.ApplicationProto
+	103, // 311: tracker.TrackerService.ReopenSearch:output_type -> tracker.ReopenSearchResponse
+	153, // 312: tracker.TrackerService.ListAuditLog:output_type -> tracker.ListAuditLogResponse
+	156, // 313: tracker.TrackerService.ExportUserData:output_type -> tracker.UserDataExport
+	158, // 314: tracker.TrackerService.EraseUserData:output_type -> tracker.EraseUserDataResponse
+	160, // 315: tracker.TrackerService.CreateBoardShare:output_type -> tracker.BoardShare
+	162, // 316: tracker.TrackerService.ListBoardShares:output_type -> tracker.ListBoardSharesResponse
+	164, // 317: tracker.TrackerService.RevokeBoardShare:output_type -> tracker.RevokeBoardShareResponse
+	107, // 318: tracker.TrackerService.ListSharedApplications:output_type -> tracker.ListApplicationsResponse
+	151, // 319: tracker.TrackerService.GetSharedApplication:output_type -> tracker.ApplicationProto
+	168, // 320: tracker.TrackerService.CreateWebhook:output_type -> tracker.Webhook
+	170, // 321: tracker.TrackerService.ListWebhooks:output_type -> tracker.ListWebhooksResponse
+	172, // 322: tracker.TrackerService.DeleteWebhook:output_type -> tracker.DeleteWebhookResponse
+	175, // 323: tracker.TrackerService.ListWebhookDeliveries:output_type -> tracker.ListWebhookDeliveriesResponse
+	177, // 324: tracker.TrackerService.RetryWebhookDelivery:output_type -> tracker.RetryWebhookDeliveryResponse
+	227, // [227:325] is the sub-list for method output_type
+	129, // [129:227] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
 }
 
 func init() { file_tracker_proto_init() }
@@ -662,13 +12316,15 @@ func file_tracker_proto_init() {
 	if File_tracker_proto != nil {
 		return
 	}
+	file_tracker_proto_msgTypes[54].OneofWrappers = []any{}
+	file_tracker_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tracker_proto_rawDesc), len(file_tracker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   187,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,104 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TrackerService_ListApplications_FullMethodName   = "/tracker.TrackerService/ListApplications"
-	TrackerService_GetApplication_FullMethodName     = "/tracker.TrackerService/GetApplication"
-	TrackerService_CreateApplication_FullMethodName  = "/tracker.TrackerService/CreateApplication"
-	TrackerService_MoveCard_FullMethodName           = "/tracker.TrackerService/MoveCard"
-	TrackerService_AddNote_FullMethodName            = "/tracker.TrackerService/AddNote"
-	TrackerService_RateApplication_FullMethodName    = "/tracker.TrackerService/RateApplication"
-	TrackerService_SetRelanceReminder_FullMethodName = "/tracker.TrackerService/SetRelanceReminder"
+	TrackerService_ListApplications_FullMethodName              = "/tracker.TrackerService/ListApplications"
+	TrackerService_GetApplication_FullMethodName                = "/tracker.TrackerService/GetApplication"
+	TrackerService_BatchGetApplications_FullMethodName          = "/tracker.TrackerService/BatchGetApplications"
+	TrackerService_GetApplicationByJobFeedId_FullMethodName     = "/tracker.TrackerService/GetApplicationByJobFeedId"
+	TrackerService_GetHistory_FullMethodName                    = "/tracker.TrackerService/GetHistory"
+	TrackerService_GetTimeline_FullMethodName                   = "/tracker.TrackerService/GetTimeline"
+	TrackerService_SearchApplications_FullMethodName            = "/tracker.TrackerService/SearchApplications"
+	TrackerService_CreateApplication_FullMethodName             = "/tracker.TrackerService/CreateApplication"
+	TrackerService_CreateManualApplication_FullMethodName       = "/tracker.TrackerService/CreateManualApplication"
+	TrackerService_ImportApplicationEmail_FullMethodName        = "/tracker.TrackerService/ImportApplicationEmail"
+	TrackerService_CloneApplication_FullMethodName              = "/tracker.TrackerService/CloneApplication"
+	TrackerService_ListApplicationTemplates_FullMethodName      = "/tracker.TrackerService/ListApplicationTemplates"
+	TrackerService_CreateApplicationTemplate_FullMethodName     = "/tracker.TrackerService/CreateApplicationTemplate"
+	TrackerService_UpdateApplicationTemplate_FullMethodName     = "/tracker.TrackerService/UpdateApplicationTemplate"
+	TrackerService_DeleteApplicationTemplate_FullMethodName     = "/tracker.TrackerService/DeleteApplicationTemplate"
+	TrackerService_CreateApplicationFromTemplate_FullMethodName = "/tracker.TrackerService/CreateApplicationFromTemplate"
+	TrackerService_MoveCard_FullMethodName                      = "/tracker.TrackerService/MoveCard"
+	TrackerService_UndoLastMove_FullMethodName                  = "/tracker.TrackerService/UndoLastMove"
+	TrackerService_UndoLastAction_FullMethodName                = "/tracker.TrackerService/UndoLastAction"
+	TrackerService_BulkMove_FullMethodName                      = "/tracker.TrackerService/BulkMove"
+	TrackerService_AddNote_FullMethodName                       = "/tracker.TrackerService/AddNote"
+	TrackerService_ListNotes_FullMethodName                     = "/tracker.TrackerService/ListNotes"
+	TrackerService_EditNote_FullMethodName                      = "/tracker.TrackerService/EditNote"
+	TrackerService_DeleteNote_FullMethodName                    = "/tracker.TrackerService/DeleteNote"
+	TrackerService_RateApplication_FullMethodName               = "/tracker.TrackerService/RateApplication"
+	TrackerService_SetPriority_FullMethodName                   = "/tracker.TrackerService/SetPriority"
+	TrackerService_SetNextStep_FullMethodName                   = "/tracker.TrackerService/SetNextStep"
+	TrackerService_SetRelanceReminder_FullMethodName            = "/tracker.TrackerService/SetRelanceReminder"
+	TrackerService_SnoozeReminder_FullMethodName                = "/tracker.TrackerService/SnoozeReminder"
+	TrackerService_ClearReminder_FullMethodName                 = "/tracker.TrackerService/ClearReminder"
+	TrackerService_SetReminderRule_FullMethodName               = "/tracker.TrackerService/SetReminderRule"
+	TrackerService_UpdateApplication_FullMethodName             = "/tracker.TrackerService/UpdateApplication"
+	TrackerService_ArchiveApplication_FullMethodName            = "/tracker.TrackerService/ArchiveApplication"
+	TrackerService_RestoreApplication_FullMethodName            = "/tracker.TrackerService/RestoreApplication"
+	TrackerService_MergeApplications_FullMethodName             = "/tracker.TrackerService/MergeApplications"
+	TrackerService_ListColumns_FullMethodName                   = "/tracker.TrackerService/ListColumns"
+	TrackerService_CreateColumn_FullMethodName                  = "/tracker.TrackerService/CreateColumn"
+	TrackerService_UpdateColumn_FullMethodName                  = "/tracker.TrackerService/UpdateColumn"
+	TrackerService_DeleteColumn_FullMethodName                  = "/tracker.TrackerService/DeleteColumn"
+	TrackerService_MoveToColumn_FullMethodName                  = "/tracker.TrackerService/MoveToColumn"
+	TrackerService_ReanalyzeApplication_FullMethodName          = "/tracker.TrackerService/ReanalyzeApplication"
+	TrackerService_ListCoverLetterVersions_FullMethodName       = "/tracker.TrackerService/ListCoverLetterVersions"
+	TrackerService_RegenerateCoverLetter_FullMethodName         = "/tracker.TrackerService/RegenerateCoverLetter"
+	TrackerService_RestoreCoverLetterVersion_FullMethodName     = "/tracker.TrackerService/RestoreCoverLetterVersion"
+	TrackerService_RequestFollowUpDraft_FullMethodName          = "/tracker.TrackerService/RequestFollowUpDraft"
+	TrackerService_CreateAttachment_FullMethodName              = "/tracker.TrackerService/CreateAttachment"
+	TrackerService_ListAttachments_FullMethodName               = "/tracker.TrackerService/ListAttachments"
+	TrackerService_GetAttachmentDownloadUrl_FullMethodName      = "/tracker.TrackerService/GetAttachmentDownloadUrl"
+	TrackerService_DeleteAttachment_FullMethodName              = "/tracker.TrackerService/DeleteAttachment"
+	TrackerService_CreateInterview_FullMethodName               = "/tracker.TrackerService/CreateInterview"
+	TrackerService_ListInterviews_FullMethodName                = "/tracker.TrackerService/ListInterviews"
+	TrackerService_UpdateInterview_FullMethodName               = "/tracker.TrackerService/UpdateInterview"
+	TrackerService_RecordInterviewFeedback_FullMethodName       = "/tracker.TrackerService/RecordInterviewFeedback"
+	TrackerService_DeleteInterview_FullMethodName               = "/tracker.TrackerService/DeleteInterview"
+	TrackerService_SetOfferDetails_FullMethodName               = "/tracker.TrackerService/SetOfferDetails"
+	TrackerService_CompareOffers_FullMethodName                 = "/tracker.TrackerService/CompareOffers"
+	TrackerService_AddNegotiationEntry_FullMethodName           = "/tracker.TrackerService/AddNegotiationEntry"
+	TrackerService_ListNegotiationEntries_FullMethodName        = "/tracker.TrackerService/ListNegotiationEntries"
+	TrackerService_DeleteNegotiationEntry_FullMethodName        = "/tracker.TrackerService/DeleteNegotiationEntry"
+	TrackerService_CreateContact_FullMethodName                 = "/tracker.TrackerService/CreateContact"
+	TrackerService_ListContacts_FullMethodName                  = "/tracker.TrackerService/ListContacts"
+	TrackerService_UpdateContact_FullMethodName                 = "/tracker.TrackerService/UpdateContact"
+	TrackerService_DeleteContact_FullMethodName                 = "/tracker.TrackerService/DeleteContact"
+	TrackerService_LinkContact_FullMethodName                   = "/tracker.TrackerService/LinkContact"
+	TrackerService_UnlinkContact_FullMethodName                 = "/tracker.TrackerService/UnlinkContact"
+	TrackerService_ListCompanies_FullMethodName                 = "/tracker.TrackerService/ListCompanies"
+	TrackerService_GetCompanyOverview_FullMethodName            = "/tracker.TrackerService/GetCompanyOverview"
+	TrackerService_GetRejectionStats_FullMethodName             = "/tracker.TrackerService/GetRejectionStats"
+	TrackerService_GetSearchConfigStats_FullMethodName          = "/tracker.TrackerService/GetSearchConfigStats"
+	TrackerService_CountApplicationsByStatus_FullMethodName     = "/tracker.TrackerService/CountApplicationsByStatus"
+	TrackerService_TakeBoardSnapshot_FullMethodName             = "/tracker.TrackerService/TakeBoardSnapshot"
+	TrackerService_CompareSnapshots_FullMethodName              = "/tracker.TrackerService/CompareSnapshots"
+	TrackerService_GetCalendarFeed_FullMethodName               = "/tracker.TrackerService/GetCalendarFeed"
+	TrackerService_RotateCalendarFeedToken_FullMethodName       = "/tracker.TrackerService/RotateCalendarFeedToken"
+	TrackerService_RenderCalendarFeed_FullMethodName            = "/tracker.TrackerService/RenderCalendarFeed"
+	TrackerService_StartGoogleCalendarAuth_FullMethodName       = "/tracker.TrackerService/StartGoogleCalendarAuth"
+	TrackerService_CompleteGoogleCalendarAuth_FullMethodName    = "/tracker.TrackerService/CompleteGoogleCalendarAuth"
+	TrackerService_GetGoogleCalendarStatus_FullMethodName       = "/tracker.TrackerService/GetGoogleCalendarStatus"
+	TrackerService_DisconnectGoogleCalendar_FullMethodName      = "/tracker.TrackerService/DisconnectGoogleCalendar"
+	TrackerService_GetBenchmark_FullMethodName                  = "/tracker.TrackerService/GetBenchmark"
+	TrackerService_GetSettings_FullMethodName                   = "/tracker.TrackerService/GetSettings"
+	TrackerService_UpdateSettings_FullMethodName                = "/tracker.TrackerService/UpdateSettings"
+	TrackerService_SetSearchConfigArchival_FullMethodName       = "/tracker.TrackerService/SetSearchConfigArchival"
+	TrackerService_ReactivateSearchConfig_FullMethodName        = "/tracker.TrackerService/ReactivateSearchConfig"
+	TrackerService_ReopenSearch_FullMethodName                  = "/tracker.TrackerService/ReopenSearch"
+	TrackerService_ListAuditLog_FullMethodName                  = "/tracker.TrackerService/ListAuditLog"
+	TrackerService_ExportUserData_FullMethodName                = "/tracker.TrackerService/ExportUserData"
+	TrackerService_EraseUserData_FullMethodName                 = "/tracker.TrackerService/EraseUserData"
+	TrackerService_CreateBoardShare_FullMethodName              = "/tracker.TrackerService/CreateBoardShare"
+	TrackerService_ListBoardShares_FullMethodName               = "/tracker.TrackerService/ListBoardShares"
+	TrackerService_RevokeBoardShare_FullMethodName              = "/tracker.TrackerService/RevokeBoardShare"
+	TrackerService_ListSharedApplications_FullMethodName        = "/tracker.TrackerService/ListSharedApplications"
+	TrackerService_GetSharedApplication_FullMethodName          = "/tracker.TrackerService/GetSharedApplication"
+	TrackerService_CreateWebhook_FullMethodName                 = "/tracker.TrackerService/CreateWebhook"
+	TrackerService_ListWebhooks_FullMethodName                  = "/tracker.TrackerService/ListWebhooks"
+	TrackerService_DeleteWebhook_FullMethodName                 = "/tracker.TrackerService/DeleteWebhook"
+	TrackerService_ListWebhookDeliveries_FullMethodName         = "/tracker.TrackerService/ListWebhookDeliveries"
+	TrackerService_RetryWebhookDelivery_FullMethodName          = "/tracker.TrackerService/RetryWebhookDelivery"
 )
 
 // TrackerServiceClient is the client API for TrackerService service.
@@ -37,6 +128,10 @@ const (
 //
 // All RPCs require the caller to pass the authenticated user's ID via gRPC
 // metadata key "x-user-id". The Gateway injects this after JWT validation.
+//
+// RPCs that change nothing are marked idempotency_level = NO_SIDE_EFFECTS;
+// every other one is recorded in the audit log and drops the caller's
+// cached views. Mark new read-only RPCs too.
 // ─────────────────────────────────────────────────────────────────────────────
 type TrackerServiceClient interface {
 	// List all applications for the authenticated user.
@@ -44,18 +139,330 @@ type TrackerServiceClient interface {
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
 	// Fetch a single application by ID. Ownership is verified.
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Fetch up to 200 applications by ID in one call (without interviews).
+	// Returns the caller's ones in request order; unknown IDs are skipped.
+	BatchGetApplications(ctx context.Context, in *BatchGetApplicationsRequest, opts ...grpc.CallOption) (*BatchGetApplicationsResponse, error)
+	// The caller's application for a job_feed entry, if they track it — for
+	// "already tracked" badges on the feed. application is unset otherwise.
+	GetApplicationByJobFeedId(ctx context.Context, in *GetApplicationByJobFeedIdRequest, opts ...grpc.CallOption) (*GetApplicationByJobFeedIdResponse, error)
+	// Page through an application's history log, newest entries first, as
+	// typed entries. Lists do not carry history_log; use this instead.
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// Page through everything that happened to an application, newest first,
+	// for its detail screen: its history entries (moves, reminders, analyses,
+	// interview feedback…), notes and interviews in one feed.
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error)
+	// Full-text search over the caller's applications — job title, company,
+	// notes and cover letter — best matches first, with highlighted fragments
+	// of the fields that matched.
+	SearchApplications(ctx context.Context, in *SearchApplicationsRequest, opts ...grpc.CallOption) (*SearchApplicationsResponse, error)
 	// Create a new application from an approved job_feed entry.
-	// Publishes CMD_ANALYZE_JOB to Redis after creation.
+	// Publishes CMD_ANALYZE_JOB to Redis after creation. Also done for every
+	// EVENT_OFFER_ACCEPTED, without confirming duplicates.
+	//
+	// CreateApplication, CreateManualApplication, ImportApplicationEmail,
+	// CloneApplication, CreateApplicationFromTemplate, MoveCard and AddNote accept an optional
+	// idempotency_key: a retried request with the same key returns the
+	// original response (keys are remembered for 24h). A retry while the
+	// original still runs fails with ABORTED (IDEMPOTENT_REQUEST_PENDING); a
+	// key reused for different parameters with INVALID_ARGUMENT
+	// (IDEMPOTENCY_KEY_REUSED).
 	CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Create an application at TO_APPLY for a job found outside JobMate.
+	// The details are stored as a user-scoped, already approved job_feed row
+	// (is_manual = true). Publishes CMD_ANALYZE_JOB like CreateApplication.
+	CreateManualApplication(ctx context.Context, in *CreateManualApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Create a card from a forwarded "your application was received" email
+	// (raw MIME or pasted text): the company, job title and date are read
+	// from it, and the card is created as CreateManualApplication does, at
+	// APPLIED with a move dated when the email was sent (or at TO_APPLY).
+	// INVALID_ARGUMENT when the email names neither a company nor a job.
+	ImportApplicationEmail(ctx context.Context, in *ImportApplicationEmailRequest, opts ...grpc.CallOption) (*ImportApplicationEmailResponse, error)
+	// Create a TO_APPLY card for another job (a feed entry or one found
+	// outside JobMate) with the setup of an existing card: its priority, next
+	// step label (without due date), reminder rule and notes. Duplicates are
+	// detected as in CreateApplication.
+	CloneApplication(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Application templates: named card setups for common kinds of
+	// applications (priority, next step label, reminder rule, scaffold
+	// notes), at most 50 per user. CreateApplicationFromTemplate creates a
+	// card with one, as CloneApplication does.
+	ListApplicationTemplates(ctx context.Context, in *ListApplicationTemplatesRequest, opts ...grpc.CallOption) (*ListApplicationTemplatesResponse, error)
+	CreateApplicationTemplate(ctx context.Context, in *CreateApplicationTemplateRequest, opts ...grpc.CallOption) (*ApplicationTemplate, error)
+	// Replace a template's name and setup; cards created from it keep theirs.
+	UpdateApplicationTemplate(ctx context.Context, in *UpdateApplicationTemplateRequest, opts ...grpc.CallOption) (*ApplicationTemplate, error)
+	DeleteApplicationTemplate(ctx context.Context, in *DeleteApplicationTemplateRequest, opts ...grpc.CallOption) (*DeleteApplicationTemplateResponse, error)
+	CreateApplicationFromTemplate(ctx context.Context, in *CreateApplicationFromTemplateRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
 	// Move a Kanban card to a new status (state machine validated).
-	// On HIRED: archives the parent search_config (sets is_active=false, completed_at=NOW()).
+	// On HIRED: deactivates the parent search_config unless the caller's
+	// preferences keep it (TrackerSettings.archive_search_on_hired,
+	// SetSearchConfigArchival); the decision is recorded in the history.
 	MoveCard(ctx context.Context, in *MoveCardRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
-	// Add or replace the free-text note on an application.
+	// Revert the most recent status change of a card (e.g. a mistaken drag),
+	// if it happened within the deployment's undo grace period (default 15 min).
+	// Appends a compensating history entry marked "undo": true.
+	UndoLastMove(ctx context.Context, in *UndoLastMoveRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Revert the last move, rating change or note edit of a card, if it was
+	// made within the undo grace period: a move as UndoLastMove does, a
+	// rating (stars, criteria, review) or a note's text by restoring the
+	// previous values, recorded in the history as an UNDO entry. An undo
+	// cannot itself be undone.
+	UndoLastAction(ctx context.Context, in *UndoLastActionRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Move several cards to the same status in one transaction.
+	// Each transition is validated individually; invalid items are reported in
+	// the per-item results and left untouched while the rest are applied.
+	BulkMove(ctx context.Context, in *BulkMoveRequest, opts ...grpc.CallOption) (*BulkMoveResponse, error)
+	// Append a timestamped note to an application. The application's
+	// user_notes field mirrors its most recent note.
+	//
+	// Notes must be valid UTF-8; control characters other than newline and tab
+	// are stripped. Oversized or invalid text fails with INVALID_ARGUMENT and a
+	// google.rpc.BadRequest detail naming the field.
 	AddNote(ctx context.Context, in *AddNoteRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
-	// Set a 1–5 star rating on an application.
+	// The application's notes, oldest first.
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
+	// Replace the text of a note (sets edited_at).
+	EditNote(ctx context.Context, in *EditNoteRequest, opts ...grpc.CallOption) (*Note, error)
+	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	// Rate an application: 1–5 stars, or per criterion (role fit,
+	// compensation, culture, commute) with the stars computed, optionally
+	// with a short review. Replaces the previous rating entirely.
 	RateApplication(ctx context.Context, in *RateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Set the LOW/MEDIUM/HIGH priority of an application (default MEDIUM).
+	SetPriority(ctx context.Context, in *SetPriorityRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Set or clear the due date of the application's next step (take-home
+	// test, offer deadline, …). Unset due_at clears the next step.
+	SetNextStep(ctx context.Context, in *SetNextStepRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
 	// Set or clear a relance reminder timestamp.
 	SetRelanceReminder(ctx context.Context, in *SetRelanceReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Push the relance reminder back by some hours/days (from now if it already
+	// fired) or dismiss it — the actions of a reminder notification. Both are
+	// logged in history_log (REMINDER_SNOOZED / REMINDER_CLEARED).
+	SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	ClearReminder(ctx context.Context, in *ClearReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Set or clear (unset rule) a reminder rule: relance reminders relative to
+	// the card's status, e.g. "10 days after applying" or "every 7 days until
+	// the status changes".
+	SetReminderRule(ctx context.Context, in *SetReminderRuleRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Patch several user-editable fields at once, atomically. Only the fields
+	// named in update_mask are written; a masked field left at its zero value
+	// is cleared. Supersedes RateApplication, SetPriority,
+	// SetRelanceReminder and SetNextStep, which remain for older clients.
+	UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Hide an application from the board without deleting it.
+	// Archived applications keep their status, notes and history, are skipped by
+	// ListApplications unless include_archived is set, and cannot be moved.
+	ArchiveApplication(ctx context.Context, in *ArchiveApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Put an archived application back on the board.
+	RestoreApplication(ctx context.Context, in *RestoreApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Fold a duplicate card (merged_application_id) into application_id and
+	// delete it. Notes, attachments, interviews, contacts, cover letter
+	// versions, the negotiation log and the offer (unless application_id has
+	// one) move over; rating, reminder and next step are kept unless unset;
+	// history is combined and the merge logged. Publishes
+	// EVENT_APPLICATION_MERGED.
+	MergeApplications(ctx context.Context, in *MergeApplicationsRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Custom board columns ("Technical Test", "Reference Check", …).
+	// Each column subdivides one canonical status; the state machine still
+	// operates on statuses. Cards without a column sit in the status' default lane.
+	ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error)
+	CreateColumn(ctx context.Context, in *CreateColumnRequest, opts ...grpc.CallOption) (*BoardColumn, error)
+	// Rename and/or reposition a column. Its status cannot change.
+	UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*BoardColumn, error)
+	// Delete a column — its cards fall back to the default lane.
+	DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error)
+	// Place a card in a column (empty column_id = default lane). When the
+	// column belongs to another status the card is transitioned first, under
+	// the same rules as MoveCard.
+	MoveToColumn(ctx context.Context, in *MoveToColumnRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Re-run the AI analysis of an application (republishes CMD_ANALYZE_JOB),
+	// e.g. after the job description was enriched or the CV changed.
+	// Limited to once per cooldown (default 10 min) per application: earlier
+	// calls fail with RESOURCE_EXHAUSTED carrying a google.rpc.RetryInfo.
+	ReanalyzeApplication(ctx context.Context, in *ReanalyzeApplicationRequest, opts ...grpc.CallOption) (*ReanalyzeApplicationResponse, error)
+	// Cover letters are versioned: every letter the AI Coach writes (and every
+	// restore) is kept. generated_cover_letter on the application is the
+	// current version.
+	ListCoverLetterVersions(ctx context.Context, in *ListCoverLetterVersionsRequest, opts ...grpc.CallOption) (*ListCoverLetterVersionsResponse, error)
+	// Ask the AI Coach for a new cover letter (publishes CMD_GENERATE_COVER_LETTER).
+	// Returns immediately; the letter arrives as a new version, announced by
+	// EVENT_COVER_LETTER_GENERATED.
+	RegenerateCoverLetter(ctx context.Context, in *RegenerateCoverLetterRequest, opts ...grpc.CallOption) (*RegenerateCoverLetterResponse, error)
+	// Make an earlier version current again (recorded as a new version).
+	RestoreCoverLetterVersion(ctx context.Context, in *RestoreCoverLetterVersionRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Ask the AI Coach to draft a follow-up message (publishes
+	// CMD_GENERATE_FOLLOWUP with the card's status, job, days since the last
+	// contact and the tone). Returns immediately; the draft replaces
+	// followup_draft on the application, announced by EVENT_FOLLOWUP_DRAFTED.
+	// Limited to once per cooldown (default 2 min) per application.
+	RequestFollowUpDraft(ctx context.Context, in *RequestFollowUpDraftRequest, opts ...grpc.CallOption) (*RequestFollowUpDraftResponse, error)
+	// Attachments: files (the CV version sent, portfolio, offer letter…)
+	// stored in S3/MinIO. Bytes never go through the API — CreateAttachment
+	// returns a presigned URL the client PUTs the file to, with exactly the
+	// declared Content-Type and Content-Length. Accepted: PDF, Word, ODT,
+	// plain text, PNG and JPEG up to 10 MiB, within a per-user storage quota.
+	// Fails with FAILED_PRECONDITION when no object store is configured.
+	CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*CreateAttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// A short-lived (15 min) download URL for one attachment. When the
+	// deployment scans attachments for malware, FAILED_PRECONDITION until the
+	// file is scanned (ATTACHMENT_SCAN_PENDING) or when it was quarantined
+	// (ATTACHMENT_QUARANTINED).
+	GetAttachmentDownloadUrl(ctx context.Context, in *GetAttachmentDownloadUrlRequest, opts ...grpc.CallOption) (*AttachmentUrl, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// Interviews: the rounds of an application's interview process. They are
+	// also returned on GetApplication. Creating one without a round numbers it
+	// after the application's last interview.
+	CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// The application's interviews, by round.
+	ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (*ListInterviewsResponse, error)
+	// Partially update an interview — only the paths in update_mask are written.
+	UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// Record the debrief of an interview and its outcome (replacing any earlier
+	// feedback). Also appends an INTERVIEW_FEEDBACK entry to history_log.
+	RecordInterviewFeedback(ctx context.Context, in *RecordInterviewFeedbackRequest, opts ...grpc.CallOption) (*Interview, error)
+	DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*DeleteInterviewResponse, error)
+	// Record or replace the terms of an application's offer, once it reached
+	// OFFER (INVALID_ARGUMENT at other statuses than OFFER and HIRED). The offer
+	// is also returned on GetApplication.
+	SetOfferDetails(ctx context.Context, in *SetOfferDetailsRequest, opts ...grpc.CallOption) (*Offer, error)
+	// Current offers (applications at OFFER, not archived) side by side, with
+	// their total yearly compensation ranked among offers in the same currency
+	// and their negotiation logs. Best offer first.
+	CompareOffers(ctx context.Context, in *CompareOffersRequest, opts ...grpc.CallOption) (*CompareOffersResponse, error)
+	// Negotiation log of an offer: counter-offers, revised offers and notes.
+	// Adding requires the application to be at OFFER (or HIRED).
+	AddNegotiationEntry(ctx context.Context, in *AddNegotiationEntryRequest, opts ...grpc.CallOption) (*NegotiationEntry, error)
+	ListNegotiationEntries(ctx context.Context, in *ListNegotiationEntriesRequest, opts ...grpc.CallOption) (*ListNegotiationEntriesResponse, error)
+	DeleteNegotiationEntry(ctx context.Context, in *DeleteNegotiationEntryRequest, opts ...grpc.CallOption) (*DeleteNegotiationEntryResponse, error)
+	// Contacts: recruiters and hiring managers. A contact belongs to the user
+	// and can be linked to any number of their applications.
+	CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	// The caller's contacts by name, optionally narrowed to one application or
+	// company.
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// Partially update a contact — only the paths in update_mask are written.
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
+	// Link / unlink a contact and an application (both idempotent).
+	LinkContact(ctx context.Context, in *LinkContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	UnlinkContact(ctx context.Context, in *UnlinkContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	// Companies the caller applied to, most recently active first, with their
+	// applications counted by status (archived ones included). Companies are
+	// matched by name, ignoring case and extra whitespace.
+	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+	// The caller's full history with one company: every application, the
+	// contacts working there or linked to those applications, and their notes.
+	// NOT_FOUND when the caller never applied there.
+	GetCompanyOverview(ctx context.Context, in *GetCompanyOverviewRequest, opts ...grpc.CallOption) (*CompanyOverview, error)
+	// The caller's REJECTED applications (archived ones included) counted by
+	// rejection reason and stage, most frequent first. Empty reason/stage =
+	// not recorded.
+	GetRejectionStats(ctx context.Context, in *GetRejectionStatsRequest, opts ...grpc.CallOption) (*GetRejectionStatsResponse, error)
+	// For each of the caller's search configs (inactive ones included): how
+	// many offers Discovery found and how many became applications,
+	// interviews and hires. Configs with the most hires, then interviews,
+	// then applications come first.
+	GetSearchConfigStats(ctx context.Context, in *GetSearchConfigStatsRequest, opts ...grpc.CallOption) (*GetSearchConfigStatsResponse, error)
+	// How many of the caller's active (not archived) applications are in each
+	// status, for the board's column headers. Cached; any mutation refreshes it.
+	CountApplicationsByStatus(ctx context.Context, in *CountApplicationsByStatusRequest, opts ...grpc.CallOption) (*CountApplicationsByStatusResponse, error)
+	// Store a snapshot of the caller's board — every card's status, whether
+	// it is archived, its company and title — as it is now. The Tracker also
+	// takes one every BOARD_SNAPSHOT_INTERVAL.
+	TakeBoardSnapshot(ctx context.Context, in *TakeBoardSnapshotRequest, opts ...grpc.CallOption) (*BoardSnapshot, error)
+	// What changed on the caller's board between since and until: cards
+	// added, removed, moved, archived and restored, from the latest snapshot
+	// taken at or before since (or the earliest one after it) to the latest
+	// one taken at or before until — or, without until, the board as it is
+	// now. NOT_FOUND when the caller has no snapshot.
+	CompareSnapshots(ctx context.Context, in *CompareSnapshotsRequest, opts ...grpc.CallOption) (*CompareSnapshotsResponse, error)
+	// The caller's secret iCalendar feed (relance reminders, interviews, offer
+	// deadlines), created on first call. Calendar apps subscribe to its path
+	// on the public API host; RotateCalendarFeedToken revokes the old URL.
+	// Only the token's hash is stored: token and path are only returned by
+	// the call creating the feed and by RotateCalendarFeedToken.
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	// The iCalendar document of a feed, for the Gateway's public
+	// /calendar/<token>.ics route. Authorized by the token alone (no x-user-id);
+	// NOT_FOUND for unknown tokens.
+	RenderCalendarFeed(ctx context.Context, in *RenderCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeedContent, error)
+	// Two-way Google Calendar sync (FAILED_PRECONDITION when the deployment has
+	// no Google OAuth client). StartGoogleCalendarAuth returns the consent page
+	// URL; Google then redirects to the Gateway, which calls
+	// CompleteGoogleCalendarAuth with the state and code it received (no
+	// x-user-id: the single-use state identifies the user). Interviews, relance
+	// reminders and offer deadlines are pushed to a "JobMate" calendar and
+	// reschedules made there are applied back, every GOOGLE_CALENDAR_SYNC_INTERVAL.
+	StartGoogleCalendarAuth(ctx context.Context, in *StartGoogleCalendarAuthRequest, opts ...grpc.CallOption) (*GoogleCalendarAuthUrl, error)
+	CompleteGoogleCalendarAuth(ctx context.Context, in *CompleteGoogleCalendarAuthRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error)
+	GetGoogleCalendarStatus(ctx context.Context, in *GetGoogleCalendarStatusRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error)
+	DisconnectGoogleCalendar(ctx context.Context, in *DisconnectGoogleCalendarRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error)
+	// How the caller's funnel for a job title compares with the anonymous
+	// median of users sharing their statistics (recomputed nightly). Falls
+	// back to all job titles (job_title empty) when the title's cohort is too
+	// small. FAILED_PRECONDITION unless the caller opted in with
+	// share_benchmarks; NOT_FOUND until enough users did.
+	GetBenchmark(ctx context.Context, in *GetBenchmarkRequest, opts ...grpc.CallOption) (*Benchmark, error)
+	// Read the caller's tracker preferences (deployment defaults when unset).
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*TrackerSettings, error)
+	// Partially update the caller's tracker preferences — unset fields are kept.
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*TrackerSettings, error)
+	// Set whether moving one of the search's cards to HIRED deactivates it
+	// (default true; TrackerSettings.archive_search_on_hired must allow it too).
+	SetSearchConfigArchival(ctx context.Context, in *SetSearchConfigArchivalRequest, opts ...grpc.CallOption) (*SearchConfigArchival, error)
+	// Reactivate the search a card came from, e.g. after its move to HIRED
+	// deactivated it. INVALID_ARGUMENT if that search is active or the card
+	// has none.
+	ReactivateSearchConfig(ctx context.Context, in *ReactivateSearchConfigRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Reactivate one of the caller's searches, e.g. when the hire that
+	// archived it falls through, and have Discovery scan it right away
+	// (CMD_SCRAPE_CONFIG). The cards whose move to HIRED archived it record
+	// the reactivation. INVALID_ARGUMENT if the search is already active.
+	ReopenSearch(ctx context.Context, in *ReopenSearchRequest, opts ...grpc.CallOption) (*ReopenSearchResponse, error)
+	// Query the audit log of mutating operations (tracker RPCs, discovery
+	// endpoints), newest first. Callers see their own entries; audit
+	// administrators (AUDIT_ADMIN_USER_IDS) anyone's.
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+	// Data-subject access: everything the tracker stores about the caller
+	// (applications with their history and reminders, notes, interviews,
+	// contacts, offers, attachment metadata, settings, audit entries…) as one
+	// JSON document.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	// Data-subject erasure: deletes or anonymizes all of the caller's tracker
+	// data, attachment files and audit entries included. Also done for every
+	// EVENT_USER_DELETED published by the auth service.
+	EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error)
+	// Grant read-only access to the caller's board (e.g. to a career coach)
+	// until expires_at (at most 90 days ahead): to a JobMate user, or — without
+	// grantee_user_id — to whoever holds the returned token.
+	CreateBoardShare(ctx context.Context, in *CreateBoardShareRequest, opts ...grpc.CallOption) (*BoardShare, error)
+	// The caller's live shares or, with received, those granted to them.
+	ListBoardShares(ctx context.Context, in *ListBoardSharesRequest, opts ...grpc.CallOption) (*ListBoardSharesResponse, error)
+	// End one of the caller's shares at once.
+	RevokeBoardShare(ctx context.Context, in *RevokeBoardShareRequest, opts ...grpc.CallOption) (*RevokeBoardShareResponse, error)
+	// Read a board shared with the caller (share_id of a share granted to
+	// them, or share_token): ListApplications / GetApplication as its owner
+	// would, restricted to the share's statuses and never archived cards.
+	// NOT_FOUND for unknown, expired or revoked shares.
+	ListSharedApplications(ctx context.Context, in *ListSharedApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	GetSharedApplication(ctx context.Context, in *GetSharedApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error)
+	// Register an https URL (at most 10 per user) to receive the caller's
+	// EVENT_CARD_MOVED, EVENT_APPLICATION_CREATED and/or EVENT_RELANCE_DUE
+	// events. Each delivery POSTs the event's JSON, signed in the
+	// X-JobMate-Signature header ("sha256=" + hex HMAC-SHA256 of
+	// "<X-JobMate-Timestamp>.<body>") with the returned secret, shown only
+	// once. FAILED_PRECONDITION when webhooks are not configured.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Delete a webhook and its pending deliveries.
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// The latest deliveries of a webhook, newest first. Failed deliveries are
+	// retried with exponential backoff, then marked DEAD after 8 attempts.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Queue a DEAD delivery again.
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*RetryWebhookDeliveryResponse, error)
 }
 
 type trackerServiceClient struct {
@@ -86,6 +493,56 @@ func (c *trackerServiceClient) GetApplication(ctx context.Context, in *GetApplic
 	return out, nil
 }
 
+func (c *trackerServiceClient) BatchGetApplications(ctx context.Context, in *BatchGetApplicationsRequest, opts ...grpc.CallOption) (*BatchGetApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetApplicationsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_BatchGetApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetApplicationByJobFeedId(ctx context.Context, in *GetApplicationByJobFeedIdRequest, opts ...grpc.CallOption) (*GetApplicationByJobFeedIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationByJobFeedIdResponse)
+	err := c.cc.Invoke(ctx, TrackerService_GetApplicationByJobFeedId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, TrackerService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimelineResponse)
+	err := c.cc.Invoke(ctx, TrackerService_GetTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) SearchApplications(ctx context.Context, in *SearchApplicationsRequest, opts ...grpc.CallOption) (*SearchApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchApplicationsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_SearchApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackerServiceClient) CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplicationProto)
@@ -96,6 +553,86 @@ func (c *trackerServiceClient) CreateApplication(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *trackerServiceClient) CreateManualApplication(ctx context.Context, in *CreateManualApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_CreateManualApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ImportApplicationEmail(ctx context.Context, in *ImportApplicationEmailRequest, opts ...grpc.CallOption) (*ImportApplicationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportApplicationEmailResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ImportApplicationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CloneApplication(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_CloneApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListApplicationTemplates(ctx context.Context, in *ListApplicationTemplatesRequest, opts ...grpc.CallOption) (*ListApplicationTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationTemplatesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListApplicationTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateApplicationTemplate(ctx context.Context, in *CreateApplicationTemplateRequest, opts ...grpc.CallOption) (*ApplicationTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationTemplate)
+	err := c.cc.Invoke(ctx, TrackerService_CreateApplicationTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpdateApplicationTemplate(ctx context.Context, in *UpdateApplicationTemplateRequest, opts ...grpc.CallOption) (*ApplicationTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationTemplate)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateApplicationTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteApplicationTemplate(ctx context.Context, in *DeleteApplicationTemplateRequest, opts ...grpc.CallOption) (*DeleteApplicationTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApplicationTemplateResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteApplicationTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateApplicationFromTemplate(ctx context.Context, in *CreateApplicationFromTemplateRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_CreateApplicationFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackerServiceClient) MoveCard(ctx context.Context, in *MoveCardRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplicationProto)
@@ -106,6 +643,36 @@ func (c *trackerServiceClient) MoveCard(ctx context.Context, in *MoveCardRequest
 	return out, nil
 }
 
+func (c *trackerServiceClient) UndoLastMove(ctx context.Context, in *UndoLastMoveRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_UndoLastMove_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UndoLastAction(ctx context.Context, in *UndoLastActionRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_UndoLastAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) BulkMove(ctx context.Context, in *BulkMoveRequest, opts ...grpc.CallOption) (*BulkMoveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkMoveResponse)
+	err := c.cc.Invoke(ctx, TrackerService_BulkMove_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackerServiceClient) AddNote(ctx context.Context, in *AddNoteRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplicationProto)
@@ -116,6 +683,36 @@ func (c *trackerServiceClient) AddNote(ctx context.Context, in *AddNoteRequest,
 	return out, nil
 }
 
+func (c *trackerServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) EditNote(ctx context.Context, in *EditNoteRequest, opts ...grpc.CallOption) (*Note, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Note)
+	err := c.cc.Invoke(ctx, TrackerService_EditNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNoteResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackerServiceClient) RateApplication(ctx context.Context, in *RateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplicationProto)
@@ -126,6 +723,26 @@ func (c *trackerServiceClient) RateApplication(ctx context.Context, in *RateAppl
 	return out, nil
 }
 
+func (c *trackerServiceClient) SetPriority(ctx context.Context, in *SetPriorityRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_SetPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) SetNextStep(ctx context.Context, in *SetNextStepRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_SetNextStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackerServiceClient) SetRelanceReminder(ctx context.Context, in *SetRelanceReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplicationProto)
@@ -136,208 +753,3135 @@ func (c *trackerServiceClient) SetRelanceReminder(ctx context.Context, in *SetRe
 	return out, nil
 }
 
-// TrackerServiceServer is the server API for TrackerService service.
-// All implementations must embed UnimplementedTrackerServiceServer
-// for forward compatibility.
-//
-// ─────────────────────────────────────────────────────────────────────────────
-// TrackerService — Kanban CRM for job applications
-//
-// All RPCs require the caller to pass the authenticated user's ID via gRPC
-// metadata key "x-user-id". The Gateway injects this after JWT validation.
-// ─────────────────────────────────────────────────────────────────────────────
-type TrackerServiceServer interface {
-	// List all applications for the authenticated user.
-	// Optional status_filter narrows to a single Kanban column.
-	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
-	// Fetch a single application by ID. Ownership is verified.
-	GetApplication(context.Context, *GetApplicationRequest) (*ApplicationProto, error)
-	// Create a new application from an approved job_feed entry.
-	// Publishes CMD_ANALYZE_JOB to Redis after creation.
-	CreateApplication(context.Context, *CreateApplicationRequest) (*ApplicationProto, error)
-	// Move a Kanban card to a new status (state machine validated).
-	// On HIRED: archives the parent search_config (sets is_active=false, completed_at=NOW()).
-	MoveCard(context.Context, *MoveCardRequest) (*ApplicationProto, error)
-	// Add or replace the free-text note on an application.
-	AddNote(context.Context, *AddNoteRequest) (*ApplicationProto, error)
-	// Set a 1–5 star rating on an application.
-	RateApplication(context.Context, *RateApplicationRequest) (*ApplicationProto, error)
-	// Set or clear a relance reminder timestamp.
-	SetRelanceReminder(context.Context, *SetRelanceReminderRequest) (*ApplicationProto, error)
-	mustEmbedUnimplementedTrackerServiceServer()
+func (c *trackerServiceClient) SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_SnoozeReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedTrackerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTrackerServiceServer struct{}
+func (c *trackerServiceClient) ClearReminder(ctx context.Context, in *ClearReminderRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_ClearReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedTrackerServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListApplications not implemented")
+func (c *trackerServiceClient) SetReminderRule(ctx context.Context, in *SetReminderRuleRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_SetReminderRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetApplication not implemented")
+
+func (c *trackerServiceClient) UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) CreateApplication(context.Context, *CreateApplicationRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateApplication not implemented")
+
+func (c *trackerServiceClient) ArchiveApplication(ctx context.Context, in *ArchiveApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_ArchiveApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) MoveCard(context.Context, *MoveCardRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method MoveCard not implemented")
+
+func (c *trackerServiceClient) RestoreApplication(ctx context.Context, in *RestoreApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_RestoreApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) AddNote(context.Context, *AddNoteRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddNote not implemented")
+
+func (c *trackerServiceClient) MergeApplications(ctx context.Context, in *MergeApplicationsRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_MergeApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) RateApplication(context.Context, *RateApplicationRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method RateApplication not implemented")
+
+func (c *trackerServiceClient) ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListColumnsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTrackerServiceServer) SetRelanceReminder(context.Context, *SetRelanceReminderRequest) (*ApplicationProto, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetRelanceReminder not implemented")
+
+func (c *trackerServiceClient) CreateColumn(ctx context.Context, in *CreateColumnRequest, opts ...grpc.CallOption) (*BoardColumn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardColumn)
+	err := c.cc.Invoke(ctx, TrackerService_CreateColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*BoardColumn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardColumn)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteColumnResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) MoveToColumn(ctx context.Context, in *MoveToColumnRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_MoveToColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ReanalyzeApplication(ctx context.Context, in *ReanalyzeApplicationRequest, opts ...grpc.CallOption) (*ReanalyzeApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReanalyzeApplicationResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ReanalyzeApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListCoverLetterVersions(ctx context.Context, in *ListCoverLetterVersionsRequest, opts ...grpc.CallOption) (*ListCoverLetterVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoverLetterVersionsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListCoverLetterVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RegenerateCoverLetter(ctx context.Context, in *RegenerateCoverLetterRequest, opts ...grpc.CallOption) (*RegenerateCoverLetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateCoverLetterResponse)
+	err := c.cc.Invoke(ctx, TrackerService_RegenerateCoverLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RestoreCoverLetterVersion(ctx context.Context, in *RestoreCoverLetterVersionRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_RestoreCoverLetterVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RequestFollowUpDraft(ctx context.Context, in *RequestFollowUpDraftRequest, opts ...grpc.CallOption) (*RequestFollowUpDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestFollowUpDraftResponse)
+	err := c.cc.Invoke(ctx, TrackerService_RequestFollowUpDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*CreateAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttachmentResponse)
+	err := c.cc.Invoke(ctx, TrackerService_CreateAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetAttachmentDownloadUrl(ctx context.Context, in *GetAttachmentDownloadUrlRequest, opts ...grpc.CallOption) (*AttachmentUrl, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentUrl)
+	err := c.cc.Invoke(ctx, TrackerService_GetAttachmentDownloadUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, TrackerService_CreateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (*ListInterviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterviewsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListInterviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RecordInterviewFeedback(ctx context.Context, in *RecordInterviewFeedbackRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, TrackerService_RecordInterviewFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*DeleteInterviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInterviewResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) SetOfferDetails(ctx context.Context, in *SetOfferDetailsRequest, opts ...grpc.CallOption) (*Offer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, TrackerService_SetOfferDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CompareOffers(ctx context.Context, in *CompareOffersRequest, opts ...grpc.CallOption) (*CompareOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareOffersResponse)
+	err := c.cc.Invoke(ctx, TrackerService_CompareOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) AddNegotiationEntry(ctx context.Context, in *AddNegotiationEntryRequest, opts ...grpc.CallOption) (*NegotiationEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NegotiationEntry)
+	err := c.cc.Invoke(ctx, TrackerService_AddNegotiationEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListNegotiationEntries(ctx context.Context, in *ListNegotiationEntriesRequest, opts ...grpc.CallOption) (*ListNegotiationEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNegotiationEntriesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListNegotiationEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteNegotiationEntry(ctx context.Context, in *DeleteNegotiationEntryRequest, opts ...grpc.CallOption) (*DeleteNegotiationEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNegotiationEntryResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteNegotiationEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateContact(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, TrackerService_CreateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContactResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) LinkContact(ctx context.Context, in *LinkContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, TrackerService_LinkContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UnlinkContact(ctx context.Context, in *UnlinkContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, TrackerService_UnlinkContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompaniesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListCompanies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetCompanyOverview(ctx context.Context, in *GetCompanyOverviewRequest, opts ...grpc.CallOption) (*CompanyOverview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyOverview)
+	err := c.cc.Invoke(ctx, TrackerService_GetCompanyOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetRejectionStats(ctx context.Context, in *GetRejectionStatsRequest, opts ...grpc.CallOption) (*GetRejectionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRejectionStatsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_GetRejectionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetSearchConfigStats(ctx context.Context, in *GetSearchConfigStatsRequest, opts ...grpc.CallOption) (*GetSearchConfigStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchConfigStatsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_GetSearchConfigStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CountApplicationsByStatus(ctx context.Context, in *CountApplicationsByStatusRequest, opts ...grpc.CallOption) (*CountApplicationsByStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountApplicationsByStatusResponse)
+	err := c.cc.Invoke(ctx, TrackerService_CountApplicationsByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) TakeBoardSnapshot(ctx context.Context, in *TakeBoardSnapshotRequest, opts ...grpc.CallOption) (*BoardSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardSnapshot)
+	err := c.cc.Invoke(ctx, TrackerService_TakeBoardSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CompareSnapshots(ctx context.Context, in *CompareSnapshotsRequest, opts ...grpc.CallOption) (*CompareSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareSnapshotsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_CompareSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, TrackerService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, TrackerService_RotateCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RenderCalendarFeed(ctx context.Context, in *RenderCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeedContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeedContent)
+	err := c.cc.Invoke(ctx, TrackerService_RenderCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) StartGoogleCalendarAuth(ctx context.Context, in *StartGoogleCalendarAuthRequest, opts ...grpc.CallOption) (*GoogleCalendarAuthUrl, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoogleCalendarAuthUrl)
+	err := c.cc.Invoke(ctx, TrackerService_StartGoogleCalendarAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CompleteGoogleCalendarAuth(ctx context.Context, in *CompleteGoogleCalendarAuthRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoogleCalendarStatus)
+	err := c.cc.Invoke(ctx, TrackerService_CompleteGoogleCalendarAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetGoogleCalendarStatus(ctx context.Context, in *GetGoogleCalendarStatusRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoogleCalendarStatus)
+	err := c.cc.Invoke(ctx, TrackerService_GetGoogleCalendarStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DisconnectGoogleCalendar(ctx context.Context, in *DisconnectGoogleCalendarRequest, opts ...grpc.CallOption) (*GoogleCalendarStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoogleCalendarStatus)
+	err := c.cc.Invoke(ctx, TrackerService_DisconnectGoogleCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetBenchmark(ctx context.Context, in *GetBenchmarkRequest, opts ...grpc.CallOption) (*Benchmark, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Benchmark)
+	err := c.cc.Invoke(ctx, TrackerService_GetBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*TrackerSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackerSettings)
+	err := c.cc.Invoke(ctx, TrackerService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*TrackerSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackerSettings)
+	err := c.cc.Invoke(ctx, TrackerService_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) SetSearchConfigArchival(ctx context.Context, in *SetSearchConfigArchivalRequest, opts ...grpc.CallOption) (*SearchConfigArchival, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchConfigArchival)
+	err := c.cc.Invoke(ctx, TrackerService_SetSearchConfigArchival_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ReactivateSearchConfig(ctx context.Context, in *ReactivateSearchConfigRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_ReactivateSearchConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ReopenSearch(ctx context.Context, in *ReopenSearchRequest, opts ...grpc.CallOption) (*ReopenSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenSearchResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ReopenSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, TrackerService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, TrackerService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateBoardShare(ctx context.Context, in *CreateBoardShareRequest, opts ...grpc.CallOption) (*BoardShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardShare)
+	err := c.cc.Invoke(ctx, TrackerService_CreateBoardShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListBoardShares(ctx context.Context, in *ListBoardSharesRequest, opts ...grpc.CallOption) (*ListBoardSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardSharesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListBoardShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RevokeBoardShare(ctx context.Context, in *RevokeBoardShareRequest, opts ...grpc.CallOption) (*RevokeBoardShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeBoardShareResponse)
+	err := c.cc.Invoke(ctx, TrackerService_RevokeBoardShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListSharedApplications(ctx context.Context, in *ListSharedApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListSharedApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) GetSharedApplication(ctx context.Context, in *GetSharedApplicationRequest, opts ...grpc.CallOption) (*ApplicationProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationProto)
+	err := c.cc.Invoke(ctx, TrackerService_GetSharedApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, TrackerService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, TrackerService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, TrackerService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackerServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*RetryWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, TrackerService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackerServiceServer is the server API for TrackerService service.
+// All implementations must embed UnimplementedTrackerServiceServer
+// for forward compatibility.
+//
+// ─────────────────────────────────────────────────────────────────────────────
+// TrackerService — Kanban CRM for job applications
+//
+// All RPCs require the caller to pass the authenticated user's ID via gRPC
+// metadata key "x-user-id". The Gateway injects this after JWT validation.
+//
+// RPCs that change nothing are marked idempotency_level = NO_SIDE_EFFECTS;
+// every other one is recorded in the audit log and drops the caller's
+// cached views. Mark new read-only RPCs too.
+// ─────────────────────────────────────────────────────────────────────────────
+type TrackerServiceServer interface {
+	// List all applications for the authenticated user.
+	// Optional status_filter narrows to a single Kanban column.
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	// Fetch a single application by ID. Ownership is verified.
+	GetApplication(context.Context, *GetApplicationRequest) (*ApplicationProto, error)
+	// Fetch up to 200 applications by ID in one call (without interviews).
+	// Returns the caller's ones in request order; unknown IDs are skipped.
+	BatchGetApplications(context.Context, *BatchGetApplicationsRequest) (*BatchGetApplicationsResponse, error)
+	// The caller's application for a job_feed entry, if they track it — for
+	// "already tracked" badges on the feed. application is unset otherwise.
+	GetApplicationByJobFeedId(context.Context, *GetApplicationByJobFeedIdRequest) (*GetApplicationByJobFeedIdResponse, error)
+	// Page through an application's history log, newest entries first, as
+	// typed entries. Lists do not carry history_log; use this instead.
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// Page through everything that happened to an application, newest first,
+	// for its detail screen: its history entries (moves, reminders, analyses,
+	// interview feedback…), notes and interviews in one feed.
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error)
+	// Full-text search over the caller's applications — job title, company,
+	// notes and cover letter — best matches first, with highlighted fragments
+	// of the fields that matched.
+	SearchApplications(context.Context, *SearchApplicationsRequest) (*SearchApplicationsResponse, error)
+	// Create a new application from an approved job_feed entry.
+	// Publishes CMD_ANALYZE_JOB to Redis after creation. Also done for every
+	// EVENT_OFFER_ACCEPTED, without confirming duplicates.
+	//
+	// CreateApplication, CreateManualApplication, ImportApplicationEmail,
+	// CloneApplication, CreateApplicationFromTemplate, MoveCard and AddNote accept an optional
+	// idempotency_key: a retried request with the same key returns the
+	// original response (keys are remembered for 24h). A retry while the
+	// original still runs fails with ABORTED (IDEMPOTENT_REQUEST_PENDING); a
+	// key reused for different parameters with INVALID_ARGUMENT
+	// (IDEMPOTENCY_KEY_REUSED).
+	CreateApplication(context.Context, *CreateApplicationRequest) (*ApplicationProto, error)
+	// Create an application at TO_APPLY for a job found outside JobMate.
+	// The details are stored as a user-scoped, already approved job_feed row
+	// (is_manual = true). Publishes CMD_ANALYZE_JOB like CreateApplication.
+	CreateManualApplication(context.Context, *CreateManualApplicationRequest) (*ApplicationProto, error)
+	// Create a card from a forwarded "your application was received" email
+	// (raw MIME or pasted text): the company, job title and date are read
+	// from it, and the card is created as CreateManualApplication does, at
+	// APPLIED with a move dated when the email was sent (or at TO_APPLY).
+	// INVALID_ARGUMENT when the email names neither a company nor a job.
+	ImportApplicationEmail(context.Context, *ImportApplicationEmailRequest) (*ImportApplicationEmailResponse, error)
+	// Create a TO_APPLY card for another job (a feed entry or one found
+	// outside JobMate) with the setup of an existing card: its priority, next
+	// step label (without due date), reminder rule and notes. Duplicates are
+	// detected as in CreateApplication.
+	CloneApplication(context.Context, *CloneApplicationRequest) (*ApplicationProto, error)
+	// Application templates: named card setups for common kinds of
+	// applications (priority, next step label, reminder rule, scaffold
+	// notes), at most 50 per user. CreateApplicationFromTemplate creates a
+	// card with one, as CloneApplication does.
+	ListApplicationTemplates(context.Context, *ListApplicationTemplatesRequest) (*ListApplicationTemplatesResponse, error)
+	CreateApplicationTemplate(context.Context, *CreateApplicationTemplateRequest) (*ApplicationTemplate, error)
+	// Replace a template's name and setup; cards created from it keep theirs.
+	UpdateApplicationTemplate(context.Context, *UpdateApplicationTemplateRequest) (*ApplicationTemplate, error)
+	DeleteApplicationTemplate(context.Context, *DeleteApplicationTemplateRequest) (*DeleteApplicationTemplateResponse, error)
+	CreateApplicationFromTemplate(context.Context, *CreateApplicationFromTemplateRequest) (*ApplicationProto, error)
+	// Move a Kanban card to a new status (state machine validated).
+	// On HIRED: deactivates the parent search_config unless the caller's
+	// preferences keep it (TrackerSettings.archive_search_on_hired,
+	// SetSearchConfigArchival); the decision is recorded in the history.
+	MoveCard(context.Context, *MoveCardRequest) (*ApplicationProto, error)
+	// Revert the most recent status change of a card (e.g. a mistaken drag),
+	// if it happened within the deployment's undo grace period (default 15 min).
+	// Appends a compensating history entry marked "undo": true.
+	UndoLastMove(context.Context, *UndoLastMoveRequest) (*ApplicationProto, error)
+	// Revert the last move, rating change or note edit of a card, if it was
+	// made within the undo grace period: a move as UndoLastMove does, a
+	// rating (stars, criteria, review) or a note's text by restoring the
+	// previous values, recorded in the history as an UNDO entry. An undo
+	// cannot itself be undone.
+	UndoLastAction(context.Context, *UndoLastActionRequest) (*ApplicationProto, error)
+	// Move several cards to the same status in one transaction.
+	// Each transition is validated individually; invalid items are reported in
+	// the per-item results and left untouched while the rest are applied.
+	BulkMove(context.Context, *BulkMoveRequest) (*BulkMoveResponse, error)
+	// Append a timestamped note to an application. The application's
+	// user_notes field mirrors its most recent note.
+	//
+	// Notes must be valid UTF-8; control characters other than newline and tab
+	// are stripped. Oversized or invalid text fails with INVALID_ARGUMENT and a
+	// google.rpc.BadRequest detail naming the field.
+	AddNote(context.Context, *AddNoteRequest) (*ApplicationProto, error)
+	// The application's notes, oldest first.
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	// Replace the text of a note (sets edited_at).
+	EditNote(context.Context, *EditNoteRequest) (*Note, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	// Rate an application: 1–5 stars, or per criterion (role fit,
+	// compensation, culture, commute) with the stars computed, optionally
+	// with a short review. Replaces the previous rating entirely.
+	RateApplication(context.Context, *RateApplicationRequest) (*ApplicationProto, error)
+	// Set the LOW/MEDIUM/HIGH priority of an application (default MEDIUM).
+	SetPriority(context.Context, *SetPriorityRequest) (*ApplicationProto, error)
+	// Set or clear the due date of the application's next step (take-home
+	// test, offer deadline, …). Unset due_at clears the next step.
+	SetNextStep(context.Context, *SetNextStepRequest) (*ApplicationProto, error)
+	// Set or clear a relance reminder timestamp.
+	SetRelanceReminder(context.Context, *SetRelanceReminderRequest) (*ApplicationProto, error)
+	// Push the relance reminder back by some hours/days (from now if it already
+	// fired) or dismiss it — the actions of a reminder notification. Both are
+	// logged in history_log (REMINDER_SNOOZED / REMINDER_CLEARED).
+	SnoozeReminder(context.Context, *SnoozeReminderRequest) (*ApplicationProto, error)
+	ClearReminder(context.Context, *ClearReminderRequest) (*ApplicationProto, error)
+	// Set or clear (unset rule) a reminder rule: relance reminders relative to
+	// the card's status, e.g. "10 days after applying" or "every 7 days until
+	// the status changes".
+	SetReminderRule(context.Context, *SetReminderRuleRequest) (*ApplicationProto, error)
+	// Patch several user-editable fields at once, atomically. Only the fields
+	// named in update_mask are written; a masked field left at its zero value
+	// is cleared. Supersedes RateApplication, SetPriority,
+	// SetRelanceReminder and SetNextStep, which remain for older clients.
+	UpdateApplication(context.Context, *UpdateApplicationRequest) (*ApplicationProto, error)
+	// Hide an application from the board without deleting it.
+	// Archived applications keep their status, notes and history, are skipped by
+	// ListApplications unless include_archived is set, and cannot be moved.
+	ArchiveApplication(context.Context, *ArchiveApplicationRequest) (*ApplicationProto, error)
+	// Put an archived application back on the board.
+	RestoreApplication(context.Context, *RestoreApplicationRequest) (*ApplicationProto, error)
+	// Fold a duplicate card (merged_application_id) into application_id and
+	// delete it. Notes, attachments, interviews, contacts, cover letter
+	// versions, the negotiation log and the offer (unless application_id has
+	// one) move over; rating, reminder and next step are kept unless unset;
+	// history is combined and the merge logged. Publishes
+	// EVENT_APPLICATION_MERGED.
+	MergeApplications(context.Context, *MergeApplicationsRequest) (*ApplicationProto, error)
+	// Custom board columns ("Technical Test", "Reference Check", …).
+	// Each column subdivides one canonical status; the state machine still
+	// operates on statuses. Cards without a column sit in the status' default lane.
+	ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error)
+	CreateColumn(context.Context, *CreateColumnRequest) (*BoardColumn, error)
+	// Rename and/or reposition a column. Its status cannot change.
+	UpdateColumn(context.Context, *UpdateColumnRequest) (*BoardColumn, error)
+	// Delete a column — its cards fall back to the default lane.
+	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	// Place a card in a column (empty column_id = default lane). When the
+	// column belongs to another status the card is transitioned first, under
+	// the same rules as MoveCard.
+	MoveToColumn(context.Context, *MoveToColumnRequest) (*ApplicationProto, error)
+	// Re-run the AI analysis of an application (republishes CMD_ANALYZE_JOB),
+	// e.g. after the job description was enriched or the CV changed.
+	// Limited to once per cooldown (default 10 min) per application: earlier
+	// calls fail with RESOURCE_EXHAUSTED carrying a google.rpc.RetryInfo.
+	ReanalyzeApplication(context.Context, *ReanalyzeApplicationRequest) (*ReanalyzeApplicationResponse, error)
+	// Cover letters are versioned: every letter the AI Coach writes (and every
+	// restore) is kept. generated_cover_letter on the application is the
+	// current version.
+	ListCoverLetterVersions(context.Context, *ListCoverLetterVersionsRequest) (*ListCoverLetterVersionsResponse, error)
+	// Ask the AI Coach for a new cover letter (publishes CMD_GENERATE_COVER_LETTER).
+	// Returns immediately; the letter arrives as a new version, announced by
+	// EVENT_COVER_LETTER_GENERATED.
+	RegenerateCoverLetter(context.Context, *RegenerateCoverLetterRequest) (*RegenerateCoverLetterResponse, error)
+	// Make an earlier version current again (recorded as a new version).
+	RestoreCoverLetterVersion(context.Context, *RestoreCoverLetterVersionRequest) (*ApplicationProto, error)
+	// Ask the AI Coach to draft a follow-up message (publishes
+	// CMD_GENERATE_FOLLOWUP with the card's status, job, days since the last
+	// contact and the tone). Returns immediately; the draft replaces
+	// followup_draft on the application, announced by EVENT_FOLLOWUP_DRAFTED.
+	// Limited to once per cooldown (default 2 min) per application.
+	RequestFollowUpDraft(context.Context, *RequestFollowUpDraftRequest) (*RequestFollowUpDraftResponse, error)
+	// Attachments: files (the CV version sent, portfolio, offer letter…)
+	// stored in S3/MinIO. Bytes never go through the API — CreateAttachment
+	// returns a presigned URL the client PUTs the file to, with exactly the
+	// declared Content-Type and Content-Length. Accepted: PDF, Word, ODT,
+	// plain text, PNG and JPEG up to 10 MiB, within a per-user storage quota.
+	// Fails with FAILED_PRECONDITION when no object store is configured.
+	CreateAttachment(context.Context, *CreateAttachmentRequest) (*CreateAttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// A short-lived (15 min) download URL for one attachment. When the
+	// deployment scans attachments for malware, FAILED_PRECONDITION until the
+	// file is scanned (ATTACHMENT_SCAN_PENDING) or when it was quarantined
+	// (ATTACHMENT_QUARANTINED).
+	GetAttachmentDownloadUrl(context.Context, *GetAttachmentDownloadUrlRequest) (*AttachmentUrl, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// Interviews: the rounds of an application's interview process. They are
+	// also returned on GetApplication. Creating one without a round numbers it
+	// after the application's last interview.
+	CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error)
+	// The application's interviews, by round.
+	ListInterviews(context.Context, *ListInterviewsRequest) (*ListInterviewsResponse, error)
+	// Partially update an interview — only the paths in update_mask are written.
+	UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error)
+	// Record the debrief of an interview and its outcome (replacing any earlier
+	// feedback). Also appends an INTERVIEW_FEEDBACK entry to history_log.
+	RecordInterviewFeedback(context.Context, *RecordInterviewFeedbackRequest) (*Interview, error)
+	DeleteInterview(context.Context, *DeleteInterviewRequest) (*DeleteInterviewResponse, error)
+	// Record or replace the terms of an application's offer, once it reached
+	// OFFER (INVALID_ARGUMENT at other statuses than OFFER and HIRED). The offer
+	// is also returned on GetApplication.
+	SetOfferDetails(context.Context, *SetOfferDetailsRequest) (*Offer, error)
+	// Current offers (applications at OFFER, not archived) side by side, with
+	// their total yearly compensation ranked among offers in the same currency
+	// and their negotiation logs. Best offer first.
+	CompareOffers(context.Context, *CompareOffersRequest) (*CompareOffersResponse, error)
+	// Negotiation log of an offer: counter-offers, revised offers and notes.
+	// Adding requires the application to be at OFFER (or HIRED).
+	AddNegotiationEntry(context.Context, *AddNegotiationEntryRequest) (*NegotiationEntry, error)
+	ListNegotiationEntries(context.Context, *ListNegotiationEntriesRequest) (*ListNegotiationEntriesResponse, error)
+	DeleteNegotiationEntry(context.Context, *DeleteNegotiationEntryRequest) (*DeleteNegotiationEntryResponse, error)
+	// Contacts: recruiters and hiring managers. A contact belongs to the user
+	// and can be linked to any number of their applications.
+	CreateContact(context.Context, *CreateContactRequest) (*Contact, error)
+	// The caller's contacts by name, optionally narrowed to one application or
+	// company.
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	// Partially update a contact — only the paths in update_mask are written.
+	UpdateContact(context.Context, *UpdateContactRequest) (*Contact, error)
+	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
+	// Link / unlink a contact and an application (both idempotent).
+	LinkContact(context.Context, *LinkContactRequest) (*Contact, error)
+	UnlinkContact(context.Context, *UnlinkContactRequest) (*Contact, error)
+	// Companies the caller applied to, most recently active first, with their
+	// applications counted by status (archived ones included). Companies are
+	// matched by name, ignoring case and extra whitespace.
+	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
+	// The caller's full history with one company: every application, the
+	// contacts working there or linked to those applications, and their notes.
+	// NOT_FOUND when the caller never applied there.
+	GetCompanyOverview(context.Context, *GetCompanyOverviewRequest) (*CompanyOverview, error)
+	// The caller's REJECTED applications (archived ones included) counted by
+	// rejection reason and stage, most frequent first. Empty reason/stage =
+	// not recorded.
+	GetRejectionStats(context.Context, *GetRejectionStatsRequest) (*GetRejectionStatsResponse, error)
+	// For each of the caller's search configs (inactive ones included): how
+	// many offers Discovery found and how many became applications,
+	// interviews and hires. Configs with the most hires, then interviews,
+	// then applications come first.
+	GetSearchConfigStats(context.Context, *GetSearchConfigStatsRequest) (*GetSearchConfigStatsResponse, error)
+	// How many of the caller's active (not archived) applications are in each
+	// status, for the board's column headers. Cached; any mutation refreshes it.
+	CountApplicationsByStatus(context.Context, *CountApplicationsByStatusRequest) (*CountApplicationsByStatusResponse, error)
+	// Store a snapshot of the caller's board — every card's status, whether
+	// it is archived, its company and title — as it is now. The Tracker also
+	// takes one every BOARD_SNAPSHOT_INTERVAL.
+	TakeBoardSnapshot(context.Context, *TakeBoardSnapshotRequest) (*BoardSnapshot, error)
+	// What changed on the caller's board between since and until: cards
+	// added, removed, moved, archived and restored, from the latest snapshot
+	// taken at or before since (or the earliest one after it) to the latest
+	// one taken at or before until — or, without until, the board as it is
+	// now. NOT_FOUND when the caller has no snapshot.
+	CompareSnapshots(context.Context, *CompareSnapshotsRequest) (*CompareSnapshotsResponse, error)
+	// The caller's secret iCalendar feed (relance reminders, interviews, offer
+	// deadlines), created on first call. Calendar apps subscribe to its path
+	// on the public API host; RotateCalendarFeedToken revokes the old URL.
+	// Only the token's hash is stored: token and path are only returned by
+	// the call creating the feed and by RotateCalendarFeedToken.
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*CalendarFeed, error)
+	RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*CalendarFeed, error)
+	// The iCalendar document of a feed, for the Gateway's public
+	// /calendar/<token>.ics route. Authorized by the token alone (no x-user-id);
+	// NOT_FOUND for unknown tokens.
+	RenderCalendarFeed(context.Context, *RenderCalendarFeedRequest) (*CalendarFeedContent, error)
+	// Two-way Google Calendar sync (FAILED_PRECONDITION when the deployment has
+	// no Google OAuth client). StartGoogleCalendarAuth returns the consent page
+	// URL; Google then redirects to the Gateway, which calls
+	// CompleteGoogleCalendarAuth with the state and code it received (no
+	// x-user-id: the single-use state identifies the user). Interviews, relance
+	// reminders and offer deadlines are pushed to a "JobMate" calendar and
+	// reschedules made there are applied back, every GOOGLE_CALENDAR_SYNC_INTERVAL.
+	StartGoogleCalendarAuth(context.Context, *StartGoogleCalendarAuthRequest) (*GoogleCalendarAuthUrl, error)
+	CompleteGoogleCalendarAuth(context.Context, *CompleteGoogleCalendarAuthRequest) (*GoogleCalendarStatus, error)
+	GetGoogleCalendarStatus(context.Context, *GetGoogleCalendarStatusRequest) (*GoogleCalendarStatus, error)
+	DisconnectGoogleCalendar(context.Context, *DisconnectGoogleCalendarRequest) (*GoogleCalendarStatus, error)
+	// How the caller's funnel for a job title compares with the anonymous
+	// median of users sharing their statistics (recomputed nightly). Falls
+	// back to all job titles (job_title empty) when the title's cohort is too
+	// small. FAILED_PRECONDITION unless the caller opted in with
+	// share_benchmarks; NOT_FOUND until enough users did.
+	GetBenchmark(context.Context, *GetBenchmarkRequest) (*Benchmark, error)
+	// Read the caller's tracker preferences (deployment defaults when unset).
+	GetSettings(context.Context, *GetSettingsRequest) (*TrackerSettings, error)
+	// Partially update the caller's tracker preferences — unset fields are kept.
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*TrackerSettings, error)
+	// Set whether moving one of the search's cards to HIRED deactivates it
+	// (default true; TrackerSettings.archive_search_on_hired must allow it too).
+	SetSearchConfigArchival(context.Context, *SetSearchConfigArchivalRequest) (*SearchConfigArchival, error)
+	// Reactivate the search a card came from, e.g. after its move to HIRED
+	// deactivated it. INVALID_ARGUMENT if that search is active or the card
+	// has none.
+	ReactivateSearchConfig(context.Context, *ReactivateSearchConfigRequest) (*ApplicationProto, error)
+	// Reactivate one of the caller's searches, e.g. when the hire that
+	// archived it falls through, and have Discovery scan it right away
+	// (CMD_SCRAPE_CONFIG). The cards whose move to HIRED archived it record
+	// the reactivation. INVALID_ARGUMENT if the search is already active.
+	ReopenSearch(context.Context, *ReopenSearchRequest) (*ReopenSearchResponse, error)
+	// Query the audit log of mutating operations (tracker RPCs, discovery
+	// endpoints), newest first. Callers see their own entries; audit
+	// administrators (AUDIT_ADMIN_USER_IDS) anyone's.
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	// Data-subject access: everything the tracker stores about the caller
+	// (applications with their history and reminders, notes, interviews,
+	// contacts, offers, attachment metadata, settings, audit entries…) as one
+	// JSON document.
+	ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error)
+	// Data-subject erasure: deletes or anonymizes all of the caller's tracker
+	// data, attachment files and audit entries included. Also done for every
+	// EVENT_USER_DELETED published by the auth service.
+	EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error)
+	// Grant read-only access to the caller's board (e.g. to a career coach)
+	// until expires_at (at most 90 days ahead): to a JobMate user, or — without
+	// grantee_user_id — to whoever holds the returned token.
+	CreateBoardShare(context.Context, *CreateBoardShareRequest) (*BoardShare, error)
+	// The caller's live shares or, with received, those granted to them.
+	ListBoardShares(context.Context, *ListBoardSharesRequest) (*ListBoardSharesResponse, error)
+	// End one of the caller's shares at once.
+	RevokeBoardShare(context.Context, *RevokeBoardShareRequest) (*RevokeBoardShareResponse, error)
+	// Read a board shared with the caller (share_id of a share granted to
+	// them, or share_token): ListApplications / GetApplication as its owner
+	// would, restricted to the share's statuses and never archived cards.
+	// NOT_FOUND for unknown, expired or revoked shares.
+	ListSharedApplications(context.Context, *ListSharedApplicationsRequest) (*ListApplicationsResponse, error)
+	GetSharedApplication(context.Context, *GetSharedApplicationRequest) (*ApplicationProto, error)
+	// Register an https URL (at most 10 per user) to receive the caller's
+	// EVENT_CARD_MOVED, EVENT_APPLICATION_CREATED and/or EVENT_RELANCE_DUE
+	// events. Each delivery POSTs the event's JSON, signed in the
+	// X-JobMate-Signature header ("sha256=" + hex HMAC-SHA256 of
+	// "<X-JobMate-Timestamp>.<body>") with the returned secret, shown only
+	// once. FAILED_PRECONDITION when webhooks are not configured.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Delete a webhook and its pending deliveries.
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// The latest deliveries of a webhook, newest first. Failed deliveries are
+	// retried with exponential backoff, then marked DEAD after 8 attempts.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Queue a DEAD delivery again.
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*RetryWebhookDeliveryResponse, error)
+	mustEmbedUnimplementedTrackerServiceServer()
+}
+
+// UnimplementedTrackerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrackerServiceServer struct{}
+
+func (UnimplementedTrackerServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) BatchGetApplications(context.Context, *BatchGetApplicationsRequest) (*BatchGetApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetApplications not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetApplicationByJobFeedId(context.Context, *GetApplicationByJobFeedIdRequest) (*GetApplicationByJobFeedIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApplicationByJobFeedId not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedTrackerServiceServer) SearchApplications(context.Context, *SearchApplicationsRequest) (*SearchApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchApplications not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateApplication(context.Context, *CreateApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateManualApplication(context.Context, *CreateManualApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateManualApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) ImportApplicationEmail(context.Context, *ImportApplicationEmailRequest) (*ImportApplicationEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportApplicationEmail not implemented")
+}
+func (UnimplementedTrackerServiceServer) CloneApplication(context.Context, *CloneApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListApplicationTemplates(context.Context, *ListApplicationTemplatesRequest) (*ListApplicationTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApplicationTemplates not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateApplicationTemplate(context.Context, *CreateApplicationTemplateRequest) (*ApplicationTemplate, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApplicationTemplate not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateApplicationTemplate(context.Context, *UpdateApplicationTemplateRequest) (*ApplicationTemplate, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateApplicationTemplate not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteApplicationTemplate(context.Context, *DeleteApplicationTemplateRequest) (*DeleteApplicationTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteApplicationTemplate not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateApplicationFromTemplate(context.Context, *CreateApplicationFromTemplateRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApplicationFromTemplate not implemented")
+}
+func (UnimplementedTrackerServiceServer) MoveCard(context.Context, *MoveCardRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveCard not implemented")
+}
+func (UnimplementedTrackerServiceServer) UndoLastMove(context.Context, *UndoLastMoveRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndoLastMove not implemented")
+}
+func (UnimplementedTrackerServiceServer) UndoLastAction(context.Context, *UndoLastActionRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndoLastAction not implemented")
+}
+func (UnimplementedTrackerServiceServer) BulkMove(context.Context, *BulkMoveRequest) (*BulkMoveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkMove not implemented")
+}
+func (UnimplementedTrackerServiceServer) AddNote(context.Context, *AddNoteRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddNote not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedTrackerServiceServer) EditNote(context.Context, *EditNoteRequest) (*Note, error) {
+	return nil, status.Error(codes.Unimplemented, "method EditNote not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedTrackerServiceServer) RateApplication(context.Context, *RateApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method RateApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetPriority(context.Context, *SetPriorityRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriority not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetNextStep(context.Context, *SetNextStepRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNextStep not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetRelanceReminder(context.Context, *SetRelanceReminderRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRelanceReminder not implemented")
+}
+func (UnimplementedTrackerServiceServer) SnoozeReminder(context.Context, *SnoozeReminderRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnoozeReminder not implemented")
+}
+func (UnimplementedTrackerServiceServer) ClearReminder(context.Context, *ClearReminderRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearReminder not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetReminderRule(context.Context, *SetReminderRuleRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetReminderRule not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateApplication(context.Context, *UpdateApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) ArchiveApplication(context.Context, *ArchiveApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) RestoreApplication(context.Context, *RestoreApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) MergeApplications(context.Context, *MergeApplicationsRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeApplications not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListColumns not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateColumn(context.Context, *CreateColumnRequest) (*BoardColumn, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateColumn not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateColumn(context.Context, *UpdateColumnRequest) (*BoardColumn, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateColumn not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteColumn not implemented")
+}
+func (UnimplementedTrackerServiceServer) MoveToColumn(context.Context, *MoveToColumnRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveToColumn not implemented")
+}
+func (UnimplementedTrackerServiceServer) ReanalyzeApplication(context.Context, *ReanalyzeApplicationRequest) (*ReanalyzeApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReanalyzeApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListCoverLetterVersions(context.Context, *ListCoverLetterVersionsRequest) (*ListCoverLetterVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoverLetterVersions not implemented")
+}
+func (UnimplementedTrackerServiceServer) RegenerateCoverLetter(context.Context, *RegenerateCoverLetterRequest) (*RegenerateCoverLetterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateCoverLetter not implemented")
+}
+func (UnimplementedTrackerServiceServer) RestoreCoverLetterVersion(context.Context, *RestoreCoverLetterVersionRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreCoverLetterVersion not implemented")
+}
+func (UnimplementedTrackerServiceServer) RequestFollowUpDraft(context.Context, *RequestFollowUpDraftRequest) (*RequestFollowUpDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestFollowUpDraft not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateAttachment(context.Context, *CreateAttachmentRequest) (*CreateAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAttachment not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetAttachmentDownloadUrl(context.Context, *GetAttachmentDownloadUrlRequest) (*AttachmentUrl, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachmentDownloadUrl not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInterview not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListInterviews(context.Context, *ListInterviewsRequest) (*ListInterviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInterviews not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInterview not implemented")
+}
+func (UnimplementedTrackerServiceServer) RecordInterviewFeedback(context.Context, *RecordInterviewFeedbackRequest) (*Interview, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordInterviewFeedback not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteInterview(context.Context, *DeleteInterviewRequest) (*DeleteInterviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteInterview not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetOfferDetails(context.Context, *SetOfferDetailsRequest) (*Offer, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOfferDetails not implemented")
+}
+func (UnimplementedTrackerServiceServer) CompareOffers(context.Context, *CompareOffersRequest) (*CompareOffersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareOffers not implemented")
+}
+func (UnimplementedTrackerServiceServer) AddNegotiationEntry(context.Context, *AddNegotiationEntryRequest) (*NegotiationEntry, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddNegotiationEntry not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListNegotiationEntries(context.Context, *ListNegotiationEntriesRequest) (*ListNegotiationEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNegotiationEntries not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteNegotiationEntry(context.Context, *DeleteNegotiationEntryRequest) (*DeleteNegotiationEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNegotiationEntry not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateContact(context.Context, *CreateContactRequest) (*Contact, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContact not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateContact(context.Context, *UpdateContactRequest) (*Contact, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteContact not implemented")
+}
+func (UnimplementedTrackerServiceServer) LinkContact(context.Context, *LinkContactRequest) (*Contact, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkContact not implemented")
+}
+func (UnimplementedTrackerServiceServer) UnlinkContact(context.Context, *UnlinkContactRequest) (*Contact, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkContact not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCompanies not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetCompanyOverview(context.Context, *GetCompanyOverviewRequest) (*CompanyOverview, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCompanyOverview not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetRejectionStats(context.Context, *GetRejectionStatsRequest) (*GetRejectionStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRejectionStats not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetSearchConfigStats(context.Context, *GetSearchConfigStatsRequest) (*GetSearchConfigStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSearchConfigStats not implemented")
+}
+func (UnimplementedTrackerServiceServer) CountApplicationsByStatus(context.Context, *CountApplicationsByStatusRequest) (*CountApplicationsByStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CountApplicationsByStatus not implemented")
+}
+func (UnimplementedTrackerServiceServer) TakeBoardSnapshot(context.Context, *TakeBoardSnapshotRequest) (*BoardSnapshot, error) {
+	return nil, status.Error(codes.Unimplemented, "method TakeBoardSnapshot not implemented")
+}
+func (UnimplementedTrackerServiceServer) CompareSnapshots(context.Context, *CompareSnapshotsRequest) (*CompareSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareSnapshots not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*CalendarFeed, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedTrackerServiceServer) RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*CalendarFeed, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateCalendarFeedToken not implemented")
+}
+func (UnimplementedTrackerServiceServer) RenderCalendarFeed(context.Context, *RenderCalendarFeedRequest) (*CalendarFeedContent, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderCalendarFeed not implemented")
+}
+func (UnimplementedTrackerServiceServer) StartGoogleCalendarAuth(context.Context, *StartGoogleCalendarAuthRequest) (*GoogleCalendarAuthUrl, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartGoogleCalendarAuth not implemented")
+}
+func (UnimplementedTrackerServiceServer) CompleteGoogleCalendarAuth(context.Context, *CompleteGoogleCalendarAuthRequest) (*GoogleCalendarStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteGoogleCalendarAuth not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetGoogleCalendarStatus(context.Context, *GetGoogleCalendarStatusRequest) (*GoogleCalendarStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoogleCalendarStatus not implemented")
+}
+func (UnimplementedTrackerServiceServer) DisconnectGoogleCalendar(context.Context, *DisconnectGoogleCalendarRequest) (*GoogleCalendarStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisconnectGoogleCalendar not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetBenchmark(context.Context, *GetBenchmarkRequest) (*Benchmark, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBenchmark not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*TrackerSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedTrackerServiceServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*TrackerSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedTrackerServiceServer) SetSearchConfigArchival(context.Context, *SetSearchConfigArchivalRequest) (*SearchConfigArchival, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSearchConfigArchival not implemented")
+}
+func (UnimplementedTrackerServiceServer) ReactivateSearchConfig(context.Context, *ReactivateSearchConfigRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReactivateSearchConfig not implemented")
+}
+func (UnimplementedTrackerServiceServer) ReopenSearch(context.Context, *ReopenSearchRequest) (*ReopenSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReopenSearch not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedTrackerServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedTrackerServiceServer) EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUserData not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateBoardShare(context.Context, *CreateBoardShareRequest) (*BoardShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBoardShare not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListBoardShares(context.Context, *ListBoardSharesRequest) (*ListBoardSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBoardShares not implemented")
+}
+func (UnimplementedTrackerServiceServer) RevokeBoardShare(context.Context, *RevokeBoardShareRequest) (*RevokeBoardShareResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeBoardShare not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListSharedApplications(context.Context, *ListSharedApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSharedApplications not implemented")
+}
+func (UnimplementedTrackerServiceServer) GetSharedApplication(context.Context, *GetSharedApplicationRequest) (*ApplicationProto, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedApplication not implemented")
+}
+func (UnimplementedTrackerServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedTrackerServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedTrackerServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedTrackerServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*RetryWebhookDeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
+func (UnimplementedTrackerServiceServer) mustEmbedUnimplementedTrackerServiceServer() {}
+func (UnimplementedTrackerServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTrackerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackerServiceServer will
+// result in compilation errors.
+type UnsafeTrackerServiceServer interface {
+	mustEmbedUnimplementedTrackerServiceServer()
+}
+
+func RegisterTrackerServiceServer(s grpc.ServiceRegistrar, srv TrackerServiceServer) {
+	// If the following call panics, it indicates UnimplementedTrackerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrackerService_ServiceDesc, srv)
+}
+
+func _TrackerService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_BatchGetApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).BatchGetApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_BatchGetApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).BatchGetApplications(ctx, req.(*BatchGetApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetApplicationByJobFeedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationByJobFeedIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetApplicationByJobFeedId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetApplicationByJobFeedId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetApplicationByJobFeedId(ctx, req.(*GetApplicationByJobFeedIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetTimeline(ctx, req.(*GetTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SearchApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SearchApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SearchApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SearchApplications(ctx, req.(*SearchApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateApplication(ctx, req.(*CreateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateManualApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManualApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateManualApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateManualApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateManualApplication(ctx, req.(*CreateManualApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ImportApplicationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportApplicationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ImportApplicationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ImportApplicationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ImportApplicationEmail(ctx, req.(*ImportApplicationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CloneApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CloneApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CloneApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CloneApplication(ctx, req.(*CloneApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListApplicationTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListApplicationTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListApplicationTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListApplicationTemplates(ctx, req.(*ListApplicationTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateApplicationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateApplicationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateApplicationTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateApplicationTemplate(ctx, req.(*CreateApplicationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateApplicationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateApplicationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateApplicationTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateApplicationTemplate(ctx, req.(*UpdateApplicationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteApplicationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteApplicationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteApplicationTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteApplicationTemplate(ctx, req.(*DeleteApplicationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateApplicationFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateApplicationFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateApplicationFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateApplicationFromTemplate(ctx, req.(*CreateApplicationFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_MoveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).MoveCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_MoveCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).MoveCard(ctx, req.(*MoveCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UndoLastMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoLastMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UndoLastMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UndoLastMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UndoLastMove(ctx, req.(*UndoLastMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UndoLastAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoLastActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UndoLastAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UndoLastAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UndoLastAction(ctx, req.(*UndoLastActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_BulkMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).BulkMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_BulkMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).BulkMove(ctx, req.(*BulkMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).AddNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_AddNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).AddNote(ctx, req.(*AddNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_EditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).EditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_EditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).EditNote(ctx, req.(*EditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RateApplication(ctx, req.(*RateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetPriority(ctx, req.(*SetPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetNextStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNextStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetNextStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetNextStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetNextStep(ctx, req.(*SetNextStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetRelanceReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRelanceReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetRelanceReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetRelanceReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetRelanceReminder(ctx, req.(*SetRelanceReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SnoozeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SnoozeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SnoozeReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SnoozeReminder(ctx, req.(*SnoozeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ClearReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ClearReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ClearReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ClearReminder(ctx, req.(*ClearReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetReminderRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReminderRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetReminderRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetReminderRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetReminderRule(ctx, req.(*SetReminderRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateApplication(ctx, req.(*UpdateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ArchiveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ArchiveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ArchiveApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ArchiveApplication(ctx, req.(*ArchiveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RestoreApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RestoreApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RestoreApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RestoreApplication(ctx, req.(*RestoreApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_MergeApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).MergeApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_MergeApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).MergeApplications(ctx, req.(*MergeApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListColumns(ctx, req.(*ListColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateColumn(ctx, req.(*CreateColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateColumn(ctx, req.(*UpdateColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteColumn(ctx, req.(*DeleteColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_MoveToColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).MoveToColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_MoveToColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).MoveToColumn(ctx, req.(*MoveToColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ReanalyzeApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReanalyzeApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ReanalyzeApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ReanalyzeApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ReanalyzeApplication(ctx, req.(*ReanalyzeApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListCoverLetterVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoverLetterVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListCoverLetterVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListCoverLetterVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListCoverLetterVersions(ctx, req.(*ListCoverLetterVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RegenerateCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateCoverLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RegenerateCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RegenerateCoverLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RegenerateCoverLetter(ctx, req.(*RegenerateCoverLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RestoreCoverLetterVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCoverLetterVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RestoreCoverLetterVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RestoreCoverLetterVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RestoreCoverLetterVersion(ctx, req.(*RestoreCoverLetterVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RequestFollowUpDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestFollowUpDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RequestFollowUpDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RequestFollowUpDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RequestFollowUpDraft(ctx, req.(*RequestFollowUpDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateAttachment(ctx, req.(*CreateAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetAttachmentDownloadUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentDownloadUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetAttachmentDownloadUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetAttachmentDownloadUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetAttachmentDownloadUrl(ctx, req.(*GetAttachmentDownloadUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateInterview(ctx, req.(*CreateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListInterviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListInterviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListInterviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListInterviews(ctx, req.(*ListInterviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateInterview(ctx, req.(*UpdateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RecordInterviewFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordInterviewFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RecordInterviewFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RecordInterviewFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RecordInterviewFeedback(ctx, req.(*RecordInterviewFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteInterview(ctx, req.(*DeleteInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetOfferDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOfferDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetOfferDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetOfferDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetOfferDetails(ctx, req.(*SetOfferDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CompareOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CompareOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CompareOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CompareOffers(ctx, req.(*CompareOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_AddNegotiationEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNegotiationEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).AddNegotiationEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_AddNegotiationEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).AddNegotiationEntry(ctx, req.(*AddNegotiationEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListNegotiationEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNegotiationEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListNegotiationEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListNegotiationEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListNegotiationEntries(ctx, req.(*ListNegotiationEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteNegotiationEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNegotiationEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteNegotiationEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteNegotiationEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteNegotiationEntry(ctx, req.(*DeleteNegotiationEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateContact(ctx, req.(*CreateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DeleteContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_LinkContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).LinkContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_LinkContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).LinkContact(ctx, req.(*LinkContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UnlinkContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UnlinkContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UnlinkContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UnlinkContact(ctx, req.(*UnlinkContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListCompanies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListCompanies(ctx, req.(*ListCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetCompanyOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetCompanyOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetCompanyOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetCompanyOverview(ctx, req.(*GetCompanyOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetRejectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRejectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetRejectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetRejectionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetRejectionStats(ctx, req.(*GetRejectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetSearchConfigStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchConfigStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetSearchConfigStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetSearchConfigStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetSearchConfigStats(ctx, req.(*GetSearchConfigStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CountApplicationsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountApplicationsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CountApplicationsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CountApplicationsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CountApplicationsByStatus(ctx, req.(*CountApplicationsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_TakeBoardSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeBoardSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).TakeBoardSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_TakeBoardSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).TakeBoardSnapshot(ctx, req.(*TakeBoardSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CompareSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CompareSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CompareSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CompareSnapshots(ctx, req.(*CompareSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RotateCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RotateCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RotateCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RotateCalendarFeedToken(ctx, req.(*RotateCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_RenderCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RenderCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RenderCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RenderCalendarFeed(ctx, req.(*RenderCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_StartGoogleCalendarAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGoogleCalendarAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).StartGoogleCalendarAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_StartGoogleCalendarAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).StartGoogleCalendarAuth(ctx, req.(*StartGoogleCalendarAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CompleteGoogleCalendarAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteGoogleCalendarAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CompleteGoogleCalendarAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CompleteGoogleCalendarAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CompleteGoogleCalendarAuth(ctx, req.(*CompleteGoogleCalendarAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetGoogleCalendarStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoogleCalendarStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetGoogleCalendarStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetGoogleCalendarStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetGoogleCalendarStatus(ctx, req.(*GetGoogleCalendarStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_DisconnectGoogleCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectGoogleCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).DisconnectGoogleCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_DisconnectGoogleCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).DisconnectGoogleCalendar(ctx, req.(*DisconnectGoogleCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetBenchmark(ctx, req.(*GetBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).GetSettings(ctx, req.(*GetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_SetSearchConfigArchival_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSearchConfigArchivalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).SetSearchConfigArchival(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_SetSearchConfigArchival_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).SetSearchConfigArchival(ctx, req.(*SetSearchConfigArchivalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ReactivateSearchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateSearchConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ReactivateSearchConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ReactivateSearchConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ReactivateSearchConfig(ctx, req.(*ReactivateSearchConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ReopenSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ReopenSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ReopenSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ReopenSearch(ctx, req.(*ReopenSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).EraseUserData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackerService_CreateBoardShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoardShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).CreateBoardShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_CreateBoardShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).CreateBoardShare(ctx, req.(*CreateBoardShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTrackerServiceServer) mustEmbedUnimplementedTrackerServiceServer() {}
-func (UnimplementedTrackerServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeTrackerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TrackerServiceServer will
-// result in compilation errors.
-type UnsafeTrackerServiceServer interface {
-	mustEmbedUnimplementedTrackerServiceServer()
+func _TrackerService_ListBoardShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).ListBoardShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_ListBoardShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).ListBoardShares(ctx, req.(*ListBoardSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTrackerServiceServer(s grpc.ServiceRegistrar, srv TrackerServiceServer) {
-	// If the following call panics, it indicates UnimplementedTrackerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _TrackerService_RevokeBoardShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBoardShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TrackerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TrackerServiceServer).RevokeBoardShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackerService_RevokeBoardShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServiceServer).RevokeBoardShare(ctx, req.(*RevokeBoardShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApplicationsRequest)
+func _TrackerService_ListSharedApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).ListApplications(ctx, in)
+		return srv.(TrackerServiceServer).ListSharedApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_ListApplications_FullMethodName,
+		FullMethod: TrackerService_ListSharedApplications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+		return srv.(TrackerServiceServer).ListSharedApplications(ctx, req.(*ListSharedApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationRequest)
+func _TrackerService_GetSharedApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).GetApplication(ctx, in)
+		return srv.(TrackerServiceServer).GetSharedApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_GetApplication_FullMethodName,
+		FullMethod: TrackerService_GetSharedApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+		return srv.(TrackerServiceServer).GetSharedApplication(ctx, req.(*GetSharedApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateApplicationRequest)
+func _TrackerService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).CreateApplication(ctx, in)
+		return srv.(TrackerServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_CreateApplication_FullMethodName,
+		FullMethod: TrackerService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).CreateApplication(ctx, req.(*CreateApplicationRequest))
+		return srv.(TrackerServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_MoveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveCardRequest)
+func _TrackerService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).MoveCard(ctx, in)
+		return srv.(TrackerServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_MoveCard_FullMethodName,
+		FullMethod: TrackerService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).MoveCard(ctx, req.(*MoveCardRequest))
+		return srv.(TrackerServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNoteRequest)
+func _TrackerService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).AddNote(ctx, in)
+		return srv.(TrackerServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_AddNote_FullMethodName,
+		FullMethod: TrackerService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).AddNote(ctx, req.(*AddNoteRequest))
+		return srv.(TrackerServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_RateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateApplicationRequest)
+func _TrackerService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).RateApplication(ctx, in)
+		return srv.(TrackerServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_RateApplication_FullMethodName,
+		FullMethod: TrackerService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).RateApplication(ctx, req.(*RateApplicationRequest))
+		return srv.(TrackerServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrackerService_SetRelanceReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRelanceReminderRequest)
+func _TrackerService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrackerServiceServer).SetRelanceReminder(ctx, in)
+		return srv.(TrackerServiceServer).RetryWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrackerService_SetRelanceReminder_FullMethodName,
+		FullMethod: TrackerService_RetryWebhookDelivery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackerServiceServer).SetRelanceReminder(ctx, req.(*SetRelanceReminderRequest))
+		return srv.(TrackerServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -357,26 +3901,390 @@ var TrackerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApplication",
 			Handler:    _TrackerService_GetApplication_Handler,
 		},
+		{
+			MethodName: "BatchGetApplications",
+			Handler:    _TrackerService_BatchGetApplications_Handler,
+		},
+		{
+			MethodName: "GetApplicationByJobFeedId",
+			Handler:    _TrackerService_GetApplicationByJobFeedId_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _TrackerService_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetTimeline",
+			Handler:    _TrackerService_GetTimeline_Handler,
+		},
+		{
+			MethodName: "SearchApplications",
+			Handler:    _TrackerService_SearchApplications_Handler,
+		},
 		{
 			MethodName: "CreateApplication",
 			Handler:    _TrackerService_CreateApplication_Handler,
 		},
+		{
+			MethodName: "CreateManualApplication",
+			Handler:    _TrackerService_CreateManualApplication_Handler,
+		},
+		{
+			MethodName: "ImportApplicationEmail",
+			Handler:    _TrackerService_ImportApplicationEmail_Handler,
+		},
+		{
+			MethodName: "CloneApplication",
+			Handler:    _TrackerService_CloneApplication_Handler,
+		},
+		{
+			MethodName: "ListApplicationTemplates",
+			Handler:    _TrackerService_ListApplicationTemplates_Handler,
+		},
+		{
+			MethodName: "CreateApplicationTemplate",
+			Handler:    _TrackerService_CreateApplicationTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationTemplate",
+			Handler:    _TrackerService_UpdateApplicationTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteApplicationTemplate",
+			Handler:    _TrackerService_DeleteApplicationTemplate_Handler,
+		},
+		{
+			MethodName: "CreateApplicationFromTemplate",
+			Handler:    _TrackerService_CreateApplicationFromTemplate_Handler,
+		},
 		{
 			MethodName: "MoveCard",
 			Handler:    _TrackerService_MoveCard_Handler,
 		},
+		{
+			MethodName: "UndoLastMove",
+			Handler:    _TrackerService_UndoLastMove_Handler,
+		},
+		{
+			MethodName: "UndoLastAction",
+			Handler:    _TrackerService_UndoLastAction_Handler,
+		},
+		{
+			MethodName: "BulkMove",
+			Handler:    _TrackerService_BulkMove_Handler,
+		},
 		{
 			MethodName: "AddNote",
 			Handler:    _TrackerService_AddNote_Handler,
 		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _TrackerService_ListNotes_Handler,
+		},
+		{
+			MethodName: "EditNote",
+			Handler:    _TrackerService_EditNote_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _TrackerService_DeleteNote_Handler,
+		},
 		{
 			MethodName: "RateApplication",
 			Handler:    _TrackerService_RateApplication_Handler,
 		},
+		{
+			MethodName: "SetPriority",
+			Handler:    _TrackerService_SetPriority_Handler,
+		},
+		{
+			MethodName: "SetNextStep",
+			Handler:    _TrackerService_SetNextStep_Handler,
+		},
 		{
 			MethodName: "SetRelanceReminder",
 			Handler:    _TrackerService_SetRelanceReminder_Handler,
 		},
+		{
+			MethodName: "SnoozeReminder",
+			Handler:    _TrackerService_SnoozeReminder_Handler,
+		},
+		{
+			MethodName: "ClearReminder",
+			Handler:    _TrackerService_ClearReminder_Handler,
+		},
+		{
+			MethodName: "SetReminderRule",
+			Handler:    _TrackerService_SetReminderRule_Handler,
+		},
+		{
+			MethodName: "UpdateApplication",
+			Handler:    _TrackerService_UpdateApplication_Handler,
+		},
+		{
+			MethodName: "ArchiveApplication",
+			Handler:    _TrackerService_ArchiveApplication_Handler,
+		},
+		{
+			MethodName: "RestoreApplication",
+			Handler:    _TrackerService_RestoreApplication_Handler,
+		},
+		{
+			MethodName: "MergeApplications",
+			Handler:    _TrackerService_MergeApplications_Handler,
+		},
+		{
+			MethodName: "ListColumns",
+			Handler:    _TrackerService_ListColumns_Handler,
+		},
+		{
+			MethodName: "CreateColumn",
+			Handler:    _TrackerService_CreateColumn_Handler,
+		},
+		{
+			MethodName: "UpdateColumn",
+			Handler:    _TrackerService_UpdateColumn_Handler,
+		},
+		{
+			MethodName: "DeleteColumn",
+			Handler:    _TrackerService_DeleteColumn_Handler,
+		},
+		{
+			MethodName: "MoveToColumn",
+			Handler:    _TrackerService_MoveToColumn_Handler,
+		},
+		{
+			MethodName: "ReanalyzeApplication",
+			Handler:    _TrackerService_ReanalyzeApplication_Handler,
+		},
+		{
+			MethodName: "ListCoverLetterVersions",
+			Handler:    _TrackerService_ListCoverLetterVersions_Handler,
+		},
+		{
+			MethodName: "RegenerateCoverLetter",
+			Handler:    _TrackerService_RegenerateCoverLetter_Handler,
+		},
+		{
+			MethodName: "RestoreCoverLetterVersion",
+			Handler:    _TrackerService_RestoreCoverLetterVersion_Handler,
+		},
+		{
+			MethodName: "RequestFollowUpDraft",
+			Handler:    _TrackerService_RequestFollowUpDraft_Handler,
+		},
+		{
+			MethodName: "CreateAttachment",
+			Handler:    _TrackerService_CreateAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _TrackerService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachmentDownloadUrl",
+			Handler:    _TrackerService_GetAttachmentDownloadUrl_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _TrackerService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "CreateInterview",
+			Handler:    _TrackerService_CreateInterview_Handler,
+		},
+		{
+			MethodName: "ListInterviews",
+			Handler:    _TrackerService_ListInterviews_Handler,
+		},
+		{
+			MethodName: "UpdateInterview",
+			Handler:    _TrackerService_UpdateInterview_Handler,
+		},
+		{
+			MethodName: "RecordInterviewFeedback",
+			Handler:    _TrackerService_RecordInterviewFeedback_Handler,
+		},
+		{
+			MethodName: "DeleteInterview",
+			Handler:    _TrackerService_DeleteInterview_Handler,
+		},
+		{
+			MethodName: "SetOfferDetails",
+			Handler:    _TrackerService_SetOfferDetails_Handler,
+		},
+		{
+			MethodName: "CompareOffers",
+			Handler:    _TrackerService_CompareOffers_Handler,
+		},
+		{
+			MethodName: "AddNegotiationEntry",
+			Handler:    _TrackerService_AddNegotiationEntry_Handler,
+		},
+		{
+			MethodName: "ListNegotiationEntries",
+			Handler:    _TrackerService_ListNegotiationEntries_Handler,
+		},
+		{
+			MethodName: "DeleteNegotiationEntry",
+			Handler:    _TrackerService_DeleteNegotiationEntry_Handler,
+		},
+		{
+			MethodName: "CreateContact",
+			Handler:    _TrackerService_CreateContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _TrackerService_ListContacts_Handler,
+		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _TrackerService_UpdateContact_Handler,
+		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _TrackerService_DeleteContact_Handler,
+		},
+		{
+			MethodName: "LinkContact",
+			Handler:    _TrackerService_LinkContact_Handler,
+		},
+		{
+			MethodName: "UnlinkContact",
+			Handler:    _TrackerService_UnlinkContact_Handler,
+		},
+		{
+			MethodName: "ListCompanies",
+			Handler:    _TrackerService_ListCompanies_Handler,
+		},
+		{
+			MethodName: "GetCompanyOverview",
+			Handler:    _TrackerService_GetCompanyOverview_Handler,
+		},
+		{
+			MethodName: "GetRejectionStats",
+			Handler:    _TrackerService_GetRejectionStats_Handler,
+		},
+		{
+			MethodName: "GetSearchConfigStats",
+			Handler:    _TrackerService_GetSearchConfigStats_Handler,
+		},
+		{
+			MethodName: "CountApplicationsByStatus",
+			Handler:    _TrackerService_CountApplicationsByStatus_Handler,
+		},
+		{
+			MethodName: "TakeBoardSnapshot",
+			Handler:    _TrackerService_TakeBoardSnapshot_Handler,
+		},
+		{
+			MethodName: "CompareSnapshots",
+			Handler:    _TrackerService_CompareSnapshots_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _TrackerService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeedToken",
+			Handler:    _TrackerService_RotateCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RenderCalendarFeed",
+			Handler:    _TrackerService_RenderCalendarFeed_Handler,
+		},
+		{
+			MethodName: "StartGoogleCalendarAuth",
+			Handler:    _TrackerService_StartGoogleCalendarAuth_Handler,
+		},
+		{
+			MethodName: "CompleteGoogleCalendarAuth",
+			Handler:    _TrackerService_CompleteGoogleCalendarAuth_Handler,
+		},
+		{
+			MethodName: "GetGoogleCalendarStatus",
+			Handler:    _TrackerService_GetGoogleCalendarStatus_Handler,
+		},
+		{
+			MethodName: "DisconnectGoogleCalendar",
+			Handler:    _TrackerService_DisconnectGoogleCalendar_Handler,
+		},
+		{
+			MethodName: "GetBenchmark",
+			Handler:    _TrackerService_GetBenchmark_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _TrackerService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _TrackerService_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "SetSearchConfigArchival",
+			Handler:    _TrackerService_SetSearchConfigArchival_Handler,
+		},
+		{
+			MethodName: "ReactivateSearchConfig",
+			Handler:    _TrackerService_ReactivateSearchConfig_Handler,
+		},
+		{
+			MethodName: "ReopenSearch",
+			Handler:    _TrackerService_ReopenSearch_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _TrackerService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _TrackerService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _TrackerService_EraseUserData_Handler,
+		},
+		{
+			MethodName: "CreateBoardShare",
+			Handler:    _TrackerService_CreateBoardShare_Handler,
+		},
+		{
+			MethodName: "ListBoardShares",
+			Handler:    _TrackerService_ListBoardShares_Handler,
+		},
+		{
+			MethodName: "RevokeBoardShare",
+			Handler:    _TrackerService_RevokeBoardShare_Handler,
+		},
+		{
+			MethodName: "ListSharedApplications",
+			Handler:    _TrackerService_ListSharedApplications_Handler,
+		},
+		{
+			MethodName: "GetSharedApplication",
+			Handler:    _TrackerService_GetSharedApplication_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TrackerService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TrackerService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TrackerService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _TrackerService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _TrackerService_RetryWebhookDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tracker.proto",
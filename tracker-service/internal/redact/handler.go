@@ -0,0 +1,75 @@
+package redact
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+)
+
+// Handler wraps h so that nothing it writes holds user content: attributes
+// with a sensitive key are replaced by their length, email addresses are
+// masked in the message, string attributes and errors, and JSON attributes
+// (json.RawMessage) are redacted like events.
+func Handler(h slog.Handler) slog.Handler {
+	return handler{h}
+}
+
+type handler struct {
+	slog.Handler
+}
+
+func (h handler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, String(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(attr(a))
+		return true
+	})
+	return h.Handler.Handle(ctx, out)
+}
+
+func (h handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = attr(a)
+	}
+	return handler{h.Handler.WithAttrs(redacted)}
+}
+
+func (h handler) WithGroup(name string) slog.Handler {
+	return handler{h.Handler.WithGroup(name)}
+}
+
+// attr redacts a log attribute.
+func attr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if SensitiveKey(a.Key) {
+		switch a.Value.Kind() {
+		case slog.KindString:
+			if s := a.Value.String(); s != "" {
+				return slog.String(a.Key, Placeholder(s))
+			}
+			return a
+		default:
+			return slog.String(a.Key, redactedValue)
+		}
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, String(a.Value.String()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, g := range group {
+			redacted[i] = attr(g)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+	case slog.KindAny:
+		switch v := a.Value.Any().(type) {
+		case error:
+			return slog.String(a.Key, String(v.Error()))
+		case json.RawMessage:
+			return slog.Any(a.Key, json.RawMessage(JSON(v)))
+		}
+	}
+	return a
+}
@@ -0,0 +1,179 @@
+// Package redact keeps what users write about their job search — notes,
+// cover letters, email addresses, salary figures — out of logs and published
+// events. It is applied where they leave the service rather than at each
+// call site: the process' slog handler (Handler), the outbox every event goes
+// through (JSON), SQL statement logging (Args).
+//
+// Values under a sensitive key (SensitiveKey) are replaced by their length,
+// and email addresses in any other string are masked, so what is left are
+// IDs, statuses, counts and sizes.
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// sensitiveKeys are the keys, lowercased without '_' or '-', whose values
+// are user content. Keys containing "email" or "salary" are sensitive too.
+var sensitiveKeys = map[string]bool{
+	"note":                 true,
+	"notes":                true,
+	"usernotes":            true,
+	"text":                 true,
+	"body":                 true,
+	"coverletter":          true,
+	"generatedcoverletter": true,
+	"followupdraft":        true,
+	"draft":                true,
+	"compensation":         true,
+	"totalcompensation":    true,
+	"basesalary":           true,
+	"bonus":                true,
+	"equityvalue":          true,
+	"equitydetails":        true,
+}
+
+// SensitiveKey reports whether values logged or published under key (a slog
+// attribute or JSON field name, in any case, snake_case or camelCase) must
+// be redacted.
+func SensitiveKey(key string) bool {
+	k := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	return sensitiveKeys[k] || strings.Contains(k, "email") || strings.Contains(k, "salary")
+}
+
+// Placeholder returns what stands for a redacted string: its length only.
+func Placeholder(s string) string {
+	return fmt.Sprintf("[redacted %d chars]", utf8.RuneCountInString(s))
+}
+
+// redactedValue stands for redacted values that are not strings (amounts,
+// objects).
+const redactedValue = "[redacted]"
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// String returns s with the email addresses it contains masked.
+func String(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	return emailPattern.ReplaceAllString(s, "[email]")
+}
+
+// JSON returns the JSON document doc with the values of sensitive keys
+// replaced, at any depth, and email addresses masked in other strings. A
+// document that is not valid JSON is only masked, as a string.
+func JSON(doc []byte) []byte {
+	var v any
+	dec := json.NewDecoder(strings.NewReader(string(doc)))
+	dec.UseNumber() // keep numbers as they were written
+	if err := dec.Decode(&v); err != nil {
+		return []byte(String(string(doc)))
+	}
+	out, err := json.Marshal(value(v))
+	if err != nil {
+		return []byte(String(string(doc)))
+	}
+	return out
+}
+
+// value redacts a decoded JSON value.
+func value(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if SensitiveKey(k) {
+				v[k] = sensitive(field)
+			} else {
+				v[k] = value(field)
+			}
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = value(v[i])
+		}
+		return v
+	case string:
+		return String(v)
+	default:
+		return v
+	}
+}
+
+// sensitive returns what replaces the value of a sensitive key. Null and
+// empty values are kept: that there is nothing is not sensitive.
+func sensitive(v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return v
+		}
+		return Placeholder(v)
+	default:
+		return redactedValue
+	}
+}
+
+// Args returns SQL statement arguments fit for a log line: strings other
+// than UUIDs are replaced by their length, byte slices by their size;
+// numbers, booleans and times are kept (offer amounts are sealed before they
+// reach a statement).
+func Args(args []any) []any {
+	out := make([]any, len(args))
+	for i, a := range args {
+		out[i] = arg(a)
+	}
+	return out
+}
+
+func arg(a any) any {
+	switch a := a.(type) {
+	case string:
+		if isUUID(a) {
+			return a
+		}
+		return fmt.Sprintf("[%d chars]", utf8.RuneCountInString(a))
+	case *string:
+		if a == nil {
+			return nil
+		}
+		return arg(*a)
+	case []byte:
+		return fmt.Sprintf("[%d bytes]", len(a))
+	case []string:
+		out := make([]any, len(a))
+		for i, s := range a {
+			out[i] = arg(s)
+		}
+		return out
+	default:
+		return a
+	}
+}
+
+// isUUID reports whether s is a UUID in its canonical text form.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, r := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return false
+			}
+		default:
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
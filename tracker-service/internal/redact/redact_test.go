@@ -0,0 +1,86 @@
+package redact_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/redact"
+)
+
+func TestSensitiveKey(t *testing.T) {
+	for _, key := range []string{"note", "user_notes", "coverLetter", "generated_cover_letter", "email", "recruiterEmail", "base_salary", "salaryMin", "compensation", "equity-details"} {
+		if !redact.SensitiveKey(key) {
+			t.Errorf("SensitiveKey(%q) = false, want true", key)
+		}
+	}
+	for _, key := range []string{"applicationId", "userId", "status", "fields", "count", "err"} {
+		if redact.SensitiveKey(key) {
+			t.Errorf("SensitiveKey(%q) = true, want false", key)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	doc := []byte(`{"applicationId":"a1","note":"Asked for 65k","offer":{"baseSalary":65000,"currency":"EUR"},` +
+		`"fields":["user_notes"],"message":"mail jane.doe@acme.io","coverLetter":null,"matchScore":87}`)
+	var got map[string]any
+	if err := json.Unmarshal(redact.JSON(doc), &got); err != nil {
+		t.Fatal(err)
+	}
+	offer, _ := got["offer"].(map[string]any)
+	switch {
+	case got["applicationId"] != "a1" || got["matchScore"] != 87.0:
+		t.Errorf("IDs and scores not kept: %v", got)
+	case got["note"] != "[redacted 13 chars]":
+		t.Errorf("note = %v, want its length only", got["note"])
+	case offer["baseSalary"] != "[redacted]" || offer["currency"] != "EUR":
+		t.Errorf("offer = %v, want the salary redacted and the currency kept", offer)
+	case got["message"] != "mail [email]":
+		t.Errorf("message = %v, want the email masked", got["message"])
+	case got["coverLetter"] != nil:
+		t.Errorf("coverLetter = %v, want null kept", got["coverLetter"])
+	}
+
+	if got := string(redact.JSON([]byte("not json, bob@example.com"))); got != "not json, [email]" {
+		t.Errorf("JSON(invalid) = %q, want it masked as a string", got)
+	}
+}
+
+func TestArgs(t *testing.T) {
+	id := "1b4e28ba-2fa1-11d2-883f-0dc0ac9b4a3e"
+	got := redact.Args([]any{id, "My note", 42, true, []byte("xyz"), nil})
+	want := []any{id, "[7 chars]", 42, true, "[3 bytes]", nil}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Args[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(redact.Handler(slog.NewJSONHandler(&buf, nil))).With("email", "jane@acme.io")
+	log.InfoContext(context.Background(), "note added for bob@acme.io",
+		"applicationId", "a1",
+		"note", "Asked for 65k",
+		slog.Group("offer", "bonus", 5000),
+		"err", errors.New("duplicate key for jane@acme.io"),
+	)
+
+	line := buf.String()
+	for _, leaked := range []string{"jane@acme.io", "bob@acme.io", "65k", "5000"} {
+		if strings.Contains(line, leaked) {
+			t.Errorf("log line %s contains %q", line, leaked)
+		}
+	}
+	for _, kept := range []string{`"applicationId":"a1"`, `"note":"[redacted 13 chars]"`, `"bonus":"[redacted]"`, `"err":"duplicate key for [email]"`} {
+		if !strings.Contains(line, kept) {
+			t.Errorf("log line %s lacks %s", line, kept)
+		}
+	}
+}
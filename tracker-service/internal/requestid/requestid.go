@@ -0,0 +1,98 @@
+// Package requestid carries the ID of the request being served through
+// contexts, so that every log line and domain event it causes can be tied
+// back to it. The ID arrives in the "x-request-id" gRPC metadata (set by the
+// Gateway or any other caller) or is generated on entry; for work started by
+// a stream message, it is the message's requestId field (FromPayload), so a
+// user action can be followed through the events the services exchange.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key the ID is read from and echoed in.
+	MetadataKey = "x-request-id"
+	// LogKey is the attribute holding the ID in log records, and the field
+	// holding it in event payloads.
+	LogKey = "requestId"
+	// maxLen bounds IDs accepted from callers; longer ones are replaced.
+	maxLen = 128
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the ID carried by ctx, "" if none (background jobs).
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// New returns a random 128-bit ID, hex encoded.
+func New() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:]) // never fails (crypto/rand)
+	return hex.EncodeToString(b[:])
+}
+
+// Valid reports whether id, received from a caller, can be used as is:
+// non-empty, at most maxLen bytes of printable ASCII.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// FromPayload returns the request ID a JSON event payload carries in its
+// LogKey field, "" if it has none or an invalid one.
+func FromPayload(payload []byte) string {
+	var envelope map[string]json.RawMessage
+	if json.Unmarshal(payload, &envelope) != nil {
+		return ""
+	}
+	var id string
+	if json.Unmarshal(envelope[LogKey], &id) != nil || !Valid(id) {
+		return ""
+	}
+	return id
+}
+
+// LogHandler wraps h to add the request ID of the context, when there is
+// one, to every record logged with a context (slog.InfoContext etc.).
+func LogHandler(h slog.Handler) slog.Handler {
+	return logHandler{h}
+}
+
+type logHandler struct {
+	slog.Handler
+}
+
+func (h logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String(LogKey, id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return logHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h logHandler) WithGroup(name string) slog.Handler {
+	return logHandler{h.Handler.WithGroup(name)}
+}
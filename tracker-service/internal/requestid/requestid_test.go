@@ -0,0 +1,65 @@
+package requestid_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/requestid"
+)
+
+func TestValid(t *testing.T) {
+	cases := map[string]bool{
+		"":                       false,
+		"5f0c6d1e-req":           true,
+		"has space":              false,
+		"line\nbreak":            false,
+		strings.Repeat("a", 128): true,
+		strings.Repeat("a", 129): false,
+	}
+	for id, want := range cases {
+		if got := requestid.Valid(id); got != want {
+			t.Errorf("Valid(%q) = %v, want %v", id, got, want)
+		}
+	}
+	if id := requestid.New(); !requestid.Valid(id) || len(id) != 32 {
+		t.Errorf("New() = %q, want 32 valid characters", id)
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(requestid.LogHandler(slog.NewJSONHandler(&buf, nil))).With("svc", "tracker")
+
+	log.InfoContext(requestid.NewContext(context.Background(), "req-1"), "with id")
+	log.InfoContext(context.Background(), "without id")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d log lines, want 2", len(lines))
+	}
+	if !strings.Contains(lines[0], `"requestId":"req-1"`) || !strings.Contains(lines[0], `"svc":"tracker"`) {
+		t.Errorf("line with id = %s", lines[0])
+	}
+	if strings.Contains(lines[1], "requestId") {
+		t.Errorf("line without id = %s", lines[1])
+	}
+}
+
+func TestFromPayload(t *testing.T) {
+	cases := map[string]string{
+		`{"type":"EVENT_ANALYSIS_DONE","requestId":"req-1"}`: "req-1",
+		`{"type":"EVENT_ANALYSIS_DONE"}`:                     "",
+		`{"requestId":"has space"}`:                          "",
+		`{"requestId":42}`:                                   "",
+		`["requestId"]`:                                      "",
+		`not json`:                                           "",
+	}
+	for payload, want := range cases {
+		if got := requestid.FromPayload([]byte(payload)); got != want {
+			t.Errorf("FromPayload(%s) = %q, want %q", payload, got, want)
+		}
+	}
+}
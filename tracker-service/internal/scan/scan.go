@@ -0,0 +1,168 @@
+// Package scan checks uploaded files for viruses and other malware before
+// they are handed out.
+//
+// Scanner is the hook: ClamAV talks to a clamd daemon (e.g. the
+// clamav/clamav container) over its INSTREAM protocol, HTTP to an external
+// scanning API. Both stream the file; neither keeps it.
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Result is a scanner's verdict on a file.
+type Result struct {
+	Infected bool
+	// Signature names what was found (e.g. "Win.Test.EICAR_HDB-1"); empty
+	// when the file is clean.
+	Signature string
+}
+
+// Scanner scans a file. An error means no verdict (scanner unreachable,
+// file too large for it…): the caller tries again later.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (Result, error)
+}
+
+// chunkSize is the size of the INSTREAM chunks sent to clamd.
+const chunkSize = 64 << 10
+
+// ClamAV scans files with a clamd daemon.
+type ClamAV struct {
+	addr    string
+	timeout time.Duration
+}
+
+// NewClamAV returns a scanner using the clamd daemon listening at addr
+// ("host:port").
+func NewClamAV(addr string) (*ClamAV, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("clamd address must be host:port, got %q", addr)
+	}
+	return &ClamAV{addr: addr, timeout: 2 * time.Minute}, nil
+}
+
+// Scan sends r to clamd with the INSTREAM command.
+func (c *ClamAV) Scan(ctx context.Context, r io.Reader) (Result, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return Result{}, fmt.Errorf("clamd: %w", err)
+	}
+	defer conn.Close()
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline) //nolint:errcheck // a failed write reports it
+
+	w := bufio.NewWriterSize(conn, chunkSize+4)
+	if _, err := w.WriteString("zINSTREAM\x00"); err != nil {
+		return Result{}, fmt.Errorf("clamd: %w", err)
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := binary.Write(w, binary.BigEndian, uint32(n)); err != nil {
+				return Result{}, fmt.Errorf("clamd: %w", err)
+			}
+			if _, err := w.Write(buf[:n]); err != nil {
+				return Result{}, fmt.Errorf("clamd: %w", err)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return Result{}, fmt.Errorf("clamd read file: %w", err)
+		}
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(0)); err != nil {
+		return Result{}, fmt.Errorf("clamd: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return Result{}, fmt.Errorf("clamd: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && reply == "" {
+		return Result{}, fmt.Errorf("clamd reply: %w", err)
+	}
+	return parseClamdReply(reply)
+}
+
+// parseClamdReply reads clamd's answer to INSTREAM: "stream: OK",
+// "stream: <signature> FOUND" or "<reason> ERROR".
+func parseClamdReply(reply string) (Result, error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	reply = strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case reply == "OK":
+		return Result{}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return Result{Infected: true, Signature: strings.TrimSuffix(reply, " FOUND")}, nil
+	}
+	return Result{}, fmt.Errorf("clamd: %s", reply)
+}
+
+// HTTP scans files with an external API: the file is POSTed as the request
+// body, with the token as a bearer token, and the API answers 200 with
+// {"infected": bool, "signature": string}.
+type HTTP struct {
+	url   string
+	token string
+	http  *http.Client
+}
+
+// NewHTTP returns a scanner using the API at rawURL.
+func NewHTTP(rawURL, token string) (*HTTP, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("scan API URL must be an http(s) URL, got %q", rawURL)
+	}
+	return &HTTP{url: rawURL, token: token, http: &http.Client{Timeout: 2 * time.Minute}}, nil
+}
+
+// Scan posts r to the API.
+func (h *HTTP) Scan(ctx context.Context, r io.Reader) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, r)
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+	resp, err := h.http.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("scan API: %w", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("scan API: unexpected status %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	var verdict struct {
+		Infected  *bool  `json:"infected"`
+		Signature string `json:"signature"`
+	}
+	if err := json.Unmarshal(body, &verdict); err != nil || verdict.Infected == nil {
+		return Result{}, fmt.Errorf("scan API: malformed response %q", bytes.TrimSpace(body))
+	}
+	if !*verdict.Infected {
+		return Result{}, nil
+	}
+	return Result{Infected: true, Signature: verdict.Signature}, nil
+}
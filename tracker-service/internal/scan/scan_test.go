@@ -0,0 +1,129 @@
+package scan_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/scan"
+)
+
+// fakeClamd accepts one INSTREAM session, reassembles the streamed file and
+// answers reply(file).
+func fakeClamd(t *testing.T, reply func(file string) string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		if cmd, err := r.ReadString(0); err != nil || cmd != "zINSTREAM\x00" {
+			return
+		}
+		var file strings.Builder
+		for {
+			var n uint32
+			if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+				return
+			}
+			if n == 0 {
+				break
+			}
+			if _, err := io.CopyN(&file, r, int64(n)); err != nil {
+				return
+			}
+		}
+		io.WriteString(conn, reply(file.String())+"\x00") //nolint:errcheck
+	}()
+	return ln.Addr().String()
+}
+
+func TestClamAV(t *testing.T) {
+	const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+	reply := func(file string) string {
+		if strings.Contains(file, "EICAR") {
+			return "stream: Win.Test.EICAR_HDB-1 FOUND"
+		}
+		return "stream: OK"
+	}
+	for name, tt := range map[string]struct {
+		file string
+		want scan.Result
+	}{
+		"clean":    {strings.Repeat("résumé ", 20000), scan.Result{}}, // several chunks
+		"infected": {eicar, scan.Result{Infected: true, Signature: "Win.Test.EICAR_HDB-1"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := scan.NewClamAV(fakeClamd(t, reply))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := c.Scan(context.Background(), strings.NewReader(tt.file))
+			if err != nil {
+				t.Fatalf("Scan: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// clamd errors (size limit exceeded…) are no verdict.
+func TestClamAV_Error(t *testing.T) {
+	c, _ := scan.NewClamAV(fakeClamd(t, func(string) string { return "INSTREAM size limit exceeded. ERROR" }))
+	if _, err := c.Scan(context.Background(), strings.NewReader("x")); err == nil {
+		t.Error("Scan after a clamd ERROR returned no error")
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		switch string(body) {
+		case "virus":
+			io.WriteString(w, `{"infected": true, "signature": "Trojan.Test"}`) //nolint:errcheck
+		case "clean":
+			io.WriteString(w, `{"infected": false}`) //nolint:errcheck
+		default:
+			io.WriteString(w, `{}`) //nolint:errcheck
+		}
+	}))
+	defer srv.Close()
+
+	h, err := scan.NewHTTP(srv.URL, "tok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := h.Scan(context.Background(), strings.NewReader("virus")); err != nil || got != (scan.Result{Infected: true, Signature: "Trojan.Test"}) {
+		t.Errorf("Scan(virus) = %+v, %v", got, err)
+	}
+	if got, err := h.Scan(context.Background(), strings.NewReader("clean")); err != nil || got.Infected {
+		t.Errorf("Scan(clean) = %+v, %v", got, err)
+	}
+	if _, err := h.Scan(context.Background(), strings.NewReader("other")); err == nil {
+		t.Error("Scan with a response without a verdict returned no error")
+	}
+
+	bad, _ := scan.NewHTTP(srv.URL, "wrong")
+	if _, err := bad.Scan(context.Background(), strings.NewReader("clean")); err == nil {
+		t.Error("Scan with a rejected token returned no error")
+	}
+}
@@ -0,0 +1,171 @@
+package secretbox
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A Keyring seals text columns (notes, cover letters, offer terms) under its
+// active key and opens values sealed under any of its keys, so keys can be
+// rotated: put the new key first, keep the old ones until every value was
+// resealed (see kanban.Service.ResealFields), then drop them.
+//
+// Sealed values are text, "enc:<key ID>:<base64>", and can be stored in the
+// columns they replace. Values without the prefix — written before
+// encryption was enabled — are opened as they are. A nil keyring stores
+// values in clear, escaping those that start with the prefix ("enc::"), so
+// that a note typed as "enc:a:…" is not later taken for a sealed value.
+
+// sealedPrefix starts every value sealed by a Keyring.
+const sealedPrefix = "enc:"
+
+// escapedPrefix starts the values in clear escaped by a nil keyring's Seal.
+const escapedPrefix = sealedPrefix + ":"
+
+// ErrUnknownKey is returned by Keyring.Open for values sealed under a key the
+// keyring does not hold (or by a nil keyring for any sealed value).
+var ErrUnknownKey = errors.New("secretbox: value sealed under an unknown key")
+
+// Keyring holds the field encryption keys by ID.
+type Keyring struct {
+	active   string
+	boxes    map[string]*Box
+	tokenKey []byte // HMAC key of Token, derived from the active key
+}
+
+// ParseKeyring parses a comma-separated list of "<ID>:<base64 key>" pairs,
+// the first being the active key, as found in configuration. IDs are 1–16
+// letters, digits, '-' or '_'.
+func ParseKeyring(spec string) (*Keyring, error) {
+	k := &Keyring{boxes: make(map[string]*Box)}
+	for _, entry := range strings.Split(spec, ",") {
+		id, key, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || !validKeyID(id) {
+			return nil, fmt.Errorf("field encryption key %q: want <id>:<base64 key>, id of 1-16 letters, digits, - or _", entry)
+		}
+		if _, dup := k.boxes[id]; dup {
+			return nil, fmt.Errorf("field encryption key ID %q given twice", id)
+		}
+		box, err := NewFromBase64(key)
+		if err != nil {
+			return nil, fmt.Errorf("field encryption key %q: %w", id, err)
+		}
+		k.boxes[id] = box
+		if k.active == "" {
+			k.active = id
+			raw, _ := base64.StdEncoding.DecodeString(key) // valid: NewFromBase64 succeeded
+			mac := hmac.New(sha256.New, raw)
+			mac.Write([]byte("jobmate search token"))
+			k.tokenKey = mac.Sum(nil)
+		}
+	}
+	return k, nil
+}
+
+func validKeyID(id string) bool {
+	if id == "" || len(id) > 16 {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// ActiveKeyID returns the ID of the key new values are sealed under, "" for
+// a nil keyring.
+func (k *Keyring) ActiveKeyID() string {
+	if k == nil {
+		return ""
+	}
+	return k.active
+}
+
+// Seal seals plaintext under the active key. A nil keyring (encryption
+// disabled) returns it unchanged, or escaped if it starts with the sealed
+// prefix.
+func (k *Keyring) Seal(plaintext string) string {
+	if k == nil {
+		if strings.HasPrefix(plaintext, sealedPrefix) {
+			return escapedPrefix + plaintext
+		}
+		return plaintext
+	}
+	sealed := k.boxes[k.active].Seal([]byte(plaintext))
+	return sealedPrefix + k.active + ":" + base64.RawStdEncoding.EncodeToString(sealed)
+}
+
+// Open returns the plaintext of a value returned by Seal, or the value
+// itself if it is not sealed. It fails with ErrUnknownKey or ErrInvalid.
+func (k *Keyring) Open(value string) (string, error) {
+	if rest, ok := strings.CutPrefix(value, escapedPrefix); ok {
+		return rest, nil
+	}
+	id, data, sealed := splitSealed(value)
+	if !sealed {
+		return value, nil
+	}
+	if k == nil || k.boxes[id] == nil {
+		return "", fmt.Errorf("%w %q", ErrUnknownKey, id)
+	}
+	raw, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return "", ErrInvalid
+	}
+	plaintext, err := k.boxes[id].Open(raw)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Reveal is Open for display: a value that does not open — text in clear
+// that only looks sealed, or a value sealed under a key no longer held — is
+// returned as it is instead of failing the read.
+func (k *Keyring) Reveal(value string) string {
+	plaintext, err := k.Open(value)
+	if err != nil {
+		return value
+	}
+	return plaintext
+}
+
+// Current reports whether value is stored as Seal would store it now:
+// sealed under the active key, or in clear for a nil keyring.
+func (k *Keyring) Current(value string) bool {
+	id, _, sealed := splitSealed(value)
+	if k == nil {
+		return !sealed
+	}
+	return sealed && id == k.active
+}
+
+// Token returns a keyed hash of term, for indexes over sealed text (see
+// kanban.Service.SearchApplications): equal terms have equal tokens under the
+// same active key, and a token reveals nothing of its term without the key.
+// Tokens change with the active key. A nil keyring returns term unchanged.
+func (k *Keyring) Token(term string) string {
+	if k == nil {
+		return term
+	}
+	mac := hmac.New(sha256.New, k.tokenKey)
+	mac.Write([]byte(term))
+	return hex.EncodeToString(mac.Sum(nil)[:12])
+}
+
+// splitSealed splits a sealed value into its key ID and base64 data.
+func splitSealed(value string) (id, data string, sealed bool) {
+	rest, ok := strings.CutPrefix(value, sealedPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, data, ok = strings.Cut(rest, ":")
+	return id, data, ok && validKeyID(id)
+}
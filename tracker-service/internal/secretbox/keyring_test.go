@@ -0,0 +1,146 @@
+package secretbox_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"jobmate/tracker-service/internal/secretbox"
+)
+
+const (
+	keyA = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	keyB = "BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBA="
+)
+
+func TestKeyringRotation(t *testing.T) {
+	old, err := secretbox.ParseKeyring("a:" + keyA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	note := "Asked for 65k, they offered 58k"
+	sealed := old.Seal(note)
+	if !strings.HasPrefix(sealed, "enc:a:") || strings.Contains(sealed, "65k") {
+		t.Fatalf("Seal = %q, want an enc:a: value without the plaintext", sealed)
+	}
+
+	rotated, err := secretbox.ParseKeyring("b:" + keyB + ", a:" + keyA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.ActiveKeyID() != "b" {
+		t.Errorf("ActiveKeyID = %q, want the first key", rotated.ActiveKeyID())
+	}
+	if got, err := rotated.Open(sealed); err != nil || got != note {
+		t.Errorf("Open(old value) = %q, %v; want %q", got, err, note)
+	}
+	if rotated.Current(sealed) {
+		t.Error("value sealed under the old key reported current")
+	}
+	if resealed := rotated.Seal(note); !rotated.Current(resealed) {
+		t.Errorf("Current(%q) = false after Seal", resealed)
+	}
+
+	onlyB, _ := secretbox.ParseKeyring("b:" + keyB)
+	if _, err := onlyB.Open(sealed); !errors.Is(err, secretbox.ErrUnknownKey) {
+		t.Errorf("Open without the key error = %v, want ErrUnknownKey", err)
+	}
+	tampered := sealed[:len(sealed)-2] + "xx"
+	if _, err := old.Open(tampered); !errors.Is(err, secretbox.ErrInvalid) {
+		t.Errorf("Open(tampered) error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestKeyringPlaintext(t *testing.T) {
+	k, _ := secretbox.ParseKeyring("a:" + keyA)
+	for _, v := range []string{"", "written before encryption", "enc: not sealed"} {
+		if got, err := k.Open(v); err != nil || got != v {
+			t.Errorf("Open(%q) = %q, %v; want it unchanged", v, got, err)
+		}
+		if k.Current(v) {
+			t.Errorf("Current(%q) = true for a value in clear", v)
+		}
+	}
+
+	var disabled *secretbox.Keyring
+	if got := disabled.Seal("note"); got != "note" {
+		t.Errorf("nil keyring Seal = %q, want the plaintext", got)
+	}
+	if !disabled.Current("note") || disabled.Current(k.Seal("note")) {
+		t.Error("nil keyring: want values in clear current, sealed ones not")
+	}
+	if _, err := disabled.Open(k.Seal("note")); !errors.Is(err, secretbox.ErrUnknownKey) {
+		t.Errorf("nil keyring Open(sealed) error = %v, want ErrUnknownKey", err)
+	}
+
+	// Text typed to look sealed is escaped in clear, and read back as typed
+	// once encryption is enabled.
+	typed := "enc:a:" + strings.TrimPrefix(k.Seal("someone else's note"), "enc:a:")
+	stored := disabled.Seal(typed)
+	if stored == typed || !disabled.Current(stored) {
+		t.Errorf("nil keyring Seal(%q) = %q, want it escaped", typed, stored)
+	}
+	for _, kr := range []*secretbox.Keyring{disabled, k} {
+		if got, err := kr.Open(stored); err != nil || got != typed {
+			t.Errorf("Open(escaped) = %q, %v; want %q", got, err, typed)
+		}
+	}
+}
+
+// Values that look sealed but do not open are shown as they are.
+func TestKeyringReveal(t *testing.T) {
+	a, _ := secretbox.ParseKeyring("a:" + keyA)
+	b, _ := secretbox.ParseKeyring("b:" + keyB)
+	sealed := a.Seal("note")
+	var disabled *secretbox.Keyring
+
+	for _, tt := range []struct {
+		keys        *secretbox.Keyring
+		value, want string
+	}{
+		{a, sealed, "note"},
+		{a, "plain", "plain"},
+		{a, "enc:a:not base64!", "enc:a:not base64!"},
+		{a, sealed[:len(sealed)-2] + "xx", sealed[:len(sealed)-2] + "xx"},
+		{b, sealed, sealed},
+		{disabled, sealed, sealed},
+		{disabled, disabled.Seal("enc:a:typed"), "enc:a:typed"},
+	} {
+		if got := tt.keys.Reveal(tt.value); got != tt.want {
+			t.Errorf("Reveal(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestKeyringToken(t *testing.T) {
+	k, _ := secretbox.ParseKeyring("a:" + keyA)
+	if k.Token("kubernet") != k.Token("kubernet") || k.Token("kubernet") == k.Token("recruit") {
+		t.Error("want equal tokens for equal terms only")
+	}
+	if tok := k.Token("kubernet"); strings.Contains(tok, "kubernet") || len(tok) != 24 {
+		t.Errorf("Token = %q, want 24 hex digits", tok)
+	}
+	rotated, _ := secretbox.ParseKeyring("b:" + keyB + ",a:" + keyA)
+	if rotated.Token("kubernet") == k.Token("kubernet") {
+		t.Error("token unchanged by a new active key")
+	}
+	var disabled *secretbox.Keyring
+	if got := disabled.Token("kubernet"); got != "kubernet" {
+		t.Errorf("nil keyring Token = %q, want the term", got)
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		keyA,                        // no ID
+		"a:" + keyA + ",a:" + keyB,  // duplicate ID
+		"a b:" + keyA,               // invalid ID
+		"a:AAAA",                    // short key
+		"seventeen-chars-x:" + keyA, // ID too long
+	} {
+		if _, err := secretbox.ParseKeyring(spec); err == nil {
+			t.Errorf("ParseKeyring(%q) = nil error, want an error", spec)
+		}
+	}
+}
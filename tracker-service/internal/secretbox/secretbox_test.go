@@ -0,0 +1,53 @@
+package secretbox_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"jobmate/tracker-service/internal/secretbox"
+)
+
+func TestSealOpen(t *testing.T) {
+	box, err := secretbox.New(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := []byte("1//0refresh-token")
+
+	a, b := box.Seal(secret), box.Seal(secret)
+	if bytes.Equal(a, b) {
+		t.Error("sealing twice gave the same value, want a fresh nonce each time")
+	}
+	if bytes.Contains(a, secret) {
+		t.Error("sealed value contains the plaintext")
+	}
+	got, err := box.Open(a)
+	if err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("Open = %q, %v; want %q", got, err, secret)
+	}
+
+	tampered := append([]byte(nil), a...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := box.Open(tampered); !errors.Is(err, secretbox.ErrInvalid) {
+		t.Errorf("Open(tampered) error = %v, want ErrInvalid", err)
+	}
+	other, _ := secretbox.New(bytes.Repeat([]byte{8}, 32))
+	if _, err := other.Open(a); !errors.Is(err, secretbox.ErrInvalid) {
+		t.Errorf("Open under another key error = %v, want ErrInvalid", err)
+	}
+	if _, err := box.Open([]byte("short")); !errors.Is(err, secretbox.ErrInvalid) {
+		t.Errorf("Open(garbage) error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestNewFromBase64(t *testing.T) {
+	if _, err := secretbox.NewFromBase64("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="); err != nil {
+		t.Errorf("32-byte key rejected: %v", err)
+	}
+	for _, key := range []string{"", "not base64!", "AAAA"} {
+		if _, err := secretbox.NewFromBase64(key); err == nil {
+			t.Errorf("NewFromBase64(%q) = nil error, want an error", key)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package storage
+
+// Exported aliases of unexported helpers, for the storage_test package only.
+
+var PresignURL = presignURL
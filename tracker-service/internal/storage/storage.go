@@ -0,0 +1,224 @@
+// Package storage talks to an S3-compatible object store (AWS S3, MinIO).
+//
+// Only presigned URLs are handed out: clients upload and download attachment
+// bytes directly, so files never transit through the Gateway or the tracker.
+// Requests are signed with AWS Signature Version 4 using path-style URLs
+// (endpoint/bucket/key), which both S3 and MinIO accept.
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config locates the bucket and holds its credentials.
+type Config struct {
+	Endpoint  string // e.g. http://minio:9000 or https://s3.eu-west-3.amazonaws.com
+	Bucket    string
+	Region    string // "us-east-1" for MinIO
+	AccessKey string
+	SecretKey string
+}
+
+// ErrNotFound is returned by Get for a missing object (e.g. one not
+// uploaded yet).
+var ErrNotFound = errors.New("storage: object not found")
+
+// Client issues presigned requests against a single bucket.
+type Client struct {
+	endpoint *url.URL
+	cfg      Config
+	http     *http.Client
+	now      func() time.Time
+}
+
+// New validates cfg and returns a Client.
+func New(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("storage endpoint must be an http(s) URL, got %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("storage bucket, access key and secret key are required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	return &Client{
+		endpoint: u,
+		cfg:      cfg,
+		http:     &http.Client{Timeout: 10 * time.Second},
+		now:      time.Now,
+	}, nil
+}
+
+// PresignPut returns a URL the client can PUT the object to. Content type
+// and length are part of the signature, so the store rejects an upload that
+// differs from what was declared (and validated) up front.
+func (c *Client) PresignPut(key, contentType string, size int64, ttl time.Duration) string {
+	return c.presign(http.MethodPut, key, nil, map[string]string{
+		"content-type":   contentType,
+		"content-length": strconv.FormatInt(size, 10),
+	}, ttl)
+}
+
+// PresignGet returns a download URL. fileName is suggested to the browser
+// through Content-Disposition.
+func (c *Client) PresignGet(key, fileName string, ttl time.Duration) string {
+	query := url.Values{}
+	if fileName != "" {
+		query.Set("response-content-disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	}
+	return c.presign(http.MethodGet, key, query, nil, ttl)
+}
+
+// Get returns the content of an object, for the tracker's own use (see
+// package scan); the caller closes it.
+func (c *Client) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.presign(http.MethodGet, key, nil, nil, time.Minute), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("storage get: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	resp.Body.Close()
+	return nil, fmt.Errorf("storage get: unexpected status %s", resp.Status)
+}
+
+// Delete removes an object. Deleting a missing object is not an error.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.presign(http.MethodDelete, key, nil, nil, time.Minute), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("storage delete: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("storage delete: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (c *Client) presign(method, key string, query url.Values, headers map[string]string, ttl time.Duration) string {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.cfg.Bucket + "/" + key
+	return presignURL(method, &u, query, headers, c.cfg.Region, c.cfg.AccessKey, c.cfg.SecretKey, c.now(), ttl)
+}
+
+// presignURL signs a request with AWS SigV4 query parameters.
+// The host header is always signed; headers (lower-case names) are signed
+// too and must be sent verbatim by the client.
+func presignURL(method string, u *url.URL, query url.Values, headers map[string]string, region, accessKey, secretKey string, t time.Time, ttl time.Duration) string {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	day := t.Format("20060102")
+	scope := day + "/" + region + "/s3/aws4_request"
+
+	signed := map[string]string{"host": u.Host}
+	for k, v := range headers {
+		signed[k] = v
+	}
+	names := make([]string, 0, len(signed))
+	for k := range signed {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonHeaders strings.Builder
+	for _, k := range names {
+		canonHeaders.WriteString(k + ":" + strings.TrimSpace(signed[k]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	q.Set("X-Amz-Credential", accessKey+"/"+scope)
+	q.Set("X-Amz-Date", amzDate)
+	q.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	q.Set("X-Amz-SignedHeaders", signedHeaders)
+	canonQuery := canonicalQuery(q)
+
+	canonRequest := strings.Join([]string{
+		method,
+		uriEncode(u.Path, false),
+		canonQuery,
+		canonHeaders.String(),
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	sum := sha256.Sum256([]byte(canonRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), day)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	return u.Scheme + "://" + u.Host + uriEncode(u.Path, false) + "?" + canonQuery + "&X-Amz-Signature=" + signature
+}
+
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range q[k] {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes s the way SigV4 expects: everything but
+// unreserved characters, and '/' too unless encoding a path.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
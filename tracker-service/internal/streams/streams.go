@@ -0,0 +1,33 @@
+// Package streams defines how services exchange messages over Redis.
+//
+// Every EVENT_* and CMD_* message is appended to a Redis Stream named after
+// its type, with the JSON document in a single "payload" field. Unlike
+// Pub/Sub, entries stay in the stream: each consuming service reads through
+// its own consumer group and acknowledges what it has handled, so a consumer
+// that was down catches up on restart. The same convention is used by the
+// Gateway, the AI Coach and the Python services.
+package streams
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// PayloadField is the stream entry field holding the JSON message.
+	PayloadField = "payload"
+	// MaxLen caps each stream (approximately): old entries are trimmed once
+	// every consumer group has long moved past them.
+	MaxLen = 10_000
+)
+
+// Publish appends payload to stream.
+func Publish(ctx context.Context, rdb redis.Cmdable, stream string, payload []byte) error {
+	return rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: MaxLen,
+		Approx: true,
+		Values: map[string]any{PayloadField: payload},
+	}).Err()
+}
@@ -0,0 +1,191 @@
+// Package telemetry traces the tracker with OpenTelemetry: each RPC is a
+// server span (otelgrpc, continuing the trace of the caller — the Gateway
+// forwards its W3C traceparent in the gRPC metadata) with a child span per
+// SQL statement (QueryTracer) and Redis command (RedisHook), exported over
+// OTLP/gRPC.
+//
+// Statements and commands only get spans inside a trace: background jobs
+// polling every few seconds would otherwise flood the collector with
+// one-span traces.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the pgx and Redis spans.
+const instrumentationName = "jobmate/tracker-service/internal/telemetry"
+
+// Setup exports the spans of the process to the OTLP/gRPC collector at
+// endpoint (e.g. http://otel-collector:4317) and accepts W3C trace context
+// from callers. The standard OTEL_* variables (OTEL_TRACES_SAMPLER,
+// OTEL_RESOURCE_ATTRIBUTES, …) still apply. The returned function flushes the
+// pending spans; call it on shutdown.
+func Setup(ctx context.Context, endpoint, serviceName, version string) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("otlp exporter: %w", err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName), semconv.ServiceVersion(version)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otel resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// inTrace reports whether ctx belongs to a trace that child spans can join.
+func inTrace(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
+
+// endSpan ends span, recording err unless it is expected (ignored).
+func endSpan(span trace.Span, err error, ignored error) {
+	if err != nil && !errors.Is(err, ignored) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ─── PostgreSQL ───────────────────────────────────────────────────────────────
+
+// QueryTracer is a pgx.QueryTracer giving each SQL statement a span, with
+// the statement's text (not its arguments, which hold user data).
+type QueryTracer struct{}
+
+type querySpanKey struct{}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if !inTrace(ctx) {
+		return ctx
+	}
+	op := sqlOperation(data.SQL)
+	ctx, span := tracer().Start(ctx, op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return context.WithValue(ctx, querySpanKey{}, span)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	if span, ok := ctx.Value(querySpanKey{}).(trace.Span); ok {
+		endSpan(span, data.Err, pgx.ErrNoRows)
+	}
+}
+
+// sqlOperation returns the first keyword of a statement (SELECT, WITH, …).
+func sqlOperation(sql string) string {
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	return "SQL"
+}
+
+// ─── Redis ────────────────────────────────────────────────────────────────────
+
+// RedisHook is a redis.Hook giving each command, or pipeline, a span. Only
+// command names are recorded: keys and values hold user data.
+type RedisHook struct{}
+
+// DialHook implements redis.Hook.
+func (RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook implements redis.Hook.
+func (RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !inTrace(ctx) {
+			return next(ctx, cmd)
+		}
+		op := strings.ToUpper(cmd.Name())
+		ctx, span := tracer().Start(ctx, op,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemNameRedis, semconv.DBOperationName(op)),
+		)
+		err := next(ctx, cmd)
+		endSpan(span, err, redis.Nil)
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook.
+func (RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !inTrace(ctx) {
+			return next(ctx, cmds)
+		}
+		ctx, span := tracer().Start(ctx, "PIPELINE",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemNameRedis, semconv.DBOperationBatchSize(len(cmds))),
+		)
+		err := next(ctx, cmds)
+		endSpan(span, err, redis.Nil)
+		return err
+	}
+}
+
+// ─── Logs ─────────────────────────────────────────────────────────────────────
+
+// LogKey is the attribute holding the trace ID in log records.
+const LogKey = "traceId"
+
+// LogHandler wraps h to add the trace ID of the context, when it has a
+// sampled span, to every record logged with a context, so that the logs of
+// a slow RPC lead to its trace.
+func LogHandler(h slog.Handler) slog.Handler {
+	return logHandler{h}
+}
+
+type logHandler struct {
+	slog.Handler
+}
+
+func (h logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsSampled() {
+		r.AddAttrs(slog.String(LogKey, sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return logHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h logHandler) WithGroup(name string) slog.Handler {
+	return logHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,103 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"jobmate/tracker-service/internal/telemetry"
+)
+
+// recordSpans installs an in-memory tracer provider for the test.
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return exp
+}
+
+func TestQueryTracer(t *testing.T) {
+	exp := recordSpans(t)
+	var qt telemetry.QueryTracer
+	const sql = "SELECT id FROM applications WHERE user_id = $1"
+
+	// Outside a trace (background jobs): no span.
+	ctx := qt.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+	qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+	if n := len(exp.GetSpans()); n != 0 {
+		t.Fatalf("%d spans outside a trace, want 0", n)
+	}
+
+	root, rpc := otel.Tracer("test").Start(context.Background(), "MoveCard")
+	for _, err := range []error{nil, pgx.ErrNoRows, errors.New("deadlock detected")} {
+		ctx := qt.TraceQueryStart(root, nil, pgx.TraceQueryStartData{SQL: sql, Args: []any{"secret-user"}})
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: err})
+	}
+	rpc.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 3 queries + the RPC", len(spans))
+	}
+	for i, s := range spans[:3] {
+		if s.Name != "SELECT" || s.Parent.SpanID() != rpc.SpanContext().SpanID() {
+			t.Errorf("query span %d = %q (parent %s), want SELECT under the RPC", i, s.Name, s.Parent.SpanID())
+		}
+		for _, a := range s.Attributes {
+			if strings.Contains(a.Value.Emit(), "secret-user") {
+				t.Errorf("query span %d records the arguments: %v", i, a)
+			}
+		}
+	}
+	if got := []codes.Code{spans[0].Status.Code, spans[1].Status.Code, spans[2].Status.Code}; got[0] != codes.Unset || got[1] != codes.Unset || got[2] != codes.Error {
+		t.Errorf("statuses = %v, want only the deadlock as an error", got)
+	}
+}
+
+func TestRedisHook(t *testing.T) {
+	exp := recordSpans(t)
+	process := telemetry.RedisHook{}.ProcessHook(func(context.Context, redis.Cmder) error { return redis.Nil })
+
+	cmd := redis.NewStringCmd(context.Background(), "get", "tracker:cache:u1")
+	_ = process(context.Background(), cmd)
+	ctx, rpc := otel.Tracer("test").Start(context.Background(), "ListApplications")
+	_ = process(ctx, cmd)
+	rpc.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 2 || spans[0].Name != "GET" || spans[0].Status.Code != codes.Unset {
+		t.Fatalf("spans = %v, want a GET span (redis.Nil is not an error) and the RPC", spans)
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	recordSpans(t)
+	var buf bytes.Buffer
+	log := slog.New(telemetry.LogHandler(slog.NewJSONHandler(&buf, nil)))
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "MoveCard")
+	defer span.End()
+	log.InfoContext(ctx, "traced")
+	log.InfoContext(context.Background(), "untraced")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if want := `"traceId":"` + span.SpanContext().TraceID().String() + `"`; len(lines) != 2 || !strings.Contains(lines[0], want) {
+		t.Errorf("traced line = %s, want %s", lines[0], want)
+	}
+	if strings.Contains(lines[1], "traceId") {
+		t.Errorf("untraced line has a trace ID: %s", lines[1])
+	}
+}
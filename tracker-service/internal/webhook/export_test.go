@@ -0,0 +1,5 @@
+package webhook
+
+// Exported helpers for the webhook_test package only.
+
+var PublicIP = publicIP
@@ -0,0 +1,152 @@
+// Package webhook sends the tracker's events to URLs registered by users.
+//
+// Each delivery is a POST of the event's JSON payload, signed with the
+// webhook's secret so receivers can check it comes from JobMate:
+// SignatureHeader is "sha256=" followed by the hex HMAC-SHA256 of
+// "<TimestampHeader value>.<body>" (see Sign). Receivers should also reject
+// old timestamps to prevent replays.
+//
+// URLs are user input: unless the client is insecure (development), it only
+// connects to public addresses — whatever the host name resolves to — and
+// never follows redirects, so webhooks cannot reach the internal network.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Delivery headers.
+const (
+	EventHeader     = "X-JobMate-Event"
+	DeliveryHeader  = "X-JobMate-Delivery"  // unique per delivery, stable across retries
+	TimestampHeader = "X-JobMate-Timestamp" // Unix seconds
+	SignatureHeader = "X-JobMate-Signature"
+)
+
+// maxURLLen matches the webhooks.url column.
+const maxURLLen = 2048
+
+// Client sends deliveries.
+type Client struct {
+	http     *http.Client
+	insecure bool
+}
+
+// New returns a Client. insecure allows plain http URLs and private
+// addresses, for local development only.
+func New(insecure bool) *Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !insecure {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+				return fmt.Errorf("webhook address %s is not public", host)
+			}
+			return nil
+		}
+	}
+	return &Client{
+		http: &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConnsPerHost: 2,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		insecure: insecure,
+	}
+}
+
+// CheckURL validates a webhook URL — absolute https (or http for an
+// insecure client), with a host and without credentials — and returns it
+// without fragment.
+func (c *Client) CheckURL(raw string) (string, error) {
+	if raw == "" || len(raw) > maxURLLen {
+		return "", fmt.Errorf("url is required and at most %d characters", maxURLLen)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("url is not a valid URL")
+	}
+	if u.Scheme != "https" && !(c.insecure && u.Scheme == "http") {
+		return "", fmt.Errorf("url must use https")
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("url must have a host")
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("url must not contain credentials")
+	}
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// Delivery is one event to send.
+type Delivery struct {
+	ID      string
+	URL     string
+	Secret  []byte
+	Event   string
+	Payload []byte // JSON
+}
+
+// Send POSTs d and returns the response status code (0 when there was no
+// response). Non-2xx responses are errors.
+func (c *Client) Send(ctx context.Context, d Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "JobMate-Webhooks/1")
+	req.Header.Set(EventHeader, d.Event)
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(TimestampHeader, ts)
+	req.Header.Set(SignatureHeader, Sign(d.Secret, ts, d.Payload))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // keep the connection reusable
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the SignatureHeader value of body sent at timestamp ts.
+func Sign(secret []byte, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// carrierNAT is the shared address space of RFC 6598.
+var carrierNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// publicIP reports whether ip is a globally routable unicast address.
+func publicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !carrierNAT.Contains(ip)
+}
@@ -0,0 +1,111 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"jobmate/tracker-service/internal/webhook"
+)
+
+func TestSend(t *testing.T) {
+	secret := []byte("s3cret")
+	payload := []byte(`{"type":"EVENT_CARD_MOVED","userId":"u1"}`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != string(payload) {
+			t.Errorf("body = %s, want %s", body, payload)
+		}
+		ts := r.Header.Get(webhook.TimestampHeader)
+		if got, want := r.Header.Get(webhook.SignatureHeader), webhook.Sign(secret, ts, body); ts == "" || got != want {
+			t.Errorf("signature = %q (ts %q), want %q", got, ts, want)
+		}
+		if r.Header.Get(webhook.EventHeader) != "EVENT_CARD_MOVED" || r.Header.Get(webhook.DeliveryHeader) != "d1" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := webhook.New(true)
+	code, err := c.Send(context.Background(), webhook.Delivery{
+		ID: "d1", URL: srv.URL, Secret: secret, Event: "EVENT_CARD_MOVED", Payload: payload,
+	})
+	if err != nil || code != http.StatusNoContent {
+		t.Errorf("Send = %d, %v; want 204, nil", code, err)
+	}
+}
+
+// Non-2xx responses, redirects included, are failed deliveries.
+func TestSend_Failure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := webhook.New(true)
+	for path, want := range map[string]int{"/": 500, "/moved": 302} {
+		code, err := c.Send(context.Background(), webhook.Delivery{URL: srv.URL + path, Payload: []byte(`{}`)})
+		if err == nil || code != want {
+			t.Errorf("Send(%s) = %d, %v; want %d and an error", path, code, err, want)
+		}
+	}
+}
+
+func TestSend_RefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	defer srv.Close()
+
+	code, err := webhook.New(false).Send(context.Background(), webhook.Delivery{URL: srv.URL, Payload: []byte(`{}`)})
+	if err == nil || code != 0 {
+		t.Errorf("Send(loopback) = %d, %v; want 0 and an error", code, err)
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	secure, insecure := webhook.New(false), webhook.New(true)
+	if got, err := secure.CheckURL("https://hooks.example.com/jobmate#frag"); err != nil || got != "https://hooks.example.com/jobmate" {
+		t.Errorf("CheckURL(valid) = %q, %v", got, err)
+	}
+	for _, raw := range []string{"", "http://hooks.example.com", "ftp://example.com", "https://", "https://user:pw@example.com", "/relative"} {
+		if _, err := secure.CheckURL(raw); err == nil {
+			t.Errorf("CheckURL(%q) = nil error, want error", raw)
+		}
+	}
+	if _, err := insecure.CheckURL("http://localhost:8080/hook"); err != nil {
+		t.Errorf("insecure CheckURL(http) = %v, want nil", err)
+	}
+}
+
+func TestPublicIP(t *testing.T) {
+	cases := map[string]bool{
+		"93.184.216.34":      true,
+		"2606:4700::1111":    true,
+		"127.0.0.1":          false,
+		"::1":                false,
+		"10.1.2.3":           false,
+		"172.16.0.1":         false,
+		"192.168.1.1":        false,
+		"169.254.169.254":    false, // cloud metadata
+		"100.64.0.1":         false,
+		"0.0.0.0":            false,
+		"fd00::1":            false,
+		"fe80::1":            false,
+		"224.0.0.1":          false,
+		"::ffff:192.168.1.1": false,
+	}
+	for raw, want := range cases {
+		if got := webhook.PublicIP(net.ParseIP(raw)); got != want {
+			t.Errorf("publicIP(%s) = %t, want %t", raw, got, want)
+		}
+	}
+}
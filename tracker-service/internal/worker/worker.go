@@ -0,0 +1,247 @@
+// Package worker runs the tracker's background jobs: periodic ones (Every)
+// and Redis Stream consumers (Consume).
+//
+// Jobs are plain functions; this package only owns the loops so every job
+// gets the same logging, panic safety and shutdown behaviour.
+package worker
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"jobmate/tracker-service/internal/db"
+	"jobmate/tracker-service/internal/requestid"
+	"jobmate/tracker-service/internal/streams"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Every runs fn immediately and then once per interval until ctx is
+// cancelled. Errors and panics are logged and never stop the loop.
+// It blocks — start it with `go worker.Every(...)`.
+func Every(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	slog.Info("worker started", "worker", name, "interval", interval.String())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		runOnce(ctx, name, fn)
+		select {
+		case <-ctx.Done():
+			slog.Info("worker stopped", "worker", name)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Consume calls fn for every entry appended to a Redis stream (see package
+// streams) until ctx is cancelled, reading as a member of consumer group
+// `group` so entries added while the tracker was down are picked up on
+// restart. The group is created at the stream's current end if missing.
+//
+// An entry is acknowledged once fn succeeds. Failed entries — and entries
+// left unacknowledged by a consumer that crashed — stay pending and are
+// claimed again after pendingIdle, up to maxDeliveries attempts, after which
+// they are logged and dropped. Errors and panics never stop the loop.
+// It blocks — start it with `go worker.Consume(...)`.
+func Consume(ctx context.Context, rdb *redis.Client, name, stream, group string, fn func(ctx context.Context, payload string) error) {
+	c := &consumer{rdb: rdb, name: name, stream: stream, group: group, fn: fn}
+	c.id, _ = os.Hostname()
+	if c.id == "" {
+		c.id = name
+	}
+
+	for {
+		err := rdb.XGroupCreateMkStream(ctx, stream, group, "$").Err()
+		if err == nil || strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			break
+		}
+		if !redisDown(err) {
+			slog.Error("worker group setup failed", "worker", name, "stream", stream, "err", err)
+		}
+		if !sleep(ctx, retryDelay) {
+			return
+		}
+	}
+	slog.Info("worker started", "worker", name, "stream", stream, "group", group)
+
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= pendingIdle {
+			c.claimPending(ctx)
+			lastClaim = time.Now()
+		}
+
+		res, err := rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: c.id,
+			Streams:  []string{stream, ">"},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() == nil && !redisDown(err) {
+				slog.Error("worker read failed", "worker", name, "stream", stream, "err", err)
+				sleep(ctx, retryDelay)
+			}
+			continue
+		}
+		for _, s := range res {
+			for _, msg := range s.Messages {
+				c.handle(ctx, msg)
+			}
+		}
+	}
+	slog.Info("worker stopped", "worker", name)
+}
+
+const (
+	readCount     = 10
+	readBlock     = 5 * time.Second
+	retryDelay    = 2 * time.Second
+	pendingIdle   = time.Minute
+	maxDeliveries = 5
+)
+
+type consumer struct {
+	rdb    *redis.Client
+	name   string
+	stream string
+	group  string
+	id     string // consumer name within the group
+	fn     func(ctx context.Context, payload string) error
+}
+
+// handle runs fn on one entry and acknowledges it on success. fn runs with
+// the request ID the entry carries, if any, so its logs and the events it
+// causes stay tied to the user action behind the message.
+func (c *consumer) handle(ctx context.Context, msg redis.XMessage) {
+	payload, ok := msg.Values[streams.PayloadField].(string)
+	if !ok {
+		slog.Warn("worker skipped entry without payload", "worker", c.name, "id", msg.ID)
+	} else if err := runOnce(withRequestID(ctx, payload), c.name, func(ctx context.Context) error {
+		return c.fn(ctx, payload)
+	}); err != nil {
+		return // stays pending, retried by claimPending
+	}
+	if err := c.rdb.XAck(ctx, c.stream, c.group, msg.ID).Err(); err != nil {
+		slog.Warn("worker ack failed", "worker", c.name, "id", msg.ID, "err", err)
+	}
+}
+
+// withRequestID returns ctx carrying the request ID of payload, if any.
+func withRequestID(ctx context.Context, payload string) context.Context {
+	if id := requestid.FromPayload([]byte(payload)); id != "" {
+		return requestid.NewContext(ctx, id)
+	}
+	return ctx
+}
+
+// claimPending takes over entries that have been pending for pendingIdle
+// and runs them again, dropping those already delivered maxDeliveries times.
+func (c *consumer) claimPending(ctx context.Context) {
+	pending, err := c.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.stream,
+		Group:  c.group,
+		Idle:   pendingIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  100,
+	}).Result()
+	if err != nil {
+		if ctx.Err() == nil && !redisDown(err) {
+			slog.Error("worker pending check failed", "worker", c.name, "stream", c.stream, "err", err)
+		}
+		return
+	}
+
+	for _, p := range pending {
+		if p.RetryCount >= maxDeliveries {
+			slog.Error("worker dropped entry", "worker", c.name, "id", p.ID, "deliveries", p.RetryCount)
+			c.rdb.XAck(ctx, c.stream, c.group, p.ID)
+			continue
+		}
+		msgs, err := c.rdb.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   c.stream,
+			Group:    c.group,
+			Consumer: c.id,
+			MinIdle:  pendingIdle,
+			Messages: []string{p.ID},
+		}).Result()
+		if err != nil {
+			slog.Warn("worker claim failed", "worker", c.name, "id", p.ID, "err", err)
+			continue
+		}
+		for _, msg := range msgs {
+			c.handle(ctx, msg)
+		}
+	}
+}
+
+// redisDown reports whether err is Redis being unavailable, which the
+// monitor already logs (see db.RedisMonitor): jobs retry on their next run.
+func redisDown(err error) bool {
+	return errors.Is(err, db.ErrRedisUnavailable)
+}
+
+// sleep waits for d or until ctx is cancelled, reporting whether it slept.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// runOnce runs fn, logging its error or panic, and returns either.
+func runOnce(ctx context.Context, name string, fn func(ctx context.Context) error) (err error) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "worker panic", "worker", name, "panic", r)
+			err = fmt.Errorf("panic: %v", r)
+		}
+		recordRun(name, time.Since(start), err)
+	}()
+	if err := fn(ctx); err != nil {
+		if ctx.Err() == nil && !redisDown(err) {
+			slog.ErrorContext(ctx, "worker run failed", "worker", name, "err", err)
+		}
+		return err
+	}
+	slog.DebugContext(ctx, "worker run done", "worker", name, "duration", time.Since(start).String())
+	return nil
+}
+
+// jobStats holds the counters of each job, published in expvar as "workers"
+// (see package admin): runs (entries handled, for consumers), failed runs
+// and the duration of the last run.
+var jobStats = expvar.NewMap("workers")
+
+func recordRun(name string, d time.Duration, err error) {
+	m, ok := jobStats.Get(name).(*expvar.Map)
+	if !ok {
+		m = new(expvar.Map)
+		jobStats.Set(name, m)
+	}
+	m.Add("runs", 1)
+	if err != nil {
+		m.Add("failures", 1)
+	}
+	last := new(expvar.Int)
+	last.Set(d.Milliseconds())
+	m.Set("lastRunMs", last)
+}